@@ -0,0 +1,55 @@
+package v1alpha1
+
+import (
+	"strings"
+)
+
+const (
+	backstageDefaultKind      = "component"
+	backstageDefaultNamespace = "default"
+)
+
+// NormalizeBackstageEntityRef returns the provided Backstage catalog entity ref
+// in the form <kind>:<namespace>/<name>, defaulting the kind to "component"
+// and the namespace to "default" if they are omitted. Entity refs are
+// compared case-insensitively by Backstage, so the result is lowercase. An
+// empty string is returned if the provided ref has no name.
+func NormalizeBackstageEntityRef(ref string) string {
+	ref = strings.ToLower(strings.TrimSpace(ref))
+	kind := backstageDefaultKind
+	if i := strings.Index(ref, ":"); i >= 0 {
+		if k := strings.TrimSpace(ref[:i]); k != "" {
+			kind = k
+		}
+		ref = ref[i+1:]
+	}
+	namespace := backstageDefaultNamespace
+	if i := strings.Index(ref, "/"); i >= 0 {
+		if ns := strings.TrimSpace(ref[:i]); ns != "" {
+			namespace = ns
+		}
+		ref = ref[i+1:]
+	}
+	name := strings.TrimSpace(ref)
+	if name == "" {
+		return ""
+	}
+	return kind + ":" + namespace + "/" + name
+}
+
+// BackstageEntityRefs returns the normalized refs of the Backstage catalog
+// entities listed by the AnnotationKeyBackstageEntities annotation among the
+// provided annotations. nil is returned if the annotation is absent.
+func BackstageEntityRefs(annotations map[string]string) []string {
+	val, ok := annotations[AnnotationKeyBackstageEntities]
+	if !ok {
+		return nil
+	}
+	var refs []string
+	for _, ref := range strings.Split(val, ",") {
+		if ref = NormalizeBackstageEntityRef(ref); ref != "" {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
@@ -0,0 +1,80 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeBackstageEntityRef(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ref      string
+		expected string
+	}{
+		{
+			name:     "empty",
+			ref:      "  ",
+			expected: "",
+		},
+		{
+			name:     "no name",
+			ref:      "component:default/",
+			expected: "",
+		},
+		{
+			name:     "name only",
+			ref:      "guestbook",
+			expected: "component:default/guestbook",
+		},
+		{
+			name:     "namespace and name",
+			ref:      "team-a/guestbook",
+			expected: "component:team-a/guestbook",
+		},
+		{
+			name:     "kind and name",
+			ref:      "system:guestbook",
+			expected: "system:default/guestbook",
+		},
+		{
+			name:     "fully qualified with mixed case and whitespace",
+			ref:      " Component:Team-A/GuestBook ",
+			expected: "component:team-a/guestbook",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, NormalizeBackstageEntityRef(testCase.ref))
+		})
+	}
+}
+
+func TestBackstageEntityRefs(t *testing.T) {
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		expected    []string
+	}{
+		{
+			name:        "annotation absent",
+			annotations: map[string]string{"foo": "bar"},
+			expected:    nil,
+		},
+		{
+			name: "annotation present",
+			annotations: map[string]string{
+				AnnotationKeyBackstageEntities: "guestbook, ,system:team-a/shop",
+			},
+			expected: []string{
+				"component:default/guestbook",
+				"system:team-a/shop",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, BackstageEntityRefs(testCase.annotations))
+		})
+	}
+}
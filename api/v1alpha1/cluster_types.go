@@ -0,0 +1,128 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClusterConditionTypeConnected is the type of a Cluster condition that
+	// indicates whether Kargo was able to connect to the Cluster's API server
+	// using the Cluster's credentials.
+	ClusterConditionTypeConnected = "Connected"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name=Server,type=string,JSONPath=`.spec.server`
+//+kubebuilder:printcolumn:name=Connected,type=string,JSONPath=`.status.conditions[?(@.type=="Connected")].status`
+//+kubebuilder:printcolumn:name=Version,type=string,JSONPath=`.status.serverVersion`
+//+kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Cluster registers an external Kubernetes cluster with a project so that the
+// project's Stages may reference it, for instance, to have the health of
+// workloads running in the cluster assessed.
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec describes how to connect to the cluster.
+	//
+	//+kubebuilder:validation:Required
+	Spec *ClusterSpec `json:"spec"`
+	// Status describes the most recently observed state of the connection to
+	// the cluster.
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+func (c *Cluster) GetStatus() *ClusterStatus {
+	return &c.Status
+}
+
+// ClusterSpec describes how to connect to a Kubernetes cluster.
+type ClusterSpec struct {
+	// Server is the URL of the cluster's Kubernetes API server.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=`^https?://`
+	Server string `json:"server"`
+	// CAData is a PEM-encoded bundle of the certificate authorities used to
+	// verify the API server's serving certificate. If unspecified, the system's
+	// trusted certificate authorities are used.
+	CAData string `json:"caData,omitempty"`
+	// InsecureSkipTLSVerify indicates whether the API server's serving
+	// certificate should go unverified. This is insecure and should only be used
+	// for testing.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// Auth describes how to authenticate to the cluster's API server.
+	//
+	//+kubebuilder:validation:Required
+	Auth ClusterAuth `json:"auth"`
+}
+
+// ClusterAuth describes how to authenticate to a Kubernetes cluster's API
+// server. Exactly one of its fields should be specified.
+type ClusterAuth struct {
+	// SecretName is the name of a Secret, in the same namespace as the Cluster,
+	// holding credentials for the cluster. The Secret must hold either a bearer
+	// token under the key "token", or a PEM-encoded client certificate and key
+	// under the keys "tls.crt" and "tls.key".
+	SecretName string `json:"secretName,omitempty"`
+	// Exec describes a command that is executed to obtain credentials for the
+	// cluster, in the manner of a kubeconfig exec credential plugin. Since this
+	// executes a command on behalf of the project, it is only honored if an
+	// operator has enabled it.
+	Exec *ClusterExecAuth `json:"exec,omitempty"`
+}
+
+// ClusterExecAuth describes a command that is executed to obtain credentials
+// for a Kubernetes cluster. The command must print an ExecCredential to
+// standard output.
+type ClusterExecAuth struct {
+	// Command is the command to execute.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Command string `json:"command"`
+	// Args are the arguments to pass to the command.
+	Args []string `json:"args,omitempty"`
+	// Env are additional environment variables to set when executing the
+	// command.
+	Env []ClusterExecEnvVar `json:"env,omitempty"`
+	// APIVersion is the version of the client.authentication.k8s.io API of the
+	// ExecCredential printed by the command. If unspecified,
+	// client.authentication.k8s.io/v1 is assumed.
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// ClusterExecEnvVar is an environment variable set when executing a
+// ClusterExecAuth command.
+type ClusterExecEnvVar struct {
+	//+kubebuilder:validation:MinLength=1
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ClusterStatus describes the most recently observed state of the connection
+// to a Kubernetes cluster.
+type ClusterStatus struct {
+	// ServerVersion is the version of Kubernetes most recently reported by the
+	// cluster's API server.
+	ServerVersion string `json:"serverVersion,omitempty"`
+	// ObservedGeneration represents the .metadata.generation that this Cluster
+	// status was reconciled against.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions contains the last observations of the Cluster's current state.
+	//
+	//+patchMergeKey=type
+	//+patchStrategy=merge
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchMergeKey:"type" patchStrategy:"merge"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterList is a list of Cluster resources.
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
@@ -2,8 +2,10 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -31,6 +33,35 @@ func GetFreight(
 	return &freight, nil
 }
 
+// IsFreightSharedWith returns true if the Project specified by the
+// freightProject argument permits the Freight belonging to it to be promoted
+// into Stages of the Project specified by the project argument. Freight is
+// always shared with its own Project. If the Project specified by the
+// freightProject argument does not exist, false is returned.
+func IsFreightSharedWith(
+	ctx context.Context,
+	c client.Client,
+	freightProject string,
+	project string,
+) (bool, error) {
+	if freightProject == project {
+		return true, nil
+	}
+	ns := corev1.Namespace{}
+	if err := c.Get(ctx, types.NamespacedName{Name: freightProject}, &ns); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "error getting Project %q", freightProject)
+	}
+	for _, sharedWith := range strings.Split(ns.Annotations[AnnotationKeyFreightSharedWith], ",") {
+		if sharedWith = strings.TrimSpace(sharedWith); sharedWith == "*" || sharedWith == project {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // GetQualifiedFreight returns a pointer to the Freight resource specified by
 // the namespacedName argument if it is found and EITHER no Stages were
 // specified in the function call OR the Freight has qualified for ANY of the
@@ -55,15 +86,52 @@ func GetQualifiedFreight(
 	if freight == nil {
 		return nil, nil
 	}
-	if len(stages) == 0 {
+	if freight.isQualifiedForAny(stages) {
 		return freight, nil
 	}
-	for qualifiedStage := range freight.Status.Qualifications {
+	return nil, nil
+}
+
+// GetPromotableFreight returns a pointer to the Freight resource specified by
+// the namespacedName argument if it is found and EITHER it has been manually
+// approved for the specified Stage OR, per the semantics of
+// GetQualifiedFreight, it has qualified for any of the specified upstream
+// Stages. In all other cases, nil is returned instead.
+func GetPromotableFreight(
+	ctx context.Context,
+	c client.Client,
+	namespacedName types.NamespacedName,
+	stage string,
+	upstreamStages []string,
+) (*Freight, error) {
+	freight, err := GetFreight(ctx, c, namespacedName)
+	if err != nil {
+		return nil, err
+	}
+	if freight == nil {
+		return nil, nil
+	}
+	if _, approved := freight.Status.Approvals[stage]; approved {
+		return freight, nil
+	}
+	if freight.isQualifiedForAny(upstreamStages) {
+		return freight, nil
+	}
+	return nil, nil
+}
+
+// isQualifiedForAny returns true if no Stages are specified or if the Freight
+// has qualified for ANY of the specified Stages.
+func (f *Freight) isQualifiedForAny(stages []string) bool {
+	if len(stages) == 0 {
+		return true
+	}
+	for qualifiedStage := range f.Status.Qualifications {
 		for _, stage := range stages {
 			if qualifiedStage == stage {
-				return freight, nil
+				return true
 			}
 		}
 	}
-	return nil, nil
+	return false
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -62,3 +63,201 @@ func TestGetFreight(t *testing.T) {
 		})
 	}
 }
+
+func TestIsFreightSharedWith(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	newProject := func(sharedWith string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "fake-freight-project",
+				Annotations: map[string]string{
+					AnnotationKeyFreightSharedWith: sharedWith,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		client         client.Client
+		freightProject string
+		assertions     func(bool, error)
+	}{
+		{
+			name:           "same Project",
+			client:         fake.NewClientBuilder().WithScheme(scheme).Build(),
+			freightProject: "fake-project",
+			assertions: func(shared bool, err error) {
+				require.NoError(t, err)
+				require.True(t, shared)
+			},
+		},
+		{
+			name:           "Freight Project not found",
+			client:         fake.NewClientBuilder().WithScheme(scheme).Build(),
+			freightProject: "fake-freight-project",
+			assertions: func(shared bool, err error) {
+				require.NoError(t, err)
+				require.False(t, shared)
+			},
+		},
+		{
+			name: "not shared",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newProject("other-project"),
+			).Build(),
+			freightProject: "fake-freight-project",
+			assertions: func(shared bool, err error) {
+				require.NoError(t, err)
+				require.False(t, shared)
+			},
+		},
+		{
+			name: "shared with Project",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newProject("other-project, fake-project"),
+			).Build(),
+			freightProject: "fake-freight-project",
+			assertions: func(shared bool, err error) {
+				require.NoError(t, err)
+				require.True(t, shared)
+			},
+		},
+		{
+			name: "shared with all Projects",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newProject("*"),
+			).Build(),
+			freightProject: "fake-freight-project",
+			assertions: func(shared bool, err error) {
+				require.NoError(t, err)
+				require.True(t, shared)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				IsFreightSharedWith(
+					context.Background(),
+					testCase.client,
+					testCase.freightProject,
+					"fake-project",
+				),
+			)
+		})
+	}
+}
+
+func TestGetPromotableFreight(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	testCases := []struct {
+		name           string
+		client         client.Client
+		upstreamStages []string
+		assertions     func(*Freight, error)
+	}{
+		{
+			name:   "not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			assertions: func(freight *Freight, err error) {
+				require.NoError(t, err)
+				require.Nil(t, freight)
+			},
+		},
+		{
+			name: "no upstream Stages",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-freight",
+						Namespace: "fake-namespace",
+					},
+				},
+			).Build(),
+			assertions: func(freight *Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+			},
+		},
+		{
+			name: "not qualified for upstream Stages and not approved",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-freight",
+						Namespace: "fake-namespace",
+					},
+				},
+			).Build(),
+			upstreamStages: []string{"fake-upstream-stage"},
+			assertions: func(freight *Freight, err error) {
+				require.NoError(t, err)
+				require.Nil(t, freight)
+			},
+		},
+		{
+			name: "qualified for an upstream Stage",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-freight",
+						Namespace: "fake-namespace",
+					},
+					Status: FreightStatus{
+						Qualifications: map[string]Qualification{
+							"fake-upstream-stage": {},
+						},
+					},
+				},
+			).Build(),
+			upstreamStages: []string{"fake-upstream-stage"},
+			assertions: func(freight *Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+			},
+		},
+		{
+			name: "approved for the Stage",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-freight",
+						Namespace: "fake-namespace",
+					},
+					Status: FreightStatus{
+						Approvals: map[string]Approval{
+							"fake-stage": {},
+						},
+					},
+				},
+			).Build(),
+			upstreamStages: []string{"fake-upstream-stage"},
+			assertions: func(freight *Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			freight, err := GetPromotableFreight(
+				context.Background(),
+				testCase.client,
+				types.NamespacedName{
+					Namespace: "fake-namespace",
+					Name:      "fake-freight",
+				},
+				"fake-stage",
+				testCase.upstreamStages,
+			)
+			testCase.assertions(freight, err)
+		})
+	}
+}
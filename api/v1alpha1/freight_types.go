@@ -20,6 +20,11 @@ type Freight struct {
 	// contents of the Freight. i.e. Two pieces of Freight can be compared for
 	// equality by comparing their IDs.
 	ID string `json:"id,omitempty"`
+	// Alias is a human-friendly name for the Freight that is unique within the
+	// Project. Unless one is explicitly specified, an alias is generated when the
+	// Freight is created if the Project has been annotated with a Freight alias
+	// policy. The alias is also reflected by the kargo.akuity.io/alias label.
+	Alias string `json:"alias,omitempty"`
 	// Commits describes specific Git repository commits.
 	Commits []GitCommit `json:"commits,omitempty"`
 	// Images describes specific versions of specific container images.
@@ -64,6 +69,20 @@ func (f *Freight) UpdateID() {
 	)
 }
 
+// FreightAliasPolicy specifies how aliases are generated for new Freight in a
+// Project. A Project selects a policy using the
+// kargo.akuity.io/freight-alias-policy annotation.
+type FreightAliasPolicy string
+
+const (
+	// FreightAliasPolicySequence generates aliases of the form <prefix>-<n>,
+	// where n is a number that is incremented for each new piece of Freight.
+	FreightAliasPolicySequence FreightAliasPolicy = "Sequence"
+	// FreightAliasPolicyDictionary generates aliases of the form
+	// <prefix>-<adjective>-<noun>, using randomly selected words.
+	FreightAliasPolicyDictionary FreightAliasPolicy = "Dictionary"
+)
+
 // GitCommit describes a specific commit from a specific Git repository.
 type GitCommit struct {
 	// RepoURL is the URL of a Git repository.
@@ -92,11 +111,84 @@ type FreightStatus struct {
 	// Qualifications describes the Stages for which this Freight has been
 	// qualified.
 	Qualifications map[string]Qualification `json:"qualifications,omitempty"`
+	// Approvals describes the Stages for which this Freight has been manually
+	// approved, regardless of whether it has qualified for any of those Stages'
+	// upstream Stages.
+	Approvals map[string]Approval `json:"approvals,omitempty"`
+	// BuildInfo describes how artifacts carried by this Freight were built, as
+	// reported by CI pipelines.
+	BuildInfo []BuildInfo `json:"buildInfo,omitempty"`
 }
 
 // Qualification describes a Freight's qualification for a Stage.
 type Qualification struct{}
 
+// Approval describes a manual approval of a piece of Freight for promotion to a
+// Stage.
+type Approval struct {
+	// Approver identifies the user who approved the Freight for the Stage.
+	Approver string `json:"approver,omitempty"`
+	// ApprovedAt is the time at which the Freight was approved for the Stage.
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty"`
+}
+
+// BuildInfo describes how a specific artifact was built, as reported by a CI
+// pipeline.
+type BuildInfo struct {
+	// RepoURL is the URL of the image repository or Git repository in which the
+	// artifact is found.
+	RepoURL string `json:"repoURL"`
+	// Revision identifies the artifact within the repository specified by
+	// RepoURL. For images, this is a tag. For Git repositories, this is a
+	// commit ID.
+	Revision string `json:"revision"`
+	// PipelineURL is a link to the CI pipeline run that built the artifact.
+	PipelineURL string `json:"pipelineURL,omitempty"`
+	// TestResultsURL is a link to the results of any tests that were run
+	// against the artifact.
+	TestResultsURL string `json:"testResultsURL,omitempty"`
+	// ReportedAt is the time at which the build metadata was reported.
+	ReportedAt *metav1.Time `json:"reportedAt,omitempty"`
+}
+
+// CarriesArtifact returns true if the Freight includes the image or Git commit
+// identified by the provided repository URL and revision.
+func (f *Freight) CarriesArtifact(repoURL, revision string) bool {
+	for _, image := range f.Images {
+		if image.RepoURL == repoURL && image.Tag == revision {
+			return true
+		}
+	}
+	for _, commit := range f.Commits {
+		if commit.RepoURL == repoURL && commit.ID == revision {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeBuildInfo returns the result of merging the provided updates into the
+// provided, existing build metadata. Updates supersede any existing build
+// metadata for the same artifact. The result is ordered from least to most
+// recently merged.
+func MergeBuildInfo(existing []BuildInfo, updates ...BuildInfo) []BuildInfo {
+	merged := make([]BuildInfo, 0, len(existing)+len(updates))
+	for _, buildInfo := range existing {
+		var superseded bool
+		for _, update := range updates {
+			if update.RepoURL == buildInfo.RepoURL &&
+				update.Revision == buildInfo.Revision {
+				superseded = true
+				break
+			}
+		}
+		if !superseded {
+			merged = append(merged, buildInfo)
+		}
+	}
+	return append(merged, updates...)
+}
+
 //+kubebuilder:object:root=true
 
 // FreightList is a list of Freight resources.
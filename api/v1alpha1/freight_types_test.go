@@ -109,3 +109,71 @@ func TestFreightUpdateID(t *testing.T) {
 	freight.UpdateID()
 	require.NotEqual(t, result, freight.ID)
 }
+
+func TestFreightCarriesArtifact(t *testing.T) {
+	freight := &Freight{
+		Commits: []GitCommit{
+			{
+				RepoURL: "fake-git-repo",
+				ID:      "fake-commit-id",
+			},
+		},
+		Images: []Image{
+			{
+				RepoURL: "fake-image-repo",
+				Tag:     "fake-tag",
+			},
+		},
+	}
+	require.True(t, freight.CarriesArtifact("fake-git-repo", "fake-commit-id"))
+	require.True(t, freight.CarriesArtifact("fake-image-repo", "fake-tag"))
+	require.False(t, freight.CarriesArtifact("fake-image-repo", "other-tag"))
+	require.False(t, freight.CarriesArtifact("other-repo", "fake-commit-id"))
+}
+
+func TestMergeBuildInfo(t *testing.T) {
+	require.Equal(
+		t,
+		[]BuildInfo{
+			{
+				RepoURL:     "fake-git-repo",
+				Revision:    "fake-commit-id",
+				PipelineURL: "fake-url",
+			},
+			{
+				RepoURL:     "fake-image-repo",
+				Revision:    "fake-tag",
+				PipelineURL: "new-url",
+			},
+			{
+				RepoURL:     "fake-image-repo",
+				Revision:    "other-tag",
+				PipelineURL: "fake-url",
+			},
+		},
+		MergeBuildInfo(
+			[]BuildInfo{
+				{
+					RepoURL:     "fake-image-repo",
+					Revision:    "fake-tag",
+					PipelineURL: "old-url",
+				},
+				{
+					RepoURL:     "fake-git-repo",
+					Revision:    "fake-commit-id",
+					PipelineURL: "fake-url",
+				},
+			},
+			BuildInfo{
+				RepoURL:     "fake-image-repo",
+				Revision:    "fake-tag",
+				PipelineURL: "new-url",
+			},
+			BuildInfo{
+				RepoURL:     "fake-image-repo",
+				Revision:    "other-tag",
+				PipelineURL: "fake-url",
+			},
+		),
+	)
+}
@@ -1,9 +1,69 @@
 package v1alpha1
 
 const (
-	LabelProjectKey = "kargo.akuity.io/project"
+	LabelProjectKey       = "kargo.akuity.io/project"
+	LabelAutoPromotionKey = "kargo.akuity.io/auto-promotion"
+	LabelSelfHealKey      = "kargo.akuity.io/self-heal"
+	LabelReleaseKey       = "kargo.akuity.io/release"
+	LabelAliasKey         = "kargo.akuity.io/alias"
+
+	// LabelArchivedKey is applied to Project namespaces to archive them. The
+	// resources of an archived Project remain readable, but are no longer
+	// reconciled and cannot be modified.
+	LabelArchivedKey = "kargo.akuity.io/archived"
+
+	// LabelArtifactKey is applied to ConfigMaps in which artifacts belonging to
+	// other resources, such as the logs of Promotions, are stored.
+	LabelArtifactKey = "kargo.akuity.io/artifact"
 
 	LabelTrueValue = "true"
 
-	AnnotationKeyRefresh = "kargo.akuity.io/refresh"
+	AnnotationKeyRefresh  = "kargo.akuity.io/refresh"
+	AnnotationKeyPromoter = "kargo.akuity.io/promoter"
+
+	// AnnotationKeyArtifactPath and AnnotationKeyArtifactContentType are applied
+	// to ConfigMaps in which artifacts are stored. Their values are the path
+	// that identifies the artifact within its namespace and the artifact's media
+	// type, respectively.
+	AnnotationKeyArtifactPath        = "kargo.akuity.io/artifact-path"
+	AnnotationKeyArtifactContentType = "kargo.akuity.io/artifact-content-type"
+
+	// AnnotationKeySupersededFreight is applied to auto-Promotions that were
+	// created while auto-Promotions were coalesced. Its value is a
+	// comma-delimited list of the IDs of the Freight superseded by the
+	// Promotion's Freight, from newest to oldest.
+	AnnotationKeySupersededFreight = "kargo.akuity.io/superseded-freight"
+
+	// The following annotations are applied to Project namespaces to configure
+	// the generation of Freight aliases.
+	AnnotationKeyFreightAliasPolicy   = "kargo.akuity.io/freight-alias-policy"
+	AnnotationKeyFreightAliasPrefix   = "kargo.akuity.io/freight-alias-prefix"
+	AnnotationKeyFreightAliasSequence = "kargo.akuity.io/freight-alias-sequence"
+
+	// AnnotationKeyFreightSharedWith is applied to Project namespaces to permit
+	// the Freight belonging to them to be promoted into Stages of other
+	// Projects. Its value is a comma-delimited list of the names of those other
+	// Projects, or "*" to permit promotion into Stages of any Project.
+	AnnotationKeyFreightSharedWith = "kargo.akuity.io/freight-shared-with"
+
+	// AnnotationKeyRenamedFrom is applied to a Stage created by renaming
+	// another. Its value is the Stage's previous name. It permits a rename that
+	// was interrupted to be resumed.
+	AnnotationKeyRenamedFrom = "kargo.akuity.io/renamed-from"
+
+	// The following annotations are applied to Events recorded about failed
+	// Promotions so that notification integrations can route them to the
+	// owners of the Promotion's Stage. Their values are comma-delimited lists
+	// of the names of the Stage's User owners, the names of its Team owners,
+	// and the email addresses of all of its owners, respectively.
+	AnnotationKeyOwnerUsers  = "kargo.akuity.io/owner-users"
+	AnnotationKeyOwnerTeams  = "kargo.akuity.io/owner-teams"
+	AnnotationKeyOwnerEmails = "kargo.akuity.io/owner-emails"
+
+	// AnnotationKeyBackstageEntities is applied to Project namespaces to
+	// associate them with the Backstage catalog entities that they deploy. Its
+	// value is a comma-delimited list of entity refs. It may also be applied to
+	// a Stage to narrow the entities deployed by that Stage to a subset of
+	// those deployed by its Project.
+	AnnotationKeyBackstageEntities = "kargo.akuity.io/backstage-entities"
 )
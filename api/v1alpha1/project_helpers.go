@@ -0,0 +1,33 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsProjectArchived returns true if the namespace of the specified Project is
+// labeled as archived. If no such namespace exists, false is returned.
+func IsProjectArchived(
+	ctx context.Context,
+	c client.Client,
+	project string,
+) (bool, error) {
+	ns := corev1.Namespace{}
+	if err := c.Get(ctx, types.NamespacedName{Name: project}, &ns); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "error getting Project %q", project)
+	}
+	return IsNamespaceArchived(&ns), nil
+}
+
+// IsNamespaceArchived returns true if the provided namespace is labeled as an
+// archived Project.
+func IsNamespaceArchived(ns *corev1.Namespace) bool {
+	return ns.Labels[LabelArchivedKey] == LabelTrueValue
+}
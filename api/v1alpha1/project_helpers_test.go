@@ -0,0 +1,79 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsProjectArchived(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	newProject := func(labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "fake-project",
+				Labels: labels,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		assertions func(bool, error)
+	}{
+		{
+			name:   "Project not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			assertions: func(archived bool, err error) {
+				require.NoError(t, err)
+				require.False(t, archived)
+			},
+		},
+		{
+			name: "not archived",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newProject(map[string]string{
+					LabelProjectKey: LabelTrueValue,
+				}),
+			).Build(),
+			assertions: func(archived bool, err error) {
+				require.NoError(t, err)
+				require.False(t, archived)
+			},
+		},
+		{
+			name: "archived",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newProject(map[string]string{
+					LabelProjectKey:  LabelTrueValue,
+					LabelArchivedKey: LabelTrueValue,
+				}),
+			).Build(),
+			assertions: func(archived bool, err error) {
+				require.NoError(t, err)
+				require.True(t, archived)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				IsProjectArchived(
+					context.Background(),
+					testCase.client,
+					"fake-project",
+				),
+			)
+		})
+	}
+}
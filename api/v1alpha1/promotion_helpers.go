@@ -30,3 +30,14 @@ func GetPromotion(
 	}
 	return &promo, nil
 }
+
+// FreightNamespace returns the namespace of the Freight referenced by the
+// Promotion. This is the namespace of the Project referenced by the
+// Promotion's FreightProject field, if specified, and the Promotion's own
+// namespace otherwise.
+func (p *Promotion) FreightNamespace() string {
+	if p.Spec != nil && p.Spec.FreightProject != "" {
+		return p.Spec.FreightProject
+	}
+	return p.Namespace
+}
@@ -62,3 +62,43 @@ func TestGetPromotion(t *testing.T) {
 		})
 	}
 }
+
+func TestPromotionFreightNamespace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		promo    *Promotion
+		expected string
+	}{
+		{
+			name: "nil spec",
+			promo: &Promotion{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fake-namespace"},
+			},
+			expected: "fake-namespace",
+		},
+		{
+			name: "Freight from the Promotion's own Project",
+			promo: &Promotion{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fake-namespace"},
+				Spec:       &PromotionSpec{Freight: "fake-freight"},
+			},
+			expected: "fake-namespace",
+		},
+		{
+			name: "Freight from another Project",
+			promo: &Promotion{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fake-namespace"},
+				Spec: &PromotionSpec{
+					Freight:        "fake-freight",
+					FreightProject: "other-namespace",
+				},
+			},
+			expected: "other-namespace",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.promo.FreightNamespace())
+		})
+	}
+}
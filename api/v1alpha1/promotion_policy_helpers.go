@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -30,3 +31,13 @@ func GetPromotionPolicy(
 	}
 	return &policy, nil
 }
+
+// ExceedsMaxFreightAge returns true if the PromotionPolicy specifies a
+// MaxFreightAge and the provided Freight was created longer ago than that, as
+// of the provided time. A nil PromotionPolicy imposes no maximum age.
+func (p *PromotionPolicy) ExceedsMaxFreightAge(freight *Freight, now time.Time) bool {
+	if p == nil || p.MaxFreightAge == nil || p.MaxFreightAge.Duration <= 0 {
+		return false
+	}
+	return now.Sub(freight.CreationTimestamp.Time) > p.MaxFreightAge.Duration
+}
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -62,3 +63,47 @@ func TestGetPromotionPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestPromotionPolicyExceedsMaxFreightAge(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	freight := &Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.Time{Time: now.Add(-48 * time.Hour)},
+		},
+	}
+	testCases := []struct {
+		name     string
+		policy   *PromotionPolicy
+		expected bool
+	}{
+		{
+			name: "nil PromotionPolicy",
+		},
+		{
+			name:   "no max age",
+			policy: &PromotionPolicy{},
+		},
+		{
+			name: "Freight younger than max age",
+			policy: &PromotionPolicy{
+				MaxFreightAge: &metav1.Duration{Duration: 72 * time.Hour},
+			},
+		},
+		{
+			name: "Freight older than max age",
+			policy: &PromotionPolicy{
+				MaxFreightAge: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.policy.ExceedsMaxFreightAge(freight, now),
+			)
+		})
+	}
+}
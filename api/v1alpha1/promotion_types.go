@@ -35,6 +35,43 @@ func (p *PromotionPhase) IsTerminal() bool {
 	return *p == PromotionPhaseSucceeded || *p == PromotionPhaseErrored
 }
 
+// PromotionFailureReason is a machine-readable classification of the cause of
+// a Promotion's failure.
+type PromotionFailureReason string
+
+const (
+	// PromotionFailureReasonGitAuthFailed denotes a Promotion that failed
+	// because Kargo was unable to authenticate to a Git repository.
+	PromotionFailureReasonGitAuthFailed PromotionFailureReason = "GitAuthFailed"
+	// PromotionFailureReasonMergeConflict denotes a Promotion that failed
+	// because changes could not be pushed to a Git repository whose remote
+	// branch had diverged (e.g. due to a concurrent update).
+	PromotionFailureReasonMergeConflict PromotionFailureReason = "MergeConflict"
+	// PromotionFailureReasonSyncTimeout denotes a Promotion that failed because
+	// an operation against Argo CD did not complete in time.
+	PromotionFailureReasonSyncTimeout PromotionFailureReason = "SyncTimeout"
+	// PromotionFailureReasonArgoCDOperationInProgress denotes a Promotion that
+	// failed because an operation that was already in progress on an Argo CD
+	// Application did not complete in time for the Promotion to sync the
+	// Application.
+	PromotionFailureReasonArgoCDOperationInProgress PromotionFailureReason = "ArgoCDOperationInProgress"
+	// PromotionFailureReasonRenderError denotes a Promotion that failed because
+	// manifests could not be rendered by a configuration management tool (e.g.
+	// Kargo Render, Kustomize, or Helm).
+	PromotionFailureReasonRenderError PromotionFailureReason = "RenderError"
+	// PromotionFailureReasonUnknown denotes a Promotion that failed for a reason
+	// that could not be classified.
+	PromotionFailureReasonUnknown PromotionFailureReason = "Unknown"
+)
+
+// IsTransient returns true if a Promotion that failed for this reason may
+// reasonably be expected to succeed if retried without any corrective action.
+func (p PromotionFailureReason) IsTransient() bool {
+	return p == PromotionFailureReasonMergeConflict ||
+		p == PromotionFailureReasonSyncTimeout ||
+		p == PromotionFailureReasonArgoCDOperationInProgress
+}
+
 //+kubebuilder:resource:shortName={promo,promos}
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
@@ -77,6 +114,16 @@ type PromotionSpec struct {
 	//
 	//+kubebuilder:validation:MinLength=1
 	Freight string `json:"freight"`
+	// FreightProject optionally specifies the Project to which the Freight
+	// referenced by the Freight field belongs, if not the Project of this
+	// Promotion. This is only permitted if the Project referenced by this field
+	// shares its Freight with the Project of this Promotion. Freight from
+	// another Project is not required to have been approved for, or to have
+	// qualified in any Stage upstream from, the Stage referenced by the Stage
+	// field.
+	//
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	FreightProject string `json:"freightProject,omitempty"`
 }
 
 // PromotionStatus describes the current state of the transition represented by
@@ -88,6 +135,114 @@ type PromotionStatus struct {
 	// from executing this Promotion. i.e. If the Phase field has a value of
 	// Failed, this field can be expected to explain why.
 	Error string `json:"error,omitempty"`
+	// FailureReason classifies the cause of the Promotion's failure. It is only
+	// populated for Promotions whose Phase is Errored.
+	FailureReason PromotionFailureReason `json:"failureReason,omitempty"`
+	// PushAttempts records each attempt that was made to push changes to a Git
+	// repository while executing the Promotion, including any attempts that
+	// were rejected and subsequently retried.
+	PushAttempts []GitPushAttempt `json:"pushAttempts,omitempty"`
+	// Attestation is a signed provenance attestation for the Promotion. It is
+	// only populated for Promotions that have succeeded and only when the
+	// Promotion controller has been configured with a signing key.
+	Attestation *PromotionAttestation `json:"attestation,omitempty"`
+	// Impact describes the Git repositories, Argo CD Applications, and clusters
+	// that the Promotion changes. It is recorded when the Promotion begins
+	// executing so that its blast radius remains apparent afterward.
+	Impact *PromotionImpact `json:"impact,omitempty"`
+	// LogURL is the URL at which the log of the Promotion's execution is stored.
+	// It is only populated when the Promotion controller has been configured
+	// with an artifact store.
+	LogURL string `json:"logURL,omitempty"`
+}
+
+// PromotionImpact describes what a Promotion changes when it is executed.
+type PromotionImpact struct {
+	// Repos lists the URLs of the Git repositories to which the Promotion
+	// pushes changes.
+	Repos []string `json:"repos,omitempty"`
+	// ArgoCDApps lists the Argo CD Applications that the Promotion updates.
+	ArgoCDApps []ArgoCDAppReference `json:"argoCDApps,omitempty"`
+	// Clusters lists the clusters to which those Argo CD Applications deploy.
+	// Clusters are as last observed by the Stage controller when it assessed
+	// the Stage's health, so a cluster some Application has never been
+	// observed to deploy to is not listed.
+	Clusters []string `json:"clusters,omitempty"`
+}
+
+// ArgoCDAppReference identifies an Argo CD Application.
+type ArgoCDAppReference struct {
+	// Namespace is the namespace of the Argo CD Application.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Argo CD Application.
+	Name string `json:"name"`
+	// Cluster identifies the cluster to which the Argo CD Application deploys,
+	// if known.
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// GitPushResult is the result of an attempt to push changes to a Git
+// repository.
+type GitPushResult string
+
+const (
+	// GitPushResultPushed denotes a push that succeeded.
+	GitPushResultPushed GitPushResult = "Pushed"
+	// GitPushResultRejected denotes a push that was rejected because the remote
+	// branch had diverged.
+	GitPushResultRejected GitPushResult = "Rejected"
+	// GitPushResultConflicted denotes a push that was rejected and whose changes
+	// could not subsequently be rebased onto the remote branch without
+	// unresolvable conflicts.
+	GitPushResultConflicted GitPushResult = "Conflicted"
+)
+
+// GitPushAttempt records a single attempt to push changes to a Git
+// repository.
+type GitPushAttempt struct {
+	// RepoURL is the URL of the repository to which changes were pushed.
+	RepoURL string `json:"repoURL"`
+	// Branch is the branch to which changes were pushed.
+	Branch string `json:"branch"`
+	// Attempt is the number of this attempt, starting from 1, among all
+	// attempts to push the same changes to the same branch.
+	Attempt int32 `json:"attempt"`
+	// Result is the result of the attempt.
+	Result GitPushResult `json:"result"`
+	// ResolvedPaths lists the paths of any files whose conflicts were resolved
+	// in favor of Kargo's changes after this attempt was rejected.
+	ResolvedPaths []string `json:"resolvedPaths,omitempty"`
+	// Message is a human-readable description of the attempt's result.
+	Message string `json:"message,omitempty"`
+}
+
+// PromotionAttestation is a signed provenance attestation for a Promotion. It
+// takes the form of a DSSE envelope wrapping an in-toto Statement with a SLSA
+// provenance predicate describing the Freight that was promoted, the Stage it
+// was promoted into, who requested the Promotion, and when it was executed.
+type PromotionAttestation struct {
+	// PayloadType is the type of the signed payload. This is always
+	// "application/vnd.in-toto+json".
+	PayloadType string `json:"payloadType"`
+	// Payload is the signed in-toto Statement.
+	Payload []byte `json:"payload"`
+	// Signatures are signatures over the DSSE pre-authentication encoding of
+	// the PayloadType and Payload fields.
+	Signatures []AttestationSignature `json:"signatures"`
+	// Reference is a digest-qualified reference to the attestation in an OCI
+	// repository. It is only populated when the Promotion controller has been
+	// configured to push attestations to an OCI repository.
+	Reference string `json:"reference,omitempty"`
+}
+
+// AttestationSignature is a single signature over a PromotionAttestation's
+// payload.
+type AttestationSignature struct {
+	// KeyID identifies the key that produced the signature. This is the
+	// hex-encoded SHA-256 digest of the DER-encoded PKIX public key.
+	KeyID string `json:"keyid,omitempty"`
+	// Sig is the signature.
+	Sig []byte `json:"sig"`
 }
 
 //+kubebuilder:object:root=true
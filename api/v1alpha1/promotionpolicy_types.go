@@ -28,6 +28,38 @@ type PromotionPolicy struct {
 	// of other, upstream Stages. This allows users to define Stages that are
 	// automatically updated as soon as new materials are detected.
 	EnableAutoPromotion bool `json:"enableAutoPromotion,omitempty"`
+	// EnableSelfHeal indicates whether, upon detecting that what is running in
+	// the Stage referenced by the Stage field has drifted from that Stage's
+	// current Freight, a new Promotion of the current Freight should
+	// automatically be created to restore the Stage to its desired state. To
+	// avoid fighting with whatever caused the drift, self-healing Promotions
+	// are rate limited. This field defaults to false.
+	EnableSelfHeal bool `json:"enableSelfHeal,omitempty"`
+	// AutoPromotionHoldback optionally specifies how long to hold back
+	// auto-promotion of the Stage referenced by the Stage field after Freight
+	// newer than that Stage's current Freight first becomes available to it.
+	// Only the newest Freight available when the holdback elapses is promoted,
+	// so Freight that becomes available in rapid succession is batched into a
+	// single Promotion. This field has no effect unless auto-promotion is
+	// enabled. e.g. "15m".
+	AutoPromotionHoldback *metav1.Duration `json:"autoPromotionHoldback,omitempty"`
+	// CoalesceAutoPromotions indicates whether, when Freight newer than that of
+	// a Pending auto-Promotion of the Stage referenced by the Stage field
+	// becomes available, the Pending Promotion should be aborted in favor of a
+	// single Promotion of the newest Freight. Freight skipped in this manner, or
+	// because newer Freight became available before it could be auto-promoted,
+	// is recorded in the Stage's history as superseded once the newest Freight
+	// has been promoted. This field has no effect unless auto-promotion is
+	// enabled. This field defaults to false.
+	CoalesceAutoPromotions bool `json:"coalesceAutoPromotions,omitempty"`
+	// MaxFreightAge optionally specifies the maximum age of Freight that may be
+	// promoted to the Stage referenced by the Stage field. The age of a piece
+	// of Freight is the time elapsed since it was created, i.e. since its
+	// Warehouse discovered it. Older Freight is never auto-promoted, and may
+	// only be promoted manually by users permitted to use the custom
+	// "promote-stale" verb on the Stage. This prevents stale artifacts from
+	// being promoted by accident. e.g. "720h".
+	MaxFreightAge *metav1.Duration `json:"maxFreightAge,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -0,0 +1,64 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetRelease(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		assertions func(*Release, error)
+	}{
+		{
+			name:   "not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			assertions: func(release *Release, err error) {
+				require.NoError(t, err)
+				require.Nil(t, release)
+			},
+		},
+
+		{
+			name: "found",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&Release{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-release",
+						Namespace: "fake-namespace",
+					},
+				},
+			).Build(),
+			assertions: func(release *Release, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-release", release.Name)
+				require.Equal(t, "fake-namespace", release.Namespace)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			release, err := GetRelease(
+				context.Background(),
+				testCase.client,
+				types.NamespacedName{
+					Namespace: "fake-namespace",
+					Name:      "fake-release",
+				},
+			)
+			testCase.assertions(release, err)
+		})
+	}
+}
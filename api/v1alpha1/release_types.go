@@ -0,0 +1,143 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ReleasePhase string
+
+const (
+	// ReleasePhasePending denotes a Release that has not started yet. i.e. Its
+	// start time has not yet arrived.
+	ReleasePhasePending ReleasePhase = "Pending"
+	// ReleasePhaseRunning denotes a Release that is actively promoting its
+	// Freight into its Stages.
+	ReleasePhaseRunning ReleasePhase = "Running"
+	// ReleasePhaseSucceeded denotes a Release that has successfully promoted its
+	// Freight into all of its Stages.
+	ReleasePhaseSucceeded ReleasePhase = "Succeeded"
+	// ReleasePhaseFailed denotes a Release that was halted because one of the
+	// Promotions it created did not succeed. Further information about the
+	// failure can be found in the Release's status.
+	ReleasePhaseFailed ReleasePhase = "Failed"
+)
+
+// IsTerminal returns true if the ReleasePhase is a terminal one.
+func (r *ReleasePhase) IsTerminal() bool {
+	return *r == ReleasePhaseSucceeded || *r == ReleasePhaseFailed
+}
+
+//+kubebuilder:resource:shortName={rel,rels}
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name=Freight,type=string,JSONPath=`.spec.freight`
+//+kubebuilder:printcolumn:name=Phase,type=string,JSONPath=`.status.phase`
+//+kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Release represents a request to promote a particular piece of Freight into
+// an ordered series of Stages, optionally beginning at a scheduled time and
+// optionally pausing between Stages.
+type Release struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec describes the Freight to be released and the Stages it is to be
+	// released into.
+	//
+	//+kubebuilder:validation:Required
+	Spec *ReleaseSpec `json:"spec"`
+	// Status describes the current state of the Release.
+	Status ReleaseStatus `json:"status,omitempty"`
+}
+
+func (r *Release) GetStatus() *ReleaseStatus {
+	return &r.Status
+}
+
+// ReleaseSpec describes the Freight to be released and the Stages it is to be
+// released into.
+type ReleaseSpec struct {
+	// Freight specifies the piece of Freight to be promoted into each of the
+	// Stages referenced by the Steps field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Freight string `json:"freight"`
+	// StartTime optionally specifies the earliest time at which the first
+	// Promotion for this Release may be created. If unspecified, the Release
+	// starts immediately.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// Steps is an ordered list of Stages into which the Freight referenced by
+	// the Freight field is to be promoted. Each step begins only after the
+	// Promotion created for the previous step has succeeded.
+	//
+	//+kubebuilder:validation:MinItems=1
+	Steps []ReleaseStep `json:"steps"`
+}
+
+// ReleaseStep describes a single Stage into which a Release's Freight is to be
+// promoted.
+type ReleaseStep struct {
+	// Stage specifies the name of a Stage into which the Release's Freight is to
+	// be promoted. The Stage referenced by this field MUST be in the same
+	// namespace as the Release.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	Stage string `json:"stage"`
+	// Delay optionally specifies how long to wait after the previous step has
+	// completed (or, for the first step, after the Release has started) before
+	// creating a Promotion for this step. e.g. "1h" or "30m".
+	Delay *metav1.Duration `json:"delay,omitempty"`
+	// Soak optionally specifies how long the Stage must remain at the Release's
+	// Freight without becoming Unhealthy, after its Promotion has succeeded,
+	// before the Release moves on to its next step. When a soak period is
+	// specified, the Stage must also be Healthy at the end of that period. If
+	// the Stage becomes Unhealthy or is promoted to different Freight during
+	// this period, the Release fails. Finally, the Release will not move on until
+	// the Freight has qualified for the Stage. A value of "0s" can be used to
+	// wait for the Stage to be Healthy and the Freight to qualify without any
+	// additional soak period. e.g. "1h" or "30m".
+	Soak *metav1.Duration `json:"soak,omitempty"`
+}
+
+// ReleaseStatus describes the current state of a Release.
+type ReleaseStatus struct {
+	// Phase describes where the Release currently is in its lifecycle.
+	Phase ReleasePhase `json:"phase,omitempty"`
+	// Error describes any errors that are preventing the Release controller
+	// from making progress on this Release, or, if the Phase field has a value
+	// of Failed, why the Release failed.
+	Error string `json:"error,omitempty"`
+	// StartedAt is the time at which the Release started.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// Steps describes the progress of each of the Release's steps. Entries
+	// appear in the same order as in the Release's spec, but only for steps that
+	// have been started.
+	Steps []ReleaseStepStatus `json:"steps,omitempty"`
+}
+
+// ReleaseStepStatus describes the progress of a single step of a Release.
+type ReleaseStepStatus struct {
+	// Stage is the name of the Stage the step promotes into.
+	Stage string `json:"stage"`
+	// Promotion is the name of the Promotion that was created for the step.
+	Promotion string `json:"promotion,omitempty"`
+	// Phase is the last observed phase of the Promotion that was created for the
+	// step.
+	Phase PromotionPhase `json:"phase,omitempty"`
+	// CompletedAt is the time at which the Promotion created for the step was
+	// observed to have reached a terminal phase.
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// VerifiedAt is the time at which the step's Stage was observed to have
+	// made it through the step's soak period. This field is only populated for
+	// steps that specify a soak period.
+	VerifiedAt *metav1.Time `json:"verifiedAt,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ReleaseList contains a list of Releases
+type ReleaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Release `json:"items"`
+}
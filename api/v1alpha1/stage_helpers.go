@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -77,3 +78,80 @@ func ClearStageRefresh(
 	}
 	return clearRefreshObject(ctx, c, &newStage)
 }
+
+// PromotionImpact returns a description of what promoting Freight to the
+// Stage changes, according to the Stage's PromotionMechanisms. The clusters to
+// which the Stage's Argo CD Applications deploy are as last recorded in the
+// Stage's status. nil is returned if the Stage has no PromotionMechanisms.
+func (s *Stage) PromotionImpact() *PromotionImpact {
+	if s.Spec == nil || s.Spec.PromotionMechanisms == nil {
+		return nil
+	}
+	mechs := s.Spec.PromotionMechanisms
+	impact := &PromotionImpact{}
+	repos := map[string]struct{}{}
+	for _, update := range mechs.GitRepoUpdates {
+		if _, ok := repos[update.RepoURL]; !ok {
+			repos[update.RepoURL] = struct{}{}
+			impact.Repos = append(impact.Repos, update.RepoURL)
+		}
+	}
+	appClusters := map[types.NamespacedName]string{}
+	if s.Status.Health != nil {
+		for _, app := range s.Status.Health.ArgoCDApps {
+			appClusters[types.NamespacedName{
+				Namespace: app.Namespace,
+				Name:      app.Name,
+			}] = app.Cluster
+		}
+	}
+	clusters := map[string]struct{}{}
+	for _, update := range mechs.ArgoCDAppUpdates {
+		app := ArgoCDAppReference{
+			Namespace: update.AppNamespaceOrDefault(),
+			Name:      update.AppName,
+		}
+		app.Cluster = appClusters[types.NamespacedName{
+			Namespace: app.Namespace,
+			Name:      app.Name,
+		}]
+		impact.ArgoCDApps = append(impact.ArgoCDApps, app)
+		if _, ok := clusters[app.Cluster]; !ok && app.Cluster != "" {
+			clusters[app.Cluster] = struct{}{}
+			impact.Clusters = append(impact.Clusters, app.Cluster)
+		}
+	}
+	return impact
+}
+
+// OwnerAnnotations returns annotations identifying the Stage's owners, for
+// application to Events about the Stage that its owners should be notified
+// of. nil is returned if the Stage has no owners.
+func (s *Stage) OwnerAnnotations() map[string]string {
+	if s.Spec == nil || len(s.Spec.Owners) == 0 {
+		return nil
+	}
+	var users, teams, emails []string
+	for _, owner := range s.Spec.Owners {
+		switch owner.Kind {
+		case StageOwnerKindUser:
+			users = append(users, owner.Name)
+		case StageOwnerKindTeam:
+			teams = append(teams, owner.Name)
+		}
+		if owner.Email != "" {
+			emails = append(emails, owner.Email)
+		}
+	}
+	annotations := make(map[string]string, 3)
+	if len(users) > 0 {
+		annotations[AnnotationKeyOwnerUsers] = strings.Join(users, ",")
+	}
+	if len(teams) > 0 {
+		annotations[AnnotationKeyOwnerTeams] = strings.Join(teams, ",")
+	}
+	if len(emails) > 0 {
+		annotations[AnnotationKeyOwnerEmails] = strings.Join(emails, ",")
+	}
+	return annotations
+}
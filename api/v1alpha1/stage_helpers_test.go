@@ -62,3 +62,94 @@ func TestGetStage(t *testing.T) {
 		})
 	}
 }
+
+func TestStagePromotionImpact(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stage    *Stage
+		expected *PromotionImpact
+	}{
+		{
+			name:     "no promotion mechanisms",
+			stage:    &Stage{Spec: &StageSpec{}},
+			expected: nil,
+		},
+		{
+			name: "promotion mechanisms",
+			stage: &Stage{
+				Spec: &StageSpec{
+					PromotionMechanisms: &PromotionMechanisms{
+						GitRepoUpdates: []GitRepoUpdate{
+							{RepoURL: "https://github.com/example/repo", WriteBranch: "env/test"},
+							{RepoURL: "https://github.com/example/repo", WriteBranch: "env/uat"},
+						},
+						ArgoCDAppUpdates: []ArgoCDAppUpdate{
+							{AppNamespace: "argocd", AppName: "app-1"},
+							{AppNamespace: "argocd", AppName: "app-2"},
+							{AppNamespace: "argocd", AppName: "app-3"},
+						},
+					},
+				},
+				Status: StageStatus{
+					Health: &Health{
+						ArgoCDApps: []ArgoCDAppStatus{
+							{Namespace: "argocd", Name: "app-1", Cluster: "in-cluster"},
+							{Namespace: "argocd", Name: "app-2", Cluster: "in-cluster"},
+						},
+					},
+				},
+			},
+			expected: &PromotionImpact{
+				Repos: []string{"https://github.com/example/repo"},
+				ArgoCDApps: []ArgoCDAppReference{
+					{Namespace: "argocd", Name: "app-1", Cluster: "in-cluster"},
+					{Namespace: "argocd", Name: "app-2", Cluster: "in-cluster"},
+					// The Stage controller has yet to observe this Application
+					{Namespace: "argocd", Name: "app-3"},
+				},
+				Clusters: []string{"in-cluster"},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.stage.PromotionImpact())
+		})
+	}
+}
+
+func TestStageOwnerAnnotations(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stage    *Stage
+		expected map[string]string
+	}{
+		{
+			name:     "no owners",
+			stage:    &Stage{Spec: &StageSpec{}},
+			expected: nil,
+		},
+		{
+			name: "owners",
+			stage: &Stage{
+				Spec: &StageSpec{
+					Owners: []StageOwner{
+						{Kind: StageOwnerKindUser, Name: "alice", Email: "alice@example.com"},
+						{Kind: StageOwnerKindUser, Name: "bob"},
+						{Kind: StageOwnerKindTeam, Name: "platform-team", Email: "platform@example.com"},
+					},
+				},
+			},
+			expected: map[string]string{
+				AnnotationKeyOwnerUsers:  "alice,bob",
+				AnnotationKeyOwnerTeams:  "platform-team",
+				AnnotationKeyOwnerEmails: "alice@example.com,platform@example.com",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.stage.OwnerAnnotations())
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package v1alpha1
 
 import (
+	"fmt"
 	"os"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -38,6 +40,29 @@ func (h HealthState) Merge(other HealthState) HealthState {
 	return other
 }
 
+const (
+	// StageConditionTypeDrifted is the type of a Stage condition that indicates
+	// whether what is actually running in the Stage's Argo CD Applications
+	// has diverged from the Stage's current Freight.
+	StageConditionTypeDrifted = "Drifted"
+	// StageConditionTypeFreightQualified is the type of a Stage condition that
+	// indicates whether the Stage's current Freight has satisfied the Stage's
+	// QualificationPolicy.
+	StageConditionTypeFreightQualified = "FreightQualified"
+)
+
+// +kubebuilder:validation:Enum={AllOf,AnyOf}
+type QualificationOperator string
+
+const (
+	// QualificationOperatorAllOf requires all of a QualificationPolicy's
+	// criteria to be satisfied.
+	QualificationOperatorAllOf QualificationOperator = "AllOf"
+	// QualificationOperatorAnyOf requires any one of a QualificationPolicy's
+	// criteria to be satisfied.
+	QualificationOperatorAnyOf QualificationOperator = "AnyOf"
+)
+
 type ArgoCDAppHealthState string
 
 const (
@@ -95,6 +120,266 @@ type StageSpec struct {
 	// single upstream Stage where they may otherwise have subscribed to multiple
 	// upstream Stages.
 	PromotionMechanisms *PromotionMechanisms `json:"promotionMechanisms,omitempty"`
+	// Qualification optionally describes what must be true of the Stage's
+	// current Freight before that Freight is qualified for the Stage and thereby
+	// made available to downstream Stages. If unspecified, Freight qualifies as
+	// soon as the Stage is observed to be Healthy with it. This field has no
+	// effect on Stages without PromotionMechanisms.
+	Qualification *QualificationPolicy `json:"qualification,omitempty"`
+	// HealthChecks optionally describes external health providers that should
+	// be consulted, in addition to any Argo CD Applications, when assessing the
+	// health of the Stage. This field has no effect on Stages without
+	// PromotionMechanisms.
+	HealthChecks []HealthCheck `json:"healthChecks,omitempty"`
+	// HealthDamping optionally describes how long a Stage that has been observed
+	// to be Unhealthy must subsequently be observed to be Healthy before it is
+	// reported as Healthy again. This reduces flapping of the Stage's health, and
+	// of its Freight's qualification, caused by brief lapses in health. This
+	// field has no effect on Stages without PromotionMechanisms.
+	HealthDamping *HealthDampingPolicy `json:"healthDamping,omitempty"`
+	// Owners optionally identifies the users and teams responsible for the
+	// Stage. Notification integrations may use this to direct notices about the
+	// Stage, such as failed Promotions, to the right people.
+	Owners []StageOwner `json:"owners,omitempty"`
+	// ReconcileInterval optionally specifies how often the Stage is
+	// reconciled, i.e. how often its health is assessed and new Freight is
+	// looked for, in the absence of any change to the Stage. It must not be
+	// less than 30s. When unspecified, the Stage is reconciled every 5m.
+	ReconcileInterval *metav1.Duration `json:"reconcileInterval,omitempty"`
+}
+
+// StageOwnerKind is the kind of an owner of a Stage.
+//
+// +kubebuilder:validation:Enum={User,Team}
+type StageOwnerKind string
+
+const (
+	StageOwnerKindUser StageOwnerKind = "User"
+	StageOwnerKindTeam StageOwnerKind = "Team"
+)
+
+// StageOwner identifies a user or team responsible for a Stage.
+type StageOwner struct {
+	// Kind specifies whether the owner is a User or a Team.
+	Kind StageOwnerKind `json:"kind"`
+	// Name identifies the owner. It is the handle by which the owner can be
+	// mentioned in chat, e.g. "alice" or "platform-team".
+	//
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Email is an optional email address at which the owner can be reached.
+	Email string `json:"email,omitempty"`
+}
+
+// HealthCheck describes an external health provider that should be consulted
+// when assessing the health of a Stage.
+type HealthCheck struct {
+	// Provider is the name of the external health provider to invoke. Health
+	// providers are registered with the Stage controller by an operator.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Provider string `json:"provider"`
+	// Parameters are optional, provider-specific parameters that are passed to
+	// the health provider along with the Stage and its current Freight.
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// HealthDampingPolicy describes what must be true before a Stage that has been
+// observed to be Unhealthy is reported as Healthy again. If both of its fields
+// are specified, both must be satisfied.
+type HealthDampingPolicy struct {
+	// ConsecutiveChecks optionally specifies how many consecutive assessments of
+	// the Stage's health must find it to be Healthy.
+	//
+	//+kubebuilder:validation:Minimum=1
+	ConsecutiveChecks int32 `json:"consecutiveChecks,omitempty"`
+	// For optionally specifies how long the Stage must continuously have been
+	// observed to be Healthy. e.g. "5m".
+	For *metav1.Duration `json:"for,omitempty"`
+}
+
+// Satisfied returns true if the provided HealthRecovery satisfies the
+// HealthDampingPolicy as of the provided time. A nil HealthDampingPolicy is
+// always satisfied.
+func (h *HealthDampingPolicy) Satisfied(recovery HealthRecovery, now time.Time) bool {
+	if h == nil {
+		return true
+	}
+	if recovery.Checks < h.ConsecutiveChecks {
+		return false
+	}
+	return h.For == nil || now.Sub(recovery.Since.Time) >= h.For.Duration
+}
+
+// QualificationPolicy describes what must be true of a Stage's current Freight
+// before that Freight is qualified for the Stage.
+type QualificationPolicy struct {
+	// Operator specifies whether all (AllOf) or any (AnyOf) of the Criteria must
+	// be satisfied. If unspecified, all of the Criteria must be satisfied.
+	Operator QualificationOperator `json:"operator,omitempty"`
+	// Criteria is a list of conditions for qualifying Freight. Each criterion
+	// must specify exactly one condition.
+	//
+	//+kubebuilder:validation:MinItems=1
+	Criteria []QualificationCriterion `json:"criteria"`
+}
+
+// QualificationCriterion describes a single condition for qualifying Freight
+// for a Stage. Exactly one of its fields should be specified.
+type QualificationCriterion struct {
+	// Healthy, if specified, is satisfied once the Stage has continuously been
+	// Healthy with the Freight for at least the duration specified by its For
+	// field. A Stage whose health is not applicable (i.e. it has no Argo CD
+	// Applications) always satisfies this criterion.
+	Healthy *HealthyCriterion `json:"healthy,omitempty"`
+	// ManualApproval, if true, is satisfied once the Freight has been manually
+	// approved for the Stage.
+	ManualApproval bool `json:"manualApproval,omitempty"`
+	// Verification, if specified, is satisfied once an external verification
+	// provider has reported that the Freight passed its checks in the Stage.
+	Verification *VerificationCriterion `json:"verification,omitempty"`
+}
+
+// HealthyCriterion describes a Healthy QualificationCriterion.
+type HealthyCriterion struct {
+	// For optionally specifies how long the Stage must continuously have been
+	// Healthy with the Freight. e.g. "10m". If unspecified, the Stage need only
+	// be Healthy.
+	For *metav1.Duration `json:"for,omitempty"`
+}
+
+// VerificationCriterion describes a Verification QualificationCriterion.
+type VerificationCriterion struct {
+	// Provider is the name of the external verification provider to invoke.
+	// Verification providers are registered with the Stage controller by an
+	// operator.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Provider string `json:"provider"`
+	// Parameters are optional, provider-specific parameters that are passed to
+	// the verification provider along with the Stage and its current Freight.
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// DefaultQualificationPolicy is the QualificationPolicy that applies to any
+// Stage that does not specify its own.
+var DefaultQualificationPolicy = QualificationPolicy{
+	Criteria: []QualificationCriterion{
+		{
+			Healthy: &HealthyCriterion{},
+		},
+	},
+}
+
+// Evaluate determines whether the provided observations of a Stage and its
+// current Freight satisfy the QualificationPolicy. A nil QualificationPolicy
+// is evaluated as the DefaultQualificationPolicy. If the policy is not
+// satisfied, a description of each unsatisfied criterion is also returned.
+func (q *QualificationPolicy) Evaluate(
+	health *Health,
+	approved bool,
+	verifications []VerificationResult,
+	now time.Time,
+) (bool, []string) {
+	if q == nil {
+		q = &DefaultQualificationPolicy
+	}
+	unmet := make([]string, 0, len(q.Criteria))
+	for _, criterion := range q.Criteria {
+		if ok, reason :=
+			criterion.evaluate(health, approved, verifications, now); ok {
+			if q.Operator == QualificationOperatorAnyOf {
+				return true, nil
+			}
+		} else {
+			unmet = append(unmet, reason)
+		}
+	}
+	if len(unmet) == 0 {
+		return true, nil
+	}
+	return false, unmet
+}
+
+func (q QualificationCriterion) evaluate(
+	health *Health,
+	approved bool,
+	verifications []VerificationResult,
+	now time.Time,
+) (bool, string) {
+	switch {
+	case q.Healthy != nil:
+		if health == nil {
+			return true, ""
+		}
+		if health.Status != HealthStateHealthy {
+			return false, fmt.Sprintf("Stage is %s", health.Status)
+		}
+		if q.Healthy.For == nil {
+			return true, ""
+		}
+		if health.HealthySince == nil ||
+			now.Sub(health.HealthySince.Time) < q.Healthy.For.Duration {
+			return false, fmt.Sprintf(
+				"Stage has not yet been Healthy for %s",
+				q.Healthy.For.Duration,
+			)
+		}
+		return true, ""
+	case q.ManualApproval:
+		if !approved {
+			return false, "Freight has not been manually approved for the Stage"
+		}
+		return true, ""
+	case q.Verification != nil:
+		for _, result := range verifications {
+			if result.Provider != q.Verification.Provider {
+				continue
+			}
+			if !result.Passed {
+				return false, fmt.Sprintf(
+					"Freight did not pass verification by provider %q: %s",
+					result.Provider,
+					result.Message,
+				)
+			}
+			return true, ""
+		}
+		return false, fmt.Sprintf(
+			"Freight has not yet been verified by provider %q",
+			q.Verification.Provider,
+		)
+	}
+	return false, "criterion specifies no condition"
+}
+
+// HasManualApprovalCriterion returns true if any of the QualificationPolicy's
+// criteria is a ManualApproval criterion.
+func (q *QualificationPolicy) HasManualApprovalCriterion() bool {
+	if q == nil {
+		return false
+	}
+	for _, criterion := range q.Criteria {
+		if criterion.ManualApproval {
+			return true
+		}
+	}
+	return false
+}
+
+// VerificationCriteria returns all of the QualificationPolicy's Verification
+// criteria.
+func (q *QualificationPolicy) VerificationCriteria() []VerificationCriterion {
+	if q == nil {
+		return nil
+	}
+	var criteria []VerificationCriterion
+	for _, criterion := range q.Criteria {
+		if criterion.Verification != nil {
+			criteria = append(criteria, *criterion.Verification)
+		}
+	}
+	return criteria
 }
 
 // Subscriptions describes a Stage's sources of Freight.
@@ -166,6 +451,55 @@ type GitRepoUpdate struct {
 	// Helm describes how to use Helm to incorporate Freight into the Stage. This
 	// is mutually exclusive with the Render and Kustomize fields.
 	Helm *HelmPromotionMechanism `json:"helm,omitempty"`
+	// ConflictResolution describes how to proceed when changes cannot be pushed
+	// to the WriteBranch because it has been updated concurrently. When not
+	// specified, such Promotions simply fail. This is not applicable to updates
+	// that use Kargo Render, which performs its own Git operations.
+	ConflictResolution *GitConflictResolution `json:"conflictResolution,omitempty"`
+}
+
+// GitConflictStrategy is a strategy for resolving a rejected push to a Git
+// repository.
+type GitConflictStrategy string
+
+const (
+	// GitConflictStrategyFail fails the Promotion as soon as a push is
+	// rejected.
+	GitConflictStrategyFail GitConflictStrategy = "Fail"
+	// GitConflictStrategyRebaseAndRetry rebases Kargo's changes onto the updated
+	// remote branch and retries the push. If rebasing produces any conflicts,
+	// the Promotion fails.
+	GitConflictStrategyRebaseAndRetry GitConflictStrategy = "RebaseAndRetry"
+	// GitConflictStrategyOursOnPath behaves like
+	// GitConflictStrategyRebaseAndRetry, except that conflicts within certain
+	// paths are resolved in favor of Kargo's changes. Conflicts anywhere else
+	// still cause the Promotion to fail.
+	GitConflictStrategyOursOnPath GitConflictStrategy = "OursOnPath"
+)
+
+// GitConflictResolution describes how to proceed when changes cannot be pushed
+// to a Git repository because the remote branch has been updated concurrently.
+type GitConflictResolution struct {
+	// Strategy is the strategy to apply when a push is rejected. Valid values
+	// are Fail, RebaseAndRetry, and OursOnPath. The default is Fail.
+	//
+	//+kubebuilder:validation:Enum=Fail;RebaseAndRetry;OursOnPath
+	//+kubebuilder:default=Fail
+	Strategy GitConflictStrategy `json:"strategy,omitempty"`
+	// Paths specifies paths, relative to the root of the repository, within
+	// which conflicts are resolved in favor of Kargo's changes. A path matches
+	// a conflicting file if it is the file itself or any of the directories that
+	// contain it. This field is required when Strategy is OursOnPath and is
+	// ignored otherwise.
+	Paths []string `json:"paths,omitempty"`
+	// MaxAttempts is the maximum number of times a push will be attempted
+	// before the Promotion fails. This field is ignored when Strategy is Fail.
+	// The default is 3.
+	//
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=10
+	//+kubebuilder:default=3
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
 }
 
 // KargoRenderPromotionMechanism describes how to use Kargo Render to
@@ -274,6 +608,60 @@ type ArgoCDAppUpdate struct {
 	// SourceUpdates describes updates to be applied to various sources of the
 	// specified Argo CD Application resource.
 	SourceUpdates []ArgoCDSourceUpdate `json:"sourceUpdates,omitempty"`
+	// HealthOverrides optionally adjusts how the health of specific resources
+	// managed by the specified Argo CD Application resource is interpreted when
+	// assessing the health of the Stage. This is useful when Argo CD's health
+	// rules misreport the health of some resources, such as the custom
+	// resources of certain operators. Only the first override matching a
+	// resource applies to it. Any health customizations configured in Argo CD
+	// itself are already reflected in the health Argo CD reports for each
+	// resource, so they are always honored.
+	HealthOverrides []ArgoCDResourceHealthOverride `json:"healthOverrides,omitempty"`
+}
+
+// ArgoCDResourceHealthOverride describes how the health of matching resources
+// managed by an Argo CD Application should be interpreted.
+type ArgoCDResourceHealthOverride struct {
+	// Group is the API group of matching resources. It is left empty for
+	// resources in the core API group.
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of matching resources.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+	// Name optionally limits the override to resources with this name.
+	Name string `json:"name,omitempty"`
+	// When optionally limits the override to resources whose health, as
+	// assessed by Argo CD, is one of the listed states. If unspecified, the
+	// override applies regardless of the health Argo CD reports.
+	When []ArgoCDAppHealthState `json:"when,omitempty"`
+	// Health is the health state that matching resources are deemed to be in.
+	//
+	//+kubebuilder:validation:Enum={Healthy,Progressing,Suspended,Degraded,Missing,Unknown}
+	Health ArgoCDAppHealthState `json:"health"`
+}
+
+// Matches returns true if the override applies to the resource with the
+// provided group, kind, and name, with the provided health as assessed by
+// Argo CD.
+func (a *ArgoCDResourceHealthOverride) Matches(
+	group string,
+	kind string,
+	name string,
+	health ArgoCDAppHealthState,
+) bool {
+	if a.Group != group || a.Kind != kind || (a.Name != "" && a.Name != name) {
+		return false
+	}
+	if len(a.When) == 0 {
+		return true
+	}
+	for _, state := range a.When {
+		if state == health {
+			return true
+		}
+	}
+	return false
 }
 
 func (a *ArgoCDAppUpdate) AppNamespaceOrDefault() string {
@@ -376,6 +764,55 @@ type StageStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// CurrentPromotion is a reference to the currently Running promotion.
 	CurrentPromotion *PromotionInfo `json:"currentPromotion,omitempty"`
+	// Conditions contains the last observations of the Stage's current state.
+	//
+	//+patchMergeKey=type
+	//+patchStrategy=merge
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchMergeKey:"type" patchStrategy:"merge"`
+	// Verifications contains the results of the most recent verification of the
+	// Stage's current Freight by each of the external verification providers
+	// referenced by the Stage's QualificationPolicy.
+	Verifications []VerificationResult `json:"verifications,omitempty"`
+	// AutoPromotionHold describes the holding back of auto-promotion of the
+	// Stage in accordance with its PromotionPolicy's AutoPromotionHoldback. This
+	// field is only populated while auto-promotion is being held back.
+	AutoPromotionHold *AutoPromotionHold `json:"autoPromotionHold,omitempty"`
+}
+
+// AutoPromotionHold describes the holding back of auto-promotion of a Stage.
+type AutoPromotionHold struct {
+	// Since is the time at which Freight newer than the Stage's current Freight
+	// was first found to be available to the Stage.
+	Since metav1.Time `json:"since"`
+	// Until is the time after which the newest Freight available to the Stage
+	// may be auto-promoted.
+	Until metav1.Time `json:"until"`
+}
+
+// VerificationResult describes the outcome of the verification of a piece of
+// Freight in a Stage by an external verification provider.
+type VerificationResult struct {
+	// Provider is the name of the verification provider.
+	Provider string `json:"provider"`
+	// Freight is the ID of the Freight that was verified.
+	Freight string `json:"freight"`
+	// Passed indicates whether the Freight passed the provider's checks.
+	Passed bool `json:"passed"`
+	// Message is a human-readable summary of the outcome of the verification.
+	Message string `json:"message,omitempty"`
+	// Details are provider-specific, structured details of the outcome of the
+	// verification.
+	Details map[string]string `json:"details,omitempty"`
+	// ArtifactURLs maps the names of any artifacts reported by the provider,
+	// such as test reports, to the URLs at which they are stored. Artifacts are
+	// only stored when the Stage controller has been configured with an
+	// artifact store.
+	ArtifactURLs map[string]string `json:"artifactURLs,omitempty"`
+	// VerifiedAt is the time at which the verification completed. It is not
+	// updated when a retried verification has the same outcome as before.
+	VerifiedAt *metav1.Time `json:"verifiedAt,omitempty"`
 }
 
 // SimpleFreight is a simplified representation of a piece of Freight -- not a
@@ -391,6 +828,14 @@ type SimpleFreight struct {
 	Images []Image `json:"images,omitempty"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty"`
+	// Superseded is only ever set on entries in a Stage's history. It indicates
+	// that the Freight was never promoted to the Stage because it was
+	// superseded by newer Freight while auto-Promotions were coalesced.
+	Superseded bool `json:"superseded,omitempty"`
+	// Project is only set if the Freight belongs to a Project other than that
+	// of the Stage. Such Freight is never qualified for the Stage, so it does
+	// not flow to any Stages downstream from it.
+	Project string `json:"project,omitempty"`
 }
 
 type SimpleFreightStack []SimpleFreight
@@ -485,6 +930,42 @@ type Health struct {
 	Issues []string `json:"issues,omitempty"`
 	// ArgoCDApps describes the current state of any related ArgoCD Applications.
 	ArgoCDApps []ArgoCDAppStatus `json:"argoCDApps,omitempty"`
+	// Providers describes the health of the Stage as most recently reported by
+	// each of the external health providers referenced by the Stage's
+	// HealthChecks.
+	Providers []HealthProviderStatus `json:"providers,omitempty"`
+	// HealthySince is the time since which the Stage has continuously been
+	// observed to be Healthy with its current Freight. This field is only
+	// populated when the Stage is Healthy.
+	HealthySince *metav1.Time `json:"healthySince,omitempty"`
+	// Recovery describes the progress of a Stage that was Unhealthy, and has
+	// since been observed to be Healthy, toward satisfying its HealthDamping
+	// policy. This field is only populated while the Stage continues to be
+	// reported as Unhealthy for that reason.
+	Recovery *HealthRecovery `json:"recovery,omitempty"`
+}
+
+// HealthRecovery describes consecutive observations of a Stage being Healthy
+// after it was observed to be Unhealthy.
+type HealthRecovery struct {
+	// Since is the time of the first of the consecutive observations of the
+	// Stage being Healthy.
+	Since metav1.Time `json:"since"`
+	// Checks is the number of consecutive observations of the Stage being
+	// Healthy.
+	Checks int32 `json:"checks"`
+}
+
+// HealthProviderStatus describes the health of a Stage as reported by a single
+// external health provider.
+type HealthProviderStatus struct {
+	// Provider is the name of the health provider.
+	Provider string `json:"provider"`
+	// Status describes the health of the Stage as reported by the provider.
+	Status HealthState `json:"status,omitempty"`
+	// Issues clarifies why the provider reported the Stage to be in any state
+	// other than Healthy.
+	Issues []string `json:"issues,omitempty"`
 }
 
 // ArgoCDAppStatus describes the current state of a single ArgoCD Application.
@@ -493,6 +974,10 @@ type ArgoCDAppStatus struct {
 	Namespace string `json:"namespace"`
 	// Name is the name of the ArgoCD Application.
 	Name string `json:"name"`
+	// Cluster identifies the cluster to which the ArgoCD Application deploys.
+	// This is the name of the Application's destination if it has one and the
+	// URL of its destination server otherwise.
+	Cluster string `json:"cluster,omitempty"`
 	// HealthStatus is the health of the ArgoCD Application.
 	HealthStatus ArgoCDAppHealthStatus `json:"healthStatus,omitempty"`
 	// SyncStatus is the sync status of the ArgoCD Application.
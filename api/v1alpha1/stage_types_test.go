@@ -2,8 +2,10 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestSimpleFreightStackEmpty(t *testing.T) {
@@ -149,3 +151,341 @@ func TestSimpleFreightStackPush(t *testing.T) {
 		})
 	}
 }
+
+func TestQualificationPolicyEvaluate(t *testing.T) {
+	testNow := time.Now()
+	healthy := &Health{
+		Status:       HealthStateHealthy,
+		HealthySince: &metav1.Time{Time: testNow.Add(-5 * time.Minute)},
+	}
+	healthyForTenMinutes := QualificationCriterion{
+		Healthy: &HealthyCriterion{
+			For: &metav1.Duration{Duration: 10 * time.Minute},
+		},
+	}
+	manualApproval := QualificationCriterion{
+		ManualApproval: true,
+	}
+	verification := QualificationCriterion{
+		Verification: &VerificationCriterion{
+			Provider: "fake-provider",
+		},
+	}
+	testCases := []struct {
+		name          string
+		policy        *QualificationPolicy
+		health        *Health
+		approved      bool
+		verifications []VerificationResult
+		assertions    func(bool, []string)
+	}{
+		{
+			name:   "default policy; health not applicable",
+			policy: nil,
+			assertions: func(qualified bool, unmet []string) {
+				require.True(t, qualified)
+				require.Empty(t, unmet)
+			},
+		},
+		{
+			name:   "default policy; Stage is Progressing",
+			policy: nil,
+			health: &Health{
+				Status: HealthStateProgressing,
+			},
+			assertions: func(qualified bool, unmet []string) {
+				require.False(t, qualified)
+				require.Equal(t, []string{"Stage is Progressing"}, unmet)
+			},
+		},
+		{
+			name:   "default policy; Stage is Healthy",
+			policy: nil,
+			health: healthy,
+			assertions: func(qualified bool, unmet []string) {
+				require.True(t, qualified)
+				require.Empty(t, unmet)
+			},
+		},
+		{
+			name: "Stage has not been Healthy for long enough",
+			policy: &QualificationPolicy{
+				Criteria: []QualificationCriterion{healthyForTenMinutes},
+			},
+			health: healthy,
+			assertions: func(qualified bool, unmet []string) {
+				require.False(t, qualified)
+				require.Len(t, unmet, 1)
+				require.Contains(t, unmet[0], "not yet been Healthy for 10m0s")
+			},
+		},
+		{
+			name: "all of; one criterion unmet",
+			policy: &QualificationPolicy{
+				Operator: QualificationOperatorAllOf,
+				Criteria: []QualificationCriterion{
+					{
+						Healthy: &HealthyCriterion{},
+					},
+					manualApproval,
+				},
+			},
+			health: healthy,
+			assertions: func(qualified bool, unmet []string) {
+				require.False(t, qualified)
+				require.Len(t, unmet, 1)
+				require.Contains(t, unmet[0], "not been manually approved")
+			},
+		},
+		{
+			name: "Freight not yet verified",
+			policy: &QualificationPolicy{
+				Criteria: []QualificationCriterion{verification},
+			},
+			verifications: []VerificationResult{
+				{
+					Provider: "another-fake-provider",
+					Passed:   true,
+				},
+			},
+			assertions: func(qualified bool, unmet []string) {
+				require.False(t, qualified)
+				require.Equal(
+					t,
+					[]string{`Freight has not yet been verified by provider "fake-provider"`},
+					unmet,
+				)
+			},
+		},
+		{
+			name: "Freight failed verification",
+			policy: &QualificationPolicy{
+				Criteria: []QualificationCriterion{verification},
+			},
+			verifications: []VerificationResult{
+				{
+					Provider: "fake-provider",
+					Message:  "something went wrong",
+				},
+			},
+			assertions: func(qualified bool, unmet []string) {
+				require.False(t, qualified)
+				require.Len(t, unmet, 1)
+				require.Contains(t, unmet[0], `did not pass verification by provider "fake-provider"`)
+				require.Contains(t, unmet[0], "something went wrong")
+			},
+		},
+		{
+			name: "Freight passed verification",
+			policy: &QualificationPolicy{
+				Criteria: []QualificationCriterion{verification},
+			},
+			verifications: []VerificationResult{
+				{
+					Provider: "fake-provider",
+					Passed:   true,
+				},
+			},
+			assertions: func(qualified bool, unmet []string) {
+				require.True(t, qualified)
+				require.Empty(t, unmet)
+			},
+		},
+		{
+			name: "all of; all criteria met",
+			policy: &QualificationPolicy{
+				Criteria: []QualificationCriterion{
+					{
+						Healthy: &HealthyCriterion{},
+					},
+					manualApproval,
+				},
+			},
+			health:   healthy,
+			approved: true,
+			assertions: func(qualified bool, unmet []string) {
+				require.True(t, qualified)
+				require.Empty(t, unmet)
+			},
+		},
+		{
+			name: "any of; one criterion met",
+			policy: &QualificationPolicy{
+				Operator: QualificationOperatorAnyOf,
+				Criteria: []QualificationCriterion{
+					healthyForTenMinutes,
+					manualApproval,
+				},
+			},
+			health:   healthy,
+			approved: true,
+			assertions: func(qualified bool, unmet []string) {
+				require.True(t, qualified)
+				require.Empty(t, unmet)
+			},
+		},
+		{
+			name: "any of; no criteria met",
+			policy: &QualificationPolicy{
+				Operator: QualificationOperatorAnyOf,
+				Criteria: []QualificationCriterion{
+					healthyForTenMinutes,
+					manualApproval,
+				},
+			},
+			health: healthy,
+			assertions: func(qualified bool, unmet []string) {
+				require.False(t, qualified)
+				require.Len(t, unmet, 2)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.policy.Evaluate(
+					testCase.health,
+					testCase.approved,
+					testCase.verifications,
+					testNow,
+				),
+			)
+		})
+	}
+}
+
+func TestHealthDampingPolicySatisfied(t *testing.T) {
+	testNow := time.Now()
+	recovery := HealthRecovery{
+		Since:  metav1.NewTime(testNow.Add(-2 * time.Minute)),
+		Checks: 3,
+	}
+	testCases := []struct {
+		name     string
+		policy   *HealthDampingPolicy
+		expected bool
+	}{
+		{
+			name:     "nil policy",
+			expected: true,
+		},
+		{
+			name:     "too few consecutive checks",
+			policy:   &HealthDampingPolicy{ConsecutiveChecks: 4},
+			expected: false,
+		},
+		{
+			name:     "enough consecutive checks",
+			policy:   &HealthDampingPolicy{ConsecutiveChecks: 3},
+			expected: true,
+		},
+		{
+			name: "not healthy for long enough",
+			policy: &HealthDampingPolicy{
+				For: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			expected: false,
+		},
+		{
+			name: "healthy for long enough",
+			policy: &HealthDampingPolicy{
+				For: &metav1.Duration{Duration: time.Minute},
+			},
+			expected: true,
+		},
+		{
+			name: "enough consecutive checks; not healthy for long enough",
+			policy: &HealthDampingPolicy{
+				ConsecutiveChecks: 2,
+				For:               &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.policy.Satisfied(recovery, testNow),
+			)
+		})
+	}
+}
+
+func TestArgoCDResourceHealthOverrideMatches(t *testing.T) {
+	testCases := []struct {
+		name     string
+		override ArgoCDResourceHealthOverride
+		expected bool
+	}{
+		{
+			name: "kind does not match",
+			override: ArgoCDResourceHealthOverride{
+				Group: "example.com",
+				Kind:  "Gadget",
+			},
+			expected: false,
+		},
+		{
+			name: "group does not match",
+			override: ArgoCDResourceHealthOverride{
+				Kind: "Widget",
+			},
+			expected: false,
+		},
+		{
+			name: "name does not match",
+			override: ArgoCDResourceHealthOverride{
+				Group: "example.com",
+				Kind:  "Widget",
+				Name:  "other-widget",
+			},
+			expected: false,
+		},
+		{
+			name: "health does not match",
+			override: ArgoCDResourceHealthOverride{
+				Group: "example.com",
+				Kind:  "Widget",
+				When:  []ArgoCDAppHealthState{ArgoCDAppHealthStateMissing},
+			},
+			expected: false,
+		},
+		{
+			name: "matches any name and health",
+			override: ArgoCDResourceHealthOverride{
+				Group: "example.com",
+				Kind:  "Widget",
+			},
+			expected: true,
+		},
+		{
+			name: "matches name and health",
+			override: ArgoCDResourceHealthOverride{
+				Group: "example.com",
+				Kind:  "Widget",
+				Name:  "fake-widget",
+				When: []ArgoCDAppHealthState{
+					ArgoCDAppHealthStateMissing,
+					ArgoCDAppHealthStateDegraded,
+				},
+			},
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.override.Matches(
+					"example.com",
+					"Widget",
+					"fake-widget",
+					ArgoCDAppHealthStateDegraded,
+				),
+			)
+		})
+	}
+}
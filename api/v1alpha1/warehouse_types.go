@@ -39,6 +39,11 @@ type WarehouseSpec struct {
 	//
 	//+kubebuilder:validation:MinItems=1
 	Subscriptions []RepoSubscription `json:"subscriptions"`
+	// ReconcileInterval optionally specifies how often the Warehouse polls its
+	// subscriptions for new artifacts. It must not be less than 30s. When
+	// unspecified, the Warehouse only polls its subscriptions when it is
+	// modified or refreshed, or when the controller periodically resyncs.
+	ReconcileInterval *metav1.Duration `json:"reconcileInterval,omitempty"`
 }
 
 // RepoSubscription describes a subscription to ONE OF a Git repository, a
@@ -159,6 +164,41 @@ type WarehouseStatus struct {
 	// ObservedGeneration represents the .metadata.generation that this Warehouse
 	// was reconciled against.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// BuildInfo describes how artifacts in repositories this Warehouse
+	// subscribes to were built, as reported by CI pipelines. The Warehouse
+	// controller merges each entry onto any Freight that carries the artifact
+	// it describes.
+	BuildInfo []BuildInfo `json:"buildInfo,omitempty"`
+	// Rejections describes, for each image or chart subscription, versions
+	// that were discovered in the subscribed repository during the most recent
+	// discovery, but were rejected by the subscription's constraints. This is
+	// useful for understanding why an expected version never produced Freight.
+	Rejections []SubscriptionRejections `json:"rejections,omitempty"`
+}
+
+// SubscriptionRejections describes versions discovered in the repository of a
+// single subscription that were rejected by the subscription's constraints.
+type SubscriptionRejections struct {
+	// RepoURL is the URL of the image repository or chart registry subscribed
+	// to.
+	RepoURL string `json:"repoURL"`
+	// Chart is the name of the chart subscribed to. It is only set for chart
+	// subscriptions.
+	Chart string `json:"chart,omitempty"`
+	// Versions lists the most recent rejected versions, newest first.
+	Versions []RejectedVersion `json:"versions,omitempty"`
+	// Omitted is the number of additional rejected versions that are not
+	// listed in Versions.
+	Omitted int32 `json:"omitted,omitempty"`
+}
+
+// RejectedVersion describes a single version of an artifact that was rejected
+// by the constraints of a subscription.
+type RejectedVersion struct {
+	// Version is the rejected image tag or chart version.
+	Version string `json:"version"`
+	// Reason describes which constraint rejected the version.
+	Reason string `json:"reason"`
 }
 
 //+kubebuilder:object:root=true
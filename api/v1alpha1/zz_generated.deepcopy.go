@@ -5,9 +5,29 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Approval) DeepCopyInto(out *Approval) {
+	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approval.
+func (in *Approval) DeepCopy() *Approval {
+	if in == nil {
+		return nil
+	}
+	out := new(Approval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDAppHealthStatus) DeepCopyInto(out *ArgoCDAppHealthStatus) {
 	*out = *in
@@ -23,6 +43,21 @@ func (in *ArgoCDAppHealthStatus) DeepCopy() *ArgoCDAppHealthStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDAppReference) DeepCopyInto(out *ArgoCDAppReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDAppReference.
+func (in *ArgoCDAppReference) DeepCopy() *ArgoCDAppReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDAppReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDAppStatus) DeepCopyInto(out *ArgoCDAppStatus) {
 	*out = *in
@@ -70,6 +105,13 @@ func (in *ArgoCDAppUpdate) DeepCopyInto(out *ArgoCDAppUpdate) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.HealthOverrides != nil {
+		in, out := &in.HealthOverrides, &out.HealthOverrides
+		*out = make([]ArgoCDResourceHealthOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDAppUpdate.
@@ -137,6 +179,26 @@ func (in *ArgoCDKustomize) DeepCopy() *ArgoCDKustomize {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDResourceHealthOverride) DeepCopyInto(out *ArgoCDResourceHealthOverride) {
+	*out = *in
+	if in.When != nil {
+		in, out := &in.When, &out.When
+		*out = make([]ArgoCDAppHealthState, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDResourceHealthOverride.
+func (in *ArgoCDResourceHealthOverride) DeepCopy() *ArgoCDResourceHealthOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDResourceHealthOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDSourceUpdate) DeepCopyInto(out *ArgoCDSourceUpdate) {
 	*out = *in
@@ -162,6 +224,62 @@ func (in *ArgoCDSourceUpdate) DeepCopy() *ArgoCDSourceUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttestationSignature) DeepCopyInto(out *AttestationSignature) {
+	*out = *in
+	if in.Sig != nil {
+		in, out := &in.Sig, &out.Sig
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttestationSignature.
+func (in *AttestationSignature) DeepCopy() *AttestationSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(AttestationSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoPromotionHold) DeepCopyInto(out *AutoPromotionHold) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	in.Until.DeepCopyInto(&out.Until)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoPromotionHold.
+func (in *AutoPromotionHold) DeepCopy() *AutoPromotionHold {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoPromotionHold)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildInfo) DeepCopyInto(out *BuildInfo) {
+	*out = *in
+	if in.ReportedAt != nil {
+		in, out := &in.ReportedAt, &out.ReportedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildInfo.
+func (in *BuildInfo) DeepCopy() *BuildInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chart) DeepCopyInto(out *Chart) {
 	*out = *in
@@ -192,6 +310,167 @@ func (in *ChartSubscription) DeepCopy() *ChartSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cluster) DeepCopyInto(out *Cluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(ClusterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
+func (in *Cluster) DeepCopy() *Cluster {
+	if in == nil {
+		return nil
+	}
+	out := new(Cluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Cluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuth) DeepCopyInto(out *ClusterAuth) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ClusterExecAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuth.
+func (in *ClusterAuth) DeepCopy() *ClusterAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExecAuth) DeepCopyInto(out *ClusterExecAuth) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]ClusterExecEnvVar, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExecAuth.
+func (in *ClusterExecAuth) DeepCopy() *ClusterExecAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExecAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExecEnvVar) DeepCopyInto(out *ClusterExecEnvVar) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExecEnvVar.
+func (in *ClusterExecEnvVar) DeepCopy() *ClusterExecEnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExecEnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
+func (in *ClusterList) DeepCopy() *ClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
+func (in *ClusterSpec) DeepCopy() *ClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
+func (in *ClusterStatus) DeepCopy() *ClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Freight) DeepCopyInto(out *Freight) {
 	*out = *in
@@ -275,6 +554,20 @@ func (in *FreightStatus) DeepCopyInto(out *FreightStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make(map[string]Approval, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.BuildInfo != nil {
+		in, out := &in.BuildInfo, &out.BuildInfo
+		*out = make([]BuildInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightStatus.
@@ -302,6 +595,46 @@ func (in *GitCommit) DeepCopy() *GitCommit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitConflictResolution) DeepCopyInto(out *GitConflictResolution) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitConflictResolution.
+func (in *GitConflictResolution) DeepCopy() *GitConflictResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(GitConflictResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitPushAttempt) DeepCopyInto(out *GitPushAttempt) {
+	*out = *in
+	if in.ResolvedPaths != nil {
+		in, out := &in.ResolvedPaths, &out.ResolvedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitPushAttempt.
+func (in *GitPushAttempt) DeepCopy() *GitPushAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(GitPushAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitRepoUpdate) DeepCopyInto(out *GitRepoUpdate) {
 	*out = *in
@@ -320,6 +653,11 @@ func (in *GitRepoUpdate) DeepCopyInto(out *GitRepoUpdate) {
 		*out = new(HelmPromotionMechanism)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ConflictResolution != nil {
+		in, out := &in.ConflictResolution, &out.ConflictResolution
+		*out = new(GitConflictResolution)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepoUpdate.
@@ -362,6 +700,22 @@ func (in *Health) DeepCopyInto(out *Health) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]HealthProviderStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HealthySince != nil {
+		in, out := &in.HealthySince, &out.HealthySince
+		*out = (*in).DeepCopy()
+	}
+	if in.Recovery != nil {
+		in, out := &in.Recovery, &out.Recovery
+		*out = new(HealthRecovery)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Health.
@@ -374,6 +728,104 @@ func (in *Health) DeepCopy() *Health {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
+func (in *HealthCheck) DeepCopy() *HealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthDampingPolicy) DeepCopyInto(out *HealthDampingPolicy) {
+	*out = *in
+	if in.For != nil {
+		in, out := &in.For, &out.For
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthDampingPolicy.
+func (in *HealthDampingPolicy) DeepCopy() *HealthDampingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthDampingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthProviderStatus) DeepCopyInto(out *HealthProviderStatus) {
+	*out = *in
+	if in.Issues != nil {
+		in, out := &in.Issues, &out.Issues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthProviderStatus.
+func (in *HealthProviderStatus) DeepCopy() *HealthProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthRecovery) DeepCopyInto(out *HealthRecovery) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthRecovery.
+func (in *HealthRecovery) DeepCopy() *HealthRecovery {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthRecovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthyCriterion) DeepCopyInto(out *HealthyCriterion) {
+	*out = *in
+	if in.For != nil {
+		in, out := &in.For, &out.For
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthyCriterion.
+func (in *HealthyCriterion) DeepCopy() *HealthyCriterion {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthyCriterion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmChartDependencyUpdate) DeepCopyInto(out *HelmChartDependencyUpdate) {
 	*out = *in
@@ -524,7 +976,7 @@ func (in *Promotion) DeepCopyInto(out *Promotion) {
 		*out = new(PromotionSpec)
 		**out = **in
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Promotion.
@@ -545,6 +997,63 @@ func (in *Promotion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionAttestation) DeepCopyInto(out *PromotionAttestation) {
+	*out = *in
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Signatures != nil {
+		in, out := &in.Signatures, &out.Signatures
+		*out = make([]AttestationSignature, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionAttestation.
+func (in *PromotionAttestation) DeepCopy() *PromotionAttestation {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionAttestation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionImpact) DeepCopyInto(out *PromotionImpact) {
+	*out = *in
+	if in.Repos != nil {
+		in, out := &in.Repos, &out.Repos
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArgoCDApps != nil {
+		in, out := &in.ArgoCDApps, &out.ArgoCDApps
+		*out = make([]ArgoCDAppReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionImpact.
+func (in *PromotionImpact) DeepCopy() *PromotionImpact {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionImpact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionInfo) DeepCopyInto(out *PromotionInfo) {
 	*out = *in
@@ -627,6 +1136,16 @@ func (in *PromotionPolicy) DeepCopyInto(out *PromotionPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.AutoPromotionHoldback != nil {
+		in, out := &in.AutoPromotionHoldback, &out.AutoPromotionHoldback
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxFreightAge != nil {
+		in, out := &in.MaxFreightAge, &out.MaxFreightAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPolicy.
@@ -697,6 +1216,23 @@ func (in *PromotionSpec) DeepCopy() *PromotionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 	*out = *in
+	if in.PushAttempts != nil {
+		in, out := &in.PushAttempts, &out.PushAttempts
+		*out = make([]GitPushAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Attestation != nil {
+		in, out := &in.Attestation, &out.Attestation
+		*out = new(PromotionAttestation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Impact != nil {
+		in, out := &in.Impact, &out.Impact
+		*out = new(PromotionImpact)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
@@ -724,6 +1260,231 @@ func (in *Qualification) DeepCopy() *Qualification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QualificationCriterion) DeepCopyInto(out *QualificationCriterion) {
+	*out = *in
+	if in.Healthy != nil {
+		in, out := &in.Healthy, &out.Healthy
+		*out = new(HealthyCriterion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VerificationCriterion)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QualificationCriterion.
+func (in *QualificationCriterion) DeepCopy() *QualificationCriterion {
+	if in == nil {
+		return nil
+	}
+	out := new(QualificationCriterion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QualificationPolicy) DeepCopyInto(out *QualificationPolicy) {
+	*out = *in
+	if in.Criteria != nil {
+		in, out := &in.Criteria, &out.Criteria
+		*out = make([]QualificationCriterion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QualificationPolicy.
+func (in *QualificationPolicy) DeepCopy() *QualificationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QualificationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RejectedVersion) DeepCopyInto(out *RejectedVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RejectedVersion.
+func (in *RejectedVersion) DeepCopy() *RejectedVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(RejectedVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Release) DeepCopyInto(out *Release) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(ReleaseSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Release.
+func (in *Release) DeepCopy() *Release {
+	if in == nil {
+		return nil
+	}
+	out := new(Release)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Release) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseList) DeepCopyInto(out *ReleaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Release, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseList.
+func (in *ReleaseList) DeepCopy() *ReleaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseSpec) DeepCopyInto(out *ReleaseSpec) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]ReleaseStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseSpec.
+func (in *ReleaseSpec) DeepCopy() *ReleaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseStatus) DeepCopyInto(out *ReleaseStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]ReleaseStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseStatus.
+func (in *ReleaseStatus) DeepCopy() *ReleaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseStep) DeepCopyInto(out *ReleaseStep) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Soak != nil {
+		in, out := &in.Soak, &out.Soak
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseStep.
+func (in *ReleaseStep) DeepCopy() *ReleaseStep {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseStepStatus) DeepCopyInto(out *ReleaseStepStatus) {
+	*out = *in
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.VerifiedAt != nil {
+		in, out := &in.VerifiedAt, &out.VerifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseStepStatus.
+func (in *ReleaseStepStatus) DeepCopy() *ReleaseStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 	*out = *in
@@ -868,6 +1629,21 @@ func (in *StageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageOwner) DeepCopyInto(out *StageOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageOwner.
+func (in *StageOwner) DeepCopy() *StageOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(StageOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 	*out = *in
@@ -881,6 +1657,33 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 		*out = new(PromotionMechanisms)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Qualification != nil {
+		in, out := &in.Qualification, &out.Qualification
+		*out = new(QualificationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]HealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HealthDamping != nil {
+		in, out := &in.HealthDamping, &out.HealthDamping
+		*out = new(HealthDampingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = make([]StageOwner, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReconcileInterval != nil {
+		in, out := &in.ReconcileInterval, &out.ReconcileInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -918,6 +1721,25 @@ func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 		*out = new(PromotionInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Verifications != nil {
+		in, out := &in.Verifications, &out.Verifications
+		*out = make([]VerificationResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AutoPromotionHold != nil {
+		in, out := &in.AutoPromotionHold, &out.AutoPromotionHold
+		*out = new(AutoPromotionHold)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageStatus.
@@ -945,6 +1767,26 @@ func (in *StageSubscription) DeepCopy() *StageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionRejections) DeepCopyInto(out *SubscriptionRejections) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]RejectedVersion, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionRejections.
+func (in *SubscriptionRejections) DeepCopy() *SubscriptionRejections {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionRejections)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subscriptions) DeepCopyInto(out *Subscriptions) {
 	*out = *in
@@ -965,6 +1807,61 @@ func (in *Subscriptions) DeepCopy() *Subscriptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationCriterion) DeepCopyInto(out *VerificationCriterion) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationCriterion.
+func (in *VerificationCriterion) DeepCopy() *VerificationCriterion {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationCriterion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationResult) DeepCopyInto(out *VerificationResult) {
+	*out = *in
+	if in.Details != nil {
+		in, out := &in.Details, &out.Details
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArtifactURLs != nil {
+		in, out := &in.ArtifactURLs, &out.ArtifactURLs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VerifiedAt != nil {
+		in, out := &in.VerifiedAt, &out.VerifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationResult.
+func (in *VerificationResult) DeepCopy() *VerificationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Warehouse) DeepCopyInto(out *Warehouse) {
 	*out = *in
@@ -975,7 +1872,7 @@ func (in *Warehouse) DeepCopyInto(out *Warehouse) {
 		*out = new(WarehouseSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Warehouse.
@@ -1038,6 +1935,11 @@ func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ReconcileInterval != nil {
+		in, out := &in.ReconcileInterval, &out.ReconcileInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseSpec.
@@ -1053,6 +1955,20 @@ func (in *WarehouseSpec) DeepCopy() *WarehouseSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WarehouseStatus) DeepCopyInto(out *WarehouseStatus) {
 	*out = *in
+	if in.BuildInfo != nil {
+		in, out := &in.BuildInfo, &out.BuildInfo
+		*out = make([]BuildInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rejections != nil {
+		in, out := &in.Rejections, &out.Rejections
+		*out = make([]SubscriptionRejections, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseStatus.
@@ -12,12 +12,14 @@ import (
 
 func main() {
 	ctx := context.Background()
-	cmd, err := NewRootCommand(option.NewOption(), &rootState{})
+	opt := option.NewOption()
+	cmd, err := NewRootCommand(opt, &rootState{})
 	if err != nil {
 		fmt.Fprintln(os.Stderr, errors.Wrap(err, "new root command"))
 		os.Exit(1)
 	}
 	if err := cmd.ExecuteContext(ctx); err != nil {
+		opt.ErrPrinter().Error(err)
 		os.Exit(1)
 	}
 }
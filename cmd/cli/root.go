@@ -15,14 +15,27 @@ import (
 	"github.com/akuity/kargo/internal/api"
 	apiconfig "github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/cli/admin"
 	"github.com/akuity/kargo/internal/cli/apply"
+	"github.com/akuity/kargo/internal/cli/approve"
+	"github.com/akuity/kargo/internal/cli/archive"
 	"github.com/akuity/kargo/internal/cli/create"
 	"github.com/akuity/kargo/internal/cli/delete"
+	"github.com/akuity/kargo/internal/cli/describe"
+	"github.com/akuity/kargo/internal/cli/diff"
+	"github.com/akuity/kargo/internal/cli/explain"
+	"github.com/akuity/kargo/internal/cli/export"
 	"github.com/akuity/kargo/internal/cli/get"
+	"github.com/akuity/kargo/internal/cli/initialize"
+	"github.com/akuity/kargo/internal/cli/inspect"
 	"github.com/akuity/kargo/internal/cli/login"
+	"github.com/akuity/kargo/internal/cli/metadata"
+	"github.com/akuity/kargo/internal/cli/operation"
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/refresh"
 	"github.com/akuity/kargo/internal/cli/stage"
+	"github.com/akuity/kargo/internal/cli/test"
+	"github.com/akuity/kargo/internal/cli/top"
 )
 
 // rootState holds state used internally by the root command.
@@ -35,6 +48,8 @@ func NewRootCommand(opt *option.Option, rs *rootState) (*cobra.Command, error) {
 		Use:               "kargo",
 		DisableAutoGenTag: true,
 		SilenceUsage:      true,
+		// Errors are printed by main using the shared output package
+		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			ctx := buildRootContext(cmd.Context())
 
@@ -86,15 +101,38 @@ func NewRootCommand(opt *option.Option, rs *rootState) (*cobra.Command, error) {
 	}
 	opt.PrintFlags = genericclioptions.NewPrintFlags("").WithTypeSetter(scheme)
 	option.InsecureTLS(&opt.InsecureTLS)(cmd.PersistentFlags())
+	option.ClientCertificate(&opt.ClientCertificatePath)(cmd.PersistentFlags())
+	option.ClientKey(&opt.ClientKeyPath)(cmd.PersistentFlags())
 	option.LocalServer(&opt.UseLocalServer)(cmd.PersistentFlags())
+	option.Server(&opt.Server)(cmd.PersistentFlags())
+	option.Token(&opt.Token)(cmd.PersistentFlags())
+	option.RequestTimeout(&opt.RequestTimeout)(cmd.PersistentFlags())
+	option.Retries(&opt.Retries)(cmd.PersistentFlags())
+	option.NoColor(&opt.NoColor)(cmd.PersistentFlags())
 
+	cmd.AddCommand(admin.NewCommand(opt))
+	cmd.AddCommand(metadata.NewAnnotateCommand(opt))
 	cmd.AddCommand(apply.NewCommand(opt))
+	cmd.AddCommand(approve.NewCommand(opt))
+	cmd.AddCommand(archive.NewArchiveCommand(opt))
 	cmd.AddCommand(create.NewCommand(opt))
 	cmd.AddCommand(delete.NewCommand(opt))
+	cmd.AddCommand(describe.NewCommand(opt))
+	cmd.AddCommand(diff.NewCommand(opt))
+	cmd.AddCommand(explain.NewFreightCommand(opt))
+	cmd.AddCommand(explain.NewPromotionCommand(opt))
+	cmd.AddCommand(export.NewCommand(opt))
 	cmd.AddCommand(get.NewCommand(opt))
+	cmd.AddCommand(initialize.NewCommand(opt))
+	cmd.AddCommand(inspect.NewCommand(opt))
+	cmd.AddCommand(metadata.NewLabelCommand(opt))
 	cmd.AddCommand(login.NewCommand(opt))
+	cmd.AddCommand(operation.NewCommand(opt))
 	cmd.AddCommand(stage.NewCommand(opt))
 	cmd.AddCommand(refresh.NewCommand(opt))
+	cmd.AddCommand(test.NewCommand(opt))
+	cmd.AddCommand(top.NewCommand(opt))
+	cmd.AddCommand(archive.NewUnarchiveCommand(opt))
 	cmd.AddCommand(newVersionCommand(opt))
 	cmd.AddCommand(
 		cobracompletefig.CreateCompletionSpecCommand(
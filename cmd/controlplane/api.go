@@ -18,6 +18,7 @@ import (
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/os"
+	"github.com/akuity/kargo/internal/types"
 	versionpkg "github.com/akuity/kargo/internal/version"
 )
 
@@ -40,9 +41,19 @@ func newAPICommand() *cobra.Command {
 			if err != nil {
 				return pkgerrors.Wrap(err, "error loading REST config")
 			}
-			kubeClient, err := kubernetes.NewClient(ctx, restCfg, kubernetes.ClientOptions{
+			clientOpts := kubernetes.ClientOptions{
 				NewInternalClient: newClientForAPI,
-			})
+				ReadFromWatchCache: types.MustParseBool(
+					os.GetEnv("KUBERNETES_READ_FROM_WATCH_CACHE", "false"),
+				),
+			}
+			if readHost := os.GetEnv("KUBERNETES_READ_HOST", ""); readHost != "" {
+				clientOpts.ReadRESTConfig = rest.CopyConfig(restCfg)
+				clientOpts.ReadRESTConfig.Host = readHost
+				log.WithField("host", readHost).
+					Info("reads will use a separate Kubernetes API endpoint")
+			}
+			kubeClient, err := kubernetes.NewClient(ctx, restCfg, clientOpts)
 			if err != nil {
 				return pkgerrors.Wrap(err, "error creating Kubernetes client")
 			}
@@ -81,8 +92,9 @@ func newAPICommand() *cobra.Command {
 
 func newClientForAPI(ctx context.Context, r *rest.Config, scheme *runtime.Scheme) (client.Client, error) {
 	mgr, err := ctrl.NewManager(r, ctrl.Options{
-		Scheme:             scheme,
-		MetricsBindAddress: "0",
+		Scheme:                scheme,
+		MetricsBindAddress:    "0",
+		ClientDisableCacheFor: kubernetes.UncachedObjects,
 	})
 	if err != nil {
 		return nil, pkgerrors.Wrap(err, "new manager")
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -11,15 +13,21 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/artifacts"
 	"github.com/akuity/kargo/internal/controller/applications"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/clusters"
 	"github.com/akuity/kargo/internal/controller/promotions"
+	"github.com/akuity/kargo/internal/controller/releases"
+	libRuntime "github.com/akuity/kargo/internal/controller/runtime"
 	"github.com/akuity/kargo/internal/controller/stages"
 	"github.com/akuity/kargo/internal/controller/warehouses"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/metrics"
 	"github.com/akuity/kargo/internal/os"
 	"github.com/akuity/kargo/internal/types"
 	versionpkg "github.com/akuity/kargo/internal/version"
@@ -46,6 +54,22 @@ func newControllerCommand() *cobra.Command {
 			}
 			startupLogEntry.Info("Starting Kargo Controller")
 
+			// Simulation mode fast-forwards time, so that time-based behaviors such
+			// as soak periods and auto-promotion holdbacks can be observed quickly.
+			// It is intended only for testing and demonstration purposes.
+			clock := libRuntime.RealClock
+			if speedStr := os.GetEnv("SIMULATION_SPEED", ""); speedStr != "" {
+				speed, err := strconv.ParseFloat(speedStr, 64)
+				if err != nil || speed <= 0 {
+					return errors.Errorf(
+						"invalid SIMULATION_SPEED %q; must be a positive number",
+						speedStr,
+					)
+				}
+				clock = libRuntime.NewSimulatedClock(time.Now(), speed)
+				log.Warnf("Simulation mode enabled; time passes %gx faster than real time", speed)
+			}
+
 			var kargoMgr manager.Manager
 			{
 				restCfg, err :=
@@ -75,8 +99,10 @@ func newControllerCommand() *cobra.Command {
 				if kargoMgr, err = ctrl.NewManager(
 					restCfg,
 					ctrl.Options{
-						Scheme:             scheme,
-						MetricsBindAddress: "0",
+						Scheme: scheme,
+						// Metrics are registered globally, so serving them from this
+						// manager alone also covers the Argo CD Application manager.
+						MetricsBindAddress: os.GetEnv("METRICS_BIND_ADDRESS", "0"),
 					},
 				); err != nil {
 					return errors.Wrap(err, "error initializing Kargo controller manager")
@@ -145,11 +171,23 @@ func newControllerCommand() *cobra.Command {
 				argoClientForCreds,
 			)
 
+			artifactStore, err := artifacts.NewStore(
+				ctx,
+				artifacts.ConfigFromEnv(),
+				kargoMgr.GetClient(),
+			)
+			if err != nil {
+				return errors.Wrap(err, "error initializing artifact store")
+			}
+
 			if err := stages.SetupReconcilerWithManager(
 				ctx,
 				kargoMgr,
 				appMgr,
+				artifactStore,
 				shardName,
+				stages.ReconcilerConfigFromEnv(),
+				clock,
 			); err != nil {
 				return errors.Wrap(err, "error setting up Stages reconciler")
 			}
@@ -159,11 +197,23 @@ func newControllerCommand() *cobra.Command {
 				kargoMgr,
 				appMgr,
 				credentialsDB,
+				artifactStore,
 				shardName,
+				promotions.ReconcilerConfigFromEnv(),
+				clock,
 			); err != nil {
 				return errors.Wrap(err, "error setting up Promotions reconciler")
 			}
 
+			if err := releases.SetupReconcilerWithManager(
+				ctx,
+				kargoMgr,
+				shardName,
+				clock,
+			); err != nil {
+				return errors.Wrap(err, "error setting up Releases reconciler")
+			}
+
 			if err := applications.SetupReconcilerWithManager(
 				ctx,
 				kargoMgr,
@@ -174,7 +224,7 @@ func newControllerCommand() *cobra.Command {
 			}
 
 			// No shard name == default controller. This is the only controller that
-			// should reconcile Warehouses.
+			// should reconcile Warehouses and Clusters.
 			if shardName == "" {
 				if err := warehouses.SetupReconcilerWithManager(
 					kargoMgr,
@@ -182,6 +232,26 @@ func newControllerCommand() *cobra.Command {
 				); err != nil {
 					return errors.Wrap(err, "error setting up Warehouses reconciler")
 				}
+				if err := clusters.SetupReconcilerWithManager(
+					kargoMgr,
+					clusters.ReconcilerConfigFromEnv(),
+				); err != nil {
+					return errors.Wrap(err, "error setting up Clusters reconciler")
+				}
+			}
+
+			pusher, err := metrics.NewPusher(
+				metrics.PushConfigFromEnv(),
+				"kargo-controller",
+				ctrlmetrics.Registry,
+			)
+			if err != nil {
+				return errors.Wrap(err, "error initializing metrics pusher")
+			}
+			if pusher != nil {
+				if err = kargoMgr.Add(manager.RunnableFunc(pusher.Run)); err != nil {
+					return errors.Wrap(err, "error adding metrics pusher to Kargo controller manager")
+				}
 			}
 
 			var errChan = make(chan error)
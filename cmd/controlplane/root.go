@@ -24,5 +24,6 @@ func Execute(ctx context.Context) error {
 	rootCmd.AddCommand(newGarbageCollectorCommand())
 	rootCmd.AddCommand(newVersionCommand())
 	rootCmd.AddCommand(newWebhooksServerCommand())
+	rootCmd.AddCommand(newWorkloadHealthProviderCommand())
 	return rootCmd.ExecuteContext(ctx)
 }
@@ -14,9 +14,11 @@ import (
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/os"
 	versionpkg "github.com/akuity/kargo/internal/version"
+	"github.com/akuity/kargo/internal/webhook/archive"
 	"github.com/akuity/kargo/internal/webhook/freight"
 	"github.com/akuity/kargo/internal/webhook/promotion"
 	"github.com/akuity/kargo/internal/webhook/promotionpolicy"
+	"github.com/akuity/kargo/internal/webhook/release"
 	"github.com/akuity/kargo/internal/webhook/stage"
 	"github.com/akuity/kargo/internal/webhook/warehouse"
 )
@@ -72,18 +74,27 @@ func newWebhooksServerCommand() *cobra.Command {
 			if err = stage.SetupWebhookWithManager(mgr); err != nil {
 				return errors.Wrap(err, "setup Stage webhook")
 			}
-			if err = promotion.SetupWebhookWithManager(mgr); err != nil {
+			if err = promotion.SetupWebhookWithManager(
+				mgr,
+				promotion.WebhookConfigFromEnv(),
+			); err != nil {
 				return errors.Wrap(err, "setup Promotion webhook")
 			}
 			if err = promotionpolicy.SetupWebhookWithManager(mgr); err != nil {
 				return errors.Wrap(err, "setup PromotionPolicy webhook")
 			}
+			if err = release.SetupWebhookWithManager(mgr); err != nil {
+				return errors.Wrap(err, "setup Release webhook")
+			}
 			if err = freight.SetupWebhookWithManager(mgr); err != nil {
 				return errors.Wrap(err, "setup Freight webhook")
 			}
 			if err = warehouse.SetupWebhookWithManager(mgr); err != nil {
 				return errors.Wrap(err, "setup Warehouse webhook")
 			}
+			if err = archive.SetupWebhookWithManager(mgr); err != nil {
+				return errors.Wrap(err, "setup archive webhook")
+			}
 
 			return errors.Wrap(
 				mgr.Start(ctx),
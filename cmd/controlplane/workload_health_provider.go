@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/os"
+	"github.com/akuity/kargo/internal/types"
+	versionpkg "github.com/akuity/kargo/internal/version"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/health/workloads"
+)
+
+func newWorkloadHealthProviderCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:               "workload-health-provider",
+		DisableAutoGenTag: true,
+		SilenceErrors:     true,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			version := versionpkg.GetVersion()
+			log.WithFields(log.Fields{
+				"version": version.Version,
+				"commit":  version.GitCommit,
+			}).Info("Starting Kargo Workload Health Provider")
+
+			var kubeClient client.Client
+			{
+				restCfg, err :=
+					kubernetes.GetRestConfig(ctx, os.GetEnv("KUBECONFIG", ""))
+				if err != nil {
+					return errors.Wrap(err, "error loading REST config")
+				}
+				scheme := runtime.NewScheme()
+				if err = appsv1.AddToScheme(scheme); err != nil {
+					return errors.Wrap(err, "error adding Kubernetes apps API to scheme")
+				}
+				if err = corev1.AddToScheme(scheme); err != nil {
+					return errors.Wrap(err, "error adding Kubernetes core API to scheme")
+				}
+				if err = kargoapi.AddToScheme(scheme); err != nil {
+					return errors.Wrap(err, "error adding Kargo API to scheme")
+				}
+				if kubeClient, err = client.New(
+					restCfg,
+					client.Options{
+						Scheme: scheme,
+					},
+				); err != nil {
+					return errors.Wrap(err, "error initializing Kubernetes client")
+				}
+			}
+
+			return health.ListenAndServe(
+				fmt.Sprintf(
+					"%s:%s",
+					os.GetEnv("HOST", "0.0.0.0"),
+					os.GetEnv("PORT", "8080"),
+				),
+				workloads.NewProvider(
+					kubeClient,
+					workloads.ProviderOptions{
+						KargoClient: kubeClient,
+						ClusterExecAuthEnabled: types.MustParseBool(
+							os.GetEnv("CLUSTER_EXEC_AUTH_ENABLED", "false"),
+						),
+					},
+				),
+			)
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/kubeclient"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// ApproveFreight records a manual approval of the specified Freight for the
+// specified Stage. Approved Freight may be promoted to that Stage even if it
+// has not qualified for any of the Stage's upstream Stages.
+func (s *server) ApproveFreight(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ApproveFreightRequest],
+) (*connect.Response[svcv1alpha1.ApproveFreightResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetStage()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	if req.Msg.GetId() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("id should not be empty"),
+		)
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	freight, err := s.getFreightFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if freight == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Freight %q not found in namespace %q",
+				req.Msg.GetId(),
+				req.Msg.GetProject(),
+			),
+		)
+	}
+
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetStage(),
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Stage %q not found in namespace %q",
+				req.Msg.GetStage(),
+				req.Msg.GetProject(),
+			),
+		)
+	}
+
+	approval := kargoapi.Approval{
+		Approver:   approverFromContext(ctx),
+		ApprovedAt: &metav1.Time{Time: s.nowFn()},
+	}
+	if err = s.patchFreightStatusFn(
+		ctx,
+		freight,
+		func(status *kargoapi.FreightStatus) {
+			if status.Approvals == nil {
+				status.Approvals = map[string]kargoapi.Approval{}
+			}
+			status.Approvals[stage.Name] = approval
+		},
+	); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.ApproveFreightResponse{}), nil
+}
+
+// approverFromContext returns a name for the user bound to the provided
+// context, suitable for recording in an Approval.
+func approverFromContext(ctx context.Context) string {
+	u, ok := user.InfoFromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if u.IsAdmin {
+		return "admin"
+	}
+	return u.Username
+}
+
+func (s *server) patchFreightStatus(
+	ctx context.Context,
+	freight *kargoapi.Freight,
+	update func(status *kargoapi.FreightStatus),
+) error {
+	return kubeclient.PatchStatus(ctx, s.client, freight, update)
+}
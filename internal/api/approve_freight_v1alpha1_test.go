@@ -0,0 +1,298 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestApproveFreight(t *testing.T) {
+	testNow := time.Now()
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.ApproveFreightRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.ApproveFreightResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.ApproveFreightRequest{},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "missing Freight ID",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+			},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+				require.Equal(t, "id should not be empty", connErr.Message())
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Id:      "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error getting Freight",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Id:      "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInternal, connErr.Code())
+				require.Equal(t, "something went wrong", connErr.Message())
+			},
+		},
+		{
+			name: "Freight not found",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Id:      "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+				require.Contains(t, connErr.Message(), "Freight")
+				require.Contains(t, connErr.Message(), "not found in namespace")
+			},
+		},
+		{
+			name: "Stage not found",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Id:      "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+				require.Contains(t, connErr.Message(), "Stage")
+				require.Contains(t, connErr.Message(), "not found in namespace")
+			},
+		},
+		{
+			name: "error patching Freight status",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Id:      "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				nowFn: time.Now,
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					func(*kargoapi.FreightStatus),
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInternal, connErr.Code())
+				require.Equal(t, "something went wrong", connErr.Message())
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Id:      "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				nowFn: func() time.Time {
+					return testNow
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-stage",
+						},
+					}, nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					update func(*kargoapi.FreightStatus),
+				) error {
+					status := &kargoapi.FreightStatus{}
+					update(status)
+					require.Equal(
+						t,
+						map[string]kargoapi.Approval{
+							"fake-stage": {
+								Approver:   "fake-user",
+								ApprovedAt: &metav1.Time{Time: testNow},
+							},
+						},
+						status.Approvals,
+					)
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.ApproveFreight(
+					user.ContextWithInfo(
+						context.Background(),
+						user.Info{Username: "fake-user"},
+					),
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
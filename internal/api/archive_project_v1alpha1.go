@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// ArchiveProject archives a Project. The resources of an archived Project
+// remain readable, but are no longer reconciled by any controller and cannot
+// be modified until the Project is unarchived.
+func (s *server) ArchiveProject(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ArchiveProjectRequest],
+) (*connect.Response[svcv1alpha1.ArchiveProjectResponse], error) {
+	project, err := s.setProjectArchived(ctx, req.Msg.GetName(), true)
+	if err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	return connect.NewResponse(&svcv1alpha1.ArchiveProjectResponse{
+		Project: project,
+	}), nil
+}
+
+// setProjectArchived adds the archived label to, or removes it from, the
+// namespace of the specified Project. It is a no-op if the Project is already
+// in the requested state.
+func (s *server) setProjectArchived(
+	ctx context.Context,
+	name string,
+	archived bool,
+) (*svcv1alpha1.Project, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name should not be empty"))
+	}
+
+	var ns corev1.Namespace
+	if err := s.client.Get(ctx, client.ObjectKey{Name: name}, &ns); err != nil {
+		if kubeerr.IsNotFound(err) {
+			return nil, connect.NewError(connect.CodeNotFound,
+				errors.Errorf("project %q not found", name))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if ns.GetLabels()[kargoapi.LabelProjectKey] != kargoapi.LabelTrueValue {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			errors.Errorf("namespace %q is not a project", ns.GetName()))
+	}
+	if kargoapi.IsNamespaceArchived(&ns) != archived {
+		patch := client.MergeFrom(ns.DeepCopy())
+		if archived {
+			ns.Labels[kargoapi.LabelArchivedKey] = kargoapi.LabelTrueValue
+		} else {
+			delete(ns.Labels, kargoapi.LabelArchivedKey)
+		}
+		if err := s.client.Patch(ctx, &ns, patch); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return newProjectProto(&ns), nil
+}
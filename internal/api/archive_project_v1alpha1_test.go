@@ -0,0 +1,146 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestArchiveProject(t *testing.T) {
+	newNamespace := func(labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "kargo-demo",
+				Labels: labels,
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		archive    bool
+		objects    []client.Object
+		assertions func(*svcv1alpha1.Project, client.Client, error)
+	}{
+		{
+			name:    "project not found",
+			archive: true,
+			assertions: func(_ *svcv1alpha1.Project, _ client.Client, err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name:    "namespace is not a project",
+			archive: true,
+			objects: []client.Object{newNamespace(nil)},
+			assertions: func(_ *svcv1alpha1.Project, _ client.Client, err error) {
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+			},
+		},
+		{
+			name:    "archive",
+			archive: true,
+			objects: []client.Object{
+				newNamespace(map[string]string{
+					kargoapi.LabelProjectKey: kargoapi.LabelTrueValue,
+				}),
+			},
+			assertions: func(project *svcv1alpha1.Project, c client.Client, err error) {
+				require.NoError(t, err)
+				require.True(t, project.GetArchived())
+				archived, err :=
+					kargoapi.IsProjectArchived(context.Background(), c, "kargo-demo")
+				require.NoError(t, err)
+				require.True(t, archived)
+			},
+		},
+		{
+			name: "unarchive",
+			objects: []client.Object{
+				newNamespace(map[string]string{
+					kargoapi.LabelProjectKey:  kargoapi.LabelTrueValue,
+					kargoapi.LabelArchivedKey: kargoapi.LabelTrueValue,
+				}),
+			},
+			assertions: func(project *svcv1alpha1.Project, c client.Client, err error) {
+				require.NoError(t, err)
+				require.False(t, project.GetArchived())
+				archived, err :=
+					kargoapi.IsProjectArchived(context.Background(), c, "kargo-demo")
+				require.NoError(t, err)
+				require.False(t, archived)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			var internalClient client.Client
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						internalClient = fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(testCase.objects...).
+							Build()
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			svr := &server{
+				client: kubeClient,
+			}
+			var project *svcv1alpha1.Project
+			if testCase.archive {
+				var res *connect.Response[svcv1alpha1.ArchiveProjectResponse]
+				res, err = svr.ArchiveProject(
+					ctx,
+					connect.NewRequest(&svcv1alpha1.ArchiveProjectRequest{
+						Name: "kargo-demo",
+					}),
+				)
+				if err == nil {
+					project = res.Msg.GetProject()
+				}
+			} else {
+				var res *connect.Response[svcv1alpha1.UnarchiveProjectResponse]
+				res, err = svr.UnarchiveProject(
+					ctx,
+					connect.NewRequest(&svcv1alpha1.UnarchiveProjectRequest{
+						Name: "kargo-demo",
+					}),
+				)
+				if err == nil {
+					project = res.Msg.GetProject()
+				}
+			}
+			testCase.assertions(project, internalClient, err)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/kubeclient"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// maxWarehouseBuildInfo is the maximum number of build metadata entries
+// retained by a Warehouse. When this is exceeded, the least recently reported
+// entries are discarded.
+const maxWarehouseBuildInfo = 100
+
+// AttachBuildInfo records build metadata reported by a CI pipeline on every
+// Warehouse in the specified project that subscribes to the repository of the
+// artifact that the metadata describes. Each of those Warehouses subsequently
+// merges the metadata onto any Freight that carries the artifact.
+func (s *server) AttachBuildInfo(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.AttachBuildInfoRequest],
+) (*connect.Response[svcv1alpha1.AttachBuildInfoResponse], error) {
+	if req.Msg.GetProject() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("project should not be empty"),
+		)
+	}
+	if len(req.Msg.GetBuildInfo()) == 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("buildInfo should not be empty"),
+		)
+	}
+	reportedAt := metav1.NewTime(s.nowFn())
+	buildInfo := make([]kargoapi.BuildInfo, len(req.Msg.GetBuildInfo()))
+	for i, b := range req.Msg.GetBuildInfo() {
+		if b.GetRepoUrl() == "" {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.Errorf("buildInfo[%d].repoURL should not be empty", i),
+			)
+		}
+		if b.GetRevision() == "" {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.Errorf("buildInfo[%d].revision should not be empty", i),
+			)
+		}
+		buildInfo[i] = *typesv1alpha1.FromBuildInfoProto(b)
+		buildInfo[i].ReportedAt = &reportedAt
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	var warehouses kargoapi.WarehouseList
+	if err := s.listWarehousesFn(
+		ctx,
+		&warehouses,
+		client.InNamespace(req.Msg.GetProject()),
+	); err != nil {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Wrap(err, "list warehouses"),
+		)
+	}
+
+	res := &svcv1alpha1.AttachBuildInfoResponse{}
+	for i := range warehouses.Items {
+		warehouse := &warehouses.Items[i]
+		var updates []kargoapi.BuildInfo
+		for _, b := range buildInfo {
+			if subscribesTo(warehouse, b.RepoURL) {
+				updates = append(updates, b)
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+		if err := s.patchWarehouseStatusFn(
+			ctx,
+			warehouse,
+			func(status *kargoapi.WarehouseStatus) {
+				merged := kargoapi.MergeBuildInfo(status.BuildInfo, updates...)
+				if len(merged) > maxWarehouseBuildInfo {
+					merged = merged[len(merged)-maxWarehouseBuildInfo:]
+				}
+				status.BuildInfo = merged
+			},
+		); err != nil {
+			return nil, connect.NewError(
+				connect.CodeInternal,
+				errors.Wrapf(err, "patch status of warehouse %q", warehouse.Name),
+			)
+		}
+		// Refreshing the Warehouse prompts it to merge the build metadata onto
+		// Freight right away
+		if _, err := s.refreshWarehouseFn(
+			ctx,
+			s.client,
+			types.NamespacedName{
+				Namespace: warehouse.Namespace,
+				Name:      warehouse.Name,
+			},
+		); err != nil {
+			return nil, connect.NewError(
+				connect.CodeInternal,
+				errors.Wrapf(err, "refresh warehouse %q", warehouse.Name),
+			)
+		}
+		res.Warehouses = append(res.Warehouses, warehouse.Name)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// subscribesTo returns true if the provided Warehouse subscribes to the image
+// repository or Git repository with the provided URL.
+func subscribesTo(warehouse *kargoapi.Warehouse, repoURL string) bool {
+	for _, sub := range warehouse.Spec.Subscriptions {
+		if sub.Image != nil && sub.Image.RepoURL == repoURL {
+			return true
+		}
+		if sub.Git != nil && sub.Git.RepoURL == repoURL {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *server) patchWarehouseStatus(
+	ctx context.Context,
+	warehouse *kargoapi.Warehouse,
+	update func(status *kargoapi.WarehouseStatus),
+) error {
+	return kubeclient.PatchStatus(ctx, s.client, warehouse, update)
+}
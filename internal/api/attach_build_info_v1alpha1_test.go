@@ -0,0 +1,242 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+func TestAttachBuildInfo(t *testing.T) {
+	testNow := time.Now()
+	testBuildInfo := []*v1alpha1.BuildInfo{
+		{
+			RepoUrl:  "fake-image-repo",
+			Revision: "fake-tag",
+		},
+	}
+	listWarehousesFn := func(
+		_ context.Context,
+		objList client.ObjectList,
+		_ ...client.ListOption,
+	) error {
+		warehouses, ok := objList.(*kargoapi.WarehouseList)
+		require.True(t, ok)
+		warehouses.Items = []kargoapi.Warehouse{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "subscribed",
+				},
+				Spec: &kargoapi.WarehouseSpec{
+					Subscriptions: []kargoapi.RepoSubscription{
+						{
+							Image: &kargoapi.ImageSubscription{
+								RepoURL: "fake-image-repo",
+							},
+						},
+					},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "not-subscribed",
+				},
+				Spec: &kargoapi.WarehouseSpec{
+					Subscriptions: []kargoapi.RepoSubscription{
+						{
+							Git: &kargoapi.GitSubscription{
+								RepoURL: "fake-git-repo",
+							},
+						},
+					},
+				},
+			},
+		}
+		return nil
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.AttachBuildInfoRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.AttachBuildInfoResponse], error)
+	}{
+		{
+			name: "missing project",
+			req: &svcv1alpha1.AttachBuildInfoRequest{
+				BuildInfo: testBuildInfo,
+			},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.AttachBuildInfoResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "missing build info",
+			req: &svcv1alpha1.AttachBuildInfoRequest{
+				Project: "fake-project",
+			},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.AttachBuildInfoResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "buildInfo should not be empty")
+			},
+		},
+		{
+			name: "missing revision",
+			req: &svcv1alpha1.AttachBuildInfoRequest{
+				Project: "fake-project",
+				BuildInfo: []*v1alpha1.BuildInfo{
+					{RepoUrl: "fake-image-repo"},
+				},
+			},
+			server: &server{
+				nowFn: func() time.Time { return testNow },
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.AttachBuildInfoResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(
+					t,
+					err.Error(),
+					"buildInfo[0].revision should not be empty",
+				)
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.AttachBuildInfoRequest{
+				Project:   "fake-project",
+				BuildInfo: testBuildInfo,
+			},
+			server: &server{
+				nowFn: func() time.Time { return testNow },
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.AttachBuildInfoResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error patching Warehouse status",
+			req: &svcv1alpha1.AttachBuildInfoRequest{
+				Project:   "fake-project",
+				BuildInfo: testBuildInfo,
+			},
+			server: &server{
+				nowFn:             func() time.Time { return testNow },
+				validateProjectFn: func(context.Context, string) error { return nil },
+				listWarehousesFn:  listWarehousesFn,
+				patchWarehouseStatusFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					func(*kargoapi.WarehouseStatus),
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.AttachBuildInfoResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.AttachBuildInfoRequest{
+				Project:   "fake-project",
+				BuildInfo: testBuildInfo,
+			},
+			server: &server{
+				nowFn:             func() time.Time { return testNow },
+				validateProjectFn: func(context.Context, string) error { return nil },
+				listWarehousesFn:  listWarehousesFn,
+				patchWarehouseStatusFn: func(
+					_ context.Context,
+					warehouse *kargoapi.Warehouse,
+					update func(*kargoapi.WarehouseStatus),
+				) error {
+					require.Equal(t, "subscribed", warehouse.Name)
+					status := &kargoapi.WarehouseStatus{
+						BuildInfo: []kargoapi.BuildInfo{
+							{
+								RepoURL:     "fake-image-repo",
+								Revision:    "fake-tag",
+								PipelineURL: "stale-pipeline-url",
+							},
+						},
+					}
+					update(status)
+					require.Equal(
+						t,
+						[]kargoapi.BuildInfo{
+							{
+								RepoURL:    "fake-image-repo",
+								Revision:   "fake-tag",
+								ReportedAt: &metav1.Time{Time: testNow},
+							},
+						},
+						status.BuildInfo,
+					)
+					return nil
+				},
+				refreshWarehouseFn: func(
+					_ context.Context,
+					_ client.Client,
+					name types.NamespacedName,
+				) (*kargoapi.Warehouse, error) {
+					require.Equal(t, "subscribed", name.Name)
+					return &kargoapi.Warehouse{}, nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.AttachBuildInfoResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"subscribed"}, res.Msg.GetWarehouses())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.AttachBuildInfo(
+					context.Background(),
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
@@ -1,14 +1,18 @@
 package config
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
 
 	"github.com/akuity/kargo/internal/api/dex"
 	"github.com/akuity/kargo/internal/api/oidc"
+	"github.com/akuity/kargo/internal/metrics"
 	"github.com/akuity/kargo/internal/os"
 	"github.com/akuity/kargo/internal/types"
 )
@@ -16,6 +20,41 @@ import (
 type StandardConfig struct {
 	GracefulShutdownTimeout time.Duration `envconfig:"GRACEFUL_SHUTDOWN_TIMEOUT" default:"30s"`
 	UIDirectory             string        `envconfig:"UI_DIR" default:"./ui/build"`
+	// ProjectCacheTTL specifies how long the outcome of successfully validating
+	// a project is cached. A zero value disables caching.
+	ProjectCacheTTL time.Duration `envconfig:"PROJECT_CACHE_TTL" default:"1m"`
+	// ProjectCacheNegativeTTL specifies how long the outcome of a project
+	// validation that failed because the project does not exist (or is not a
+	// project) is cached. A zero value disables negative caching.
+	ProjectCacheNegativeTTL time.Duration `envconfig:"PROJECT_CACHE_NEGATIVE_TTL" default:"10s"`
+	// ProjectCacheMaxEntries specifies the maximum number of project validation
+	// outcomes that are cached at once. Outcomes are cached separately for each
+	// caller. A zero value disables caching.
+	ProjectCacheMaxEntries int `envconfig:"PROJECT_CACHE_MAX_ENTRIES" default:"10000"`
+	// ControllerMetricsURL optionally specifies the URL of the controller's
+	// metrics endpoint, from which controller stats are derived. If unspecified,
+	// controller stats are unavailable.
+	ControllerMetricsURL string `envconfig:"CONTROLLER_METRICS_URL"`
+	// ClusterExecAuthEnabled specifies whether Clusters may use exec credential
+	// plugins to obtain credentials when their connections are tested.
+	ClusterExecAuthEnabled bool `envconfig:"CLUSTER_EXEC_AUTH_ENABLED" default:"false"`
+	// ExternalLinks optionally specifies templates for links to external
+	// systems, such as logging or monitoring dashboards, that are rendered for
+	// each Stage and Promotion returned by the API.
+	ExternalLinks ExternalLinkTemplates `envconfig:"EXTERNAL_LINKS"`
+	// MaxRequestBytes specifies the maximum size, in bytes, of a request. A
+	// zero value disables the limit.
+	MaxRequestBytes int `envconfig:"MAX_REQUEST_BYTES" default:"4194304"`
+	// MaxRequestBatchSize specifies the maximum number of entries in any list
+	// or map within a request (e.g. the Stages in a rollout order or the labels
+	// to remove from a resource). A zero value disables the limit.
+	MaxRequestBatchSize int `envconfig:"MAX_REQUEST_BATCH_SIZE" default:"100"`
+	// MaxManifestObjects specifies the maximum number of objects in a manifest
+	// that is applied or deleted. A zero value disables the limit.
+	MaxManifestObjects int `envconfig:"MAX_MANIFEST_OBJECTS" default:"0"`
+	// MaxRequestDepth specifies the maximum depth to which messages may be
+	// nested within a request. A zero value disables the limit.
+	MaxRequestDepth int `envconfig:"MAX_REQUEST_DEPTH" default:"16"`
 }
 
 type ServerConfig struct {
@@ -26,6 +65,18 @@ type ServerConfig struct {
 	AdminConfig    *AdminConfig
 	DexProxyConfig *dex.ProxyConfig
 	ArgoCDConfig   ArgoCDConfig
+	// AnonymousAccessConfig, if non-nil, enables unauthenticated, read-only
+	// access to selected procedures.
+	AnonymousAccessConfig *AnonymousAccessConfig
+	// TokenRevocationConfig, if non-nil, enables the revocation of tokens
+	// before they expire.
+	TokenRevocationConfig *TokenRevocationConfig
+	// KubernetesLoginConfig, if non-nil, enables users to exchange credentials
+	// for the Kubernetes cluster for a Kargo API session.
+	KubernetesLoginConfig *KubernetesLoginConfig
+	// MetricsPushConfig configures the optional pushing of the server's
+	// metrics to external systems.
+	MetricsPushConfig metrics.PushConfig
 }
 
 func ServerConfigFromEnv() ServerConfig {
@@ -48,12 +99,31 @@ func ServerConfigFromEnv() ServerConfig {
 		cfg.DexProxyConfig = &dexProxyCfg
 	}
 	envconfig.MustProcess("", &cfg.ArgoCDConfig)
+	envconfig.MustProcess("", &cfg.MetricsPushConfig)
+	if types.MustParseBool(os.GetEnv("ANONYMOUS_ACCESS_ENABLED", "false")) {
+		anonymousAccessCfg := AnonymousAccessConfigFromEnv()
+		cfg.AnonymousAccessConfig = &anonymousAccessCfg
+	}
+	if types.MustParseBool(os.GetEnv("TOKEN_REVOCATION_ENABLED", "false")) {
+		tokenRevocationCfg := TokenRevocationConfigFromEnv()
+		cfg.TokenRevocationConfig = &tokenRevocationCfg
+	}
+	if types.MustParseBool(os.GetEnv("KUBERNETES_LOGIN_ENABLED", "false")) {
+		kubernetesLoginCfg := KubernetesLoginConfigFromEnv()
+		cfg.KubernetesLoginConfig = &kubernetesLoginCfg
+	}
 	return cfg
 }
 
 type TLSConfig struct {
 	CertPath string `envconfig:"TLS_CERT_PATH" required:"true"`
 	KeyPath  string `envconfig:"TLS_KEY_PATH" required:"true"`
+	// ClientCAPath optionally specifies the path to a PEM-encoded bundle of CA
+	// certificates. When specified, clients may authenticate by presenting a
+	// certificate signed by one of these CAs. The certificate's common name is
+	// used as the client's username and its organizations are used as the
+	// client's groups.
+	ClientCAPath string `envconfig:"TLS_CLIENT_CA_PATH"`
 }
 
 func TLSConfigFromEnv() TLSConfig {
@@ -77,6 +147,9 @@ type AdminConfig struct {
 	// TokenTTL specifies how long ID tokens for the admin account are valid. i.e.
 	// The expiry will be the time of issue plus this duration.
 	TokenTTL time.Duration `envconfig:"ADMIN_ACCOUNT_TOKEN_TTL" default:"1h"`
+	// ViewerTokenTTL specifies how long read-only viewer tokens issued by the
+	// admin account are valid when no TTL is explicitly requested.
+	ViewerTokenTTL time.Duration `envconfig:"ADMIN_ACCOUNT_VIEWER_TOKEN_TTL" default:"720h"`
 }
 
 // AdminConfigFromEnv returns an AdminConfig populated from environment
@@ -87,6 +160,60 @@ func AdminConfigFromEnv() AdminConfig {
 	return cfg
 }
 
+// AnonymousAccessConfig represents configuration for unauthenticated access to
+// the API server.
+type AnonymousAccessConfig struct {
+	// Procedures is an allowlist of the names of RPCs (e.g. "ListStages") that
+	// may be invoked without authenticating. Only RPCs that do not mutate
+	// anything may be listed here.
+	Procedures []string `envconfig:"ANONYMOUS_ACCESS_PROCEDURES" required:"true"`
+}
+
+// AnonymousAccessConfigFromEnv returns an AnonymousAccessConfig populated from
+// environment variables.
+func AnonymousAccessConfigFromEnv() AnonymousAccessConfig {
+	var cfg AnonymousAccessConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// TokenRevocationConfig represents configuration for the revocation of tokens
+// before they expire.
+type TokenRevocationConfig struct {
+	// ConfigMapNamespace is the namespace of the ConfigMap in which the IDs of
+	// revoked tokens are stored.
+	ConfigMapNamespace string `envconfig:"TOKEN_REVOCATION_CONFIGMAP_NAMESPACE" required:"true"`
+	// ConfigMapName is the name of the ConfigMap in which the IDs of revoked
+	// tokens are stored.
+	ConfigMapName string `envconfig:"TOKEN_REVOCATION_CONFIGMAP_NAME" default:"kargo-api-revoked-tokens"`
+}
+
+// TokenRevocationConfigFromEnv returns a TokenRevocationConfig populated from
+// environment variables.
+func TokenRevocationConfigFromEnv() TokenRevocationConfig {
+	var cfg TokenRevocationConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// KubernetesLoginConfig represents configuration for the exchange of
+// credentials for the Kubernetes cluster for Kargo API sessions. Session tokens
+// are signed using the admin account's token signing key, so the admin account
+// must also be enabled.
+type KubernetesLoginConfig struct {
+	// TokenTTL specifies how long session tokens issued in exchange for
+	// Kubernetes credentials are valid.
+	TokenTTL time.Duration `envconfig:"KUBERNETES_LOGIN_TOKEN_TTL" default:"8h"`
+}
+
+// KubernetesLoginConfigFromEnv returns a KubernetesLoginConfig populated from
+// environment variables.
+func KubernetesLoginConfigFromEnv() KubernetesLoginConfig {
+	var cfg KubernetesLoginConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
 type ArgoCDURLMap map[string]string
 
 func (a *ArgoCDURLMap) Decode(value string) error {
@@ -115,3 +242,74 @@ type ArgoCDConfig struct {
 	// URLs is a mapping from shard name to Argo CD URL
 	URLs ArgoCDURLMap `envconfig:"ARGOCD_URLS"`
 }
+
+// ExternalLinkData is the data available to ExternalLinkTemplates.
+type ExternalLinkData struct {
+	// Project is the name of the project.
+	Project string
+	// Stage is the name of the Stage. For Promotions, it is the name of the
+	// Stage being promoted.
+	Stage string
+	// Promotion is the name of the Promotion. It is empty for Stages.
+	Promotion string
+	// Freight is the ID of the Freight. For Stages, it is the ID of the Stage's
+	// current Freight, if any. For Promotions, it is the ID of the Freight being
+	// promoted.
+	Freight string
+}
+
+// ExternalLinkTemplate is a template for a named link to an external system.
+type ExternalLinkTemplate struct {
+	// Name is the text of the link. e.g. "Logs"
+	Name string `json:"name"`
+	// URL is a Go template for the link's URL, which is rendered using
+	// ExternalLinkData. e.g. "https://grafana.example.com/d/logs?var-ns={{.Stage}}"
+	URL string `json:"url"`
+
+	urlTemplate *template.Template
+}
+
+// Render returns the URL of the link for the provided data.
+func (e ExternalLinkTemplate) Render(data ExternalLinkData) (string, error) {
+	tmpl := e.urlTemplate
+	if tmpl == nil {
+		var err error
+		if tmpl, err = template.New(e.Name).Parse(e.URL); err != nil {
+			return "", err
+		}
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// ExternalLinkTemplates is a list of ExternalLinkTemplate that is decoded from
+// a JSON array of objects with name and url fields.
+type ExternalLinkTemplates []ExternalLinkTemplate
+
+func (e *ExternalLinkTemplates) Decode(value string) error {
+	var links []ExternalLinkTemplate
+	if strings.TrimSpace(value) != "" {
+		if err := json.Unmarshal([]byte(value), &links); err != nil {
+			return fmt.Errorf("error decoding external links: %w", err)
+		}
+	}
+	for i, link := range links {
+		if link.Name == "" || link.URL == "" {
+			return fmt.Errorf("external link %d: name and url must not be empty", i)
+		}
+		tmpl, err := template.New(link.Name).Parse(link.URL)
+		if err != nil {
+			return fmt.Errorf("external link %q: %w", link.Name, err)
+		}
+		links[i].urlTemplate = tmpl
+		// Fail fast on templates that reference data that doesn't exist
+		if _, err = links[i].Render(ExternalLinkData{}); err != nil {
+			return fmt.Errorf("external link %q: %w", link.Name, err)
+		}
+	}
+	*e = links
+	return nil
+}
@@ -69,3 +69,73 @@ func TestDecodeArgoCDURLs(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeExternalLinks(t *testing.T) {
+	testCases := []struct {
+		name       string
+		input      string
+		assertions func(ExternalLinkTemplates, error)
+	}{
+		{
+			name:  "empty string",
+			input: "",
+			assertions: func(links ExternalLinkTemplates, err error) {
+				require.NoError(t, err)
+				require.Empty(t, links)
+			},
+		},
+		{
+			name:  "invalid JSON",
+			input: "not json",
+			assertions: func(_ ExternalLinkTemplates, err error) {
+				require.ErrorContains(t, err, "error decoding external links")
+			},
+		},
+		{
+			name:  "missing url",
+			input: `[{"name":"Logs"}]`,
+			assertions: func(_ ExternalLinkTemplates, err error) {
+				require.ErrorContains(t, err, "name and url must not be empty")
+			},
+		},
+		{
+			name:  "invalid template",
+			input: `[{"name":"Logs","url":"https://logs.example.com/{{.Stage"}]`,
+			assertions: func(_ ExternalLinkTemplates, err error) {
+				require.ErrorContains(t, err, `external link "Logs"`)
+			},
+		},
+		{
+			name:  "template references unknown data",
+			input: `[{"name":"Logs","url":"https://logs.example.com/{{.Environment}}"}]`,
+			assertions: func(_ ExternalLinkTemplates, err error) {
+				require.ErrorContains(t, err, `external link "Logs"`)
+			},
+		},
+		{
+			name: "valid templates",
+			input: `[
+				{"name":"Logs","url":"https://logs.example.com/{{.Project}}/{{.Stage}}"},
+				{"name":"Runbook","url":"https://wiki.example.com/runbooks/{{.Stage}}"}
+			]`,
+			assertions: func(links ExternalLinkTemplates, err error) {
+				require.NoError(t, err)
+				require.Len(t, links, 2)
+				url, err := links[0].Render(ExternalLinkData{
+					Project: "kargo-demo",
+					Stage:   "test",
+				})
+				require.NoError(t, err)
+				require.Equal(t, "https://logs.example.com/kargo-demo/test", url)
+				require.Equal(t, "Runbook", links[1].Name)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var links ExternalLinkTemplates
+			err := links.Decode(testCase.input)
+			testCase.assertions(links, err)
+		})
+	}
+}
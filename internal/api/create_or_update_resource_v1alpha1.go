@@ -2,11 +2,14 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
@@ -21,21 +24,40 @@ func (s *server) CreateOrUpdateResource(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "parse manifest"))
 	}
 
+	strict := req.Msg.GetStrict()
 	size := len(cluster) + len(namespaced)
 	res := make([]*svcv1alpha1.CreateOrUpdateResourceResult, 0, size)
+	// Namespaces applied by this request may not be recognized as Projects
+	// immediately, so these are waited for before anything is applied to them
+	appliedNamespaces := map[string]struct{}{}
 	for _, obj := range cluster {
-		res = append(res, s.createOrUpdateResource(ctx, obj))
+		r := s.createOrUpdateResource(ctx, obj, strict)
+		if obj.GetKind() == "Namespace" && r.GetError() == "" {
+			appliedNamespaces[obj.GetName()] = struct{}{}
+		}
+		res = append(res, withResultIdentity(r, obj))
 	}
+	validatedProjects := map[string]error{}
 	for _, obj := range namespaced {
-		if err := s.validateProject(ctx, obj.GetNamespace()); err != nil {
-			res = append(res, &svcv1alpha1.CreateOrUpdateResourceResult{
+		project := obj.GetNamespace()
+		err, validated := validatedProjects[project]
+		if !validated {
+			if _, applied := appliedNamespaces[project]; applied {
+				err = s.waitForProject(ctx, project)
+			} else {
+				err = s.validateProject(ctx, project)
+			}
+			validatedProjects[project] = err
+		}
+		if err != nil {
+			res = append(res, withResultIdentity(&svcv1alpha1.CreateOrUpdateResourceResult{
 				Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
 					Error: err.Error(),
 				},
-			})
+			}, obj))
 			continue
 		}
-		res = append(res, s.createOrUpdateResource(ctx, obj))
+		res = append(res, withResultIdentity(s.createOrUpdateResource(ctx, obj, strict), obj))
 	}
 	return &connect.Response[svcv1alpha1.CreateOrUpdateResourceResponse]{
 		Msg: &svcv1alpha1.CreateOrUpdateResourceResponse{
@@ -47,11 +69,24 @@ func (s *server) CreateOrUpdateResource(
 func (s *server) createOrUpdateResource(
 	ctx context.Context,
 	obj *unstructured.Unstructured,
+	strict bool,
 ) *svcv1alpha1.CreateOrUpdateResourceResult {
+	// Strict field validation is performed by the Kubernetes API server, which
+	// reports the path of each unknown or duplicate field it encounters
+	var fieldValidation string
+	if strict {
+		fieldValidation = metav1.FieldValidationStrict
+	}
 	if err := s.client.Get(ctx, client.ObjectKeyFromObject(obj), obj.DeepCopy()); err != nil {
 		if kubeerr.IsNotFound(err) {
 			// Create if resource not found
-			switch res := s.createResource(ctx, obj).Result.(type) {
+			switch res := s.createResource(
+				ctx,
+				obj,
+				&client.CreateOptions{
+					Raw: &metav1.CreateOptions{FieldValidation: fieldValidation},
+				},
+			).Result.(type) {
 			case *svcv1alpha1.CreateResourceResult_CreatedResourceManifest:
 				return &svcv1alpha1.CreateOrUpdateResourceResult{
 					Result: &svcv1alpha1.CreateOrUpdateResourceResult_CreatedResourceManifest{
@@ -68,7 +103,13 @@ func (s *server) createOrUpdateResource(
 	}
 
 	// Update if resource found
-	switch res := s.updateResource(ctx, obj).Result.(type) {
+	switch res := s.updateResource(
+		ctx,
+		obj,
+		&client.UpdateOptions{
+			Raw: &metav1.UpdateOptions{FieldValidation: fieldValidation},
+		},
+	).Result.(type) {
 	case *svcv1alpha1.UpdateResourceResult_UpdatedResourceManifest:
 		return &svcv1alpha1.CreateOrUpdateResourceResult{
 			Result: &svcv1alpha1.CreateOrUpdateResourceResult_UpdatedResourceManifest{
@@ -82,6 +123,45 @@ func (s *server) createOrUpdateResource(
 	}
 }
 
+const (
+	// projectReadyTimeout is how long waitForProject waits for a Project.
+	projectReadyTimeout = 30 * time.Second
+	// projectReadyPollInterval is how often waitForProject checks whether a
+	// Project is ready.
+	projectReadyPollInterval = 250 * time.Millisecond
+)
+
+// waitForProject waits for the specified Project, which has just been created
+// or updated, to be recognized as a Project. It returns the error from the
+// last attempt to validate the Project if that does not happen within
+// projectReadyTimeout.
+func (s *server) waitForProject(ctx context.Context, project string) error {
+	var err error
+	if pollErr := wait.PollImmediateWithContext(
+		ctx,
+		projectReadyPollInterval,
+		projectReadyTimeout,
+		func(ctx context.Context) (bool, error) {
+			err = s.validateProject(ctx, project)
+			return err == nil, nil
+		},
+	); pollErr != nil && err == nil {
+		return pollErr
+	}
+	return err
+}
+
+// withResultIdentity records in the provided result which object it is for.
+func withResultIdentity(
+	res *svcv1alpha1.CreateOrUpdateResourceResult,
+	obj *unstructured.Unstructured,
+) *svcv1alpha1.CreateOrUpdateResourceResult {
+	res.Kind = obj.GetKind()
+	res.Namespace = obj.GetNamespace()
+	res.Name = obj.GetName()
+	return res
+}
+
 func newCreateOrUpdateResourceResultError(err error) *svcv1alpha1.CreateOrUpdateResourceResult {
 	return &svcv1alpha1.CreateOrUpdateResourceResult{
 		Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
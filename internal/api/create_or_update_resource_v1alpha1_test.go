@@ -0,0 +1,238 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// fieldValidationRecordingClient is a kubernetes.Client that records the field
+// validation requested by each create and update.
+type fieldValidationRecordingClient struct {
+	kubernetes.Client
+	fieldValidations []string
+}
+
+func (c *fieldValidationRecordingClient) Create(
+	ctx context.Context,
+	obj client.Object,
+	opts ...client.CreateOption,
+) error {
+	c.fieldValidations = append(
+		c.fieldValidations,
+		(&client.CreateOptions{}).ApplyOptions(opts).AsCreateOptions().FieldValidation,
+	)
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *fieldValidationRecordingClient) Update(
+	ctx context.Context,
+	obj client.Object,
+	opts ...client.UpdateOption,
+) error {
+	c.fieldValidations = append(
+		c.fieldValidations,
+		(&client.UpdateOptions{}).ApplyOptions(opts).AsUpdateOptions().FieldValidation,
+	)
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func TestCreateOrUpdateResourceStrict(t *testing.T) {
+	manifest := []byte(`apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: existing
+  namespace: fake-project
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: new
+  namespace: fake-project
+`)
+	testCases := []struct {
+		name     string
+		strict   bool
+		expected []string
+	}{
+		{
+			name:     "not strict",
+			expected: []string{"", ""},
+		},
+		{
+			name:   "strict",
+			strict: true,
+			expected: []string{
+				metav1.FieldValidationStrict,
+				metav1.FieldValidationStrict,
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Simulate an admin user to prevent any authz issues with the
+			// authorizing client.
+			ctx := user.ContextWithInfo(context.Background(), user.Info{IsAdmin: true})
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(
+								&kargoapi.Warehouse{
+									ObjectMeta: metav1.ObjectMeta{
+										Name:      "existing",
+										Namespace: "fake-project",
+									},
+								},
+							).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			recordingClient := &fieldValidationRecordingClient{Client: kubeClient}
+			s := &server{
+				client: recordingClient,
+				externalValidateProjectFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) error {
+					return nil
+				},
+				parseManifestFn: func(
+					[]byte,
+				) ([]*unstructured.Unstructured, []*unstructured.Unstructured, error) {
+					var objs []*unstructured.Unstructured
+					for _, name := range []string{"existing", "new"} {
+						obj := &unstructured.Unstructured{}
+						obj.SetAPIVersion(kargoapi.GroupVersion.String())
+						obj.SetKind("Warehouse")
+						obj.SetNamespace("fake-project")
+						obj.SetName(name)
+						objs = append(objs, obj)
+					}
+					return nil, objs, nil
+				},
+			}
+			res, err := s.CreateOrUpdateResource(
+				ctx,
+				connect.NewRequest(&svcv1alpha1.CreateOrUpdateResourceRequest{
+					Manifest: manifest,
+					Strict:   testCase.strict,
+				}),
+			)
+			require.NoError(t, err)
+			require.Len(t, res.Msg.GetResults(), 2)
+			require.NotNil(t, res.Msg.GetResults()[0].GetUpdatedResourceManifest())
+			require.NotNil(t, res.Msg.GetResults()[1].GetCreatedResourceManifest())
+			require.Equal(t, testCase.expected, recordingClient.fieldValidations)
+		})
+	}
+}
+
+func TestCreateOrUpdateResourceWaitsForProject(t *testing.T) {
+	// Simulate an admin user to prevent any authz issues with the authorizing
+	// client.
+	ctx := user.ContextWithInfo(context.Background(), user.Info{IsAdmin: true})
+	kubeClient, err := kubernetes.NewClient(
+		ctx,
+		&rest.Config{},
+		kubernetes.ClientOptions{
+			NewInternalClient: func(
+				_ context.Context,
+				_ *rest.Config,
+				scheme *runtime.Scheme,
+			) (client.Client, error) {
+				return fake.NewClientBuilder().WithScheme(scheme).Build(), nil
+			},
+		},
+	)
+	require.NoError(t, err)
+	validations := map[string]int{}
+	s := &server{
+		client: kubeClient,
+		externalValidateProjectFn: func(
+			_ context.Context,
+			_ client.Client,
+			project string,
+		) error {
+			validations[project]++
+			// The new project is only recognized on the third attempt
+			if project == "new-project" && validations[project] < 3 {
+				return validation.ErrProjectNotFound
+			}
+			if project == "missing-project" {
+				return validation.ErrProjectNotFound
+			}
+			return nil
+		},
+		parseManifestFn: func(
+			[]byte,
+		) ([]*unstructured.Unstructured, []*unstructured.Unstructured, error) {
+			ns := &unstructured.Unstructured{}
+			ns.SetAPIVersion("v1")
+			ns.SetKind("Namespace")
+			ns.SetName("new-project")
+			var namespaced []*unstructured.Unstructured
+			for _, project := range []string{"new-project", "missing-project", "new-project"} {
+				obj := &unstructured.Unstructured{}
+				obj.SetAPIVersion(kargoapi.GroupVersion.String())
+				obj.SetKind("Warehouse")
+				obj.SetNamespace(project)
+				obj.SetName(fmt.Sprintf("warehouse-%d", len(namespaced)))
+				namespaced = append(namespaced, obj)
+			}
+			return []*unstructured.Unstructured{ns}, namespaced, nil
+		},
+	}
+	res, err := s.CreateOrUpdateResource(
+		ctx,
+		connect.NewRequest(&svcv1alpha1.CreateOrUpdateResourceRequest{}),
+	)
+	require.NoError(t, err)
+	results := res.Msg.GetResults()
+	require.Len(t, results, 4)
+
+	require.NotNil(t, results[0].GetCreatedResourceManifest())
+	require.Equal(t, "Namespace", results[0].GetKind())
+	require.Equal(t, "new-project", results[0].GetName())
+
+	require.NotNil(t, results[1].GetCreatedResourceManifest())
+	require.Equal(t, "Warehouse", results[1].GetKind())
+	require.Equal(t, "new-project", results[1].GetNamespace())
+	require.Equal(t, "warehouse-0", results[1].GetName())
+
+	require.Contains(t, results[2].GetError(), validation.ErrProjectNotFound.Error())
+	require.Equal(t, "Warehouse", results[2].GetKind())
+	require.Equal(t, "missing-project", results[2].GetNamespace())
+	require.Equal(t, "warehouse-1", results[2].GetName())
+
+	require.NotNil(t, results[3].GetCreatedResourceManifest())
+	require.Equal(t, "warehouse-2", results[3].GetName())
+
+	// Each project is validated only until it is found to be valid or not
+	require.Equal(t, map[string]int{"new-project": 3, "missing-project": 1}, validations)
+}
@@ -6,7 +6,6 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
-	"google.golang.org/protobuf/types/known/timestamppb"
 	corev1 "k8s.io/api/core/v1"
 	kubeerr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -51,9 +50,6 @@ func (s *server) CreateProject(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	return connect.NewResponse(&svcv1alpha1.CreateProjectResponse{
-		Project: &svcv1alpha1.Project{
-			Name:       ns.Name,
-			CreateTime: timestamppb.New(ns.CreationTimestamp.Time),
-		},
+		Project: newProjectProto(&ns),
 	}), nil
 }
@@ -38,6 +38,7 @@ func (s *server) CreatePromotionPolicy(
 			},
 			Stage:               req.Msg.GetTyped().GetStage(),
 			EnableAutoPromotion: req.Msg.GetTyped().GetEnableAutoPromotion(),
+			EnableSelfHeal:      req.Msg.GetTyped().GetEnableSelfHeal(),
 		}
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("promotion_policy should not be empty"))
@@ -6,6 +6,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	sigyaml "sigs.k8s.io/yaml"
 
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
@@ -46,8 +47,9 @@ func (s *server) CreateResource(
 func (s *server) createResource(
 	ctx context.Context,
 	obj *unstructured.Unstructured,
+	opts ...client.CreateOption,
 ) *svcv1alpha1.CreateResourceResult {
-	if err := s.client.Create(ctx, obj); err != nil {
+	if err := s.client.Create(ctx, obj, opts...); err != nil {
 		return &svcv1alpha1.CreateResourceResult{
 			Result: &svcv1alpha1.CreateResourceResult_Error{
 				Error: errors.Wrap(err, "create resource").Error(),
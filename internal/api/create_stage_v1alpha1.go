@@ -52,6 +52,6 @@ func (s *server) CreateStage(
 		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "create stage"))
 	}
 	return connect.NewResponse(&svcv1alpha1.CreateStageResponse{
-		Stage: typesv1alpha1.ToStageProto(stage),
+		Stage: s.toStageProto(ctx, stage),
 	}), nil
 }
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// CreateViewerToken issues a long-lived, read-only ID token suitable for use
+// by dashboards and other unattended clients that need to display, but never
+// modify, the state of a Kargo installation. Only the admin account may issue
+// viewer tokens.
+func (s *server) CreateViewerToken(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.CreateViewerTokenRequest],
+) (*connect.Response[svcv1alpha1.CreateViewerTokenResponse], error) {
+	if s.cfg.AdminConfig == nil {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("admin user is not enabled"),
+		)
+	}
+	if u, ok := user.InfoFromContext(ctx); !ok || !u.IsAdmin {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("only the admin user may create viewer tokens"),
+		)
+	}
+
+	ttl := s.cfg.AdminConfig.ViewerTokenTTL
+	if req.Msg.Ttl != nil {
+		var err error
+		if ttl, err = time.ParseDuration(req.Msg.GetTtl()); err != nil {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.Wrapf(err, "invalid ttl %q", req.Msg.GetTtl()),
+			)
+		}
+		if ttl <= 0 {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.Errorf("ttl %q must be positive", req.Msg.GetTtl()),
+			)
+		}
+	}
+
+	now := s.nowFn()
+	idToken := jwt.NewWithClaims(
+		jwt.SigningMethodHS256,
+		user.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				IssuedAt:  jwt.NewNumericDate(now),
+				Issuer:    s.cfg.AdminConfig.TokenIssuer,
+				Audience:  []string{s.cfg.AdminConfig.TokenAudience},
+				NotBefore: jwt.NewNumericDate(now),
+				Subject:   "viewer",
+				ID:        uuid.NewString(),
+				ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			},
+			ReadOnly: true,
+		},
+	)
+
+	signedToken, err := idToken.SignedString(s.cfg.AdminConfig.TokenSigningKey)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Wrap(err, "error signing ID token"),
+		)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.CreateViewerTokenResponse{
+		IdToken: signedToken,
+	}), nil
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/pointer"
+
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestCreateViewerToken(t *testing.T) {
+	testNow := time.Now()
+	testAdminConfig := &config.AdminConfig{
+		TokenIssuer:     "fake-issuer",
+		TokenAudience:   "fake-audience",
+		TokenSigningKey: []byte("fake-signing-key"),
+		ViewerTokenTTL:  time.Hour,
+	}
+	testCases := []struct {
+		name       string
+		userInfo   *user.Info
+		req        *svcv1alpha1.CreateViewerTokenRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.CreateViewerTokenResponse], error)
+	}{
+		{
+			name:   "admin user is not enabled",
+			req:    &svcv1alpha1.CreateViewerTokenRequest{},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.CreateViewerTokenResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+				require.Equal(t, "admin user is not enabled", connErr.Message())
+			},
+		},
+		{
+			name:     "user is not the admin user",
+			userInfo: &user.Info{ReadOnly: true},
+			req:      &svcv1alpha1.CreateViewerTokenRequest{},
+			server: &server{
+				cfg: config.ServerConfig{AdminConfig: testAdminConfig},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.CreateViewerTokenResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+			},
+		},
+		{
+			name:     "invalid ttl",
+			userInfo: &user.Info{IsAdmin: true},
+			req: &svcv1alpha1.CreateViewerTokenRequest{
+				Ttl: pointer.String("-1h"),
+			},
+			server: &server{
+				cfg: config.ServerConfig{AdminConfig: testAdminConfig},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.CreateViewerTokenResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name:     "success",
+			userInfo: &user.Info{IsAdmin: true},
+			req: &svcv1alpha1.CreateViewerTokenRequest{
+				Ttl: pointer.String("24h"),
+			},
+			server: &server{
+				cfg:   config.ServerConfig{AdminConfig: testAdminConfig},
+				nowFn: func() time.Time { return testNow },
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.CreateViewerTokenResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				claims := user.Claims{}
+				_, err = jwt.ParseWithClaims(
+					res.Msg.GetIdToken(),
+					&claims,
+					func(*jwt.Token) (any, error) {
+						return testAdminConfig.TokenSigningKey, nil
+					},
+				)
+				require.NoError(t, err)
+				require.True(t, claims.ReadOnly)
+				require.Equal(t, testAdminConfig.TokenIssuer, claims.Issuer)
+				require.Equal(
+					t,
+					testNow.Add(24*time.Hour).Unix(),
+					claims.ExpiresAt.Unix(),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.userInfo != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.userInfo)
+			}
+			testCase.assertions(
+				testCase.server.CreateViewerToken(
+					ctx,
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
@@ -35,6 +35,10 @@ func (s *server) DeleteProject(
 		return nil, connect.NewError(connect.CodeFailedPrecondition,
 			errors.Errorf("namespace %q is not a project", ns.GetName()))
 	}
+	if kargoapi.IsNamespaceArchived(&ns) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			errors.Errorf("project %q is archived and must be unarchived before it can be deleted", name))
+	}
 	if err := s.client.Delete(ctx, &ns); err != nil && !kubeerr.IsNotFound(err) {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -0,0 +1,226 @@
+package api
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
+	libGit "github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/logging"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// DiffFreight summarizes, file by file, the differences in the content of a Git
+// repository between the commits referenced by two pieces of Freight.
+func (s *server) DiffFreight(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.DiffFreightRequest],
+) (*connect.Response[svcv1alpha1.DiffFreightResponse], error) {
+	project := req.Msg.GetProject()
+	if project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	if req.Msg.GetFrom() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("from should not be empty"))
+	}
+	if req.Msg.GetTo() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("to should not be empty"))
+	}
+	paths, err := cleanDiffPaths(req.Msg.GetPaths())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err = s.validateProjectFn(ctx, project); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	var freight [2]*kargoapi.Freight
+	for i, id := range []string{req.Msg.GetFrom(), req.Msg.GetTo()} {
+		if freight[i], err = s.getFreightFn(
+			ctx,
+			s.client,
+			types.NamespacedName{
+				Namespace: project,
+				Name:      id,
+			},
+		); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if freight[i] == nil {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				errors.Errorf("Freight %q not found in namespace %q", id, project),
+			)
+		}
+	}
+
+	from, to, err := findSharedCommits(freight[0], freight[1], req.Msg.GetRepoUrl())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	res := &svcv1alpha1.DiffFreightResponse{
+		RepoUrl:    from.RepoURL,
+		FromCommit: from.ID,
+		ToCommit:   to.ID,
+	}
+	if from.ID == to.ID {
+		return connect.NewResponse(res), nil
+	}
+
+	diffs, err := s.diffCommitsFn(ctx, project, from.RepoURL, from.ID, to.ID, paths)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res.Files = make([]*svcv1alpha1.FileDiff, len(diffs))
+	for i, diff := range diffs {
+		res.Files[i] = &svcv1alpha1.FileDiff{
+			Path:      diff.Path,
+			Status:    string(diff.Status),
+			Additions: int32(diff.Additions),
+			Deletions: int32(diff.Deletions),
+			Binary:    diff.Binary,
+		}
+	}
+	return connect.NewResponse(res), nil
+}
+
+// findSharedCommits returns the commits referenced by each of the provided
+// Freight that belong to the same Git repository. If repoURL is non-empty,
+// only commits from that repository are considered. Otherwise, the Freight
+// must reference commits from exactly one repository in common.
+func findSharedCommits(
+	from *kargoapi.Freight,
+	to *kargoapi.Freight,
+	repoURL string,
+) (kargoapi.GitCommit, kargoapi.GitCommit, error) {
+	repoURL = libGit.NormalizeGitURL(repoURL)
+	toCommits := make(map[string]kargoapi.GitCommit, len(to.Commits))
+	for _, commit := range to.Commits {
+		toCommits[libGit.NormalizeGitURL(commit.RepoURL)] = commit
+	}
+	var shared [][2]kargoapi.GitCommit
+	for _, fromCommit := range from.Commits {
+		url := libGit.NormalizeGitURL(fromCommit.RepoURL)
+		if repoURL != "" && url != repoURL {
+			continue
+		}
+		if toCommit, ok := toCommits[url]; ok {
+			shared = append(shared, [2]kargoapi.GitCommit{fromCommit, toCommit})
+		}
+	}
+	switch len(shared) {
+	case 0:
+		if repoURL != "" {
+			return kargoapi.GitCommit{}, kargoapi.GitCommit{}, errors.Errorf(
+				"Freight %q and %q do not both reference a commit from repository %q",
+				from.Name,
+				to.Name,
+				repoURL,
+			)
+		}
+		return kargoapi.GitCommit{}, kargoapi.GitCommit{}, errors.Errorf(
+			"Freight %q and %q do not reference commits from any repository in common",
+			from.Name,
+			to.Name,
+		)
+	case 1:
+		return shared[0][0], shared[0][1], nil
+	default:
+		return kargoapi.GitCommit{}, kargoapi.GitCommit{}, errors.Errorf(
+			"Freight %q and %q reference commits from more than one repository "+
+				"in common; repo_url should not be empty",
+			from.Name,
+			to.Name,
+		)
+	}
+}
+
+// cleanDiffPaths cleans the provided paths and verifies that all of them are
+// relative paths within a repository.
+func cleanDiffPaths(paths []string) ([]string, error) {
+	cleaned := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		c := path.Clean(p)
+		if path.IsAbs(c) || c == ".." || strings.HasPrefix(c, "../") {
+			return nil, errors.Errorf("path %q is not relative to the root of the repository", p)
+		}
+		cleaned = append(cleaned, c)
+	}
+	return cleaned, nil
+}
+
+// diffCommits clones the specified Git repository, using any credentials the
+// project has for it, and summarizes the differences between the specified
+// commits. Credentials are only used if the context-bound user is permitted to
+// read Secrets in the project. Otherwise, the repository is cloned without any.
+func (s *server) diffCommits(
+	ctx context.Context,
+	project string,
+	repoURL string,
+	fromID string,
+	toID string,
+	paths []string,
+) ([]git.FileDiff, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repo", repoURL)
+	var repoCreds git.RepoCredentials
+	var creds credentials.Credentials
+	var ok bool
+	err := s.client.Authorize(
+		ctx,
+		"get",
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: project,
+			},
+		},
+	)
+	switch {
+	case errors.Is(err, kubernetes.ErrNotAllowed):
+		logger.Debug("user is not permitted to use the project's credentials")
+	case err != nil:
+		return nil, errors.Wrap(err, "error authorizing use of credentials")
+	default:
+		if creds, ok, err = s.credentialsDB.Get(
+			ctx,
+			project,
+			credentials.TypeGit,
+			repoURL,
+		); err != nil {
+			return nil, errors.Wrapf(err, "error obtaining credentials for git repo %q", repoURL)
+		}
+	}
+	if ok {
+		logger.Debug("obtained credentials for git repo")
+		repoCreds = git.RepoCredentials{
+			Username:      creds.Username,
+			Password:      creds.Password,
+			SSHPrivateKey: creds.SSHPrivateKey,
+		}
+	} else {
+		logger.Debug("found no credentials for git repo")
+	}
+	repo, err := s.cloneRepoFn(repoURL, repoCreds)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error cloning git repo %q", repoURL)
+	}
+	defer func() {
+		if err := repo.Close(); err != nil {
+			logger.WithError(err).Warn("error cleaning up git repo")
+		}
+	}()
+	return repo.DiffFiles(fromID, toID, paths...)
+}
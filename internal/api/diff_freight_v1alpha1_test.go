@@ -0,0 +1,327 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestDiffFreight(t *testing.T) {
+	testFreight := map[string]*kargoapi.Freight{
+		"old": {
+			ObjectMeta: metav1.ObjectMeta{Name: "old"},
+			Commits: []kargoapi.GitCommit{
+				{RepoURL: "https://github.com/example/config", ID: "abc123"},
+				{RepoURL: "https://github.com/example/other", ID: "def456"},
+			},
+		},
+		"new": {
+			ObjectMeta: metav1.ObjectMeta{Name: "new"},
+			Commits: []kargoapi.GitCommit{
+				{RepoURL: "https://github.com/example/config.git", ID: "123abc"},
+				{RepoURL: "https://github.com/example/other", ID: "def456"},
+			},
+		},
+		"images-only": {
+			ObjectMeta: metav1.ObjectMeta{Name: "images-only"},
+		},
+	}
+	getFreightFn := func(
+		_ context.Context,
+		_ client.Client,
+		key types.NamespacedName,
+	) (*kargoapi.Freight, error) {
+		return testFreight[key.Name], nil
+	}
+	validateProjectFn := func(context.Context, string) error { return nil }
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.DiffFreightRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.DiffFreightResponse], error)
+	}{
+		{
+			name:   "missing project",
+			req:    &svcv1alpha1.DiffFreightRequest{From: "old", To: "new"},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.DiffFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "missing Freight ID",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "old",
+			},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.DiffFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "to should not be empty")
+			},
+		},
+		{
+			name: "path outside the repository",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "old",
+				To:      "new",
+				Paths:   []string{"charts/../../etc"},
+			},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.DiffFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "is not relative to the root of the repository")
+			},
+		},
+		{
+			name: "Freight not found",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "old",
+				To:      "non-existing",
+			},
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				getFreightFn:      getFreightFn,
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.DiffFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "no repository in common",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "old",
+				To:      "images-only",
+			},
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				getFreightFn:      getFreightFn,
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.DiffFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "any repository in common")
+			},
+		},
+		{
+			name: "more than one repository in common",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "old",
+				To:      "new",
+			},
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				getFreightFn:      getFreightFn,
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.DiffFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "repo_url should not be empty")
+			},
+		},
+		{
+			name: "same commit",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "old",
+				To:      "new",
+				RepoUrl: "https://github.com/example/other",
+			},
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				getFreightFn:      getFreightFn,
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.DiffFreightResponse], err error) {
+				require.NoError(t, err)
+				require.Equal(t, "def456", res.Msg.GetFromCommit())
+				require.Equal(t, "def456", res.Msg.GetToCommit())
+				require.Empty(t, res.Msg.GetFiles())
+			},
+		},
+		{
+			name: "error diffing commits",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "old",
+				To:      "new",
+				RepoUrl: "https://github.com/example/config",
+			},
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				getFreightFn:      getFreightFn,
+				diffCommitsFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+					string,
+					[]string,
+				) ([]git.FileDiff, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.DiffFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "old",
+				To:      "new",
+				RepoUrl: "https://github.com/example/config",
+				Paths:   []string{"charts/app/", ""},
+			},
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				getFreightFn:      getFreightFn,
+				diffCommitsFn: func(
+					_ context.Context,
+					project string,
+					repoURL string,
+					fromID string,
+					toID string,
+					paths []string,
+				) ([]git.FileDiff, error) {
+					require.Equal(t, "fake-project", project)
+					require.Equal(t, "https://github.com/example/config", repoURL)
+					require.Equal(t, "abc123", fromID)
+					require.Equal(t, "123abc", toID)
+					require.Equal(t, []string{"charts/app"}, paths)
+					return []git.FileDiff{
+						{
+							Path:      "charts/app/values.yaml",
+							Status:    git.FileStatusModified,
+							Additions: 2,
+							Deletions: 1,
+						},
+					}, nil
+				},
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.DiffFreightResponse], err error) {
+				require.NoError(t, err)
+				require.Equal(t, "https://github.com/example/config", res.Msg.GetRepoUrl())
+				require.Equal(t, "abc123", res.Msg.GetFromCommit())
+				require.Equal(t, "123abc", res.Msg.GetToCommit())
+				require.Len(t, res.Msg.GetFiles(), 1)
+				file := res.Msg.GetFiles()[0]
+				require.Equal(t, "charts/app/values.yaml", file.GetPath())
+				require.Equal(t, "Modified", file.GetStatus())
+				require.Equal(t, int32(2), file.GetAdditions())
+				require.Equal(t, int32(1), file.GetDeletions())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.DiffFreight(
+					context.Background(),
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
+
+func TestDiffCommits(t *testing.T) {
+	testCases := []struct {
+		name          string
+		user          user.Info
+		expectedCreds git.RepoCredentials
+	}{
+		{
+			name: "user permitted to read Secrets",
+			user: user.Info{IsAdmin: true},
+			expectedCreds: git.RepoCredentials{
+				Username: "fake-username",
+				Password: "fake-password",
+			},
+		},
+		{
+			name: "read-only user",
+			user: user.Info{ReadOnly: true},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := user.ContextWithInfo(context.Background(), testCase.user)
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().WithScheme(scheme).Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			var usedCreds git.RepoCredentials
+			s := &server{
+				client: kubeClient,
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{
+							Username: "fake-username",
+							Password: "fake-password",
+						}, true, nil
+					},
+				},
+				cloneRepoFn: func(
+					_ string,
+					repoCreds git.RepoCredentials,
+				) (git.Repo, error) {
+					usedCreds = repoCreds
+					return nil, errors.New("something went wrong")
+				},
+			}
+			_, err = s.diffCommits(
+				ctx,
+				"fake-project",
+				"https://github.com/example/config",
+				"abc123",
+				"123abc",
+				nil,
+			)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "something went wrong")
+			require.Equal(t, testCase.expectedCreds, usedCreds)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libGit "github.com/akuity/kargo/internal/git"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	discoveryOutcomeDiscovered    = "Discovered"
+	discoveryOutcomeRejected      = "Rejected"
+	discoveryOutcomeNotDiscovered = "NotDiscovered"
+)
+
+// ExplainDiscovery explains, for every subscription of every Warehouse in the
+// specified project, whether the specified revision of an artifact became
+// Freight and, if it did not, why. If no repository URL is specified and no
+// subscription discovered or rejected the revision, every subscription is
+// reported as not having discovered it.
+func (s *server) ExplainDiscovery(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ExplainDiscoveryRequest],
+) (*connect.Response[svcv1alpha1.ExplainDiscoveryResponse], error) {
+	project := req.Msg.GetProject()
+	if project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	revision := req.Msg.GetRevision()
+	if revision == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("revision should not be empty"))
+	}
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	var warehouses kargoapi.WarehouseList
+	if err := s.listWarehousesFn(ctx, &warehouses, client.InNamespace(project)); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "list warehouses"))
+	}
+	sort.Slice(warehouses.Items, func(i, j int) bool {
+		return warehouses.Items[i].Name < warehouses.Items[j].Name
+	})
+
+	var explained, unexplained []*svcv1alpha1.DiscoveryExplanation
+	for i := range warehouses.Items {
+		warehouse := &warehouses.Items[i]
+		if warehouse.Spec == nil {
+			continue
+		}
+		freight, err := s.getFreightFromWarehouseFn(ctx, project, warehouse.Name)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		sort.Slice(freight, func(i, j int) bool {
+			return freight[i].Name < freight[j].Name
+		})
+		for _, sub := range warehouse.Spec.Subscriptions {
+			repoURL, _ := subscriptionRepo(sub)
+			if repoURL == "" ||
+				(req.Msg.GetRepoUrl() != "" && !sameRepo(sub, repoURL, req.Msg.GetRepoUrl())) {
+				continue
+			}
+			explanations := explainDiscovery(warehouse, freight, sub, revision)
+			if explanations[0].GetOutcome() == discoveryOutcomeNotDiscovered {
+				unexplained = append(unexplained, explanations...)
+			} else {
+				explained = append(explained, explanations...)
+			}
+		}
+	}
+
+	res := &svcv1alpha1.ExplainDiscoveryResponse{Explanations: explained}
+	if req.Msg.GetRepoUrl() != "" || len(explained) == 0 {
+		res.Explanations = append(res.Explanations, unexplained...)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// explainDiscovery explains what became of the specified revision with respect
+// to the provided subscription of the provided Warehouse, which owns the
+// provided Freight. One explanation is returned for each piece of Freight
+// carrying the revision or, if there is no such Freight, a single explanation
+// is returned.
+func explainDiscovery(
+	warehouse *kargoapi.Warehouse,
+	freight []kargoapi.Freight,
+	sub kargoapi.RepoSubscription,
+	revision string,
+) []*svcv1alpha1.DiscoveryExplanation {
+	repoURL, chart := subscriptionRepo(sub)
+	newExplanation := func(outcome, reason string) *svcv1alpha1.DiscoveryExplanation {
+		e := &svcv1alpha1.DiscoveryExplanation{
+			Warehouse: warehouse.Name,
+			RepoUrl:   repoURL,
+			Outcome:   outcome,
+			Reason:    reason,
+		}
+		if chart != "" {
+			e.Chart = proto.String(chart)
+		}
+		return e
+	}
+
+	var explanations []*svcv1alpha1.DiscoveryExplanation
+	for i := range freight {
+		f := &freight[i]
+		if !carriesRevision(f, sub, revision) {
+			continue
+		}
+		e := newExplanation(
+			discoveryOutcomeDiscovered,
+			fmt.Sprintf("revision was discovered and is carried by Freight %q", f.Name),
+		)
+		e.Freight = proto.String(f.Name)
+		if f.Alias != "" {
+			e.Alias = proto.String(f.Alias)
+		}
+		e.QualifiedStages = sortedKeys(f.Status.Qualifications)
+		e.ApprovedStages = sortedKeys(f.Status.Approvals)
+		explanations = append(explanations, e)
+	}
+	if len(explanations) > 0 {
+		return explanations
+	}
+
+	var omitted int32
+	for _, rejections := range warehouse.Status.Rejections {
+		if rejections.RepoURL != repoURL || rejections.Chart != chart {
+			continue
+		}
+		for _, v := range rejections.Versions {
+			if v.Version == revision {
+				return []*svcv1alpha1.DiscoveryExplanation{
+					newExplanation(discoveryOutcomeRejected, "revision "+v.Reason),
+				}
+			}
+		}
+		omitted += rejections.Omitted
+	}
+
+	var reason string
+	switch {
+	case sub.Git != nil:
+		reason = "revision was not the most recent commit when the Warehouse " +
+			"last discovered a new commit"
+	case omitted > 0:
+		reason = fmt.Sprintf(
+			"revision was not among the newest rejected versions recorded by the "+
+				"Warehouse; %d older rejected versions were not recorded, so it may "+
+				"have been rejected, superseded by a newer version, or not exist",
+			omitted,
+		)
+	default:
+		reason = "revision was neither discovered nor rejected; it may have " +
+			"been superseded by a newer version, may not have been published " +
+			"yet, or may not exist"
+	}
+	return []*svcv1alpha1.DiscoveryExplanation{
+		newExplanation(discoveryOutcomeNotDiscovered, reason),
+	}
+}
+
+// subscriptionRepo returns the URL of the repository the provided subscription
+// subscribes to and, for chart subscriptions, the name of the chart.
+func subscriptionRepo(sub kargoapi.RepoSubscription) (string, string) {
+	switch {
+	case sub.Git != nil:
+		return sub.Git.RepoURL, ""
+	case sub.Image != nil:
+		return sub.Image.RepoURL, ""
+	case sub.Chart != nil:
+		return sub.Chart.RegistryURL, sub.Chart.Name
+	default:
+		return "", ""
+	}
+}
+
+// sameRepo returns true if the provided repository URLs, which belong to the
+// provided subscription, refer to the same repository.
+func sameRepo(sub kargoapi.RepoSubscription, url1, url2 string) bool {
+	if sub.Git != nil {
+		return libGit.NormalizeGitURL(url1) == libGit.NormalizeGitURL(url2)
+	}
+	return url1 == url2
+}
+
+// carriesRevision returns true if the provided Freight carries the specified
+// revision of the artifact the provided subscription subscribes to. Git commit
+// IDs may be abbreviated.
+func carriesRevision(
+	freight *kargoapi.Freight,
+	sub kargoapi.RepoSubscription,
+	revision string,
+) bool {
+	switch {
+	case sub.Git != nil:
+		for _, commit := range freight.Commits {
+			if sameRepo(sub, commit.RepoURL, sub.Git.RepoURL) &&
+				strings.HasPrefix(commit.ID, revision) {
+				return true
+			}
+		}
+	case sub.Image != nil:
+		for _, image := range freight.Images {
+			if image.RepoURL == sub.Image.RepoURL && image.Tag == revision {
+				return true
+			}
+		}
+	case sub.Chart != nil:
+		for _, chart := range freight.Charts {
+			if chart.RegistryURL == sub.Chart.RegistryURL &&
+				chart.Name == sub.Chart.Name && chart.Version == revision {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
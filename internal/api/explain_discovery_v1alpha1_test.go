@@ -0,0 +1,193 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestExplainDiscovery(t *testing.T) {
+	testWarehouse := kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-warehouse",
+			Namespace: "fake-project",
+		},
+		Spec: &kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{
+				{Image: &kargoapi.ImageSubscription{RepoURL: "nginx"}},
+				{Git: &kargoapi.GitSubscription{RepoURL: "https://github.com/example/config"}},
+			},
+		},
+		Status: kargoapi.WarehouseStatus{
+			Rejections: []kargoapi.SubscriptionRejections{
+				{
+					RepoURL: "nginx",
+					Versions: []kargoapi.RejectedVersion{
+						{Version: "2.0.0", Reason: `does not satisfy semver constraint "^1.0.0"`},
+					},
+					Omitted: 3,
+				},
+			},
+		},
+	}
+	testFreight := []kargoapi.Freight{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+			Alias:      "fake-alias",
+			Images:     []kargoapi.Image{{RepoURL: "nginx", Tag: "1.0.0"}},
+			Commits: []kargoapi.GitCommit{
+				{RepoURL: "https://github.com/example/config.git", ID: "abc123def456"},
+			},
+			Status: kargoapi.FreightStatus{
+				Qualifications: map[string]kargoapi.Qualification{"test": {}},
+				Approvals:      map[string]kargoapi.Approval{"prod": {}},
+			},
+		},
+	}
+	testServer := &server{
+		validateProjectFn: func(context.Context, string) error { return nil },
+		listWarehousesFn: func(
+			_ context.Context,
+			list client.ObjectList,
+			_ ...client.ListOption,
+		) error {
+			list.(*kargoapi.WarehouseList).Items = []kargoapi.Warehouse{testWarehouse} // nolint: forcetypeassert
+			return nil
+		},
+		getFreightFromWarehouseFn: func(
+			context.Context,
+			string,
+			string,
+		) ([]kargoapi.Freight, error) {
+			return testFreight, nil
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.ExplainDiscoveryRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.ExplainDiscoveryResponse], error)
+	}{
+		{
+			name:   "missing project",
+			req:    &svcv1alpha1.ExplainDiscoveryRequest{Revision: "1.0.0"},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.ExplainDiscoveryResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name:   "missing revision",
+			req:    &svcv1alpha1.ExplainDiscoveryRequest{Project: "fake-project"},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.ExplainDiscoveryResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "revision should not be empty")
+			},
+		},
+		{
+			name: "discovered image",
+			req: &svcv1alpha1.ExplainDiscoveryRequest{
+				Project:  "fake-project",
+				Revision: "1.0.0",
+			},
+			server: testServer,
+			assertions: func(res *connect.Response[svcv1alpha1.ExplainDiscoveryResponse], err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetExplanations(), 1)
+				e := res.Msg.GetExplanations()[0]
+				require.Equal(t, "nginx", e.GetRepoUrl())
+				require.Equal(t, discoveryOutcomeDiscovered, e.GetOutcome())
+				require.Equal(t, "fake-freight", e.GetFreight())
+				require.Equal(t, "fake-alias", e.GetAlias())
+				require.Equal(t, []string{"test"}, e.GetQualifiedStages())
+				require.Equal(t, []string{"prod"}, e.GetApprovedStages())
+			},
+		},
+		{
+			name: "discovered abbreviated commit",
+			req: &svcv1alpha1.ExplainDiscoveryRequest{
+				Project:  "fake-project",
+				Revision: "abc123",
+				RepoUrl:  "https://github.com/example/config.git",
+			},
+			server: testServer,
+			assertions: func(res *connect.Response[svcv1alpha1.ExplainDiscoveryResponse], err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetExplanations(), 1)
+				e := res.Msg.GetExplanations()[0]
+				require.Equal(t, "https://github.com/example/config", e.GetRepoUrl())
+				require.Equal(t, discoveryOutcomeDiscovered, e.GetOutcome())
+				require.Equal(t, "fake-freight", e.GetFreight())
+			},
+		},
+		{
+			name: "rejected image",
+			req: &svcv1alpha1.ExplainDiscoveryRequest{
+				Project:  "fake-project",
+				Revision: "2.0.0",
+			},
+			server: testServer,
+			assertions: func(res *connect.Response[svcv1alpha1.ExplainDiscoveryResponse], err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetExplanations(), 1)
+				e := res.Msg.GetExplanations()[0]
+				require.Equal(t, discoveryOutcomeRejected, e.GetOutcome())
+				require.Equal(t, `revision does not satisfy semver constraint "^1.0.0"`, e.GetReason())
+			},
+		},
+		{
+			name: "not discovered by any subscription",
+			req: &svcv1alpha1.ExplainDiscoveryRequest{
+				Project:  "fake-project",
+				Revision: "0.1.0",
+			},
+			server: testServer,
+			assertions: func(res *connect.Response[svcv1alpha1.ExplainDiscoveryResponse], err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetExplanations(), 2)
+				for _, e := range res.Msg.GetExplanations() {
+					require.Equal(t, discoveryOutcomeNotDiscovered, e.GetOutcome())
+				}
+				require.Contains(
+					t,
+					res.Msg.GetExplanations()[0].GetReason(),
+					"3 older rejected versions were not recorded",
+				)
+			},
+		},
+		{
+			name: "no subscription to repository",
+			req: &svcv1alpha1.ExplainDiscoveryRequest{
+				Project:  "fake-project",
+				Revision: "1.0.0",
+				RepoUrl:  "redis",
+			},
+			server: testServer,
+			assertions: func(res *connect.Response[svcv1alpha1.ExplainDiscoveryResponse], err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.Msg.GetExplanations())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.ExplainDiscovery(
+					context.Background(),
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	promotionGateFreightExists      = "FreightExists"
+	promotionGateProjectNotArchived = "ProjectNotArchived"
+	promotionGateAuthorized         = "Authorized"
+	promotionGateQualified          = "Qualified"
+	promotionGateFreightAge         = "FreightAge"
+
+	promotionGateStatusPassed       = "Passed"
+	promotionGateStatusBlocked      = "Blocked"
+	promotionGateStatusNotEvaluated = "NotEvaluated"
+)
+
+// ExplainPromotionEligibility reports, for each of the gates that must be
+// passed for the specified Freight to be promoted to the specified Stage,
+// whether it was passed and, if it was not, why. Unlike PromoteStage, which
+// reports only the first gate that was not passed, and often only as a terse
+// NotFound error, every gate is evaluated.
+func (s *server) ExplainPromotionEligibility(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ExplainPromotionEligibilityRequest],
+) (*connect.Response[svcv1alpha1.ExplainPromotionEligibilityResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateProjectAndStageNonEmpty(project, req.Msg.GetStage()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	freightName := req.Msg.GetFreight()
+	if freightName == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("freight should not be empty"),
+		)
+	}
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      req.Msg.GetStage(),
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Stage %q not found in namespace %q",
+				req.Msg.GetStage(),
+				project,
+			),
+		)
+	}
+	freight, err := s.getFreightFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      freightName,
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	gates := make([]*svcv1alpha1.PromotionGate, 0, 5)
+
+	existsGate := &svcv1alpha1.PromotionGate{
+		Name:   promotionGateFreightExists,
+		Status: promotionGateStatusPassed,
+		Reason: fmt.Sprintf("Freight %q exists in Project %q", freightName, project),
+	}
+	if freight == nil {
+		existsGate.Status = promotionGateStatusBlocked
+		existsGate.Reason =
+			fmt.Sprintf("Freight %q not found in Project %q", freightName, project)
+		otherProjects, err := s.findFreightProjectsFn(ctx, project, freightName)
+		if err != nil {
+			// This is only a hint, so don't fail for want of it
+			logging.LoggerFromContext(ctx).WithError(err).
+				Debug("error looking for Freight in other Projects")
+		}
+		for _, otherProject := range otherProjects {
+			existsGate.Details = append(
+				existsGate.Details,
+				fmt.Sprintf(
+					"Freight %q belongs to Project %q; it can only be promoted to "+
+						"Stage %q if that Project shares its Freight with Project %q",
+					freightName,
+					otherProject,
+					stage.Name,
+					project,
+				),
+			)
+		}
+	}
+	gates = append(gates, existsGate)
+
+	archived, err := s.isProjectArchivedFn(ctx, s.client, project)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	archivedGate := &svcv1alpha1.PromotionGate{
+		Name:   promotionGateProjectNotArchived,
+		Status: promotionGateStatusPassed,
+		Reason: fmt.Sprintf("Project %q is not archived", project),
+	}
+	if archived {
+		archivedGate.Status = promotionGateStatusBlocked
+		archivedGate.Reason = fmt.Sprintf(
+			"Project %q is archived and must be unarchived before any of its "+
+				"Stages can be promoted",
+			project,
+		)
+	}
+	gates = append(gates, archivedGate)
+
+	authorizedGate := &svcv1alpha1.PromotionGate{
+		Name:   promotionGateAuthorized,
+		Status: promotionGateStatusPassed,
+		Reason: fmt.Sprintf("You are permitted to promote Stage %q", stage.Name),
+	}
+	if err = s.client.Authorize(ctx, "promote", stage); err != nil {
+		if !errors.Is(err, kubernetes.ErrNotAllowed) {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		authorizedGate.Status = promotionGateStatusBlocked
+		authorizedGate.Reason = fmt.Sprintf(
+			"You are not permitted to promote Stage %q; this requires the "+
+				"\"promote\" verb on the Stage",
+			stage.Name,
+		)
+	}
+	gates = append(gates, authorizedGate)
+
+	qualifiedGate := &svcv1alpha1.PromotionGate{
+		Name:   promotionGateQualified,
+		Status: promotionGateStatusNotEvaluated,
+		Reason: "Freight not found",
+	}
+	if freight != nil {
+		if qualifiedGate, err = s.explainFreightQualification(ctx, stage, freight); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	gates = append(gates, qualifiedGate)
+
+	ageGate := &svcv1alpha1.PromotionGate{
+		Name:   promotionGateFreightAge,
+		Status: promotionGateStatusNotEvaluated,
+		Reason: "Freight not found",
+	}
+	if freight != nil {
+		if ageGate, err = s.explainFreightAge(ctx, stage, freight); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	gates = append(gates, ageGate)
+
+	eligible := true
+	for _, gate := range gates {
+		if gate.GetStatus() != promotionGateStatusPassed {
+			eligible = false
+			break
+		}
+	}
+	return connect.NewResponse(&svcv1alpha1.ExplainPromotionEligibilityResponse{
+		Eligible: eligible,
+		Gates:    gates,
+		Impact:   typesv1alpha1.ToPromotionImpactProto(stage.PromotionImpact()),
+	}), nil
+}
+
+// explainFreightAge explains whether the provided Freight is older than the
+// maximum age permitted by the provided Stage's PromotionPolicy and, if it is,
+// whether the user is permitted to promote it anyway.
+func (s *server) explainFreightAge(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight *kargoapi.Freight,
+) (*svcv1alpha1.PromotionGate, error) {
+	gate := &svcv1alpha1.PromotionGate{
+		Name:   promotionGateFreightAge,
+		Status: promotionGateStatusPassed,
+	}
+	policy, err := s.getStagePromotionPolicy(ctx, stage.Namespace, stage.Name)
+	if err != nil {
+		return nil, err
+	}
+	now := s.nowFn()
+	if !policy.ExceedsMaxFreightAge(freight, now) {
+		gate.Reason = fmt.Sprintf(
+			"Freight does not exceed any maximum age permitted for Stage %q",
+			stage.Name,
+		)
+		return gate, nil
+	}
+	gate.Reason = fmt.Sprintf(
+		"Freight is %s old, which exceeds the maximum age of %s permitted for "+
+			"Stage %q by PromotionPolicy %q",
+		now.Sub(freight.CreationTimestamp.Time).Truncate(time.Second),
+		policy.MaxFreightAge.Duration,
+		stage.Name,
+		policy.Name,
+	)
+	if err = s.client.Authorize(ctx, promoteStaleVerb, stage); err == nil {
+		gate.Details = []string{
+			fmt.Sprintf(
+				"You are permitted to promote it anyway, as you have the %q verb "+
+					"on the Stage",
+				promoteStaleVerb,
+			),
+		}
+		return gate, nil
+	} else if !errors.Is(err, kubernetes.ErrNotAllowed) {
+		return nil, err
+	}
+	gate.Status = promotionGateStatusBlocked
+	gate.Details = []string{
+		fmt.Sprintf(
+			"Promoting it anyway requires the %q verb on the Stage",
+			promoteStaleVerb,
+		),
+	}
+	return gate, nil
+}
+
+// explainFreightQualification explains whether the provided Freight is
+// available to the provided Stage, per the semantics of
+// kargoapi.GetPromotableFreight, and, if it is not, why the Freight has not
+// qualified for each of the Stage's upstream Stages. For an upstream Stage to
+// which the Freight is currently promoted, this is why the upstream Stage's
+// qualification criteria are not yet satisfied. e.g. Because the upstream
+// Stage has not yet been Healthy for long enough, or because verification of
+// the Freight is still pending.
+func (s *server) explainFreightQualification(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight *kargoapi.Freight,
+) (*svcv1alpha1.PromotionGate, error) {
+	gate := &svcv1alpha1.PromotionGate{
+		Name:   promotionGateQualified,
+		Status: promotionGateStatusPassed,
+	}
+	if _, ok := freight.Status.Approvals[stage.Name]; ok {
+		gate.Reason = fmt.Sprintf(
+			"Freight has been manually approved for Stage %q",
+			stage.Name,
+		)
+		return gate, nil
+	}
+	var upstreams []kargoapi.StageSubscription
+	if stage.Spec != nil && stage.Spec.Subscriptions != nil {
+		upstreams = stage.Spec.Subscriptions.UpstreamStages
+	}
+	if len(upstreams) == 0 {
+		gate.Reason = fmt.Sprintf(
+			"Stage %q has no upstream Stages, so all Freight is available to it",
+			stage.Name,
+		)
+		return gate, nil
+	}
+	for _, upstream := range upstreams {
+		if _, ok := freight.Status.Qualifications[upstream.Name]; ok {
+			gate.Reason = fmt.Sprintf(
+				"Freight has qualified for upstream Stage %q",
+				upstream.Name,
+			)
+			return gate, nil
+		}
+	}
+
+	gate.Status = promotionGateStatusBlocked
+	gate.Reason = fmt.Sprintf(
+		"Freight has not been manually approved for Stage %q and has not "+
+			"qualified for any of its upstream Stages",
+		stage.Name,
+	)
+	for _, upstream := range upstreams {
+		reason, err := s.explainUpstreamQualification(ctx, stage.Namespace, upstream.Name, freight)
+		if err != nil {
+			return nil, err
+		}
+		gate.Details = append(
+			gate.Details,
+			fmt.Sprintf("Stage %q: %s", upstream.Name, reason),
+		)
+	}
+	return gate, nil
+}
+
+// explainUpstreamQualification explains why the provided Freight has not
+// qualified for the specified upstream Stage.
+func (s *server) explainUpstreamQualification(
+	ctx context.Context,
+	project string,
+	upstreamName string,
+	freight *kargoapi.Freight,
+) (string, error) {
+	upstream, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      upstreamName,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	if upstream == nil {
+		return "Stage not found", nil
+	}
+	if upstream.Status.CurrentFreight == nil ||
+		upstream.Status.CurrentFreight.ID != freight.Name {
+		if promo := upstream.Status.CurrentPromotion; promo != nil &&
+			promo.Freight.ID == freight.Name {
+			return fmt.Sprintf(
+				"Freight is being promoted to the Stage by Promotion %q",
+				promo.Name,
+			), nil
+		}
+		return "Freight has not been promoted to the Stage", nil
+	}
+	var policy *kargoapi.QualificationPolicy
+	if upstream.Spec != nil {
+		policy = upstream.Spec.Qualification
+	}
+	_, approved := freight.Status.Approvals[upstream.Name]
+	qualified, unmet := policy.Evaluate(
+		upstream.Status.Health,
+		approved,
+		upstream.Status.Verifications,
+		s.nowFn(),
+	)
+	if qualified {
+		return "Freight satisfies the Stage's qualification criteria, but has " +
+			"not yet been marked as qualified for it", nil
+	}
+	return strings.Join(unmet, "; "), nil
+}
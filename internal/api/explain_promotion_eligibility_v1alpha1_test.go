@@ -0,0 +1,366 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestExplainPromotionEligibility(t *testing.T) {
+	const testProject = "kargo-demo"
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	newNamespace := func(name string, archived bool) *corev1.Namespace {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					kargoapi.LabelProjectKey: kargoapi.LabelTrueValue,
+				},
+			},
+		}
+		if archived {
+			ns.Labels[kargoapi.LabelArchivedKey] = kargoapi.LabelTrueValue
+		}
+		return ns
+	}
+	newStage := func(name string, upstreams ...string) *kargoapi.Stage {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      name,
+			},
+			Spec: &kargoapi.StageSpec{
+				Subscriptions: &kargoapi.Subscriptions{},
+			},
+		}
+		for _, upstream := range upstreams {
+			stage.Spec.Subscriptions.UpstreamStages = append(
+				stage.Spec.Subscriptions.UpstreamStages,
+				kargoapi.StageSubscription{Name: upstream},
+			)
+		}
+		return stage
+	}
+	newFreight := func(namespace string) *kargoapi.Freight {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "fake-freight",
+			},
+		}
+	}
+	// The soaking Stage has been Healthy with the Freight for less time than
+	// its qualification policy requires, and is still awaiting verification.
+	soakingStage := newStage("soaking")
+	soakingStage.Spec.Qualification = &kargoapi.QualificationPolicy{
+		Criteria: []kargoapi.QualificationCriterion{
+			{
+				Healthy: &kargoapi.HealthyCriterion{
+					For: &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+			{
+				Verification: &kargoapi.VerificationCriterion{Provider: "smoke"},
+			},
+		},
+	}
+	soakingStage.Status.CurrentFreight = &kargoapi.SimpleFreight{ID: "fake-freight"}
+	soakingStage.Status.Health = &kargoapi.Health{
+		Status:       kargoapi.HealthStateHealthy,
+		HealthySince: &metav1.Time{Time: now.Add(-time.Minute)},
+	}
+	gateByName := func(
+		res *connect.Response[svcv1alpha1.ExplainPromotionEligibilityResponse],
+		name string,
+	) *svcv1alpha1.PromotionGate {
+		for _, gate := range res.Msg.GetGates() {
+			if gate.GetName() == name {
+				return gate
+			}
+		}
+		require.Failf(t, "gate not found", "no gate named %q", name)
+		return nil
+	}
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.ExplainPromotionEligibilityRequest
+		readOnly   bool
+		objects    []client.Object
+		assertions func(*connect.Response[svcv1alpha1.ExplainPromotionEligibilityResponse], error)
+	}{
+		{
+			name: "freight not specified",
+			req: &svcv1alpha1.ExplainPromotionEligibilityRequest{
+				Project: testProject,
+				Stage:   "test",
+			},
+			objects: []client.Object{newNamespace(testProject, false)},
+			assertions: func(_ *connect.Response[svcv1alpha1.ExplainPromotionEligibilityResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Stage not found",
+			req: &svcv1alpha1.ExplainPromotionEligibilityRequest{
+				Project: testProject,
+				Stage:   "test",
+				Freight: "fake-freight",
+			},
+			objects: []client.Object{newNamespace(testProject, false)},
+			assertions: func(_ *connect.Response[svcv1alpha1.ExplainPromotionEligibilityResponse], err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Freight belongs to another Project",
+			req: &svcv1alpha1.ExplainPromotionEligibilityRequest{
+				Project: testProject,
+				Stage:   "test",
+				Freight: "fake-freight",
+			},
+			objects: []client.Object{
+				newNamespace(testProject, false),
+				newNamespace("other-project", false),
+				newStage("test"),
+				newFreight("other-project"),
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.ExplainPromotionEligibilityResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Msg.GetEligible())
+				gate := gateByName(res, "FreightExists")
+				require.Equal(t, "Blocked", gate.GetStatus())
+				require.Len(t, gate.GetDetails(), 1)
+				require.Contains(t, gate.GetDetails()[0], `belongs to Project "other-project"`)
+				require.Equal(t, "NotEvaluated", gateByName(res, "Qualified").GetStatus())
+			},
+		},
+		{
+			name: "Freight approved for Stage",
+			req: &svcv1alpha1.ExplainPromotionEligibilityRequest{
+				Project: testProject,
+				Stage:   "test",
+				Freight: "fake-freight",
+			},
+			objects: []client.Object{
+				newNamespace(testProject, false),
+				func() client.Object {
+					stage := newStage("test", "soaking")
+					stage.Spec.PromotionMechanisms = &kargoapi.PromotionMechanisms{
+						GitRepoUpdates: []kargoapi.GitRepoUpdate{
+							{RepoURL: "https://github.com/example/repo"},
+						},
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{AppNamespace: "argocd", AppName: "fake-app"},
+						},
+					}
+					stage.Status.Health = &kargoapi.Health{
+						ArgoCDApps: []kargoapi.ArgoCDAppStatus{
+							{Namespace: "argocd", Name: "fake-app", Cluster: "in-cluster"},
+						},
+					}
+					return stage
+				}(),
+				soakingStage.DeepCopy(),
+				func() client.Object {
+					freight := newFreight(testProject)
+					freight.Status.Approvals = map[string]kargoapi.Approval{"test": {}}
+					return freight
+				}(),
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.ExplainPromotionEligibilityResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, res.Msg.GetEligible())
+				require.Len(t, res.Msg.GetGates(), 5)
+				for _, gate := range res.Msg.GetGates() {
+					require.Equal(t, "Passed", gate.GetStatus(), gate.GetName())
+				}
+				require.Contains(t, gateByName(res, "Qualified").GetReason(), "manually approved")
+				impact := res.Msg.GetImpact()
+				require.Equal(t, []string{"https://github.com/example/repo"}, impact.GetRepos())
+				require.Len(t, impact.GetArgocdApps(), 1)
+				require.Equal(t, "fake-app", impact.GetArgocdApps()[0].GetName())
+				require.Equal(t, []string{"in-cluster"}, impact.GetClusters())
+			},
+		},
+		{
+			name: "Freight not qualified upstream",
+			req: &svcv1alpha1.ExplainPromotionEligibilityRequest{
+				Project: testProject,
+				Stage:   "test",
+				Freight: "fake-freight",
+			},
+			objects: []client.Object{
+				newNamespace(testProject, false),
+				newStage("test", "soaking", "other", "missing"),
+				soakingStage.DeepCopy(),
+				newStage("other"),
+				newFreight(testProject),
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.ExplainPromotionEligibilityResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Msg.GetEligible())
+				require.Equal(t, "Passed", gateByName(res, "FreightExists").GetStatus())
+				gate := gateByName(res, "Qualified")
+				require.Equal(t, "Blocked", gate.GetStatus())
+				require.Equal(
+					t,
+					[]string{
+						`Stage "soaking": Stage has not yet been Healthy for 10m0s; ` +
+							`Freight has not yet been verified by provider "smoke"`,
+						`Stage "other": Freight has not been promoted to the Stage`,
+						`Stage "missing": Stage not found`,
+					},
+					gate.GetDetails(),
+				)
+			},
+		},
+		{
+			name: "Project archived",
+			req: &svcv1alpha1.ExplainPromotionEligibilityRequest{
+				Project: testProject,
+				Stage:   "test",
+				Freight: "fake-freight",
+			},
+			objects: []client.Object{
+				newNamespace(testProject, true),
+				newStage("test"),
+				newFreight(testProject),
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.ExplainPromotionEligibilityResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Msg.GetEligible())
+				require.Equal(t, "Blocked", gateByName(res, "ProjectNotArchived").GetStatus())
+				require.Equal(t, "Passed", gateByName(res, "Qualified").GetStatus())
+			},
+		},
+		{
+			name: "Freight exceeds maximum age",
+			req: &svcv1alpha1.ExplainPromotionEligibilityRequest{
+				Project: testProject,
+				Stage:   "test",
+				Freight: "fake-freight",
+			},
+			readOnly: true,
+			objects: []client.Object{
+				newNamespace(testProject, false),
+				newStage("test"),
+				func() client.Object {
+					freight := newFreight(testProject)
+					freight.CreationTimestamp = metav1.Time{Time: now.Add(-48 * time.Hour)}
+					return freight
+				}(),
+				&kargoapi.PromotionPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-policy",
+					},
+					Stage:         "test",
+					MaxFreightAge: &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.ExplainPromotionEligibilityResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Msg.GetEligible())
+				gate := gateByName(res, "FreightAge")
+				require.Equal(t, "Blocked", gate.GetStatus())
+				require.Contains(t, gate.GetReason(), "is 48h0m0s old")
+				require.Contains(t, gate.GetDetails()[0], `"promote-stale" verb`)
+			},
+		},
+		{
+			name: "user not permitted to promote",
+			req: &svcv1alpha1.ExplainPromotionEligibilityRequest{
+				Project: testProject,
+				Stage:   "test",
+				Freight: "fake-freight",
+			},
+			readOnly: true,
+			objects: []client.Object{
+				newNamespace(testProject, false),
+				newStage("test"),
+				newFreight(testProject),
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.ExplainPromotionEligibilityResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Msg.GetEligible())
+				require.Equal(t, "Blocked", gateByName(res, "Authorized").GetStatus())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client, unless the test case calls for a read-only user.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin:  !testCase.readOnly,
+					ReadOnly: testCase.readOnly,
+				},
+			)
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(testCase.objects...).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			svr := &server{
+				client: kubeClient,
+			}
+			svr.validateProjectFn = svr.validateProject
+			svr.externalValidateProjectFn = validation.ValidateProject
+			svr.getStageFn = kargoapi.GetStage
+			svr.getFreightFn = kargoapi.GetFreight
+			svr.findFreightProjectsFn = svr.findFreightProjects
+			svr.isProjectArchivedFn = kargoapi.IsProjectArchived
+			svr.nowFn = func() time.Time { return now }
+			res, err := svr.ExplainPromotionEligibility(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(res, err)
+		})
+	}
+}
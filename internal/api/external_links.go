@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/config"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+// toStageProto returns a protobuf representation of the provided Stage,
+// including any external links configured for the installation.
+func (s *server) toStageProto(ctx context.Context, stage kargoapi.Stage) *v1alpha1.Stage {
+	res := typesv1alpha1.ToStageProto(stage)
+	data := config.ExternalLinkData{
+		Project: stage.Namespace,
+		Stage:   stage.Name,
+	}
+	if stage.Status.CurrentFreight != nil {
+		data.Freight = stage.Status.CurrentFreight.ID
+	}
+	res.ExternalLinks = s.renderExternalLinks(ctx, data)
+	return res
+}
+
+// toPromotionProto returns a protobuf representation of the provided
+// Promotion, including any external links configured for the installation.
+func (s *server) toPromotionProto(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+) *v1alpha1.Promotion {
+	res := typesv1alpha1.ToPromotionProto(promo)
+	data := config.ExternalLinkData{
+		Project:   promo.Namespace,
+		Promotion: promo.Name,
+	}
+	if promo.Spec != nil {
+		data.Stage = promo.Spec.Stage
+		data.Freight = promo.Spec.Freight
+	}
+	res.ExternalLinks = s.renderExternalLinks(ctx, data)
+	return res
+}
+
+// renderExternalLinks renders all external links configured for the
+// installation using the provided data. Links that cannot be rendered are
+// omitted.
+func (s *server) renderExternalLinks(
+	ctx context.Context,
+	data config.ExternalLinkData,
+) []*v1alpha1.ExternalLink {
+	if len(s.cfg.ExternalLinks) == 0 {
+		return nil
+	}
+	links := make([]*v1alpha1.ExternalLink, 0, len(s.cfg.ExternalLinks))
+	for _, tmpl := range s.cfg.ExternalLinks {
+		url, err := tmpl.Render(data)
+		if err != nil {
+			logging.LoggerFromContext(ctx).WithField("link", tmpl.Name).
+				Errorf("error rendering external link: %s", err)
+			continue
+		}
+		links = append(links, &v1alpha1.ExternalLink{
+			Name: tmpl.Name,
+			Url:  url,
+		})
+	}
+	return links
+}
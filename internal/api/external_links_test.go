@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+func TestExternalLinks(t *testing.T) {
+	var links config.ExternalLinkTemplates
+	require.NoError(t, links.Decode(`[
+		{"name":"Logs","url":"https://logs.example.com/{{.Project}}/{{.Stage}}?freight={{.Freight}}"},
+		{"name":"Promotion","url":"https://ci.example.com/{{.Promotion}}"}
+	]`))
+	s := &server{
+		cfg: config.ServerConfig{
+			StandardConfig: config.StandardConfig{
+				ExternalLinks: links,
+			},
+		},
+	}
+
+	stage := s.toStageProto(
+		context.Background(),
+		kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kargo-demo",
+				Name:      "test",
+			},
+			Spec: &kargoapi.StageSpec{
+				Subscriptions: &kargoapi.Subscriptions{},
+			},
+			Status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{ID: "fake-freight"},
+			},
+		},
+	)
+	require.Equal(
+		t,
+		[]*v1alpha1.ExternalLink{
+			{
+				Name: "Logs",
+				Url:  "https://logs.example.com/kargo-demo/test?freight=fake-freight",
+			},
+			{
+				Name: "Promotion",
+				Url:  "https://ci.example.com/",
+			},
+		},
+		stage.GetExternalLinks(),
+	)
+
+	promo := s.toPromotionProto(
+		context.Background(),
+		kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kargo-demo",
+				Name:      "fake-promo",
+			},
+			Spec: &kargoapi.PromotionSpec{
+				Stage:   "uat",
+				Freight: "other-freight",
+			},
+		},
+	)
+	require.Equal(
+		t,
+		[]*v1alpha1.ExternalLink{
+			{
+				Name: "Logs",
+				Url:  "https://logs.example.com/kargo-demo/uat?freight=other-freight",
+			},
+			{
+				Name: "Promotion",
+				Url:  "https://ci.example.com/fake-promo",
+			},
+		},
+		promo.GetExternalLinks(),
+	)
+
+	require.Empty(
+		t,
+		(&server{}).toPromotionProto(
+			context.Background(),
+			kargoapi.Promotion{Spec: &kargoapi.PromotionSpec{}},
+		).GetExternalLinks(),
+	)
+}
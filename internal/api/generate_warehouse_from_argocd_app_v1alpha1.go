@@ -0,0 +1,208 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) GenerateWarehouseFromArgoCDApp(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.GenerateWarehouseFromArgoCDAppRequest],
+) (*connect.Response[svcv1alpha1.GenerateWarehouseFromArgoCDAppResponse], error) {
+	project := req.Msg.GetProject()
+	if project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	appName := req.Msg.GetAppName()
+	if appName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("app_name should not be empty"))
+	}
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	update := kargoapi.ArgoCDAppUpdate{
+		AppName:      appName,
+		AppNamespace: req.Msg.GetAppNamespace(),
+	}
+	var app argocd.Application
+	if err := s.client.Get(ctx, client.ObjectKey{
+		Namespace: update.AppNamespaceOrDefault(),
+		Name:      appName,
+	}, &app); err != nil {
+		if kubeerr.IsNotFound(err) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "get Argo CD Application"))
+	}
+
+	name := req.Msg.GetName()
+	if name == "" {
+		name = appName
+	}
+	warehouse, stage := resourcesForArgoCDApp(project, name, update, &app)
+	if len(warehouse.Spec.Subscriptions) == 0 {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"Argo CD Application %q has no sources or images to subscribe to",
+				appName,
+			),
+		)
+	}
+
+	var buf bytes.Buffer
+	for _, r := range []struct {
+		obj  runtime.Object
+		kind string
+	}{
+		{obj: warehouse, kind: "Warehouse"},
+		{obj: stage, kind: "Stage"},
+	} {
+		doc, err := exportObject(r.obj, r.kind)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		buf.WriteString("---\n")
+		buf.Write(doc)
+	}
+	return connect.NewResponse(&svcv1alpha1.GenerateWarehouseFromArgoCDAppResponse{
+		Manifest: buf.Bytes(),
+	}), nil
+}
+
+// resourcesForArgoCDApp returns a Warehouse that subscribes to each of the
+// provided Argo CD Application's sources and to the repositories of the images
+// it is running, and a Stage that subscribes to that Warehouse and promotes
+// Freight by applying the provided update, completed with updates to each of
+// the Application's sources, to the Application. Both are named name.
+func resourcesForArgoCDApp(
+	project string,
+	name string,
+	update kargoapi.ArgoCDAppUpdate,
+	app *argocd.Application,
+) (*kargoapi.Warehouse, *kargoapi.Stage) {
+	sources := app.Spec.Sources
+	if len(sources) == 0 && app.Spec.Source != nil {
+		sources = argocd.ApplicationSources{*app.Spec.Source}
+	}
+
+	// Map each image the Application is running to its repository, in the order
+	// in which Argo CD reports them
+	var imageRepos []string
+	reposByImage := make(map[string]string, len(app.Status.Summary.Images))
+	seenRepos := make(map[string]struct{}, len(app.Status.Summary.Images))
+	for _, image := range app.Status.Summary.Images {
+		repo := imageRepoURL(image)
+		reposByImage[image] = repo
+		if _, seen := seenRepos[repo]; !seen {
+			seenRepos[repo] = struct{}{}
+			imageRepos = append(imageRepos, repo)
+		}
+	}
+
+	subs := make([]kargoapi.RepoSubscription, 0, len(sources)+len(imageRepos))
+	for _, src := range sources {
+		srcUpdate := kargoapi.ArgoCDSourceUpdate{
+			RepoURL:              src.RepoURL,
+			UpdateTargetRevision: true,
+		}
+		if src.Chart != "" {
+			registryURL := src.RepoURL
+			if !strings.Contains(registryURL, "://") {
+				// Argo CD omits the scheme of OCI registries
+				registryURL = "oci://" + registryURL
+			}
+			subs = append(subs, kargoapi.RepoSubscription{
+				Chart: &kargoapi.ChartSubscription{
+					RegistryURL: registryURL,
+					Name:        src.Chart,
+				},
+			})
+			srcUpdate.Chart = src.Chart
+		} else {
+			sub := &kargoapi.GitSubscription{RepoURL: src.RepoURL}
+			if src.TargetRevision != "HEAD" {
+				sub.Branch = src.TargetRevision
+			}
+			subs = append(subs, kargoapi.RepoSubscription{Git: sub})
+		}
+		if src.Kustomize != nil && len(imageRepos) > 0 {
+			srcUpdate.Kustomize = &kargoapi.ArgoCDKustomize{
+				Images: imageRepos,
+			}
+		}
+		if src.Helm != nil {
+			// Only parameters that are set to one of the images the Application is
+			// running can be recognized as referring to an image
+			var images []kargoapi.ArgoCDHelmImageUpdate
+			for _, param := range src.Helm.Parameters {
+				if repo, ok := reposByImage[param.Value]; ok {
+					images = append(images, kargoapi.ArgoCDHelmImageUpdate{
+						Image: repo,
+						Key:   param.Name,
+						Value: kargoapi.ImageUpdateValueTypeImage,
+					})
+				}
+			}
+			if len(images) > 0 {
+				srcUpdate.Helm = &kargoapi.ArgoCDHelm{Images: images}
+			}
+		}
+		update.SourceUpdates = append(update.SourceUpdates, srcUpdate)
+	}
+	for _, repo := range imageRepos {
+		subs = append(subs, kargoapi.RepoSubscription{
+			Image: &kargoapi.ImageSubscription{RepoURL: repo},
+		})
+	}
+
+	warehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: project,
+			Name:      name,
+		},
+		Spec: &kargoapi.WarehouseSpec{
+			Subscriptions: subs,
+		},
+	}
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: project,
+			Name:      name,
+		},
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				Warehouse: name,
+			},
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{update},
+			},
+		},
+	}
+	return warehouse, stage
+}
+
+// imageRepoURL returns the provided image reference stripped of any tag or
+// digest.
+func imageRepoURL(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
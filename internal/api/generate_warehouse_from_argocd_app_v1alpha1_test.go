@@ -0,0 +1,229 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestGenerateWarehouseFromArgoCDApp(t *testing.T) {
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.GenerateWarehouseFromArgoCDAppRequest
+		objects    []client.Object
+		assertions func(*connect.Response[svcv1alpha1.GenerateWarehouseFromArgoCDAppResponse], error)
+	}{
+		{
+			name: "project not specified",
+			req: &svcv1alpha1.GenerateWarehouseFromArgoCDAppRequest{
+				AppName: "guestbook",
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.GenerateWarehouseFromArgoCDAppResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "app name not specified",
+			req: &svcv1alpha1.GenerateWarehouseFromArgoCDAppRequest{
+				Project: "kargo-demo",
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.GenerateWarehouseFromArgoCDAppResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "app not found",
+			req: &svcv1alpha1.GenerateWarehouseFromArgoCDAppRequest{
+				Project: "kargo-demo",
+				AppName: "guestbook",
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.GenerateWarehouseFromArgoCDAppResponse], err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "app has nothing to subscribe to",
+			req: &svcv1alpha1.GenerateWarehouseFromArgoCDAppRequest{
+				Project: "kargo-demo",
+				AppName: "guestbook",
+			},
+			objects: []client.Object{
+				&argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "argocd",
+						Name:      "guestbook",
+					},
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.GenerateWarehouseFromArgoCDAppResponse], err error) {
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.GenerateWarehouseFromArgoCDAppRequest{
+				Project:      "kargo-demo",
+				AppName:      "guestbook",
+				AppNamespace: pointer.String("apps"),
+				Name:         pointer.String("test"),
+			},
+			objects: []client.Object{
+				&argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "apps",
+						Name:      "guestbook",
+					},
+					Spec: argocd.ApplicationSpec{
+						Sources: argocd.ApplicationSources{
+							{
+								RepoURL:        "https://github.com/example/guestbook",
+								TargetRevision: "main",
+								Kustomize:      &argocd.ApplicationSourceKustomize{},
+							},
+							{
+								RepoURL:        "ghcr.io/example/charts",
+								Chart:          "redis",
+								TargetRevision: "1.0.0",
+								Helm: &argocd.ApplicationSourceHelm{
+									Parameters: []argocd.HelmParameter{
+										{Name: "image", Value: "redis:7.2.0"},
+										{Name: "replicas", Value: "3"},
+									},
+								},
+							},
+						},
+					},
+					Status: argocd.ApplicationStatus{
+						Summary: argocd.ApplicationSummary{
+							Images: []string{
+								"ghcr.io/example/guestbook:v1.0.0",
+								"redis:7.2.0",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.GenerateWarehouseFromArgoCDAppResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: test
+  namespace: kargo-demo
+spec:
+  subscriptions:
+  - git:
+      branch: main
+      repoURL: https://github.com/example/guestbook
+  - chart:
+      name: redis
+      registryURL: oci://ghcr.io/example/charts
+  - image:
+      repoURL: ghcr.io/example/guestbook
+  - image:
+      repoURL: redis
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: test
+  namespace: kargo-demo
+spec:
+  promotionMechanisms:
+    argoCDAppUpdates:
+    - appName: guestbook
+      appNamespace: apps
+      sourceUpdates:
+      - kustomize:
+          images:
+          - ghcr.io/example/guestbook
+          - redis
+        repoURL: https://github.com/example/guestbook
+        updateTargetRevision: true
+      - chart: redis
+        helm:
+          images:
+          - image: redis
+            key: image
+            value: Image
+        repoURL: ghcr.io/example/charts
+        updateTargetRevision: true
+  subscriptions:
+    warehouse: test
+`,
+					string(res.Msg.GetManifest()),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(testCase.objects...).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			svr := &server{
+				client:            kubeClient,
+				validateProjectFn: func(context.Context, string) error { return nil },
+			}
+			res, err := svr.GenerateWarehouseFromArgoCDApp(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(res, err)
+		})
+	}
+}
+
+func TestImageRepoURL(t *testing.T) {
+	testCases := map[string]string{
+		"nginx":                             "nginx",
+		"nginx:1.25.0":                      "nginx",
+		"localhost:5000/nginx":              "localhost:5000/nginx",
+		"localhost:5000/nginx:1.25.0":       "localhost:5000/nginx",
+		"ghcr.io/example/app@sha256:abc":    "ghcr.io/example/app",
+		"ghcr.io/example/app:v1@sha256:abc": "ghcr.io/example/app",
+	}
+	for image, expected := range testCases {
+		t.Run(image, func(t *testing.T) {
+			require.Equal(t, expected, imageRepoURL(image))
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// backstageCatalogAnnotationKeyProject is the key of the annotation by which a
+// Backstage catalog entity refers to a Kargo Project.
+const backstageCatalogAnnotationKeyProject = "kargo.akuity.io/project"
+
+// GetBackstageEntityStatus reports the status of every pipeline that deploys
+// the specified Backstage catalog entity, in a shape that is convenient for
+// Backstage plugins to render. A pipeline is the set of Stages of a Project
+// whose namespace is annotated as deploying the entity, less any of those
+// Stages that are themselves annotated as deploying only other entities.
+func (s *server) GetBackstageEntityStatus(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.GetBackstageEntityStatusRequest],
+) (*connect.Response[svcv1alpha1.GetBackstageEntityStatusResponse], error) {
+	entityRef := kargoapi.NormalizeBackstageEntityRef(req.Msg.GetEntityRef())
+	if entityRef == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("entity_ref should not be empty"),
+		)
+	}
+
+	selector := labels.Set{kargoapi.LabelProjectKey: kargoapi.LabelTrueValue}.AsSelector()
+	nsList := &corev1.NamespaceList{}
+	if err := s.client.List(ctx, nsList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &svcv1alpha1.GetBackstageEntityStatusResponse{
+		EntityRef: entityRef,
+	}
+	for _, ns := range nsList.Items {
+		if !slices.Contains(kargoapi.BackstageEntityRefs(ns.Annotations), entityRef) {
+			continue
+		}
+		pipeline, err := s.getBackstagePipeline(ctx, ns.Name, entityRef)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		res.Pipelines = append(res.Pipelines, pipeline)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// getBackstagePipeline returns the status of the Stages of the specified
+// Project that deploy the specified Backstage catalog entity.
+func (s *server) getBackstagePipeline(
+	ctx context.Context,
+	project string,
+	entityRef string,
+) (*svcv1alpha1.BackstagePipeline, error) {
+	var stages kargoapi.StageList
+	if err := s.client.List(ctx, &stages, client.InNamespace(project)); err != nil {
+		return nil, errors.Wrapf(err, "error listing Stages in Project %q", project)
+	}
+	pipeline := &svcv1alpha1.BackstagePipeline{
+		Project: project,
+	}
+	status := kargoapi.HealthStateHealthy
+	for _, stage := range stages.Items {
+		// A Stage that is not annotated deploys every entity its Project does
+		if refs := kargoapi.BackstageEntityRefs(stage.Annotations); refs != nil &&
+			!slices.Contains(refs, entityRef) {
+			continue
+		}
+		deployment := newBackstageDeployment(stage)
+		status = status.Merge(kargoapi.HealthState(deployment.Status))
+		pipeline.Deployments = append(pipeline.Deployments, deployment)
+	}
+	if len(pipeline.Deployments) == 0 {
+		status = kargoapi.HealthStateUnknown
+	}
+	pipeline.Status = string(status)
+	return pipeline, nil
+}
+
+// newBackstageDeployment returns a description of what is deployed to the
+// provided Stage.
+func newBackstageDeployment(stage kargoapi.Stage) *svcv1alpha1.BackstageDeployment {
+	deployment := &svcv1alpha1.BackstageDeployment{
+		Stage:  stage.Name,
+		Status: string(kargoapi.HealthStateUnknown),
+	}
+	if health := stage.Status.Health; health != nil && health.Status != "" {
+		deployment.Status = string(health.Status)
+	}
+	if promo := stage.Status.CurrentPromotion; promo != nil {
+		deployment.Status = string(kargoapi.HealthStateProgressing)
+		deployment.Promotion = promo.Name
+	}
+	freight := stage.Status.CurrentFreight
+	if freight == nil {
+		return deployment
+	}
+	deployment.Freight = freight.ID
+	for _, image := range freight.Images {
+		deployment.Images = append(
+			deployment.Images,
+			fmt.Sprintf("%s:%s", image.RepoURL, image.Tag),
+		)
+	}
+	for _, commit := range freight.Commits {
+		deployment.Commits = append(
+			deployment.Commits,
+			fmt.Sprintf("%s@%s", commit.RepoURL, commit.ID),
+		)
+	}
+	for _, chart := range freight.Charts {
+		deployment.Charts = append(
+			deployment.Charts,
+			fmt.Sprintf("%s/%s:%s", chart.RegistryURL, chart.Name, chart.Version),
+		)
+	}
+	return deployment
+}
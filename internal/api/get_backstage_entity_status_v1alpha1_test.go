@@ -0,0 +1,202 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestGetBackstageEntityStatus(t *testing.T) {
+	newNamespace := func(name string, entities string) *corev1.Namespace {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					kargoapi.LabelProjectKey: kargoapi.LabelTrueValue,
+				},
+			},
+		}
+		if entities != "" {
+			ns.Annotations = map[string]string{
+				kargoapi.AnnotationKeyBackstageEntities: entities,
+			}
+		}
+		return ns
+	}
+	newStage := func(
+		project string,
+		name string,
+		health kargoapi.HealthState,
+	) *kargoapi.Stage {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: project,
+				Name:      name,
+			},
+		}
+		if health != "" {
+			stage.Status.Health = &kargoapi.Health{Status: health}
+		}
+		return stage
+	}
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.GetBackstageEntityStatusRequest
+		objects    []client.Object
+		assertions func(*connect.Response[svcv1alpha1.GetBackstageEntityStatusResponse], error)
+	}{
+		{
+			name:    "entity ref not specified",
+			req:     &svcv1alpha1.GetBackstageEntityStatusRequest{},
+			objects: []client.Object{newNamespace("kargo-demo", "guestbook")},
+			assertions: func(_ *connect.Response[svcv1alpha1.GetBackstageEntityStatusResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "no Project deploys the entity",
+			req: &svcv1alpha1.GetBackstageEntityStatusRequest{
+				EntityRef: "guestbook",
+			},
+			objects: []client.Object{
+				newNamespace("kargo-demo", "shop"),
+				newStage("kargo-demo", "test", kargoapi.HealthStateHealthy),
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.GetBackstageEntityStatusResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "component:default/guestbook", res.Msg.GetEntityRef())
+				require.Empty(t, res.Msg.GetPipelines())
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.GetBackstageEntityStatusRequest{
+				EntityRef: "Component:default/GuestBook",
+			},
+			objects: []client.Object{
+				newNamespace("kargo-demo", "guestbook,shop"),
+				newNamespace("kargo-other", "guestbook"),
+				newNamespace("kargo-unrelated", ""),
+				func() client.Object {
+					stage := newStage("kargo-demo", "prod", kargoapi.HealthStateUnhealthy)
+					stage.Status.CurrentFreight = &kargoapi.SimpleFreight{
+						ID: "fake-freight",
+						Images: []kargoapi.Image{
+							{RepoURL: "nginx", Tag: "1.25.0"},
+						},
+						Commits: []kargoapi.GitCommit{
+							{RepoURL: "https://github.com/example/guestbook", ID: "abc123"},
+						},
+						Charts: []kargoapi.Chart{
+							{RegistryURL: "oci://charts.example.com", Name: "guestbook", Version: "1.0.0"},
+						},
+					}
+					return stage
+				}(),
+				func() client.Object {
+					stage := newStage("kargo-demo", "shop-prod", kargoapi.HealthStateHealthy)
+					stage.Annotations = map[string]string{
+						kargoapi.AnnotationKeyBackstageEntities: "shop",
+					}
+					return stage
+				}(),
+				func() client.Object {
+					stage := newStage("kargo-demo", "uat", kargoapi.HealthStateHealthy)
+					stage.Status.CurrentPromotion = &kargoapi.PromotionInfo{
+						Name: "fake-promotion",
+					}
+					return stage
+				}(),
+				newStage("kargo-other", "test", ""),
+				newStage("kargo-unrelated", "test", kargoapi.HealthStateHealthy),
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.GetBackstageEntityStatusResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "component:default/guestbook", res.Msg.GetEntityRef())
+				pipelines := res.Msg.GetPipelines()
+				require.Len(t, pipelines, 2)
+
+				require.Equal(t, "kargo-demo", pipelines[0].GetProject())
+				require.Equal(t, "Unhealthy", pipelines[0].GetStatus())
+				deployments := pipelines[0].GetDeployments()
+				require.Len(t, deployments, 2)
+				require.Equal(t, "prod", deployments[0].GetStage())
+				require.Equal(t, "Unhealthy", deployments[0].GetStatus())
+				require.Equal(t, "fake-freight", deployments[0].GetFreight())
+				require.Equal(t, []string{"nginx:1.25.0"}, deployments[0].GetImages())
+				require.Equal(
+					t,
+					[]string{"https://github.com/example/guestbook@abc123"},
+					deployments[0].GetCommits(),
+				)
+				require.Equal(
+					t,
+					[]string{"oci://charts.example.com/guestbook:1.0.0"},
+					deployments[0].GetCharts(),
+				)
+				require.Equal(t, "uat", deployments[1].GetStage())
+				require.Equal(t, "Progressing", deployments[1].GetStatus())
+				require.Equal(t, "fake-promotion", deployments[1].GetPromotion())
+
+				require.Equal(t, "kargo-other", pipelines[1].GetProject())
+				require.Equal(t, "Unknown", pipelines[1].GetStatus())
+				require.Len(t, pipelines[1].GetDeployments(), 1)
+				require.Equal(t, "Unknown", pipelines[1].GetDeployments()[0].GetStatus())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(testCase.objects...).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			svr := &server{
+				client: kubeClient,
+			}
+			res, err := svr.GetBackstageEntityStatus(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(res, err)
+		})
+	}
+}
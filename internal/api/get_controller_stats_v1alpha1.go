@@ -0,0 +1,172 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	metricReconcileTotal     = "controller_runtime_reconcile_total"
+	metricReconcileErrors    = "controller_runtime_reconcile_errors_total"
+	metricReconcileTime      = "controller_runtime_reconcile_time_seconds"
+	metricActiveWorkers      = "controller_runtime_active_workers"
+	metricWorkqueueDepth     = "workqueue_depth"
+	metricPromotionsInFlight = "kargo_promotions_in_flight"
+)
+
+// GetControllerStats returns a summary of the controller's current workload
+// and recent performance, derived from the controller's metrics. Only the
+// admin account may retrieve controller stats.
+func (s *server) GetControllerStats(
+	ctx context.Context,
+	_ *connect.Request[svcv1alpha1.GetControllerStatsRequest],
+) (*connect.Response[svcv1alpha1.GetControllerStatsResponse], error) {
+	if s.cfg.ControllerMetricsURL == "" {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("controller stats are not enabled"),
+		)
+	}
+	if u, ok := user.InfoFromContext(ctx); !ok || !u.IsAdmin {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("only the admin user may retrieve controller stats"),
+		)
+	}
+
+	families, err := s.getControllerMetricsFn(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	return connect.NewResponse(newControllerStatsResponse(families)), nil
+}
+
+// getControllerMetrics scrapes the controller's metrics endpoint.
+func (s *server) getControllerMetrics(
+	ctx context.Context,
+) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		s.cfg.ControllerMetricsURL,
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating request for controller metrics")
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "error retrieving controller metrics")
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf(
+			"received unexpected status code %d retrieving controller metrics",
+			res.StatusCode,
+		)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(res.Body)
+	return families, errors.Wrap(err, "error parsing controller metrics")
+}
+
+// newControllerStatsResponse summarizes the provided metrics on a
+// per-controller basis.
+func newControllerStatsResponse(
+	families map[string]*dto.MetricFamily,
+) *svcv1alpha1.GetControllerStatsResponse {
+	statsByController := map[string]*svcv1alpha1.ReconcilerStats{}
+	getStats := func(controller string) *svcv1alpha1.ReconcilerStats {
+		stats, ok := statsByController[controller]
+		if !ok {
+			stats = &svcv1alpha1.ReconcilerStats{Controller: controller}
+			statsByController[controller] = stats
+		}
+		return stats
+	}
+	forEachMetric := func(name, label string, fn func(string, *dto.Metric)) {
+		family, ok := families[name]
+		if !ok {
+			return
+		}
+		for _, metric := range family.GetMetric() {
+			for _, pair := range metric.GetLabel() {
+				if pair.GetName() == label {
+					fn(pair.GetValue(), metric)
+					break
+				}
+			}
+		}
+	}
+
+	forEachMetric(
+		metricWorkqueueDepth,
+		"name",
+		func(controller string, metric *dto.Metric) {
+			getStats(controller).QueueDepth = int64(metric.GetGauge().GetValue())
+		},
+	)
+	forEachMetric(
+		metricActiveWorkers,
+		"controller",
+		func(controller string, metric *dto.Metric) {
+			getStats(controller).ActiveWorkers = int64(metric.GetGauge().GetValue())
+		},
+	)
+	// This counter is broken down by result, so it needs to be summed
+	forEachMetric(
+		metricReconcileTotal,
+		"controller",
+		func(controller string, metric *dto.Metric) {
+			getStats(controller).ReconcilesTotal +=
+				int64(metric.GetCounter().GetValue())
+		},
+	)
+	forEachMetric(
+		metricReconcileErrors,
+		"controller",
+		func(controller string, metric *dto.Metric) {
+			getStats(controller).ErrorsTotal = int64(metric.GetCounter().GetValue())
+		},
+	)
+	forEachMetric(
+		metricReconcileTime,
+		"controller",
+		func(controller string, metric *dto.Metric) {
+			histogram := metric.GetHistogram()
+			if histogram.GetSampleCount() > 0 {
+				getStats(controller).AverageReconcileSeconds =
+					histogram.GetSampleSum() / float64(histogram.GetSampleCount())
+			}
+		},
+	)
+
+	res := &svcv1alpha1.GetControllerStatsResponse{
+		Reconcilers: make(
+			[]*svcv1alpha1.ReconcilerStats,
+			0,
+			len(statsByController),
+		),
+	}
+	for _, stats := range statsByController {
+		res.Reconcilers = append(res.Reconcilers, stats)
+	}
+	sort.Slice(res.Reconcilers, func(i, j int) bool {
+		return res.Reconcilers[i].Controller < res.Reconcilers[j].Controller
+	})
+	if family, ok := families[metricPromotionsInFlight]; ok {
+		for _, metric := range family.GetMetric() {
+			res.PromotionsInFlight += int64(metric.GetGauge().GetValue())
+		}
+	}
+	return res
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const testControllerMetrics = `
+# TYPE controller_runtime_active_workers gauge
+controller_runtime_active_workers{controller="promotion"} 1
+controller_runtime_active_workers{controller="stage"} 0
+# TYPE controller_runtime_reconcile_errors_total counter
+controller_runtime_reconcile_errors_total{controller="promotion"} 1
+controller_runtime_reconcile_errors_total{controller="stage"} 0
+# TYPE controller_runtime_reconcile_time_seconds histogram
+controller_runtime_reconcile_time_seconds_bucket{controller="promotion",le="+Inf"} 4
+controller_runtime_reconcile_time_seconds_sum{controller="promotion"} 10
+controller_runtime_reconcile_time_seconds_count{controller="promotion"} 4
+controller_runtime_reconcile_time_seconds_bucket{controller="stage",le="+Inf"} 0
+controller_runtime_reconcile_time_seconds_sum{controller="stage"} 0
+controller_runtime_reconcile_time_seconds_count{controller="stage"} 0
+# TYPE controller_runtime_reconcile_total counter
+controller_runtime_reconcile_total{controller="promotion",result="error"} 1
+controller_runtime_reconcile_total{controller="promotion",result="success"} 3
+controller_runtime_reconcile_total{controller="stage",result="success"} 0
+# TYPE kargo_promotions_in_flight gauge
+kargo_promotions_in_flight 1
+# TYPE workqueue_depth gauge
+workqueue_depth{name="promotion"} 2
+workqueue_depth{name="stage"} 5
+`
+
+func TestGetControllerStats(t *testing.T) {
+	testMetrics, err := (&expfmt.TextParser{}).
+		TextToMetricFamilies(strings.NewReader(testControllerMetrics))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		userInfo   *user.Info
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.GetControllerStatsResponse], error)
+	}{
+		{
+			name:   "controller stats are not enabled",
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.GetControllerStatsResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+			},
+		},
+		{
+			name:     "user is not the admin user",
+			userInfo: &user.Info{Username: "fake-user"},
+			server: &server{
+				cfg: config.ServerConfig{
+					StandardConfig: config.StandardConfig{
+						ControllerMetricsURL: "http://fake-controller/metrics",
+					},
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.GetControllerStatsResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			},
+		},
+		{
+			name:     "error getting controller metrics",
+			userInfo: &user.Info{IsAdmin: true},
+			server: &server{
+				cfg: config.ServerConfig{
+					StandardConfig: config.StandardConfig{
+						ControllerMetricsURL: "http://fake-controller/metrics",
+					},
+				},
+				getControllerMetricsFn: func(
+					context.Context,
+				) (map[string]*dto.MetricFamily, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.GetControllerStatsResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name:     "success",
+			userInfo: &user.Info{IsAdmin: true},
+			server: &server{
+				cfg: config.ServerConfig{
+					StandardConfig: config.StandardConfig{
+						ControllerMetricsURL: "http://fake-controller/metrics",
+					},
+				},
+				getControllerMetricsFn: func(
+					context.Context,
+				) (map[string]*dto.MetricFamily, error) {
+					return testMetrics, nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.GetControllerStatsResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, int64(1), res.Msg.GetPromotionsInFlight())
+				reconcilers := res.Msg.GetReconcilers()
+				require.Len(t, reconcilers, 2)
+
+				promotion := reconcilers[0]
+				require.Equal(t, "promotion", promotion.GetController())
+				require.Equal(t, int64(2), promotion.GetQueueDepth())
+				require.Equal(t, int64(1), promotion.GetActiveWorkers())
+				require.Equal(t, int64(4), promotion.GetReconcilesTotal())
+				require.Equal(t, int64(1), promotion.GetErrorsTotal())
+				require.Equal(t, 2.5, promotion.GetAverageReconcileSeconds())
+
+				stage := reconcilers[1]
+				require.Equal(t, "stage", stage.GetController())
+				require.Equal(t, int64(5), stage.GetQueueDepth())
+				require.Zero(t, stage.GetReconcilesTotal())
+				require.Zero(t, stage.GetAverageReconcileSeconds())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.userInfo != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.userInfo)
+			}
+			testCase.assertions(
+				testCase.server.GetControllerStats(
+					ctx,
+					connect.NewRequest(&svcv1alpha1.GetControllerStatsRequest{}),
+				),
+			)
+		})
+	}
+}
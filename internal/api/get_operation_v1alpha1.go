@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) GetOperation(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.GetOperationRequest],
+) (*connect.Response[svcv1alpha1.GetOperationResponse], error) {
+	if req.Msg.GetId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id should not be empty"))
+	}
+	op, err := s.operations.Get(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, operationError(err)
+	}
+	return connect.NewResponse(&svcv1alpha1.GetOperationResponse{
+		Operation: toOperationProto(op),
+	}), nil
+}
@@ -9,7 +9,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
-	"github.com/akuity/kargo/internal/api/types/v1alpha1"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -38,6 +37,6 @@ func (s *server) GetPromotion(
 		}
 	}
 	return connect.NewResponse(&svcv1alpha1.GetPromotionResponse{
-		Promotion: v1alpha1.ToPromotionProto(promotion),
+		Promotion: s.toPromotionProto(ctx, promotion),
 	}), nil
 }
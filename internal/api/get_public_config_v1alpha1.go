@@ -23,7 +23,9 @@ func (s *server) GetPublicConfig(
 	}
 	resp := &svcv1alpha1.GetPublicConfigResponse{
 		AdminAccountEnabled: s.cfg.AdminConfig != nil,
-		OidcConfig:          oidcCfg,
+		KubernetesLoginEnabled: s.cfg.KubernetesLoginConfig != nil &&
+			s.cfg.AdminConfig != nil,
+		OidcConfig: oidcCfg,
 	}
 	return connect.NewResponse(resp), nil
 }
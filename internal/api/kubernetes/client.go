@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -22,9 +23,14 @@ import (
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/api/user"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// ErrNotAllowed is returned by Client operations that the context-bound user
+// is not permitted to perform.
+var ErrNotAllowed = errors.New("not allowed")
+
 // ClientOptions specifies options for customizing the client returned by the
 // NewClient function.
 type ClientOptions struct {
@@ -47,6 +53,26 @@ type ClientOptions struct {
 	// nil/unspecified, in which case, the NewClient function to which this struct
 	// is passed will supply its own default implementation.
 	NewInternalDynamicClient func(*rest.Config) (dynamic.Interface, error)
+	// NewInternalWriteClient may be used to take control of how the
+	// controller-runtime client used for writes is created when ReadRESTConfig
+	// is specified. This is mainly useful for tests. Ordinarily, the value of
+	// this field should be left as nil/unspecified, in which case, the
+	// NewClient function to which this struct is passed will supply its own
+	// default implementation, which is an uncached client.
+	NewInternalWriteClient func(*rest.Config, *runtime.Scheme) (libClient.Client, error)
+	// ReadRESTConfig optionally specifies a separate REST config to be used for
+	// all reads (get, list, and watch); e.g. to direct them to a read-optimized
+	// replica of the Kubernetes API server on very large clusters. Writes,
+	// including the access reviews used to enforce RBAC, are always performed
+	// using the REST config passed to the NewClient function. If nil, that REST
+	// config is used for reads as well.
+	ReadRESTConfig *rest.Config
+	// ReadFromWatchCache specifies whether reads that are not served by the
+	// internal client's own cache, including the initial state of watches,
+	// should be served from the Kubernetes API server's watch cache instead of
+	// requiring a consistent read from etcd. This reduces load on very large
+	// clusters at the expense of such reads possibly being slightly stale.
+	ReadFromWatchCache bool
 	// Scheme may be used to take control of the scheme used by the client's own
 	// internal/underlying controller-runtime client. Ordinarily, the value of
 	// this field should be left as nil/unspecified, in which case, the NewClient
@@ -55,6 +81,16 @@ type ClientOptions struct {
 	Scheme *runtime.Scheme
 }
 
+// UncachedObjects are the kinds of objects that the Kargo API server only reads
+// occasionally, so reads of them should bypass the internal client's cache
+// rather than maintain a cache of every such object in the cluster. Secrets, in
+// particular, are only ever listed by label within a single Project namespace,
+// which is all the API server's RBAC permits.
+var UncachedObjects = []libClient.Object{
+	&argocd.Application{},
+	&corev1.Secret{},
+}
+
 // setOptionsDefaults sets default values for any unspecified fields in the
 // provided ClientOptions struct.
 func setOptionsDefaults(opts ClientOptions) (ClientOptions, error) {
@@ -67,6 +103,9 @@ func setOptionsDefaults(opts ClientOptions) (ClientOptions, error) {
 		if err := kargoapi.AddToScheme(opts.Scheme); err != nil {
 			return opts, errors.Wrap(err, "error adding Kargo API to scheme")
 		}
+		if err := argocd.AddToScheme(opts.Scheme); err != nil {
+			return opts, errors.Wrap(err, "error adding Argo CD API to scheme")
+		}
 	}
 	if opts.NewInternalClient == nil {
 		opts.NewInternalClient = newDefaultInternalClient
@@ -74,6 +113,9 @@ func setOptionsDefaults(opts ClientOptions) (ClientOptions, error) {
 	if opts.NewInternalDynamicClient == nil {
 		opts.NewInternalDynamicClient = dynamic.NewForConfig
 	}
+	if opts.NewInternalWriteClient == nil {
+		opts.NewInternalWriteClient = newDefaultInternalWriteClient
+	}
 	return opts, nil
 }
 
@@ -89,6 +131,11 @@ type Client interface {
 		namespace string,
 		opts metav1.ListOptions,
 	) (watch.Interface, error)
+	// Authorize returns ErrNotAllowed if the context-bound user is not
+	// permitted to perform the specified verb on the provided object. This is
+	// useful for verbs, like "promote", that do not correspond to any operation
+	// the Client itself performs.
+	Authorize(ctx context.Context, verb string, obj libClient.Object) error
 }
 
 // client implements Client.
@@ -96,6 +143,7 @@ type client struct {
 	internalClient        libClient.Client
 	statusWriter          *authorizingStatusWriterWrapper
 	internalDynamicClient dynamic.Interface
+	readFromWatchCache    bool
 
 	getAuthorizedClientFn func(
 		ctx context.Context,
@@ -128,13 +176,32 @@ func NewClient(
 	if opts, err = setOptionsDefaults(opts); err != nil {
 		return nil, errors.Wrap(err, "error setting client options defaults")
 	}
+	readRESTCfg := restCfg
+	if opts.ReadRESTConfig != nil {
+		readRESTCfg = opts.ReadRESTConfig
+	}
 	internalClient, err :=
-		opts.NewInternalClient(ctx, restCfg, opts.Scheme)
+		opts.NewInternalClient(ctx, readRESTCfg, opts.Scheme)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building internal client")
 	}
+	if opts.ReadFromWatchCache {
+		internalClient = &watchCacheClient{Client: internalClient}
+	}
+	if opts.ReadRESTConfig != nil {
+		// The internal client built above talks exclusively to the read
+		// endpoint, so writes need a client of their own
+		writeClient, err := opts.NewInternalWriteClient(restCfg, opts.Scheme)
+		if err != nil {
+			return nil, errors.Wrap(err, "error building internal write client")
+		}
+		internalClient = &splitClient{
+			Client: writeClient,
+			reader: internalClient,
+		}
+	}
 	internalDynamicClient, err :=
-		opts.NewInternalDynamicClient(restCfg)
+		opts.NewInternalDynamicClient(readRESTCfg)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building internal dynamic client")
 	}
@@ -145,6 +212,7 @@ func NewClient(
 			getAuthorizedClientFn: getAuthorizedClient,
 		},
 		internalDynamicClient: internalDynamicClient,
+		readFromWatchCache:    opts.ReadFromWatchCache,
 		getAuthorizedClientFn: getAuthorizedClient,
 	}, nil
 }
@@ -158,6 +226,7 @@ func newDefaultInternalClient(
 		restCfg,
 		func(clusterOptions *libCluster.Options) {
 			clusterOptions.Scheme = scheme
+			clusterOptions.ClientDisableCacheFor = UncachedObjects
 		},
 	)
 	if err != nil {
@@ -358,6 +427,26 @@ func (c *client) DeleteAllOf(
 	return client.DeleteAllOf(ctx, obj, opts...)
 }
 
+func (c *client) Authorize(
+	ctx context.Context,
+	verb string,
+	obj libClient.Object,
+) error {
+	gvr, key, err := gvrAndKeyFromObj(obj, obj, c.internalClient.Scheme())
+	if err != nil {
+		return err
+	}
+	_, err = c.getAuthorizedClientFn(
+		ctx,
+		c.internalClient,
+		verb,
+		gvr,
+		"", // No subresource
+		*key,
+	)
+	return err
+}
+
 func (c *client) Status() libClient.StatusWriter {
 	return c.statusWriter
 }
@@ -459,6 +548,9 @@ func (c *client) Watch(
 	} else {
 		ri = c.internalDynamicClient.Resource(gvr)
 	}
+	if c.readFromWatchCache && opts.ResourceVersion == "" {
+		opts.ResourceVersion = watchCacheResourceVersion
+	}
 	return ri.Watch(ctx, opts)
 }
 
@@ -520,7 +612,22 @@ func getAuthorizedClient(
 ) (libClient.Client, error) {
 	userInfo, ok := user.InfoFromContext(ctx)
 	if !ok {
-		return nil, errors.New("not allowed")
+		return nil, ErrNotAllowed
+	}
+
+	// Users restricted to read-only access get to use the Kargo API server's own
+	// Kubernetes client, but only for operations that don't mutate anything and
+	// never for reading Secrets, which the server can read in every Project.
+	if userInfo.ReadOnly {
+		if gvr.Group == "" && gvr.Resource == "secrets" {
+			return nil, ErrNotAllowed
+		}
+		switch verb {
+		case "get", "list", "watch":
+			return internalClient, nil
+		default:
+			return nil, ErrNotAllowed
+		}
 	}
 
 	// Admins get to use the Kargo API server's own Kubernetes client. i.e. They
@@ -529,6 +636,35 @@ func getAuthorizedClient(
 		return internalClient, nil
 	}
 
+	if userInfo.KubernetesUser {
+		// This user's identity was verified by the Kubernetes API server when they
+		// exchanged their credentials for a session, so we can ask the Kubernetes
+		// API server whether the user is permitted to perform the desired
+		// operation.
+		sar := &authv1.SubjectAccessReview{
+			Spec: authv1.SubjectAccessReviewSpec{
+				User:   userInfo.Username,
+				Groups: userInfo.Groups,
+				ResourceAttributes: &authv1.ResourceAttributes{
+					Verb:        verb,
+					Group:       gvr.Group,
+					Version:     gvr.Version,
+					Resource:    gvr.Resource,
+					Subresource: subresource,
+					Namespace:   key.Namespace,
+					Name:        key.Name,
+				},
+			},
+		}
+		if err := internalClient.Create(ctx, sar); err != nil {
+			return nil, errors.Wrap(err, "error conducting SubjectAccessReview")
+		}
+		if !sar.Status.Allowed {
+			return nil, ErrNotAllowed
+		}
+		return internalClient, nil
+	}
+
 	if userInfo.Username != "" {
 		// TODO: We are not yet mapping users to Kubernetes SAs, so anyone we could
 		// authenticate currently gets all the same permissions as the Kargo API
@@ -566,7 +702,7 @@ func getAuthorizedClient(
 			errors.Wrap(err, "error getting user-specific Kubernetes client")
 	}
 	if client == nil {
-		return nil, errors.New("not allowed")
+		return nil, ErrNotAllowed
 	}
 	// Note: At present, we never return the user-specific client. We always
 	// return the internal client so that most operations are performed by a
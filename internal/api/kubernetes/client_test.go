@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -25,6 +26,7 @@ func TestSetOptionsDefaults(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, opts.NewInternalClient)
 	require.NotNil(t, opts.NewInternalDynamicClient)
+	require.NotNil(t, opts.NewInternalWriteClient)
 	require.NotNil(t, opts.Scheme)
 }
 
@@ -56,6 +58,47 @@ func TestNewClient(t *testing.T) {
 	require.NotNil(t, client.getAuthorizedClientFn)
 }
 
+func TestNewClientWithReadRESTConfig(t *testing.T) {
+	testReadClient := fake.NewClientBuilder().Build()
+	testWriteClient := fake.NewClientBuilder().Build()
+	readRESTCfg := &rest.Config{Host: "https://read-replica"}
+	c, err := NewClient(
+		context.Background(),
+		&rest.Config{},
+		ClientOptions{
+			NewInternalClient: func(
+				_ context.Context,
+				cfg *rest.Config,
+				_ *runtime.Scheme,
+			) (libClient.Client, error) {
+				require.Same(t, readRESTCfg, cfg)
+				return testReadClient, nil
+			},
+			NewInternalWriteClient: func(
+				cfg *rest.Config,
+				_ *runtime.Scheme,
+			) (libClient.Client, error) {
+				require.NotSame(t, readRESTCfg, cfg)
+				return testWriteClient, nil
+			},
+			ReadRESTConfig:     readRESTCfg,
+			ReadFromWatchCache: true,
+		},
+	)
+	require.NoError(t, err)
+	client, ok := c.(*client)
+	require.True(t, ok)
+	require.True(t, client.readFromWatchCache)
+	split, ok := client.internalClient.(*splitClient)
+	require.True(t, ok)
+	require.Equal(t, testWriteClient, split.Client)
+	require.Equal(
+		t,
+		&watchCacheClient{Client: testReadClient},
+		split.reader,
+	)
+}
+
 func TestAllClientOperations(t *testing.T) {
 	getOp := func(client *client) error {
 		return client.Get(
@@ -168,6 +211,19 @@ func TestAllClientOperations(t *testing.T) {
 		return err
 	}
 
+	authorizeOp := func(client *client) error {
+		return client.Authorize(
+			context.Background(),
+			"promote",
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace",
+					Name:      "test-name",
+				},
+			},
+		)
+	}
+
 	testCases := []struct {
 		name       string
 		op         func(client *client) error
@@ -359,6 +415,24 @@ func TestAllClientOperations(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+
+		{
+			name: "authorize unauthorized",
+			op:   authorizeOp,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "not allowed", err.Error())
+			},
+		},
+
+		{
+			name:    "authorize authorized",
+			op:      authorizeOp,
+			allowed: true,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -431,11 +505,36 @@ func TestAllClientOperations(t *testing.T) {
 	}
 }
 
+// subjectAccessReviewClient is a libClient.Client that answers
+// SubjectAccessReviews by allowing all operations except those performed by
+// "forbidden-user".
+type subjectAccessReviewClient struct {
+	libClient.Client
+	sar *authv1.SubjectAccessReview
+}
+
+func (s *subjectAccessReviewClient) Create(
+	ctx context.Context,
+	obj libClient.Object,
+	opts ...libClient.CreateOption,
+) error {
+	if sar, ok := obj.(*authv1.SubjectAccessReview); ok {
+		sar.Status.Allowed = sar.Spec.User != "forbidden-user"
+		s.sar = sar
+		return nil
+	}
+	return s.Client.Create(ctx, obj, opts...)
+}
+
 func TestGetAuthorizedClient(t *testing.T) {
-	testInternalClient := fake.NewClientBuilder().Build()
+	testInternalClient := &subjectAccessReviewClient{
+		Client: fake.NewClientBuilder().Build(),
+	}
 	testCases := []struct {
 		name     string
 		userInfo *user.Info
+		verb     string
+		gvr      schema.GroupVersionResource
 		assert   func(libClient.Client, error)
 	}{
 		{
@@ -455,6 +554,73 @@ func TestGetAuthorizedClient(t *testing.T) {
 				require.Same(t, testInternalClient, client)
 			},
 		},
+		{
+			name: "read-only user performing read operation",
+			userInfo: &user.Info{
+				ReadOnly: true,
+			},
+			verb: "list",
+			assert: func(client libClient.Client, err error) {
+				require.NoError(t, err)
+				require.Same(t, testInternalClient, client)
+			},
+		},
+		{
+			name: "read-only user reading Secrets",
+			userInfo: &user.Info{
+				ReadOnly: true,
+			},
+			verb: "list",
+			gvr: schema.GroupVersionResource{
+				Version:  "v1",
+				Resource: "secrets",
+			},
+			assert: func(_ libClient.Client, err error) {
+				require.Error(t, err)
+				require.Equal(t, "not allowed", err.Error())
+			},
+		},
+		{
+			name: "read-only user performing write operation",
+			userInfo: &user.Info{
+				ReadOnly: true,
+			},
+			verb: "update",
+			assert: func(_ libClient.Client, err error) {
+				require.Error(t, err)
+				require.Equal(t, "not allowed", err.Error())
+			},
+		},
+		{
+			name: "Kubernetes user permitted to perform operation",
+			userInfo: &user.Info{
+				Username:       "test-user",
+				KubernetesUser: true,
+			},
+			verb: "list",
+			assert: func(client libClient.Client, err error) {
+				require.NoError(t, err)
+				require.Same(t, testInternalClient, client)
+				require.Equal(t, "test-user", testInternalClient.sar.Spec.User)
+				require.Equal(
+					t,
+					"list",
+					testInternalClient.sar.Spec.ResourceAttributes.Verb,
+				)
+			},
+		},
+		{
+			name: "Kubernetes user not permitted to perform operation",
+			userInfo: &user.Info{
+				Username:       "forbidden-user",
+				KubernetesUser: true,
+			},
+			verb: "delete",
+			assert: func(_ libClient.Client, err error) {
+				require.Error(t, err)
+				require.Equal(t, "not allowed", err.Error())
+			},
+		},
 		{
 			name: "sso user",
 			userInfo: &user.Info{
@@ -474,8 +640,8 @@ func TestGetAuthorizedClient(t *testing.T) {
 					getAuthorizedClient(
 						ctx,
 						testInternalClient,
-						"", // Verb doesn't matter for these tests
-						schema.GroupVersionResource{},
+						testCase.verb,
+						testCase.gvr,
 						"",                    // Subresource doesn't matter for these tests
 						libClient.ObjectKey{}, // Object key doesn't matter for these tests
 					),
@@ -0,0 +1,87 @@
+package kubernetes
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	libClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// watchCacheResourceVersion is the resource version that, when specified for a
+// get, list, or watch, permits the Kubernetes API server to serve the request
+// from its watch cache instead of performing a consistent read from etcd.
+const watchCacheResourceVersion = "0"
+
+// splitClient is an implementation of the controller-runtime Client interface
+// that performs all reads using one client and everything else using another.
+type splitClient struct {
+	// Client is used for everything other than reads.
+	libClient.Client
+	reader libClient.Reader
+}
+
+func (s *splitClient) Get(
+	ctx context.Context,
+	key libClient.ObjectKey,
+	obj libClient.Object,
+	opts ...libClient.GetOption,
+) error {
+	return s.reader.Get(ctx, key, obj, opts...)
+}
+
+func (s *splitClient) List(
+	ctx context.Context,
+	list libClient.ObjectList,
+	opts ...libClient.ListOption,
+) error {
+	return s.reader.List(ctx, list, opts...)
+}
+
+// watchCacheClient is an implementation of the controller-runtime Client
+// interface that permits every get and list that does not specify raw options
+// of its own to be served from the Kubernetes API server's watch cache. Reads
+// served by a controller-runtime cache are unaffected.
+type watchCacheClient struct {
+	libClient.Client
+}
+
+func (w *watchCacheClient) Get(
+	ctx context.Context,
+	key libClient.ObjectKey,
+	obj libClient.Object,
+	opts ...libClient.GetOption,
+) error {
+	getOpts := &libClient.GetOptions{}
+	getOpts.ApplyOptions(opts)
+	if getOpts.Raw == nil {
+		opts = append(opts, &libClient.GetOptions{
+			Raw: &metav1.GetOptions{ResourceVersion: watchCacheResourceVersion},
+		})
+	}
+	return w.Client.Get(ctx, key, obj, opts...)
+}
+
+func (w *watchCacheClient) List(
+	ctx context.Context,
+	list libClient.ObjectList,
+	opts ...libClient.ListOption,
+) error {
+	listOpts := &libClient.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	// A resource version may not be specified when continuing a list
+	if listOpts.Raw == nil && listOpts.Continue == "" {
+		opts = append(opts, &libClient.ListOptions{
+			Raw: &metav1.ListOptions{ResourceVersion: watchCacheResourceVersion},
+		})
+	}
+	return w.Client.List(ctx, list, opts...)
+}
+
+func newDefaultInternalWriteClient(
+	restCfg *rest.Config,
+	scheme *runtime.Scheme,
+) (libClient.Client, error) {
+	return libClient.New(restCfg, libClient.Options{Scheme: scheme})
+}
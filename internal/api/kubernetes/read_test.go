@@ -0,0 +1,161 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	libClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSplitClient(t *testing.T) {
+	testPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      "test-name",
+		},
+	}
+	reader := fake.NewClientBuilder().WithObjects(testPod).Build()
+	writer := fake.NewClientBuilder().Build()
+	c := &splitClient{
+		Client: writer,
+		reader: reader,
+	}
+
+	// Reads are served by the reader
+	err := c.Get(
+		context.Background(),
+		types.NamespacedName{
+			Namespace: "test-namespace",
+			Name:      "test-name",
+		},
+		&corev1.Pod{},
+	)
+	require.NoError(t, err)
+	pods := &corev1.PodList{}
+	require.NoError(t, c.List(context.Background(), pods))
+	require.Len(t, pods.Items, 1)
+
+	// Writes go to the writer
+	require.NoError(
+		t,
+		c.Create(
+			context.Background(),
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace",
+					Name:      "another-name",
+				},
+			},
+		),
+	)
+	pods = &corev1.PodList{}
+	require.NoError(t, writer.List(context.Background(), pods))
+	require.Len(t, pods.Items, 1)
+	require.Equal(t, "another-name", pods.Items[0].Name)
+}
+
+func TestWatchCacheClient(t *testing.T) {
+	testCases := []struct {
+		name       string
+		opts       []libClient.ListOption
+		assertions func(*libClient.ListOptions)
+	}{
+		{
+			name: "no raw options",
+			opts: []libClient.ListOption{libClient.InNamespace("test-namespace")},
+			assertions: func(opts *libClient.ListOptions) {
+				require.Equal(t, "test-namespace", opts.Namespace)
+				require.Equal(
+					t,
+					watchCacheResourceVersion,
+					opts.Raw.ResourceVersion,
+				)
+			},
+		},
+		{
+			name: "raw options specified",
+			opts: []libClient.ListOption{
+				&libClient.ListOptions{
+					Raw: &metav1.ListOptions{ResourceVersion: "42"},
+				},
+			},
+			assertions: func(opts *libClient.ListOptions) {
+				require.Equal(t, "42", opts.Raw.ResourceVersion)
+			},
+		},
+		{
+			name: "continuing a list",
+			opts: []libClient.ListOption{libClient.Continue("fake-token")},
+			assertions: func(opts *libClient.ListOptions) {
+				require.Nil(t, opts.Raw)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := &optionsRecordingClient{
+				Client: fake.NewClientBuilder().Build(),
+			}
+			c := &watchCacheClient{Client: recorder}
+			require.NoError(
+				t,
+				c.List(context.Background(), &corev1.PodList{}, testCase.opts...),
+			)
+			testCase.assertions(recorder.listOpts)
+		})
+	}
+
+	t.Run("get", func(t *testing.T) {
+		recorder := &optionsRecordingClient{
+			Client: fake.NewClientBuilder().Build(),
+		}
+		c := &watchCacheClient{Client: recorder}
+		_ = c.Get(
+			context.Background(),
+			types.NamespacedName{
+				Namespace: "test-namespace",
+				Name:      "test-name",
+			},
+			&corev1.Pod{},
+		)
+		require.Equal(
+			t,
+			watchCacheResourceVersion,
+			recorder.getOpts.Raw.ResourceVersion,
+		)
+	})
+}
+
+// optionsRecordingClient is a controller-runtime Client that records the
+// options passed to its most recent get and list.
+type optionsRecordingClient struct {
+	libClient.Client
+	getOpts  *libClient.GetOptions
+	listOpts *libClient.ListOptions
+}
+
+func (o *optionsRecordingClient) Get(
+	ctx context.Context,
+	key libClient.ObjectKey,
+	obj libClient.Object,
+	opts ...libClient.GetOption,
+) error {
+	o.getOpts = &libClient.GetOptions{}
+	o.getOpts.ApplyOptions(opts)
+	return o.Client.Get(ctx, key, obj, opts...)
+}
+
+func (o *optionsRecordingClient) List(
+	ctx context.Context,
+	list libClient.ObjectList,
+	opts ...libClient.ListOption,
+) error {
+	o.listOpts = &libClient.ListOptions{}
+	o.listOpts.ApplyOptions(opts)
+	return o.Client.List(ctx, list, opts...)
+}
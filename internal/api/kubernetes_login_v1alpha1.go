@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	authnv1 "k8s.io/api/authentication/v1"
+
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// KubernetesLogin exchanges a credential for the Kubernetes cluster (e.g. a
+// token obtained from a kubeconfig's exec plugin or via a TokenRequest for a
+// ServiceAccount) for a Kargo-issued ID token identifying the same Kubernetes
+// user. The credential is verified using a TokenReview. Operations later
+// performed using the ID token are subject to SubjectAccessReviews, so the
+// user has exactly the permissions within Kargo that they have in the cluster.
+func (s *server) KubernetesLogin(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.KubernetesLoginRequest],
+) (*connect.Response[svcv1alpha1.KubernetesLoginResponse], error) {
+	if s.cfg.KubernetesLoginConfig == nil || s.cfg.AdminConfig == nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("login using Kubernetes credentials is not enabled"),
+		)
+	}
+	if req.Msg.GetToken() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("token should not be empty"),
+		)
+	}
+
+	status, err := s.reviewTokenFn(ctx, req.Msg.GetToken())
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Wrap(err, "error reviewing token"),
+		)
+	}
+	if !status.Authenticated {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("token was not accepted by the Kubernetes API server"),
+		)
+	}
+
+	now := s.nowFn()
+	idToken := jwt.NewWithClaims(
+		jwt.SigningMethodHS256,
+		user.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				IssuedAt:  jwt.NewNumericDate(now),
+				Issuer:    s.cfg.AdminConfig.TokenIssuer,
+				Audience:  []string{s.cfg.AdminConfig.TokenAudience},
+				NotBefore: jwt.NewNumericDate(now),
+				Subject:   status.User.Username,
+				ID:        uuid.NewString(),
+				ExpiresAt: jwt.NewNumericDate(
+					now.Add(s.cfg.KubernetesLoginConfig.TokenTTL),
+				),
+			},
+			Groups:     status.User.Groups,
+			Kubernetes: true,
+		},
+	)
+
+	signedToken, err := idToken.SignedString(s.cfg.AdminConfig.TokenSigningKey)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Wrap(err, "error signing ID token"),
+		)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.KubernetesLoginResponse{
+		IdToken: signedToken,
+	}), nil
+}
+
+// reviewToken asks the Kubernetes API server to verify the provided token and
+// returns the outcome.
+func (s *server) reviewToken(
+	ctx context.Context,
+	token string,
+) (*authnv1.TokenReviewStatus, error) {
+	review := &authnv1.TokenReview{
+		Spec: authnv1.TokenReviewSpec{
+			Token: token,
+		},
+	}
+	// The caller is not authenticated yet, so the review is performed with the
+	// server's own permissions
+	if err := s.client.Create(
+		user.ContextWithInfo(ctx, user.Info{IsAdmin: true}),
+		review,
+	); err != nil {
+		return nil, err
+	}
+	return &review.Status, nil
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	authnv1 "k8s.io/api/authentication/v1"
+
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestKubernetesLogin(t *testing.T) {
+	testNow := time.Now()
+	testCfg := config.ServerConfig{
+		AdminConfig: &config.AdminConfig{
+			TokenIssuer:     "fake-issuer",
+			TokenAudience:   "fake-audience",
+			TokenSigningKey: []byte("fake-signing-key"),
+		},
+		KubernetesLoginConfig: &config.KubernetesLoginConfig{
+			TokenTTL: time.Hour,
+		},
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.KubernetesLoginRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.KubernetesLoginResponse], error)
+	}{
+		{
+			name: "Kubernetes login is not enabled",
+			req:  &svcv1alpha1.KubernetesLoginRequest{Token: "fake-token"},
+			server: &server{
+				cfg: config.ServerConfig{AdminConfig: testCfg.AdminConfig},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.KubernetesLoginResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+			},
+		},
+		{
+			name:   "token is empty",
+			req:    &svcv1alpha1.KubernetesLoginRequest{},
+			server: &server{cfg: testCfg},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.KubernetesLoginResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "error reviewing token",
+			req:  &svcv1alpha1.KubernetesLoginRequest{Token: "fake-token"},
+			server: &server{
+				cfg: testCfg,
+				reviewTokenFn: func(
+					context.Context,
+					string,
+				) (*authnv1.TokenReviewStatus, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.KubernetesLoginResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "token is not authenticated",
+			req:  &svcv1alpha1.KubernetesLoginRequest{Token: "fake-token"},
+			server: &server{
+				cfg: testCfg,
+				reviewTokenFn: func(
+					context.Context,
+					string,
+				) (*authnv1.TokenReviewStatus, error) {
+					return &authnv1.TokenReviewStatus{}, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.KubernetesLoginResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "success",
+			req:  &svcv1alpha1.KubernetesLoginRequest{Token: "fake-token"},
+			server: &server{
+				cfg:   testCfg,
+				nowFn: func() time.Time { return testNow },
+				reviewTokenFn: func(
+					_ context.Context,
+					token string,
+				) (*authnv1.TokenReviewStatus, error) {
+					require.Equal(t, "fake-token", token)
+					return &authnv1.TokenReviewStatus{
+						Authenticated: true,
+						User: authnv1.UserInfo{
+							Username: "system:serviceaccount:kargo-demo:ci",
+							Groups:   []string{"system:serviceaccounts"},
+						},
+					}, nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.KubernetesLoginResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				claims := user.Claims{}
+				_, err = jwt.ParseWithClaims(
+					res.Msg.GetIdToken(),
+					&claims,
+					func(*jwt.Token) (any, error) {
+						return testCfg.AdminConfig.TokenSigningKey, nil
+					},
+				)
+				require.NoError(t, err)
+				require.True(t, claims.Kubernetes)
+				require.False(t, claims.ReadOnly)
+				require.Equal(t, "system:serviceaccount:kargo-demo:ci", claims.Subject)
+				require.Equal(t, []string{"system:serviceaccounts"}, claims.Groups)
+				require.Equal(t, testCfg.AdminConfig.TokenIssuer, claims.Issuer)
+				require.Equal(
+					t,
+					testNow.Add(time.Hour).Unix(),
+					claims.ExpiresAt.Unix(),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.KubernetesLogin(
+					context.Background(),
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	eventSourceAudit      = "audit"
+	eventSourceKubernetes = "kubernetes"
+)
+
+func (s *server) ListEvents(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ListEventsRequest],
+) (*connect.Response[svcv1alpha1.ListEventsResponse], error) {
+	project := req.Msg.GetProject()
+	if project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err
+	}
+	stage := req.Msg.GetStage()
+	promotion := req.Msg.GetPromotion()
+
+	var promos kargoapi.PromotionList
+	if err := s.client.List(ctx, &promos, client.InNamespace(project)); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var kubeEvents corev1.EventList
+	if err := s.client.List(ctx, &kubeEvents, client.InNamespace(project)); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var freight kargoapi.FreightList
+	if promotion == "" {
+		if err := s.client.List(ctx, &freight, client.InNamespace(project)); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	// Events concerning a Stage include those concerning its Promotions
+	stagePromoNames := map[string]struct{}{}
+	for _, promo := range promos.Items {
+		if promo.Spec != nil && promo.Spec.Stage == stage {
+			stagePromoNames[promo.Name] = struct{}{}
+		}
+	}
+	concerned := func(kind, name string) bool {
+		switch {
+		case promotion != "":
+			return kind == "Promotion" && name == promotion
+		case stage != "":
+			_, ok := stagePromoNames[name]
+			return (kind == "Stage" && name == stage) || (kind == "Promotion" && ok)
+		default:
+			return true
+		}
+	}
+
+	var events []*svcv1alpha1.Event
+	for _, e := range kubeEvents.Items {
+		obj := e.InvolvedObject
+		gv, err := schema.ParseGroupVersion(obj.APIVersion)
+		if err != nil || gv.Group != kargoapi.GroupVersion.Group {
+			continue
+		}
+		if !concerned(obj.Kind, obj.Name) {
+			continue
+		}
+		events = append(events, &svcv1alpha1.Event{
+			Time:    timestamppb.New(kubeEventTime(e)),
+			Source:  eventSourceKubernetes,
+			Type:    e.Type,
+			Reason:  e.Reason,
+			Kind:    obj.Kind,
+			Name:    obj.Name,
+			Message: e.Message,
+			Count:   e.Count,
+		})
+	}
+	for _, promo := range promos.Items {
+		if !concerned("Promotion", promo.Name) {
+			continue
+		}
+		events = append(events, promotionAuditEvent(promo))
+	}
+	for _, f := range freight.Items {
+		for approvedStage, approval := range f.Status.Approvals {
+			if stage != "" && approvedStage != stage {
+				continue
+			}
+			events = append(events, approvalAuditEvent(f, approvedStage, approval))
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].GetTime().AsTime().Before(events[j].GetTime().AsTime())
+	})
+	return connect.NewResponse(&svcv1alpha1.ListEventsResponse{
+		Events: events,
+	}), nil
+}
+
+// kubeEventTime returns the time at which the provided Kubernetes Event most
+// recently occurred. Which of an Event's timestamps are populated depends on
+// the API that was used to emit it.
+func kubeEventTime(e corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	case !e.FirstTimestamp.IsZero():
+		return e.FirstTimestamp.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+// promotionAuditEvent returns an audit entry recording the creation of the
+// provided Promotion.
+func promotionAuditEvent(promo kargoapi.Promotion) *svcv1alpha1.Event {
+	var stage, freight string
+	if promo.Spec != nil {
+		stage = promo.Spec.Stage
+		freight = promo.Spec.Freight
+	}
+	description := "Promotion"
+	switch {
+	case promo.Labels[kargoapi.LabelSelfHealKey] == kargoapi.LabelTrueValue:
+		description = "Self-healing Promotion"
+	case promo.Labels[kargoapi.LabelAutoPromotionKey] == kargoapi.LabelTrueValue:
+		description = "Auto-Promotion"
+	}
+	return &svcv1alpha1.Event{
+		Time:   timestamppb.New(promo.CreationTimestamp.Time),
+		Source: eventSourceAudit,
+		Type:   corev1.EventTypeNormal,
+		Reason: "PromotionCreated",
+		Kind:   "Promotion",
+		Name:   promo.Name,
+		Message: fmt.Sprintf(
+			"%s of Stage %q to Freight %q was created",
+			description,
+			stage,
+			freight,
+		),
+		Actor: promo.Annotations[kargoapi.AnnotationKeyPromoter],
+	}
+}
+
+// approvalAuditEvent returns an audit entry recording the manual approval of
+// the provided Freight for the specified Stage.
+func approvalAuditEvent(
+	freight kargoapi.Freight,
+	stage string,
+	approval kargoapi.Approval,
+) *svcv1alpha1.Event {
+	// The time of approval is optional
+	approvedAt := freight.CreationTimestamp.Time
+	if approval.ApprovedAt != nil {
+		approvedAt = approval.ApprovedAt.Time
+	}
+	return &svcv1alpha1.Event{
+		Time:    timestamppb.New(approvedAt),
+		Source:  eventSourceAudit,
+		Type:    corev1.EventTypeNormal,
+		Reason:  "FreightApproved",
+		Kind:    "Freight",
+		Name:    freight.Name,
+		Message: fmt.Sprintf("Freight %q was approved for Stage %q", freight.Name, stage),
+		Actor:   approval.Approver,
+	}
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestListEvents(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) metav1.Time {
+		return metav1.NewTime(start.Add(time.Duration(minutes) * time.Minute))
+	}
+	approvedAt := at(4)
+
+	// Simulate an admin user to prevent any authz issues with the authorizing
+	// client.
+	ctx := user.ContextWithInfo(context.Background(), user.Info{IsAdmin: true})
+	kubeClient, err := kubernetes.NewClient(
+		ctx,
+		&rest.Config{},
+		kubernetes.ClientOptions{
+			NewInternalClient: func(
+				_ context.Context,
+				_ *rest.Config,
+				scheme *runtime.Scheme,
+			) (client.Client, error) {
+				return fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(
+						&kargoapi.Promotion{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace:         "fake-project",
+								Name:              "test-promo",
+								CreationTimestamp: at(2),
+								Annotations: map[string]string{
+									kargoapi.AnnotationKeyPromoter: "alice",
+								},
+							},
+							Spec: &kargoapi.PromotionSpec{
+								Stage:   "test",
+								Freight: "fake-freight",
+							},
+						},
+						&kargoapi.Promotion{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace:         "fake-project",
+								Name:              "prod-promo",
+								CreationTimestamp: at(5),
+								Labels: map[string]string{
+									kargoapi.LabelAutoPromotionKey: kargoapi.LabelTrueValue,
+								},
+							},
+							Spec: &kargoapi.PromotionSpec{
+								Stage:   "prod",
+								Freight: "fake-freight",
+							},
+						},
+						&kargoapi.Freight{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "fake-project",
+								Name:      "fake-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								Approvals: map[string]kargoapi.Approval{
+									"prod": {
+										Approver:   "bob",
+										ApprovedAt: &approvedAt,
+									},
+								},
+							},
+						},
+						&corev1.Event{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "fake-project",
+								Name:      "test-drifted",
+							},
+							InvolvedObject: corev1.ObjectReference{
+								APIVersion: kargoapi.GroupVersion.String(),
+								Kind:       "Stage",
+								Name:       "test",
+							},
+							Type:          corev1.EventTypeWarning,
+							Reason:        "Drifted",
+							Message:       "Stage has drifted",
+							Count:         3,
+							LastTimestamp: at(3),
+						},
+						&corev1.Event{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "fake-project",
+								Name:      "pod-event",
+							},
+							InvolvedObject: corev1.ObjectReference{
+								APIVersion: "v1",
+								Kind:       "Pod",
+								Name:       "test",
+							},
+							LastTimestamp: at(1),
+						},
+					).
+					Build(), nil
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.ListEventsRequest
+		assertions func(*connect.Response[svcv1alpha1.ListEventsResponse], error)
+	}{
+		{
+			name: "missing project",
+			req:  &svcv1alpha1.ListEventsRequest{},
+			assertions: func(_ *connect.Response[svcv1alpha1.ListEventsResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "all events in project",
+			req: &svcv1alpha1.ListEventsRequest{
+				Project: "fake-project",
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.ListEventsResponse], err error) {
+				require.NoError(t, err)
+				events := res.Msg.GetEvents()
+				require.Len(t, events, 4)
+
+				require.Equal(t, eventSourceAudit, events[0].GetSource())
+				require.Equal(t, "PromotionCreated", events[0].GetReason())
+				require.Equal(t, "test-promo", events[0].GetName())
+				require.Equal(t, "alice", events[0].GetActor())
+
+				require.Equal(t, eventSourceKubernetes, events[1].GetSource())
+				require.Equal(t, "Drifted", events[1].GetReason())
+				require.Equal(t, corev1.EventTypeWarning, events[1].GetType())
+				require.Equal(t, int32(3), events[1].GetCount())
+
+				require.Equal(t, "FreightApproved", events[2].GetReason())
+				require.Equal(t, "bob", events[2].GetActor())
+				require.Equal(t, at(4).Time, events[2].GetTime().AsTime())
+
+				require.Equal(t, "prod-promo", events[3].GetName())
+				require.Contains(t, events[3].GetMessage(), "Auto-Promotion")
+			},
+		},
+		{
+			name: "events for Stage",
+			req: &svcv1alpha1.ListEventsRequest{
+				Project: "fake-project",
+				Stage:   pointer.String("prod"),
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.ListEventsResponse], err error) {
+				require.NoError(t, err)
+				events := res.Msg.GetEvents()
+				require.Len(t, events, 2)
+				require.Equal(t, "FreightApproved", events[0].GetReason())
+				require.Equal(t, "prod-promo", events[1].GetName())
+			},
+		},
+		{
+			name: "events for Promotion",
+			req: &svcv1alpha1.ListEventsRequest{
+				Project:   "fake-project",
+				Promotion: pointer.String("test-promo"),
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.ListEventsResponse], err error) {
+				require.NoError(t, err)
+				events := res.Msg.GetEvents()
+				require.Len(t, events, 1)
+				require.Equal(t, "test-promo", events[0].GetName())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &server{
+				client:            kubeClient,
+				validateProjectFn: func(context.Context, string) error { return nil },
+			}
+			res, err := s.ListEvents(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(res, err)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) ListOperations(
+	ctx context.Context,
+	_ *connect.Request[svcv1alpha1.ListOperationsRequest],
+) (*connect.Response[svcv1alpha1.ListOperationsResponse], error) {
+	ops := s.operations.List(ctx)
+	res := make([]*svcv1alpha1.Operation, len(ops))
+	for i, op := range ops {
+		res[i] = toOperationProto(op)
+	}
+	return connect.NewResponse(&svcv1alpha1.ListOperationsResponse{
+		Operations: res,
+	}), nil
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -15,7 +16,7 @@ import (
 
 func (s *server) ListProjects(
 	ctx context.Context,
-	_ *connect.Request[svcv1alpha1.ListProjectsRequest],
+	req *connect.Request[svcv1alpha1.ListProjectsRequest],
 ) (*connect.Response[svcv1alpha1.ListProjectsResponse], error) {
 	// Only list namespaces which are labeled as Kargo projects
 	selector := labels.Set{kargoapi.LabelProjectKey: kargoapi.LabelTrueValue}.AsSelector()
@@ -25,13 +26,28 @@ func (s *server) ListProjects(
 	}
 
 	projects := make([]*svcv1alpha1.Project, len(nsList.Items))
-	for i, ns := range nsList.Items {
-		projects[i] = &svcv1alpha1.Project{
-			Name:       ns.Name,
-			CreateTime: timestamppb.New(ns.CreationTimestamp.Time),
-		}
+	for i := range nsList.Items {
+		projects[i] = newProjectProto(&nsList.Items[i])
 	}
-	return connect.NewResponse(&svcv1alpha1.ListProjectsResponse{
+	res := &svcv1alpha1.ListProjectsResponse{
 		Projects: projects,
-	}), nil
+	}
+	if req.Msg.GetIncludeTable() {
+		res.Table = newProjectsTable(nsList.Items, time.Now())
+	}
+	return connect.NewResponse(res), nil
+}
+
+// newProjectProto returns a protobuf representation of the Project whose
+// namespace is provided.
+func newProjectProto(ns *corev1.Namespace) *svcv1alpha1.Project {
+	return &svcv1alpha1.Project{
+		Name:              ns.Name,
+		CreateTime:        timestamppb.New(ns.CreationTimestamp.Time),
+		Archived:          kargoapi.IsNamespaceArchived(ns),
+		BackstageEntities: kargoapi.BackstageEntityRefs(ns.Annotations),
+		CatalogAnnotations: map[string]string{
+			backstageCatalogAnnotationKeyProject: ns.Name,
+		},
+	}
 }
@@ -2,13 +2,13 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
-	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	"github.com/akuity/kargo/internal/kubeclient"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 	"github.com/akuity/kargo/pkg/api/v1alpha1"
@@ -40,9 +40,13 @@ func (s *server) ListPromotions(
 	}
 	promotions := make([]*v1alpha1.Promotion, len(list.Items))
 	for idx, promotion := range list.Items {
-		promotions[idx] = typesv1alpha1.ToPromotionProto(promotion)
+		promotions[idx] = s.toPromotionProto(ctx, promotion)
 	}
-	return connect.NewResponse(&svcv1alpha1.ListPromotionsResponse{
+	res := &svcv1alpha1.ListPromotionsResponse{
 		Promotions: promotions,
-	}), nil
+	}
+	if req.Msg.GetIncludeTable() {
+		res.Table = newPromotionsTable(list.Items, time.Now())
+	}
+	return connect.NewResponse(res), nil
 }
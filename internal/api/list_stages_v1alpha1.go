@@ -2,13 +2,13 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
-	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 	"github.com/akuity/kargo/pkg/api/v1alpha1"
 )
@@ -31,9 +31,13 @@ func (s *server) ListStages(
 
 	stages := make([]*v1alpha1.Stage, len(list.Items))
 	for idx := range list.Items {
-		stages[idx] = typesv1alpha1.ToStageProto(list.Items[idx])
+		stages[idx] = s.toStageProto(ctx, list.Items[idx])
 	}
-	return connect.NewResponse(&svcv1alpha1.ListStagesResponse{
+	res := &svcv1alpha1.ListStagesResponse{
 		Stages: stages,
-	}), nil
+	}
+	if req.Msg.GetIncludeTable() {
+		res.Table = newStagesTable(list.Items, time.Now())
+	}
+	return connect.NewResponse(res), nil
 }
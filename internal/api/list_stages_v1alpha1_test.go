@@ -21,9 +21,10 @@ import (
 
 func TestListStages(t *testing.T) {
 	testSets := map[string]struct {
-		req          *svcv1alpha1.ListStagesRequest
-		errExpected  bool
-		expectedCode connect.Code
+		req           *svcv1alpha1.ListStagesRequest
+		errExpected   bool
+		expectedCode  connect.Code
+		tableExpected bool
 	}{
 		"empty project": {
 			req: &svcv1alpha1.ListStagesRequest{
@@ -37,6 +38,13 @@ func TestListStages(t *testing.T) {
 				Project: "kargo-demo",
 			},
 		},
+		"existing project with table": {
+			req: &svcv1alpha1.ListStagesRequest{
+				Project:      "kargo-demo",
+				IncludeTable: true,
+			},
+			tableExpected: true,
+		},
 		"non-existing project": {
 			req: &svcv1alpha1.ListStagesRequest{
 				Project: "non-existing-project",
@@ -95,6 +103,16 @@ func TestListStages(t *testing.T) {
 				return
 			}
 			require.Len(t, res.Msg.GetStages(), 1)
+			if !ts.tableExpected {
+				require.Nil(t, res.Msg.GetTable())
+				return
+			}
+			require.Len(t, res.Msg.GetTable().GetRows(), 1)
+			require.Equal(
+				t,
+				res.Msg.GetStages()[0].GetMetadata().GetName(),
+				res.Msg.GetTable().GetRows()[0].GetName(),
+			)
 		})
 	}
 }
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
@@ -33,7 +34,11 @@ func (s *server) ListWarehouses(
 	for idx := range list.Items {
 		warehouses[idx] = typesv1alpha1.ToWarehouseProto(list.Items[idx])
 	}
-	return connect.NewResponse(&svcv1alpha1.ListWarehousesResponse{
+	res := &svcv1alpha1.ListWarehousesResponse{
 		Warehouses: warehouses,
-	}), nil
+	}
+	if req.Msg.GetIncludeTable() {
+		res.Table = newWarehousesTable(list.Items, time.Now())
+	}
+	return connect.NewResponse(res), nil
 }
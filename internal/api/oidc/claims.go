@@ -0,0 +1,83 @@
+package oidc
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ExtractIdentity returns a username and groups from the provided claims,
+// according to the claim mapping specified by the Config. An error is returned
+// if the claim referenced by UsernameClaim is missing or is not a non-empty
+// string, or if the claim referenced by GroupsClaim is present, but is not a
+// string or a list of strings. A missing groups claim is not an error.
+func (c Config) ExtractIdentity(claims map[string]any) (string, []string, error) {
+	usernameClaim := c.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = "sub"
+	}
+	groupsClaim := c.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+
+	rawUsername, ok := lookupClaim(claims, usernameClaim)
+	if !ok {
+		return "", nil, errors.Errorf("claim %q not found", usernameClaim)
+	}
+	username, ok := rawUsername.(string)
+	if !ok || username == "" {
+		return "", nil,
+			errors.Errorf("claim %q is not a non-empty string", usernameClaim)
+	}
+
+	var groups []string
+	if rawGroups, ok := lookupClaim(claims, groupsClaim); ok {
+		switch g := rawGroups.(type) {
+		case string:
+			groups = []string{g}
+		case []any:
+			groups = make([]string, 0, len(g))
+			for _, rawGroup := range g {
+				group, ok := rawGroup.(string)
+				if !ok {
+					return "", nil, errors.Errorf(
+						"claim %q is not a string or a list of strings",
+						groupsClaim,
+					)
+				}
+				groups = append(groups, group)
+			}
+		case nil:
+		default:
+			return "", nil, errors.Errorf(
+				"claim %q is not a string or a list of strings",
+				groupsClaim,
+			)
+		}
+	}
+	if c.GroupsPrefix != "" {
+		for i, group := range groups {
+			groups[i] = strings.TrimPrefix(group, c.GroupsPrefix)
+		}
+	}
+
+	return username, groups, nil
+}
+
+// lookupClaim returns the value found at the specified dot-separated path
+// within the provided claims. A boolean is also returned to indicate whether a
+// value was found.
+func lookupClaim(claims map[string]any, path string) (any, bool) {
+	var val any = claims
+	for _, name := range strings.Split(path, ".") {
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if val, ok = obj[name]; !ok {
+			return nil, false
+		}
+	}
+	return val, true
+}
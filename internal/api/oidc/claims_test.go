@@ -0,0 +1,109 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractIdentity(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        Config
+		claims     map[string]any
+		assertions func(username string, groups []string, err error)
+	}{
+		{
+			name: "default mapping",
+			claims: map[string]any{
+				"sub":    "tony",
+				"groups": []any{"avengers"},
+			},
+			assertions: func(username string, groups []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "tony", username)
+				require.Equal(t, []string{"avengers"}, groups)
+			},
+		},
+		{
+			name: "username claim not found",
+			cfg:  Config{UsernameClaim: "email"},
+			claims: map[string]any{
+				"sub": "tony",
+			},
+			assertions: func(_ string, _ []string, err error) {
+				require.Error(t, err)
+				require.Equal(t, `claim "email" not found`, err.Error())
+			},
+		},
+		{
+			name: "username claim is not a string",
+			claims: map[string]any{
+				"sub": 42.0,
+			},
+			assertions: func(_ string, _ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is not a non-empty string")
+			},
+		},
+		{
+			name: "groups claim not found",
+			claims: map[string]any{
+				"sub": "tony",
+			},
+			assertions: func(username string, groups []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "tony", username)
+				require.Empty(t, groups)
+			},
+		},
+		{
+			name: "groups claim is not a list of strings",
+			claims: map[string]any{
+				"sub":    "tony",
+				"groups": []any{"avengers", 42.0},
+			},
+			assertions: func(_ string, _ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is not a string or a list of strings")
+			},
+		},
+		{
+			name: "custom nested claims and group prefix",
+			cfg: Config{
+				UsernameClaim: "email",
+				GroupsClaim:   "realm_access.roles",
+				GroupsPrefix:  "kargo:",
+			},
+			claims: map[string]any{
+				"sub":   "fake-subject",
+				"email": "tony@starkindustries.com",
+				"realm_access": map[string]any{
+					"roles": []any{"kargo:wallboards", "avengers"},
+				},
+			},
+			assertions: func(username string, groups []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "tony@starkindustries.com", username)
+				require.Equal(t, []string{"wallboards", "avengers"}, groups)
+			},
+		},
+		{
+			name: "single string groups claim",
+			cfg:  Config{GroupsClaim: "role"},
+			claims: map[string]any{
+				"sub":  "tony",
+				"role": "avengers",
+			},
+			assertions: func(_ string, groups []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"avengers"}, groups)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(testCase.cfg.ExtractIdentity(testCase.claims))
+		})
+	}
+}
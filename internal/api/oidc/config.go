@@ -17,6 +17,23 @@ type Config struct {
 	// CLIClientID is the client ID provided by the OpenID Connect identity
 	// provider for CLI login.
 	CLIClientID string `envconfig:"OIDC_CLI_CLIENT_ID"`
+	// ReadOnlyGroups are the names of groups whose members are restricted to
+	// read-only access to the API server, regardless of any other permissions
+	// they might have.
+	ReadOnlyGroups []string `envconfig:"OIDC_READ_ONLY_GROUPS"`
+	// UsernameClaim is the path to the claim whose value is used as a user's
+	// username. Nested claims may be referenced using dots to separate the names
+	// of successive claims. e.g. "user.email".
+	UsernameClaim string `envconfig:"OIDC_USERNAME_CLAIM" default:"sub"`
+	// GroupsClaim is the path to the claim whose value is used as a user's
+	// groups. The claim's value may be either a single string or a list of
+	// strings. Nested claims may be referenced using dots to separate the names
+	// of successive claims. e.g. "realm_access.roles".
+	GroupsClaim string `envconfig:"OIDC_GROUPS_CLAIM" default:"groups"`
+	// GroupsPrefix is an optional prefix that is stripped from the names of any
+	// groups that have it. This is applied before group names are compared to
+	// ReadOnlyGroups.
+	GroupsPrefix string `envconfig:"OIDC_GROUPS_PREFIX"`
 	// Scopes are the scopes to be requested during the authorization code flow.
 	Scopes []string
 }
@@ -0,0 +1,274 @@
+package operation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// Phase is the phase of an Operation.
+type Phase string
+
+const (
+	PhaseRunning   Phase = "Running"
+	PhaseSucceeded Phase = "Succeeded"
+	PhaseFailed    Phase = "Failed"
+	PhaseCanceled  Phase = "Canceled"
+)
+
+// IsTerminal returns true if the Phase is a terminal one.
+func (p Phase) IsTerminal() bool {
+	return p != PhaseRunning
+}
+
+// DefaultRetention is how long the Manager retains Operations after they have
+// reached a terminal phase, if no other retention period is specified.
+const DefaultRetention = time.Hour
+
+// ErrNotFound is returned when an Operation does not exist or is not visible
+// to the user requesting it.
+var ErrNotFound = errors.New("operation not found")
+
+// Func is the work performed by an Operation. It should return promptly once
+// the provided context is canceled. Progress may be reported using the
+// provided function. The returned result is retained by the Manager until the
+// Operation expires.
+type Func func(ctx context.Context, progress func(message string)) (any, error)
+
+// Operation is a point-in-time snapshot of the state of an Operation.
+type Operation struct {
+	// ID uniquely identifies the Operation.
+	ID string
+	// Type describes the kind of work performed by the Operation.
+	Type string
+	// Project is the project the Operation pertains to, if any.
+	Project string
+	// Phase is the Operation's current phase.
+	Phase Phase
+	// Message is the most recently reported progress of a running Operation or
+	// the cause of a failed Operation's failure.
+	Message string
+	// StartTime is the time at which the Operation was started.
+	StartTime time.Time
+	// EndTime is the time at which the Operation reached a terminal phase. It
+	// is the zero value while the Operation is running.
+	EndTime time.Time
+	// Result is the result of a succeeded Operation.
+	Result any
+}
+
+// operation is the Manager's internal record of an Operation.
+type operation struct {
+	mu     sync.RWMutex
+	op     Operation
+	owner  string
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (o *operation) snapshot() Operation {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.op
+}
+
+// Manager runs Operations in the background, on behalf of the users who start
+// them, so that heavy work is not bound to the lifetime of a single API
+// request. Clients poll for an Operation's state using its ID and may cancel
+// it. Operations are held in memory by the API server that started them, so
+// they are lost if it restarts and cannot be polled or canceled via any other
+// API server. For this reason, the API server must not be scaled beyond a
+// single replica.
+type Manager struct {
+	retention time.Duration
+	nowFn     func() time.Time
+
+	mu         sync.Mutex
+	operations map[string]*operation
+}
+
+// NewManager returns a Manager that retains Operations for the specified
+// period after they reach a terminal phase. A non-positive retention period
+// selects DefaultRetention.
+func NewManager(retention time.Duration) *Manager {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	return &Manager{
+		retention:  retention,
+		nowFn:      time.Now,
+		operations: map[string]*operation{},
+	}
+}
+
+// Start starts an Operation of the specified type that executes the provided
+// Func in the background and returns a snapshot of its initial state. The
+// Func is executed with the identity of the user bound to the provided
+// context, but not with its deadline or cancellation.
+func (m *Manager) Start(
+	ctx context.Context,
+	opType string,
+	project string,
+	fn Func,
+) Operation {
+	m.prune()
+
+	info, _ := user.InfoFromContext(ctx)
+	opCtx := user.ContextWithInfo(context.Background(), info)
+	opCtx = logging.ContextWithLogger(opCtx, logging.LoggerFromContext(ctx))
+	opCtx, cancel := context.WithCancel(opCtx)
+
+	o := &operation{
+		op: Operation{
+			ID:        uuid.NewString(),
+			Type:      opType,
+			Project:   project,
+			Phase:     PhaseRunning,
+			StartTime: m.nowFn(),
+		},
+		owner:  ownerOf(info),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	m.mu.Lock()
+	m.operations[o.op.ID] = o
+	m.mu.Unlock()
+
+	go m.run(opCtx, o, fn)
+	return o.snapshot()
+}
+
+func (m *Manager) run(ctx context.Context, o *operation, fn Func) {
+	defer close(o.done)
+	defer o.cancel()
+	progress := func(message string) {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		if o.op.Phase == PhaseRunning {
+			o.op.Message = message
+		}
+	}
+	result, err := fn(ctx, progress)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.op.EndTime = m.nowFn()
+	switch {
+	case ctx.Err() != nil:
+		o.op.Phase = PhaseCanceled
+		o.op.Message = "operation was canceled"
+	case err != nil:
+		o.op.Phase = PhaseFailed
+		o.op.Message = err.Error()
+		logging.LoggerFromContext(ctx).WithError(err).
+			WithField("operation", o.op.ID).
+			Error("operation failed")
+	default:
+		o.op.Phase = PhaseSucceeded
+		o.op.Message = ""
+		o.op.Result = result
+	}
+}
+
+// Get returns a snapshot of the state of the Operation with the specified ID.
+// Operations are only visible to the user who started them. ErrNotFound is
+// returned if the Operation does not exist or is not visible to the user bound
+// to the provided context.
+func (m *Manager) Get(ctx context.Context, id string) (Operation, error) {
+	m.prune()
+	o, err := m.get(ctx, id)
+	if err != nil {
+		return Operation{}, err
+	}
+	return o.snapshot(), nil
+}
+
+// Cancel cancels the Operation with the specified ID, waits for it to stop,
+// and returns a snapshot of its final state. Canceling an Operation that has
+// already reached a terminal phase has no effect. ErrNotFound is returned if
+// the Operation does not exist or is not visible to the user bound to the
+// provided context.
+func (m *Manager) Cancel(ctx context.Context, id string) (Operation, error) {
+	o, err := m.get(ctx, id)
+	if err != nil {
+		return Operation{}, err
+	}
+	o.cancel()
+	select {
+	case <-o.done:
+	case <-ctx.Done():
+		return Operation{}, ctx.Err()
+	}
+	return o.snapshot(), nil
+}
+
+// List returns snapshots of all Operations visible to the user bound to the
+// provided context, ordered by start time.
+func (m *Manager) List(ctx context.Context) []Operation {
+	m.prune()
+	info, _ := user.InfoFromContext(ctx)
+	owner := ownerOf(info)
+	m.mu.Lock()
+	ops := make([]Operation, 0, len(m.operations))
+	for _, o := range m.operations {
+		if o.owner == owner {
+			ops = append(ops, o.snapshot())
+		}
+	}
+	m.mu.Unlock()
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].StartTime.Before(ops[j].StartTime)
+	})
+	return ops
+}
+
+func (m *Manager) get(ctx context.Context, id string) (*operation, error) {
+	info, _ := user.InfoFromContext(ctx)
+	m.mu.Lock()
+	o, ok := m.operations[id]
+	m.mu.Unlock()
+	if !ok || o.owner != ownerOf(info) {
+		return nil, ErrNotFound
+	}
+	return o, nil
+}
+
+// prune forgets all Operations that reached a terminal phase longer ago than
+// the Manager's retention period.
+func (m *Manager) prune() {
+	cutoff := m.nowFn().Add(-m.retention)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, o := range m.operations {
+		op := o.snapshot()
+		if op.Phase.IsTerminal() && op.EndTime.Before(cutoff) {
+			delete(m.operations, id)
+		}
+	}
+}
+
+// ownerOf returns a string identifying the user described by the provided
+// Info. Bearer tokens are hashed so they are not retained in memory.
+func ownerOf(info user.Info) string {
+	switch {
+	case info.IsAdmin:
+		return "admin"
+	case info.BearerToken != "":
+		sum := sha256.Sum256([]byte(info.BearerToken))
+		return "token:" + hex.EncodeToString(sum[:])
+	case info.KubernetesUser:
+		return "kubernetes:" + info.Username
+	case info.Username != "":
+		return "user:" + info.Username
+	default:
+		return "anonymous"
+	}
+}
@@ -0,0 +1,156 @@
+package operation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/api/user"
+)
+
+func TestManager(t *testing.T) {
+	ctx := user.ContextWithInfo(context.Background(), user.Info{Username: "alice"})
+	otherCtx := user.ContextWithInfo(context.Background(), user.Info{Username: "bob"})
+
+	testCases := []struct {
+		name       string
+		fn         Func
+		assertions func(*Manager, Operation)
+	}{
+		{
+			name: "operation succeeds",
+			fn: func(_ context.Context, progress func(string)) (any, error) {
+				progress("halfway there")
+				return "result", nil
+			},
+			assertions: func(m *Manager, op Operation) {
+				op = waitForTerminal(ctx, t, m, op.ID)
+				require.Equal(t, PhaseSucceeded, op.Phase)
+				require.Equal(t, "result", op.Result)
+				require.Empty(t, op.Message)
+				require.False(t, op.EndTime.IsZero())
+			},
+		},
+		{
+			name: "operation fails",
+			fn: func(context.Context, func(string)) (any, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(m *Manager, op Operation) {
+				op = waitForTerminal(ctx, t, m, op.ID)
+				require.Equal(t, PhaseFailed, op.Phase)
+				require.Equal(t, "something went wrong", op.Message)
+				require.Nil(t, op.Result)
+			},
+		},
+		{
+			name: "operation is canceled",
+			fn: func(ctx context.Context, _ func(string)) (any, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+			assertions: func(m *Manager, op Operation) {
+				_, err := m.Cancel(otherCtx, op.ID)
+				require.ErrorIs(t, err, ErrNotFound)
+				op, err = m.Cancel(ctx, op.ID)
+				require.NoError(t, err)
+				require.Equal(t, PhaseCanceled, op.Phase)
+			},
+		},
+		{
+			name: "operation is only visible to its owner",
+			fn: func(ctx context.Context, _ func(string)) (any, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+			assertions: func(m *Manager, op Operation) {
+				_, err := m.Get(otherCtx, op.ID)
+				require.ErrorIs(t, err, ErrNotFound)
+				require.Empty(t, m.List(otherCtx))
+				ops := m.List(ctx)
+				require.Len(t, ops, 1)
+				require.Equal(t, op.ID, ops[0].ID)
+				_, err = m.Cancel(ctx, op.ID)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "operation runs with the identity of the user who started it",
+			fn: func(ctx context.Context, _ func(string)) (any, error) {
+				u, ok := user.InfoFromContext(ctx)
+				if !ok || u.Username != "alice" {
+					return nil, errors.New("user info not propagated")
+				}
+				return nil, nil
+			},
+			assertions: func(m *Manager, op Operation) {
+				op = waitForTerminal(ctx, t, m, op.ID)
+				require.Equal(t, PhaseSucceeded, op.Phase)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			m := NewManager(0)
+			startCtx, cancel := context.WithCancel(ctx)
+			op := m.Start(startCtx, "Test", "fake-project", testCase.fn)
+			cancel()
+			require.NotEmpty(t, op.ID)
+			require.Equal(t, "Test", op.Type)
+			require.Equal(t, "fake-project", op.Project)
+			testCase.assertions(m, op)
+		})
+	}
+}
+
+func TestManagerPrune(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager(time.Minute)
+	now := time.Now()
+	m.nowFn = func() time.Time { return now }
+
+	op := m.Start(ctx, "Test", "", func(context.Context, func(string)) (any, error) {
+		return nil, nil
+	})
+	waitForTerminal(ctx, t, m, op.ID)
+
+	// Not yet expired
+	now = now.Add(30 * time.Second)
+	_, err := m.Get(ctx, op.ID)
+	require.NoError(t, err)
+
+	// Expired
+	now = now.Add(time.Minute)
+	_, err = m.Get(ctx, op.ID)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestOwnerOf(t *testing.T) {
+	testCases := map[string]user.Info{
+		"admin":            {IsAdmin: true},
+		"kubernetes:alice": {KubernetesUser: true, Username: "alice"},
+		"user:alice":       {Username: "alice"},
+		"anonymous":        {},
+	}
+	for expected, info := range testCases {
+		require.Equal(t, expected, ownerOf(info))
+	}
+	owner := ownerOf(user.Info{BearerToken: "secret"})
+	require.NotContains(t, owner, "secret")
+	require.Equal(t, owner, ownerOf(user.Info{BearerToken: "secret"}))
+	require.NotEqual(t, owner, ownerOf(user.Info{BearerToken: "other-secret"}))
+}
+
+func waitForTerminal(ctx context.Context, t *testing.T, m *Manager, id string) Operation {
+	var op Operation
+	require.Eventually(t, func() bool {
+		var err error
+		op, err = m.Get(ctx, id)
+		require.NoError(t, err)
+		return op.Phase.IsTerminal()
+	}, 5*time.Second, 10*time.Millisecond)
+	return op
+}
@@ -0,0 +1,123 @@
+package option
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+var (
+	_ connect.Interceptor = &archiveInterceptor{}
+)
+
+// projectMutatingProcedures is the set of procedures that modify resources
+// of the Project named by their requests. These are rejected for archived
+// Projects. Procedures that modify resources without naming a Project in
+// their requests (e.g. CreateResource) are instead rejected by the webhooks.
+var projectMutatingProcedures = map[string]struct{}{
+	svcv1alpha1connect.KargoServiceUpdateMetadataProcedure:           {},
+	svcv1alpha1connect.KargoServiceDeleteStageProcedure:              {},
+	svcv1alpha1connect.KargoServiceRenameStageProcedure:              {},
+	svcv1alpha1connect.KargoServicePromoteStageProcedure:             {},
+	svcv1alpha1connect.KargoServicePromoteSubscribersProcedure:       {},
+	svcv1alpha1connect.KargoServiceRefreshStageProcedure:             {},
+	svcv1alpha1connect.KargoServiceSetAutoPromotionForStageProcedure: {},
+	svcv1alpha1connect.KargoServiceDeletePromotionPolicyProcedure:    {},
+	svcv1alpha1connect.KargoServiceApproveFreightProcedure:           {},
+	svcv1alpha1connect.KargoServiceAttachBuildInfoProcedure:          {},
+	svcv1alpha1connect.KargoServiceDeleteWarehouseProcedure:          {},
+	svcv1alpha1connect.KargoServiceRefreshWarehouseProcedure:         {},
+}
+
+// projectRequest is implemented by requests that name a Project.
+type projectRequest interface {
+	GetProject() string
+}
+
+// archiveInterceptor rejects requests that would modify resources of an
+// archived Project.
+type archiveInterceptor struct {
+	isProjectArchivedFn func(ctx context.Context, project string) (bool, error)
+}
+
+func newArchiveInterceptor(
+	isProjectArchivedFn func(ctx context.Context, project string) (bool, error),
+) connect.Interceptor {
+	return &archiveInterceptor{
+		isProjectArchivedFn: isProjectArchivedFn,
+	}
+}
+
+func (i *archiveInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		if _, ok := projectMutatingProcedures[req.Spec().Procedure]; ok {
+			if err := i.check(ctx, req.Any()); err != nil {
+				return nil, err
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *archiveInterceptor) WrapStreamingClient(
+	next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *archiveInterceptor) WrapStreamingHandler(
+	next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if _, ok := projectMutatingProcedures[conn.Spec().Procedure]; !ok {
+			return next(ctx, conn)
+		}
+		return next(ctx, &archiveStreamingHandlerConn{
+			StreamingHandlerConn: conn,
+			ctx:                  ctx,
+			interceptor:          i,
+		})
+	}
+}
+
+// check returns a FailedPrecondition error if the provided request message
+// names an archived Project.
+func (i *archiveInterceptor) check(ctx context.Context, msg any) error {
+	pr, ok := msg.(projectRequest)
+	if !ok || pr.GetProject() == "" {
+		return nil
+	}
+	archived, err := i.isProjectArchivedFn(ctx, pr.GetProject())
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if archived {
+		return connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"project %q is archived and must be unarchived before it can be modified",
+				pr.GetProject(),
+			),
+		)
+	}
+	return nil
+}
+
+// archiveStreamingHandlerConn checks each message received by a streaming
+// handler against the archived Projects of an archiveInterceptor.
+type archiveStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	ctx         context.Context
+	interceptor *archiveInterceptor
+}
+
+func (c *archiveStreamingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return c.interceptor.check(c.ctx, msg)
+}
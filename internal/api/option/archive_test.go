@@ -0,0 +1,183 @@
+package option
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+// procedureRequest wraps a request to report the specified procedure, which
+// is otherwise only set for requests received by a handler.
+type procedureRequest struct {
+	connect.AnyRequest
+	procedure string
+}
+
+func (p *procedureRequest) Spec() connect.Spec {
+	return connect.Spec{Procedure: p.procedure}
+}
+
+func TestArchiveInterceptor(t *testing.T) {
+	isProjectArchived := func(_ context.Context, project string) (bool, error) {
+		switch project {
+		case "fake-archived-project":
+			return true, nil
+		case "fake-broken-project":
+			return false, errors.New("something went wrong")
+		}
+		return false, nil
+	}
+	testCases := []struct {
+		name       string
+		req        connect.AnyRequest
+		assertions func(error)
+	}{
+		{
+			name: "read-only procedure in archived project",
+			req: &procedureRequest{
+				AnyRequest: connect.NewRequest(&svcv1alpha1.GetStageRequest{
+					Project: "fake-archived-project",
+					Name:    "fake-stage",
+				}),
+				procedure: svcv1alpha1connect.KargoServiceGetStageProcedure,
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "mutating procedure in active project",
+			req: &procedureRequest{
+				AnyRequest: connect.NewRequest(&svcv1alpha1.ApproveFreightRequest{
+					Project: "fake-project",
+					Id:      "fake-freight",
+					Stage:   "fake-stage",
+				}),
+				procedure: svcv1alpha1connect.KargoServiceApproveFreightProcedure,
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error looking up project",
+			req: &procedureRequest{
+				AnyRequest: connect.NewRequest(&svcv1alpha1.RefreshStageRequest{
+					Project: "fake-broken-project",
+					Name:    "fake-stage",
+				}),
+				procedure: svcv1alpha1connect.KargoServiceRefreshStageProcedure,
+			},
+			assertions: func(err error) {
+				require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "mutating procedure in archived project",
+			req: &procedureRequest{
+				AnyRequest: connect.NewRequest(&svcv1alpha1.ApproveFreightRequest{
+					Project: "fake-archived-project",
+					Id:      "fake-freight",
+					Stage:   "fake-stage",
+				}),
+				procedure: svcv1alpha1connect.KargoServiceApproveFreightProcedure,
+			},
+			assertions: func(err error) {
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				require.ErrorContains(t, err, `project "fake-archived-project" is archived`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var called bool
+			next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+				called = true
+				return nil, nil
+			}
+			_, err := newArchiveInterceptor(isProjectArchived).WrapUnary(next)(
+				context.Background(),
+				testCase.req,
+			)
+			testCase.assertions(err)
+			require.Equal(t, err == nil, called)
+		})
+	}
+}
+
+// fakeStreamingHandlerConn is a connect.StreamingHandlerConn that receives a
+// single request message for the specified procedure.
+type fakeStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	procedure string
+	req       proto.Message
+}
+
+func (f *fakeStreamingHandlerConn) Spec() connect.Spec {
+	return connect.Spec{Procedure: f.procedure}
+}
+
+func (f *fakeStreamingHandlerConn) Receive(msg any) error {
+	proto.Merge(msg.(proto.Message), f.req) // nolint: forcetypeassert
+	return nil
+}
+
+func TestArchiveInterceptorStreaming(t *testing.T) {
+	isProjectArchived := func(_ context.Context, project string) (bool, error) {
+		return project == "fake-archived-project", nil
+	}
+	testCases := []struct {
+		name       string
+		project    string
+		assertions func(error)
+	}{
+		{
+			name:    "mutating procedure in active project",
+			project: "fake-project",
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "mutating procedure in archived project",
+			project: "fake-archived-project",
+			assertions: func(err error) {
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				require.ErrorContains(t, err, `project "fake-archived-project" is archived`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var called bool
+			next := func(_ context.Context, conn connect.StreamingHandlerConn) error {
+				if err := conn.Receive(&svcv1alpha1.PromoteSubscribersRequest{}); err != nil {
+					return err
+				}
+				called = true
+				return nil
+			}
+			err := newArchiveInterceptor(isProjectArchived).WrapStreamingHandler(next)(
+				context.Background(),
+				&fakeStreamingHandlerConn{
+					procedure: svcv1alpha1connect.KargoServicePromoteSubscribersProcedure,
+					req: &svcv1alpha1.PromoteSubscribersRequest{
+						Project: testCase.project,
+						Stage:   "fake-stage",
+					},
+				},
+			)
+			testCase.assertions(err)
+			require.Equal(t, err == nil, called)
+		})
+	}
+}
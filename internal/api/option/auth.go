@@ -18,7 +18,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/akuity/kargo/internal/api/config"
+	libOIDC "github.com/akuity/kargo/internal/api/oidc"
+	"github.com/akuity/kargo/internal/api/revocation"
 	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
 const authHeaderKey = "Authorization"
@@ -28,6 +31,35 @@ var exemptProcedures = map[string]struct{}{
 	"/grpc.health.v1.Health/Watch":                                   {},
 	"/akuity.io.kargo.service.v1alpha1.KargoService/GetPublicConfig": {},
 	"/akuity.io.kargo.service.v1alpha1.KargoService/AdminLogin":      {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/KubernetesLogin": {},
+}
+
+// readOnlyProcedures is the set of procedures that users restricted to
+// read-only access are permitted to invoke. DiffFreight is deliberately absent,
+// since it makes use of a Project's repository credentials.
+var readOnlyProcedures = map[string]struct{}{
+	svcv1alpha1connect.KargoServiceGetVersionInfoProcedure:              {},
+	svcv1alpha1connect.KargoServiceGetConfigProcedure:                   {},
+	svcv1alpha1connect.KargoServiceListStagesProcedure:                  {},
+	svcv1alpha1connect.KargoServiceGetStageProcedure:                    {},
+	svcv1alpha1connect.KargoServiceListEventsProcedure:                  {},
+	svcv1alpha1connect.KargoServiceWatchStagesProcedure:                 {},
+	svcv1alpha1connect.KargoServiceExplainPromotionEligibilityProcedure: {},
+	svcv1alpha1connect.KargoServiceListPromotionsProcedure:              {},
+	svcv1alpha1connect.KargoServiceWatchPromotionsProcedure:             {},
+	svcv1alpha1connect.KargoServiceGetPromotionProcedure:                {},
+	svcv1alpha1connect.KargoServiceWatchPromotionProcedure:              {},
+	svcv1alpha1connect.KargoServiceListPromotionPoliciesProcedure:       {},
+	svcv1alpha1connect.KargoServiceGetPromotionPolicyProcedure:          {},
+	svcv1alpha1connect.KargoServiceListProjectsProcedure:                {},
+	svcv1alpha1connect.KargoServiceGetBackstageEntityStatusProcedure:    {},
+	svcv1alpha1connect.KargoServiceQueryFreightProcedure:                {},
+	svcv1alpha1connect.KargoServiceListWarehousesProcedure:              {},
+	svcv1alpha1connect.KargoServiceGetWarehouseProcedure:                {},
+	svcv1alpha1connect.KargoServiceExplainDiscoveryProcedure:            {},
+	svcv1alpha1connect.KargoServiceWatchWarehousesProcedure:             {},
+	svcv1alpha1connect.KargoServiceGetOperationProcedure:                {},
+	svcv1alpha1connect.KargoServiceListOperationsProcedure:              {},
 }
 
 // authInterceptor implements connect.Interceptor and is used to retrieve the
@@ -45,21 +77,41 @@ type authInterceptor struct {
 		ctx context.Context,
 		rawToken string,
 	) (string, []string, bool)
-	oidcTokenVerifyFn   goOIDCIDTokenVerifyFn
-	oidcExtractGroupsFn func(*oidc.IDToken) ([]string, error)
+	oidcTokenVerifyFn     goOIDCIDTokenVerifyFn
+	oidcExtractIdentityFn func(*oidc.IDToken) (string, []string, error)
+
+	// isTokenRevokedFn, if non-nil, is used to determine whether a token with
+	// the specified ID has been revoked.
+	isTokenRevokedFn func(id string) bool
+
+	// anonymousProcedures is the set of procedures that may be invoked without
+	// authenticating. It is populated from the server's AnonymousAccessConfig.
+	anonymousProcedures map[string]struct{}
 }
 
 // goOIDCIDTokenVerifyFn is a github.com/coreos/go-oidc/v3/oidc/IDTokenVerifier.Verify() function
 type goOIDCIDTokenVerifyFn func(ctx context.Context, rawIDToken string) (*oidc.IDToken, error)
 
-// newAuthInterceptor returns an initialized *authInterceptor.
+// newAuthInterceptor returns an initialized *authInterceptor. If the provided
+// revocation list is non-nil, it will be consulted to reject revoked tokens.
 func newAuthInterceptor(
 	ctx context.Context,
 	cfg config.ServerConfig,
+	revokedTokens *revocation.List,
 ) (*authInterceptor, error) {
 	a := &authInterceptor{
 		cfg: cfg,
 	}
+	if revokedTokens != nil {
+		a.isTokenRevokedFn = revokedTokens.IsRevoked
+	}
+	if cfg.AnonymousAccessConfig != nil {
+		var err error
+		if a.anonymousProcedures, err =
+			getAnonymousProcedures(cfg.AnonymousAccessConfig.Procedures); err != nil {
+			return nil, err
+		}
+	}
 	if cfg.OIDCConfig != nil {
 		var err error
 		a.oidcTokenVerifyFn, err = newMultiClientVerifier(ctx, cfg)
@@ -71,10 +123,34 @@ func newAuthInterceptor(
 		jwt.NewParser(jwt.WithoutClaimsValidation()).ParseUnverified
 	a.verifyKargoIssuedTokenFn = a.verifyKargoIssuedToken
 	a.verifyIDPIssuedTokenFn = a.verifyIDPIssuedToken
-	a.oidcExtractGroupsFn = oidcExtractGroups
+	a.oidcExtractIdentityFn = a.oidcExtractIdentity
 	return a, nil
 }
 
+// getAnonymousProcedures resolves the provided RPC names to the fully-qualified
+// procedures they correspond to. Since anonymous users are restricted to
+// read-only access, an error is returned if any of the RPCs is not a read-only
+// one.
+func getAnonymousProcedures(names []string) (map[string]struct{}, error) {
+	procedures := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		procedure :=
+			fmt.Sprintf("/%s/%s", svcv1alpha1connect.KargoServiceName, name)
+		if _, ok := readOnlyProcedures[procedure]; !ok {
+			return nil, errors.Errorf(
+				"%q is not a read-only RPC and cannot permit anonymous access",
+				name,
+			)
+		}
+		procedures[procedure] = struct{}{}
+	}
+	return procedures, nil
+}
+
 // newMultiClientVerifier returns a function that implements go-oidc IDTokenVerifier.Verify()
 // but iterates through multiple verifiers. We commonly have both a CLI and Web OIDC client,
 // each needing it's own OIDC verification.
@@ -210,6 +286,9 @@ func (a *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 			a.authenticate(ctx, req.Spec().Procedure, req.Header()); err != nil {
 			return nil, connect.NewError(connect.CodeUnauthenticated, err)
 		}
+		if err = authorize(ctx, req.Spec().Procedure); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
 		return next(ctx, req)
 	}
 }
@@ -240,6 +319,9 @@ func (a *authInterceptor) WrapStreamingHandler(
 		); err != nil {
 			return connect.NewError(connect.CodeUnauthenticated, err)
 		}
+		if err = authorize(ctx, conn.Spec().Procedure); err != nil {
+			return connect.NewError(connect.CodePermissionDenied, err)
+		}
 		return next(ctx, conn)
 	}
 }
@@ -261,6 +343,29 @@ func (a *authInterceptor) authenticate(
 
 	rawToken := strings.TrimPrefix(header.Get(authHeaderKey), "Bearer ")
 	if rawToken == "" {
+		// Clients that presented a certificate that was already verified during
+		// the TLS handshake are identified by that certificate's subject.
+		if cert := clientCertFromContext(ctx); cert != nil {
+			return user.ContextWithInfo(
+				ctx,
+				user.Info{
+					Username: cert.Subject.CommonName,
+					Groups:   cert.Subject.Organization,
+					ReadOnly: a.isReadOnlyGroupMember(cert.Subject.Organization),
+				},
+			), nil
+		}
+		// Unauthenticated users may only invoke procedures that have explicitly
+		// been opened up to anonymous access, and even then, only with read-only
+		// permissions.
+		if _, ok := a.anonymousProcedures[procedure]; ok {
+			return user.ContextWithInfo(
+				ctx,
+				user.Info{
+					ReadOnly: true,
+				},
+			), nil
+		}
 		return ctx, errors.New("no token provided")
 	}
 
@@ -269,7 +374,7 @@ func (a *authInterceptor) authenticate(
 	// Note: If this is a JWT, we cannot trust these claims yet because we're not
 	// verifying the token yet. We use untrustedClaims.Issuer only as a hint as to
 	// HOW we might be able to verify the token further.
-	untrustedClaims := jwt.RegisteredClaims{}
+	untrustedClaims := user.Claims{}
 	if _, _, err := a.parseUnverifiedJWTFn(rawToken, &untrustedClaims); err != nil {
 		// This token isn't a JWT, so it's probably an opaque bearer token for the
 		// Kubernetes API server. Just run with it. If we're wrong, Kubernetes API
@@ -284,7 +389,8 @@ func (a *authInterceptor) authenticate(
 
 	// If we get to here, we're dealing with a JWT. It could have been issued:
 	//
-	//   1. Directly by the Kargo API server (in the case of admin)
+	//   1. Directly by the Kargo API server (in the case of admin, viewers, or
+	//      Kubernetes users who have exchanged their credentials for a session)
 	//   2. By Kargo's OpenID Connect identity provider
 	//   3. By the Kubernetes cluster's identity provider
 	//   4. By Kubernetes itself (a service account token, perhaps)
@@ -292,7 +398,32 @@ func (a *authInterceptor) authenticate(
 	if a.cfg.AdminConfig != nil &&
 		untrustedClaims.Issuer == a.cfg.AdminConfig.TokenIssuer {
 		// Case 1: This token was allegedly issued directly by the Kargo API server.
+		// Once the token is verified, its claims can be trusted. Tokens that carry
+		// the read-only claim are viewer tokens rather than admin tokens.
 		if a.verifyKargoIssuedTokenFn(rawToken) {
+			if a.isRevoked(untrustedClaims.ID) {
+				return ctx, errors.New("token has been revoked")
+			}
+			if untrustedClaims.Kubernetes {
+				// This token was issued in exchange for the credentials of a
+				// Kubernetes user.
+				return user.ContextWithInfo(
+					ctx,
+					user.Info{
+						Username:       untrustedClaims.Subject,
+						Groups:         untrustedClaims.Groups,
+						KubernetesUser: true,
+					},
+				), nil
+			}
+			if untrustedClaims.ReadOnly {
+				return user.ContextWithInfo(
+					ctx,
+					user.Info{
+						ReadOnly: true,
+					},
+				), nil
+			}
 			return user.ContextWithInfo(
 				ctx,
 				user.Info{
@@ -309,11 +440,15 @@ func (a *authInterceptor) authenticate(
 		// identity provider.
 		username, groups, ok := a.verifyIDPIssuedTokenFn(ctx, rawToken)
 		if ok {
+			if a.isRevoked(untrustedClaims.ID) {
+				return ctx, errors.New("token has been revoked")
+			}
 			return user.ContextWithInfo(
 				ctx,
 				user.Info{
 					Username: username,
 					Groups:   groups,
+					ReadOnly: a.isReadOnlyGroupMember(groups),
 				},
 			), nil
 		}
@@ -332,6 +467,44 @@ func (a *authInterceptor) authenticate(
 	), nil
 }
 
+// authorize examines context-bound user.Info and returns an error if the user
+// is restricted to read-only access and the specified procedure is not one of
+// the read-only procedures. It is the caller's responsibility to wrap the error
+// appropriately to convey to the client that the operation is not permitted.
+func authorize(ctx context.Context, procedure string) error {
+	u, ok := user.InfoFromContext(ctx)
+	if !ok || !u.ReadOnly {
+		return nil
+	}
+	if _, ok = readOnlyProcedures[procedure]; !ok {
+		return errors.Errorf("read-only users may not invoke %s", procedure)
+	}
+	return nil
+}
+
+// isRevoked returns a boolean indicating whether the token with the specified
+// ID has been revoked. Note that this should only be called for tokens that
+// have already been verified, since only then can their ID be trusted.
+func (a *authInterceptor) isRevoked(id string) bool {
+	return a.isTokenRevokedFn != nil && a.isTokenRevokedFn(id)
+}
+
+// isReadOnlyGroupMember returns a boolean indicating whether any of the
+// provided groups is one of the configured read-only groups.
+func (a *authInterceptor) isReadOnlyGroupMember(groups []string) bool {
+	if a.cfg.OIDCConfig == nil {
+		return false
+	}
+	for _, group := range groups {
+		for _, readOnlyGroup := range a.cfg.OIDCConfig.ReadOnlyGroups {
+			if group == readOnlyGroup {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // verifyIDPIssuedToken attempts to verify that the provided raw token was
 // issued by Kargo's OpenID Connect identity provider. On success, username, and
 // groups are extracted and returned along with a true boolean. If the provided
@@ -350,11 +523,11 @@ func (a *authInterceptor) verifyIDPIssuedToken(
 	if err != nil {
 		return "", nil, false
 	}
-	groups, err := a.oidcExtractGroupsFn(token)
+	username, groups, err := a.oidcExtractIdentityFn(token)
 	if err != nil {
 		return "", nil, false
 	}
-	return token.Subject, groups, true
+	return username, groups, true
 }
 
 // verifyKargoIssuedToken attempts to verify that the provided raw token was
@@ -373,10 +546,19 @@ func (a *authInterceptor) verifyKargoIssuedToken(rawToken string) bool {
 	return err == nil
 }
 
-func oidcExtractGroups(token *oidc.IDToken) ([]string, error) {
-	var claims struct {
-		Groups []string `json:"groups"`
+// oidcExtractIdentity extracts a username and groups from the claims of the
+// provided token, according to the claim mapping specified by the server's
+// OpenID Connect configuration.
+func (a *authInterceptor) oidcExtractIdentity(
+	token *oidc.IDToken,
+) (string, []string, error) {
+	claims := map[string]any{}
+	if err := token.Claims(&claims); err != nil {
+		return "", nil, err
+	}
+	var cfg libOIDC.Config
+	if a.cfg.OIDCConfig != nil {
+		cfg = *a.cfg.OIDCConfig
 	}
-	err := token.Claims(&claims)
-	return claims.Groups, err
+	return cfg.ExtractIdentity(claims)
 }
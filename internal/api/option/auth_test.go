@@ -2,6 +2,8 @@ package option
 
 import (
 	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -18,6 +20,7 @@ import (
 	"github.com/akuity/kargo/internal/api/dex"
 	libOIDC "github.com/akuity/kargo/internal/api/oidc"
 	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
 // This is self-signed and completely useless CA cert just for testing purposes.
@@ -46,13 +49,57 @@ c1e3
 -----END CERTIFICATE-----`)
 
 func TestNewAuthInterceptor(t *testing.T) {
-	a, err := newAuthInterceptor(context.Background(), config.ServerConfig{})
+	a, err := newAuthInterceptor(context.Background(), config.ServerConfig{}, nil)
 	require.NoError(t, err)
 	require.NotNil(t, a)
 	require.NotNil(t, a.parseUnverifiedJWTFn)
 	require.NotNil(t, a.verifyKargoIssuedTokenFn)
 	require.NotNil(t, a.verifyIDPIssuedTokenFn)
-	require.NotNil(t, a.oidcExtractGroupsFn)
+	require.NotNil(t, a.oidcExtractIdentityFn)
+}
+
+func TestGetAnonymousProcedures(t *testing.T) {
+	testCases := []struct {
+		name       string
+		names      []string
+		assertions func(map[string]struct{}, error)
+	}{
+		{
+			name:  "mutating RPC",
+			names: []string{"ListStages", "PromoteStage"},
+			assertions: func(_ map[string]struct{}, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), `"PromoteStage" is not a read-only RPC`)
+			},
+		},
+		{
+			name:  "unknown RPC",
+			names: []string{"FakeRPC"},
+			assertions: func(_ map[string]struct{}, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:  "success",
+			names: []string{"ListStages", " WatchStages", ""},
+			assertions: func(procedures map[string]struct{}, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]struct{}{
+						svcv1alpha1connect.KargoServiceListStagesProcedure:  {},
+						svcv1alpha1connect.KargoServiceWatchStagesProcedure: {},
+					},
+					procedures,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(getAnonymousProcedures(testCase.names))
+		})
+	}
 }
 
 func TestGetKeySet(t *testing.T) {
@@ -164,6 +211,7 @@ func TestAuthenticate(t *testing.T) {
 		procedure       string
 		authInterceptor *authInterceptor
 		token           string
+		clientCert      *x509.Certificate
 		assertions      func(ctx context.Context, err error)
 	}{
 		"exempt procedure": {
@@ -178,7 +226,8 @@ func TestAuthenticate(t *testing.T) {
 			},
 		},
 		"no token provided": {
-			procedure: testProcedure,
+			procedure:       testProcedure,
+			authInterceptor: &authInterceptor{},
 			// It's an error if no token is provided.
 			assertions: func(ctx context.Context, err error) {
 				require.Error(t, err)
@@ -187,6 +236,45 @@ func TestAuthenticate(t *testing.T) {
 				require.False(t, ok)
 			},
 		},
+		"no token provided, but verified client certificate presented": {
+			procedure:       testProcedure,
+			authInterceptor: &authInterceptor{},
+			clientCert: &x509.Certificate{
+				Subject: pkix.Name{
+					CommonName:   "ci-bot",
+					Organization: []string{"ci"},
+				},
+			},
+			// We expect user info derived from the certificate's subject to be bound
+			// to the context.
+			assertions: func(ctx context.Context, err error) {
+				require.NoError(t, err)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.False(t, u.IsAdmin)
+				require.Equal(t, "ci-bot", u.Username)
+				require.Equal(t, []string{"ci"}, u.Groups)
+				require.Empty(t, u.BearerToken)
+			},
+		},
+		"no token provided for anonymous procedure": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				anonymousProcedures: map[string]struct{}{
+					testProcedure: {},
+				},
+			},
+			// Anonymous users are bound to the context as read-only users.
+			assertions: func(ctx context.Context, err error) {
+				require.NoError(t, err)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.True(t, u.ReadOnly)
+				require.False(t, u.IsAdmin)
+				require.Empty(t, u.Username)
+				require.Empty(t, u.BearerToken)
+			},
+		},
 		"non-JWT token": {
 			procedure: testProcedure,
 			authInterceptor: &authInterceptor{
@@ -217,7 +305,7 @@ func TestAuthenticate(t *testing.T) {
 					},
 				},
 				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
-					rc, ok := claims.(*jwt.RegisteredClaims)
+					rc, ok := claims.(*user.Claims)
 					require.True(t, ok)
 					rc.Issuer = testKargoIssuer
 					return nil, nil, nil
@@ -247,7 +335,7 @@ func TestAuthenticate(t *testing.T) {
 					},
 				},
 				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
-					rc, ok := claims.(*jwt.RegisteredClaims)
+					rc, ok := claims.(*user.Claims)
 					require.True(t, ok)
 					rc.Issuer = testKargoIssuer
 					return nil, nil, nil
@@ -269,6 +357,105 @@ func TestAuthenticate(t *testing.T) {
 				require.Empty(t, u.BearerToken)
 			},
 		},
+		"success verifying Kargo-issued viewer token": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{
+					AdminConfig: &config.AdminConfig{
+						TokenIssuer: testKargoIssuer,
+					},
+				},
+				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
+					c, ok := claims.(*user.Claims)
+					require.True(t, ok)
+					c.Issuer = testKargoIssuer
+					c.ReadOnly = true
+					return nil, nil, nil
+				},
+				verifyKargoIssuedTokenFn: func(rawToken string) bool {
+					return true
+				},
+			},
+			token: testToken,
+			// If this is successful, we expect that user info for a read-only user
+			// is bound to the context.
+			assertions: func(ctx context.Context, err error) {
+				require.NoError(t, err)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.False(t, u.IsAdmin)
+				require.True(t, u.ReadOnly)
+				require.Empty(t, u.Username)
+				require.Empty(t, u.Groups)
+				require.Empty(t, u.BearerToken)
+			},
+		},
+		"success verifying Kargo-issued Kubernetes user token": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{
+					AdminConfig: &config.AdminConfig{
+						TokenIssuer: testKargoIssuer,
+					},
+				},
+				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
+					c, ok := claims.(*user.Claims)
+					require.True(t, ok)
+					c.Issuer = testKargoIssuer
+					c.Subject = "system:serviceaccount:kargo-demo:ci"
+					c.Groups = []string{"system:serviceaccounts"}
+					c.Kubernetes = true
+					return nil, nil, nil
+				},
+				verifyKargoIssuedTokenFn: func(rawToken string) bool {
+					return true
+				},
+			},
+			token: testToken,
+			// If this is successful, we expect that user info for the Kubernetes
+			// user is bound to the context.
+			assertions: func(ctx context.Context, err error) {
+				require.NoError(t, err)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.False(t, u.IsAdmin)
+				require.False(t, u.ReadOnly)
+				require.True(t, u.KubernetesUser)
+				require.Equal(t, "system:serviceaccount:kargo-demo:ci", u.Username)
+				require.Equal(t, []string{"system:serviceaccounts"}, u.Groups)
+				require.Empty(t, u.BearerToken)
+			},
+		},
+		"revoked Kargo-issued token": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{
+					AdminConfig: &config.AdminConfig{
+						TokenIssuer: testKargoIssuer,
+					},
+				},
+				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
+					c, ok := claims.(*user.Claims)
+					require.True(t, ok)
+					c.Issuer = testKargoIssuer
+					c.ID = "fake-id"
+					return nil, nil, nil
+				},
+				verifyKargoIssuedTokenFn: func(rawToken string) bool {
+					return true
+				},
+				isTokenRevokedFn: func(id string) bool {
+					return id == "fake-id"
+				},
+			},
+			token: testToken,
+			assertions: func(ctx context.Context, err error) {
+				require.Error(t, err)
+				require.Equal(t, "token has been revoked", err.Error())
+				_, ok := user.InfoFromContext(ctx)
+				require.False(t, ok)
+			},
+		},
 		"failure verifying IDP-issued token": {
 			procedure: testProcedure,
 			authInterceptor: &authInterceptor{
@@ -278,7 +465,7 @@ func TestAuthenticate(t *testing.T) {
 					},
 				},
 				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
-					rc, ok := claims.(*jwt.RegisteredClaims)
+					rc, ok := claims.(*user.Claims)
 					require.True(t, ok)
 					rc.Issuer = testIDPIssuer
 					return nil, nil, nil
@@ -311,7 +498,7 @@ func TestAuthenticate(t *testing.T) {
 					},
 				},
 				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
-					rc, ok := claims.(*jwt.RegisteredClaims)
+					rc, ok := claims.(*user.Claims)
 					require.True(t, ok)
 					rc.Issuer = testIDPIssuer
 					return nil, nil, nil
@@ -336,11 +523,44 @@ func TestAuthenticate(t *testing.T) {
 				require.Empty(t, u.BearerToken)
 			},
 		},
+		"success verifying IDP-issued token for read-only group member": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{
+					OIDCConfig: &libOIDC.Config{
+						IssuerURL:      testIDPIssuer,
+						ReadOnlyGroups: []string{"wallboards"},
+					},
+				},
+				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
+					rc, ok := claims.(*user.Claims)
+					require.True(t, ok)
+					rc.Issuer = testIDPIssuer
+					return nil, nil, nil
+				},
+				verifyIDPIssuedTokenFn: func(
+					context.Context,
+					string,
+				) (string, []string, bool) {
+					return "jarvis@starkindustries.com", []string{"wallboards"}, true
+				},
+			},
+			token: testToken,
+			assertions: func(ctx context.Context, err error) {
+				require.NoError(t, err)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.False(t, u.IsAdmin)
+				require.True(t, u.ReadOnly)
+				require.Equal(t, "jarvis@starkindustries.com", u.Username)
+				require.Equal(t, []string{"wallboards"}, u.Groups)
+			},
+		},
 		"unrecognized JWT": {
 			procedure: testProcedure,
 			authInterceptor: &authInterceptor{
 				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
-					rc, ok := claims.(*jwt.RegisteredClaims)
+					rc, ok := claims.(*user.Claims)
 					require.True(t, ok)
 					rc.Issuer = "unrecognized-issuer"
 					return nil, nil, nil
@@ -366,8 +586,12 @@ func TestAuthenticate(t *testing.T) {
 			if ts.token != "" {
 				header.Set("Authorization", ts.token)
 			}
+			ctx := context.Background()
+			if ts.clientCert != nil {
+				ctx = contextWithClientCert(ctx, ts.clientCert)
+			}
 			ctx, err := ts.authInterceptor.authenticate(
-				context.Background(),
+				ctx,
 				ts.procedure,
 				header,
 			)
@@ -376,6 +600,98 @@ func TestAuthenticate(t *testing.T) {
 	}
 }
 
+func TestAuthorize(t *testing.T) {
+	testCases := []struct {
+		name       string
+		userInfo   *user.Info
+		procedure  string
+		assertions func(error)
+	}{
+		{
+			name:      "no context-bound user.Info",
+			procedure: svcv1alpha1connect.KargoServicePromoteStageProcedure,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "unrestricted user",
+			userInfo:  &user.Info{IsAdmin: true},
+			procedure: svcv1alpha1connect.KargoServicePromoteStageProcedure,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "read-only user invoking read-only procedure",
+			userInfo:  &user.Info{ReadOnly: true},
+			procedure: svcv1alpha1connect.KargoServiceWatchStagesProcedure,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "read-only user invoking ListEvents",
+			userInfo:  &user.Info{ReadOnly: true},
+			procedure: svcv1alpha1connect.KargoServiceListEventsProcedure,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "read-only user invoking DiffFreight",
+			userInfo:  &user.Info{ReadOnly: true},
+			procedure: svcv1alpha1connect.KargoServiceDiffFreightProcedure,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "read-only users may not invoke")
+			},
+		},
+		{
+			name:      "read-only user invoking ExplainDiscovery",
+			userInfo:  &user.Info{ReadOnly: true},
+			procedure: svcv1alpha1connect.KargoServiceExplainDiscoveryProcedure,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "read-only user invoking GetOperation",
+			userInfo:  &user.Info{ReadOnly: true},
+			procedure: svcv1alpha1connect.KargoServiceGetOperationProcedure,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "read-only user invoking ListOperations",
+			userInfo:  &user.Info{ReadOnly: true},
+			procedure: svcv1alpha1connect.KargoServiceListOperationsProcedure,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "read-only user invoking mutating procedure",
+			userInfo:  &user.Info{ReadOnly: true},
+			procedure: svcv1alpha1connect.KargoServicePromoteStageProcedure,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "read-only users may not invoke")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.userInfo != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.userInfo)
+			}
+			testCase.assertions(authorize(ctx, testCase.procedure))
+		})
+	}
+}
+
 func TestVerifyIDPIssuedTokenFn(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -412,8 +728,8 @@ func TestVerifyIDPIssuedTokenFn(t *testing.T) {
 				) (*oidc.IDToken, error) {
 					return &oidc.IDToken{}, nil
 				},
-				oidcExtractGroupsFn: func(*oidc.IDToken) ([]string, error) {
-					return nil, errors.New("something went wrong")
+				oidcExtractIdentityFn: func(*oidc.IDToken) (string, []string, error) {
+					return "", nil, errors.New("something went wrong")
 				},
 			},
 			assertions: func(_ string, _ []string, ok bool) {
@@ -427,12 +743,10 @@ func TestVerifyIDPIssuedTokenFn(t *testing.T) {
 					context.Context,
 					string,
 				) (*oidc.IDToken, error) {
-					return &oidc.IDToken{
-						Subject: "tony@starkindustries.io",
-					}, nil
+					return &oidc.IDToken{}, nil
 				},
-				oidcExtractGroupsFn: func(*oidc.IDToken) ([]string, error) {
-					return []string{"avengers"}, nil
+				oidcExtractIdentityFn: func(*oidc.IDToken) (string, []string, error) {
+					return "tony@starkindustries.io", []string{"avengers"}, nil
 				},
 			},
 			assertions: func(username string, groups []string, ok bool) {
@@ -0,0 +1,44 @@
+package option
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+)
+
+type clientCertKey struct{}
+
+// WithClientCertificate returns an http.Handler that binds the leaf
+// certificate of any verified certificate chain presented by a client to the
+// request's context before handing the request off to the provided handler.
+// The auth interceptor will later use this certificate to authenticate the
+// client if no bearer token was presented.
+func WithClientCertificate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil &&
+			len(r.TLS.VerifiedChains) > 0 &&
+			len(r.TLS.VerifiedChains[0]) > 0 {
+			r = r.WithContext(
+				contextWithClientCert(r.Context(), r.TLS.VerifiedChains[0][0]),
+			)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// contextWithClientCert returns a context.Context that has been augmented with
+// the provided client certificate.
+func contextWithClientCert(
+	ctx context.Context,
+	cert *x509.Certificate,
+) context.Context {
+	return context.WithValue(ctx, clientCertKey{}, cert)
+}
+
+// clientCertFromContext extracts a verified client certificate from the
+// provided context.Context and returns it. If no certificate is found, nil is
+// returned.
+func clientCertFromContext(ctx context.Context) *x509.Certificate {
+	cert, _ := ctx.Value(clientCertKey{}).(*x509.Certificate)
+	return cert
+}
@@ -0,0 +1,56 @@
+package option
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithClientCertificate(t *testing.T) {
+	testCert := &x509.Certificate{}
+	testCases := []struct {
+		name       string
+		tlsState   *tls.ConnectionState
+		assertions func(*x509.Certificate)
+	}{
+		{
+			name: "no TLS",
+			assertions: func(cert *x509.Certificate) {
+				require.Nil(t, cert)
+			},
+		},
+		{
+			name:     "no verified certificate chains",
+			tlsState: &tls.ConnectionState{},
+			assertions: func(cert *x509.Certificate) {
+				require.Nil(t, cert)
+			},
+		},
+		{
+			name: "verified certificate chain",
+			tlsState: &tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{testCert}},
+			},
+			assertions: func(cert *x509.Certificate) {
+				require.Same(t, testCert, cert)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.TLS = testCase.tlsState
+			var cert *x509.Certificate
+			WithClientCertificate(
+				http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+					cert = clientCertFromContext(r.Context())
+				}),
+			).ServeHTTP(httptest.NewRecorder(), req)
+			testCase.assertions(cert)
+		})
+	}
+}
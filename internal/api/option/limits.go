@@ -0,0 +1,219 @@
+package option
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+var (
+	_ connect.Interceptor = &limitInterceptor{}
+)
+
+// requestLimits bounds the size and complexity of requests. A zero value for
+// any limit disables it.
+type requestLimits struct {
+	// maxBytes is the maximum size, in bytes, of a request message.
+	maxBytes int
+	// maxBatchSize is the maximum number of entries in any repeated or map
+	// field of a request message.
+	maxBatchSize int
+	// maxDepth is the maximum depth to which messages may be nested within a
+	// request message.
+	maxDepth int
+	// maxManifestObjects is the maximum number of objects in a manifest.
+	maxManifestObjects int
+}
+
+// manifestRequest is implemented by requests that carry a manifest of one or
+// more Kubernetes objects; e.g. CreateResourceRequest and
+// DeleteResourceRequest.
+type manifestRequest interface {
+	GetManifest() []byte
+}
+
+type limitInterceptor struct {
+	limits requestLimits
+}
+
+func newLimitInterceptor(limits requestLimits) connect.Interceptor {
+	return &limitInterceptor{
+		limits: limits,
+	}
+}
+
+func (i *limitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		if err := i.check(req.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *limitInterceptor) WrapStreamingClient(
+	next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *limitInterceptor) WrapStreamingHandler(
+	next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &limitStreamingHandlerConn{
+			StreamingHandlerConn: conn,
+			interceptor:          i,
+		})
+	}
+}
+
+// check returns a ResourceExhausted error if the provided request message
+// exceeds any of the limits.
+func (i *limitInterceptor) check(msg any) error {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	if i.limits.maxBytes > 0 {
+		if size := proto.Size(m); size > i.limits.maxBytes {
+			return connect.NewError(
+				connect.CodeResourceExhausted,
+				errors.Errorf(
+					"request of %d bytes exceeds the limit of %d bytes",
+					size,
+					i.limits.maxBytes,
+				),
+			)
+		}
+	}
+	if err := i.checkMessage(m.ProtoReflect(), 1); err != nil {
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	}
+	if mr, ok := msg.(manifestRequest); ok && i.limits.maxManifestObjects > 0 {
+		count, err := countManifestObjects(mr.GetManifest())
+		if err != nil {
+			return connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.Wrap(err, "error reading manifest"),
+			)
+		}
+		if count > i.limits.maxManifestObjects {
+			return connect.NewError(
+				connect.CodeResourceExhausted,
+				errors.Errorf(
+					"manifest of %d objects exceeds the limit of %d objects",
+					count,
+					i.limits.maxManifestObjects,
+				),
+			)
+		}
+	}
+	return nil
+}
+
+// checkMessage recursively checks the provided message, found at the
+// specified depth, against the batch size and depth limits.
+func (i *limitInterceptor) checkMessage(m protoreflect.Message, depth int) error {
+	if i.limits.maxDepth > 0 && depth > i.limits.maxDepth {
+		return errors.Errorf(
+			"request exceeds the limit of %d levels of nested messages",
+			i.limits.maxDepth,
+		)
+	}
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			list := v.List()
+			if err = i.checkBatchSize(fd, list.Len()); err != nil {
+				return false
+			}
+			if fd.Message() == nil {
+				return true
+			}
+			for j := 0; j < list.Len(); j++ {
+				if err = i.checkMessage(list.Get(j).Message(), depth+1); err != nil {
+					return false
+				}
+			}
+		case fd.IsMap():
+			mp := v.Map()
+			if err = i.checkBatchSize(fd, mp.Len()); err != nil {
+				return false
+			}
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			mp.Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				err = i.checkMessage(mv.Message(), depth+1)
+				return err == nil
+			})
+			if err != nil {
+				return false
+			}
+		case fd.Message() != nil:
+			if err = i.checkMessage(v.Message(), depth+1); err != nil {
+				return false
+			}
+		}
+		return true
+	})
+	return err
+}
+
+func (i *limitInterceptor) checkBatchSize(
+	fd protoreflect.FieldDescriptor,
+	count int,
+) error {
+	if i.limits.maxBatchSize > 0 && count > i.limits.maxBatchSize {
+		return errors.Errorf(
+			"field %q has %d entries, exceeding the limit of %d entries",
+			fd.Name(),
+			count,
+			i.limits.maxBatchSize,
+		)
+	}
+	return nil
+}
+
+// countManifestObjects returns the number of non-empty YAML documents in the
+// provided manifest.
+func countManifestObjects(manifest []byte) (int, error) {
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(manifest)))
+	var count int
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		if len(bytes.TrimSpace(doc)) > 0 {
+			count++
+		}
+	}
+}
+
+// limitStreamingHandlerConn checks each message received by a streaming
+// handler against the limits of a limitInterceptor.
+type limitStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	interceptor *limitInterceptor
+}
+
+func (c *limitStreamingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return c.interceptor.check(msg)
+}
@@ -0,0 +1,125 @@
+package option
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+func TestLimitInterceptor(t *testing.T) {
+	testCases := []struct {
+		name       string
+		limits     requestLimits
+		req        connect.AnyRequest
+		assertions func(error)
+	}{
+		{
+			name: "no limits",
+			req: connect.NewRequest(&svcv1alpha1.PromoteSubscribersRequest{
+				RolloutOrder: []string{"a", "b", "c"},
+			}),
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "within limits",
+			limits: requestLimits{maxBytes: 1024, maxBatchSize: 3, maxDepth: 4},
+			req: connect.NewRequest(&svcv1alpha1.PromoteSubscribersRequest{
+				RolloutOrder: []string{"a", "b", "c"},
+			}),
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "request too large",
+			limits: requestLimits{maxBytes: 16},
+			req: connect.NewRequest(&svcv1alpha1.GetStageRequest{
+				Name: strings.Repeat("a", 32),
+			}),
+			assertions: func(err error) {
+				require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+				require.ErrorContains(t, err, "exceeds the limit of 16 bytes")
+			},
+		},
+		{
+			name:   "too many names",
+			limits: requestLimits{maxBatchSize: 2},
+			req: connect.NewRequest(&svcv1alpha1.PromoteSubscribersRequest{
+				RolloutOrder: []string{"a", "b", "c"},
+			}),
+			assertions: func(err error) {
+				require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+				require.ErrorContains(t, err, `field "rollout_order" has 3 entries`)
+			},
+		},
+		{
+			name:   "too many map entries",
+			limits: requestLimits{maxBatchSize: 1},
+			req: connect.NewRequest(&svcv1alpha1.UpdateMetadataRequest{
+				Labels: map[string]string{"a": "1", "b": "2"},
+			}),
+			assertions: func(err error) {
+				require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+				require.ErrorContains(t, err, `field "labels" has 2 entries`)
+			},
+		},
+		{
+			name:   "many objects in manifest without manifest limit",
+			limits: requestLimits{maxBatchSize: 1},
+			req: connect.NewRequest(&svcv1alpha1.DeleteResourceRequest{
+				Manifest: []byte("kind: Stage\n---\nkind: Warehouse\n"),
+			}),
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "too many objects in manifest",
+			limits: requestLimits{maxManifestObjects: 1},
+			req: connect.NewRequest(&svcv1alpha1.DeleteResourceRequest{
+				Manifest: []byte("kind: Stage\n---\nkind: Warehouse\n"),
+			}),
+			assertions: func(err error) {
+				require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+				require.ErrorContains(t, err, "manifest of 2 objects")
+			},
+		},
+		{
+			name:   "messages nested too deeply",
+			limits: requestLimits{maxDepth: 2},
+			req: connect.NewRequest(&svcv1alpha1.AttachBuildInfoRequest{
+				BuildInfo: []*v1alpha1.BuildInfo{{
+					ReportedAt: timestamppb.Now(),
+				}},
+			}),
+			assertions: func(err error) {
+				require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+				require.ErrorContains(t, err, "limit of 2 levels")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var called bool
+			next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+				called = true
+				return nil, nil
+			}
+			_, err := newLimitInterceptor(testCase.limits).WrapUnary(next)(
+				context.Background(),
+				testCase.req,
+			)
+			testCase.assertions(err)
+			require.Equal(t, err == nil, called)
+		})
+	}
+}
@@ -10,25 +10,37 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/revocation"
 	"github.com/akuity/kargo/internal/logging"
 )
 
 func NewHandlerOption(
 	ctx context.Context,
 	cfg config.ServerConfig,
+	revokedTokens *revocation.List,
+	isProjectArchivedFn func(ctx context.Context, project string) (bool, error),
 ) (connect.HandlerOption, error) {
 	interceptors := []connect.Interceptor{
 		newLogInterceptor(logging.LoggerFromContext(ctx), loggingIgnorableMethods),
+		newLimitInterceptor(requestLimits{
+			maxBytes:           cfg.MaxRequestBytes,
+			maxBatchSize:       cfg.MaxRequestBatchSize,
+			maxDepth:           cfg.MaxRequestDepth,
+			maxManifestObjects: cfg.MaxManifestObjects,
+		}),
 	}
 	if !cfg.LocalMode {
-		authInterceptor, err := newAuthInterceptor(ctx, cfg)
+		authInterceptor, err := newAuthInterceptor(ctx, cfg, revokedTokens)
 		if err != nil {
 			return nil,
 				errors.Wrap(err, "error initializing authentication interceptor")
 		}
 		interceptors = append(interceptors, authInterceptor)
 	}
-	return connect.WithHandlerOptions(
+	// This follows the authentication interceptor so that Projects are looked
+	// up on behalf of the authenticated user.
+	interceptors = append(interceptors, newArchiveInterceptor(isProjectArchivedFn))
+	opts := []connect.HandlerOption{
 		connect.WithCodec(newJSONCodec("json")),
 		connect.WithCodec(newJSONCodec("json; charset=utf-8")),
 		connect.WithInterceptors(interceptors...),
@@ -38,5 +50,12 @@ func NewHandlerOption(
 				return connect.NewError(
 					connect.CodeInternal, fmt.Errorf("panic: %v", r))
 			}),
-	), nil
+	}
+	if cfg.MaxRequestBytes > 0 {
+		// Reject oversized requests before they are read into memory. The
+		// limit interceptor cannot do this because it sees requests only after
+		// they have been unmarshaled.
+		opts = append(opts, connect.WithReadMaxBytes(cfg.MaxRequestBytes))
+	}
+	return connect.WithHandlerOptions(opts...), nil
 }
@@ -2,18 +2,31 @@ package api
 
 import (
 	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	"github.com/akuity/kargo/internal/kargo"
+	"github.com/akuity/kargo/internal/logging"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
 // PromoteStage creates a Promotion resource to transition a specified Stage
-// into the state represented by the specified Freight.
+// into the state represented by the specified Freight. Alternatively, it can
+// create a Release resource to also progressively transition every Stage
+// downstream from the specified Stage into that same state.
 func (s *server) PromoteStage(
 	ctx context.Context,
 	req *connect.Request[svcv1alpha1.PromoteStageRequest],
@@ -21,6 +34,33 @@ func (s *server) PromoteStage(
 	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetName()); err != nil {
 		return nil, err // This already returns a connect.Error
 	}
+	// Stages promoted as part of a downstream rollout are, at minimum, verified
+	// before the rollout continues, so the soak period defaults to zero.
+	soak := &metav1.Duration{}
+	if req.Msg.Soak != nil {
+		if !req.Msg.GetToAllDownstream() {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("soak may only be specified when promoting to all downstream Stages"),
+			)
+		}
+		var err error
+		if soak, err = parseSoak(req.Msg.GetSoak()); err != nil {
+			return nil, err // This already returns a connect.Error
+		}
+	}
+	freightProject := req.Msg.GetFreightProject()
+	if freightProject == req.Msg.GetProject() {
+		freightProject = ""
+	}
+	if freightProject != "" && req.Msg.GetToAllDownstream() {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New(
+				"Freight from another Project cannot be promoted to all downstream Stages",
+			),
+		)
+	}
 	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
 		return nil, err // This already returns a connect.Error
 	}
@@ -46,38 +86,437 @@ func (s *server) PromoteStage(
 		)
 	}
 
-	// Get the specified Freight. Expect a nil if it is either not found or is
-	// not qualified for any of the upstream Stages. Errors are internal problems.
-	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
-	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
-		upstreamStages[i] = upstreamStage.Name
+	var freight *kargoapi.Freight
+	if freightProject != "" {
+		if freight, err = s.validateFreightFromProject(
+			ctx,
+			req.Msg.GetProject(),
+			freightProject,
+			req.Msg.GetFreight(),
+		); err != nil {
+			return nil, err // This already returns a connect.Error
+		}
+	} else {
+		// Get the specified Freight. Expect a nil if it is either not found or is
+		// neither approved for this Stage nor qualified for any of the upstream
+		// Stages. Errors are internal problems.
+		upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
+		for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
+			upstreamStages[i] = upstreamStage.Name
+		}
+		if freight, err = s.getPromotableFreightFn(
+			ctx,
+			s.client,
+			types.NamespacedName{
+				Namespace: req.Msg.GetProject(),
+				Name:      req.Msg.GetFreight(),
+			},
+			stage.Name,
+			upstreamStages,
+		); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		} else if freight == nil {
+			return nil, s.newFreightNotFoundError(
+				ctx,
+				req.Msg.GetProject(),
+				req.Msg.GetFreight(),
+			)
+		}
+	}
+	if err = s.validateFreightAgeFn(ctx, req.Msg.GetProject(), stage.Name, freight); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	if req.Msg.GetToAllDownstream() {
+		downstream, err := s.findDownstreamStagesFn(ctx, stage)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		for _, stageName := range downstream {
+			if err = s.validateFreightAgeFn(ctx, req.Msg.GetProject(), stageName, freight); err != nil {
+				return nil, err // This already returns a connect.Error
+			}
+		}
+		steps := make([]kargoapi.ReleaseStep, 0, len(downstream)+1)
+		for _, stageName := range append([]string{stage.Name}, downstream...) {
+			steps = append(steps, kargoapi.ReleaseStep{
+				Stage: stageName,
+				Soak:  soak.DeepCopy(),
+			})
+		}
+		release := kargo.NewRelease(*stage, req.Msg.GetFreight(), steps)
+		if err := s.createReleaseFn(ctx, &release); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return connect.NewResponse(&svcv1alpha1.PromoteStageResponse{
+			Release: typesv1alpha1.ToReleaseProto(release),
+		}), nil
+	}
+
+	promotion := kargo.NewPromotion(*stage, req.Msg.GetFreight())
+	promotion.Spec.FreightProject = freightProject
+	setPromoter(ctx, &promotion)
+	if err := s.createPromotionFn(ctx, &promotion); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&svcv1alpha1.PromoteStageResponse{
+		Promotion: s.toPromotionProto(ctx, promotion),
+	}), nil
+}
+
+// setPromoter records the user bound to the provided context as the promoter
+// of the provided Promotion. The API server usually creates Promotions using its
+// own ServiceAccount, so without this, the Promotion webhook would record the
+// API server itself as the promoter. Users whose requests are forwarded to
+// Kubernetes with their own credentials create Promotions as themselves, so
+// nothing is recorded for them here.
+func setPromoter(ctx context.Context, promo *kargoapi.Promotion) {
+	promoter := approverFromContext(ctx)
+	if promoter == "" {
+		return
+	}
+	if promo.Annotations == nil {
+		promo.Annotations = map[string]string{}
+	}
+	promo.Annotations[kargoapi.AnnotationKeyPromoter] = promoter
+}
+
+// validateFreightFromProject returns the specified Freight, or a connect.Error
+// if it does not belong to the specified freightProject, or if that Project
+// does not share its Freight with the specified Project. Freight from another
+// Project
+// is not required to be approved for, or to have qualified in any Stage
+// upstream from, the Stage into which it is promoted, since it is only ever
+// approved for, and qualified in, the Stages of its own Project.
+func (s *server) validateFreightFromProject(
+	ctx context.Context,
+	project string,
+	freightProject string,
+	freightName string,
+) (*kargoapi.Freight, error) {
+	if err := s.validateProjectFn(ctx, freightProject); err != nil {
+		return nil, err // This already returns a connect.Error
 	}
-	if freight, err := s.getQualifiedFreightFn(
+	shared, err := s.isFreightSharedWithFn(ctx, s.client, freightProject, project)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !shared {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.Errorf(
+				"Project %q does not share its Freight with Project %q; this "+
+					"requires Project %q to be annotated with %s=%s",
+				freightProject,
+				project,
+				freightProject,
+				kargoapi.AnnotationKeyFreightSharedWith,
+				project,
+			),
+		)
+	}
+	freight, err := s.getFreightFn(
 		ctx,
 		s.client,
 		types.NamespacedName{
-			Namespace: req.Msg.GetProject(),
-			Name:      req.Msg.GetFreight(),
+			Namespace: freightProject,
+			Name:      freightName,
 		},
-		upstreamStages,
-	); err != nil {
+	)
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
-	} else if freight == nil {
+	}
+	if freight == nil {
 		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Freight %q not found in namespace %q",
+				freightName,
+				freightProject,
+			),
+		)
+	}
+	return freight, nil
+}
+
+// promoteStaleVerb is the custom verb that permits a user to promote Freight
+// to a Stage even if the Freight is older than the maximum age permitted by
+// the Stage's PromotionPolicy.
+const promoteStaleVerb = "promote-stale"
+
+// validateFreightAge returns a connect.Error if the PromotionPolicy associated
+// with the specified Stage specifies a maximum Freight age that the provided
+// Freight exceeds, unless the user is permitted to use the custom
+// "promote-stale" verb on the Stage.
+func (s *server) validateFreightAge(
+	ctx context.Context,
+	project string,
+	stage string,
+	freight *kargoapi.Freight,
+) error {
+	policy, err := s.getStagePromotionPolicy(ctx, project, stage)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	now := s.nowFn()
+	if !policy.ExceedsMaxFreightAge(freight, now) {
+		return nil
+	}
+	if err = s.client.Authorize(
+		ctx,
+		promoteStaleVerb,
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: project,
+				Name:      stage,
+			},
+		},
+	); err == nil {
+		logging.LoggerFromContext(ctx).WithFields(logrus.Fields{
+			"stage":   stage,
+			"freight": freight.Name,
+		}).Info("permitting promotion of Freight older than the Stage's maximum Freight age")
+		return nil
+	} else if !errors.Is(err, kubernetes.ErrNotAllowed) {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewError(
+		connect.CodeFailedPrecondition,
+		errors.Errorf(
+			"Freight %q is %s old, which exceeds the maximum age of %s permitted "+
+				"for Stage %q by PromotionPolicy %q; promoting it anyway requires "+
+				"the %q verb on the Stage",
+			freight.Name,
+			now.Sub(freight.CreationTimestamp.Time).Truncate(time.Second),
+			policy.MaxFreightAge.Duration,
+			stage,
+			policy.Name,
+			promoteStaleVerb,
+		),
+	)
+}
+
+// getStagePromotionPolicy returns the PromotionPolicy associated with the
+// specified Stage. As in the Stage controller, if no PromotionPolicy, or more
+// than one PromotionPolicy, is associated with the Stage, nil is returned.
+func (s *server) getStagePromotionPolicy(
+	ctx context.Context,
+	project string,
+	stage string,
+) (*kargoapi.PromotionPolicy, error) {
+	var policies kargoapi.PromotionPolicyList
+	if err := s.client.List(ctx, &policies, client.InNamespace(project)); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error listing PromotionPolicies in namespace %q",
+			project,
+		)
+	}
+	var policy *kargoapi.PromotionPolicy
+	for i := range policies.Items {
+		if policies.Items[i].Stage != stage {
+			continue
+		}
+		if policy != nil {
+			return nil, nil
+		}
+		policy = &policies.Items[i]
+	}
+	return policy, nil
+}
+
+// newFreightNotFoundError returns a connect.Error indicating that no qualified
+// Freight with the specified name was found in the specified Project. Freight
+// IDs are commonly copied from one Project and mistakenly used in another, so
+// if Freight with the specified name instead belongs to any other Project(s),
+// the error names them and explains how to promote Freight from another
+// Project intentionally.
+func (s *server) newFreightNotFoundError(
+	ctx context.Context,
+	project string,
+	freightName string,
+) error {
+	otherProjects, err := s.findFreightProjectsFn(ctx, project, freightName)
+	if err != nil {
+		// This is only a hint, so don't fail for want of it
+		logging.LoggerFromContext(ctx).WithError(err).
+			Debug("error looking for Freight in other Projects")
+	}
+	if len(otherProjects) == 0 {
+		return connect.NewError(
 			connect.CodeNotFound,
 			errors.Errorf(
 				"no qualified Freight %q found in namespace %q",
-				req.Msg.GetFreight(),
-				req.Msg.GetProject(),
+				freightName,
+				project,
 			),
 		)
 	}
+	quoted := make([]string, len(otherProjects))
+	for i, otherProject := range otherProjects {
+		quoted[i] = strconv.Quote(otherProject)
+	}
+	belongsTo := "Project " + quoted[0]
+	if len(quoted) > 1 {
+		belongsTo = "Projects " + strings.Join(quoted, ", ")
+	}
+	return connect.NewError(
+		connect.CodeInvalidArgument,
+		errors.Errorf(
+			"Freight %q does not belong to Project %q; it belongs to %s. If "+
+				"promoting Freight from another Project is intentional, specify "+
+				"the Freight's Project (e.g. using kargo stage promote "+
+				"--project-of-freight); this is "+
+				"only permitted if that Project shares its Freight with Project %q",
+			freightName,
+			project,
+			belongsTo,
+			project,
+		),
+	)
+}
 
-	promotion := kargo.NewPromotion(*stage, req.Msg.GetFreight())
-	if err := s.createPromotionFn(ctx, &promotion); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+// findFreightProjects returns the names of all Projects, other than the
+// specified Project, to which Freight with the specified name belongs, if no
+// such Freight belongs to the specified Project. Only Projects and Freight
+// visible to the user are considered.
+func (s *server) findFreightProjects(
+	ctx context.Context,
+	project string,
+	freightName string,
+) ([]string, error) {
+	freight, err := s.getFreightFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      freightName,
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
-	return connect.NewResponse(&svcv1alpha1.PromoteStageResponse{
-		Promotion: typesv1alpha1.ToPromotionProto(promotion),
-	}), nil
+	if freight != nil {
+		// The Freight exists, but isn't qualified
+		return nil, nil
+	}
+	nsList := &corev1.NamespaceList{}
+	if err = s.client.List(
+		ctx,
+		nsList,
+		client.MatchingLabels{kargoapi.LabelProjectKey: kargoapi.LabelTrueValue},
+	); err != nil {
+		return nil, errors.Wrap(err, "error listing Projects")
+	}
+	var projects []string
+	for _, ns := range nsList.Items {
+		if ns.Name == project {
+			continue
+		}
+		freight, err := s.getFreightFn(
+			ctx,
+			s.client,
+			types.NamespacedName{
+				Namespace: ns.Name,
+				Name:      freightName,
+			},
+		)
+		if err != nil {
+			// Most likely, the user isn't permitted to read Freight in this
+			// Project, in which case it shouldn't be revealed to them anyway
+			continue
+		}
+		if freight != nil {
+			projects = append(projects, ns.Name)
+		}
+	}
+	sort.Strings(projects)
+	return projects, nil
+}
+
+// findDownstreamStages returns the names of all Stages that are directly or
+// transitively downstream from the given Stage, in an order in which they can
+// be promoted one at a time.
+func (s *server) findDownstreamStages(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) ([]string, error) {
+	var allStages kargoapi.StageList
+	if err := s.client.List(
+		ctx,
+		&allStages,
+		client.InNamespace(stage.Namespace),
+	); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error listing Stages in namespace %q",
+			stage.Namespace,
+		)
+	}
+	return sortDownstreamStages(stage.Name, allStages.Items), nil
+}
+
+// sortDownstreamStages returns the names of all Stages that are directly or
+// transitively downstream from the named Stage. Every Stage in the result is
+// preceded by any of its own upstream Stages that are also in the result. Stages
+// with no such ordering relative to one another are sorted by name. Stages that
+// are part of a cycle, and therefore cannot be ordered, are omitted.
+func sortDownstreamStages(root string, stages []kargoapi.Stage) []string {
+	subscribers := map[string][]string{}
+	for _, stage := range stages {
+		if stage.Spec == nil || stage.Spec.Subscriptions == nil {
+			continue
+		}
+		for _, upstream := range stage.Spec.Subscriptions.UpstreamStages {
+			subscribers[upstream.Name] = append(subscribers[upstream.Name], stage.Name)
+		}
+	}
+
+	// Find everything reachable from the root
+	downstream := map[string]struct{}{}
+	queue := []string{root}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, subscriber := range subscribers[name] {
+			if _, ok := downstream[subscriber]; ok || subscriber == root {
+				continue
+			}
+			downstream[subscriber] = struct{}{}
+			queue = append(queue, subscriber)
+		}
+	}
+
+	// Count how many of each Stage's upstream Stages must be promoted first
+	pending := make(map[string]int, len(downstream))
+	for name := range downstream {
+		for _, subscriber := range subscribers[name] {
+			if _, ok := downstream[subscriber]; ok {
+				pending[subscriber]++
+			}
+		}
+	}
+	var ready []string
+	for name := range downstream {
+		if pending[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	sorted := make([]string, 0, len(downstream))
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		name := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, name)
+		for _, subscriber := range subscribers[name] {
+			if _, ok := downstream[subscriber]; !ok {
+				continue
+			}
+			if pending[subscriber]--; pending[subscriber] == 0 {
+				ready = append(ready, subscriber)
+			}
+		}
+	}
+	return sorted
 }
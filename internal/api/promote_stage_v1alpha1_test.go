@@ -3,14 +3,23 @@ package api
 import (
 	"context"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -144,10 +153,11 @@ func TestPromoteStage(t *testing.T) {
 						},
 					}, nil
 				},
-				getQualifiedFreightFn: func(
+				getPromotableFreightFn: func(
 					context.Context,
 					client.Client,
 					types.NamespacedName,
+					string,
 					[]string,
 				) (*kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
@@ -192,14 +202,18 @@ func TestPromoteStage(t *testing.T) {
 						},
 					}, nil
 				},
-				getQualifiedFreightFn: func(
+				getPromotableFreightFn: func(
 					context.Context,
 					client.Client,
 					types.NamespacedName,
+					string,
 					[]string,
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
+				findFreightProjectsFn: func(context.Context, string, string) ([]string, error) {
+					return nil, nil
+				},
 			},
 			assertions: func(
 				_ *connect.Response[svcv1alpha1.PromoteStageResponse],
@@ -213,6 +227,246 @@ func TestPromoteStage(t *testing.T) {
 				require.Contains(t, connErr.Message(), "found in namespace")
 			},
 		},
+		{
+			name: "Freight belongs to another Project",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								Warehouse: "fake-warehouse",
+							},
+						},
+					}, nil
+				},
+				getPromotableFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					string,
+					[]string,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+				findFreightProjectsFn: func(context.Context, string, string) ([]string, error) {
+					return []string{"other-project"}, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+				require.Contains(t, connErr.Message(), `it belongs to Project "other-project"`)
+				require.Contains(t, connErr.Message(), "--project-of-freight")
+			},
+		},
+		{
+			name: "Freight from another Project promoted to all downstream Stages",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project:         "fake-project",
+				Name:            "fake-stage",
+				Freight:         "fake-freight",
+				FreightProject:  proto.String("other-project"),
+				ToAllDownstream: true,
+			},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "Freight Project does not share its Freight",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project:        "fake-project",
+				Name:           "fake-stage",
+				Freight:        "fake-freight",
+				FreightProject: proto.String("other-project"),
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								Warehouse: "fake-warehouse",
+							},
+						},
+					}, nil
+				},
+				isFreightSharedWithFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+				require.Contains(t, connErr.Message(), kargoapi.AnnotationKeyFreightSharedWith)
+			},
+		},
+		{
+			name: "success promoting Freight from another Project",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project:        "fake-project",
+				Name:           "fake-stage",
+				Freight:        "fake-freight",
+				FreightProject: proto.String("other-project"),
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-stage",
+							Namespace: "fake-project",
+						},
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								Warehouse: "fake-warehouse",
+							},
+						},
+					}, nil
+				},
+				isFreightSharedWithFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getFreightFn: func(
+					_ context.Context,
+					_ client.Client,
+					namespacedName types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					require.Equal(t, "other-project", namespacedName.Namespace)
+					return &kargoapi.Freight{}, nil
+				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"other-project",
+					res.Msg.GetPromotion().GetSpec().GetFreightProject(),
+				)
+			},
+		},
+		{
+			name: "Freight exceeds maximum age",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								Warehouse: "fake-warehouse",
+							},
+						},
+					}, nil
+				},
+				getPromotableFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					string,
+					[]string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return connect.NewError(
+						connect.CodeFailedPrecondition,
+						errors.New("too old"),
+					)
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+			},
+		},
 		{
 			name: "error creating Promotion",
 			req: &svcv1alpha1.PromoteStageRequest{
@@ -241,14 +495,23 @@ func TestPromoteStage(t *testing.T) {
 						},
 					}, nil
 				},
-				getQualifiedFreightFn: func(
+				getPromotableFreightFn: func(
 					context.Context,
 					client.Client,
 					types.NamespacedName,
+					string,
 					[]string,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
@@ -296,14 +559,23 @@ func TestPromoteStage(t *testing.T) {
 						},
 					}, nil
 				},
-				getQualifiedFreightFn: func(
+				getPromotableFreightFn: func(
 					context.Context,
 					client.Client,
 					types.NamespacedName,
+					string,
 					[]string,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
@@ -321,6 +593,99 @@ func TestPromoteStage(t *testing.T) {
 				require.NotNil(t, res.Msg.GetPromotion())
 			},
 		},
+		{
+			name: "soak specified without promoting to all downstream Stages",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Freight: "fake-freight",
+				Soak:    proto.String("1h"),
+			},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "success promoting to all downstream Stages",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project:         "fake-project",
+				Name:            "fake-stage",
+				Freight:         "fake-freight",
+				ToAllDownstream: true,
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-stage",
+							Namespace: "fake-project",
+						},
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								Warehouse: "fake-warehouse",
+							},
+						},
+					}, nil
+				},
+				getPromotableFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					string,
+					[]string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
+				findDownstreamStagesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]string, error) {
+					return []string{"fake-downstream-stage"}, nil
+				},
+				createReleaseFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Nil(t, res.Msg.GetPromotion())
+				steps := res.Msg.GetRelease().GetSpec().GetSteps()
+				require.Len(t, steps, 2)
+				require.Equal(t, "fake-stage", steps[0].GetStage())
+				require.Equal(t, "0s", steps[0].GetSoak())
+				require.Equal(t, "fake-downstream-stage", steps[1].GetStage())
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -333,3 +698,396 @@ func TestPromoteStage(t *testing.T) {
 		})
 	}
 }
+
+func TestPromoteStageRecordsPromoter(t *testing.T) {
+	testCases := []struct {
+		name       string
+		user       user.Info
+		assertions func(*kargoapi.Promotion)
+	}{
+		{
+			name: "admin",
+			user: user.Info{IsAdmin: true},
+			assertions: func(promo *kargoapi.Promotion) {
+				require.Equal(
+					t,
+					"admin",
+					promo.Annotations[kargoapi.AnnotationKeyPromoter],
+				)
+			},
+		},
+		{
+			name: "user with a username",
+			user: user.Info{Username: "fake-user"},
+			assertions: func(promo *kargoapi.Promotion) {
+				require.Equal(
+					t,
+					"fake-user",
+					promo.Annotations[kargoapi.AnnotationKeyPromoter],
+				)
+			},
+		},
+		{
+			name: "user with a bearer token",
+			user: user.Info{BearerToken: "fake-token"},
+			assertions: func(promo *kargoapi.Promotion) {
+				require.NotContains(
+					t,
+					promo.Annotations,
+					kargoapi.AnnotationKeyPromoter,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var created *kargoapi.Promotion
+			s := &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-stage",
+							Namespace: "fake-project",
+						},
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								Warehouse: "fake-warehouse",
+							},
+						},
+					}, nil
+				},
+				getPromotableFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					string,
+					[]string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					created = obj.(*kargoapi.Promotion) // nolint: forcetypeassert
+					return nil
+				},
+			}
+			_, err := s.PromoteStage(
+				user.ContextWithInfo(context.Background(), testCase.user),
+				connect.NewRequest(&svcv1alpha1.PromoteStageRequest{
+					Project: "fake-project",
+					Name:    "fake-stage",
+					Freight: "fake-freight",
+				}),
+			)
+			require.NoError(t, err)
+			require.NotNil(t, created)
+			testCase.assertions(created)
+		})
+	}
+}
+
+func TestValidateFreightAge(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "fake-project",
+			Name:              "fake-freight",
+			CreationTimestamp: metav1.Time{Time: now.Add(-48 * time.Hour)},
+		},
+	}
+	newPolicy := func(name string, stage string, maxAge time.Duration) *kargoapi.PromotionPolicy {
+		return &kargoapi.PromotionPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      name,
+			},
+			Stage:         stage,
+			MaxFreightAge: &metav1.Duration{Duration: maxAge},
+		}
+	}
+	testCases := []struct {
+		name       string
+		readOnly   bool
+		objects    []client.Object
+		assertions func(error)
+	}{
+		{
+			name: "no PromotionPolicy",
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "Freight younger than maximum age",
+			readOnly: true,
+			objects: []client.Object{
+				newPolicy("fake-policy", "fake-stage", 72*time.Hour),
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "PromotionPolicy for another Stage",
+			readOnly: true,
+			objects: []client.Object{
+				newPolicy("fake-policy", "other-stage", time.Hour),
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "ambiguous PromotionPolicies",
+			readOnly: true,
+			objects: []client.Object{
+				newPolicy("fake-policy", "fake-stage", time.Hour),
+				newPolicy("another-policy", "fake-stage", time.Hour),
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "Freight older than maximum age",
+			readOnly: true,
+			objects: []client.Object{
+				newPolicy("fake-policy", "fake-stage", 24*time.Hour),
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "is 48h0m0s old")
+				require.Contains(t, err.Error(), `"promote-stale" verb`)
+			},
+		},
+		{
+			name: "Freight older than maximum age promoted by permitted user",
+			objects: []client.Object{
+				newPolicy("fake-policy", "fake-stage", 24*time.Hour),
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Admins are permitted to promote stale Freight, whereas read-only
+			// users are not
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin:  !testCase.readOnly,
+					ReadOnly: testCase.readOnly,
+				},
+			)
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(testCase.objects...).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			s := &server{
+				client: kubeClient,
+				nowFn:  func() time.Time { return now },
+			}
+			testCase.assertions(
+				s.validateFreightAge(ctx, "fake-project", "fake-stage", freight),
+			)
+		})
+	}
+}
+
+func TestFindFreightProjects(t *testing.T) {
+	newProject := func(name string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					kargoapi.LabelProjectKey: kargoapi.LabelTrueValue,
+				},
+			},
+		}
+	}
+	newFreight := func(namespace string) *kargoapi.Freight {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "fake-freight",
+			},
+		}
+	}
+	testCases := []struct {
+		name     string
+		objects  []client.Object
+		expected []string
+	}{
+		{
+			name: "Freight belongs to the Project",
+			objects: []client.Object{
+				newProject("fake-project"),
+				newProject("other-project"),
+				newFreight("fake-project"),
+				newFreight("other-project"),
+			},
+			expected: nil,
+		},
+		{
+			name: "Freight belongs to no Project",
+			objects: []client.Object{
+				newProject("fake-project"),
+				newProject("other-project"),
+			},
+			expected: nil,
+		},
+		{
+			name: "Freight belongs to other Projects",
+			objects: []client.Object{
+				newProject("fake-project"),
+				newProject("other-project"),
+				newProject("another-project"),
+				newProject("unrelated-project"),
+				newFreight("other-project"),
+				newFreight("another-project"),
+			},
+			expected: []string{"another-project", "other-project"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Simulate an admin user to prevent any authz issues with the
+			// authorizing client.
+			ctx := user.ContextWithInfo(context.Background(), user.Info{IsAdmin: true})
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(testCase.objects...).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			s := &server{
+				client:       kubeClient,
+				getFreightFn: kargoapi.GetFreight,
+			}
+			projects, err := s.findFreightProjects(ctx, "fake-project", "fake-freight")
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, projects)
+		})
+	}
+}
+
+func TestSortDownstreamStages(t *testing.T) {
+	newStage := func(name string, upstreams ...string) kargoapi.Stage {
+		subs := make([]kargoapi.StageSubscription, len(upstreams))
+		for i, upstream := range upstreams {
+			subs[i] = kargoapi.StageSubscription{Name: upstream}
+		}
+		return kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: &kargoapi.StageSpec{
+				Subscriptions: &kargoapi.Subscriptions{
+					UpstreamStages: subs,
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name     string
+		stages   []kargoapi.Stage
+		expected []string
+	}{
+		{
+			name:     "no downstream Stages",
+			stages:   []kargoapi.Stage{newStage("test")},
+			expected: []string{},
+		},
+		{
+			name: "linear pipeline",
+			stages: []kargoapi.Stage{
+				newStage("test"),
+				newStage("prod", "uat"),
+				newStage("uat", "test"),
+			},
+			expected: []string{"uat", "prod"},
+		},
+		{
+			name: "fan out and fan in",
+			stages: []kargoapi.Stage{
+				newStage("test"),
+				newStage("uat-b", "test"),
+				newStage("uat-a", "test"),
+				newStage("prod", "uat-a", "uat-b"),
+				newStage("unrelated", "other"),
+			},
+			expected: []string{"uat-a", "uat-b", "prod"},
+		},
+		{
+			name: "Stage with an upstream outside the downstream graph",
+			stages: []kargoapi.Stage{
+				newStage("test"),
+				newStage("other"),
+				newStage("prod", "other", "test"),
+			},
+			expected: []string{"prod"},
+		},
+		{
+			name: "cycle",
+			stages: []kargoapi.Stage{
+				newStage("test"),
+				newStage("a", "test", "b"),
+				newStage("b", "a"),
+				newStage("c", "test"),
+			},
+			expected: []string{"c"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				sortDownstreamStages("test", testCase.stages),
+			)
+		})
+	}
+}
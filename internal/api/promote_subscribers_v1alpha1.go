@@ -4,9 +4,13 @@ import (
 	"context"
 	goerrors "errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -14,41 +18,66 @@ import (
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	"github.com/akuity/kargo/internal/kargo"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
-	"github.com/akuity/kargo/pkg/api/v1alpha1"
 )
 
 // PromoteSubscribers creates a Promotion resources to transition all Stages
 // immediately downstream from the specified Stage into the state represented by
-// the specified Freight.
+// the specified Freight. Progress is streamed to the client one subscriber at a
+// time so that Stages with many subscribers do not leave the client waiting
+// for all Promotions to be created.
 func (s *server) PromoteSubscribers(
 	ctx context.Context,
 	req *connect.Request[svcv1alpha1.PromoteSubscribersRequest],
-) (*connect.Response[svcv1alpha1.PromoteSubscribersResponse], error) {
-	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetStage()); err != nil {
-		return nil, err
+	stream *connect.ServerStream[svcv1alpha1.PromoteSubscribersResponse],
+) error {
+	return s.promoteSubscribers(ctx, req.Msg, stream.Send)
+}
+
+// promoteSubscribers does the work of PromoteSubscribers, passing each progress
+// message to the provided send function.
+func (s *server) promoteSubscribers(
+	ctx context.Context,
+	req *svcv1alpha1.PromoteSubscribersRequest,
+	send func(*svcv1alpha1.PromoteSubscribersResponse) error,
+) error {
+	if err := validateProjectAndStageNonEmpty(req.GetProject(), req.GetStage()); err != nil {
+		return err
+	}
+	var soak *metav1.Duration
+	if req.Soak != nil {
+		if len(req.GetRolloutOrder()) == 0 {
+			return connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("soak may only be specified along with a rollout order"),
+			)
+		}
+		var err error
+		if soak, err = parseSoak(req.GetSoak()); err != nil {
+			return err
+		}
 	}
-	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
-		return nil, err
+	if err := s.validateProjectFn(ctx, req.GetProject()); err != nil {
+		return err
 	}
 
 	stage, err := s.getStageFn(
 		ctx,
 		s.client,
 		types.NamespacedName{
-			Namespace: req.Msg.GetProject(),
-			Name:      req.Msg.GetStage(),
+			Namespace: req.GetProject(),
+			Name:      req.GetStage(),
 		},
 	)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return connect.NewError(connect.CodeInternal, err)
 	}
 	if stage == nil {
-		return nil, connect.NewError(
+		return connect.NewError(
 			connect.CodeNotFound,
 			errors.Errorf(
 				"Stage %q not found in namespace %q",
-				req.Msg.GetStage(),
-				req.Msg.GetProject(),
+				req.GetStage(),
+				req.GetProject(),
 			),
 		)
 	}
@@ -61,52 +90,77 @@ func (s *server) PromoteSubscribers(
 		ctx,
 		s.client,
 		types.NamespacedName{
-			Namespace: req.Msg.GetProject(),
-			Name:      req.Msg.GetFreight(),
+			Namespace: req.GetProject(),
+			Name:      req.GetFreight(),
 		},
-		[]string{req.Msg.GetStage()},
+		[]string{req.GetStage()},
 	); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return connect.NewError(connect.CodeInternal, err)
 	} else if freight == nil {
-		return nil, connect.NewError(
+		return connect.NewError(
 			connect.CodeNotFound,
 			errors.Errorf(
 				"no qualified Freight %q found in namespace %q",
-				req.Msg.GetFreight(),
-				req.Msg.GetProject(),
+				req.GetFreight(),
+				req.GetProject(),
 			),
 		)
 	}
 
 	subscribers, err := s.findStageSubscribersFn(ctx, stage)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return connect.NewError(connect.CodeInternal, err)
 	}
 	if len(subscribers) == 0 {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Stage %q has no subscribers", req.Msg.GetStage()))
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("Stage %q has no subscribers", req.GetStage()))
+	}
+
+	if len(req.GetRolloutOrder()) > 0 {
+		steps, err := getRolloutSteps(subscribers, req.GetRolloutOrder(), soak)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		for _, subscriber := range subscribers {
+			if err = s.validateFreightAgeFn(ctx, req.GetProject(), subscriber.Name, freight); err != nil {
+				return err // This already returns a connect.Error
+			}
+		}
+		release := kargo.NewRelease(*stage, req.GetFreight(), steps)
+		if err := s.createReleaseFn(ctx, &release); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return send(&svcv1alpha1.PromoteSubscribersResponse{
+			Release: typesv1alpha1.ToReleaseProto(release),
+		})
 	}
 
 	promoteErrs := make([]error, 0, len(subscribers))
-	createdPromos := make([]*v1alpha1.Promotion, 0, len(subscribers))
-	for _, subscriber := range subscribers {
-		newPromo := kargo.NewPromotion(subscriber, req.Msg.GetFreight())
-		if err := s.createPromotionFn(ctx, &newPromo); err != nil {
+	for i, subscriber := range subscribers {
+		res := &svcv1alpha1.PromoteSubscribersResponse{
+			Stage:     subscriber.Name,
+			Completed: int32(i + 1),
+			Total:     int32(len(subscribers)),
+		}
+		newPromo := kargo.NewPromotion(subscriber, req.GetFreight())
+		setPromoter(ctx, &newPromo)
+		if err := s.validateFreightAgeFn(ctx, req.GetProject(), subscriber.Name, freight); err != nil {
 			promoteErrs = append(promoteErrs, err)
-			continue
+			res.Error = err.Error()
+		} else if err := s.createPromotionFn(ctx, &newPromo); err != nil {
+			promoteErrs = append(promoteErrs, err)
+			res.Error = err.Error()
+		} else {
+			res.Promotion = s.toPromotionProto(ctx, newPromo)
+		}
+		if err := send(res); err != nil {
+			return errors.Wrap(err, "send response")
 		}
-		createdPromos = append(createdPromos, typesv1alpha1.ToPromotionProto(newPromo))
 	}
 
-	res := connect.NewResponse(&svcv1alpha1.PromoteSubscribersResponse{
-		Promotions: createdPromos,
-	})
-
 	if len(promoteErrs) > 0 {
-		return res,
-			connect.NewError(connect.CodeInternal, goerrors.Join(promoteErrs...))
+		return connect.NewError(connect.CodeInternal, goerrors.Join(promoteErrs...))
 	}
-
-	return res, nil
+	return nil
 }
 
 // findStageSubscribers returns a list of Stages that are subscribed to the given Stage
@@ -131,3 +185,65 @@ func (s *server) findStageSubscribers(ctx context.Context, stage *kargoapi.Stage
 	}
 	return subscribers, nil
 }
+
+// getRolloutSteps returns Release steps that promote the given subscribers in
+// the given order. The order must name every subscriber exactly once.
+func getRolloutSteps(
+	subscribers []kargoapi.Stage,
+	order []string,
+	soak *metav1.Duration,
+) ([]kargoapi.ReleaseStep, error) {
+	remaining := make(map[string]struct{}, len(subscribers))
+	for _, subscriber := range subscribers {
+		remaining[subscriber.Name] = struct{}{}
+	}
+	steps := make([]kargoapi.ReleaseStep, 0, len(order))
+	for _, stageName := range order {
+		if _, ok := remaining[stageName]; !ok {
+			return nil, errors.Errorf(
+				"Stage %q in rollout order is not a subscriber or is listed more "+
+					"than once",
+				stageName,
+			)
+		}
+		delete(remaining, stageName)
+		var stepSoak *metav1.Duration
+		if soak != nil {
+			stepSoak = soak.DeepCopy()
+		}
+		steps = append(steps, kargoapi.ReleaseStep{
+			Stage: stageName,
+			Soak:  stepSoak,
+		})
+	}
+	if len(remaining) > 0 {
+		missing := make([]string, 0, len(remaining))
+		for stageName := range remaining {
+			missing = append(missing, stageName)
+		}
+		sort.Strings(missing)
+		return nil, errors.Errorf(
+			"rollout order is missing subscribers: %s",
+			strings.Join(missing, ", "),
+		)
+	}
+	return steps, nil
+}
+
+// parseSoak parses a soak period specified in an API request.
+func parseSoak(soak string) (*metav1.Duration, error) {
+	d, err := time.ParseDuration(soak)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Wrapf(err, "invalid soak %q", soak),
+		)
+	}
+	if d < 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("soak %q must not be negative", soak),
+		)
+	}
+	return &metav1.Duration{Duration: d}, nil
+}
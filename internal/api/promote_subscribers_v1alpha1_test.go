@@ -7,6 +7,8 @@ import (
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -19,16 +21,13 @@ func TestPromoteSubscribers(t *testing.T) {
 		name       string
 		req        *svcv1alpha1.PromoteSubscribersRequest
 		server     *server
-		assertions func(
-			*connect.Response[svcv1alpha1.PromoteSubscribersResponse],
-			error,
-		)
+		assertions func([]*svcv1alpha1.PromoteSubscribersResponse, error)
 	}{
 		{
 			name:   "input validation error",
 			server: &server{},
 			assertions: func(
-				_ *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				_ []*svcv1alpha1.PromoteSubscribersResponse,
 				err error,
 			) {
 				require.Error(t, err)
@@ -50,7 +49,7 @@ func TestPromoteSubscribers(t *testing.T) {
 				},
 			},
 			assertions: func(
-				_ *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				_ []*svcv1alpha1.PromoteSubscribersResponse,
 				err error,
 			) {
 				require.Error(t, err)
@@ -77,7 +76,7 @@ func TestPromoteSubscribers(t *testing.T) {
 				},
 			},
 			assertions: func(
-				_ *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				_ []*svcv1alpha1.PromoteSubscribersResponse,
 				err error,
 			) {
 				require.Error(t, err)
@@ -107,7 +106,7 @@ func TestPromoteSubscribers(t *testing.T) {
 				},
 			},
 			assertions: func(
-				_ *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				_ []*svcv1alpha1.PromoteSubscribersResponse,
 				err error,
 			) {
 				require.Error(t, err)
@@ -156,7 +155,7 @@ func TestPromoteSubscribers(t *testing.T) {
 				},
 			},
 			assertions: func(
-				_ *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				_ []*svcv1alpha1.PromoteSubscribersResponse,
 				err error,
 			) {
 				require.Error(t, err)
@@ -204,7 +203,7 @@ func TestPromoteSubscribers(t *testing.T) {
 				},
 			},
 			assertions: func(
-				_ *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				_ []*svcv1alpha1.PromoteSubscribersResponse,
 				err error,
 			) {
 				require.Error(t, err)
@@ -251,6 +250,14 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
 				findStageSubscribersFn: func(
 					context.Context,
 					*kargoapi.Stage,
@@ -259,7 +266,7 @@ func TestPromoteSubscribers(t *testing.T) {
 				},
 			},
 			assertions: func(
-				_ *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				_ []*svcv1alpha1.PromoteSubscribersResponse,
 				err error,
 			) {
 				require.Error(t, err)
@@ -305,6 +312,14 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
 				findStageSubscribersFn: func(
 					context.Context,
 					*kargoapi.Stage,
@@ -313,7 +328,7 @@ func TestPromoteSubscribers(t *testing.T) {
 				},
 			},
 			assertions: func(
-				_ *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				_ []*svcv1alpha1.PromoteSubscribersResponse,
 				err error,
 			) {
 				require.Error(t, err)
@@ -360,6 +375,14 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
 				findStageSubscribersFn: func(
 					context.Context,
 					*kargoapi.Stage,
@@ -375,7 +398,7 @@ func TestPromoteSubscribers(t *testing.T) {
 				},
 			},
 			assertions: func(
-				_ *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				res []*svcv1alpha1.PromoteSubscribersResponse,
 				err error,
 			) {
 				require.Error(t, err)
@@ -383,6 +406,90 @@ func TestPromoteSubscribers(t *testing.T) {
 				require.True(t, ok)
 				require.Equal(t, connect.CodeInternal, connErr.Code())
 				require.Contains(t, connErr.Message(), "something went wrong")
+				require.Len(t, res, 1)
+				require.Nil(t, res[0].GetPromotion())
+				require.Equal(t, "something went wrong", res[0].GetError())
+			},
+		},
+		{
+			name: "error creating some Promotions",
+			req: &svcv1alpha1.PromoteSubscribersRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
+				findStageSubscribersFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Stage, error) {
+					return []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "prod-us",
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "prod-eu",
+							},
+						},
+					}, nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					promo := obj.(*kargoapi.Promotion) // nolint: forcetypeassert
+					if promo.Spec.Stage == "prod-us" {
+						return errors.New("something went wrong")
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				res []*svcv1alpha1.PromoteSubscribersResponse,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+				require.Len(t, res, 2)
+				require.Equal(t, "prod-us", res[0].GetStage())
+				require.Nil(t, res[0].GetPromotion())
+				require.Equal(t, "something went wrong", res[0].GetError())
+				require.Equal(t, int32(1), res[0].GetCompleted())
+				require.Equal(t, "prod-eu", res[1].GetStage())
+				require.NotNil(t, res[1].GetPromotion())
+				require.Empty(t, res[1].GetError())
+				require.Equal(t, int32(2), res[1].GetCompleted())
+				require.Equal(t, int32(2), res[1].GetTotal())
 			},
 		},
 		{
@@ -421,6 +528,14 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
 				findStageSubscribersFn: func(
 					context.Context,
 					*kargoapi.Stage,
@@ -436,22 +551,285 @@ func TestPromoteSubscribers(t *testing.T) {
 				},
 			},
 			assertions: func(
-				res *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				res []*svcv1alpha1.PromoteSubscribersResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, res, 1)
+				require.NotNil(t, res[0].GetPromotion())
+				require.Empty(t, res[0].GetError())
+				require.Equal(t, int32(1), res[0].GetCompleted())
+				require.Equal(t, int32(1), res[0].GetTotal())
+			},
+		},
+		{
+			name: "soak specified without rollout order",
+			req: &svcv1alpha1.PromoteSubscribersRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+				Soak:    proto.String("1h"),
+			},
+			server: &server{},
+			assertions: func(
+				_ []*svcv1alpha1.PromoteSubscribersResponse,
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+				require.Contains(t, connErr.Message(), "rollout order")
+			},
+		},
+		{
+			name: "rollout order is missing a subscriber",
+			req: &svcv1alpha1.PromoteSubscribersRequest{
+				Project:      "fake-project",
+				Stage:        "fake-stage",
+				Freight:      "fake-freight",
+				RolloutOrder: []string{"prod-us"},
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-stage",
+							Namespace: "fake-project",
+						},
+					}, nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
+				findStageSubscribersFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Stage, error) {
+					return []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "prod-us",
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "prod-eu",
+							},
+						},
+					}, nil
+				},
+				createReleaseFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				_ []*svcv1alpha1.PromoteSubscribersResponse,
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+				require.Contains(t, connErr.Message(), "missing subscribers: prod-eu")
+			},
+		},
+		{
+			name: "rollout order names a Stage that is not a subscriber",
+			req: &svcv1alpha1.PromoteSubscribersRequest{
+				Project:      "fake-project",
+				Stage:        "fake-stage",
+				Freight:      "fake-freight",
+				RolloutOrder: []string{"prod-us", "prod-eu", "prod-ap"},
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-stage",
+							Namespace: "fake-project",
+						},
+					}, nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
+				findStageSubscribersFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Stage, error) {
+					return []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "prod-us",
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "prod-eu",
+							},
+						},
+					}, nil
+				},
+				createReleaseFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				_ []*svcv1alpha1.PromoteSubscribersResponse,
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+				require.Contains(t, connErr.Message(), `"prod-ap"`)
+			},
+		},
+		{
+			name: "success with rollout order",
+			req: &svcv1alpha1.PromoteSubscribersRequest{
+				Project:      "fake-project",
+				Stage:        "fake-stage",
+				Freight:      "fake-freight",
+				RolloutOrder: []string{"prod-eu", "prod-us"},
+				Soak:         proto.String("1h"),
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-stage",
+							Namespace: "fake-project",
+						},
+					}, nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				validateFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) error {
+					return nil
+				},
+				findStageSubscribersFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Stage, error) {
+					return []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "prod-us",
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "prod-eu",
+							},
+						},
+					}, nil
+				},
+				createReleaseFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				res []*svcv1alpha1.PromoteSubscribersResponse,
 				err error,
 			) {
 				require.NoError(t, err)
-				require.NotNil(t, res)
-				require.NotEmpty(t, res.Msg.GetPromotions())
+				require.Len(t, res, 1)
+				require.Nil(t, res[0].GetPromotion())
+				release := res[0].GetRelease()
+				require.NotNil(t, release)
+				require.Equal(t, "fake-freight", release.GetSpec().GetFreight())
+				steps := release.GetSpec().GetSteps()
+				require.Len(t, steps, 2)
+				require.Equal(t, "prod-eu", steps[0].GetStage())
+				require.Equal(t, "1h0m0s", steps[0].GetSoak())
+				require.Equal(t, "prod-us", steps[1].GetStage())
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			resp, err := testCase.server.PromoteSubscribers(
+			var res []*svcv1alpha1.PromoteSubscribersResponse
+			err := testCase.server.promoteSubscribers(
 				context.Background(),
-				connect.NewRequest(testCase.req),
+				testCase.req,
+				func(msg *svcv1alpha1.PromoteSubscribersResponse) error {
+					res = append(res, msg)
+					return nil
+				},
 			)
-			testCase.assertions(resp, err)
+			testCase.assertions(res, err)
 		})
 	}
 }
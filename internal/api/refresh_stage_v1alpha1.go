@@ -7,7 +7,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
-	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -32,6 +31,6 @@ func (s *server) RefreshStage(
 	}
 
 	return connect.NewResponse(&svcv1alpha1.RefreshStageResponse{
-		Stage: typesv1alpha1.ToStageProto(*stage),
+		Stage: s.toStageProto(ctx, *stage),
 	}), nil
 }
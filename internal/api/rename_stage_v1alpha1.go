@@ -0,0 +1,319 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kubeclient"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// stageReferences describes the resources whose references to a Stage were
+// rewritten when that Stage was renamed.
+type stageReferences struct {
+	stages            []string
+	promotionPolicies []string
+	freight           []string
+}
+
+// RenameStage renames a Stage. Kubernetes resources cannot be renamed in
+// place, so a Stage with the new name is created with the specification and
+// status of the original, every reference to the original from other Stages,
+// PromotionPolicies, and Freight is rewritten to refer to the new Stage, and
+// the original is deleted. Each of these steps is idempotent, so a rename that
+// fails part way through can be resumed by repeating the request.
+func (s *server) RenameStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.RenameStageRequest],
+) (*connect.Response[svcv1alpha1.RenameStageResponse], error) {
+	project := req.Msg.GetProject()
+	name := req.Msg.GetName()
+	newName := req.Msg.GetNewName()
+	if err := validateProjectAndStageNonEmpty(project, name); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	if newName == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("new_name should not be empty"),
+		)
+	}
+	if errs := validation.IsDNS1123Subdomain(newName); len(errs) > 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("invalid new_name %q: %s", newName, strings.Join(errs, "; ")),
+		)
+	}
+	if newName == name {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("new_name should differ from name"),
+		)
+	}
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      name,
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	newStage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      newName,
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resumed := newStage != nil &&
+		newStage.Annotations[kargoapi.AnnotationKeyRenamedFrom] == name
+	switch {
+	case stage == nil && resumed:
+		// The original Stage is deleted last, so the rename already completed
+		return connect.NewResponse(&svcv1alpha1.RenameStageResponse{
+			Stage: s.toStageProto(ctx, *newStage),
+		}), nil
+	case stage == nil:
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf("Stage %q not found in namespace %q", name, project),
+		)
+	case newStage != nil && !resumed:
+		return nil, connect.NewError(
+			connect.CodeAlreadyExists,
+			errors.Errorf("Stage %q already exists in namespace %q", newName, project),
+		)
+	}
+
+	if err = s.validateStageRenamableFn(ctx, stage); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	if newStage == nil {
+		if newStage, err = s.copyStage(ctx, stage, newName); err != nil {
+			return nil, connect.NewError(
+				connect.CodeInternal,
+				errors.Wrapf(err, "error creating Stage %q", newName),
+			)
+		}
+	}
+
+	refs, err := s.rewriteStageReferencesFn(ctx, project, name, newName)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Wrapf(
+				err,
+				"error rewriting references to Stage %q; the rename is incomplete "+
+					"and may be resumed by repeating it",
+				name,
+			),
+		)
+	}
+
+	if err = s.client.Delete(ctx, stage); err != nil && !kubeerr.IsNotFound(err) {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Wrapf(
+				err,
+				"error deleting Stage %q; the rename is incomplete and may be "+
+					"resumed by repeating it",
+				name,
+			),
+		)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.RenameStageResponse{
+		Stage:                    s.toStageProto(ctx, *newStage),
+		UpdatedStages:            refs.stages,
+		UpdatedPromotionPolicies: refs.promotionPolicies,
+		UpdatedFreight:           refs.freight,
+	}), nil
+}
+
+// validateStageRenamable returns an error if the provided Stage has a
+// Promotion or a Release in progress. Neither could complete once the Stage
+// has been renamed, as both refer to the Stage by a name that is immutable.
+func (s *server) validateStageRenamable(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) error {
+	var promos kargoapi.PromotionList
+	if err := s.client.List(ctx, &promos, client.InNamespace(stage.Namespace)); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	for _, promo := range promos.Items {
+		if promo.Spec.Stage == stage.Name && !promo.Status.Phase.IsTerminal() {
+			return connect.NewError(
+				connect.CodeFailedPrecondition,
+				errors.Errorf(
+					"Stage %q cannot be renamed while Promotion %q is in progress",
+					stage.Name,
+					promo.Name,
+				),
+			)
+		}
+	}
+	var releases kargoapi.ReleaseList
+	if err := s.client.List(ctx, &releases, client.InNamespace(stage.Namespace)); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	for _, release := range releases.Items {
+		if release.Status.Phase.IsTerminal() {
+			continue
+		}
+		for _, step := range release.Spec.Steps {
+			if step.Stage == stage.Name {
+				return connect.NewError(
+					connect.CodeFailedPrecondition,
+					errors.Errorf(
+						"Stage %q cannot be renamed while Release %q is in progress",
+						stage.Name,
+						release.Name,
+					),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// copyStage creates a Stage with the provided name and with the labels,
+// annotations, specification, and status of the provided Stage. The new Stage
+// is annotated with the name of the provided Stage.
+func (s *server) copyStage(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	name string,
+) (*kargoapi.Stage, error) {
+	newStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   stage.Namespace,
+			Name:        name,
+			Labels:      stage.Labels,
+			Annotations: map[string]string{},
+		},
+		Spec: stage.Spec.DeepCopy(),
+	}
+	for k, v := range stage.Annotations {
+		newStage.Annotations[k] = v
+	}
+	newStage.Annotations[kargoapi.AnnotationKeyRenamedFrom] = stage.Name
+	if err := s.client.Create(ctx, newStage); err != nil {
+		return nil, err
+	}
+	// Status is ignored on creation, so it is copied separately
+	newStage.Status = *stage.Status.DeepCopy()
+	if err := s.client.Status().Update(ctx, newStage); err != nil {
+		return nil, errors.Wrap(err, "error copying status")
+	}
+	return newStage, nil
+}
+
+// rewriteStageReferences rewrites every reference to the Stage with the
+// provided name by another Stage's subscriptions, by a PromotionPolicy, or by
+// a Freight's qualifications or approvals to refer to the Stage with the
+// provided new name instead. PromotionPolicies are rewritten first so that
+// the original Stage is not auto-promoted while the rename is in progress.
+func (s *server) rewriteStageReferences(
+	ctx context.Context,
+	project string,
+	name string,
+	newName string,
+) (stageReferences, error) {
+	var refs stageReferences
+
+	var policies kargoapi.PromotionPolicyList
+	if err := s.client.List(ctx, &policies, client.InNamespace(project)); err != nil {
+		return refs, errors.Wrap(err, "error listing PromotionPolicies")
+	}
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if policy.Stage != name {
+			continue
+		}
+		policy.Stage = newName
+		if err := s.client.Update(ctx, policy); err != nil {
+			return refs, errors.Wrapf(err, "error updating PromotionPolicy %q", policy.Name)
+		}
+		refs.promotionPolicies = append(refs.promotionPolicies, policy.Name)
+	}
+
+	var stages kargoapi.StageList
+	if err := s.client.List(ctx, &stages, client.InNamespace(project)); err != nil {
+		return refs, errors.Wrap(err, "error listing Stages")
+	}
+	for i := range stages.Items {
+		stage := &stages.Items[i]
+		if stage.Spec == nil || stage.Spec.Subscriptions == nil {
+			continue
+		}
+		var updated bool
+		for j, upstream := range stage.Spec.Subscriptions.UpstreamStages {
+			if upstream.Name == name {
+				stage.Spec.Subscriptions.UpstreamStages[j].Name = newName
+				updated = true
+			}
+		}
+		if !updated {
+			continue
+		}
+		if err := s.client.Update(ctx, stage); err != nil {
+			return refs, errors.Wrapf(err, "error updating Stage %q", stage.Name)
+		}
+		refs.stages = append(refs.stages, stage.Name)
+	}
+
+	var freight kargoapi.FreightList
+	if err := s.client.List(ctx, &freight, client.InNamespace(project)); err != nil {
+		return refs, errors.Wrap(err, "error listing Freight")
+	}
+	for i := range freight.Items {
+		f := &freight.Items[i]
+		_, qualified := f.Status.Qualifications[name]
+		_, approved := f.Status.Approvals[name]
+		if !qualified && !approved {
+			continue
+		}
+		if err := kubeclient.PatchStatus(
+			ctx,
+			s.client,
+			f,
+			func(status *kargoapi.FreightStatus) {
+				if q, ok := status.Qualifications[name]; ok {
+					status.Qualifications[newName] = q
+					delete(status.Qualifications, name)
+				}
+				if a, ok := status.Approvals[name]; ok {
+					status.Approvals[newName] = a
+					delete(status.Approvals, name)
+				}
+			},
+		); err != nil {
+			return refs, errors.Wrapf(err, "error updating Freight %q", f.Name)
+		}
+		refs.freight = append(refs.freight, f.Name)
+	}
+
+	return refs, nil
+}
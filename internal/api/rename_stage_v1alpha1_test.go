@@ -0,0 +1,330 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestRenameStage(t *testing.T) {
+	const testProject = "kargo-demo"
+	newStage := func(name string, upstreams ...string) *kargoapi.Stage {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      name,
+			},
+			Spec: &kargoapi.StageSpec{
+				Subscriptions: &kargoapi.Subscriptions{},
+			},
+		}
+		for _, upstream := range upstreams {
+			stage.Spec.Subscriptions.UpstreamStages = append(
+				stage.Spec.Subscriptions.UpstreamStages,
+				kargoapi.StageSubscription{Name: upstream},
+			)
+		}
+		return stage
+	}
+	testNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testProject,
+			Labels: map[string]string{
+				kargoapi.LabelProjectKey: kargoapi.LabelTrueValue,
+			},
+		},
+	}
+	testStage := newStage("test")
+	testStage.Labels = map[string]string{"team": "fake-team"}
+	testStage.Status.CurrentFreight = &kargoapi.SimpleFreight{ID: "fake-freight"}
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.RenameStageRequest
+		objects    []client.Object
+		assertions func(*connect.Response[svcv1alpha1.RenameStageResponse], client.Client, error)
+	}{
+		{
+			name: "new name not specified",
+			req: &svcv1alpha1.RenameStageRequest{
+				Project: testProject,
+				Name:    "test",
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.RenameStageResponse], _ client.Client, err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "invalid new name",
+			req: &svcv1alpha1.RenameStageRequest{
+				Project: testProject,
+				Name:    "test",
+				NewName: "Not_Valid",
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.RenameStageResponse], _ client.Client, err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "invalid new_name")
+			},
+		},
+		{
+			name: "new name same as name",
+			req: &svcv1alpha1.RenameStageRequest{
+				Project: testProject,
+				Name:    "test",
+				NewName: "test",
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.RenameStageResponse], _ client.Client, err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Stage not found",
+			req: &svcv1alpha1.RenameStageRequest{
+				Project: testProject,
+				Name:    "test",
+				NewName: "renamed",
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.RenameStageResponse], _ client.Client, err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Stage with new name already exists",
+			req: &svcv1alpha1.RenameStageRequest{
+				Project: testProject,
+				Name:    "test",
+				NewName: "renamed",
+			},
+			objects: []client.Object{testStage.DeepCopy(), newStage("renamed")},
+			assertions: func(_ *connect.Response[svcv1alpha1.RenameStageResponse], _ client.Client, err error) {
+				require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Promotion in progress",
+			req: &svcv1alpha1.RenameStageRequest{
+				Project: testProject,
+				Name:    "test",
+				NewName: "renamed",
+			},
+			objects: []client.Object{
+				testStage.DeepCopy(),
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-promotion",
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "test",
+						Freight: "fake-freight",
+					},
+					Status: kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhaseRunning,
+					},
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.RenameStageResponse], c client.Client, err error) {
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				require.Contains(t, err.Error(), `Promotion "fake-promotion" is in progress`)
+				// Nothing should have been created
+				stage, err := kargoapi.GetStage(
+					context.Background(),
+					c,
+					client.ObjectKey{Namespace: testProject, Name: "renamed"},
+				)
+				require.NoError(t, err)
+				require.Nil(t, stage)
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.RenameStageRequest{
+				Project: testProject,
+				Name:    "test",
+				NewName: "renamed",
+			},
+			objects: []client.Object{
+				testStage.DeepCopy(),
+				newStage("downstream", "test", "other"),
+				newStage("other"),
+				&kargoapi.PromotionPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-policy",
+					},
+					Stage:               "test",
+					EnableAutoPromotion: true,
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-freight",
+					},
+					Status: kargoapi.FreightStatus{
+						Qualifications: map[string]kargoapi.Qualification{
+							"test":  {},
+							"other": {},
+						},
+						Approvals: map[string]kargoapi.Approval{
+							"test": {Approver: "fake-approver"},
+						},
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "unrelated-freight",
+					},
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.RenameStageResponse],
+				c client.Client,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "renamed", res.Msg.GetStage().GetMetadata().GetName())
+				require.Equal(t, []string{"downstream"}, res.Msg.GetUpdatedStages())
+				require.Equal(t, []string{"fake-policy"}, res.Msg.GetUpdatedPromotionPolicies())
+				require.Equal(t, []string{"fake-freight"}, res.Msg.GetUpdatedFreight())
+
+				ctx := context.Background()
+				stage, err := kargoapi.GetStage(
+					ctx,
+					c,
+					client.ObjectKey{Namespace: testProject, Name: "test"},
+				)
+				require.NoError(t, err)
+				require.Nil(t, stage)
+
+				stage, err = kargoapi.GetStage(
+					ctx,
+					c,
+					client.ObjectKey{Namespace: testProject, Name: "renamed"},
+				)
+				require.NoError(t, err)
+				require.NotNil(t, stage)
+				require.Equal(t, "fake-team", stage.Labels["team"])
+				require.Equal(t, "test", stage.Annotations[kargoapi.AnnotationKeyRenamedFrom])
+				require.Equal(t, "fake-freight", stage.Status.CurrentFreight.ID)
+
+				downstream, err := kargoapi.GetStage(
+					ctx,
+					c,
+					client.ObjectKey{Namespace: testProject, Name: "downstream"},
+				)
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]kargoapi.StageSubscription{{Name: "renamed"}, {Name: "other"}},
+					downstream.Spec.Subscriptions.UpstreamStages,
+				)
+
+				policy := &kargoapi.PromotionPolicy{}
+				require.NoError(
+					t,
+					c.Get(ctx, client.ObjectKey{Namespace: testProject, Name: "fake-policy"}, policy),
+				)
+				require.Equal(t, "renamed", policy.Stage)
+
+				freight, err := kargoapi.GetFreight(
+					ctx,
+					c,
+					client.ObjectKey{Namespace: testProject, Name: "fake-freight"},
+				)
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]kargoapi.Qualification{"renamed": {}, "other": {}},
+					freight.Status.Qualifications,
+				)
+				require.Equal(
+					t,
+					map[string]kargoapi.Approval{"renamed": {Approver: "fake-approver"}},
+					freight.Status.Approvals,
+				)
+			},
+		},
+		{
+			name: "rename already completed",
+			req: &svcv1alpha1.RenameStageRequest{
+				Project: testProject,
+				Name:    "test",
+				NewName: "renamed",
+			},
+			objects: []client.Object{
+				func() client.Object {
+					stage := newStage("renamed")
+					stage.Annotations = map[string]string{
+						kargoapi.AnnotationKeyRenamedFrom: "test",
+					}
+					return stage
+				}(),
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.RenameStageResponse],
+				_ client.Client,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "renamed", res.Msg.GetStage().GetMetadata().GetName())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			var internalClient client.Client
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						internalClient = fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(testNamespace).
+							WithObjects(testCase.objects...).
+							Build()
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			svr := &server{
+				client: kubeClient,
+			}
+			svr.validateProjectFn = svr.validateProject
+			svr.externalValidateProjectFn = validation.ValidateProject
+			svr.getStageFn = kargoapi.GetStage
+			svr.validateStageRenamableFn = svr.validateStageRenamable
+			svr.rewriteStageReferencesFn = svr.rewriteStageReferences
+			res, err := svr.RenameStage(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(res, internalClient, err)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package revocation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// configMapWatcher is the subset of the API server's Kubernetes client used by
+// the List to learn about changes to the ConfigMap backing it.
+type configMapWatcher interface {
+	Watch(
+		ctx context.Context,
+		obj client.Object,
+		namespace string,
+		opts metav1.ListOptions,
+	) (watch.Interface, error)
+}
+
+// List is an in-memory copy of the revoked token IDs stored in a ConfigMap.
+// Each key of the ConfigMap's data is the ID (i.e. the JTI claim) of a revoked
+// token and each value is either empty or the time, in RFC 3339 format, at
+// which the revoked token expires. Once a revoked token has expired, there is
+// no further need to keep its ID on the List.
+type List struct {
+	namespace string
+	name      string
+
+	mu      sync.RWMutex
+	entries map[string]string
+}
+
+// NewList returns a List backed by the ConfigMap with the specified namespace
+// and name.
+func NewList(namespace, name string) *List {
+	return &List{
+		namespace: namespace,
+		name:      name,
+		entries:   map[string]string{},
+	}
+}
+
+// Namespace returns the namespace of the ConfigMap backing the List.
+func (l *List) Namespace() string {
+	return l.namespace
+}
+
+// Name returns the name of the ConfigMap backing the List.
+func (l *List) Name() string {
+	return l.name
+}
+
+// IsRevoked returns a boolean indicating whether the token with the specified
+// ID has been revoked.
+func (l *List) IsRevoked(id string) bool {
+	if id == "" {
+		return false
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	_, ok := l.entries[id]
+	return ok
+}
+
+// Expired returns the IDs of all revoked tokens that expired before the
+// specified time. These may safely be pruned from the List.
+func (l *List) Expired(now time.Time) []string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	var expired []string
+	for id, expiry := range l.entries {
+		if expiry == "" {
+			continue
+		}
+		expiresAt, err := time.Parse(time.RFC3339, expiry)
+		if err != nil {
+			continue
+		}
+		if expiresAt.Before(now) {
+			expired = append(expired, id)
+		}
+	}
+	return expired
+}
+
+// Add adds the specified entries to the List without waiting for the
+// ConfigMap backing it to be updated. This is useful for ensuring that a token
+// is treated as revoked immediately after the ConfigMap has been successfully
+// updated.
+func (l *List) Add(entries map[string]string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for id, expiry := range entries {
+		l.entries[id] = expiry
+	}
+}
+
+// Run watches the ConfigMap backing the List using the provided watcher and
+// replaces the List's contents whenever the ConfigMap is added or modified.
+// If the ConfigMap is deleted, the List is emptied. If the watch is
+// interrupted, it is re-established. Run blocks until the provided context is
+// canceled.
+func (l *List) Run(ctx context.Context, w configMapWatcher) {
+	logger := logging.LoggerFromContext(ctx)
+	for {
+		if err := l.watch(ctx, w); err != nil {
+			logger.Errorf("error watching revoked tokens: %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func (l *List) watch(ctx context.Context, w configMapWatcher) error {
+	wi, err := w.Watch(
+		ctx,
+		&corev1.ConfigMap{},
+		l.namespace,
+		metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector(
+				"metadata.name",
+				l.name,
+			).String(),
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "watch ConfigMap")
+	}
+	defer wi.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-wi.ResultChan():
+			if !ok {
+				return nil
+			}
+			l.handleEvent(e)
+		}
+	}
+}
+
+func (l *List) handleEvent(e watch.Event) {
+	switch e.Type {
+	case watch.Added, watch.Modified:
+		data, ok := getData(e.Object)
+		if !ok {
+			return
+		}
+		l.mu.Lock()
+		l.entries = data
+		l.mu.Unlock()
+	case watch.Deleted:
+		l.mu.Lock()
+		l.entries = map[string]string{}
+		l.mu.Unlock()
+	}
+}
+
+// getData extracts the data of a ConfigMap from the provided object, which,
+// depending on the underlying client, may be either a *corev1.ConfigMap or an
+// unstructured representation of one.
+func getData(obj runtime.Object) (map[string]string, bool) {
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		data := make(map[string]string, len(o.Data))
+		for k, v := range o.Data {
+			data[k] = v
+		}
+		return data, true
+	case *unstructured.Unstructured:
+		data, _, err := unstructured.NestedStringMap(o.Object, "data")
+		if err != nil {
+			return nil, false
+		}
+		if data == nil {
+			data = map[string]string{}
+		}
+		return data, true
+	}
+	return nil, false
+}
@@ -0,0 +1,105 @@
+package revocation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestListHandleEvent(t *testing.T) {
+	testCases := []struct {
+		name       string
+		events     []watch.Event
+		assertions func(*List)
+	}{
+		{
+			name: "ConfigMap added",
+			events: []watch.Event{
+				{
+					Type: watch.Added,
+					Object: &corev1.ConfigMap{
+						Data: map[string]string{"fake-id": ""},
+					},
+				},
+			},
+			assertions: func(l *List) {
+				require.True(t, l.IsRevoked("fake-id"))
+				require.False(t, l.IsRevoked("another-fake-id"))
+			},
+		},
+		{
+			name: "unstructured ConfigMap modified",
+			events: []watch.Event{
+				{
+					Type: watch.Added,
+					Object: &corev1.ConfigMap{
+						Data: map[string]string{"fake-id": ""},
+					},
+				},
+				{
+					Type: watch.Modified,
+					Object: &unstructured.Unstructured{
+						Object: map[string]any{
+							"data": map[string]any{"another-fake-id": ""},
+						},
+					},
+				},
+			},
+			assertions: func(l *List) {
+				require.False(t, l.IsRevoked("fake-id"))
+				require.True(t, l.IsRevoked("another-fake-id"))
+			},
+		},
+		{
+			name: "ConfigMap deleted",
+			events: []watch.Event{
+				{
+					Type: watch.Added,
+					Object: &corev1.ConfigMap{
+						Data: map[string]string{"fake-id": ""},
+					},
+				},
+				{
+					Type:   watch.Deleted,
+					Object: &corev1.ConfigMap{},
+				},
+			},
+			assertions: func(l *List) {
+				require.False(t, l.IsRevoked("fake-id"))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			l := NewList("fake-namespace", "fake-name")
+			for _, e := range testCase.events {
+				l.handleEvent(e)
+			}
+			testCase.assertions(l)
+		})
+	}
+}
+
+func TestListIsRevoked(t *testing.T) {
+	l := NewList("fake-namespace", "fake-name")
+	require.False(t, l.IsRevoked(""))
+	require.False(t, l.IsRevoked("fake-id"))
+	l.Add(map[string]string{"fake-id": ""})
+	require.True(t, l.IsRevoked("fake-id"))
+}
+
+func TestListExpired(t *testing.T) {
+	now := time.Now()
+	l := NewList("fake-namespace", "fake-name")
+	l.Add(map[string]string{
+		"no-expiry":  "",
+		"bad-expiry": "not-a-time",
+		"expired":    now.Add(-time.Hour).Format(time.RFC3339),
+		"unexpired":  now.Add(time.Hour).Format(time.RFC3339),
+	})
+	require.Equal(t, []string{"expired"}, l.Expired(now))
+}
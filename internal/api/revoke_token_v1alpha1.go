@@ -0,0 +1,126 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// RevokeToken adds the ID of a token to the server's revocation list so that
+// the token will be rejected, even if it has not yet expired. Only the admin
+// account may revoke tokens.
+func (s *server) RevokeToken(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.RevokeTokenRequest],
+) (*connect.Response[svcv1alpha1.RevokeTokenResponse], error) {
+	if s.revokedTokens == nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("token revocation is not enabled"),
+		)
+	}
+	if u, ok := user.InfoFromContext(ctx); !ok || !u.IsAdmin {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("only the admin user may revoke tokens"),
+		)
+	}
+
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("id should not be empty"),
+		)
+	}
+	if errs := validation.IsConfigMapKey(id); len(errs) > 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("invalid id %q: %s", id, strings.Join(errs, "; ")),
+		)
+	}
+
+	var expiry string
+	if req.Msg.ExpireTime != nil {
+		expiry = req.Msg.GetExpireTime().AsTime().UTC().Format(time.RFC3339)
+	}
+
+	// Take this opportunity to prune any revoked tokens that have since expired
+	entries := map[string]*string{
+		id: &expiry,
+	}
+	for _, expiredID := range s.revokedTokens.Expired(s.nowFn()) {
+		if expiredID != id {
+			entries[expiredID] = nil
+		}
+	}
+	if err := s.patchRevokedTokensFn(ctx, entries); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Don't wait for the watch to catch up. The token should be rejected
+	// immediately.
+	s.revokedTokens.Add(map[string]string{id: expiry})
+
+	return connect.NewResponse(&svcv1alpha1.RevokeTokenResponse{}), nil
+}
+
+// patchRevokedTokens applies the provided entries to the ConfigMap backing the
+// server's revocation list, creating that ConfigMap if it does not already
+// exist. Entries with a nil value are removed from the ConfigMap.
+func (s *server) patchRevokedTokens(
+	ctx context.Context,
+	entries map[string]*string,
+) error {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: s.revokedTokens.Namespace(),
+			Name:      s.revokedTokens.Name(),
+		},
+	}
+	patchBytes, err := json.Marshal(map[string]any{"data": entries})
+	if err != nil {
+		return errors.Wrap(err, "error marshaling patch")
+	}
+	err = s.client.Patch(
+		ctx,
+		configMap,
+		client.RawPatch(types.MergePatchType, patchBytes),
+	)
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return errors.Wrapf(
+			err,
+			"error patching ConfigMap %q in namespace %q",
+			configMap.Name,
+			configMap.Namespace,
+		)
+	}
+	configMap.Data = map[string]string{}
+	for id, expiry := range entries {
+		if expiry != nil {
+			configMap.Data[id] = *expiry
+		}
+	}
+	return errors.Wrapf(
+		s.client.Create(ctx, configMap),
+		"error creating ConfigMap %q in namespace %q",
+		configMap.Name,
+		configMap.Namespace,
+	)
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/akuity/kargo/internal/api/revocation"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestRevokeToken(t *testing.T) {
+	testNow := time.Now()
+	testExpiry := testNow.Add(time.Hour).UTC().Truncate(time.Second)
+	testCases := []struct {
+		name       string
+		userInfo   *user.Info
+		req        *svcv1alpha1.RevokeTokenRequest
+		server     *server
+		assertions func(*server, error)
+	}{
+		{
+			name:   "token revocation is not enabled",
+			req:    &svcv1alpha1.RevokeTokenRequest{Id: "fake-id"},
+			server: &server{},
+			assertions: func(_ *server, err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+			},
+		},
+		{
+			name:     "user is not the admin user",
+			userInfo: &user.Info{Username: "fake-user"},
+			req:      &svcv1alpha1.RevokeTokenRequest{Id: "fake-id"},
+			server: &server{
+				revokedTokens: revocation.NewList("fake-namespace", "fake-name"),
+			},
+			assertions: func(_ *server, err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+			},
+		},
+		{
+			name:     "invalid id",
+			userInfo: &user.Info{IsAdmin: true},
+			req:      &svcv1alpha1.RevokeTokenRequest{Id: "not a valid id"},
+			server: &server{
+				revokedTokens: revocation.NewList("fake-namespace", "fake-name"),
+			},
+			assertions: func(_ *server, err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name:     "error patching revoked tokens",
+			userInfo: &user.Info{IsAdmin: true},
+			req:      &svcv1alpha1.RevokeTokenRequest{Id: "fake-id"},
+			server: &server{
+				revokedTokens: revocation.NewList("fake-namespace", "fake-name"),
+				nowFn:         func() time.Time { return testNow },
+				patchRevokedTokensFn: func(context.Context, map[string]*string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(s *server, err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInternal, connErr.Code())
+				require.False(t, s.revokedTokens.IsRevoked("fake-id"))
+			},
+		},
+		{
+			name:     "success",
+			userInfo: &user.Info{IsAdmin: true},
+			req: &svcv1alpha1.RevokeTokenRequest{
+				Id:         "fake-id",
+				ExpireTime: timestamppb.New(testExpiry),
+			},
+			server: func() *server {
+				revokedTokens := revocation.NewList("fake-namespace", "fake-name")
+				revokedTokens.Add(map[string]string{
+					"expired-id": testNow.Add(-time.Hour).Format(time.RFC3339),
+				})
+				return &server{
+					revokedTokens: revokedTokens,
+					nowFn:         func() time.Time { return testNow },
+					patchRevokedTokensFn: func(
+						_ context.Context,
+						entries map[string]*string,
+					) error {
+						expiry := testExpiry.Format(time.RFC3339)
+						require.Equal(
+							t,
+							map[string]*string{
+								"fake-id":    &expiry,
+								"expired-id": nil,
+							},
+							entries,
+						)
+						return nil
+					},
+				}
+			}(),
+			assertions: func(s *server, err error) {
+				require.NoError(t, err)
+				require.True(t, s.revokedTokens.IsRevoked("fake-id"))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.userInfo != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.userInfo)
+			}
+			_, err := testCase.server.RevokeToken(
+				ctx,
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(testCase.server, err)
+		})
+	}
+}
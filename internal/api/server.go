@@ -2,6 +2,8 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"net"
 	"net/http"
 	goos "os"
@@ -9,21 +11,34 @@ import (
 
 	"connectrpc.com/grpchealth"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	authnv1 "k8s.io/api/authentication/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/dex"
 	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/operation"
 	"github.com/akuity/kargo/internal/api/option"
+	"github.com/akuity/kargo/internal/api/revocation"
+	"github.com/akuity/kargo/internal/api/user"
 	"github.com/akuity/kargo/internal/api/validation"
+	"github.com/akuity/kargo/internal/cluster"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
 	httputil "github.com/akuity/kargo/internal/http"
+	"github.com/akuity/kargo/internal/images"
 	"github.com/akuity/kargo/internal/kubeclient/manifest"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/metrics"
 	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
@@ -35,6 +50,14 @@ type server struct {
 	cfg    config.ServerConfig
 	client kubernetes.Client
 
+	projectCache *validation.ProjectCache
+
+	revokedTokens *revocation.List
+
+	operations *operation.Manager
+
+	credentialsDB credentials.Database
+
 	// The following behaviors are overridable for testing purposes:
 
 	// Common validations:
@@ -61,6 +84,13 @@ type server struct {
 		namespacedName types.NamespacedName,
 		stages []string,
 	) (*kargoapi.Freight, error)
+	getPromotableFreightFn func(
+		ctx context.Context,
+		client client.Client,
+		namespacedName types.NamespacedName,
+		stage string,
+		upstreamStages []string,
+	) (*kargoapi.Freight, error)
 
 	// Common Promotions:
 	createPromotionFn func(
@@ -68,10 +98,81 @@ type server struct {
 		client.Object,
 		...client.CreateOption,
 	) error
+	validateFreightAgeFn func(
+		ctx context.Context,
+		project string,
+		stage string,
+		freight *kargoapi.Freight,
+	) error
+
+	// ApproveFreight API:
+	nowFn        func() time.Time
+	getFreightFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Freight, error)
+	patchFreightStatusFn func(
+		ctx context.Context,
+		freight *kargoapi.Freight,
+		update func(status *kargoapi.FreightStatus),
+	) error
+
+	// AttachBuildInfo API:
+	listWarehousesFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+	patchWarehouseStatusFn func(
+		ctx context.Context,
+		warehouse *kargoapi.Warehouse,
+		update func(status *kargoapi.WarehouseStatus),
+	) error
+	refreshWarehouseFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Warehouse, error)
 
 	// Promote subscribers:
 	findStageSubscribersFn func(ctx context.Context, stage *kargoapi.Stage) ([]kargoapi.Stage, error)
 
+	// Promote to all downstream Stages:
+	findDownstreamStagesFn func(ctx context.Context, stage *kargoapi.Stage) ([]string, error)
+
+	// Promote Freight from another Project:
+	isFreightSharedWithFn func(
+		ctx context.Context,
+		client client.Client,
+		freightProject string,
+		project string,
+	) (bool, error)
+	findFreightProjectsFn func(ctx context.Context, project string, freight string) ([]string, error)
+
+	// Rename Stages:
+	validateStageRenamableFn func(ctx context.Context, stage *kargoapi.Stage) error
+	rewriteStageReferencesFn func(
+		ctx context.Context,
+		project string,
+		name string,
+		newName string,
+	) (stageReferences, error)
+
+	// Explain promotion eligibility:
+	isProjectArchivedFn func(
+		ctx context.Context,
+		client client.Client,
+		project string,
+	) (bool, error)
+
+	// Common Releases:
+	createReleaseFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+
 	// QueryFreight API:
 	listFreightFn func(
 		context.Context,
@@ -96,6 +197,68 @@ type server struct {
 
 	// Common manifest parsing:
 	parseManifestFn manifest.ParseFunc
+
+	// KubernetesLogin API:
+	reviewTokenFn func(
+		ctx context.Context,
+		token string,
+	) (*authnv1.TokenReviewStatus, error)
+
+	// RevokeToken API:
+	patchRevokedTokensFn func(
+		ctx context.Context,
+		entries map[string]*string,
+	) error
+
+	// GetControllerStats API:
+	getControllerMetricsFn func(
+		ctx context.Context,
+	) (map[string]*dto.MetricFamily, error)
+
+	// DiffFreight API:
+	diffCommitsFn func(
+		ctx context.Context,
+		project string,
+		repoURL string,
+		fromID string,
+		toID string,
+		paths []string,
+	) ([]git.FileDiff, error)
+	cloneRepoFn func(
+		repoURL string,
+		repoCreds git.RepoCredentials,
+	) (git.Repo, error)
+
+	// TestCredentials API:
+	checkGitAccessFn func(
+		repoURL string,
+		repoCreds git.RepoCredentials,
+	) error
+	checkImageAccessFn func(
+		repoURL string,
+		creds *images.Credentials,
+	) error
+	checkChartRegistryAccessFn func(
+		ctx context.Context,
+		registryURL string,
+		creds *helm.Credentials,
+	) error
+
+	// TestClusterConnection API:
+	clusterRESTConfigFn func(
+		ctx context.Context,
+		client client.Client,
+		cluster *kargoapi.Cluster,
+		allowExec bool,
+	) (*rest.Config, error)
+	clusterServerVersionFn func(*rest.Config) (string, error)
+
+	// StartOperation API:
+	exportProjectFn func(
+		ctx context.Context,
+		project string,
+		progress func(message string),
+	) ([]byte, error)
 }
 
 type Server interface {
@@ -113,16 +276,66 @@ func NewServer(
 	// TODO: KR: Test that these all get set
 	s.validateProjectFn = s.validateProject
 	s.externalValidateProjectFn = validation.ValidateProject
+	if (cfg.ProjectCacheTTL > 0 || cfg.ProjectCacheNegativeTTL > 0) &&
+		cfg.ProjectCacheMaxEntries > 0 {
+		s.projectCache = validation.NewProjectCache(
+			cfg.ProjectCacheTTL,
+			cfg.ProjectCacheNegativeTTL,
+			cfg.ProjectCacheMaxEntries,
+		)
+		s.externalValidateProjectFn = s.projectCache.ValidateProject
+	}
+	if cfg.TokenRevocationConfig != nil {
+		s.revokedTokens = revocation.NewList(
+			cfg.TokenRevocationConfig.ConfigMapNamespace,
+			cfg.TokenRevocationConfig.ConfigMapName,
+		)
+	}
+	s.operations = operation.NewManager(operation.DefaultRetention)
+	// Credentials are looked up using the authorizing client, and only after
+	// DiffFreight has confirmed that the user may read Secrets in the Project.
+	// Read-only users, who otherwise share the server's own read access, may not.
+	s.credentialsDB = credentials.NewKubernetesDatabase(
+		cfg.ArgoCDConfig.Namespace,
+		kubeClient,
+		nil,
+	)
 	s.getStageFn = kargoapi.GetStage
 	s.getQualifiedFreightFn = kargoapi.GetQualifiedFreight
+	s.getPromotableFreightFn = kargoapi.GetPromotableFreight
 	s.createPromotionFn = kubeClient.Create
+	s.validateFreightAgeFn = s.validateFreightAge
+	s.nowFn = time.Now
+	s.getFreightFn = kargoapi.GetFreight
+	s.patchFreightStatusFn = s.patchFreightStatus
+	s.listWarehousesFn = kubeClient.List
+	s.patchWarehouseStatusFn = s.patchWarehouseStatus
+	s.refreshWarehouseFn = kargoapi.RefreshWarehouse
 	s.findStageSubscribersFn = s.findStageSubscribers
+	s.findDownstreamStagesFn = s.findDownstreamStages
+	s.isFreightSharedWithFn = kargoapi.IsFreightSharedWith
+	s.findFreightProjectsFn = s.findFreightProjects
+	s.validateStageRenamableFn = s.validateStageRenamable
+	s.rewriteStageReferencesFn = s.rewriteStageReferences
+	s.isProjectArchivedFn = kargoapi.IsProjectArchived
+	s.createReleaseFn = kubeClient.Create
 	s.listFreightFn = kubeClient.List
 	s.getAvailableFreightForStageFn = s.getAvailableFreightForStage
 	s.getFreightFromWarehouseFn = s.getFreightFromWarehouse
 	s.getFreightQualifiedForUpstreamStagesFn =
 		s.getFreightQualifiedForUpstreamStages
 	s.parseManifestFn = manifest.NewParser(kubeClient.Scheme())
+	s.reviewTokenFn = s.reviewToken
+	s.patchRevokedTokensFn = s.patchRevokedTokens
+	s.getControllerMetricsFn = s.getControllerMetrics
+	s.diffCommitsFn = s.diffCommits
+	s.cloneRepoFn = git.Clone
+	s.checkGitAccessFn = git.CheckAccess
+	s.checkImageAccessFn = images.CheckAccess
+	s.checkChartRegistryAccessFn = helm.CheckAccess
+	s.clusterRESTConfigFn = cluster.RESTConfig
+	s.clusterServerVersionFn = cluster.ServerVersion
+	s.exportProjectFn = s.exportProject
 	return s
 }
 
@@ -130,7 +343,14 @@ func (s *server) Serve(ctx context.Context, l net.Listener) error {
 	log := logging.LoggerFromContext(ctx)
 	mux := http.NewServeMux()
 
-	opts, err := option.NewHandlerOption(ctx, s.cfg)
+	opts, err := option.NewHandlerOption(
+		ctx,
+		s.cfg,
+		s.revokedTokens,
+		func(ctx context.Context, project string) (bool, error) {
+			return kargoapi.IsProjectArchived(ctx, s.client, project)
+		},
+	)
 	if err != nil {
 		return errors.Wrap(err, "error initializing handler options")
 	}
@@ -147,10 +367,53 @@ func (s *server) Serve(ctx context.Context, l net.Listener) error {
 		mux.Handle("/dex/", dexProxy)
 	}
 
+	if s.projectCache != nil {
+		// The cache's watch is performed with the server's own permissions
+		go s.projectCache.Run(
+			user.ContextWithInfo(ctx, user.Info{IsAdmin: true}),
+			s.client,
+		)
+	}
+
+	if s.revokedTokens != nil {
+		// The revocation list's watch is performed with the server's own
+		// permissions
+		go s.revokedTokens.Run(
+			user.ContextWithInfo(ctx, user.Info{IsAdmin: true}),
+			s.client,
+		)
+	}
+
+	pusher, err := metrics.NewPusher(
+		s.cfg.MetricsPushConfig,
+		"kargo-api",
+		prometheus.DefaultGatherer,
+	)
+	if err != nil {
+		return errors.Wrap(err, "error initializing metrics pusher")
+	}
+	if pusher != nil {
+		go func() {
+			if err := pusher.Run(ctx); err != nil {
+				log.WithError(err).Error("error pushing metrics")
+			}
+		}()
+	}
+
 	srv := &http.Server{
-		Handler:           h2c.NewHandler(mux, &http2.Server{}),
+		Handler: h2c.NewHandler(
+			option.WithClientCertificate(mux),
+			&http2.Server{},
+		),
 		ReadHeaderTimeout: time.Minute,
 	}
+	if s.cfg.TLSConfig != nil && s.cfg.TLSConfig.ClientCAPath != "" {
+		if srv.TLSConfig, err = newClientAuthTLSConfig(
+			s.cfg.TLSConfig.ClientCAPath,
+		); err != nil {
+			return err
+		}
+	}
 
 	errCh := make(chan error)
 	go func() {
@@ -182,6 +445,27 @@ func (s *server) Serve(ctx context.Context, l net.Listener) error {
 	}
 }
 
+// newClientAuthTLSConfig returns a *tls.Config that requests, but does not
+// require, client certificates and verifies any that are presented against the
+// CA certificates found in the specified file. Clients that do not present a
+// certificate may still authenticate by other means.
+func newClientAuthTLSConfig(caCertPath string) (*tls.Config, error) {
+	caCertBytes, err := goos.ReadFile(caCertPath)
+	if err != nil {
+		return nil,
+			errors.Wrapf(err, "error reading client CA cert file %q", caCertPath)
+	}
+	caCertPool := x509.NewCertPool()
+	if ok := caCertPool.AppendCertsFromPEM(caCertBytes); !ok {
+		return nil, errors.New("invalid client CA cert data")
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+		ClientCAs:  caCertPool,
+	}, nil
+}
+
 func (s *server) newDashboardRequestHandler() http.HandlerFunc {
 	fs := http.FileServer(http.Dir(s.cfg.UIDirectory))
 	return func(w http.ResponseWriter, req *http.Request) {
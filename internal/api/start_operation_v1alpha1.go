@@ -0,0 +1,183 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/operation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	operationTypeDeleteResource = "DeleteResource"
+	operationTypeExportProject  = "ExportProject"
+)
+
+func (s *server) StartOperation(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.StartOperationRequest],
+) (*connect.Response[svcv1alpha1.StartOperationResponse], error) {
+	var op operation.Operation
+	switch o := req.Msg.GetOperation().(type) {
+	case *svcv1alpha1.StartOperationRequest_DeleteResource:
+		if len(o.DeleteResource.GetManifest()) == 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("manifest should not be empty"))
+		}
+		deleteReq := connect.NewRequest(o.DeleteResource)
+		op = s.operations.Start(
+			ctx,
+			operationTypeDeleteResource,
+			"",
+			func(ctx context.Context, _ func(string)) (any, error) {
+				res, err := s.DeleteResource(ctx, deleteReq)
+				if err != nil {
+					return nil, err
+				}
+				return res.Msg, nil
+			},
+		)
+	case *svcv1alpha1.StartOperationRequest_ExportProject:
+		project := o.ExportProject.GetProject()
+		if project == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+		}
+		if err := s.validateProjectFn(ctx, project); err != nil {
+			return nil, err
+		}
+		op = s.operations.Start(
+			ctx,
+			operationTypeExportProject,
+			project,
+			func(ctx context.Context, progress func(string)) (any, error) {
+				manifest, err := s.exportProjectFn(ctx, project, progress)
+				if err != nil {
+					return nil, err
+				}
+				return &svcv1alpha1.ExportProjectResult{Manifest: manifest}, nil
+			},
+		)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("operation should not be empty"))
+	}
+	return connect.NewResponse(&svcv1alpha1.StartOperationResponse{
+		Operation: toOperationProto(op),
+	}), nil
+}
+
+// exportProjectLists are the lists of resources that are included when a
+// project is exported, in the order they are exported.
+var exportProjectLists = []struct {
+	kind string
+	list func() client.ObjectList
+}{
+	{kind: "Warehouse", list: func() client.ObjectList { return &kargoapi.WarehouseList{} }},
+	{kind: "Stage", list: func() client.ObjectList { return &kargoapi.StageList{} }},
+	{kind: "PromotionPolicy", list: func() client.ObjectList { return &kargoapi.PromotionPolicyList{} }},
+}
+
+// exportProject returns a multi-document YAML manifest describing the
+// specified project's Warehouses, Stages, and PromotionPolicies. Server-managed
+// fields are omitted so that the manifest may be applied to re-create the
+// project elsewhere.
+func (s *server) exportProject(
+	ctx context.Context,
+	project string,
+	progress func(message string),
+) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, l := range exportProjectLists {
+		progress(fmt.Sprintf("exporting %s resources", l.kind))
+		list := l.list()
+		if err := s.client.List(ctx, list, client.InNamespace(project)); err != nil {
+			return nil, errors.Wrapf(err, "list %s resources", l.kind)
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, errors.Wrapf(err, "extract %s resources", l.kind)
+		}
+		for _, item := range items {
+			doc, err := exportObject(item, l.kind)
+			if err != nil {
+				return nil, err
+			}
+			buf.WriteString("---\n")
+			buf.Write(doc)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// exportObject marshals the provided object as YAML, omitting its status and
+// all metadata that is managed by the server.
+func exportObject(obj runtime.Object, kind string) ([]byte, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, errors.Wrapf(err, "convert %s", kind)
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(kargoapi.GroupVersion.WithKind(kind))
+	unstructured.RemoveNestedField(u.Object, "status")
+	for _, field := range []string{
+		"creationTimestamp",
+		"generation",
+		"managedFields",
+		"resourceVersion",
+		"uid",
+	} {
+		unstructured.RemoveNestedField(u.Object, "metadata", field)
+	}
+	doc, err := sigyaml.Marshal(u.Object)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal %s %q", kind, u.GetName())
+	}
+	return doc, nil
+}
+
+func toOperationProto(op operation.Operation) *svcv1alpha1.Operation {
+	res := &svcv1alpha1.Operation{
+		Id:        op.ID,
+		Type:      op.Type,
+		Project:   op.Project,
+		Phase:     string(op.Phase),
+		Message:   op.Message,
+		StartTime: timestamppb.New(op.StartTime),
+	}
+	if !op.EndTime.IsZero() {
+		res.EndTime = timestamppb.New(op.EndTime)
+	}
+	switch result := op.Result.(type) {
+	case *svcv1alpha1.DeleteResourceResponse:
+		res.Result = &svcv1alpha1.OperationResult{
+			Result: &svcv1alpha1.OperationResult_DeleteResource{
+				DeleteResource: result,
+			},
+		}
+	case *svcv1alpha1.ExportProjectResult:
+		res.Result = &svcv1alpha1.OperationResult{
+			Result: &svcv1alpha1.OperationResult_ExportProject{
+				ExportProject: result,
+			},
+		}
+	}
+	return res
+}
+
+// operationError converts an error returned by the operation.Manager to a
+// connect error.
+func operationError(err error) error {
+	if errors.Is(err, operation.ErrNotFound) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
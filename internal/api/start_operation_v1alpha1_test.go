@@ -0,0 +1,181 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/operation"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestStartOperation(t *testing.T) {
+	testCases := map[string]struct {
+		req        *svcv1alpha1.StartOperationRequest
+		assertions func(*server, *connect.Response[svcv1alpha1.StartOperationResponse], error)
+	}{
+		"no operation": {
+			req: &svcv1alpha1.StartOperationRequest{},
+			assertions: func(
+				_ *server,
+				_ *connect.Response[svcv1alpha1.StartOperationResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"export with empty project": {
+			req: &svcv1alpha1.StartOperationRequest{
+				Operation: &svcv1alpha1.StartOperationRequest_ExportProject{
+					ExportProject: &svcv1alpha1.ExportProjectRequest{},
+				},
+			},
+			assertions: func(
+				_ *server,
+				_ *connect.Response[svcv1alpha1.StartOperationResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"export non-existing project": {
+			req: &svcv1alpha1.StartOperationRequest{
+				Operation: &svcv1alpha1.StartOperationRequest_ExportProject{
+					ExportProject: &svcv1alpha1.ExportProjectRequest{
+						Project: "non-existing-project",
+					},
+				},
+			},
+			assertions: func(
+				_ *server,
+				_ *connect.Response[svcv1alpha1.StartOperationResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		"delete with empty manifest": {
+			req: &svcv1alpha1.StartOperationRequest{
+				Operation: &svcv1alpha1.StartOperationRequest_DeleteResource{
+					DeleteResource: &svcv1alpha1.DeleteResourceRequest{},
+				},
+			},
+			assertions: func(
+				_ *server,
+				_ *connect.Response[svcv1alpha1.StartOperationResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"export project": {
+			req: &svcv1alpha1.StartOperationRequest{
+				Operation: &svcv1alpha1.StartOperationRequest_ExportProject{
+					ExportProject: &svcv1alpha1.ExportProjectRequest{
+						Project: "kargo-demo",
+					},
+				},
+			},
+			assertions: func(
+				svr *server,
+				res *connect.Response[svcv1alpha1.StartOperationResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				op := res.Msg.GetOperation()
+				require.NotEmpty(t, op.GetId())
+				require.Equal(t, "ExportProject", op.GetType())
+				require.Equal(t, "kargo-demo", op.GetProject())
+
+				// The operation must be retrievable with the identity of the user
+				// who started it.
+				ctx := user.ContextWithInfo(
+					context.Background(),
+					user.Info{
+						IsAdmin: true,
+					},
+				)
+				require.Eventually(t, func() bool {
+					getRes, err := svr.GetOperation(
+						ctx,
+						connect.NewRequest(&svcv1alpha1.GetOperationRequest{
+							Id: op.GetId(),
+						}),
+					)
+					require.NoError(t, err)
+					op = getRes.Msg.GetOperation()
+					return operation.Phase(op.GetPhase()).IsTerminal()
+				}, 5*time.Second, 10*time.Millisecond)
+				require.Equal(t, string(operation.PhaseSucceeded), op.GetPhase())
+				require.NotNil(t, op.GetEndTime())
+
+				manifest := string(op.GetResult().GetExportProject().GetManifest())
+				require.Contains(t, manifest, "kind: Stage\n")
+				require.Contains(t, manifest, "name: test\n")
+				require.NotContains(t, manifest, "resourceVersion")
+				require.NotContains(t, manifest, "status:")
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(
+								mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+								mustNewObject[kargoapi.Stage]("testdata/stage.yaml"),
+							).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client:     kubeClient,
+				operations: operation.NewManager(operation.DefaultRetention),
+			}
+			svr.validateProjectFn = svr.validateProject
+			svr.externalValidateProjectFn = validation.ValidateProject
+			svr.exportProjectFn = svr.exportProject
+			res, err := svr.StartOperation(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(svr, res, err)
+		})
+	}
+}
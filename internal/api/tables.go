@@ -0,0 +1,205 @@
+package api
+
+import (
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	tableColumnTypeAge    = "age"
+	tableColumnTypeHealth = "health"
+	tableColumnTypeString = "string"
+
+	tableGlyphHealthy     = "healthy"
+	tableGlyphProgressing = "progressing"
+	tableGlyphUnhealthy   = "unhealthy"
+	tableGlyphUnknown     = "unknown"
+)
+
+// newStagesTable returns a Table representation of the provided Stages. Ages
+// are computed relative to now.
+func newStagesTable(stages []kargoapi.Stage, now time.Time) *svcv1alpha1.Table {
+	rows := make([]*svcv1alpha1.TableRow, len(stages))
+	for i, stage := range stages {
+		var currentFreightID string
+		if stage.Status.CurrentFreight != nil {
+			currentFreightID = stage.Status.CurrentFreight.ID
+		}
+		health := &svcv1alpha1.TableCell{}
+		if stage.Status.Health != nil {
+			health.Text = string(stage.Status.Health.Status)
+			health.Glyph = healthStateGlyph(stage.Status.Health.Status)
+		}
+		var owners []string
+		if stage.Spec != nil {
+			for _, owner := range stage.Spec.Owners {
+				owners = append(owners, owner.Name)
+			}
+		}
+		rows[i] = &svcv1alpha1.TableRow{
+			Name: stage.Name,
+			Cells: []*svcv1alpha1.TableCell{
+				{Text: stage.Name},
+				{Text: currentFreightID},
+				health,
+				{Text: strings.Join(owners, ",")},
+				ageCell(stage.CreationTimestamp.Time, now),
+			},
+		}
+	}
+	return &svcv1alpha1.Table{
+		Columns: []*svcv1alpha1.TableColumn{
+			{Name: "Name", Type: tableColumnTypeString},
+			{Name: "Current Freight", Type: tableColumnTypeString},
+			{Name: "Health", Type: tableColumnTypeHealth},
+			{Name: "Owners", Type: tableColumnTypeString},
+			{Name: "Age", Type: tableColumnTypeAge},
+		},
+		Rows: rows,
+	}
+}
+
+// newPromotionsTable returns a Table representation of the provided
+// Promotions. Ages are computed relative to now.
+func newPromotionsTable(
+	promos []kargoapi.Promotion,
+	now time.Time,
+) *svcv1alpha1.Table {
+	rows := make([]*svcv1alpha1.TableRow, len(promos))
+	for i, promo := range promos {
+		var stage, freight string
+		if promo.Spec != nil {
+			stage = promo.Spec.Stage
+			freight = promo.Spec.Freight
+		}
+		phase := promo.GetStatus().Phase
+		rows[i] = &svcv1alpha1.TableRow{
+			Name: promo.Name,
+			Cells: []*svcv1alpha1.TableCell{
+				{Text: promo.Name},
+				{Text: stage},
+				{Text: freight},
+				{
+					Text:  string(phase),
+					Glyph: promotionPhaseGlyph(phase),
+				},
+				ageCell(promo.CreationTimestamp.Time, now),
+			},
+		}
+	}
+	return &svcv1alpha1.Table{
+		Columns: []*svcv1alpha1.TableColumn{
+			{Name: "Name", Type: tableColumnTypeString},
+			{Name: "Stage", Type: tableColumnTypeString},
+			{Name: "Freight", Type: tableColumnTypeString},
+			{Name: "Phase", Type: tableColumnTypeHealth},
+			{Name: "Age", Type: tableColumnTypeAge},
+		},
+		Rows: rows,
+	}
+}
+
+// newWarehousesTable returns a Table representation of the provided
+// Warehouses. Ages are computed relative to now.
+func newWarehousesTable(
+	warehouses []kargoapi.Warehouse,
+	now time.Time,
+) *svcv1alpha1.Table {
+	rows := make([]*svcv1alpha1.TableRow, len(warehouses))
+	for i, warehouse := range warehouses {
+		rows[i] = &svcv1alpha1.TableRow{
+			Name: warehouse.Name,
+			Cells: []*svcv1alpha1.TableCell{
+				{Text: warehouse.Name},
+				ageCell(warehouse.CreationTimestamp.Time, now),
+			},
+		}
+	}
+	return &svcv1alpha1.Table{
+		Columns: []*svcv1alpha1.TableColumn{
+			{Name: "Name", Type: tableColumnTypeString},
+			{Name: "Age", Type: tableColumnTypeAge},
+		},
+		Rows: rows,
+	}
+}
+
+// newProjectsTable returns a Table representation of the provided project
+// namespaces. Ages are computed relative to now.
+func newProjectsTable(
+	namespaces []corev1.Namespace,
+	now time.Time,
+) *svcv1alpha1.Table {
+	rows := make([]*svcv1alpha1.TableRow, len(namespaces))
+	for i, ns := range namespaces {
+		rows[i] = &svcv1alpha1.TableRow{
+			Name: ns.Name,
+			Cells: []*svcv1alpha1.TableCell{
+				{Text: ns.Name},
+				projectStatusCell(&namespaces[i]),
+				ageCell(ns.CreationTimestamp.Time, now),
+			},
+		}
+	}
+	return &svcv1alpha1.Table{
+		Columns: []*svcv1alpha1.TableColumn{
+			{Name: "Name", Type: tableColumnTypeString},
+			{Name: "Status", Type: tableColumnTypeString},
+			{Name: "Age", Type: tableColumnTypeAge},
+		},
+		Rows: rows,
+	}
+}
+
+// projectStatusCell returns a cell indicating whether the Project represented
+// by the provided namespace is archived.
+func projectStatusCell(ns *corev1.Namespace) *svcv1alpha1.TableCell {
+	if kargoapi.IsNamespaceArchived(ns) {
+		return &svcv1alpha1.TableCell{Text: "Archived"}
+	}
+	return &svcv1alpha1.TableCell{Text: "Active"}
+}
+
+// ageCell returns a cell containing the humanized amount of time elapsed
+// between created and now.
+func ageCell(created time.Time, now time.Time) *svcv1alpha1.TableCell {
+	return &svcv1alpha1.TableCell{
+		Text: duration.HumanDuration(now.Sub(created)),
+	}
+}
+
+// healthStateGlyph returns the glyph corresponding to the provided
+// HealthState.
+func healthStateGlyph(state kargoapi.HealthState) string {
+	switch state {
+	case kargoapi.HealthStateHealthy:
+		return tableGlyphHealthy
+	case kargoapi.HealthStateProgressing:
+		return tableGlyphProgressing
+	case kargoapi.HealthStateUnhealthy:
+		return tableGlyphUnhealthy
+	default:
+		return tableGlyphUnknown
+	}
+}
+
+// promotionPhaseGlyph returns the glyph corresponding to the provided
+// PromotionPhase.
+func promotionPhaseGlyph(phase kargoapi.PromotionPhase) string {
+	switch phase {
+	case kargoapi.PromotionPhaseSucceeded:
+		return tableGlyphHealthy
+	case kargoapi.PromotionPhasePending, kargoapi.PromotionPhaseRunning:
+		return tableGlyphProgressing
+	case kargoapi.PromotionPhaseErrored:
+		return tableGlyphUnhealthy
+	default:
+		return tableGlyphUnknown
+	}
+}
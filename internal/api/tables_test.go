@@ -0,0 +1,196 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestNewStagesTable(t *testing.T) {
+	now := time.Now()
+	table := newStagesTable(
+		[]kargoapi.Stage{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "fake-stage",
+					CreationTimestamp: metav1.NewTime(now.Add(-90 * time.Minute)),
+				},
+				Spec: &kargoapi.StageSpec{
+					Owners: []kargoapi.StageOwner{
+						{Kind: kargoapi.StageOwnerKindUser, Name: "alice"},
+						{Kind: kargoapi.StageOwnerKindTeam, Name: "platform-team"},
+					},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{
+						ID: "fake-freight",
+					},
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateProgressing,
+					},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "another-fake-stage",
+					CreationTimestamp: metav1.NewTime(now.Add(-3 * 24 * time.Hour)),
+				},
+			},
+		},
+		now,
+	)
+	require.Equal(
+		t,
+		[]*svcv1alpha1.TableColumn{
+			{Name: "Name", Type: tableColumnTypeString},
+			{Name: "Current Freight", Type: tableColumnTypeString},
+			{Name: "Health", Type: tableColumnTypeHealth},
+			{Name: "Owners", Type: tableColumnTypeString},
+			{Name: "Age", Type: tableColumnTypeAge},
+		},
+		table.Columns,
+	)
+	require.Equal(
+		t,
+		[]*svcv1alpha1.TableRow{
+			{
+				Name: "fake-stage",
+				Cells: []*svcv1alpha1.TableCell{
+					{Text: "fake-stage"},
+					{Text: "fake-freight"},
+					{Text: "Progressing", Glyph: tableGlyphProgressing},
+					{Text: "alice,platform-team"},
+					{Text: "90m"},
+				},
+			},
+			{
+				Name: "another-fake-stage",
+				Cells: []*svcv1alpha1.TableCell{
+					{Text: "another-fake-stage"},
+					{},
+					{},
+					{},
+					{Text: "3d"},
+				},
+			},
+		},
+		table.Rows,
+	)
+}
+
+func TestNewPromotionsTable(t *testing.T) {
+	now := time.Now()
+	table := newPromotionsTable(
+		[]kargoapi.Promotion{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "fake-promotion",
+					CreationTimestamp: metav1.NewTime(now.Add(-30 * time.Second)),
+				},
+				Spec: &kargoapi.PromotionSpec{
+					Stage:   "fake-stage",
+					Freight: "fake-freight",
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseErrored,
+				},
+			},
+		},
+		now,
+	)
+	require.Len(t, table.Columns, 5)
+	require.Equal(
+		t,
+		[]*svcv1alpha1.TableRow{
+			{
+				Name: "fake-promotion",
+				Cells: []*svcv1alpha1.TableCell{
+					{Text: "fake-promotion"},
+					{Text: "fake-stage"},
+					{Text: "fake-freight"},
+					{Text: "Errored", Glyph: tableGlyphUnhealthy},
+					{Text: "30s"},
+				},
+			},
+		},
+		table.Rows,
+	)
+}
+
+func TestNewProjectsTable(t *testing.T) {
+	now := time.Now()
+	table := newProjectsTable(
+		[]corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "fake-project",
+					CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-archived-project",
+					Labels: map[string]string{
+						kargoapi.LabelArchivedKey: kargoapi.LabelTrueValue,
+					},
+					CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+				},
+			},
+		},
+		now,
+	)
+	require.Equal(
+		t,
+		[]*svcv1alpha1.TableRow{
+			{
+				Name: "fake-project",
+				Cells: []*svcv1alpha1.TableCell{
+					{Text: "fake-project"},
+					{Text: "Active"},
+					{Text: "120m"},
+				},
+			},
+			{
+				Name: "fake-archived-project",
+				Cells: []*svcv1alpha1.TableCell{
+					{Text: "fake-archived-project"},
+					{Text: "Archived"},
+					{Text: "60m"},
+				},
+			},
+		},
+		table.Rows,
+	)
+}
+
+func TestHealthStateGlyph(t *testing.T) {
+	require.Equal(t, tableGlyphHealthy, healthStateGlyph(kargoapi.HealthStateHealthy))
+	require.Equal(t, tableGlyphUnhealthy, healthStateGlyph(kargoapi.HealthStateUnhealthy))
+	require.Equal(t, tableGlyphUnknown, healthStateGlyph(kargoapi.HealthStateUnknown))
+	require.Equal(t, tableGlyphUnknown, healthStateGlyph(""))
+}
+
+func TestPromotionPhaseGlyph(t *testing.T) {
+	require.Equal(
+		t,
+		tableGlyphHealthy,
+		promotionPhaseGlyph(kargoapi.PromotionPhaseSucceeded),
+	)
+	require.Equal(
+		t,
+		tableGlyphProgressing,
+		promotionPhaseGlyph(kargoapi.PromotionPhasePending),
+	)
+	require.Equal(
+		t,
+		tableGlyphProgressing,
+		promotionPhaseGlyph(kargoapi.PromotionPhaseRunning),
+	)
+	require.Equal(t, tableGlyphUnknown, promotionPhaseGlyph(""))
+}
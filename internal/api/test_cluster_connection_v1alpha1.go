@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) TestClusterConnection(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.TestClusterConnectionRequest],
+) (*connect.Response[svcv1alpha1.TestClusterConnectionResponse], error) {
+	project := req.Msg.GetProject()
+	if project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	name := req.Msg.GetName()
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name should not be empty"))
+	}
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	c, err := kargoapi.GetCluster(ctx, s.client, types.NamespacedName{
+		Namespace: project,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if c == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf("Cluster %q not found in project %q", name, project),
+		)
+	}
+
+	res := &svcv1alpha1.TestClusterConnectionResponse{
+		Server: c.Spec.Server,
+	}
+	// Failure to connect is the outcome of the test rather than an error
+	cfg, err := s.clusterRESTConfigFn(ctx, s.client, c, s.cfg.ClusterExecAuthEnabled)
+	if err != nil {
+		res.Message = errors.Wrap(err, "invalid configuration").Error()
+		return connect.NewResponse(res), nil
+	}
+	version, err := s.clusterServerVersionFn(cfg)
+	if err != nil {
+		res.Message = err.Error()
+		return connect.NewResponse(res), nil
+	}
+	res.Success = true
+	res.ServerVersion = &version
+	res.Message = fmt.Sprintf("API server reported Kubernetes version %s", version)
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,172 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestTestClusterConnection(t *testing.T) {
+	// Simulate an admin user to prevent any authz issues with the authorizing
+	// client.
+	ctx := user.ContextWithInfo(context.Background(), user.Info{IsAdmin: true})
+	kubeClient, err := kubernetes.NewClient(
+		ctx,
+		&rest.Config{},
+		kubernetes.ClientOptions{
+			NewInternalClient: func(
+				_ context.Context,
+				_ *rest.Config,
+				scheme *runtime.Scheme,
+			) (client.Client, error) {
+				return fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(
+						&kargoapi.Cluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "fake-cluster",
+								Namespace: "fake-project",
+							},
+							Spec: &kargoapi.ClusterSpec{
+								Server: "https://fake-server",
+							},
+						},
+					).
+					Build(), nil
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.TestClusterConnectionRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.TestClusterConnectionResponse], error)
+	}{
+		{
+			name:   "missing project",
+			req:    &svcv1alpha1.TestClusterConnectionRequest{Name: "fake-cluster"},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.TestClusterConnectionResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Cluster not found",
+			req: &svcv1alpha1.TestClusterConnectionRequest{
+				Project: "fake-project",
+				Name:    "missing",
+			},
+			server: &server{
+				client:            kubeClient,
+				validateProjectFn: func(context.Context, string) error { return nil },
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.TestClusterConnectionResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "invalid configuration",
+			req: &svcv1alpha1.TestClusterConnectionRequest{
+				Project: "fake-project",
+				Name:    "fake-cluster",
+			},
+			server: &server{
+				client:            kubeClient,
+				validateProjectFn: func(context.Context, string) error { return nil },
+				clusterRESTConfigFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Cluster,
+					bool,
+				) (*rest.Config, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.TestClusterConnectionResponse], err error) {
+				require.NoError(t, err)
+				require.False(t, res.Msg.GetSuccess())
+				require.Equal(t, "https://fake-server", res.Msg.GetServer())
+				require.Equal(t, "invalid configuration: something went wrong", res.Msg.GetMessage())
+			},
+		},
+		{
+			name: "connection failed",
+			req: &svcv1alpha1.TestClusterConnectionRequest{
+				Project: "fake-project",
+				Name:    "fake-cluster",
+			},
+			server: &server{
+				client:            kubeClient,
+				validateProjectFn: func(context.Context, string) error { return nil },
+				clusterRESTConfigFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Cluster,
+					bool,
+				) (*rest.Config, error) {
+					return &rest.Config{}, nil
+				},
+				clusterServerVersionFn: func(*rest.Config) (string, error) {
+					return "", errors.New("connection refused")
+				},
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.TestClusterConnectionResponse], err error) {
+				require.NoError(t, err)
+				require.False(t, res.Msg.GetSuccess())
+				require.Nil(t, res.Msg.ServerVersion)
+				require.Equal(t, "connection refused", res.Msg.GetMessage())
+			},
+		},
+		{
+			name: "connected",
+			req: &svcv1alpha1.TestClusterConnectionRequest{
+				Project: "fake-project",
+				Name:    "fake-cluster",
+			},
+			server: &server{
+				client:            kubeClient,
+				validateProjectFn: func(context.Context, string) error { return nil },
+				clusterRESTConfigFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Cluster,
+					bool,
+				) (*rest.Config, error) {
+					return &rest.Config{}, nil
+				},
+				clusterServerVersionFn: func(*rest.Config) (string, error) {
+					return "v1.28.2", nil
+				},
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.TestClusterConnectionResponse], err error) {
+				require.NoError(t, err)
+				require.True(t, res.Msg.GetSuccess())
+				require.Equal(t, "v1.28.2", res.Msg.GetServerVersion())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.TestClusterConnection(ctx, connect.NewRequest(testCase.req)),
+			)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
+	libGit "github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/helm"
+	"github.com/akuity/kargo/internal/images"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) TestCredentials(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.TestCredentialsRequest],
+) (*connect.Response[svcv1alpha1.TestCredentialsResponse], error) {
+	project := req.Msg.GetProject()
+	if project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	name := req.Msg.GetName()
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name should not be empty"))
+	}
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	var secret corev1.Secret
+	if err := s.client.Get(ctx, client.ObjectKey{
+		Namespace: project,
+		Name:      name,
+	}, &secret); err != nil {
+		if kubeerr.IsNotFound(err) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	creds, err := credentials.FromSecret(&secret)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	repoURL, err := credentialsTestURL(creds, req.Msg.GetRepoUrl())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	res := &svcv1alpha1.TestCredentialsResponse{
+		Type:    string(creds.Type),
+		RepoUrl: repoURL,
+	}
+	switch creds.Type {
+	case credentials.TypeGit:
+		err = s.checkGitAccessFn(repoURL, git.RepoCredentials{
+			Username:      creds.Username,
+			Password:      creds.Password,
+			SSHPrivateKey: creds.SSHPrivateKey,
+		})
+		res.Message = fmt.Sprintf("successfully listed branches of git repo %q", repoURL)
+	case credentials.TypeImage:
+		err = s.checkImageAccessFn(repoURL, &images.Credentials{
+			Username: creds.Username,
+			Password: creds.Password,
+		})
+		res.Message = fmt.Sprintf("successfully listed tags of image %q", repoURL)
+	case credentials.TypeHelm:
+		err = s.checkChartRegistryAccessFn(ctx, repoURL, &helm.Credentials{
+			Username: creds.Username,
+			Password: creds.Password,
+		})
+		res.Message = fmt.Sprintf("successfully queried chart registry %q", repoURL)
+	}
+	// Failure to access the repository is the outcome of the test rather than
+	// an error
+	res.Success = err == nil
+	if err != nil {
+		res.Message = err.Error()
+	}
+	return connect.NewResponse(res), nil
+}
+
+// credentialsTestURL returns the URL of the repository that the provided
+// credentials should be tested against. A credentials template applies to
+// many repositories, so the URL of one of them must be specified. Otherwise,
+// the specified URL, if any, must refer to the repository the credentials
+// apply to.
+func credentialsTestURL(
+	creds *credentials.SecretCredentials,
+	repoURL string,
+) (string, error) {
+	if creds.Template {
+		if repoURL == "" {
+			return "", errors.Errorf(
+				"repo_url should not be empty when testing a credentials template "+
+					"for repositories beginning with %q",
+				creds.URL,
+			)
+		}
+		prefixOf := repoURL
+		if creds.Type == credentials.TypeGit {
+			prefixOf = libGit.NormalizeGitURL(repoURL)
+		}
+		if !strings.HasPrefix(prefixOf, creds.URL) {
+			return "", errors.Errorf(
+				"credentials template does not apply to %q; it applies only to "+
+					"repositories beginning with %q",
+				repoURL,
+				creds.URL,
+			)
+		}
+		return repoURL, nil
+	}
+	if repoURL == "" {
+		return creds.URL, nil
+	}
+	same := repoURL == creds.URL
+	if creds.Type == credentials.TypeGit {
+		same = libGit.NormalizeGitURL(repoURL) == libGit.NormalizeGitURL(creds.URL)
+	}
+	if !same {
+		return "", errors.Errorf(
+			"credentials do not apply to %q; they apply only to %q",
+			repoURL,
+			creds.URL,
+		)
+	}
+	return repoURL, nil
+}
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/images"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestTestCredentials(t *testing.T) {
+	newSecret := func(name, secretType, credType, url string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "fake-project",
+				Labels: map[string]string{
+					"kargo.akuity.io/secret-type": secretType,
+				},
+			},
+			Data: map[string][]byte{
+				"type":     []byte(credType),
+				"url":      []byte(url),
+				"username": []byte("fake-username"),
+				"password": []byte("fake-password"),
+			},
+		}
+	}
+
+	// Simulate an admin user to prevent any authz issues with the authorizing
+	// client.
+	ctx := user.ContextWithInfo(context.Background(), user.Info{IsAdmin: true})
+	kubeClient, err := kubernetes.NewClient(
+		ctx,
+		&rest.Config{},
+		kubernetes.ClientOptions{
+			NewInternalClient: func(
+				_ context.Context,
+				_ *rest.Config,
+				scheme *runtime.Scheme,
+			) (client.Client, error) {
+				return fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(
+						newSecret("git-creds", "repository", "git", "https://github.com/example/repo.git"),
+						newSecret("git-template", "repo-creds", "git", "https://github.com/example/"),
+						newSecret("image-creds", "repository", "image", "ghcr.io/example/image"),
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "unlabeled",
+								Namespace: "fake-project",
+							},
+						},
+					).
+					Build(), nil
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	testServer := &server{
+		client:            kubeClient,
+		validateProjectFn: func(context.Context, string) error { return nil },
+		checkGitAccessFn: func(repoURL string, creds git.RepoCredentials) error {
+			require.Equal(t, "fake-username", creds.Username)
+			require.Equal(t, "fake-password", creds.Password)
+			return nil
+		},
+		checkImageAccessFn: func(string, *images.Credentials) error {
+			return errors.New("unauthorized")
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.TestCredentialsRequest
+		assertions func(*connect.Response[svcv1alpha1.TestCredentialsResponse], error)
+	}{
+		{
+			name: "missing name",
+			req:  &svcv1alpha1.TestCredentialsRequest{Project: "fake-project"},
+			assertions: func(_ *connect.Response[svcv1alpha1.TestCredentialsResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Secret not found",
+			req: &svcv1alpha1.TestCredentialsRequest{
+				Project: "fake-project",
+				Name:    "missing",
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.TestCredentialsResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Secret does not hold credentials",
+			req: &svcv1alpha1.TestCredentialsRequest{
+				Project: "fake-project",
+				Name:    "unlabeled",
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.TestCredentialsResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "git credentials succeed",
+			req: &svcv1alpha1.TestCredentialsRequest{
+				Project: "fake-project",
+				Name:    "git-creds",
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.TestCredentialsResponse], err error) {
+				require.NoError(t, err)
+				require.True(t, res.Msg.GetSuccess())
+				require.Equal(t, "git", res.Msg.GetType())
+				require.Equal(t, "https://github.com/example/repo.git", res.Msg.GetRepoUrl())
+			},
+		},
+		{
+			name: "credentials template without repo URL",
+			req: &svcv1alpha1.TestCredentialsRequest{
+				Project: "fake-project",
+				Name:    "git-template",
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.TestCredentialsResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "repo_url should not be empty")
+			},
+		},
+		{
+			name: "credentials template with inapplicable repo URL",
+			req: &svcv1alpha1.TestCredentialsRequest{
+				Project: "fake-project",
+				Name:    "git-template",
+				RepoUrl: "https://gitlab.com/example/repo",
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.TestCredentialsResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "credentials template with applicable repo URL",
+			req: &svcv1alpha1.TestCredentialsRequest{
+				Project: "fake-project",
+				Name:    "git-template",
+				RepoUrl: "https://github.com/example/other-repo",
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.TestCredentialsResponse], err error) {
+				require.NoError(t, err)
+				require.True(t, res.Msg.GetSuccess())
+				require.Equal(t, "https://github.com/example/other-repo", res.Msg.GetRepoUrl())
+			},
+		},
+		{
+			name: "image credentials fail",
+			req: &svcv1alpha1.TestCredentialsRequest{
+				Project: "fake-project",
+				Name:    "image-creds",
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.TestCredentialsResponse], err error) {
+				require.NoError(t, err)
+				require.False(t, res.Msg.GetSuccess())
+				require.Equal(t, "unauthorized", res.Msg.GetMessage())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testServer.TestCredentials(ctx, connect.NewRequest(testCase.req)),
+			)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesmetav1 "github.com/akuity/kargo/internal/api/types/metav1"
 	"github.com/akuity/kargo/internal/version"
+	"github.com/akuity/kargo/pkg/api/metav1"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 	"github.com/akuity/kargo/pkg/api/v1alpha1"
 )
@@ -24,6 +25,11 @@ func FromProjectProto(p *svcv1alpha1.Project) *unstructured.Unstructured {
 	u.SetKind("Project")
 	u.SetCreationTimestamp(kubemetav1.NewTime(p.GetCreateTime().AsTime()))
 	u.SetName(p.GetName())
+	if p.GetArchived() {
+		u.SetLabels(map[string]string{
+			kargoapi.LabelArchivedKey: kargoapi.LabelTrueValue,
+		})
+	}
 	return u
 }
 
@@ -54,6 +60,85 @@ func FromStageSpecProto(s *v1alpha1.StageSpec) *kargoapi.StageSpec {
 	return &kargoapi.StageSpec{
 		Subscriptions:       FromSubscriptionsProto(s.GetSubscriptions()),
 		PromotionMechanisms: FromPromotionMechanismsProto(s.GetPromotionMechanisms()),
+		Qualification:       FromQualificationPolicyProto(s.GetQualification()),
+		HealthChecks:        FromHealthChecksProto(s.GetHealthChecks()),
+		HealthDamping:       FromHealthDampingPolicyProto(s.GetHealthDamping()),
+		Owners:              FromStageOwnersProto(s.GetOwners()),
+		ReconcileInterval:   fromDurationString(s.ReconcileInterval),
+	}
+}
+
+func FromStageOwnersProto(owners []*v1alpha1.StageOwner) []kargoapi.StageOwner {
+	if len(owners) == 0 {
+		return nil
+	}
+	res := make([]kargoapi.StageOwner, len(owners))
+	for i, owner := range owners {
+		res[i] = kargoapi.StageOwner{
+			Kind:  kargoapi.StageOwnerKind(owner.GetKind()),
+			Name:  owner.GetName(),
+			Email: owner.GetEmail(),
+		}
+	}
+	return res
+}
+
+func FromHealthDampingPolicyProto(
+	h *v1alpha1.HealthDampingPolicy,
+) *kargoapi.HealthDampingPolicy {
+	if h == nil {
+		return nil
+	}
+	return &kargoapi.HealthDampingPolicy{
+		ConsecutiveChecks: h.GetConsecutiveChecks(),
+		For:               fromDurationString(h.For),
+	}
+}
+
+func FromHealthChecksProto(checks []*v1alpha1.HealthCheck) []kargoapi.HealthCheck {
+	if len(checks) == 0 {
+		return nil
+	}
+	res := make([]kargoapi.HealthCheck, len(checks))
+	for i, check := range checks {
+		res[i] = kargoapi.HealthCheck{
+			Provider:   check.GetProvider(),
+			Parameters: check.GetParameters(),
+		}
+	}
+	return res
+}
+
+func FromQualificationPolicyProto(
+	q *v1alpha1.QualificationPolicy,
+) *kargoapi.QualificationPolicy {
+	if q == nil {
+		return nil
+	}
+	criteria := make([]kargoapi.QualificationCriterion, len(q.GetCriteria()))
+	for i, criterion := range q.GetCriteria() {
+		var healthy *kargoapi.HealthyCriterion
+		if criterion.GetHealthy() != nil {
+			healthy = &kargoapi.HealthyCriterion{
+				For: fromDurationString(criterion.GetHealthy().For),
+			}
+		}
+		var verification *kargoapi.VerificationCriterion
+		if criterion.GetVerification() != nil {
+			verification = &kargoapi.VerificationCriterion{
+				Provider:   criterion.GetVerification().GetProvider(),
+				Parameters: criterion.GetVerification().GetParameters(),
+			}
+		}
+		criteria[i] = kargoapi.QualificationCriterion{
+			Healthy:        healthy,
+			ManualApproval: criterion.GetManualApproval(),
+			Verification:   verification,
+		}
+	}
+	return &kargoapi.QualificationPolicy{
+		Operator: kargoapi.QualificationOperator(q.GetOperator()),
+		Criteria: criteria,
 	}
 }
 
@@ -65,11 +150,57 @@ func FromStageStatusProto(s *v1alpha1.StageStatus) *kargoapi.StageStatus {
 	for idx, freight := range s.GetHistory() {
 		history[idx] = *FromSimpleFreightProto(freight)
 	}
+	var conditions []kubemetav1.Condition
+	if len(s.GetConditions()) > 0 {
+		conditions = make([]kubemetav1.Condition, len(s.GetConditions()))
+		for idx, condition := range s.GetConditions() {
+			conditions[idx] = *typesmetav1.FromConditionProto(condition)
+		}
+	}
+	var verifications []kargoapi.VerificationResult
+	if len(s.GetVerifications()) > 0 {
+		verifications = make([]kargoapi.VerificationResult, len(s.GetVerifications()))
+		for idx, result := range s.GetVerifications() {
+			verifications[idx] = *FromVerificationResultProto(result)
+		}
+	}
+	var autoPromotionHold *kargoapi.AutoPromotionHold
+	if s.GetAutoPromotionHold() != nil {
+		autoPromotionHold = &kargoapi.AutoPromotionHold{
+			Since: kubemetav1.NewTime(s.GetAutoPromotionHold().GetSince().AsTime()),
+			Until: kubemetav1.NewTime(s.GetAutoPromotionHold().GetUntil().AsTime()),
+		}
+	}
 	return &kargoapi.StageStatus{
-		CurrentFreight: FromSimpleFreightProto(s.GetCurrentFreight()),
-		History:        history,
-		Health:         FromHealthProto(s.GetHealth()),
-		Error:          s.GetError(),
+		CurrentFreight:    FromSimpleFreightProto(s.GetCurrentFreight()),
+		History:           history,
+		Health:            FromHealthProto(s.GetHealth()),
+		Error:             s.GetError(),
+		Conditions:        conditions,
+		Verifications:     verifications,
+		AutoPromotionHold: autoPromotionHold,
+	}
+}
+
+func FromVerificationResultProto(
+	r *v1alpha1.VerificationResult,
+) *kargoapi.VerificationResult {
+	if r == nil {
+		return nil
+	}
+	var verifiedAt *kubemetav1.Time
+	if r.GetVerifiedAt() != nil {
+		t := kubemetav1.NewTime(r.GetVerifiedAt().AsTime())
+		verifiedAt = &t
+	}
+	return &kargoapi.VerificationResult{
+		Provider:     r.GetProvider(),
+		Freight:      r.GetFreight(),
+		Passed:       r.GetPassed(),
+		Message:      r.GetMessage(),
+		Details:      r.GetDetails(),
+		ArtifactURLs: r.GetArtifactUrls(),
+		VerifiedAt:   verifiedAt,
 	}
 }
 
@@ -98,6 +229,10 @@ func FromFreightProto(f *v1alpha1.Freight) *kargoapi.Freight {
 	for stageName := range f.Status.Qualifications {
 		qualifications[stageName] = kargoapi.Qualification{}
 	}
+	approvals := make(map[string]kargoapi.Approval, len(f.Status.Approvals))
+	for stageName, approval := range f.Status.Approvals {
+		approvals[stageName] = *FromApprovalProto(approval)
+	}
 	return &kargoapi.Freight{
 		TypeMeta: kubemetav1.TypeMeta{
 			APIVersion: kargoapi.GroupVersion.String(),
@@ -105,15 +240,62 @@ func FromFreightProto(f *v1alpha1.Freight) *kargoapi.Freight {
 		},
 		ObjectMeta: objectMeta,
 		ID:         f.GetId(),
+		Alias:      f.GetAlias(),
 		Commits:    commits,
 		Images:     images,
 		Charts:     charts,
 		Status: kargoapi.FreightStatus{
 			Qualifications: qualifications,
+			Approvals:      approvals,
+			BuildInfo:      FromBuildInfosProto(f.Status.GetBuildInfo()),
 		},
 	}
 }
 
+func FromBuildInfosProto(b []*v1alpha1.BuildInfo) []kargoapi.BuildInfo {
+	if b == nil {
+		return nil
+	}
+	buildInfo := make([]kargoapi.BuildInfo, len(b))
+	for idx := range b {
+		buildInfo[idx] = *FromBuildInfoProto(b[idx])
+	}
+	return buildInfo
+}
+
+func FromBuildInfoProto(b *v1alpha1.BuildInfo) *kargoapi.BuildInfo {
+	if b == nil {
+		return nil
+	}
+	var reportedAt *kubemetav1.Time
+	if b.GetReportedAt() != nil {
+		t := kubemetav1.NewTime(b.GetReportedAt().AsTime())
+		reportedAt = &t
+	}
+	return &kargoapi.BuildInfo{
+		RepoURL:        b.GetRepoUrl(),
+		Revision:       b.GetRevision(),
+		PipelineURL:    b.GetPipelineUrl(),
+		TestResultsURL: b.GetTestResultsUrl(),
+		ReportedAt:     reportedAt,
+	}
+}
+
+func FromApprovalProto(a *v1alpha1.Approval) *kargoapi.Approval {
+	if a == nil {
+		return nil
+	}
+	var approvedAt *kubemetav1.Time
+	if a.GetApprovedAt() != nil {
+		t := kubemetav1.NewTime(a.GetApprovedAt().AsTime())
+		approvedAt = &t
+	}
+	return &kargoapi.Approval{
+		Approver:   a.GetApprover(),
+		ApprovedAt: approvedAt,
+	}
+}
+
 func FromSimpleFreightProto(s *v1alpha1.SimpleFreight) *kargoapi.SimpleFreight {
 	if s == nil {
 		return nil
@@ -131,10 +313,12 @@ func FromSimpleFreightProto(s *v1alpha1.SimpleFreight) *kargoapi.SimpleFreight {
 		charts[idx] = *FromChartProto(chart)
 	}
 	return &kargoapi.SimpleFreight{
-		ID:      s.GetId(),
-		Commits: commits,
-		Images:  images,
-		Charts:  charts,
+		ID:         s.GetId(),
+		Commits:    commits,
+		Images:     images,
+		Charts:     charts,
+		Superseded: s.GetSuperseded(),
+		Project:    s.GetProject(),
 	}
 }
 
@@ -173,7 +357,8 @@ func FromWarehouseSpecProto(s *v1alpha1.WarehouseSpec) *kargoapi.WarehouseSpec {
 		subscriptions = append(subscriptions, *FromRepoSubscriptionProto(subscription))
 	}
 	return &kargoapi.WarehouseSpec{
-		Subscriptions: subscriptions,
+		Subscriptions:     subscriptions,
+		ReconcileInterval: fromDurationString(s.ReconcileInterval),
 	}
 }
 
@@ -225,10 +410,42 @@ func FromHealthProto(h *v1alpha1.Health) *kargoapi.Health {
 	for i, argocdAppState := range h.GetArgocdApps() {
 		argocdAppStates[i] = FromArgoCDAppStateProto(argocdAppState)
 	}
+	var healthySince *kubemetav1.Time
+	if h.GetHealthySince() != nil {
+		t := kubemetav1.NewTime(h.GetHealthySince().AsTime())
+		healthySince = &t
+	}
+	var providers []kargoapi.HealthProviderStatus
+	if len(h.GetProviders()) > 0 {
+		providers = make([]kargoapi.HealthProviderStatus, len(h.GetProviders()))
+		for i, provider := range h.GetProviders() {
+			providers[i] = FromHealthProviderStatusProto(provider)
+		}
+	}
+	var recovery *kargoapi.HealthRecovery
+	if h.GetRecovery() != nil {
+		recovery = &kargoapi.HealthRecovery{
+			Since:  kubemetav1.NewTime(h.GetRecovery().GetSince().AsTime()),
+			Checks: h.GetRecovery().GetChecks(),
+		}
+	}
 	return &kargoapi.Health{
-		Status:     kargoapi.HealthState(h.GetStatus()),
-		Issues:     h.GetIssues(),
-		ArgoCDApps: argocdAppStates,
+		Status:       kargoapi.HealthState(h.GetStatus()),
+		Issues:       h.GetIssues(),
+		ArgoCDApps:   argocdAppStates,
+		Providers:    providers,
+		HealthySince: healthySince,
+		Recovery:     recovery,
+	}
+}
+
+func FromHealthProviderStatusProto(
+	p *v1alpha1.HealthProviderStatus,
+) kargoapi.HealthProviderStatus {
+	return kargoapi.HealthProviderStatus{
+		Provider: p.GetProvider(),
+		Status:   kargoapi.HealthState(p.GetStatus()),
+		Issues:   p.GetIssues(),
 	}
 }
 
@@ -238,6 +455,7 @@ func FromArgoCDAppStateProto(
 	return kargoapi.ArgoCDAppStatus{
 		Namespace:    a.GetNamespace(),
 		Name:         a.GetName(),
+		Cluster:      a.GetCluster(),
 		HealthStatus: FromArgoCDAppHealthStatusProto(a.GetHealthStatus()),
 		SyncStatus:   FromArgoCDAppSyncStatusProto(a.GetSyncStatus()),
 	}
@@ -351,6 +569,22 @@ func FromGitRepoUpdateProto(u *v1alpha1.GitRepoUpdate) *kargoapi.GitRepoUpdate {
 		Render:      FromKargoRenderPromotionMechanismProto(u.GetRender()),
 		Kustomize:   FromKustomizePromotionMechanismProto(u.GetKustomize()),
 		Helm:        FromHelmPromotionMechanismProto(u.GetHelm()),
+		ConflictResolution: FromGitConflictResolutionProto(
+			u.GetConflictResolution(),
+		),
+	}
+}
+
+func FromGitConflictResolutionProto(
+	r *v1alpha1.GitConflictResolution,
+) *kargoapi.GitConflictResolution {
+	if r == nil {
+		return nil
+	}
+	return &kargoapi.GitConflictResolution{
+		Strategy:    kargoapi.GitConflictStrategy(r.GetStrategy()),
+		Paths:       r.GetPaths(),
+		MaxAttempts: r.GetMaxAttempts(),
 	}
 }
 
@@ -441,10 +675,34 @@ func FromArgoCDAppUpdatesProto(u *v1alpha1.ArgoCDAppUpdate) *kargoapi.ArgoCDAppU
 	for idx, update := range u.GetSourceUpdates() {
 		sourceUpdates[idx] = *FromArgoCDSourceUpdateProto(update)
 	}
+	var healthOverrides []kargoapi.ArgoCDResourceHealthOverride
+	for _, override := range u.GetHealthOverrides() {
+		healthOverrides = append(healthOverrides, *FromArgoCDResourceHealthOverrideProto(override))
+	}
 	return &kargoapi.ArgoCDAppUpdate{
-		AppName:       u.GetAppName(),
-		AppNamespace:  u.GetAppNamespace(),
-		SourceUpdates: sourceUpdates,
+		AppName:         u.GetAppName(),
+		AppNamespace:    u.GetAppNamespace(),
+		SourceUpdates:   sourceUpdates,
+		HealthOverrides: healthOverrides,
+	}
+}
+
+func FromArgoCDResourceHealthOverrideProto(
+	o *v1alpha1.ArgoCDResourceHealthOverride,
+) *kargoapi.ArgoCDResourceHealthOverride {
+	if o == nil {
+		return nil
+	}
+	var when []kargoapi.ArgoCDAppHealthState
+	for _, state := range o.GetWhen() {
+		when = append(when, kargoapi.ArgoCDAppHealthState(state))
+	}
+	return &kargoapi.ArgoCDResourceHealthOverride{
+		Group:  o.GetGroup(),
+		Kind:   o.GetKind(),
+		Name:   o.GetName(),
+		When:   when,
+		Health: kargoapi.ArgoCDAppHealthState(o.GetHealth()),
 	}
 }
 
@@ -531,8 +789,9 @@ func FromPromotionSpecProto(s *v1alpha1.PromotionSpec) *kargoapi.PromotionSpec {
 		return nil
 	}
 	return &kargoapi.PromotionSpec{
-		Stage:   s.GetStage(),
-		Freight: s.GetFreight(),
+		Stage:          s.GetStage(),
+		Freight:        s.GetFreight(),
+		FreightProject: s.GetFreightProject(),
 	}
 }
 
@@ -541,8 +800,73 @@ func FromPromotionStatusProto(s *v1alpha1.PromotionStatus) *kargoapi.PromotionSt
 		return nil
 	}
 	return &kargoapi.PromotionStatus{
-		Phase: kargoapi.PromotionPhase(s.GetPhase()),
-		Error: s.GetError(),
+		Phase:         kargoapi.PromotionPhase(s.GetPhase()),
+		Error:         s.GetError(),
+		FailureReason: kargoapi.PromotionFailureReason(s.GetFailureReason()),
+		PushAttempts:  FromGitPushAttemptsProto(s.GetPushAttempts()),
+		Attestation:   FromPromotionAttestationProto(s.GetAttestation()),
+		Impact:        FromPromotionImpactProto(s.GetImpact()),
+		LogURL:        s.GetLogUrl(),
+	}
+}
+
+func FromPromotionImpactProto(i *v1alpha1.PromotionImpact) *kargoapi.PromotionImpact {
+	if i == nil {
+		return nil
+	}
+	var apps []kargoapi.ArgoCDAppReference
+	for _, app := range i.GetArgocdApps() {
+		apps = append(apps, kargoapi.ArgoCDAppReference{
+			Namespace: app.GetNamespace(),
+			Name:      app.GetName(),
+			Cluster:   app.GetCluster(),
+		})
+	}
+	return &kargoapi.PromotionImpact{
+		Repos:      i.GetRepos(),
+		ArgoCDApps: apps,
+		Clusters:   i.GetClusters(),
+	}
+}
+
+func FromGitPushAttemptsProto(
+	attempts []*v1alpha1.GitPushAttempt,
+) []kargoapi.GitPushAttempt {
+	if attempts == nil {
+		return nil
+	}
+	res := make([]kargoapi.GitPushAttempt, len(attempts))
+	for idx, a := range attempts {
+		res[idx] = kargoapi.GitPushAttempt{
+			RepoURL:       a.GetRepoUrl(),
+			Branch:        a.GetBranch(),
+			Attempt:       a.GetAttempt(),
+			Result:        kargoapi.GitPushResult(a.GetResult()),
+			ResolvedPaths: a.GetResolvedPaths(),
+			Message:       a.GetMessage(),
+		}
+	}
+	return res
+}
+
+func FromPromotionAttestationProto(
+	a *v1alpha1.PromotionAttestation,
+) *kargoapi.PromotionAttestation {
+	if a == nil {
+		return nil
+	}
+	signatures := make([]kargoapi.AttestationSignature, len(a.GetSignatures()))
+	for idx, sig := range a.GetSignatures() {
+		signatures[idx] = kargoapi.AttestationSignature{
+			KeyID: sig.GetKeyid(),
+			Sig:   sig.GetSig(),
+		}
+	}
+	return &kargoapi.PromotionAttestation{
+		PayloadType: a.GetPayloadType(),
+		Payload:     a.GetPayload(),
+		Signatures:  signatures,
+		Reference:   a.GetReference(),
 	}
 }
 
@@ -559,10 +883,125 @@ func FromPromotionPolicyProto(p *v1alpha1.PromotionPolicy) *kargoapi.PromotionPo
 			APIVersion: kargoapi.GroupVersion.String(),
 			Kind:       "PromotionPolicy",
 		},
-		ObjectMeta:          objectMeta,
-		Stage:               p.GetStage(),
-		EnableAutoPromotion: p.GetEnableAutoPromotion(),
+		ObjectMeta:             objectMeta,
+		Stage:                  p.GetStage(),
+		EnableAutoPromotion:    p.GetEnableAutoPromotion(),
+		EnableSelfHeal:         p.GetEnableSelfHeal(),
+		AutoPromotionHoldback:  fromDurationString(p.AutoPromotionHoldback),
+		CoalesceAutoPromotions: p.GetCoalesceAutoPromotions(),
+		MaxFreightAge:          fromDurationString(p.MaxFreightAge),
+	}
+}
+
+func FromReleaseProto(r *v1alpha1.Release) *kargoapi.Release {
+	if r == nil {
+		return nil
+	}
+	var status kargoapi.ReleaseStatus
+	if r.GetStatus() != nil {
+		status = *FromReleaseStatusProto(r.GetStatus())
+	}
+	var objectMeta kubemetav1.ObjectMeta
+	if r.GetMetadata() != nil {
+		objectMeta = *typesmetav1.FromObjectMetaProto(r.GetMetadata())
+	}
+	return &kargoapi.Release{
+		TypeMeta: kubemetav1.TypeMeta{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Release",
+		},
+		ObjectMeta: objectMeta,
+		Spec:       FromReleaseSpecProto(r.GetSpec()),
+		Status:     status,
+	}
+}
+
+func FromReleaseSpecProto(s *v1alpha1.ReleaseSpec) *kargoapi.ReleaseSpec {
+	if s == nil {
+		return nil
+	}
+	var startTime *kubemetav1.Time
+	if s.GetStartTime() != nil {
+		t := kubemetav1.NewTime(s.GetStartTime().AsTime())
+		startTime = &t
+	}
+	steps := make([]kargoapi.ReleaseStep, len(s.GetSteps()))
+	for idx, step := range s.GetSteps() {
+		steps[idx] = *FromReleaseStepProto(step)
+	}
+	return &kargoapi.ReleaseSpec{
+		Freight:   s.GetFreight(),
+		StartTime: startTime,
+		Steps:     steps,
+	}
+}
+
+func FromReleaseStepProto(s *v1alpha1.ReleaseStep) *kargoapi.ReleaseStep {
+	if s == nil {
+		return nil
 	}
+	return &kargoapi.ReleaseStep{
+		Stage: s.GetStage(),
+		Delay: fromDurationString(s.Delay),
+		Soak:  fromDurationString(s.Soak),
+	}
+}
+
+func FromReleaseStatusProto(s *v1alpha1.ReleaseStatus) *kargoapi.ReleaseStatus {
+	if s == nil {
+		return nil
+	}
+	var startedAt *kubemetav1.Time
+	if s.GetStartedAt() != nil {
+		t := kubemetav1.NewTime(s.GetStartedAt().AsTime())
+		startedAt = &t
+	}
+	steps := make([]kargoapi.ReleaseStepStatus, len(s.GetSteps()))
+	for idx, step := range s.GetSteps() {
+		steps[idx] = *FromReleaseStepStatusProto(step)
+	}
+	return &kargoapi.ReleaseStatus{
+		Phase:     kargoapi.ReleasePhase(s.GetPhase()),
+		Error:     s.GetError(),
+		StartedAt: startedAt,
+		Steps:     steps,
+	}
+}
+
+func FromReleaseStepStatusProto(
+	s *v1alpha1.ReleaseStepStatus,
+) *kargoapi.ReleaseStepStatus {
+	if s == nil {
+		return nil
+	}
+	var completedAt *kubemetav1.Time
+	if s.GetCompletedAt() != nil {
+		t := kubemetav1.NewTime(s.GetCompletedAt().AsTime())
+		completedAt = &t
+	}
+	var verifiedAt *kubemetav1.Time
+	if s.GetVerifiedAt() != nil {
+		t := kubemetav1.NewTime(s.GetVerifiedAt().AsTime())
+		verifiedAt = &t
+	}
+	return &kargoapi.ReleaseStepStatus{
+		Stage:       s.GetStage(),
+		Promotion:   s.GetPromotion(),
+		Phase:       kargoapi.PromotionPhase(s.GetPhase()),
+		CompletedAt: completedAt,
+		VerifiedAt:  verifiedAt,
+	}
+}
+
+func fromDurationString(s *string) *kubemetav1.Duration {
+	if s == nil {
+		return nil
+	}
+	d, err := time.ParseDuration(*s)
+	if err != nil {
+		return nil
+	}
+	return &kubemetav1.Duration{Duration: d}
 }
 
 func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
@@ -579,6 +1018,21 @@ func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 	if e.Status.Health != nil {
 		health = ToHealthProto(*e.Status.Health)
 	}
+	conditions := make([]*metav1.Condition, len(e.Status.Conditions))
+	for idx := range e.Status.Conditions {
+		conditions[idx] = typesmetav1.ToConditionProto(e.Status.Conditions[idx])
+	}
+	verifications := make([]*v1alpha1.VerificationResult, len(e.Status.Verifications))
+	for idx := range e.Status.Verifications {
+		verifications[idx] = ToVerificationResultProto(e.Status.Verifications[idx])
+	}
+	var autoPromotionHold *v1alpha1.AutoPromotionHold
+	if e.Status.AutoPromotionHold != nil {
+		autoPromotionHold = &v1alpha1.AutoPromotionHold{
+			Since: timestamppb.New(e.Status.AutoPromotionHold.Since.Time),
+			Until: timestamppb.New(e.Status.AutoPromotionHold.Until.Time),
+		}
+	}
 
 	metadata := e.ObjectMeta.DeepCopy()
 	metadata.SetManagedFields(nil)
@@ -587,6 +1041,17 @@ func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 	if e.Spec.PromotionMechanisms != nil {
 		promotionMechanisms = ToPromotionMechanismsProto(*e.Spec.PromotionMechanisms)
 	}
+	var qualification *v1alpha1.QualificationPolicy
+	if e.Spec.Qualification != nil {
+		qualification = ToQualificationPolicyProto(*e.Spec.Qualification)
+	}
+	var healthDamping *v1alpha1.HealthDampingPolicy
+	if e.Spec.HealthDamping != nil {
+		healthDamping = &v1alpha1.HealthDampingPolicy{
+			ConsecutiveChecks: e.Spec.HealthDamping.ConsecutiveChecks,
+			For:               toDurationString(e.Spec.HealthDamping.For),
+		}
+	}
 	var currentPromotion *v1alpha1.PromotionInfo
 	if e.Status.CurrentPromotion != nil {
 		sf := kargoapi.SimpleFreight{
@@ -594,6 +1059,7 @@ func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 			Commits: e.Status.CurrentPromotion.Freight.Commits,
 			Images:  e.Status.CurrentPromotion.Freight.Images,
 			Charts:  e.Status.CurrentPromotion.Freight.Charts,
+			Project: e.Status.CurrentPromotion.Freight.Project,
 		}
 		currentPromotion = &v1alpha1.PromotionInfo{
 			Name:    e.Status.CurrentPromotion.Name,
@@ -607,17 +1073,96 @@ func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 		Spec: &v1alpha1.StageSpec{
 			Subscriptions:       ToSubscriptionsProto(*e.Spec.Subscriptions),
 			PromotionMechanisms: promotionMechanisms,
+			Qualification:       qualification,
+			HealthChecks:        ToHealthChecksProto(e.Spec.HealthChecks),
+			HealthDamping:       healthDamping,
+			Owners:              ToStageOwnersProto(e.Spec.Owners),
+			ReconcileInterval:   toDurationString(e.Spec.ReconcileInterval),
 		},
 		Status: &v1alpha1.StageStatus{
-			CurrentFreight:   currentFreight,
-			CurrentPromotion: currentPromotion,
-			History:          history,
-			Health:           health,
-			Error:            e.Status.Error,
+			CurrentFreight:    currentFreight,
+			CurrentPromotion:  currentPromotion,
+			History:           history,
+			Health:            health,
+			Error:             e.Status.Error,
+			Conditions:        conditions,
+			Verifications:     verifications,
+			AutoPromotionHold: autoPromotionHold,
 		},
 	}
 }
 
+func ToHealthChecksProto(checks []kargoapi.HealthCheck) []*v1alpha1.HealthCheck {
+	res := make([]*v1alpha1.HealthCheck, len(checks))
+	for i, check := range checks {
+		res[i] = &v1alpha1.HealthCheck{
+			Provider:   check.Provider,
+			Parameters: check.Parameters,
+		}
+	}
+	return res
+}
+
+func ToStageOwnersProto(owners []kargoapi.StageOwner) []*v1alpha1.StageOwner {
+	res := make([]*v1alpha1.StageOwner, len(owners))
+	for i, owner := range owners {
+		res[i] = &v1alpha1.StageOwner{
+			Kind:  string(owner.Kind),
+			Name:  owner.Name,
+			Email: owner.Email,
+		}
+	}
+	return res
+}
+
+func ToVerificationResultProto(
+	r kargoapi.VerificationResult,
+) *v1alpha1.VerificationResult {
+	var verifiedAt *timestamppb.Timestamp
+	if r.VerifiedAt != nil {
+		verifiedAt = timestamppb.New(r.VerifiedAt.Time)
+	}
+	return &v1alpha1.VerificationResult{
+		Provider:     r.Provider,
+		Freight:      r.Freight,
+		Passed:       r.Passed,
+		Message:      r.Message,
+		Details:      r.Details,
+		ArtifactUrls: r.ArtifactURLs,
+		VerifiedAt:   verifiedAt,
+	}
+}
+
+func ToQualificationPolicyProto(
+	q kargoapi.QualificationPolicy,
+) *v1alpha1.QualificationPolicy {
+	criteria := make([]*v1alpha1.QualificationCriterion, len(q.Criteria))
+	for i, criterion := range q.Criteria {
+		var healthy *v1alpha1.HealthyCriterion
+		if criterion.Healthy != nil {
+			healthy = &v1alpha1.HealthyCriterion{
+				For: toDurationString(criterion.Healthy.For),
+			}
+		}
+		var verification *v1alpha1.VerificationCriterion
+		if criterion.Verification != nil {
+			verification = &v1alpha1.VerificationCriterion{
+				Provider:   criterion.Verification.Provider,
+				Parameters: criterion.Verification.Parameters,
+			}
+		}
+		criteria[i] = &v1alpha1.QualificationCriterion{
+			Healthy:        healthy,
+			ManualApproval: criterion.ManualApproval,
+			Verification:   verification,
+		}
+	}
+	return &v1alpha1.QualificationPolicy{
+		Operator: string(q.Operator),
+		Criteria: criteria,
+	}
+}
+
 func ToRepoSubscriptionProto(s kargoapi.RepoSubscription) *v1alpha1.RepoSubscription {
 	var git *v1alpha1.GitSubscription
 	if s.Git != nil {
@@ -709,13 +1254,28 @@ func ToGitRepoUpdateProto(g kargoapi.GitRepoUpdate) *v1alpha1.GitRepoUpdate {
 	if g.Helm != nil {
 		helm = ToHelmPromotionMechanismProto(*g.Helm)
 	}
+	var conflictResolution *v1alpha1.GitConflictResolution
+	if g.ConflictResolution != nil {
+		conflictResolution = ToGitConflictResolutionProto(*g.ConflictResolution)
+	}
 	return &v1alpha1.GitRepoUpdate{
-		RepoUrl:     g.RepoURL,
-		ReadBranch:  proto.String(g.ReadBranch),
-		WriteBranch: g.WriteBranch,
-		Render:      render,
-		Kustomize:   kustomize,
-		Helm:        helm,
+		RepoUrl:            g.RepoURL,
+		ReadBranch:         proto.String(g.ReadBranch),
+		WriteBranch:        g.WriteBranch,
+		Render:             render,
+		Kustomize:          kustomize,
+		Helm:               helm,
+		ConflictResolution: conflictResolution,
+	}
+}
+
+func ToGitConflictResolutionProto(
+	r kargoapi.GitConflictResolution,
+) *v1alpha1.GitConflictResolution {
+	return &v1alpha1.GitConflictResolution{
+		Strategy:    proto.String(string(r.Strategy)),
+		Paths:       r.Paths,
+		MaxAttempts: proto.Int32(r.MaxAttempts),
 	}
 }
 
@@ -781,10 +1341,31 @@ func ToArgoCDAppUpdateProto(h kargoapi.ArgoCDAppUpdate) *v1alpha1.ArgoCDAppUpdat
 	for idx := range h.SourceUpdates {
 		sourceUpdates[idx] = ToArgoCDSourceUpdateProto(h.SourceUpdates[idx])
 	}
+	healthOverrides := make([]*v1alpha1.ArgoCDResourceHealthOverride, len(h.HealthOverrides))
+	for idx := range h.HealthOverrides {
+		healthOverrides[idx] = ToArgoCDResourceHealthOverrideProto(h.HealthOverrides[idx])
+	}
 	return &v1alpha1.ArgoCDAppUpdate{
-		AppName:       h.AppName,
-		AppNamespace:  proto.String(h.AppNamespace),
-		SourceUpdates: sourceUpdates,
+		AppName:         h.AppName,
+		AppNamespace:    proto.String(h.AppNamespace),
+		SourceUpdates:   sourceUpdates,
+		HealthOverrides: healthOverrides,
+	}
+}
+
+func ToArgoCDResourceHealthOverrideProto(
+	o kargoapi.ArgoCDResourceHealthOverride,
+) *v1alpha1.ArgoCDResourceHealthOverride {
+	when := make([]string, len(o.When))
+	for idx, state := range o.When {
+		when[idx] = string(state)
+	}
+	return &v1alpha1.ArgoCDResourceHealthOverride{
+		Group:  proto.String(o.Group),
+		Kind:   o.Kind,
+		Name:   proto.String(o.Name),
+		When:   when,
+		Health: string(o.Health),
 	}
 }
 
@@ -850,20 +1431,63 @@ func ToFreightProto(f kargoapi.Freight) *v1alpha1.Freight {
 	for stageName := range f.Status.Qualifications {
 		qualifications[stageName] = &v1alpha1.Qualification{}
 	}
+	approvals := make(map[string]*v1alpha1.Approval, len(f.Status.Approvals))
+	for stageName, approval := range f.Status.Approvals {
+		approvals[stageName] = ToApprovalProto(approval)
+	}
 	return &v1alpha1.Freight{
 		ApiVersion: f.APIVersion,
 		Kind:       f.Kind,
 		Id:         f.ID,
+		Alias:      f.Alias,
 		Images:     images,
 		Charts:     charts,
 		Commits:    commits,
 		Metadata:   typesmetav1.ToObjectMetaProto(*metadata),
 		Status: &v1alpha1.FreightStatus{
 			Qualifications: qualifications,
+			Approvals:      approvals,
+			BuildInfo:      ToBuildInfosProto(f.Status.BuildInfo),
 		},
 	}
 }
 
+func ToBuildInfosProto(b []kargoapi.BuildInfo) []*v1alpha1.BuildInfo {
+	if b == nil {
+		return nil
+	}
+	buildInfo := make([]*v1alpha1.BuildInfo, len(b))
+	for idx := range b {
+		buildInfo[idx] = ToBuildInfoProto(b[idx])
+	}
+	return buildInfo
+}
+
+func ToBuildInfoProto(b kargoapi.BuildInfo) *v1alpha1.BuildInfo {
+	var reportedAt *timestamppb.Timestamp
+	if b.ReportedAt != nil {
+		reportedAt = timestamppb.New(b.ReportedAt.Time)
+	}
+	return &v1alpha1.BuildInfo{
+		RepoUrl:        b.RepoURL,
+		Revision:       b.Revision,
+		PipelineUrl:    proto.String(b.PipelineURL),
+		TestResultsUrl: proto.String(b.TestResultsURL),
+		ReportedAt:     reportedAt,
+	}
+}
+
+func ToApprovalProto(a kargoapi.Approval) *v1alpha1.Approval {
+	var approvedAt *timestamppb.Timestamp
+	if a.ApprovedAt != nil {
+		approvedAt = timestamppb.New(a.ApprovedAt.Time)
+	}
+	return &v1alpha1.Approval{
+		Approver:   a.Approver,
+		ApprovedAt: approvedAt,
+	}
+}
+
 func ToSimpleFreightProto(s kargoapi.SimpleFreight, firstSeen *time.Time) *v1alpha1.SimpleFreight {
 	var firstSeenProto *timestamppb.Timestamp
 	if firstSeen != nil {
@@ -882,11 +1506,13 @@ func ToSimpleFreightProto(s kargoapi.SimpleFreight, firstSeen *time.Time) *v1alp
 		charts[idx] = ToChartProto(s.Charts[idx])
 	}
 	return &v1alpha1.SimpleFreight{
-		Id:        s.ID,
-		FirstSeen: firstSeenProto,
-		Commits:   commits,
-		Images:    images,
-		Charts:    charts,
+		Id:         s.ID,
+		FirstSeen:  firstSeenProto,
+		Commits:    commits,
+		Images:     images,
+		Charts:     charts,
+		Superseded: s.Superseded,
+		Project:    s.Project,
 	}
 }
 
@@ -900,6 +1526,8 @@ func ToWarehouseProto(w kargoapi.Warehouse) *v1alpha1.Warehouse {
 		status = &v1alpha1.WarehouseStatus{
 			Error:              w.GetStatus().Error,
 			ObservedGeneration: w.GetStatus().ObservedGeneration,
+			BuildInfo:          ToBuildInfosProto(w.GetStatus().BuildInfo),
+			Rejections:         ToSubscriptionRejectionsProto(w.GetStatus().Rejections),
 		}
 	}
 	return &v1alpha1.Warehouse{
@@ -907,12 +1535,38 @@ func ToWarehouseProto(w kargoapi.Warehouse) *v1alpha1.Warehouse {
 		Kind:       w.Kind,
 		Metadata:   typesmetav1.ToObjectMetaProto(w.ObjectMeta),
 		Spec: &v1alpha1.WarehouseSpec{
-			Subscriptions: subscriptions,
+			Subscriptions:     subscriptions,
+			ReconcileInterval: toDurationString(w.Spec.ReconcileInterval),
 		},
 		Status: status,
 	}
 }
 
+func ToSubscriptionRejectionsProto(
+	r []kargoapi.SubscriptionRejections,
+) []*v1alpha1.SubscriptionRejections {
+	if r == nil {
+		return nil
+	}
+	rejections := make([]*v1alpha1.SubscriptionRejections, len(r))
+	for idx, subRejections := range r {
+		versions := make([]*v1alpha1.RejectedVersion, len(subRejections.Versions))
+		for vIdx, version := range subRejections.Versions {
+			versions[vIdx] = &v1alpha1.RejectedVersion{
+				Version: version.Version,
+				Reason:  version.Reason,
+			}
+		}
+		rejections[idx] = &v1alpha1.SubscriptionRejections{
+			RepoUrl:  subRejections.RepoURL,
+			Chart:    proto.String(subRejections.Chart),
+			Versions: versions,
+			Omitted:  proto.Int32(subRejections.Omitted),
+		}
+	}
+	return rejections
+}
+
 func ToGitCommitProto(g kargoapi.GitCommit) *v1alpha1.GitCommit {
 	return &v1alpha1.GitCommit{
 		RepoUrl:           g.RepoURL,
@@ -944,10 +1598,38 @@ func ToHealthProto(h kargoapi.Health) *v1alpha1.Health {
 	for i, argocdAppState := range h.ArgoCDApps {
 		argocdAppStates[i] = ToArgoCDAppStateProto(argocdAppState)
 	}
+	var healthySince *timestamppb.Timestamp
+	if h.HealthySince != nil {
+		healthySince = timestamppb.New(h.HealthySince.Time)
+	}
+	providers := make([]*v1alpha1.HealthProviderStatus, len(h.Providers))
+	for i, provider := range h.Providers {
+		providers[i] = ToHealthProviderStatusProto(provider)
+	}
+	var recovery *v1alpha1.HealthRecovery
+	if h.Recovery != nil {
+		recovery = &v1alpha1.HealthRecovery{
+			Since:  timestamppb.New(h.Recovery.Since.Time),
+			Checks: h.Recovery.Checks,
+		}
+	}
 	return &v1alpha1.Health{
-		Status:     string(h.Status),
-		Issues:     h.Issues,
-		ArgocdApps: argocdAppStates,
+		Status:       string(h.Status),
+		Issues:       h.Issues,
+		ArgocdApps:   argocdAppStates,
+		Providers:    providers,
+		HealthySince: healthySince,
+		Recovery:     recovery,
+	}
+}
+
+func ToHealthProviderStatusProto(
+	p kargoapi.HealthProviderStatus,
+) *v1alpha1.HealthProviderStatus {
+	return &v1alpha1.HealthProviderStatus{
+		Provider: p.Provider,
+		Status:   string(p.Status),
+		Issues:   p.Issues,
 	}
 }
 
@@ -957,6 +1639,7 @@ func ToArgoCDAppStateProto(
 	return &v1alpha1.ArgoCDAppState{
 		Name:         a.Name,
 		Namespace:    a.Namespace,
+		Cluster:      proto.String(a.Cluster),
 		HealthStatus: ToArgoCDAppHealthStatusProto(a.HealthStatus),
 		SyncStatus:   ToArgoCDAppSyncStatusProto(a.SyncStatus),
 	}
@@ -990,27 +1673,170 @@ func ToPromotionProto(p kargoapi.Promotion) *v1alpha1.Promotion {
 		Kind:       p.Kind,
 		Metadata:   typesmetav1.ToObjectMetaProto(*metadata),
 		Spec: &v1alpha1.PromotionSpec{
-			Stage:   p.Spec.Stage,
-			Freight: p.Spec.Freight,
+			Stage:          p.Spec.Stage,
+			Freight:        p.Spec.Freight,
+			FreightProject: p.Spec.FreightProject,
 		},
 		Status: &v1alpha1.PromotionStatus{
-			Phase: string(p.Status.Phase),
-			Error: p.Status.Error,
+			Phase:         string(p.Status.Phase),
+			Error:         p.Status.Error,
+			FailureReason: string(p.Status.FailureReason),
+			PushAttempts:  ToGitPushAttemptsProto(p.Status.PushAttempts),
+			Attestation:   ToPromotionAttestationProto(p.Status.Attestation),
+			Impact:        ToPromotionImpactProto(p.Status.Impact),
+			LogUrl:        proto.String(p.Status.LogURL),
 		},
 	}
 }
 
+func ToPromotionImpactProto(i *kargoapi.PromotionImpact) *v1alpha1.PromotionImpact {
+	if i == nil {
+		return nil
+	}
+	apps := make([]*v1alpha1.ArgoCDAppReference, len(i.ArgoCDApps))
+	for idx, app := range i.ArgoCDApps {
+		apps[idx] = &v1alpha1.ArgoCDAppReference{
+			Namespace: app.Namespace,
+			Name:      app.Name,
+			Cluster:   proto.String(app.Cluster),
+		}
+	}
+	return &v1alpha1.PromotionImpact{
+		Repos:      i.Repos,
+		ArgocdApps: apps,
+		Clusters:   i.Clusters,
+	}
+}
+
+func ToGitPushAttemptsProto(
+	attempts []kargoapi.GitPushAttempt,
+) []*v1alpha1.GitPushAttempt {
+	if attempts == nil {
+		return nil
+	}
+	res := make([]*v1alpha1.GitPushAttempt, len(attempts))
+	for idx, a := range attempts {
+		res[idx] = &v1alpha1.GitPushAttempt{
+			RepoUrl:       a.RepoURL,
+			Branch:        a.Branch,
+			Attempt:       a.Attempt,
+			Result:        string(a.Result),
+			ResolvedPaths: a.ResolvedPaths,
+			Message:       proto.String(a.Message),
+		}
+	}
+	return res
+}
+
+func ToPromotionAttestationProto(
+	a *kargoapi.PromotionAttestation,
+) *v1alpha1.PromotionAttestation {
+	if a == nil {
+		return nil
+	}
+	signatures := make([]*v1alpha1.AttestationSignature, len(a.Signatures))
+	for idx, sig := range a.Signatures {
+		signatures[idx] = &v1alpha1.AttestationSignature{
+			Keyid: sig.KeyID,
+			Sig:   sig.Sig,
+		}
+	}
+	return &v1alpha1.PromotionAttestation{
+		PayloadType: a.PayloadType,
+		Payload:     a.Payload,
+		Signatures:  signatures,
+		Reference:   a.Reference,
+	}
+}
+
 func ToPromotionPolicyProto(p kargoapi.PromotionPolicy) *v1alpha1.PromotionPolicy {
 	metadata := p.ObjectMeta.DeepCopy()
 	metadata.SetManagedFields(nil)
 
 	return &v1alpha1.PromotionPolicy{
-		ApiVersion:          p.APIVersion,
-		Kind:                p.Kind,
-		Metadata:            typesmetav1.ToObjectMetaProto(*metadata),
-		Stage:               p.Stage,
-		EnableAutoPromotion: p.EnableAutoPromotion,
+		ApiVersion:             p.APIVersion,
+		Kind:                   p.Kind,
+		Metadata:               typesmetav1.ToObjectMetaProto(*metadata),
+		Stage:                  p.Stage,
+		EnableAutoPromotion:    p.EnableAutoPromotion,
+		EnableSelfHeal:         p.EnableSelfHeal,
+		AutoPromotionHoldback:  toDurationString(p.AutoPromotionHoldback),
+		CoalesceAutoPromotions: p.CoalesceAutoPromotions,
+		MaxFreightAge:          toDurationString(p.MaxFreightAge),
+	}
+}
+
+func ToReleaseProto(r kargoapi.Release) *v1alpha1.Release {
+	metadata := r.ObjectMeta.DeepCopy()
+	metadata.SetManagedFields(nil)
+
+	var spec *v1alpha1.ReleaseSpec
+	if r.Spec != nil {
+		var startTime *timestamppb.Timestamp
+		if r.Spec.StartTime != nil {
+			startTime = timestamppb.New(r.Spec.StartTime.Time)
+		}
+		steps := make([]*v1alpha1.ReleaseStep, len(r.Spec.Steps))
+		for idx, step := range r.Spec.Steps {
+			steps[idx] = &v1alpha1.ReleaseStep{
+				Stage: step.Stage,
+				Delay: toDurationString(step.Delay),
+				Soak:  toDurationString(step.Soak),
+			}
+		}
+		spec = &v1alpha1.ReleaseSpec{
+			Freight:   r.Spec.Freight,
+			StartTime: startTime,
+			Steps:     steps,
+		}
+	}
+	var startedAt *timestamppb.Timestamp
+	if r.Status.StartedAt != nil {
+		startedAt = timestamppb.New(r.Status.StartedAt.Time)
+	}
+	stepStatuses := make([]*v1alpha1.ReleaseStepStatus, len(r.Status.Steps))
+	for idx, step := range r.Status.Steps {
+		stepStatuses[idx] = ToReleaseStepStatusProto(step)
+	}
+	return &v1alpha1.Release{
+		ApiVersion: r.APIVersion,
+		Kind:       r.Kind,
+		Metadata:   typesmetav1.ToObjectMetaProto(*metadata),
+		Spec:       spec,
+		Status: &v1alpha1.ReleaseStatus{
+			Phase:     string(r.Status.Phase),
+			Error:     r.Status.Error,
+			StartedAt: startedAt,
+			Steps:     stepStatuses,
+		},
+	}
+}
+
+func ToReleaseStepStatusProto(
+	s kargoapi.ReleaseStepStatus,
+) *v1alpha1.ReleaseStepStatus {
+	var completedAt *timestamppb.Timestamp
+	if s.CompletedAt != nil {
+		completedAt = timestamppb.New(s.CompletedAt.Time)
+	}
+	var verifiedAt *timestamppb.Timestamp
+	if s.VerifiedAt != nil {
+		verifiedAt = timestamppb.New(s.VerifiedAt.Time)
+	}
+	return &v1alpha1.ReleaseStepStatus{
+		Stage:       s.Stage,
+		Promotion:   s.Promotion,
+		Phase:       string(s.Phase),
+		CompletedAt: completedAt,
+		VerifiedAt:  verifiedAt,
+	}
+}
+
+func toDurationString(d *kubemetav1.Duration) *string {
+	if d == nil {
+		return nil
 	}
+	return proto.String(d.Duration.String())
 }
 
 func ToVersionProto(v version.Version) *svcv1alpha1.VersionInfo {
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// UnarchiveProject unarchives a previously archived Project, after which its
+// resources are once again reconciled and may be modified.
+func (s *server) UnarchiveProject(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.UnarchiveProjectRequest],
+) (*connect.Response[svcv1alpha1.UnarchiveProjectResponse], error) {
+	project, err := s.setProjectArchived(ctx, req.Msg.GetName(), false)
+	if err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	return connect.NewResponse(&svcv1alpha1.UnarchiveProjectResponse{
+		Project: project,
+	}), nil
+}
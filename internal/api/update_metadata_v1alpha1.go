@@ -0,0 +1,192 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// reservedMetadataKeyPrefix is the prefix of label and annotation keys that
+// drive Kargo's own behavior. Keys with this prefix may not be modified using
+// the UpdateMetadata API.
+const reservedMetadataKeyPrefix = "kargo.akuity.io/"
+
+// UpdateMetadata adds, updates, and removes labels and annotations of a single
+// Kargo resource, such as a Stage, Freight, or Promotion. The change is
+// applied using a patch, so the user must be permitted to patch the resource.
+func (s *server) UpdateMetadata(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.UpdateMetadataRequest],
+) (*connect.Response[svcv1alpha1.UpdateMetadataResponse], error) {
+	if req.Msg.GetProject() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("project should not be empty"),
+		)
+	}
+	if req.Msg.GetKind() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("kind should not be empty"),
+		)
+	}
+	if req.Msg.GetName() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("name should not be empty"),
+		)
+	}
+	if len(req.Msg.GetLabels()) == 0 && len(req.Msg.GetRemoveLabels()) == 0 &&
+		len(req.Msg.GetAnnotations()) == 0 &&
+		len(req.Msg.GetRemoveAnnotations()) == 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("at least one label or annotation must be updated"),
+		)
+	}
+	gvk := kargoapi.GroupVersion.WithKind(req.Msg.GetKind())
+	if strings.HasSuffix(gvk.Kind, "List") ||
+		!s.client.Scheme().Recognizes(gvk) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("unsupported kind %q", req.Msg.GetKind()),
+		)
+	}
+	labels, err := newMetadataPatch(
+		"label",
+		req.Msg.GetLabels(),
+		req.Msg.GetRemoveLabels(),
+		validation.IsValidLabelValue,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	annotations, err := newMetadataPatch(
+		"annotation",
+		req.Msg.GetAnnotations(),
+		req.Msg.GetRemoveAnnotations(),
+		nil,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err = s.validateProject(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]any{}
+	if len(labels) > 0 {
+		metadata["labels"] = labels
+	}
+	if len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+	patch, err := json.Marshal(map[string]any{"metadata": metadata})
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Wrap(err, "marshal patch"),
+		)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetNamespace(req.Msg.GetProject())
+	obj.SetName(req.Msg.GetName())
+	if err = s.client.Patch(
+		ctx,
+		obj,
+		client.RawPatch(types.MergePatchType, patch),
+	); err != nil {
+		if kubeerr.IsNotFound(err) {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf(
+					"%s %q not found",
+					gvk.Kind,
+					client.ObjectKeyFromObject(obj).String(),
+				),
+			)
+		}
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Wrapf(err, "patch %s metadata", gvk.Kind),
+		)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.UpdateMetadataResponse{
+		Labels:      obj.GetLabels(),
+		Annotations: obj.GetAnnotations(),
+	}), nil
+}
+
+// newMetadataPatch validates the provided labels or annotations (as indicated
+// by what) to add or update and the keys of those to remove, and returns them
+// in the form of a JSON merge patch for the corresponding metadata field. If
+// non-nil, validateValue is used to validate values.
+func newMetadataPatch(
+	what string,
+	set map[string]string,
+	remove []string,
+	validateValue func(string) []string,
+) (map[string]any, error) {
+	patch := make(map[string]any, len(set)+len(remove))
+	validateKey := func(key string) error {
+		if strings.HasPrefix(key, reservedMetadataKeyPrefix) {
+			return errors.Errorf("%s %q is reserved for use by Kargo", what, key)
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return errors.Errorf(
+				"invalid %s key %q: %s",
+				what,
+				key,
+				strings.Join(errs, "; "),
+			)
+		}
+		return nil
+	}
+	for key, value := range set {
+		if err := validateKey(key); err != nil {
+			return nil, err
+		}
+		if validateValue != nil {
+			if errs := validateValue(value); len(errs) > 0 {
+				return nil, errors.Errorf(
+					"invalid value %q for %s %q: %s",
+					value,
+					what,
+					key,
+					strings.Join(errs, "; "),
+				)
+			}
+		}
+		patch[key] = value
+	}
+	for _, key := range remove {
+		if err := validateKey(key); err != nil {
+			return nil, err
+		}
+		if _, ok := set[key]; ok {
+			return nil, errors.Errorf(
+				"%s %q cannot be both set and removed",
+				what,
+				key,
+			)
+		}
+		// A null value removes the key
+		patch[key] = nil
+	}
+	return patch, nil
+}
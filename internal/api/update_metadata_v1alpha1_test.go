@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestUpdateMetadata(t *testing.T) {
+	testCases := map[string]struct {
+		req        *svcv1alpha1.UpdateMetadataRequest
+		assertions func(*connect.Response[svcv1alpha1.UpdateMetadataResponse], error)
+	}{
+		"empty project": {
+			req: &svcv1alpha1.UpdateMetadataRequest{
+				Kind:   "Stage",
+				Name:   "test",
+				Labels: map[string]string{"team": "payments"},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.UpdateMetadataResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"no changes": {
+			req: &svcv1alpha1.UpdateMetadataRequest{
+				Project: "kargo-demo",
+				Kind:    "Stage",
+				Name:    "test",
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.UpdateMetadataResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"unsupported kind": {
+			req: &svcv1alpha1.UpdateMetadataRequest{
+				Project: "kargo-demo",
+				Kind:    "StageList",
+				Name:    "test",
+				Labels:  map[string]string{"team": "payments"},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.UpdateMetadataResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "unsupported kind")
+			},
+		},
+		"reserved key": {
+			req: &svcv1alpha1.UpdateMetadataRequest{
+				Project: "kargo-demo",
+				Kind:    "Stage",
+				Name:    "test",
+				Labels:  map[string]string{kargoapi.LabelAliasKey: "fake-alias"},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.UpdateMetadataResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "is reserved for use by Kargo")
+			},
+		},
+		"invalid label value": {
+			req: &svcv1alpha1.UpdateMetadataRequest{
+				Project: "kargo-demo",
+				Kind:    "Stage",
+				Name:    "test",
+				Labels:  map[string]string{"team": "not a valid value"},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.UpdateMetadataResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "invalid value")
+			},
+		},
+		"key both set and removed": {
+			req: &svcv1alpha1.UpdateMetadataRequest{
+				Project:      "kargo-demo",
+				Kind:         "Stage",
+				Name:         "test",
+				Labels:       map[string]string{"team": "payments"},
+				RemoveLabels: []string{"team"},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.UpdateMetadataResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "cannot be both set and removed")
+			},
+		},
+		"non-existing resource": {
+			req: &svcv1alpha1.UpdateMetadataRequest{
+				Project: "kargo-demo",
+				Kind:    "Stage",
+				Name:    "non-existing",
+				Labels:  map[string]string{"team": "payments"},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.UpdateMetadataResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		"success": {
+			req: &svcv1alpha1.UpdateMetadataRequest{
+				Project:           "kargo-demo",
+				Kind:              "Stage",
+				Name:              "test",
+				Labels:            map[string]string{"team": "payments"},
+				RemoveLabels:      []string{"obsolete"},
+				Annotations:       map[string]string{"example.com/owner": "Jane Doe"},
+				RemoveAnnotations: []string{"example.com/obsolete"},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.UpdateMetadataResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]string{"team": "payments"},
+					res.Msg.GetLabels(),
+				)
+				require.Equal(
+					t,
+					map[string]string{"example.com/owner": "Jane Doe"},
+					res.Msg.GetAnnotations(),
+				)
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			stage := mustNewObject[kargoapi.Stage]("testdata/stage.yaml")
+			stage.Labels = map[string]string{"obsolete": "true"}
+			stage.Annotations = map[string]string{"example.com/obsolete": "true"}
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(
+								mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+								stage,
+							).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client: kubeClient,
+			}
+			svr.externalValidateProjectFn = validation.ValidateProject
+			testCase.assertions(
+				svr.UpdateMetadata(ctx, connect.NewRequest(testCase.req)),
+			)
+		})
+	}
+}
@@ -39,6 +39,7 @@ func (s *server) UpdatePromotionPolicy(
 			},
 			Stage:               req.Msg.GetTyped().GetStage(),
 			EnableAutoPromotion: req.Msg.GetTyped().GetEnableAutoPromotion(),
+			EnableSelfHeal:      req.Msg.GetTyped().GetEnableSelfHeal(),
 		}
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("promotion_policy should not be empty"))
@@ -47,6 +47,7 @@ func (s *server) UpdateResource(
 func (s *server) updateResource(
 	ctx context.Context,
 	obj *unstructured.Unstructured,
+	opts ...client.UpdateOption,
 ) *svcv1alpha1.UpdateResourceResult {
 	currentObj := obj.DeepCopy()
 	if err := s.client.Get(ctx, client.ObjectKeyFromObject(obj), currentObj); err != nil {
@@ -58,7 +59,7 @@ func (s *server) updateResource(
 	}
 
 	obj.SetResourceVersion(currentObj.GetResourceVersion())
-	if err := s.client.Update(ctx, obj); err != nil {
+	if err := s.client.Update(ctx, obj, opts...); err != nil {
 		return &svcv1alpha1.UpdateResourceResult{
 			Result: &svcv1alpha1.UpdateResourceResult_Error{
 				Error: errors.Wrap(err, "update resource").Error(),
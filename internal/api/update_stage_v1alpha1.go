@@ -57,6 +57,6 @@ func (s *server) UpdateStage(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	return connect.NewResponse(&svcv1alpha1.UpdateStageResponse{
-		Stage: typesv1alpha1.ToStageProto(stage),
+		Stage: s.toStageProto(ctx, stage),
 	}), nil
 }
@@ -1,6 +1,10 @@
 package user
 
-import "context"
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v5"
+)
 
 type userInfoKey struct{}
 
@@ -19,6 +23,17 @@ type Info struct {
 	// Groups are the group claims obtained from credentials that have been
 	// successfully verified by the server's authentication middleware.
 	Groups []string
+	// ReadOnly indicates that the user represented by this struct may only
+	// perform read operations, regardless of any other permissions they might
+	// have. This is set for bearers of Kargo-issued viewer tokens, for users
+	// belonging to any of the API server's configured read-only groups, and for
+	// anonymous users.
+	ReadOnly bool
+	// KubernetesUser indicates that Username and Groups identify a user of the
+	// Kubernetes cluster, as verified by a TokenReview when the user exchanged
+	// their Kubernetes credentials for a Kargo API session. Operations performed
+	// by such users are subject to a SubjectAccessReview.
+	KubernetesUser bool
 	// BearerToken is set only in cases where the server's authentication
 	// middleware could not verify the token it was presented with. In this case,
 	// we assume the token to be a valid credential for a Kubernetes user. When
@@ -27,6 +42,22 @@ type Info struct {
 	BearerToken string
 }
 
+// Claims represents the claims found in ID tokens issued directly by the Kargo
+// API server.
+type Claims struct {
+	jwt.RegisteredClaims
+	// ReadOnly indicates that the bearer of the token may only perform read
+	// operations.
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Groups are the groups to which the bearer of the token belongs. This is
+	// only set for tokens issued in exchange for Kubernetes credentials.
+	Groups []string `json:"groups,omitempty"`
+	// Kubernetes indicates that the token was issued in exchange for Kubernetes
+	// credentials and that its subject is the name of a user of the Kubernetes
+	// cluster.
+	Kubernetes bool `json:"kubernetes,omitempty"`
+}
+
 // ContextWithInfo returns a context.Context that has been augmented with
 // the provided Info.
 func ContextWithInfo(ctx context.Context, u Info) context.Context {
@@ -0,0 +1,254 @@
+package validation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// namespaceWatcher is the subset of the API server's Kubernetes client used
+// by the ProjectCache to learn about changes to Project namespaces.
+type namespaceWatcher interface {
+	Watch(
+		ctx context.Context,
+		obj client.Object,
+		namespace string,
+		opts metav1.ListOptions,
+	) (watch.Interface, error)
+}
+
+type projectCacheEntry struct {
+	err       error
+	expiresAt time.Time
+}
+
+// ProjectCache caches the outcome of project validation so that busy API
+// servers need not consult the Kubernetes API for every RPC that references a
+// project. Both positive results and definitive negative results (i.e. the
+// project does not exist or the namespace is not a project) are cached, using
+// separate TTLs. Transient errors are never cached. Entries are invalidated
+// early whenever the ProjectCache observes a change to the corresponding
+// namespace. Expired entries are pruned whenever the cache is full, and, if the
+// cache is still full after pruning, further results are not cached until room
+// is made.
+//
+// Because validation is performed using a Kubernetes client that is subject to
+// the caller's own permissions, results are cached separately for each caller.
+// A result obtained by one caller is never returned to another.
+type ProjectCache struct {
+	ttl         time.Duration
+	negativeTTL time.Duration
+	maxEntries  int
+
+	mu      sync.RWMutex
+	entries map[string]map[string]projectCacheEntry
+	// size is the total number of entries across all projects and callers
+	size int
+
+	// The following behaviors are overridable for testing purposes:
+	nowFn             func() time.Time
+	validateProjectFn func(
+		ctx context.Context,
+		kc client.Client,
+		project string,
+	) error
+}
+
+// NewProjectCache returns a ProjectCache that caches successful validations for
+// the duration specified by ttl and definitive failures for the duration
+// specified by negativeTTL. No more than maxEntries results are cached at once.
+func NewProjectCache(
+	ttl time.Duration,
+	negativeTTL time.Duration,
+	maxEntries int,
+) *ProjectCache {
+	return &ProjectCache{
+		ttl:               ttl,
+		negativeTTL:       negativeTTL,
+		maxEntries:        maxEntries,
+		entries:           map[string]map[string]projectCacheEntry{},
+		nowFn:             time.Now,
+		validateProjectFn: ValidateProject,
+	}
+}
+
+// ValidateProject is a drop-in replacement for the package-level
+// ValidateProject function that consults the cache before falling back to the
+// Kubernetes API.
+func (p *ProjectCache) ValidateProject(
+	ctx context.Context,
+	kc client.Client,
+	project string,
+) error {
+	now := p.nowFn()
+	caller := callerKey(ctx)
+	p.mu.RLock()
+	entry, ok := p.entries[project][caller]
+	p.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.err
+	}
+
+	err := p.validateProjectFn(ctx, kc, project)
+	var ttl time.Duration
+	var fieldErr *field.Error
+	switch {
+	case err == nil:
+		ttl = p.ttl
+	case errors.Is(err, ErrProjectNotFound), errors.As(err, &fieldErr):
+		ttl = p.negativeTTL
+	default:
+		// Don't cache errors that may be transient
+		return err
+	}
+	if ttl > 0 {
+		p.store(project, caller, projectCacheEntry{
+			err:       err,
+			expiresAt: now.Add(ttl),
+		}, now)
+	}
+	return err
+}
+
+// store caches the provided entry for the specified project and caller. If the
+// cache is full, expired entries are pruned first. If the cache is still full
+// after pruning, the entry is discarded.
+func (p *ProjectCache) store(
+	project string,
+	caller string,
+	entry projectCacheEntry,
+	now time.Time,
+) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, exists := p.entries[project][caller]
+	if !exists && p.size >= p.maxEntries {
+		p.prune(now)
+		if p.size >= p.maxEntries {
+			return
+		}
+	}
+	if p.entries[project] == nil {
+		p.entries[project] = map[string]projectCacheEntry{}
+	}
+	p.entries[project][caller] = entry
+	if !exists {
+		p.size++
+	}
+}
+
+// prune evicts all entries that have expired as of the specified time. The
+// caller must hold the write lock.
+func (p *ProjectCache) prune(now time.Time) {
+	for project, callers := range p.entries {
+		for caller, entry := range callers {
+			if !now.Before(entry.expiresAt) {
+				delete(callers, caller)
+				p.size--
+			}
+		}
+		if len(callers) == 0 {
+			delete(p.entries, project)
+		}
+	}
+}
+
+// Invalidate evicts all callers' cached results for the specified project.
+func (p *ProjectCache) Invalidate(project string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.size -= len(p.entries[project])
+	delete(p.entries, project)
+}
+
+// Run watches namespaces using the provided watcher and invalidates cached
+// results for any namespace that is added, modified, or deleted. If the watch
+// is interrupted, it is re-established and the entire cache is flushed, since
+// events may have been missed in the interim. Run blocks until the provided
+// context is canceled.
+func (p *ProjectCache) Run(ctx context.Context, w namespaceWatcher) {
+	logger := logging.LoggerFromContext(ctx)
+	for {
+		p.flush()
+		if err := p.watch(ctx, w); err != nil {
+			logger.Errorf("error watching Projects: %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func (p *ProjectCache) watch(ctx context.Context, w namespaceWatcher) error {
+	wi, err := w.Watch(ctx, &corev1.Namespace{}, "", metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "watch namespaces")
+	}
+	defer wi.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-wi.ResultChan():
+			if !ok {
+				return nil
+			}
+			obj, ok := e.Object.(metav1.Object)
+			if !ok {
+				continue
+			}
+			p.Invalidate(obj.GetName())
+		}
+	}
+}
+
+func (p *ProjectCache) flush() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.entries = map[string]map[string]projectCacheEntry{}
+	p.size = 0
+}
+
+// callerKey returns a string that uniquely identifies the caller whose
+// user.Info is bound to the provided context. Callers presenting unverified
+// bearer tokens are identified by a hash of the token.
+func callerKey(ctx context.Context) string {
+	u, ok := user.InfoFromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if u.BearerToken != "" {
+		sum := sha256.Sum256([]byte(u.BearerToken))
+		return "token:" + hex.EncodeToString(sum[:])
+	}
+	groups := make([]string, len(u.Groups))
+	copy(groups, u.Groups)
+	sort.Strings(groups)
+	return strings.Join(
+		[]string{
+			strconv.FormatBool(u.IsAdmin),
+			strconv.FormatBool(u.ReadOnly),
+			strconv.FormatBool(u.KubernetesUser),
+			strconv.Quote(u.Username),
+			strconv.Quote(strings.Join(groups, "\n")),
+		},
+		":",
+	)
+}
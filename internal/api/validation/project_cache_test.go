@@ -0,0 +1,195 @@
+package validation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuity/kargo/internal/api/user"
+)
+
+func TestProjectCacheValidateProject(t *testing.T) {
+	testCases := []struct {
+		name          string
+		validationErr error
+		advance       time.Duration
+		assertions    func(err error, calls int)
+	}{
+		{
+			name:    "valid project is cached",
+			advance: 30 * time.Second,
+			assertions: func(err error, calls int) {
+				require.NoError(t, err)
+				require.Equal(t, 1, calls)
+			},
+		},
+		{
+			name:    "valid project cache entry expires",
+			advance: 2 * time.Minute,
+			assertions: func(err error, calls int) {
+				require.NoError(t, err)
+				require.Equal(t, 2, calls)
+			},
+		},
+		{
+			name:          "project not found is cached",
+			validationErr: ErrProjectNotFound,
+			advance:       5 * time.Second,
+			assertions: func(err error, calls int) {
+				require.ErrorIs(t, err, ErrProjectNotFound)
+				require.Equal(t, 1, calls)
+			},
+		},
+		{
+			name:          "negative cache entry expires",
+			validationErr: ErrProjectNotFound,
+			advance:       30 * time.Second,
+			assertions: func(err error, calls int) {
+				require.ErrorIs(t, err, ErrProjectNotFound)
+				require.Equal(t, 2, calls)
+			},
+		},
+		{
+			name:          "namespace that is not a project is cached",
+			validationErr: &field.Error{},
+			advance:       5 * time.Second,
+			assertions: func(err error, calls int) {
+				require.Error(t, err)
+				require.Equal(t, 1, calls)
+			},
+		},
+		{
+			name:          "other errors are not cached",
+			validationErr: errors.New("something went wrong"),
+			assertions: func(err error, calls int) {
+				require.Error(t, err)
+				require.Equal(t, 2, calls)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			now := time.Now()
+			var calls int
+			cache := NewProjectCache(time.Minute, 10*time.Second, 100)
+			cache.nowFn = func() time.Time {
+				return now
+			}
+			cache.validateProjectFn = func(
+				context.Context,
+				client.Client,
+				string,
+			) error {
+				calls++
+				return testCase.validationErr
+			}
+			_ = cache.ValidateProject(context.Background(), nil, "fake-project")
+			now = now.Add(testCase.advance)
+			err := cache.ValidateProject(context.Background(), nil, "fake-project")
+			testCase.assertions(err, calls)
+		})
+	}
+}
+
+func TestProjectCacheValidateProjectPerCaller(t *testing.T) {
+	var calls int
+	cache := NewProjectCache(time.Minute, 10*time.Second, 100)
+	cache.validateProjectFn = func(
+		ctx context.Context,
+		_ client.Client,
+		_ string,
+	) error {
+		calls++
+		// Simulate an authorizing client that permits only "alice" to get the
+		// project namespace
+		if u, _ := user.InfoFromContext(ctx); u.Username != "alice" {
+			return errors.New("forbidden")
+		}
+		return nil
+	}
+	aliceCtx := user.ContextWithInfo(
+		context.Background(),
+		user.Info{Username: "alice"},
+	)
+	bobCtx := user.ContextWithInfo(
+		context.Background(),
+		user.Info{Username: "bob"},
+	)
+	require.NoError(t, cache.ValidateProject(aliceCtx, nil, "fake-project"))
+	require.ErrorContains(
+		t,
+		cache.ValidateProject(bobCtx, nil, "fake-project"),
+		"forbidden",
+	)
+	require.NoError(t, cache.ValidateProject(aliceCtx, nil, "fake-project"))
+	require.Equal(t, 2, calls)
+}
+
+func TestProjectCacheInvalidate(t *testing.T) {
+	var calls int
+	cache := NewProjectCache(time.Minute, 10*time.Second, 100)
+	cache.validateProjectFn = func(context.Context, client.Client, string) error {
+		calls++
+		return nil
+	}
+	require.NoError(
+		t,
+		cache.ValidateProject(context.Background(), nil, "fake-project"),
+	)
+	cache.Invalidate("fake-project")
+	require.NoError(
+		t,
+		cache.ValidateProject(context.Background(), nil, "fake-project"),
+	)
+	require.Equal(t, 2, calls)
+}
+
+func TestProjectCacheMaxEntries(t *testing.T) {
+	now := time.Now()
+	var calls int
+	cache := NewProjectCache(time.Minute, 10*time.Second, 2)
+	cache.nowFn = func() time.Time {
+		return now
+	}
+	cache.validateProjectFn = func(context.Context, client.Client, string) error {
+		calls++
+		return ErrProjectNotFound
+	}
+	validate := func(project string) {
+		require.ErrorIs(
+			t,
+			cache.ValidateProject(context.Background(), nil, project),
+			ErrProjectNotFound,
+		)
+	}
+
+	// Fill the cache
+	validate("fake-project-1")
+	validate("fake-project-2")
+	require.Equal(t, 2, cache.size)
+
+	// Nothing has expired, so this result is not cached
+	validate("fake-project-3")
+	require.Equal(t, 2, cache.size)
+	validate("fake-project-3")
+	require.Equal(t, 4, calls)
+
+	// Cached results are still used
+	validate("fake-project-1")
+	require.Equal(t, 4, calls)
+
+	// Once the cached results expire, they are pruned to make room
+	now = now.Add(time.Minute)
+	validate("fake-project-3")
+	require.Equal(t, 5, calls)
+	require.Equal(t, 1, cache.size)
+	require.Len(t, cache.entries, 1)
+
+	cache.Invalidate("fake-project-3")
+	require.Equal(t, 0, cache.size)
+}
@@ -12,7 +12,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
-	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -67,7 +66,7 @@ func (s *server) WatchPromotions(
 				continue
 			}
 			if err := stream.Send(&svcv1alpha1.WatchPromotionsResponse{
-				Promotion: typesv1alpha1.ToPromotionProto(*promotion),
+				Promotion: s.toPromotionProto(ctx, *promotion),
 				Type:      string(e.Type),
 			}); err != nil {
 				return errors.Wrap(err, "send response")
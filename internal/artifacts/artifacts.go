@@ -0,0 +1,190 @@
+// Package artifacts stores large artifacts, such as the logs of Promotions and
+// the artifacts reported by verification providers, outside of the resources
+// they belong to. Resources record only the URLs of their artifacts, which
+// keeps them small while retaining full detail.
+package artifacts
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Config represents configuration for storing artifacts.
+type Config struct {
+	// URL specifies where artifacts are stored. Supported forms are:
+	//
+	//   - s3://bucket/prefix for Amazon S3 or any S3-compatible object storage
+	//   - gs://bucket/prefix for Google Cloud Storage
+	//   - azblob://container/prefix for Azure Blob Storage
+	//   - configmap:// for ConfigMaps in the namespaces of the resources the
+	//     artifacts belong to, which requires no external storage but is only
+	//     suitable for artifacts of less than a megabyte
+	//
+	// The s3, gs, and azblob forms accept an endpoint query parameter that
+	// overrides the service's default endpoint; e.g. for S3-compatible storage
+	// or emulators. The s3 form also accepts a region query parameter, which
+	// takes precedence over S3Region. If unspecified, artifacts are not stored.
+	URL string `envconfig:"ARTIFACT_STORE_URL"`
+	// S3Region is the AWS region of the S3 bucket.
+	S3Region string `envconfig:"AWS_REGION" default:"us-east-1"`
+	// S3AccessKeyID is the access key ID with which requests to S3 are signed.
+	S3AccessKeyID string `envconfig:"AWS_ACCESS_KEY_ID"`
+	// S3SecretAccessKey is the secret access key with which requests to S3 are
+	// signed.
+	S3SecretAccessKey string `envconfig:"AWS_SECRET_ACCESS_KEY"`
+	// S3SessionToken is the session token to include in requests to S3 when
+	// using temporary credentials.
+	S3SessionToken string `envconfig:"AWS_SESSION_TOKEN"`
+	// AzureStorageAccount is the name of the Azure storage account in which the
+	// blob container resides.
+	AzureStorageAccount string `envconfig:"AZURE_STORAGE_ACCOUNT"`
+	// AzureStorageSASToken is a shared access signature granting permission to
+	// create blobs in the blob container.
+	AzureStorageSASToken string `envconfig:"AZURE_STORAGE_SAS_TOKEN"`
+}
+
+// ConfigFromEnv returns a Config populated from environment variables.
+func ConfigFromEnv() Config {
+	var cfg Config
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// Artifact is a single artifact to be stored.
+type Artifact struct {
+	// Namespace is the namespace of the resource to which the artifact belongs.
+	Namespace string
+	// Path identifies the artifact among all artifacts in Namespace; e.g.
+	// promotions/my-promotion/log.txt.
+	Path string
+	// Owner optionally references the resource to which the artifact belongs.
+	// Stores that keep artifacts in the cluster use it to ensure artifacts are
+	// garbage collected along with that resource.
+	Owner *metav1.OwnerReference
+	// ContentType is the media type of Data.
+	ContentType string
+	// Data is the content of the artifact.
+	Data []byte
+}
+
+// key returns the key under which the artifact is stored, relative to any
+// prefix.
+func (a Artifact) key() string {
+	return path.Join(a.Namespace, a.Path)
+}
+
+// Store is the interface for components that store artifacts.
+type Store interface {
+	// Put stores the provided Artifact, replacing any artifact previously stored
+	// with the same Namespace and Path, and returns the URL at which it can be
+	// found.
+	Put(context.Context, Artifact) (string, error)
+}
+
+// NewStore returns a Store as specified by the provided Config. Artifacts
+// stored in ConfigMaps are written using the provided client. If the Config
+// does not specify where artifacts are stored, a nil Store is returned.
+func NewStore(
+	ctx context.Context,
+	cfg Config,
+	kubeClient client.Client,
+) (Store, error) {
+	if cfg.URL == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing artifact store URL")
+	}
+	prefix := strings.Trim(u.Path, "/")
+	endpoint := u.Query().Get("endpoint")
+	var store Store
+	switch u.Scheme {
+	case "s3":
+		region := u.Query().Get("region")
+		if region == "" {
+			region = cfg.S3Region
+		}
+		var s *s3Store
+		s, err = newS3Store(u.Host, prefix, endpoint, region, s3Credentials{
+			accessKeyID:     cfg.S3AccessKeyID,
+			secretAccessKey: cfg.S3SecretAccessKey,
+			sessionToken:    cfg.S3SessionToken,
+		})
+		store = s
+	case "gs":
+		var g *gcsStore
+		g, err = newGCSStore(ctx, u.Host, prefix, endpoint)
+		store = g
+	case "azblob":
+		var a *azureStore
+		a, err = newAzureStore(
+			cfg.AzureStorageAccount,
+			u.Host,
+			prefix,
+			endpoint,
+			cfg.AzureStorageSASToken,
+		)
+		store = a
+	case "configmap":
+		store = newConfigMapStore(kubeClient)
+	default:
+		err = errors.Errorf("unsupported artifact store URL scheme %q", u.Scheme)
+	}
+	if err != nil {
+		// Don't return a Store that wraps a nil pointer
+		return nil, err
+	}
+	return store, nil
+}
+
+// put sends the provided request, which uploads an object to an object
+// storage service, and returns an error if the service does not report
+// success.
+func put(httpClient *http.Client, req *http.Request) error {
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "error uploading artifact")
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		// Object storage services describe errors in the response body
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return errors.Errorf(
+			"error uploading artifact: received %d response: %s",
+			res.StatusCode,
+			strings.TrimSpace(string(body)),
+		)
+	}
+	return nil
+}
+
+// escapePath percent-encodes every byte of the provided slash-separated path
+// other than slashes and the unreserved characters of RFC 3986. This is
+// stricter than url.PathEscape, as is required for AWS signatures, and is
+// understood by every supported object storage service.
+func escapePath(p string) string {
+	const hex = "0123456789ABCDEF"
+	var sb strings.Builder
+	for i := 0; i < len(p); i++ {
+		switch c := p[i]; {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~', c == '/':
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte('%')
+			sb.WriteByte(hex[c>>4])
+			sb.WriteByte(hex[c&0xf])
+		}
+	}
+	return sb.String()
+}
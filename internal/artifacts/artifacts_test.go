@@ -0,0 +1,91 @@
+package artifacts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStore(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        Config
+		assertions func(Store, error)
+	}{
+		{
+			name: "no URL",
+			assertions: func(store Store, err error) {
+				require.NoError(t, err)
+				require.Nil(t, store)
+			},
+		},
+		{
+			name: "unsupported scheme",
+			cfg:  Config{URL: "ftp://example.com/artifacts"},
+			assertions: func(_ Store, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unsupported artifact store URL scheme")
+			},
+		},
+		{
+			name: "S3 without credentials",
+			cfg:  Config{URL: "s3://fake-bucket"},
+			assertions: func(_ Store, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "access key ID and secret access key")
+			},
+		},
+		{
+			name: "S3",
+			cfg: Config{
+				URL:               "s3://fake-bucket/kargo/artifacts?region=eu-west-1",
+				S3Region:          "us-east-1",
+				S3AccessKeyID:     "fake-key-id",
+				S3SecretAccessKey: "fake-secret",
+			},
+			assertions: func(store Store, err error) {
+				require.NoError(t, err)
+				s, ok := store.(*s3Store)
+				require.True(t, ok)
+				require.Equal(t, "fake-bucket", s.bucket)
+				require.Equal(t, "kargo/artifacts", s.prefix)
+				require.Equal(t, "eu-west-1", s.region)
+				require.Nil(t, s.endpoint)
+			},
+		},
+		{
+			name: "Azure Blob Storage",
+			cfg: Config{
+				URL:                  "azblob://fake-container",
+				AzureStorageAccount:  "fake-account",
+				AzureStorageSASToken: "sv=fake",
+			},
+			assertions: func(store Store, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &azureStore{}, store)
+			},
+		},
+		{
+			name: "ConfigMaps",
+			cfg:  Config{URL: "configmap://"},
+			assertions: func(store Store, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &configMapStore{}, store)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(NewStore(context.Background(), testCase.cfg, nil))
+		})
+	}
+}
+
+func TestEscapePath(t *testing.T) {
+	require.Equal(
+		t,
+		"fake-namespace/stages/fake-stage/a%20b%24c~d.txt",
+		escapePath("fake-namespace/stages/fake-stage/a b$c~d.txt"),
+	)
+}
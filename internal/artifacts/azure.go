@@ -0,0 +1,88 @@
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// azureStore is a Store that uploads artifacts to Azure Blob Storage as block
+// blobs.
+type azureStore struct {
+	// endpoint is the base URL of the storage account's blob service.
+	endpoint  string
+	container string
+	prefix    string
+	// sasToken is a shared access signature, in the form of a query string,
+	// with which requests are authorized.
+	sasToken   string
+	httpClient *http.Client
+}
+
+func newAzureStore(
+	account string,
+	container string,
+	prefix string,
+	endpoint string,
+	sasToken string,
+) (*azureStore, error) {
+	if container == "" {
+		return nil, errors.New(
+			"Azure Blob Storage artifact store URL does not specify a container",
+		)
+	}
+	if endpoint == "" {
+		if account == "" {
+			return nil, errors.New(
+				"a storage account is required to store artifacts in Azure Blob " +
+					"Storage",
+			)
+		}
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", account)
+	}
+	if sasToken == "" {
+		return nil, errors.New(
+			"a shared access signature is required to store artifacts in Azure " +
+				"Blob Storage",
+		)
+	}
+	return &azureStore{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		container:  container,
+		prefix:     prefix,
+		sasToken:   strings.TrimPrefix(sasToken, "?"),
+		httpClient: &http.Client{Timeout: time.Minute},
+	}, nil
+}
+
+// Put implements Store.
+func (a *azureStore) Put(ctx context.Context, artifact Artifact) (string, error) {
+	blobURL := fmt.Sprintf(
+		"%s/%s/%s",
+		a.endpoint,
+		a.container,
+		escapePath(path.Join(a.prefix, artifact.key())),
+	)
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPut,
+		blobURL+"?"+a.sasToken,
+		bytes.NewReader(artifact.Data),
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "error creating Azure Blob Storage request")
+	}
+	req.Header.Set("Content-Type", artifact.ContentType)
+	req.Header.Set("X-Ms-Blob-Type", "BlockBlob")
+	if err = put(a.httpClient, req); err != nil {
+		return "", err
+	}
+	// The shared access signature is a secret, so it is not part of the URL
+	return blobURL, nil
+}
@@ -0,0 +1,125 @@
+package artifacts
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAzureStore(t *testing.T) {
+	testCases := []struct {
+		name       string
+		account    string
+		container  string
+		endpoint   string
+		sasToken   string
+		assertions func(*azureStore, error)
+	}{
+		{
+			name:     "container not specified",
+			account:  "fake-account",
+			sasToken: "sv=fake",
+			assertions: func(_ *azureStore, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "does not specify a container")
+			},
+		},
+		{
+			name:      "neither account nor endpoint specified",
+			container: "fake-container",
+			sasToken:  "sv=fake",
+			assertions: func(_ *azureStore, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "a storage account is required")
+			},
+		},
+		{
+			name:      "shared access signature not specified",
+			account:   "fake-account",
+			container: "fake-container",
+			assertions: func(_ *azureStore, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "a shared access signature is required")
+			},
+		},
+		{
+			name:      "default endpoint",
+			account:   "fake-account",
+			container: "fake-container",
+			sasToken:  "?sv=fake",
+			assertions: func(a *azureStore, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "https://fake-account.blob.core.windows.net", a.endpoint)
+				require.Equal(t, "sv=fake", a.sasToken)
+			},
+		},
+		{
+			name:      "endpoint specified",
+			container: "fake-container",
+			endpoint:  "http://127.0.0.1:10000/devstoreaccount1/",
+			sasToken:  "sv=fake",
+			assertions: func(a *azureStore, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "http://127.0.0.1:10000/devstoreaccount1", a.endpoint)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				newAzureStore(
+					testCase.account,
+					testCase.container,
+					"",
+					testCase.endpoint,
+					testCase.sasToken,
+				),
+			)
+		})
+	}
+}
+
+func TestAzureStorePut(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPut, r.Method)
+			require.Equal(
+				t,
+				"/fake-container/kargo/fake-namespace/promotions/fake-promotion/log.txt",
+				r.URL.Path,
+			)
+			require.Equal(t, "sv=fake&sig=fake", r.URL.RawQuery)
+			require.Equal(t, "BlockBlob", r.Header.Get("X-Ms-Blob-Type"))
+			require.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, "fake log", string(body))
+			w.WriteHeader(http.StatusCreated)
+		}),
+	)
+	defer server.Close()
+	a, err := newAzureStore(
+		"",
+		"fake-container",
+		"kargo",
+		server.URL,
+		"sv=fake&sig=fake",
+	)
+	require.NoError(t, err)
+	url, err := a.Put(context.Background(), Artifact{
+		Namespace:   "fake-namespace",
+		Path:        "promotions/fake-promotion/log.txt",
+		ContentType: "text/plain",
+		Data:        []byte("fake log"),
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		server.URL+"/fake-container/kargo/fake-namespace/promotions/fake-promotion/log.txt",
+		url,
+	)
+}
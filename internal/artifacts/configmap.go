@@ -0,0 +1,89 @@
+package artifacts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	// maxConfigMapArtifactSize is the size, in bytes, of the largest artifact
+	// that can be stored in a ConfigMap. ConfigMaps are limited to 1 MiB,
+	// including their metadata, so some room is left for it.
+	maxConfigMapArtifactSize = 1000 * 1024
+	// configMapArtifactDataKey is the key under which an artifact is stored in
+	// a ConfigMap's binary data.
+	configMapArtifactDataKey = "content"
+)
+
+// configMapStore is a Store that stores each artifact in a ConfigMap in the
+// namespace of the resource the artifact belongs to. It requires no external
+// storage, but is only suitable for small artifacts.
+type configMapStore struct {
+	client client.Client
+}
+
+func newConfigMapStore(kubeClient client.Client) *configMapStore {
+	return &configMapStore{client: kubeClient}
+}
+
+// Put implements Store.
+func (c *configMapStore) Put(ctx context.Context, a Artifact) (string, error) {
+	if len(a.Data) > maxConfigMapArtifactSize {
+		return "", errors.Errorf(
+			"artifact %q is %d bytes; at most %d bytes can be stored in a ConfigMap",
+			a.Path,
+			len(a.Data),
+			maxConfigMapArtifactSize,
+		)
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: a.Namespace,
+			Name:      configMapName(a.Path),
+			Labels: map[string]string{
+				kargoapi.LabelArtifactKey: kargoapi.LabelTrueValue,
+			},
+			Annotations: map[string]string{
+				kargoapi.AnnotationKeyArtifactPath:        a.Path,
+				kargoapi.AnnotationKeyArtifactContentType: a.ContentType,
+			},
+		},
+		BinaryData: map[string][]byte{
+			configMapArtifactDataKey: a.Data,
+		},
+	}
+	if a.Owner != nil {
+		cm.OwnerReferences = []metav1.OwnerReference{*a.Owner}
+	}
+	err := c.client.Create(ctx, cm)
+	if apierrors.IsAlreadyExists(err) {
+		// Patching, unlike updating, does not require the existing ConfigMap to
+		// be read first
+		err = c.client.Patch(ctx, cm, client.MergeFrom(&corev1.ConfigMap{}))
+	}
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error storing artifact %q in ConfigMap %q in namespace %q",
+			a.Path,
+			cm.Name,
+			cm.Namespace,
+		)
+	}
+	return fmt.Sprintf("configmap://%s/%s", cm.Namespace, cm.Name), nil
+}
+
+// configMapName returns the name of the ConfigMap in which the artifact with
+// the specified path is stored. Paths can contain characters that are not
+// permitted in names, so the name is derived from a digest of the path.
+func configMapName(artifactPath string) string {
+	return "kargo-artifact-" + sha256Hex([]byte(artifactPath))[:16]
+}
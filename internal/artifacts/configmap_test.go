@@ -0,0 +1,128 @@
+package artifacts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestConfigMapStorePut(t *testing.T) {
+	testArtifact := Artifact{
+		Namespace: "fake-namespace",
+		Path:      "promotions/fake-promotion/log.txt",
+		Owner: &metav1.OwnerReference{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Promotion",
+			Name:       "fake-promotion",
+			UID:        types.UID("fake-uid"),
+		},
+		ContentType: "text/plain",
+		Data:        []byte("fake log"),
+	}
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		artifact   Artifact
+		assertions func(client.Client, string, error)
+	}{
+		{
+			name: "artifact too large",
+			artifact: Artifact{
+				Namespace: "fake-namespace",
+				Path:      "promotions/fake-promotion/log.txt",
+				Data:      make([]byte, maxConfigMapArtifactSize+1),
+			},
+			assertions: func(_ client.Client, _ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "can be stored in a ConfigMap")
+			},
+		},
+		{
+			name:     "ConfigMap created",
+			artifact: testArtifact,
+			assertions: func(c client.Client, url string, err error) {
+				require.NoError(t, err)
+				name := configMapName(testArtifact.Path)
+				require.Equal(t, "configmap://fake-namespace/"+name, url)
+				cm := &corev1.ConfigMap{}
+				require.NoError(
+					t,
+					c.Get(
+						context.Background(),
+						types.NamespacedName{Namespace: "fake-namespace", Name: name},
+						cm,
+					),
+				)
+				require.Equal(
+					t,
+					kargoapi.LabelTrueValue,
+					cm.Labels[kargoapi.LabelArtifactKey],
+				)
+				require.Equal(
+					t,
+					testArtifact.Path,
+					cm.Annotations[kargoapi.AnnotationKeyArtifactPath],
+				)
+				require.Equal(
+					t,
+					"text/plain",
+					cm.Annotations[kargoapi.AnnotationKeyArtifactContentType],
+				)
+				require.Equal(t, []metav1.OwnerReference{*testArtifact.Owner}, cm.OwnerReferences)
+				require.Equal(t, []byte("fake log"), cm.BinaryData[configMapArtifactDataKey])
+			},
+		},
+		{
+			name: "ConfigMap replaced",
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      configMapName(testArtifact.Path),
+					},
+					BinaryData: map[string][]byte{
+						configMapArtifactDataKey: []byte("old log"),
+					},
+				},
+			},
+			artifact: testArtifact,
+			assertions: func(c client.Client, _ string, err error) {
+				require.NoError(t, err)
+				cm := &corev1.ConfigMap{}
+				require.NoError(
+					t,
+					c.Get(
+						context.Background(),
+						types.NamespacedName{
+							Namespace: "fake-namespace",
+							Name:      configMapName(testArtifact.Path),
+						},
+						cm,
+					),
+				)
+				require.Equal(t, []byte("fake log"), cm.BinaryData[configMapArtifactDataKey])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			url, err := newConfigMapStore(c).Put(context.Background(), testCase.artifact)
+			testCase.assertions(c, url, err)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package artifacts
+
+import "context"
+
+// FakeStore is a mock implementation of the Store interface that is used to
+// facilitate unit testing.
+type FakeStore struct {
+	PutFn func(context.Context, Artifact) (string, error)
+}
+
+func (f *FakeStore) Put(ctx context.Context, a Artifact) (string, error) {
+	if f.PutFn == nil {
+		return "", nil
+	}
+	return f.PutFn(ctx, a)
+}
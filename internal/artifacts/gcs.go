@@ -0,0 +1,90 @@
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	gcsDefaultEndpoint = "https://storage.googleapis.com"
+	gcsScope           = "https://www.googleapis.com/auth/devstorage.read_write"
+)
+
+// gcsStore is a Store that uploads artifacts to Google Cloud Storage.
+type gcsStore struct {
+	bucket   string
+	prefix   string
+	endpoint string
+	// tokenSource, if non-nil, is the source of the OAuth 2.0 tokens with which
+	// requests are authorized.
+	tokenSource oauth2.TokenSource
+	httpClient  *http.Client
+}
+
+// newGCSStore returns a gcsStore that authorizes requests using Application
+// Default Credentials; e.g. those of a GKE workload identity.
+func newGCSStore(
+	ctx context.Context,
+	bucket string,
+	prefix string,
+	endpoint string,
+) (*gcsStore, error) {
+	if bucket == "" {
+		return nil, errors.New("GCS artifact store URL does not specify a bucket")
+	}
+	if endpoint == "" {
+		endpoint = gcsDefaultEndpoint
+	}
+	tokenSource, err := google.DefaultTokenSource(ctx, gcsScope)
+	if err != nil {
+		return nil, errors.Wrap(err, "error finding Google Cloud credentials")
+	}
+	return &gcsStore{
+		bucket:      bucket,
+		prefix:      prefix,
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
+		tokenSource: tokenSource,
+		httpClient:  &http.Client{Timeout: time.Minute},
+	}, nil
+}
+
+// Put implements Store.
+func (g *gcsStore) Put(ctx context.Context, a Artifact) (string, error) {
+	key := path.Join(g.prefix, a.key())
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf(
+			"%s/upload/storage/v1/b/%s/o?uploadType=media&name=%s",
+			g.endpoint,
+			url.PathEscape(g.bucket),
+			url.QueryEscape(key),
+		),
+		bytes.NewReader(a.Data),
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "error creating GCS request")
+	}
+	req.Header.Set("Content-Type", a.ContentType)
+	if g.tokenSource != nil {
+		token, err := g.tokenSource.Token()
+		if err != nil {
+			return "", errors.Wrap(err, "error obtaining Google Cloud access token")
+		}
+		token.SetAuthHeader(req)
+	}
+	if err = put(g.httpClient, req); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("gs://%s/%s", g.bucket, key), nil
+}
@@ -0,0 +1,80 @@
+package artifacts
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestGCSStorePut(t *testing.T) {
+	testCases := []struct {
+		name       string
+		handler    http.HandlerFunc
+		assertions func(string, error)
+	}{
+		{
+			name: "error response",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "received 404 response")
+			},
+		},
+		{
+			name: "success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "/upload/storage/v1/b/fake-bucket/o", r.URL.Path)
+				require.Equal(t, "media", r.URL.Query().Get("uploadType"))
+				require.Equal(
+					t,
+					"kargo/fake-namespace/promotions/fake-promotion/log.txt",
+					r.URL.Query().Get("name"),
+				)
+				require.Equal(t, "Bearer fake-token", r.Header.Get("Authorization"))
+				require.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, "fake log", string(body))
+			},
+			assertions: func(url string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"gs://fake-bucket/kargo/fake-namespace/promotions/fake-promotion/log.txt",
+					url,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := httptest.NewServer(testCase.handler)
+			defer server.Close()
+			g := &gcsStore{
+				bucket:   "fake-bucket",
+				prefix:   "kargo",
+				endpoint: server.URL,
+				tokenSource: oauth2.StaticTokenSource(&oauth2.Token{
+					AccessToken: "fake-token",
+				}),
+				httpClient: server.Client(),
+			}
+			testCase.assertions(
+				g.Put(context.Background(), Artifact{
+					Namespace:   "fake-namespace",
+					Path:        "promotions/fake-promotion/log.txt",
+					ContentType: "text/plain",
+					Data:        []byte("fake log"),
+				}),
+			)
+		})
+	}
+}
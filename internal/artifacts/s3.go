@@ -0,0 +1,189 @@
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// s3Credentials are the static AWS credentials with which requests to S3 are
+// signed.
+type s3Credentials struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+// s3Store is a Store that uploads artifacts to Amazon S3 or to S3-compatible
+// object storage.
+type s3Store struct {
+	bucket string
+	prefix string
+	region string
+	// endpoint, if non-nil, is the base URL of S3-compatible object storage,
+	// which is addressed using path-style URLs. Otherwise, Amazon S3 is
+	// addressed using virtual-hosted-style URLs.
+	endpoint   *url.URL
+	creds      s3Credentials
+	httpClient *http.Client
+	nowFn      func() time.Time
+}
+
+func newS3Store(
+	bucket string,
+	prefix string,
+	endpoint string,
+	region string,
+	creds s3Credentials,
+) (*s3Store, error) {
+	if bucket == "" {
+		return nil, errors.New("S3 artifact store URL does not specify a bucket")
+	}
+	if creds.accessKeyID == "" || creds.secretAccessKey == "" {
+		return nil, errors.New(
+			"an access key ID and secret access key are required to store " +
+				"artifacts in S3",
+		)
+	}
+	s := &s3Store{
+		bucket:     bucket,
+		prefix:     prefix,
+		region:     region,
+		creds:      creds,
+		httpClient: &http.Client{Timeout: time.Minute},
+		nowFn:      time.Now,
+	}
+	if endpoint != "" {
+		var err error
+		if s.endpoint, err = url.Parse(endpoint); err != nil {
+			return nil, errors.Wrap(err, "error parsing S3 endpoint")
+		}
+	}
+	return s, nil
+}
+
+// Put implements Store.
+func (s *s3Store) Put(ctx context.Context, a Artifact) (string, error) {
+	key := path.Join(s.prefix, a.key())
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPut,
+		s.objectURL(key),
+		bytes.NewReader(a.Data),
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "error creating S3 request")
+	}
+	req.Header.Set("Content-Type", a.ContentType)
+	s.sign(req, a.Data)
+	if err = put(s.httpClient, req); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("s3://%s/%s", s.bucket, key), nil
+}
+
+// objectURL returns the URL of the object with the specified key.
+func (s *s3Store) objectURL(key string) string {
+	if s.endpoint != nil {
+		return fmt.Sprintf(
+			"%s/%s/%s",
+			strings.TrimSuffix(s.endpoint.String(), "/"),
+			s.bucket,
+			escapePath(key),
+		)
+	}
+	return fmt.Sprintf(
+		"https://%s.s3.%s.amazonaws.com/%s",
+		s.bucket,
+		s.region,
+		escapePath(key),
+	)
+}
+
+// sign adds AWS Signature Version 4 authentication to the provided request,
+// whose body is the provided payload. All the request's headers are signed.
+// The request must not have a query string.
+func (s *s3Store) sign(req *http.Request, payload []byte) {
+	now := s.nowFn().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.creds.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.creds.sessionToken)
+	}
+
+	names := []string{"host"}
+	for name := range req.Header {
+		names = append(names, strings.ToLower(name))
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		value := req.URL.Host
+		if name != "host" {
+			value = strings.Join(req.Header.Values(name), ",")
+		}
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", name, strings.TrimSpace(value))
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join(
+		[]string{
+			req.Method,
+			req.URL.EscapedPath(),
+			"", // No query string
+			canonicalHeaders.String(),
+			signedHeaders,
+			payloadHash,
+		},
+		"\n",
+	)
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, s.region)
+	stringToSign := strings.Join(
+		[]string{
+			"AWS4-HMAC-SHA256",
+			amzDate,
+			scope,
+			sha256Hex([]byte(canonicalRequest)),
+		},
+		"\n",
+	)
+	signingKey := hmacSHA256([]byte("AWS4"+s.creds.secretAccessKey), date)
+	for _, part := range []string{s.region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	req.Header.Set(
+		"Authorization",
+		fmt.Sprintf(
+			"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+			s.creds.accessKeyID,
+			scope,
+			signedHeaders,
+			hex.EncodeToString(hmacSHA256(signingKey, stringToSign)),
+		),
+	)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data)) // nolint: errcheck
+	return h.Sum(nil)
+}
@@ -0,0 +1,16 @@
+package admin
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Perform administrative operations",
+	}
+	cmd.AddCommand(newRevokeTokenCommand(opt))
+	return cmd
+}
@@ -0,0 +1,67 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	kargosvcapi "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestNewRevokeTokenRequest(t *testing.T) {
+	testExpiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	signToken := func(claims jwt.RegisteredClaims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+			SignedString([]byte("fake-key"))
+		require.NoError(t, err)
+		return token
+	}
+	testCases := []struct {
+		name       string
+		arg        string
+		assertions func(*kargosvcapi.RevokeTokenRequest, error)
+	}{
+		{
+			name: "empty argument",
+			assertions: func(_ *kargosvcapi.RevokeTokenRequest, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "argument is an ID",
+			arg:  "fake-id",
+			assertions: func(req *kargosvcapi.RevokeTokenRequest, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-id", req.GetId())
+				require.Nil(t, req.ExpireTime)
+			},
+		},
+		{
+			name: "argument is a JWT without an ID",
+			arg:  signToken(jwt.RegisteredClaims{Subject: "fake-subject"}),
+			assertions: func(_ *kargosvcapi.RevokeTokenRequest, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "token has no ID")
+			},
+		},
+		{
+			name: "argument is a JWT",
+			arg: signToken(jwt.RegisteredClaims{
+				ID:        "fake-id",
+				ExpiresAt: jwt.NewNumericDate(testExpiry),
+			}),
+			assertions: func(req *kargosvcapi.RevokeTokenRequest, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-id", req.GetId())
+				require.Equal(t, testExpiry.UTC(), req.GetExpireTime().AsTime())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(newRevokeTokenRequest(testCase.arg))
+		})
+	}
+}
@@ -2,7 +2,7 @@ package apply
 
 import (
 	goerrors "errors"
-	"fmt"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
@@ -20,6 +20,7 @@ import (
 
 type Flags struct {
 	Filenames []string
+	Strict    bool
 }
 
 func NewCommand(opt *option.Option) *cobra.Command {
@@ -30,6 +31,12 @@ func NewCommand(opt *option.Option) *cobra.Command {
 		Example: `
 # Apply a stage using the data in stage.yaml
 kargo apply -f stage.yaml
+
+# Apply a project and everything in it from a multi-document stream
+cat pipeline.yaml | kargo apply -f -
+
+# Apply a stage, silently dropping any fields that are unknown
+kargo apply -f stage.yaml --strict=false
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -61,55 +68,38 @@ kargo apply -f stage.yaml
 			resp, err := kargoSvcCli.CreateOrUpdateResource(ctx,
 				connect.NewRequest(&kargosvcapi.CreateOrUpdateResourceRequest{
 					Manifest: rawManifest,
+					Strict:   flag.Strict,
 				}))
 			if err != nil {
 				return errors.Wrap(err, "apply resource")
 			}
 
-			resCap := len(resp.Msg.GetResults())
-			createdRes := make([]*kargosvcapi.CreateOrUpdateResourceResult_CreatedResourceManifest, 0, resCap)
-			updatedRes := make([]*kargosvcapi.CreateOrUpdateResourceResult_UpdatedResourceManifest, 0, resCap)
-			errs := make([]error, 0, resCap)
+			// Results are reported in the order in which resources were applied:
+			// Projects first, then Warehouses, Stages, and PromotionPolicies
+			errs := make([]error, 0, len(resp.Msg.GetResults()))
 			for _, r := range resp.Msg.GetResults() {
+				name := types.NamespacedName{
+					Namespace: r.GetNamespace(),
+					Name:      r.GetName(),
+				}.String()
+				var status string
+				var manifest []byte
 				switch typedRes := r.GetResult().(type) {
 				case *kargosvcapi.CreateOrUpdateResourceResult_CreatedResourceManifest:
-					createdRes = append(createdRes, typedRes)
+					status, manifest = "Created", typedRes.CreatedResourceManifest
 				case *kargosvcapi.CreateOrUpdateResourceResult_UpdatedResourceManifest:
-					updatedRes = append(updatedRes, typedRes)
+					status, manifest = "Updated", typedRes.UpdatedResourceManifest
 				case *kargosvcapi.CreateOrUpdateResourceResult_Error:
-					errs = append(errs, errors.New(typedRes.Error))
-				}
-			}
-			for _, r := range createdRes {
-				var obj unstructured.Unstructured
-				if err := sigyaml.Unmarshal(r.CreatedResourceManifest, &obj); err != nil {
-					fmt.Fprintf(opt.IOStreams.ErrOut, "%s",
-						errors.Wrap(err, "Error: unmarshal created manifest"))
+					errs = append(errs, errors.Errorf("%s %q: %s", r.GetKind(), name, typedRes.Error))
 					continue
 				}
 				if printer == nil {
-					name := types.NamespacedName{
-						Namespace: obj.GetNamespace(),
-						Name:      obj.GetName(),
-					}.String()
-					fmt.Fprintf(opt.IOStreams.Out, "%s Created: %q\n", obj.GetKind(), name)
+					opt.Printer().Successf("%s %s: %q\n", r.GetKind(), status, name)
 					continue
 				}
-				_ = printer.PrintObj(&obj, opt.IOStreams.Out)
-			}
-			for _, r := range updatedRes {
 				var obj unstructured.Unstructured
-				if err := sigyaml.Unmarshal(r.UpdatedResourceManifest, &obj); err != nil {
-					fmt.Fprintf(opt.IOStreams.ErrOut, "%s",
-						errors.Wrap(err, "Error: unmarshal updated manifest"))
-					continue
-				}
-				if printer == nil {
-					name := types.NamespacedName{
-						Namespace: obj.GetNamespace(),
-						Name:      obj.GetName(),
-					}.String()
-					fmt.Fprintf(opt.IOStreams.Out, "%s Updated: %q\n", obj.GetKind(), name)
+				if err := sigyaml.Unmarshal(manifest, &obj); err != nil {
+					opt.ErrPrinter().Error(errors.Wrapf(err, "unmarshal %s manifest", strings.ToLower(status)))
 					continue
 				}
 				_ = printer.PrintObj(&obj, opt.IOStreams.Out)
@@ -119,5 +109,6 @@ kargo apply -f stage.yaml
 	}
 	opt.PrintFlags.AddFlags(cmd)
 	option.Filenames("apply", &flag.Filenames)(cmd.Flags())
+	option.Strict(&flag.Strict)(cmd.Flags())
 	return cmd
 }
@@ -0,0 +1,20 @@
+package approve
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve (RESOURCE) (NAME)",
+		Short: "Approve a resource",
+		Example: `
+# Approve a piece of freight for promotion to a stage
+kargo approve freight --project=my-project --stage=my-stage my-freight
+`,
+	}
+	cmd.AddCommand(newApproveFreightCommand(opt))
+	return cmd
+}
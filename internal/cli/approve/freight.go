@@ -0,0 +1,65 @@
+package approve
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type FreightFlags struct {
+	Stage option.Optional[string]
+}
+
+func newApproveFreightCommand(opt *option.Option) *cobra.Command {
+	flag := FreightFlags{
+		Stage: option.OptionalString(),
+	}
+	cmd := &cobra.Command{
+		Use:   "freight --project=project --stage=stage (FREIGHT)",
+		Short: "Manually approve a piece of freight for promotion to a stage",
+		Args:  option.ExactArgs(1),
+		Example: `
+# Approve a piece of freight for promotion to a stage
+kargo approve freight --project=my-project --stage=my-stage my-freight
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project.OrElse("")
+			if project == "" {
+				return errors.New("project is required")
+			}
+			stage := strings.TrimSpace(flag.Stage.OrElse(""))
+			if stage == "" {
+				return errors.New("stage is required")
+			}
+			freight := strings.TrimSpace(args[0])
+			if freight == "" {
+				return errors.New("freight is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			if _, err = kargoSvcCli.ApproveFreight(ctx, connect.NewRequest(&v1alpha1.ApproveFreightRequest{
+				Project: project,
+				Id:      freight,
+				Stage:   stage,
+			})); err != nil {
+				return errors.Wrap(err, "approve freight")
+			}
+			opt.Printer().Successf("Freight %q approved for stage %q\n", freight, stage)
+			return nil
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	option.OptionalStage(flag.Stage)(cmd.Flags())
+	return cmd
+}
@@ -0,0 +1,30 @@
+package archive
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewArchiveCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive",
+		Short: "Archive a project",
+		Long: `Archive a project.
+
+The resources of an archived project remain readable, but are no longer
+reconciled and cannot be modified, created, or deleted until the project is
+unarchived. An archived project cannot be deleted.`,
+	}
+	cmd.AddCommand(newArchiveProjectCommand(opt))
+	return cmd
+}
+
+func NewUnarchiveCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unarchive",
+		Short: "Unarchive a project",
+	}
+	cmd.AddCommand(newUnarchiveProjectCommand(opt))
+	return cmd
+}
@@ -0,0 +1,124 @@
+package archive
+
+import (
+	"context"
+	goerrors "errors"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+func newArchiveProjectCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project [NAME]...",
+		Short: "Archive project by name",
+		Args:  option.MinimumNArgs(1),
+		Example: `
+# Archive project
+kargo archive project my-project
+`,
+		RunE: setProjectsArchived(
+			opt,
+			"Archived",
+			func(
+				ctx context.Context,
+				kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+				name string,
+			) error {
+				_, err := kargoSvcCli.ArchiveProject(
+					ctx,
+					connect.NewRequest(&v1alpha1.ArchiveProjectRequest{
+						Name: name,
+					}),
+				)
+				return err
+			},
+		),
+	}
+	opt.PrintFlags.AddFlags(cmd)
+	return cmd
+}
+
+func newUnarchiveProjectCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project [NAME]...",
+		Short: "Unarchive project by name",
+		Args:  option.MinimumNArgs(1),
+		Example: `
+# Unarchive project
+kargo unarchive project my-project
+`,
+		RunE: setProjectsArchived(
+			opt,
+			"Unarchived",
+			func(
+				ctx context.Context,
+				kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+				name string,
+			) error {
+				_, err := kargoSvcCli.UnarchiveProject(
+					ctx,
+					connect.NewRequest(&v1alpha1.UnarchiveProjectRequest{
+						Name: name,
+					}),
+				)
+				return err
+			},
+		),
+	}
+	opt.PrintFlags.AddFlags(cmd)
+	return cmd
+}
+
+// setProjectsArchived returns a function that applies the provided transition
+// to every Project named by its arguments and prints the outcome for each.
+func setProjectsArchived(
+	opt *option.Option,
+	status string,
+	transition func(
+		ctx context.Context,
+		kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+		name string,
+	) error,
+) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+		if err != nil {
+			return errors.Wrap(err, "get client from config")
+		}
+
+		var resErr error
+		names := slices.Compact(args)
+		results := make([]output.Result, 0, len(names))
+		for _, name := range names {
+			if err := transition(ctx, kargoSvcCli, name); err != nil {
+				resErr = goerrors.Join(resErr, errors.Wrap(err, "Error"))
+				results = append(results, output.Result{
+					Kind:   "Project",
+					Name:   name,
+					Status: output.ResultStatusFailed,
+					Error:  err.Error(),
+				})
+				continue
+			}
+			results = append(results, output.Result{
+				Kind:   "Project",
+				Name:   name,
+				Status: status,
+			})
+		}
+		if err := opt.PrintResults(results); err != nil {
+			return errors.Wrap(err, "print results")
+		}
+		return resErr
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"net/http"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
@@ -16,54 +17,116 @@ import (
 // GetClientFromConfig returns a new client for the Kargo API server located at
 // the address specified in local configuration, using credentials also
 // specified in local configuration UNLESS the specified options indicates that
-// the local server should be used instead.
+// the local server should be used instead. A server address or token specified
+// by the options takes precedence over local configuration. If a server
+// address is specified, local configuration is not consulted at all, which
+// permits use of the CLI in environments, such as CI, where no one has logged
+// in. Unary requests made using the returned client are subject to the timeout
+// and retry policy specified by the options.
 func GetClientFromConfig(ctx context.Context, opt *option.Option) (
 	svcv1alpha1connect.KargoServiceClient,
 	error,
 ) {
+	interceptor := &requestInterceptor{
+		timeout: opt.RequestTimeout,
+		retries: opt.Retries,
+		backoff: time.Second,
+	}
 	if opt.UseLocalServer {
-		return GetClient(opt.LocalServerAddress, "", opt.InsecureTLS), nil
+		return GetClient(opt.LocalServerAddress, "", nil, opt.InsecureTLS, interceptor), nil
 	}
-	cfg, err := config.LoadCLIConfig()
-	if err != nil {
+	var cfg config.CLIConfig
+	var err error
+	if opt.Server != "" {
+		cfg.APIAddress = opt.Server
+	} else if cfg, err = config.LoadCLIConfig(); err != nil {
 		return nil, err
 	}
 	skipTLSVerify := opt.InsecureTLS || cfg.InsecureSkipTLSVerify
-	if cfg, err =
+	if opt.Token != "" {
+		// A token specified explicitly is used as is
+		cfg.BearerToken = opt.Token
+	} else if cfg, err =
 		newTokenRefresher().refreshToken(ctx, cfg, skipTLSVerify); err != nil {
 		return nil, errors.Wrap(err, "error refreshing token")
 	}
-	return GetClient(cfg.APIAddress, cfg.BearerToken, skipTLSVerify), nil
+	// Client certificate flags take precedence over local configuration
+	certPath, keyPath := cfg.ClientCertificatePath, cfg.ClientKeyPath
+	if opt.ClientCertificatePath != "" || opt.ClientKeyPath != "" {
+		certPath, keyPath = opt.ClientCertificatePath, opt.ClientKeyPath
+	}
+	clientCert, err := LoadClientCertificate(certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+	return GetClient(
+		cfg.APIAddress,
+		cfg.BearerToken,
+		clientCert,
+		skipTLSVerify,
+		interceptor,
+	), nil
+}
+
+// LoadClientCertificate loads a client certificate and its corresponding
+// private key from the specified files. If neither path is specified, nil is
+// returned. It is an error to specify only one of the two paths.
+func LoadClientCertificate(certPath, keyPath string) (*tls.Certificate, error) {
+	if certPath == "" && keyPath == "" {
+		return nil, nil
+	}
+	if certPath == "" || keyPath == "" {
+		return nil, errors.New(
+			"a client certificate and client key must be specified together",
+		)
+	}
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading client certificate")
+	}
+	return &cert, nil
 }
 
 // GetClient returns a new client for the Kargo API server located at the
 // specified address. If the provided credential is non-empty, the client will
 // be decorated with an interceptor that adds the credential to outbound
-// requests.
+// requests. If the provided client certificate is non-nil, it will be
+// presented to the server for TLS client authentication. Any additional
+// interceptors provided are applied to outbound requests after the credential
+// is added.
 func GetClient(
 	serverAddress string,
 	credential string,
+	clientCert *tls.Certificate,
 	insecureTLS bool,
+	interceptors ...connect.Interceptor,
 ) svcv1alpha1connect.KargoServiceClient {
+	tlsCfg := &tls.Config{
+		InsecureSkipVerify: insecureTLS, // nolint: gosec
+	}
+	if clientCert != nil {
+		tlsCfg.Certificates = []tls.Certificate{*clientCert}
+	}
 	httpClient := &http.Client{
 		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: insecureTLS, // nolint: gosec
-			},
+			TLSClientConfig: tlsCfg,
 		},
 	}
-	if credential == "" {
-		return svcv1alpha1connect.NewKargoServiceClient(httpClient, serverAddress)
+	if credential != "" {
+		interceptors = append(
+			[]connect.Interceptor{
+				&authInterceptor{
+					credential: credential,
+				},
+			},
+			interceptors...,
+		)
 	}
 	return svcv1alpha1connect.NewKargoServiceClient(
 		httpClient,
 		serverAddress,
 		connect.WithClientOptions(
-			connect.WithInterceptors(
-				&authInterceptor{
-					credential: credential,
-				},
-			),
+			connect.WithInterceptors(interceptors...),
 		),
 	)
 }
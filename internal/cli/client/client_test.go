@@ -0,0 +1,49 @@
+package client
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadClientCertificate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		certPath   string
+		keyPath    string
+		assertions func(*tls.Certificate, error)
+	}{
+		{
+			name: "neither path specified",
+			assertions: func(cert *tls.Certificate, err error) {
+				require.NoError(t, err)
+				require.Nil(t, cert)
+			},
+		},
+		{
+			name:     "only certificate path specified",
+			certPath: "client.crt",
+			assertions: func(_ *tls.Certificate, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "must be specified together")
+			},
+		},
+		{
+			name:     "error loading certificate",
+			certPath: "nonexistent.crt",
+			keyPath:  "nonexistent.key",
+			assertions: func(_ *tls.Certificate, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error loading client certificate")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				LoadClientCertificate(testCase.certPath, testCase.keyPath),
+			)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// maxRetryBackoff is the longest requestInterceptor waits between attempts.
+const maxRetryBackoff = 10 * time.Second
+
+// requestInterceptor implements connect.Interceptor and is used to bound how
+// long unary requests may take, and to retry unary requests that fail because
+// the server is unavailable. Streaming requests are unaffected, since they are
+// expected to be long-lived.
+type requestInterceptor struct {
+	// timeout is the maximum amount of time a request may take, including all of
+	// its retries. Zero means no timeout.
+	timeout time.Duration
+	// retries is the number of times a request that failed with
+	// connect.CodeUnavailable is retried before giving up.
+	retries int
+	// backoff is how long to wait before the first retry. The wait doubles with
+	// each subsequent retry, up to maxRetryBackoff.
+	backoff time.Duration
+}
+
+func (r *requestInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if r.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, r.timeout)
+			defer cancel()
+		}
+		backoff := r.backoff
+		for attempt := 0; ; attempt++ {
+			res, err := next(ctx, req)
+			if err == nil || attempt >= r.retries ||
+				connect.CodeOf(err) != connect.CodeUnavailable {
+				return res, err
+			}
+			select {
+			case <-ctx.Done():
+				return nil, err
+			case <-time.After(backoff):
+			}
+			if backoff *= 2; backoff > maxRetryBackoff {
+				backoff = maxRetryBackoff
+			}
+		}
+	}
+}
+
+func (r *requestInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	// This is a no-op because streaming requests are expected to be long-lived.
+	return next
+}
+
+func (r *requestInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	// This is a no-op because this interceptor is only used with clients.
+	return next
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestRequestInterceptorWrapUnary(t *testing.T) {
+	testCases := []struct {
+		name        string
+		interceptor *requestInterceptor
+		errs        []error
+		assertions  func(attempts int, err error)
+	}{
+		{
+			name:        "success",
+			interceptor: &requestInterceptor{retries: 2},
+			errs:        []error{nil},
+			assertions: func(attempts int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, attempts)
+			},
+		},
+		{
+			name:        "error other than unavailable is not retried",
+			interceptor: &requestInterceptor{retries: 2},
+			errs: []error{
+				connect.NewError(connect.CodeNotFound, errors.New("something went wrong")),
+			},
+			assertions: func(attempts int, err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+				require.Equal(t, 1, attempts)
+			},
+		},
+		{
+			name:        "unavailable is retried until success",
+			interceptor: &requestInterceptor{retries: 2, backoff: time.Millisecond},
+			errs: []error{
+				connect.NewError(connect.CodeUnavailable, errors.New("something went wrong")),
+				connect.NewError(connect.CodeUnavailable, errors.New("something went wrong")),
+				nil,
+			},
+			assertions: func(attempts int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 3, attempts)
+			},
+		},
+		{
+			name:        "retries exhausted",
+			interceptor: &requestInterceptor{retries: 1, backoff: time.Millisecond},
+			errs: []error{
+				connect.NewError(connect.CodeUnavailable, errors.New("something went wrong")),
+				connect.NewError(connect.CodeUnavailable, errors.New("something went wrong")),
+				nil,
+			},
+			assertions: func(attempts int, err error) {
+				require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+				require.Equal(t, 2, attempts)
+			},
+		},
+		{
+			name: "timeout elapses while waiting to retry",
+			interceptor: &requestInterceptor{
+				timeout: 10 * time.Millisecond,
+				retries: 1,
+				backoff: time.Minute,
+			},
+			errs: []error{
+				connect.NewError(connect.CodeUnavailable, errors.New("something went wrong")),
+				nil,
+			},
+			assertions: func(attempts int, err error) {
+				require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+				require.Equal(t, 1, attempts)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var attempts int
+			_, err := testCase.interceptor.WrapUnary(
+				func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+					err := testCase.errs[attempts]
+					attempts++
+					if err != nil {
+						return nil, err
+					}
+					return connect.NewResponse(&svcv1alpha1.GetVersionInfoResponse{}), nil
+				},
+			)(
+				context.Background(),
+				connect.NewRequest(&svcv1alpha1.GetVersionInfoRequest{}),
+			)
+			testCase.assertions(attempts, err)
+		})
+	}
+}
@@ -105,7 +105,7 @@ func redeemRefreshToken(
 	refreshToken string,
 	insecureTLS bool,
 ) (string, string, error) {
-	client := GetClient(serverAddress, "", insecureTLS)
+	client := GetClient(serverAddress, "", nil, insecureTLS)
 
 	res, err := client.GetPublicConfig(
 		ctx,
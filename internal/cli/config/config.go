@@ -59,6 +59,14 @@ type CLIConfig struct {
 	// re-authenticates. When true, refresh tokens will not be used, thereby
 	// forcing users to periodically re-assess this choice.
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// ClientCertificatePath is the path to a client certificate used to
+	// authenticate with the Kargo API server using TLS client authentication.
+	// This is an alternative to the BearerToken for environments that prohibit
+	// static tokens.
+	ClientCertificatePath string `json:"clientCertificate,omitempty"`
+	// ClientKeyPath is the path to the private key corresponding to the client
+	// certificate referenced by ClientCertificatePath.
+	ClientKeyPath string `json:"clientKey,omitempty"`
 }
 
 // LoadCLIConfig loads Kargo CLI configuration from a file in the Kargo home
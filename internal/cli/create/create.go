@@ -2,7 +2,6 @@ package create
 
 import (
 	goerrors "errors"
-	"fmt"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
@@ -33,6 +32,9 @@ kargo create -f stage.yaml
 
 # Create project
 kargo create project my-project
+
+# Create a read-only token for use by dashboards
+kargo create viewer-token
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -78,8 +80,7 @@ kargo create project my-project
 			for _, r := range successRes {
 				var obj unstructured.Unstructured
 				if err := sigyaml.Unmarshal(r.CreatedResourceManifest, &obj); err != nil {
-					fmt.Fprintf(opt.IOStreams.ErrOut, "%s",
-						errors.Wrap(err, "Error: unmarshal created manifest"))
+					opt.ErrPrinter().Error(errors.Wrap(err, "unmarshal created manifest"))
 					continue
 				}
 				if printer == nil {
@@ -87,7 +88,7 @@ kargo create project my-project
 						Namespace: obj.GetNamespace(),
 						Name:      obj.GetName(),
 					}.String()
-					fmt.Fprintf(opt.IOStreams.Out, "%s Created: %q\n", obj.GetKind(), name)
+					opt.Printer().Successf("%s Created: %q\n", obj.GetKind(), name)
 					continue
 				}
 				_ = printer.PrintObj(&obj, opt.IOStreams.Out)
@@ -100,5 +101,6 @@ kargo create project my-project
 
 	// Subcommands
 	cmd.AddCommand(newProjectCommand(opt))
+	cmd.AddCommand(newViewerTokenCommand(opt))
 	return cmd
 }
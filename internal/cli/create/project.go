@@ -1,7 +1,6 @@
 package create
 
 import (
-	"fmt"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -53,7 +52,7 @@ kargo create project my-project
 			project.SetName(resp.Msg.GetProject().GetName())
 
 			if pointer.StringDeref(opt.PrintFlags.OutputFormat, "") == "" {
-				_, _ = fmt.Fprintf(opt.IOStreams.Out, "Project Created: %q\n", name)
+				opt.Printer().Successf("Project Created: %q\n", name)
 				return nil
 			}
 			printer, err := opt.PrintFlags.ToPrinter()
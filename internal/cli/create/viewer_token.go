@@ -0,0 +1,52 @@
+package create
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/pointer"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	kargosvcapi "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newViewerTokenCommand(opt *option.Option) *cobra.Command {
+	var ttl string
+	cmd := &cobra.Command{
+		Use:   "viewer-token [--ttl=ttl]",
+		Short: "Create a read-only token for use by dashboards",
+		Args:  option.ExactArgs(0),
+		Example: `
+# Create a viewer token using the server's default TTL
+kargo create viewer-token
+
+# Create a viewer token that is valid for one week
+kargo create viewer-token --ttl=168h
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			req := &kargosvcapi.CreateViewerTokenRequest{}
+			if ttl != "" {
+				req.Ttl = pointer.String(ttl)
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			resp, err :=
+				kargoSvcCli.CreateViewerToken(ctx, connect.NewRequest(req))
+			if err != nil {
+				return errors.Wrap(err, "create viewer token")
+			}
+			_, _ = fmt.Fprintln(opt.IOStreams.Out, resp.Msg.GetIdToken())
+			return nil
+		},
+	}
+	option.TTL(&ttl)(cmd.Flags())
+	return cmd
+}
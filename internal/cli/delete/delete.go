@@ -2,7 +2,6 @@ package delete
 
 import (
 	goerrors "errors"
-	"fmt"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -14,6 +13,7 @@ import (
 
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
 	kargosvcapi "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -60,32 +60,39 @@ kargo delete -f stage.yaml
 			}
 
 			resCap := len(resp.Msg.GetResults())
-			successRes := make([]*kargosvcapi.DeleteResourceResult_DeletedResourceManifest, 0, resCap)
+			results := make([]output.Result, 0, resCap)
 			deleteErrs := make([]error, 0, resCap)
 			for _, r := range resp.Msg.GetResults() {
 				switch typedRes := r.GetResult().(type) {
 				case *kargosvcapi.DeleteResourceResult_DeletedResourceManifest:
-					successRes = append(successRes, typedRes)
+					var obj unstructured.Unstructured
+					if err := sigyaml.Unmarshal(typedRes.DeletedResourceManifest, &obj); err != nil {
+						opt.ErrPrinter().Error(errors.Wrap(err, "unmarshal deleted manifest"))
+						continue
+					}
+					results = append(results, output.Result{
+						Kind: obj.GetKind(),
+						Name: strings.TrimLeft(types.NamespacedName{
+							Namespace: obj.GetNamespace(),
+							Name:      obj.GetName(),
+						}.String(), "/"),
+						Status: "Deleted",
+					})
 				case *kargosvcapi.DeleteResourceResult_Error:
 					deleteErrs = append(deleteErrs, errors.New(typedRes.Error))
+					results = append(results, output.Result{
+						Status: output.ResultStatusFailed,
+						Error:  typedRes.Error,
+					})
 				}
 			}
-			for _, r := range successRes {
-				var obj unstructured.Unstructured
-				if err := sigyaml.Unmarshal(r.DeletedResourceManifest, &obj); err != nil {
-					fmt.Fprintf(opt.IOStreams.ErrOut, "%s",
-						errors.Wrap(err, "Error: unmarshal deleted manifest"))
-					continue
-				}
-				name := strings.TrimLeft(types.NamespacedName{
-					Namespace: obj.GetNamespace(),
-					Name:      obj.GetName(),
-				}.String(), "/")
-				fmt.Fprintf(opt.IOStreams.Out, "%s Deleted: %q\n", obj.GetKind(), name)
+			if err := opt.PrintResults(results); err != nil {
+				return errors.Wrap(err, "print results")
 			}
 			return goerrors.Join(deleteErrs...)
 		},
 	}
+	opt.PrintFlags.AddFlags(cmd)
 	option.Filenames("delete", &flag.Filenames)(cmd.Flags())
 
 	// Subcommands
@@ -2,7 +2,6 @@ package delete
 
 import (
 	goerrors "errors"
-	"fmt"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
@@ -11,6 +10,7 @@ import (
 
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -31,14 +31,29 @@ kargo delete project my-project
 			}
 
 			var resErr error
-			for _, name := range slices.Compact(args) {
+			names := slices.Compact(args)
+			results := make([]output.Result, 0, len(names))
+			for _, name := range names {
 				if _, err := kargoSvcCli.DeleteProject(ctx, connect.NewRequest(&v1alpha1.DeleteProjectRequest{
 					Name: name,
 				})); err != nil {
 					resErr = goerrors.Join(resErr, errors.Wrap(err, "Error"))
+					results = append(results, output.Result{
+						Kind:   "Project",
+						Name:   name,
+						Status: output.ResultStatusFailed,
+						Error:  err.Error(),
+					})
 					continue
 				}
-				_, _ = fmt.Fprintf(opt.IOStreams.Out, "Project Deleted: %q\n", name)
+				results = append(results, output.Result{
+					Kind:   "Project",
+					Name:   name,
+					Status: "Deleted",
+				})
+			}
+			if err := opt.PrintResults(results); err != nil {
+				return errors.Wrap(err, "print results")
 			}
 			return resErr
 		},
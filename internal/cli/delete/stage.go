@@ -2,7 +2,6 @@ package delete
 
 import (
 	goerrors "errors"
-	"fmt"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
@@ -11,6 +10,7 @@ import (
 
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -22,6 +22,9 @@ func newStageCommand(opt *option.Option) *cobra.Command {
 		Example: `
 # Delete stage
 kargo delete stage --project=my-project my-stage
+
+# Delete stages and print the outcome for each as JSON
+kargo delete stage --project=my-project my-stage another-stage -o json
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -36,15 +39,30 @@ kargo delete stage --project=my-project my-stage
 			}
 
 			var resErr error
-			for _, name := range slices.Compact(args) {
+			names := slices.Compact(args)
+			results := make([]output.Result, 0, len(names))
+			for _, name := range names {
 				if _, err := kargoSvcCli.DeleteStage(ctx, connect.NewRequest(&v1alpha1.DeleteStageRequest{
 					Project: project,
 					Name:    name,
 				})); err != nil {
 					resErr = goerrors.Join(resErr, errors.Wrap(err, "Error"))
+					results = append(results, output.Result{
+						Kind:   "Stage",
+						Name:   name,
+						Status: output.ResultStatusFailed,
+						Error:  err.Error(),
+					})
 					continue
 				}
-				_, _ = fmt.Fprintf(opt.IOStreams.Out, "Stage Deleted: %q\n", name)
+				results = append(results, output.Result{
+					Kind:   "Stage",
+					Name:   name,
+					Status: "Deleted",
+				})
+			}
+			if err := opt.PrintResults(results); err != nil {
+				return errors.Wrap(err, "print results")
 			}
 			return resErr
 		},
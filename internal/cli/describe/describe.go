@@ -0,0 +1,21 @@
+package describe
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe (RESOURCE) (NAME)",
+		Short: "Show details of a resource",
+		Example: `
+# Show details of a promotion, including what it changes
+kargo describe promotion --project=my-project some-promotion
+`,
+	}
+	// Subcommands
+	cmd.AddCommand(newDescribePromotionCommand(opt))
+	return cmd
+}
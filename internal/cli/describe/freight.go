@@ -0,0 +1,86 @@
+package describe
+
+import (
+	"sort"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/output"
+)
+
+// PrintFreight prints the commits, images, and charts that a piece of Freight
+// references, and the Stages it has been qualified and approved for.
+func PrintFreight(p *output.Printer, freight *kargoapi.Freight) {
+	p.Printf("Name:     %s\n", freight.Name)
+	if freight.ID != "" && freight.ID != freight.Name {
+		p.Printf("ID:       %s\n", freight.ID)
+	}
+	if freight.Alias != "" {
+		p.Printf("Alias:    %s\n", freight.Alias)
+	}
+	p.Printf("\n")
+	printArtifacts(p, freight.Commits, freight.Images, freight.Charts, freight.Status.BuildInfo)
+
+	qualified := make([]string, 0, len(freight.Status.Qualifications))
+	for stage := range freight.Status.Qualifications {
+		qualified = append(qualified, stage)
+	}
+	sort.Strings(qualified)
+	approved := make([]string, 0, len(freight.Status.Approvals))
+	for stage, approval := range freight.Status.Approvals {
+		if approval.Approver != "" {
+			stage += " (by " + approval.Approver + ")"
+		}
+		approved = append(approved, stage)
+	}
+	sort.Strings(approved)
+	p.Printf("Stages:\n")
+	p.Printf("  Qualified for:\n")
+	printList(p, qualified)
+	p.Printf("  Approved for:\n")
+	printList(p, approved)
+}
+
+// printArtifacts prints the provided commits, images, and charts, and any
+// builds that have been reported for them.
+func printArtifacts(
+	p *output.Printer,
+	commits []kargoapi.GitCommit,
+	images []kargoapi.Image,
+	charts []kargoapi.Chart,
+	builds []kargoapi.BuildInfo,
+) {
+	items := make([]string, len(commits))
+	for i, commit := range commits {
+		items[i] = commit.RepoURL + "@" + commit.ID
+		if commit.Branch != "" {
+			items[i] += " (branch " + commit.Branch + ")"
+		}
+	}
+	p.Printf("Artifacts:\n")
+	p.Printf("  Commits:\n")
+	printList(p, items)
+	items = make([]string, len(images))
+	for i, image := range images {
+		items[i] = image.RepoURL + ":" + image.Tag
+	}
+	p.Printf("  Images:\n")
+	printList(p, items)
+	items = make([]string, len(charts))
+	for i, chart := range charts {
+		items[i] = chart.RegistryURL + "/" + chart.Name + ":" + chart.Version
+	}
+	p.Printf("  Charts:\n")
+	printList(p, items)
+	if len(builds) == 0 {
+		return
+	}
+	items = make([]string, len(builds))
+	for i, build := range builds {
+		items[i] = build.RepoURL + "@" + build.Revision
+		if build.PipelineURL != "" {
+			items[i] += " (" + build.PipelineURL + ")"
+		}
+	}
+	p.Printf("  Builds:\n")
+	printList(p, items)
+}
@@ -0,0 +1,109 @@
+package describe
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/output"
+)
+
+func TestPrintFreight(t *testing.T) {
+	testCases := []struct {
+		name     string
+		freight  *kargoapi.Freight
+		expected string
+	}{
+		{
+			name: "nothing qualified or approved",
+			freight: &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+				ID:         "fake-freight",
+				Images: []kargoapi.Image{
+					{RepoURL: "nginx", Tag: "1.25.0"},
+				},
+			},
+			expected: `Name:     fake-freight
+
+Artifacts:
+  Commits:
+    (none)
+  Images:
+    - nginx:1.25.0
+  Charts:
+    (none)
+Stages:
+  Qualified for:
+    (none)
+  Approved for:
+    (none)
+`,
+		},
+		{
+			name: "qualified, approved, and built",
+			freight: &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+				ID:         "fake-id",
+				Alias:      "wonky-wombat",
+				Commits: []kargoapi.GitCommit{
+					{
+						RepoURL: "https://github.com/example/config",
+						ID:      "3f4e2a1",
+						Branch:  "main",
+					},
+				},
+				Charts: []kargoapi.Chart{
+					{RegistryURL: "oci://ghcr.io/example/charts", Name: "redis", Version: "1.0.0"},
+				},
+				Status: kargoapi.FreightStatus{
+					Qualifications: map[string]kargoapi.Qualification{
+						"test": {},
+						"uat":  {},
+					},
+					Approvals: map[string]kargoapi.Approval{
+						"prod":    {Approver: "alice"},
+						"staging": {},
+					},
+					BuildInfo: []kargoapi.BuildInfo{
+						{
+							RepoURL:     "https://github.com/example/app",
+							Revision:    "1a2b3c4",
+							PipelineURL: "https://ci.example.com/runs/42",
+						},
+					},
+				},
+			},
+			expected: `Name:     fake-freight
+ID:       fake-id
+Alias:    wonky-wombat
+
+Artifacts:
+  Commits:
+    - https://github.com/example/config@3f4e2a1 (branch main)
+  Images:
+    (none)
+  Charts:
+    - oci://ghcr.io/example/charts/redis:1.0.0
+  Builds:
+    - https://github.com/example/app@1a2b3c4 (https://ci.example.com/runs/42)
+Stages:
+  Qualified for:
+    - test
+    - uat
+  Approved for:
+    - prod (by alice)
+    - staging
+`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			PrintFreight(output.NewPrinter(out, true), testCase.freight)
+			require.Equal(t, testCase.expected, out.String())
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package describe
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newDescribePromotionCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "promotion --project=project (NAME)",
+		Aliases: []string{"promotions", "promos", "promo"},
+		Short:   "Show details of a promotion",
+		Args:    option.ExactArgs(1),
+		Example: `
+# Show details of a promotion, including what it changes
+kargo describe promotion --project=my-project some-promotion
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project.OrElse("")
+			if project == "" {
+				return errors.New("project is required")
+			}
+			name := strings.TrimSpace(args[0])
+			if name == "" {
+				return errors.New("name is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			res, err := kargoSvcCli.GetPromotion(
+				ctx,
+				connect.NewRequest(&v1alpha1.GetPromotionRequest{
+					Project: project,
+					Name:    name,
+				}),
+			)
+			if err != nil {
+				return errors.Wrap(err, "get promotion")
+			}
+			PrintPromotion(
+				opt.Printer(),
+				typesv1alpha1.FromPromotionProto(res.Msg.GetPromotion()),
+			)
+			return nil
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	return cmd
+}
+
+// PrintPromotion prints the Stage and Freight of a Promotion, its progress,
+// and what it changes.
+func PrintPromotion(p *output.Printer, promo *kargoapi.Promotion) {
+	p.Printf("Name:     %s\n", promo.Name)
+	p.Printf("Stage:    %s\n", promo.Spec.Stage)
+	freight := promo.Spec.Freight
+	if promo.Spec.FreightProject != "" {
+		freight = promo.Spec.FreightProject + "/" + freight
+	}
+	p.Printf("Freight:  %s\n", freight)
+	p.Printf("Phase:    %s\n", p.Status(string(promo.Status.Phase)))
+	if promo.Status.Error != "" {
+		p.Printf("Error:    %s\n", promo.Status.Error)
+	}
+	if promo.Status.FailureReason != "" {
+		p.Printf("Reason:   %s\n", promo.Status.FailureReason)
+	}
+	if promo.Status.LogURL != "" {
+		p.Printf("Log:      %s\n", promo.Status.LogURL)
+	}
+	p.Printf("\n")
+	if promo.Status.Impact == nil {
+		p.Printf("Impact: not yet recorded\n")
+		return
+	}
+	PrintImpact(p, promo.Status.Impact)
+}
+
+// PrintImpact prints the Git repositories, Argo CD Applications, and clusters
+// that a Promotion changes.
+func PrintImpact(p *output.Printer, impact *kargoapi.PromotionImpact) {
+	p.Printf("Impact:\n")
+	p.Printf("  Git repositories:\n")
+	printList(p, impact.Repos)
+	apps := make([]string, len(impact.ArgoCDApps))
+	for i, app := range impact.ArgoCDApps {
+		apps[i] = app.Namespace + "/" + app.Name
+		if app.Cluster != "" {
+			apps[i] += " (cluster " + app.Cluster + ")"
+		}
+	}
+	p.Printf("  Argo CD Applications:\n")
+	printList(p, apps)
+	p.Printf("  Clusters:\n")
+	printList(p, impact.Clusters)
+}
+
+func printList(p *output.Printer, items []string) {
+	if len(items) == 0 {
+		p.Printf("    (none)\n")
+		return
+	}
+	for _, item := range items {
+		p.Printf("    - %s\n", item)
+	}
+}
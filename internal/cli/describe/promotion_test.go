@@ -0,0 +1,89 @@
+package describe
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/output"
+)
+
+func TestPrintPromotion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		promo    *kargoapi.Promotion
+		expected string
+	}{
+		{
+			name: "impact not yet recorded",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promo"},
+				Spec: &kargoapi.PromotionSpec{
+					Stage:   "test",
+					Freight: "fake-freight",
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhasePending,
+				},
+			},
+			expected: `Name:     fake-promo
+Stage:    test
+Freight:  fake-freight
+Phase:    Pending
+
+Impact: not yet recorded
+`,
+		},
+		{
+			name: "impact recorded",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promo"},
+				Spec: &kargoapi.PromotionSpec{
+					Stage:          "test",
+					Freight:        "fake-freight",
+					FreightProject: "other-project",
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase:         kargoapi.PromotionPhaseErrored,
+					Error:         "something went wrong",
+					FailureReason: kargoapi.PromotionFailureReasonUnknown,
+					LogURL:        "s3://fake-bucket/fake-log.txt",
+					Impact: &kargoapi.PromotionImpact{
+						ArgoCDApps: []kargoapi.ArgoCDAppReference{
+							{Namespace: "argocd", Name: "app-1", Cluster: "in-cluster"},
+							{Namespace: "argocd", Name: "app-2"},
+						},
+						Clusters: []string{"in-cluster"},
+					},
+				},
+			},
+			expected: `Name:     fake-promo
+Stage:    test
+Freight:  other-project/fake-freight
+Phase:    Errored
+Error:    something went wrong
+Reason:   Unknown
+Log:      s3://fake-bucket/fake-log.txt
+
+Impact:
+  Git repositories:
+    (none)
+  Argo CD Applications:
+    - argocd/app-1 (cluster in-cluster)
+    - argocd/app-2
+  Clusters:
+    - in-cluster
+`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			PrintPromotion(output.NewPrinter(out, true), testCase.promo)
+			require.Equal(t, testCase.expected, out.String())
+		})
+	}
+}
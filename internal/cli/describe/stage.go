@@ -0,0 +1,82 @@
+package describe
+
+import (
+	"sort"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/output"
+)
+
+// PrintStage prints where a Stage gets its Freight from, its health, the
+// Freight it is currently using, and the outcomes of verifying that Freight.
+func PrintStage(p *output.Printer, stage *kargoapi.Stage) {
+	p.Printf("Name:     %s\n", stage.Name)
+	if stage.Spec != nil && stage.Spec.Subscriptions != nil {
+		subs := stage.Spec.Subscriptions
+		if subs.Warehouse != "" {
+			p.Printf("Source:   warehouse %s\n", subs.Warehouse)
+		} else if len(subs.UpstreamStages) > 0 {
+			upstreams := make([]string, len(subs.UpstreamStages))
+			for i, upstream := range subs.UpstreamStages {
+				upstreams[i] = upstream.Name
+			}
+			p.Printf("Source:   stages %s\n", strings.Join(upstreams, ", "))
+		}
+	}
+	health := kargoapi.HealthStateUnknown
+	if stage.Status.Health != nil && stage.Status.Health.Status != "" {
+		health = stage.Status.Health.Status
+	}
+	p.Printf("Health:   %s\n", p.Status(string(health)))
+	if stage.Status.Error != "" {
+		p.Printf("Error:    %s\n", stage.Status.Error)
+	}
+	if promo := stage.Status.CurrentPromotion; promo != nil {
+		p.Printf("Promotion in progress: %s (freight %s)\n", promo.Name, promo.Freight.ID)
+	}
+	if stage.Status.Health != nil && len(stage.Status.Health.Issues) > 0 {
+		p.Printf("Issues:\n")
+		for _, issue := range stage.Status.Health.Issues {
+			p.Printf("  - %s\n", issue)
+		}
+	}
+	p.Printf("\n")
+
+	freight := stage.Status.CurrentFreight
+	if freight == nil {
+		p.Printf("Current freight: none\n")
+		return
+	}
+	p.Printf("Current freight: %s\n", freight.ID)
+	printArtifacts(p, freight.Commits, freight.Images, freight.Charts, nil)
+
+	p.Printf("Verifications:\n")
+	var verified bool
+	for _, v := range stage.Status.Verifications {
+		// Only the outcomes of verifying the current Freight are of interest
+		if v.Freight != freight.ID {
+			continue
+		}
+		verified = true
+		outcome := p.Colorize(output.StateHealthy, "Passed")
+		if !v.Passed {
+			outcome = p.Colorize(output.StateUnhealthy, "Failed")
+		}
+		p.Printf("  %s: %s\n", v.Provider, outcome)
+		if v.Message != "" {
+			p.Printf("    %s\n", v.Message)
+		}
+		names := make([]string, 0, len(v.ArtifactURLs))
+		for name := range v.ArtifactURLs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			p.Printf("    %s: %s\n", name, v.ArtifactURLs[name])
+		}
+	}
+	if !verified {
+		p.Printf("  (none)\n")
+	}
+}
@@ -0,0 +1,120 @@
+package describe
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/output"
+)
+
+func TestPrintStage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stage    *kargoapi.Stage
+		expected string
+	}{
+		{
+			name: "no current freight",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+				},
+			},
+			expected: `Name:     test
+Source:   warehouse fake-warehouse
+Health:   Unknown
+
+Current freight: none
+`,
+		},
+		{
+			name: "current freight verified",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{Name: "uat"},
+							{Name: "staging"},
+						},
+					},
+				},
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateUnhealthy,
+						Issues: []string{"something went wrong"},
+					},
+					Error: "something else went wrong",
+					CurrentPromotion: &kargoapi.PromotionInfo{
+						Name:    "fake-promo",
+						Freight: kargoapi.SimpleFreight{ID: "new-freight"},
+					},
+					CurrentFreight: &kargoapi.SimpleFreight{
+						ID: "fake-freight",
+						Images: []kargoapi.Image{
+							{RepoURL: "nginx", Tag: "1.25.0"},
+						},
+					},
+					Verifications: []kargoapi.VerificationResult{
+						{
+							Provider: "fake-provider",
+							Freight:  "older-freight",
+							Passed:   true,
+						},
+						{
+							Provider: "fake-provider",
+							Freight:  "fake-freight",
+							Message:  "smoke tests failed",
+							ArtifactURLs: map[string]string{
+								"log":     "s3://fake-bucket/log.txt",
+								"results": "s3://fake-bucket/results.xml",
+							},
+						},
+						{
+							Provider: "other-provider",
+							Freight:  "fake-freight",
+							Passed:   true,
+						},
+					},
+				},
+			},
+			expected: `Name:     prod
+Source:   stages uat, staging
+Health:   Unhealthy
+Error:    something else went wrong
+Promotion in progress: fake-promo (freight new-freight)
+Issues:
+  - something went wrong
+
+Current freight: fake-freight
+Artifacts:
+  Commits:
+    (none)
+  Images:
+    - nginx:1.25.0
+  Charts:
+    (none)
+Verifications:
+  fake-provider: Failed
+    smoke tests failed
+    log: s3://fake-bucket/log.txt
+    results: s3://fake-bucket/results.xml
+  other-provider: Passed
+`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			PrintStage(output.NewPrinter(out, true), testCase.stage)
+			require.Equal(t, testCase.expected, out.String())
+		})
+	}
+}
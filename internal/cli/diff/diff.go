@@ -0,0 +1,16 @@
+package diff
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Summarize differences between resources",
+	}
+	cmd.AddCommand(newFreightCommand(opt))
+	return cmd
+}
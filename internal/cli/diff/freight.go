@@ -0,0 +1,138 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type FreightFlags struct {
+	RepoURL string
+	Paths   []string
+}
+
+func newFreightCommand(opt *option.Option) *cobra.Command {
+	var flag FreightFlags
+	cmd := &cobra.Command{
+		Use:   "freight --project=project (FROM) (TO)",
+		Short: "Summarize the changes to a Git repository between two pieces of freight",
+		Args:  option.ExactArgs(2),
+		Example: `
+# Summarize the changes between two pieces of freight
+kargo diff freight --project=my-project old-freight new-freight
+
+# Summarize the changes to chart values only
+kargo diff freight --project=my-project --path=charts/my-app/values.yaml \
+  old-freight new-freight
+
+# Select a repository when both pieces of freight reference several in common
+kargo diff freight --project=my-project \
+  --repo-url=https://github.com/example/config old-freight new-freight
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project.OrElse("")
+			if project == "" {
+				return errors.New("project is required")
+			}
+			from := strings.TrimSpace(args[0])
+			to := strings.TrimSpace(args[1])
+			if from == "" || to == "" {
+				return errors.New("freight is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			res, err := kargoSvcCli.DiffFreight(ctx, connect.NewRequest(&v1alpha1.DiffFreightRequest{
+				Project: project,
+				From:    from,
+				To:      to,
+				RepoUrl: flag.RepoURL,
+				Paths:   flag.Paths,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "diff freight")
+			}
+			return printFileDiffs(opt.Printer(), res.Msg)
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	cmd.Flags().StringVar(&flag.RepoURL, "repo-url", "",
+		"URL of the Git repository to diff, if the freight reference more than one in common")
+	cmd.Flags().StringSliceVar(&flag.Paths, "path", nil,
+		"Restrict the diff to the specified paths, relative to the root of the repository")
+	return cmd
+}
+
+// fileStatusStates maps the status of a changed file to the State it is
+// rendered as.
+var fileStatusStates = map[string]output.State{
+	"Added":       output.StateHealthy,
+	"Deleted":     output.StateUnhealthy,
+	"Modified":    output.StateProgressing,
+	"TypeChanged": output.StateProgressing,
+}
+
+func printFileDiffs(p *output.Printer, res *v1alpha1.DiffFreightResponse) error {
+	p.Printf(
+		"%s %s..%s\n",
+		res.GetRepoUrl(),
+		shortCommit(res.GetFromCommit()),
+		shortCommit(res.GetToCommit()),
+	)
+	if len(res.GetFiles()) == 0 {
+		p.Printf("No changes\n")
+		return nil
+	}
+	var additions, deletions int32
+	rows := make([][]output.Cell, len(res.GetFiles()))
+	for i, file := range res.GetFiles() {
+		changes := "binary"
+		if !file.GetBinary() {
+			changes = fmt.Sprintf("+%d -%d", file.GetAdditions(), file.GetDeletions())
+			additions += file.GetAdditions()
+			deletions += file.GetDeletions()
+		}
+		rows[i] = []output.Cell{
+			{Text: file.GetStatus(), State: fileStatusStates[file.GetStatus()]},
+			{Text: file.GetPath()},
+			{Text: changes},
+		}
+	}
+	if err := p.Table(
+		[]output.Column{
+			{Name: "Status", Stateful: true},
+			{Name: "Path"},
+			{Name: "Changes"},
+		},
+		rows,
+	); err != nil {
+		return err
+	}
+	p.Printf(
+		"%d file(s) changed, %d insertion(s), %d deletion(s)\n",
+		len(res.GetFiles()),
+		additions,
+		deletions,
+	)
+	return nil
+}
+
+// shortCommit abbreviates the provided commit ID the way Git does.
+func shortCommit(id string) string {
+	if len(id) > 7 {
+		return id[:7]
+	}
+	return id
+}
@@ -0,0 +1,114 @@
+package explain
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type FreightFlags struct {
+	RepoURL string
+}
+
+func NewFreightCommand(opt *option.Option) *cobra.Command {
+	var flag FreightFlags
+	cmd := &cobra.Command{
+		Use:   "explain-freight --project=project (REVISION)",
+		Short: "Explain whether and why an image tag, chart version, or commit became freight",
+		Args:  option.ExactArgs(1),
+		Example: `
+# Explain what became of an image tag
+kargo explain-freight --project=my-project 1.25.0
+
+# Explain what became of a commit in a specific Git repository
+kargo explain-freight --project=my-project \
+  --repo-url=https://github.com/example/config 3f4e2a1
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project.OrElse("")
+			if project == "" {
+				return errors.New("project is required")
+			}
+			revision := strings.TrimSpace(args[0])
+			if revision == "" {
+				return errors.New("revision is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			res, err := kargoSvcCli.ExplainDiscovery(ctx, connect.NewRequest(&v1alpha1.ExplainDiscoveryRequest{
+				Project:  project,
+				Revision: revision,
+				RepoUrl:  flag.RepoURL,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "explain discovery")
+			}
+			if len(res.Msg.GetExplanations()) == 0 {
+				if flag.RepoURL != "" {
+					return errors.Errorf(
+						"no warehouse in project %q subscribes to %q", project, flag.RepoURL)
+				}
+				return errors.Errorf("no warehouse in project %q has any subscriptions", project)
+			}
+			printExplanations(opt.Printer(), res.Msg.GetExplanations())
+			return nil
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	cmd.Flags().StringVar(&flag.RepoURL, "repo-url", "",
+		"URL of the image repository, Git repository, or chart registry the revision belongs to")
+	return cmd
+}
+
+// outcomeStates maps the outcome of an explanation to the State it is rendered
+// as.
+var outcomeStates = map[string]output.State{
+	"Discovered":    output.StateHealthy,
+	"Rejected":      output.StateUnhealthy,
+	"NotDiscovered": output.StateUnknown,
+}
+
+func printExplanations(p *output.Printer, explanations []*v1alpha1.DiscoveryExplanation) {
+	for i, e := range explanations {
+		if i > 0 {
+			p.Printf("\n")
+		}
+		repo := e.GetRepoUrl()
+		if e.GetChart() != "" {
+			repo += " (chart " + e.GetChart() + ")"
+		}
+		p.Printf(
+			"Warehouse %s, %s: %s\n",
+			e.GetWarehouse(),
+			repo,
+			p.Colorize(outcomeStates[e.GetOutcome()], e.GetOutcome()),
+		)
+		p.Printf("  %s\n", e.GetReason())
+		if e.GetAlias() != "" {
+			p.Printf("  Alias:         %s\n", e.GetAlias())
+		}
+		if e.GetOutcome() == "Discovered" {
+			p.Printf("  Qualified for: %s\n", listOrNone(e.GetQualifiedStages()))
+			p.Printf("  Approved for:  %s\n", listOrNone(e.GetApprovedStages()))
+		}
+	}
+}
+
+func listOrNone(items []string) string {
+	if len(items) == 0 {
+		return "<none>"
+	}
+	return strings.Join(items, ", ")
+}
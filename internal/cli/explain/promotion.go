@@ -0,0 +1,109 @@
+package explain
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/describe"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type PromotionFlags struct {
+	Stage option.Optional[string]
+}
+
+func NewPromotionCommand(opt *option.Option) *cobra.Command {
+	flag := PromotionFlags{
+		Stage: option.OptionalString(),
+	}
+	cmd := &cobra.Command{
+		Use:   "explain-promotion --project=project --stage=stage (FREIGHT)",
+		Short: "Explain whether and why a piece of freight can or cannot be promoted to a stage",
+		Args:  option.ExactArgs(1),
+		Example: `
+# Explain what is blocking a piece of freight from being promoted to a stage
+kargo explain-promotion --project=my-project --stage=prod my-freight
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project.OrElse("")
+			if project == "" {
+				return errors.New("project is required")
+			}
+			stage := strings.TrimSpace(flag.Stage.OrElse(""))
+			if stage == "" {
+				return errors.New("stage is required")
+			}
+			freight := strings.TrimSpace(args[0])
+			if freight == "" {
+				return errors.New("freight is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			res, err := kargoSvcCli.ExplainPromotionEligibility(
+				ctx,
+				connect.NewRequest(&v1alpha1.ExplainPromotionEligibilityRequest{
+					Project: project,
+					Stage:   stage,
+					Freight: freight,
+				}),
+			)
+			if err != nil {
+				return errors.Wrap(err, "explain promotion eligibility")
+			}
+			printer := opt.Printer()
+			printGates(printer, freight, stage, res.Msg)
+			if impact := res.Msg.GetImpact(); impact != nil {
+				printer.Printf("\n")
+				describe.PrintImpact(printer, typesv1alpha1.FromPromotionImpactProto(impact))
+			}
+			return nil
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	option.OptionalStage(flag.Stage)(cmd.Flags())
+	return cmd
+}
+
+// gateStates maps the status of a promotion gate to the State it is rendered
+// as.
+var gateStates = map[string]output.State{
+	"Passed":       output.StateHealthy,
+	"Blocked":      output.StateUnhealthy,
+	"NotEvaluated": output.StateUnknown,
+}
+
+func printGates(
+	p *output.Printer,
+	freight string,
+	stage string,
+	res *v1alpha1.ExplainPromotionEligibilityResponse,
+) {
+	if res.GetEligible() {
+		p.Printf("Freight %s can be promoted to stage %s\n\n", freight, stage)
+	} else {
+		p.Printf("Freight %s cannot be promoted to stage %s\n\n", freight, stage)
+	}
+	for _, gate := range res.GetGates() {
+		p.Printf(
+			"%s: %s\n",
+			gate.GetName(),
+			p.Colorize(gateStates[gate.GetStatus()], gate.GetStatus()),
+		)
+		p.Printf("  %s\n", gate.GetReason())
+		for _, detail := range gate.GetDetails() {
+			p.Printf("  - %s\n", detail)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package export
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/operation"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export resources",
+	}
+	cmd.AddCommand(newProjectCommand(opt))
+	return cmd
+}
+
+type projectFlags struct {
+	Detach bool
+}
+
+func newProjectCommand(opt *option.Option) *cobra.Command {
+	var flag projectFlags
+	cmd := &cobra.Command{
+		Use:   "project (PROJECT)",
+		Short: "Export the Warehouses, Stages, and PromotionPolicies of a project",
+		Args:  option.ExactArgs(1),
+		Example: `
+# Export a project to a file
+kargo export project my-project > my-project.yaml
+
+# Start exporting a project without waiting for the export to complete
+kargo export project --detach my-project
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			project := strings.TrimSpace(args[0])
+			if project == "" {
+				return errors.New("project is required")
+			}
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			res, err := kargoSvcCli.StartOperation(ctx, connect.NewRequest(&v1alpha1.StartOperationRequest{
+				Operation: &v1alpha1.StartOperationRequest_ExportProject{
+					ExportProject: &v1alpha1.ExportProjectRequest{
+						Project: project,
+					},
+				},
+			}))
+			if err != nil {
+				return errors.Wrap(err, "start export")
+			}
+			id := res.Msg.GetOperation().GetId()
+			if flag.Detach {
+				opt.Printer().Successf("Export of project %q started as operation %q\n", project, id)
+				return nil
+			}
+			op, err := operation.Wait(ctx, opt, kargoSvcCli, id)
+			if err != nil {
+				return err
+			}
+			_, err = opt.IOStreams.Out.Write(op.GetResult().GetExportProject().GetManifest())
+			return errors.Wrap(err, "write manifest")
+		},
+	}
+	cmd.Flags().BoolVar(&flag.Detach, "detach", false,
+		"Start the export without waiting for it to complete")
+	return cmd
+}
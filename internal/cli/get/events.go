@@ -0,0 +1,117 @@
+package get
+
+import (
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type EventsFlags struct {
+	Stage     option.Optional[string]
+	Promotion option.Optional[string]
+}
+
+func newGetEventsCommand(opt *option.Option) *cobra.Command {
+	flag := EventsFlags{
+		Stage:     option.OptionalString(),
+		Promotion: option.OptionalString(),
+	}
+	cmd := &cobra.Command{
+		Use:     "events --project=project [--stage=stage] [--promotion=promotion]",
+		Aliases: []string{"event", "ev"},
+		Short:   "Display a timeline of events",
+		Args:    option.ExactArgs(0),
+		Example: `
+# List all events in the project
+kargo get events --project=my-project
+
+# List all events concerning the stage or its promotions
+kargo get events --project=my-project --stage=my-stage
+
+# List all events concerning the promotion
+kargo get events --project=my-project --promotion=some-promotion
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project.OrElse("")
+			if project == "" {
+				return errors.New("project is required")
+			}
+			req := &v1alpha1.ListEventsRequest{
+				Project: project,
+			}
+			if stage, ok := flag.Stage.Get(); ok {
+				req.Stage = proto.String(stage)
+			}
+			if promotion, ok := flag.Promotion.Get(); ok {
+				req.Promotion = proto.String(promotion)
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			resp, err := kargoSvcCli.ListEvents(ctx, connect.NewRequest(req))
+			if err != nil {
+				return errors.Wrap(err, "list events")
+			}
+			columns, rows := newEventsTable(resp.Msg.GetEvents(), time.Now())
+			return opt.Printer().Table(columns, rows)
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	option.OptionalStage(flag.Stage)(cmd.Flags())
+	option.OptionalPromotion(flag.Promotion)(cmd.Flags())
+	return cmd
+}
+
+// newEventsTable returns the columns and rows of a table representation of
+// the provided events. Ages are computed relative to now.
+func newEventsTable(
+	events []*v1alpha1.Event,
+	now time.Time,
+) ([]output.Column, [][]output.Cell) {
+	rows := make([][]output.Cell, len(events))
+	for i, e := range events {
+		state := output.StateUnknown
+		if e.GetType() == corev1.EventTypeWarning {
+			state = output.StateUnhealthy
+		}
+		message := e.GetMessage()
+		if e.GetCount() > 1 {
+			message = fmt.Sprintf("%s (x%d)", message, e.GetCount())
+		}
+		actor := e.GetActor()
+		if actor == "" {
+			actor = "-"
+		}
+		rows[i] = []output.Cell{
+			{Text: duration.HumanDuration(now.Sub(e.GetTime().AsTime()))},
+			{Text: e.GetType(), State: state},
+			{Text: e.GetReason()},
+			{Text: fmt.Sprintf("%s/%s", e.GetKind(), e.GetName())},
+			{Text: actor},
+			{Text: message},
+		}
+	}
+	return []output.Column{
+		{Name: "Last Seen"},
+		{Name: "Type", Stateful: true},
+		{Name: "Reason"},
+		{Name: "Object"},
+		{Name: "Actor"},
+		{Name: "Message"},
+	}, rows
+}
@@ -2,11 +2,14 @@ package get
 
 import (
 	goerrors "errors"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
@@ -28,6 +31,9 @@ kargo get freight --project=my-project -o json
 
 # Get a single piece of freight in the project
 kargo get freight --project=my-project my-freight
+
+# Get a single piece of freight in the project by its alias
+kargo get freight --project=my-project payments-1234
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -63,6 +69,14 @@ kargo get freight --project=my-project my-freight
 				for _, f := range freight.Freight {
 					freightByName[f.GetMetadata().GetName()] = typesv1alpha1.FromFreightProto(f)
 				}
+				// Freight may also be referred to by alias
+				for _, f := range freight.Freight {
+					if alias := f.GetAlias(); alias != "" {
+						if _, ok := freightByName[alias]; !ok {
+							freightByName[alias] = typesv1alpha1.FromFreightProto(f)
+						}
+					}
+				}
 				for _, name := range names {
 					if f, ok := freightByName[name]; ok {
 						res = append(res, f)
@@ -71,7 +85,7 @@ kargo get freight --project=my-project my-freight
 					}
 				}
 			}
-			if err := printObjects(opt, res); err != nil {
+			if err := printObjects(opt, res, nil); err != nil {
 				return err
 			}
 			return resErr
@@ -81,3 +95,26 @@ kargo get freight --project=my-project my-freight
 	opt.PrintFlags.AddFlags(cmd)
 	return cmd
 }
+
+func newFreightTable(list *metav1.List) *metav1.Table {
+	rows := make([]metav1.TableRow, len(list.Items))
+	for i, item := range list.Items {
+		freight := item.Object.(*kargoapi.Freight) // nolint: forcetypeassert
+		rows[i] = metav1.TableRow{
+			Cells: []any{
+				freight.Name,
+				freight.Alias,
+				duration.HumanDuration(time.Since(freight.CreationTimestamp.Time)),
+			},
+			Object: list.Items[i],
+		}
+	}
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Alias", Type: "string"},
+			{Name: "Age", Type: "string"},
+		},
+		Rows: rows,
+	}
+}
@@ -3,6 +3,7 @@ package get
 import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
@@ -10,6 +11,8 @@ import (
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
 func NewCommand(opt *option.Option) *cobra.Command {
@@ -25,9 +28,13 @@ kargo get stages --project=my-project
 
 # List all promotions for the given stage
 kargo get promotions --project=my-project --stage=my-stage
+
+# List a timeline of events for the given stage
+kargo get events --project=my-project --stage=my-stage
 `,
 	}
 	// Subcommands
+	cmd.AddCommand(newGetEventsCommand(opt))
 	cmd.AddCommand(newGetFreightCommand(opt))
 	cmd.AddCommand(newGetProjectsCommand(opt))
 	cmd.AddCommand(newGetPromotionsCommand(opt))
@@ -36,7 +43,11 @@ kargo get promotions --project=my-project --stage=my-stage
 	return cmd
 }
 
-func printObjects[T runtime.Object](opt *option.Option, objects []T) error {
+func printObjects[T runtime.Object](
+	opt *option.Option,
+	objects []T,
+	table *v1alpha1.Table,
+) error {
 	items := make([]runtime.RawExtension, len(objects))
 	for i, obj := range objects {
 		items[i] = runtime.RawExtension{Object: obj}
@@ -60,6 +71,10 @@ func printObjects[T runtime.Object](opt *option.Option, objects []T) error {
 		return printer.PrintObj(list, opt.IOStreams.Out)
 	}
 
+	if table != nil {
+		return printTable(opt, table, list)
+	}
+	// Older API servers do not return tables, in which case one is built here
 	var t T
 	switch any(t).(type) {
 	case *kargoapi.Stage:
@@ -68,7 +83,50 @@ func printObjects[T runtime.Object](opt *option.Option, objects []T) error {
 	case *kargoapi.Promotion:
 		table := newPromotionTable(list)
 		return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, opt.IOStreams.Out)
+	case *kargoapi.Freight:
+		table := newFreightTable(list)
+		return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, opt.IOStreams.Out)
 	default:
 		return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(list, opt.IOStreams.Out)
 	}
 }
+
+// printTable prints a Table computed by the API server, limited to the rows
+// for the items of the provided list and in the same order as those items.
+func printTable(
+	opt *option.Option,
+	table *v1alpha1.Table,
+	list *metav1.List,
+) error {
+	rowsByName := make(map[string]*v1alpha1.TableRow, len(table.GetRows()))
+	for _, row := range table.GetRows() {
+		rowsByName[row.GetName()] = row
+	}
+	rows := make([][]output.Cell, 0, len(list.Items))
+	for _, item := range list.Items {
+		obj, err := meta.Accessor(item.Object)
+		if err != nil {
+			return errors.Wrap(err, "get object metadata")
+		}
+		row, ok := rowsByName[obj.GetName()]
+		if !ok {
+			continue
+		}
+		cells := make([]output.Cell, len(row.GetCells()))
+		for i, cell := range row.GetCells() {
+			cells[i] = output.Cell{
+				Text:  cell.GetText(),
+				State: output.State(cell.GetGlyph()),
+			}
+		}
+		rows = append(rows, cells)
+	}
+	columns := make([]output.Column, len(table.GetColumns()))
+	for i, column := range table.GetColumns() {
+		columns[i] = output.Column{
+			Name:     column.GetName(),
+			Stateful: column.GetType() == "health",
+		}
+	}
+	return opt.Printer().Table(columns, rows)
+}
@@ -34,7 +34,9 @@ kargo get projects -o json
 			if err != nil {
 				return errors.New("get client from config")
 			}
-			resp, err := kargoSvcCli.ListProjects(ctx, connect.NewRequest(&v1alpha1.ListProjectsRequest{}))
+			resp, err := kargoSvcCli.ListProjects(ctx, connect.NewRequest(&v1alpha1.ListProjectsRequest{
+				IncludeTable: true,
+			}))
 			if err != nil {
 				return errors.Wrap(err, "list projects")
 			}
@@ -59,7 +61,7 @@ kargo get projects -o json
 					}
 				}
 			}
-			if err := printObjects(opt, res); err != nil {
+			if err := printObjects(opt, res, resp.Msg.GetTable()); err != nil {
 				return err
 			}
 			return resErr
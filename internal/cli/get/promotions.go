@@ -52,7 +52,8 @@ kargo get promotions --project=my-project some-promotion
 				return errors.New("project is required")
 			}
 			req := &v1alpha1.ListPromotionsRequest{
-				Project: project,
+				Project:      project,
+				IncludeTable: true,
 			}
 			if stage, ok := flag.Stage.Get(); ok {
 				req.Stage = proto.String(stage)
@@ -87,7 +88,7 @@ kargo get promotions --project=my-project some-promotion
 					}
 				}
 			}
-			if err := printObjects(opt, res); err != nil {
+			if err := printObjects(opt, res, resp.Msg.GetTable()); err != nil {
 				return err
 			}
 			return resErr
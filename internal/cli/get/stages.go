@@ -46,7 +46,8 @@ kargo get stages --project=my-project my-stage
 				return errors.New("get client from config")
 			}
 			resp, err := kargoSvcCli.ListStages(ctx, connect.NewRequest(&v1alpha1.ListStagesRequest{
-				Project: project,
+				Project:      project,
+				IncludeTable: true,
 			}))
 			if err != nil {
 				return errors.Wrap(err, "list stages")
@@ -72,7 +73,7 @@ kargo get stages --project=my-project my-stage
 					}
 				}
 			}
-			if err := printObjects(opt, res); err != nil {
+			if err := printObjects(opt, res, resp.Msg.GetTable()); err != nil {
 				return err
 			}
 			return resErr
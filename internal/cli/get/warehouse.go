@@ -46,7 +46,8 @@ kargo get warehouses --project=my-project my-warehouse
 				ctx,
 				connect.NewRequest(
 					&v1alpha1.ListWarehousesRequest{
-						Project: project,
+						Project:      project,
+						IncludeTable: true,
 					},
 				),
 			)
@@ -77,7 +78,7 @@ kargo get warehouses --project=my-project my-warehouse
 					}
 				}
 			}
-			if err := printObjects(opt, res); err != nil {
+			if err := printObjects(opt, res, resp.Msg.GetTable()); err != nil {
 				return err
 			}
 			return resErr
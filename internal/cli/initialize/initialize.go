@@ -0,0 +1,17 @@
+package initialize
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Generate manifests for new resources",
+	}
+	// Subcommands
+	cmd.AddCommand(newWarehouseCommand(opt))
+	return cmd
+}
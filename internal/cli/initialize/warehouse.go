@@ -0,0 +1,86 @@
+package initialize
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/pointer"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type warehouseFlags struct {
+	FromArgoCDApp      string
+	ArgoCDAppNamespace string
+}
+
+func newWarehouseCommand(opt *option.Option) *cobra.Command {
+	var flag warehouseFlags
+	cmd := &cobra.Command{
+		Use:   "warehouse --project=project --from-argocd-app=app [NAME]",
+		Short: "Generate a warehouse and a stage from an existing Argo CD Application",
+		Args:  option.MaximumNArgs(1),
+		Example: `
+# Generate a warehouse and a stage named after an Argo CD Application
+kargo init warehouse --project=my-project --from-argocd-app=guestbook > guestbook.yaml
+
+# Generate and create a warehouse and a stage named test
+kargo init warehouse --project=my-project --from-argocd-app=guestbook test | kargo apply -f -
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project.OrElse("")
+			if project == "" {
+				return errors.New("project is required")
+			}
+			app := strings.TrimSpace(flag.FromArgoCDApp)
+			if app == "" {
+				return errors.New("from-argocd-app is required")
+			}
+			req := &v1alpha1.GenerateWarehouseFromArgoCDAppRequest{
+				Project: project,
+				AppName: app,
+			}
+			if flag.ArgoCDAppNamespace != "" {
+				req.AppNamespace = pointer.String(flag.ArgoCDAppNamespace)
+			}
+			name := app
+			if len(args) == 1 {
+				name = strings.TrimSpace(args[0])
+				req.Name = pointer.String(name)
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			res, err := kargoSvcCli.GenerateWarehouseFromArgoCDApp(ctx, connect.NewRequest(req))
+			if err != nil {
+				return errors.Wrap(err, "generate warehouse from Argo CD Application")
+			}
+			if _, err = opt.IOStreams.Out.Write(res.Msg.GetManifest()); err != nil {
+				return errors.Wrap(err, "write manifest")
+			}
+			// The manifest may be piped elsewhere, so this is written separately
+			opt.ErrPrinter().Printf(
+				"Before promoting to the stage, annotate Argo CD Application %q with "+
+					"kargo.akuity.io/authorized-stage: %s:%s\n",
+				app,
+				project,
+				name,
+			)
+			return nil
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	cmd.Flags().StringVar(&flag.FromArgoCDApp, "from-argocd-app", "",
+		"Name of the Argo CD Application to generate the warehouse and stage from")
+	cmd.Flags().StringVar(&flag.ArgoCDAppNamespace, "argocd-app-namespace", "",
+		"Namespace of the Argo CD Application. Defaults to the namespace Argo CD is installed in")
+	return cmd
+}
@@ -0,0 +1,198 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/describe"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
+)
+
+type Flags struct {
+	Filenames []string
+}
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	var flag Flags
+	cmd := &cobra.Command{
+		Use:   "inspect -f (FILENAME)",
+		Short: "Show details of exported resources without connecting to Kargo",
+		Args:  option.ExactArgs(0),
+		Example: `
+# Show details of the freight and stages in a state dump
+kargo inspect -f freight.yaml -f stages.yaml
+
+# Show details of a stage exported using kubectl
+kubectl get stage test --namespace=my-project -o yaml | kargo inspect -f -
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if len(flag.Filenames) == 0 {
+				return errors.New("filename is required")
+			}
+			var manifest bytes.Buffer
+			for _, filename := range flag.Filenames {
+				if err := readManifests(&manifest, opt.IOStreams.In, filename); err != nil {
+					return errors.Wrapf(err, "read manifests from %q", filename)
+				}
+			}
+			objs, skipped, err := decodeObjects(manifest.Bytes())
+			if err != nil {
+				return err
+			}
+			for _, s := range skipped {
+				opt.ErrPrinter().Printf("Skipping %s, which cannot be inspected\n", s)
+			}
+			if len(objs) == 0 {
+				return errors.New("no Freight, Stages, or Promotions found")
+			}
+			printObjects(opt.Printer(), objs)
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVarP(&flag.Filenames, "filename", "f", nil,
+		"Filename or directory of the manifests to inspect, or - to read them from stdin")
+	return cmd
+}
+
+// readManifests appends the manifests in the provided file, or in the YAML
+// and JSON files directly within the provided directory, to buf. If the
+// filename is "-", the manifests are read from in instead.
+func readManifests(buf *bytes.Buffer, in io.Reader, filename string) error {
+	if filename == "-" {
+		_, err := buf.ReadFrom(in)
+		return err
+	}
+	info, err := os.Stat(filename)
+	if err != nil {
+		return err
+	}
+	filenames := []string{filename}
+	if info.IsDir() {
+		entries, err := os.ReadDir(filename)
+		if err != nil {
+			return err
+		}
+		filenames = filenames[:0]
+		for _, entry := range entries {
+			switch filepath.Ext(entry.Name()) {
+			case ".yaml", ".yml", ".json":
+				if !entry.IsDir() {
+					filenames = append(filenames, filepath.Join(filename, entry.Name()))
+				}
+			}
+		}
+	}
+	for _, name := range filenames {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		// Keep the last document of one file from running into the first of
+		// the next
+		buf.WriteString("\n---\n")
+		buf.Write(data)
+	}
+	return nil
+}
+
+// decodeObjects decodes the provided stream of YAML or JSON documents into
+// typed Freight, Stages, and Promotions. Lists, such as those output by
+// kubectl when getting several resources at once, are expanded into their
+// items. Resources of any other kind, such as the Warehouses that accompany
+// Stages in the output of kargo export, are not decoded. Instead, their kinds
+// and names are returned separately.
+func decodeObjects(manifest []byte) ([]runtime.Object, []string, error) {
+	var objs []runtime.Object
+	var skipped []string
+	dec := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	for {
+		var doc map[string]any
+		if err := dec.Decode(&doc); err != nil {
+			if err == io.EOF {
+				return objs, skipped, nil
+			}
+			return nil, nil, errors.Wrap(err, "decode manifest")
+		}
+		if len(doc) == 0 {
+			continue
+		}
+		u := &unstructured.Unstructured{Object: doc}
+		items := []unstructured.Unstructured{*u}
+		if u.IsList() {
+			list, err := u.ToList()
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "decode list")
+			}
+			items = list.Items
+		}
+		for i := range items {
+			obj, err := typedObject(&items[i])
+			if err != nil {
+				return nil, nil, err
+			}
+			if obj == nil {
+				skipped = append(skipped, fmt.Sprintf("%s %q", items[i].GetKind(), items[i].GetName()))
+				continue
+			}
+			objs = append(objs, obj)
+		}
+	}
+}
+
+// typedObject converts the provided resource into the Kargo type of its kind.
+// If the resource is not a Freight, Stage, or Promotion, nil is returned.
+func typedObject(u *unstructured.Unstructured) (runtime.Object, error) {
+	gvk := u.GroupVersionKind()
+	var obj runtime.Object
+	if gvk.Group == kargoapi.GroupVersion.Group {
+		switch gvk.Kind {
+		case "Freight":
+			obj = &kargoapi.Freight{}
+		case "Stage":
+			obj = &kargoapi.Stage{}
+		case "Promotion":
+			obj = &kargoapi.Promotion{}
+		}
+	}
+	if obj == nil {
+		return nil, nil
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		return nil, errors.Wrapf(err, "decode %s %q", gvk.Kind, u.GetName())
+	}
+	return obj, nil
+}
+
+// printObjects prints the details of each of the provided objects in the same
+// form as `kargo describe` does, preceded by its kind and project.
+func printObjects(p *output.Printer, objs []runtime.Object) {
+	for i, obj := range objs {
+		if i > 0 {
+			p.Printf("\n")
+		}
+		p.Printf("Kind:     %s\n", obj.GetObjectKind().GroupVersionKind().Kind)
+		if project := obj.(metav1.Object).GetNamespace(); project != "" {
+			p.Printf("Project:  %s\n", project)
+		}
+		switch o := obj.(type) {
+		case *kargoapi.Freight:
+			describe.PrintFreight(p, o)
+		case *kargoapi.Stage:
+			describe.PrintStage(p, o)
+		case *kargoapi.Promotion:
+			describe.PrintPromotion(p, o)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package inspect
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/output"
+)
+
+func TestReadManifests(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "freight.yaml"), []byte("kind: Freight"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "stage.json"), []byte(`{"kind":"Stage"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Ignored"), 0o600))
+
+	testCases := []struct {
+		name       string
+		filename   string
+		assertions func(string, error)
+	}{
+		{
+			name:     "file not found",
+			filename: filepath.Join(dir, "missing.yaml"),
+			assertions: func(_ string, err error) {
+				require.True(t, os.IsNotExist(err))
+			},
+		},
+		{
+			name:     "stdin",
+			filename: "-",
+			assertions: func(manifest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "kind: Promotion", manifest)
+			},
+		},
+		{
+			name:     "file",
+			filename: filepath.Join(dir, "freight.yaml"),
+			assertions: func(manifest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "\n---\nkind: Freight", manifest)
+			},
+		},
+		{
+			name:     "directory",
+			filename: dir,
+			assertions: func(manifest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "\n---\nkind: Freight\n---\n{\"kind\":\"Stage\"}", manifest)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := readManifests(buf, strings.NewReader("kind: Promotion"), testCase.filename)
+			testCase.assertions(buf.String(), err)
+		})
+	}
+}
+
+func TestDecodeObjects(t *testing.T) {
+	testCases := []struct {
+		name       string
+		manifest   string
+		assertions func([]runtime.Object, []string, error)
+	}{
+		{
+			name:     "invalid manifest",
+			manifest: "kind: [",
+			assertions: func(_ []runtime.Object, _ []string, err error) {
+				require.ErrorContains(t, err, "decode manifest")
+			},
+		},
+		{
+			name: "kinds that cannot be inspected",
+			manifest: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: fake-configmap
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: fake-warehouse
+`,
+			assertions: func(objs []runtime.Object, skipped []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, objs)
+				require.Equal(
+					t,
+					[]string{`ConfigMap "fake-configmap"`, `Warehouse "fake-warehouse"`},
+					skipped,
+				)
+			},
+		},
+		{
+			name: "success",
+			manifest: `
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: kargo.akuity.io/v1alpha1
+  kind: Freight
+  metadata:
+    name: fake-freight
+    namespace: fake-project
+  id: fake-freight
+- apiVersion: kargo.akuity.io/v1alpha1
+  kind: Stage
+  metadata:
+    name: fake-stage
+    namespace: fake-project
+  status:
+    currentFreight:
+      id: fake-freight
+---
+{"apiVersion": "kargo.akuity.io/v1alpha1", "kind": "Promotion", "metadata": {"name": "fake-promo"}}
+`,
+			assertions: func(objs []runtime.Object, skipped []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, skipped)
+				require.Len(t, objs, 3)
+				freight, ok := objs[0].(*kargoapi.Freight)
+				require.True(t, ok)
+				require.Equal(t, "fake-freight", freight.ID)
+				stage, ok := objs[1].(*kargoapi.Stage)
+				require.True(t, ok)
+				require.Equal(t, "fake-freight", stage.Status.CurrentFreight.ID)
+				promo, ok := objs[2].(*kargoapi.Promotion)
+				require.True(t, ok)
+				require.Equal(t, "fake-promo", promo.Name)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(decodeObjects([]byte(testCase.manifest)))
+		})
+	}
+}
+
+func TestPrintObjects(t *testing.T) {
+	objs, _, err := decodeObjects([]byte(`apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: fake-stage
+  namespace: fake-project
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Promotion
+metadata:
+  name: fake-promo
+spec:
+  stage: fake-stage
+  freight: fake-freight
+status:
+  phase: Succeeded
+`))
+	require.NoError(t, err)
+	out := &bytes.Buffer{}
+	printObjects(output.NewPrinter(out, true), objs)
+	require.Equal(
+		t,
+		`Kind:     Stage
+Project:  fake-project
+Name:     fake-stage
+Health:   Unknown
+
+Current freight: none
+
+Kind:     Promotion
+Name:     fake-promo
+Stage:    fake-stage
+Freight:  fake-freight
+Phase:    Succeeded
+
+Impact: not yet recorded
+`,
+		out.String(),
+	)
+}
@@ -11,6 +11,7 @@ import (
 	"math/big"
 	"net"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -21,6 +22,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"golang.org/x/oauth2"
+	authnv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/strings/slices"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 
@@ -36,6 +41,7 @@ const (
 	flagKubeconfig           = "kubeconfig"
 	flagPassword             = "password"
 	flagPort                 = "port"
+	flagServiceAccount       = "service-account"
 	flagSSO                  = "sso"
 	defaultRandStringCharSet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 )
@@ -45,10 +51,25 @@ var assets embed.FS
 
 func NewCommand(opt *option.Option) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "login server-address",
-		Args:    option.ExactArgs(1),
-		Short:   "Log in to a Kargo API server",
-		Example: "kargo login https://kargo.example.com --sso",
+		Use:   "login server-address",
+		Args:  option.ExactArgs(1),
+		Short: "Log in to a Kargo API server",
+		Example: `
+# Log in using the server's configured identity provider
+kargo login https://kargo.example.com --sso
+
+# Log in using the local Kubernetes configuration's current context
+kargo login https://kargo.example.com --kubeconfig
+
+# Log in as a ServiceAccount, using the local Kubernetes configuration's
+# current context to request a token for it
+kargo login https://kargo.example.com --kubeconfig \
+  --service-account=kargo-demo/ci
+
+# Log in using a client certificate
+kargo login https://kargo.example.com \
+  --client-certificate=client.crt --client-key=client.key
+`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
@@ -67,7 +88,13 @@ func NewCommand(opt *option.Option) *cobra.Command {
 				return err
 			}
 
+			useClientCert :=
+				opt.ClientCertificatePath != "" || opt.ClientKeyPath != ""
+
 			var flagCount int
+			if useClientCert {
+				flagCount++
+			}
 			if useAdmin {
 				flagCount++
 			}
@@ -79,13 +106,24 @@ func NewCommand(opt *option.Option) *cobra.Command {
 			}
 			if flagCount != 1 {
 				return errors.Errorf(
-					"please specify exactly one of --admin, --kubeconfig, or --sso",
+					"please specify exactly one of --admin, --kubeconfig, --sso, or " +
+						"--client-certificate and --client-key",
 				)
 			}
 
 			serverAddress := args[0]
-			var bearerToken, refreshToken string
-			if useAdmin {
+			var bearerToken, refreshToken, certPath, keyPath string
+			if useClientCert {
+				if certPath, keyPath, err = clientCertLogin(
+					ctx,
+					serverAddress,
+					opt.ClientCertificatePath,
+					opt.ClientKeyPath,
+					opt.InsecureTLS,
+				); err != nil {
+					return err
+				}
+			} else if useAdmin {
 				var password string
 				if password, err = cmd.Flags().GetString(flagPassword); err != nil {
 					return err
@@ -108,8 +146,17 @@ func NewCommand(opt *option.Option) *cobra.Command {
 					return err
 				}
 			} else if useKubeconfig {
-				bearerToken, err = kubeconfigLogin(ctx)
-				if err != nil {
+				var serviceAccount string
+				if serviceAccount, err =
+					cmd.Flags().GetString(flagServiceAccount); err != nil {
+					return err
+				}
+				if bearerToken, err = kubeconfigLogin(
+					ctx,
+					serverAddress,
+					serviceAccount,
+					opt.InsecureTLS,
+				); err != nil {
 					return err
 				}
 			} else {
@@ -140,6 +187,8 @@ func NewCommand(opt *option.Option) *cobra.Command {
 					BearerToken:           bearerToken,
 					RefreshToken:          refreshToken,
 					InsecureSkipTLSVerify: opt.InsecureTLS,
+					ClientCertificatePath: certPath,
+					ClientKeyPath:         keyPath,
 				},
 			)
 			return errors.Wrap(err, "error persisting configuration")
@@ -173,6 +222,13 @@ func NewCommand(opt *option.Option) *cobra.Command {
 		"Port to use for the callback URL; 0 selects any available, "+
 			"unprivileged port; only used with --sso",
 	)
+	cmd.Flags().String(
+		flagServiceAccount,
+		"",
+		"Specify a ServiceAccount, in the form namespace/name, to log in as "+
+			"using a token requested for it using the local Kubernetes "+
+			"configuration's current context; only used with --kubeconfig",
+	)
 	cmd.Flags().BoolP(
 		flagSSO,
 		"s",
@@ -189,7 +245,7 @@ func adminLogin(
 	password string,
 	insecureTLS bool,
 ) (string, error) {
-	kargoClient := client.GetClient(serverAddress, "", insecureTLS)
+	kargoClient := client.GetClient(serverAddress, "", nil, insecureTLS)
 
 	cfgRes, err := kargoClient.GetPublicConfig(
 		ctx,
@@ -219,16 +275,133 @@ func adminLogin(
 	return loginRes.Msg.IdToken, nil
 }
 
-// kubeconfigLogin gleans a bearer token from the local kubeconfig's current
-// context.
-func kubeconfigLogin(ctx context.Context) (string, error) {
+// clientCertLogin verifies that the Kargo API server accepts the client
+// certificate found in the specified files. Upon success, it returns absolute
+// paths to the certificate and key files so they can be persisted to local
+// configuration.
+func clientCertLogin(
+	ctx context.Context,
+	serverAddress string,
+	certPath string,
+	keyPath string,
+	insecureTLS bool,
+) (string, string, error) {
+	var err error
+	if certPath, err = filepath.Abs(certPath); err != nil {
+		return "", "", errors.Wrap(err, "error resolving client certificate path")
+	}
+	if keyPath, err = filepath.Abs(keyPath); err != nil {
+		return "", "", errors.Wrap(err, "error resolving client key path")
+	}
+	clientCert, err := client.LoadClientCertificate(certPath, keyPath)
+	if err != nil {
+		return "", "", err
+	}
+	kargoClient :=
+		client.GetClient(serverAddress, "", clientCert, insecureTLS)
+	if _, err = kargoClient.GetConfig(
+		ctx,
+		connect.NewRequest(&v1alpha1.GetConfigRequest{}),
+	); err != nil {
+		return "", "",
+			errors.Wrap(err, "error logging in using client certificate")
+	}
+	return certPath, keyPath, nil
+}
+
+// kubeconfigLogin obtains a credential for the Kubernetes cluster using the
+// local kubeconfig's current context. If a ServiceAccount is specified, the
+// credential is a token requested for that ServiceAccount. Otherwise, it is
+// the credential (e.g. a static token or the output of an exec plugin) for the
+// context's own user. If the Kargo API server supports it, the credential is
+// exchanged for a Kargo-issued ID token, which is returned. Otherwise, the
+// credential itself is returned.
+func kubeconfigLogin(
+	ctx context.Context,
+	serverAddress string,
+	serviceAccount string,
+	insecureTLS bool,
+) (string, error) {
 	restCfg, err := config.GetConfig()
 	if err != nil {
 		return "", errors.Wrap(err, "error loading kubeconfig")
 	}
-	bearerToken, err := kubeclient.GetCredential(ctx, restCfg)
-	return bearerToken,
-		errors.Wrap(err, "error retrieving bearer token from kubeconfig")
+
+	var credential string
+	if serviceAccount != "" {
+		if credential, err =
+			requestServiceAccountToken(ctx, restCfg, serviceAccount); err != nil {
+			return "", err
+		}
+	} else if credential, err =
+		kubeclient.GetCredential(ctx, restCfg); err != nil {
+		return "", errors.Wrap(err, "error retrieving bearer token from kubeconfig")
+	}
+
+	kargoClient := client.GetClient(serverAddress, "", nil, insecureTLS)
+
+	cfgRes, err := kargoClient.GetPublicConfig(
+		ctx,
+		connect.NewRequest(&v1alpha1.GetPublicConfigRequest{}),
+	)
+	if err != nil {
+		return "", errors.Wrap(
+			err,
+			"error retrieving public configuration from server",
+		)
+	}
+
+	if !cfgRes.Msg.KubernetesLoginEnabled {
+		// The server will use the credential directly when talking to the
+		// Kubernetes API server on our behalf.
+		return credential, nil
+	}
+
+	loginRes, err := kargoClient.KubernetesLogin(
+		ctx,
+		connect.NewRequest(&v1alpha1.KubernetesLoginRequest{
+			Token: credential,
+		}),
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "error logging in using Kubernetes credentials")
+	}
+
+	return loginRes.Msg.IdToken, nil
+}
+
+// requestServiceAccountToken uses the provided REST config to request a token
+// for the specified ServiceAccount, which must be of the form namespace/name.
+func requestServiceAccountToken(
+	ctx context.Context,
+	restCfg *rest.Config,
+	serviceAccount string,
+) (string, error) {
+	namespace, name, ok := strings.Cut(serviceAccount, "/")
+	if !ok || namespace == "" || name == "" {
+		return "", errors.Errorf(
+			"invalid ServiceAccount %q; expected the form namespace/name",
+			serviceAccount,
+		)
+	}
+	clientset, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		return "", errors.Wrap(err, "error creating Kubernetes client")
+	}
+	tokenReq, err := clientset.CoreV1().ServiceAccounts(namespace).CreateToken(
+		ctx,
+		name,
+		&authnv1.TokenRequest{},
+		metav1.CreateOptions{},
+	)
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error requesting token for ServiceAccount %q",
+			serviceAccount,
+		)
+	}
+	return tokenReq.Status.Token, nil
 }
 
 // ssoLogin performs a login using OpenID Connect. It first retrieves
@@ -242,7 +415,7 @@ func ssoLogin(
 	callbackPort int,
 	insecureTLS bool,
 ) (string, string, error) {
-	kargoClient := client.GetClient(serverAddress, "", insecureTLS)
+	kargoClient := client.GetClient(serverAddress, "", nil, insecureTLS)
 
 	res, err := kargoClient.GetPublicConfig(
 		ctx,
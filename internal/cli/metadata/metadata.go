@@ -0,0 +1,164 @@
+package metadata
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// kindsByResource maps the resource names accepted on the command line to the
+// kinds of the Kargo resources they refer to.
+var kindsByResource = map[string]string{
+	"freight":           "Freight",
+	"freights":          "Freight",
+	"promo":             "Promotion",
+	"promos":            "Promotion",
+	"promotion":         "Promotion",
+	"promotions":        "Promotion",
+	"promotionpolicy":   "PromotionPolicy",
+	"promotionpolicies": "PromotionPolicy",
+	"release":           "Release",
+	"releases":          "Release",
+	"stage":             "Stage",
+	"stages":            "Stage",
+	"warehouse":         "Warehouse",
+	"warehouses":        "Warehouse",
+}
+
+// NewLabelCommand returns a command for updating the labels of a Kargo
+// resource.
+func NewLabelCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "label --project=project (RESOURCE) (NAME) KEY=VALUE... [KEY-...]",
+		Short: "Update the labels of a resource",
+		Args:  option.MinimumNArgs(3),
+		Example: `
+# Add or update a label on a stage
+kargo label --project=my-project stage my-stage team=payments
+
+# Remove a label from a piece of freight
+kargo label --project=my-project freight my-freight team-
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateMetadata(cmd, opt, args, "label", "labeled")
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	return cmd
+}
+
+// NewAnnotateCommand returns a command for updating the annotations of a Kargo
+// resource.
+func NewAnnotateCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annotate --project=project (RESOURCE) (NAME) KEY=VALUE... [KEY-...]",
+		Short: "Update the annotations of a resource",
+		Args:  option.MinimumNArgs(3),
+		Example: `
+# Add or update an annotation on a promotion
+kargo annotate --project=my-project promotion my-promotion \
+  example.com/ticket=OPS-1234
+
+# Remove an annotation from a stage
+kargo annotate --project=my-project stage my-stage example.com/ticket-
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateMetadata(cmd, opt, args, "annotation", "annotated")
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	return cmd
+}
+
+// updateMetadata updates either the labels or the annotations (as indicated by
+// what) of the resource identified by the provided arguments.
+func updateMetadata(
+	cmd *cobra.Command,
+	opt *option.Option,
+	args []string,
+	what string,
+	pastTense string,
+) error {
+	ctx := cmd.Context()
+
+	project := opt.Project.OrElse("")
+	if project == "" {
+		return errors.New("project is required")
+	}
+	kind, err := resolveKind(args[0])
+	if err != nil {
+		return err
+	}
+	name := strings.TrimSpace(args[1])
+	if name == "" {
+		return errors.New("name is required")
+	}
+	set, remove, err := parseChanges(args[2:])
+	if err != nil {
+		return err
+	}
+
+	req := &v1alpha1.UpdateMetadataRequest{
+		Project: project,
+		Kind:    kind,
+		Name:    name,
+	}
+	if what == "label" {
+		req.Labels = set
+		req.RemoveLabels = remove
+	} else {
+		req.Annotations = set
+		req.RemoveAnnotations = remove
+	}
+
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+	if err != nil {
+		return errors.Wrap(err, "get client from config")
+	}
+	if _, err = kargoSvcCli.UpdateMetadata(ctx, connect.NewRequest(req)); err != nil {
+		return errors.Wrapf(err, "update %ss", what)
+	}
+	opt.Printer().Successf("%s %q %s\n", kind, name, pastTense)
+	return nil
+}
+
+// resolveKind returns the kind of Kargo resource referred to by the provided
+// resource name.
+func resolveKind(resource string) (string, error) {
+	kind, ok := kindsByResource[strings.ToLower(strings.TrimSpace(resource))]
+	if !ok {
+		return "", errors.Errorf("unsupported resource %q", resource)
+	}
+	return kind, nil
+}
+
+// parseChanges parses arguments of the form KEY=VALUE, which add or update a
+// key, and KEY-, which remove a key.
+func parseChanges(args []string) (map[string]string, []string, error) {
+	set := map[string]string{}
+	var remove []string
+	for _, arg := range args {
+		if key, value, ok := strings.Cut(arg, "="); ok {
+			if key == "" {
+				return nil, nil, errors.Errorf("invalid argument %q: empty key", arg)
+			}
+			set[key] = value
+			continue
+		}
+		if key, ok := strings.CutSuffix(arg, "-"); ok && key != "" {
+			remove = append(remove, key)
+			continue
+		}
+		return nil, nil, errors.Errorf(
+			"invalid argument %q: expected KEY=VALUE or KEY-",
+			arg,
+		)
+	}
+	return set, remove, nil
+}
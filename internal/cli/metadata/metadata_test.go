@@ -0,0 +1,60 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveKind(t *testing.T) {
+	kind, err := resolveKind("Promos")
+	require.NoError(t, err)
+	require.Equal(t, "Promotion", kind)
+
+	_, err = resolveKind("pods")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported resource")
+}
+
+func TestParseChanges(t *testing.T) {
+	testCases := []struct {
+		name       string
+		args       []string
+		assertions func(map[string]string, []string, error)
+	}{
+		{
+			name: "empty key",
+			args: []string{"=value"},
+			assertions: func(_ map[string]string, _ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "empty key")
+			},
+		},
+		{
+			name: "neither set nor remove",
+			args: []string{"team"},
+			assertions: func(_ map[string]string, _ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "expected KEY=VALUE or KEY-")
+			},
+		},
+		{
+			name: "success",
+			args: []string{"team=payments", "example.com/ticket-", "empty="},
+			assertions: func(set map[string]string, remove []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]string{"team": "payments", "empty": ""},
+					set,
+				)
+				require.Equal(t, []string{"example.com/ticket"}, remove)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(parseChanges(testCase.args))
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+const (
+	phaseRunning   = "Running"
+	phaseSucceeded = "Succeeded"
+)
+
+// pollInterval is how often the state of a running Operation is polled.
+const pollInterval = time.Second
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operation",
+		Short: "Manage long-running operations performed by the server",
+	}
+	cmd.AddCommand(newGetCommand(opt))
+	cmd.AddCommand(newCancelCommand(opt))
+	return cmd
+}
+
+func newGetCommand(opt *option.Option) *cobra.Command {
+	var wait bool
+	cmd := &cobra.Command{
+		Use:   "get (ID)",
+		Short: "Display the state of an operation",
+		Args:  option.ExactArgs(1),
+		Example: `
+# Display the state of an operation
+kargo operation get 4f3c9a52-8d0e-4d3a-9f4e-2b6f7d9c1a0e
+
+# Wait for an operation to complete
+kargo operation get --wait 4f3c9a52-8d0e-4d3a-9f4e-2b6f7d9c1a0e
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			id := strings.TrimSpace(args[0])
+			if id == "" {
+				return errors.New("id is required")
+			}
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			var op *v1alpha1.Operation
+			if wait {
+				op, err = Wait(ctx, opt, kargoSvcCli, id)
+			} else {
+				op, err = get(ctx, kargoSvcCli, id)
+			}
+			if err != nil {
+				return err
+			}
+			printOperation(opt, op)
+			return nil
+		},
+	}
+	option.Wait(&wait, "Wait until the operation completes")(cmd.Flags())
+	return cmd
+}
+
+func newCancelCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel (ID)",
+		Short:   "Cancel a running operation",
+		Args:    option.ExactArgs(1),
+		Example: "kargo operation cancel 4f3c9a52-8d0e-4d3a-9f4e-2b6f7d9c1a0e",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			id := strings.TrimSpace(args[0])
+			if id == "" {
+				return errors.New("id is required")
+			}
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			res, err := kargoSvcCli.CancelOperation(ctx, connect.NewRequest(&v1alpha1.CancelOperationRequest{
+				Id: id,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "cancel operation")
+			}
+			printOperation(opt, res.Msg.GetOperation())
+			return nil
+		},
+	}
+	return cmd
+}
+
+// Wait polls the Operation with the specified ID until it reaches a terminal
+// phase and returns its final state. A spinner reflecting the Operation's
+// progress is displayed while waiting. An error is returned if the Operation
+// did not succeed.
+func Wait(
+	ctx context.Context,
+	opt *option.Option,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	id string,
+) (*v1alpha1.Operation, error) {
+	spinner := opt.ErrPrinter().Spinner(fmt.Sprintf("Waiting for operation %q to complete", id))
+	spinner.Start()
+	defer spinner.Stop()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		op, err := get(ctx, kargoSvcCli, id)
+		if err != nil {
+			return nil, err
+		}
+		if op.GetPhase() != phaseRunning {
+			if op.GetPhase() != phaseSucceeded {
+				return nil, errors.Errorf(
+					"operation %q %s: %s",
+					id,
+					strings.ToLower(op.GetPhase()),
+					op.GetMessage(),
+				)
+			}
+			return op, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func get(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	id string,
+) (*v1alpha1.Operation, error) {
+	res, err := kargoSvcCli.GetOperation(ctx, connect.NewRequest(&v1alpha1.GetOperationRequest{
+		Id: id,
+	}))
+	if err != nil {
+		return nil, errors.Wrap(err, "get operation")
+	}
+	return res.Msg.GetOperation(), nil
+}
+
+func printOperation(opt *option.Option, op *v1alpha1.Operation) {
+	p := opt.Printer()
+	p.Printf("ID:      %s\n", op.GetId())
+	p.Printf("Type:    %s\n", op.GetType())
+	if op.GetProject() != "" {
+		p.Printf("Project: %s\n", op.GetProject())
+	}
+	p.Printf("Phase:   %s\n", p.Status(op.GetPhase()))
+	if op.GetMessage() != "" {
+		p.Printf("Message: %s\n", op.GetMessage())
+	}
+}
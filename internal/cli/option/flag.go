@@ -2,6 +2,7 @@ package option
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/pflag"
 )
@@ -15,12 +16,67 @@ func Filenames(verb string, v *[]string) FlagFn {
 	}
 }
 
+func Strict(v *bool) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.BoolVar(v, "strict", true,
+			"Reject resources containing unknown or duplicate fields instead of dropping those fields")
+	}
+}
+
 func InsecureTLS(v *bool) FlagFn {
 	return func(fs *pflag.FlagSet) {
 		fs.BoolVar(v, "insecure-skip-tls-verify", false, "Skip TLS certificate verification")
 	}
 }
 
+func ClientCertificate(v *string) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.StringVar(v, "client-certificate", "",
+			"Path to a client certificate file for TLS client authentication")
+	}
+}
+
+func ClientKey(v *string) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.StringVar(v, "client-key", "",
+			"Path to a client key file for TLS client authentication")
+	}
+}
+
+func Server(v *string) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.StringVar(v, "server", "",
+			"Address of the Kargo API server; overrides local configuration, which need not exist")
+	}
+}
+
+func Token(v *string) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.StringVar(v, "token", "",
+			"Bearer token for authenticating to the Kargo API server; overrides local configuration")
+	}
+}
+
+func RequestTimeout(v *time.Duration) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.DurationVar(v, "request-timeout", 0,
+			"Maximum time to wait for each API request to complete, including retries; 0 means no timeout")
+	}
+}
+
+func Retries(v *int) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.IntVar(v, "retries", 0,
+			"Number of times to retry an API request if the Kargo API server is unavailable")
+	}
+}
+
+func NoColor(v *bool) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.BoolVar(v, "no-color", false, "Disable colorized output")
+	}
+}
+
 func LocalServer(v *bool) FlagFn {
 	return func(fs *pflag.FlagSet) {
 		fs.BoolVar(v, "local-server", false, "Use local server")
@@ -43,3 +99,50 @@ func Freight(v *string) FlagFn {
 		fs.StringVar(v, "freight", "", "Freight ID")
 	}
 }
+
+func ProjectOfFreight(v *string) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.StringVar(v, "project-of-freight", "",
+			"Project the Freight belongs to, if not the Stage's own; that Project must share its Freight "+
+				"with the Stage's Project")
+	}
+}
+
+func RolloutOrder(v *[]string) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.StringSliceVar(v, "rollout-order", nil,
+			"Comma-separated list of all subscribers, in the order they should be promoted")
+	}
+}
+
+func Soak(v *string) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.StringVar(v, "soak", "",
+			"How long each Stage must remain healthy before the next is promoted")
+	}
+}
+
+func Wait(v *bool, usage string) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.BoolVar(v, "wait", false, usage)
+	}
+}
+
+func ToAllDownstream(v *bool) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.BoolVar(v, "to-all-downstream", false,
+			"Progressively promote into every Stage downstream from the Stage as well")
+	}
+}
+
+func TTL(v *string) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.StringVar(v, "ttl", "", "How long the token is valid for")
+	}
+}
+
+func OptionalPromotion(v Optional[string]) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.Var(v, "promotion", "Promotion")
+	}
+}
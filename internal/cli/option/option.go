@@ -2,14 +2,17 @@ package option
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/utils/pointer"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/output"
 )
 
 type Option struct {
@@ -17,8 +20,25 @@ type Option struct {
 	LocalServerAddress string
 	UseLocalServer     bool
 
+	ClientCertificatePath string
+	ClientKeyPath         string
+
+	// Server and Token, if specified, override the API server address and
+	// bearer token found in local configuration.
+	Server string
+	Token  string
+
+	// RequestTimeout bounds how long each API request may take, including
+	// retries. Zero means no timeout.
+	RequestTimeout time.Duration
+	// Retries is the number of times an API request is retried if the server is
+	// unavailable.
+	Retries int
+
 	Project Optional[string]
 
+	NoColor bool
+
 	IOStreams  *genericclioptions.IOStreams
 	PrintFlags *genericclioptions.PrintFlags
 }
@@ -29,6 +49,38 @@ func NewOption() *Option {
 	}
 }
 
+// Printer returns an output.Printer for human-readable output written to the
+// standard output stream.
+func (o *Option) Printer() *output.Printer {
+	return output.NewPrinter(o.IOStreams.Out, o.NoColor)
+}
+
+// ErrPrinter returns an output.Printer for human-readable output written to
+// the standard error stream.
+func (o *Option) ErrPrinter() *output.Printer {
+	return output.NewPrinter(o.IOStreams.ErrOut, o.NoColor)
+}
+
+// PrintResults prints the provided Results of a command that operates on
+// several items at once. If an output format was selected using the --output
+// flag, all Results, including failures, are printed as a List in that format.
+// Otherwise, a human-readable line is printed for each success.
+func (o *Option) PrintResults(results []output.Result) error {
+	if pointer.StringDeref(o.PrintFlags.OutputFormat, "") == "" {
+		o.Printer().Results(results)
+		return nil
+	}
+	printer, err := o.PrintFlags.ToPrinter()
+	if err != nil {
+		return errors.Wrap(err, "new printer")
+	}
+	list, err := output.NewResultList(results)
+	if err != nil {
+		return errors.Wrap(err, "build result list")
+	}
+	return printer.PrintObj(list, o.IOStreams.Out)
+}
+
 func NewScheme() (*runtime.Scheme, error) {
 	scheme := runtime.NewScheme()
 	if err := corev1.AddToScheme(scheme); err != nil {
@@ -63,3 +115,15 @@ func MinimumNArgs(n int) cobra.PositionalArgs {
 		return nil
 	}
 }
+
+// MaximumNArgs is a wrapper around cobra.MaximumNArgs to additionally print usage string
+func MaximumNArgs(n int) cobra.PositionalArgs {
+	maxNArgs := cobra.MaximumNArgs(n)
+	return func(cmd *cobra.Command, args []string) error {
+		if err := maxNArgs(cmd, args); err != nil {
+			fmt.Println(cmd.UsageString())
+			return err
+		}
+		return nil
+	}
+}
@@ -0,0 +1,134 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// State is a coarse classification of the state of a resource, used for
+// rendering that state consistently across all commands.
+type State string
+
+const (
+	StateHealthy     State = "healthy"
+	StateProgressing State = "progressing"
+	StateUnhealthy   State = "unhealthy"
+	StateUnknown     State = "unknown"
+)
+
+const (
+	colorGreen  = "\x1b[32m"
+	colorRed    = "\x1b[31m"
+	colorYellow = "\x1b[33m"
+	// colorDefault has the same length as the other colors. It is used for
+	// cells without a state so that all cells in a column carry escape
+	// sequences of equal width, which keeps tabular output aligned.
+	colorDefault = "\x1b[39m"
+	colorReset   = "\x1b[0m"
+)
+
+// stateColors maps each State to the color used for rendering it.
+var stateColors = map[State]string{
+	StateHealthy:     colorGreen,
+	StateProgressing: colorYellow,
+	StateUnhealthy:   colorRed,
+	StateUnknown:     colorDefault,
+}
+
+// StateOf classifies the provided human-readable status, such as a Stage's
+// health or a Promotion's phase, as a State.
+func StateOf(status string) State {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case "healthy", "succeeded":
+		return StateHealthy
+	case "progressing", "pending", "running":
+		return StateProgressing
+	case "unhealthy", "errored", "failed":
+		return StateUnhealthy
+	default:
+		return StateUnknown
+	}
+}
+
+// Printer renders human-readable output. Output is colorized only if it is
+// written to a terminal, color has not been disabled using the --no-color
+// flag, and the NO_COLOR environment variable is not set.
+type Printer struct {
+	out   io.Writer
+	tty   bool
+	color bool
+}
+
+// NewPrinter returns a Printer that writes to the provided io.Writer. If
+// noColor is true, output is never colorized.
+func NewPrinter(out io.Writer, noColor bool) *Printer {
+	tty := isTerminal(out)
+	return &Printer{
+		out:   out,
+		tty:   tty,
+		color: tty && !noColor && colorAllowedByEnv(),
+	}
+}
+
+// Printf writes formatted, uncolored output.
+func (p *Printer) Printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(p.out, format, args...)
+}
+
+// Successf writes formatted output colorized as a healthy state.
+func (p *Printer) Successf(format string, args ...any) {
+	_, _ = fmt.Fprint(p.out, p.Colorize(StateHealthy, fmt.Sprintf(format, args...)))
+}
+
+// Error writes the provided error colorized as an unhealthy state.
+func (p *Printer) Error(err error) {
+	_, _ = fmt.Fprintln(p.out, p.Colorize(StateUnhealthy, "Error: "+err.Error()))
+}
+
+// Status returns the provided human-readable status colorized according to
+// the State it is classified as.
+func (p *Printer) Status(status string) string {
+	return p.Colorize(StateOf(status), status)
+}
+
+// Colorize returns the provided text colorized as the provided State. If
+// colorized output is disabled, the text is returned unmodified.
+func (p *Printer) Colorize(state State, text string) string {
+	if !p.color {
+		return text
+	}
+	color, ok := stateColors[state]
+	if !ok {
+		color = colorDefault
+	}
+	return color + text + colorReset
+}
+
+// IsInteractive returns true if both the provided io.Reader and io.Writer are
+// terminals. i.e. If the user can be prompted for input.
+func IsInteractive(in io.Reader, out io.Writer) bool {
+	f, ok := in.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return false
+	}
+	return isTerminal(out)
+}
+
+// isTerminal returns true if the provided io.Writer is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	return term.IsTerminal(int(f.Fd()))
+}
+
+// colorAllowedByEnv returns false if the environment indicates that output
+// should not be colorized. See https://no-color.org.
+func colorAllowedByEnv() bool {
+	return os.Getenv("NO_COLOR") == "" && os.Getenv("TERM") != "dumb"
+}
@@ -0,0 +1,146 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateOf(t *testing.T) {
+	testCases := map[string]State{
+		"Healthy":     StateHealthy,
+		"Succeeded":   StateHealthy,
+		"Progressing": StateProgressing,
+		"Pending":     StateProgressing,
+		"Running":     StateProgressing,
+		"Unhealthy":   StateUnhealthy,
+		"Errored":     StateUnhealthy,
+		"Failed":      StateUnhealthy,
+		"":            StateUnknown,
+		"bogus":       StateUnknown,
+	}
+	for status, expected := range testCases {
+		require.Equal(t, expected, StateOf(status), status)
+	}
+}
+
+func TestNewPrinter(t *testing.T) {
+	// A bytes.Buffer is not a terminal, so output should never be colorized
+	p := NewPrinter(&bytes.Buffer{}, false)
+	require.False(t, p.tty)
+	require.False(t, p.color)
+}
+
+func TestPrinter(t *testing.T) {
+	testCases := []struct {
+		name       string
+		color      bool
+		assertions func(*Printer, *bytes.Buffer)
+	}{
+		{
+			name: "color disabled",
+			assertions: func(p *Printer, buf *bytes.Buffer) {
+				p.Successf("Stage %q created\n", "test")
+				p.Error(errors.New("something went wrong"))
+				require.Equal(
+					t,
+					"Stage \"test\" created\nError: something went wrong\n",
+					buf.String(),
+				)
+				require.Equal(t, "Errored", p.Status("Errored"))
+			},
+		},
+		{
+			name:  "color enabled",
+			color: true,
+			assertions: func(p *Printer, buf *bytes.Buffer) {
+				p.Successf("Stage %q created\n", "test")
+				p.Error(errors.New("something went wrong"))
+				require.Equal(
+					t,
+					colorGreen+"Stage \"test\" created\n"+colorReset+
+						colorRed+"Error: something went wrong"+colorReset+"\n",
+					buf.String(),
+				)
+				require.Equal(t, colorRed+"Errored"+colorReset, p.Status("Errored"))
+				require.Equal(t, colorYellow+"Running"+colorReset, p.Status("Running"))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			testCase.assertions(&Printer{out: buf, color: testCase.color}, buf)
+		})
+	}
+}
+
+func TestColorAllowedByEnv(t *testing.T) {
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("NO_COLOR", "")
+	require.True(t, colorAllowedByEnv())
+	t.Setenv("NO_COLOR", "1")
+	require.False(t, colorAllowedByEnv())
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("TERM", "dumb")
+	require.False(t, colorAllowedByEnv())
+}
+
+func TestIsInteractive(t *testing.T) {
+	// Neither a buffer nor a regular file is a terminal
+	require.False(t, IsInteractive(&bytes.Buffer{}, &bytes.Buffer{}))
+	f, err := os.CreateTemp(t.TempDir(), "")
+	require.NoError(t, err)
+	defer f.Close()
+	require.False(t, IsInteractive(f, f))
+}
+
+func TestTable(t *testing.T) {
+	columns := []Column{
+		{Name: "Name"},
+		{Name: "Health", Stateful: true},
+		{Name: "Age"},
+	}
+	rows := [][]Cell{
+		{{Text: "test"}, {Text: "Healthy", State: StateHealthy}, {Text: "5m"}},
+		{{Text: "another-test"}, {}, {Text: "3d"}},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, (&Printer{out: buf}).Table(columns, rows))
+	require.Equal(
+		t,
+		"NAME           HEALTH    AGE\n"+
+			"test           Healthy   5m\n"+
+			"another-test             3d\n",
+		buf.String(),
+	)
+
+	// With color enabled, every cell of a stateful column carries escape
+	// sequences of the same width, so the visible layout is unchanged.
+	buf.Reset()
+	require.NoError(t, (&Printer{out: buf, color: true}).Table(columns, rows))
+	require.Equal(
+		t,
+		"NAME           "+colorDefault+"HEALTH"+colorReset+"    AGE\n"+
+			"test           "+colorGreen+"Healthy"+colorReset+"   5m\n"+
+			"another-test   "+colorDefault+colorReset+"          3d\n",
+		buf.String(),
+	)
+}
+
+func TestSpinner(t *testing.T) {
+	buf := &bytes.Buffer{}
+	s := (&Printer{out: buf}).Spinner("Waiting")
+	// Stopping a Spinner that was never started should have no effect
+	s.Stop()
+	s.Start()
+	s.Start()
+	s.Stop()
+	s.Stop()
+	// Output is not a terminal, so the message should be printed exactly once
+	require.Equal(t, "Waiting...\n", buf.String())
+}
@@ -0,0 +1,66 @@
+package output
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ResultStatusFailed is the status of a Result for an item on which an
+// operation failed.
+const ResultStatusFailed = "Failed"
+
+// Result is the outcome of an operation on a single item by a command that
+// operates on several items at once (e.g. deleting several Stages). Such
+// commands print a Result for every item so that scripts can determine the
+// outcome for each item individually.
+type Result struct {
+	// Kind is the kind of the item (e.g. Stage), if known.
+	Kind string `json:"kind,omitempty"`
+	// Name is the name of the item, if known.
+	Name string `json:"name"`
+	// Status is ResultStatusFailed if the operation failed. Otherwise, it
+	// describes the operation that succeeded (e.g. Deleted).
+	Status string `json:"status"`
+	// Error is the message of the error that caused the operation to fail.
+	Error string `json:"error,omitempty"`
+}
+
+// Failed returns true if the operation on the item failed.
+func (r Result) Failed() bool {
+	return r.Status == ResultStatusFailed
+}
+
+// NewResultList returns the provided Results as a List that can be printed
+// using any printer of structured output (e.g. JSON or YAML). A List is
+// returned even for a single Result so that output is always of the same
+// shape.
+func NewResultList(results []Result) (*metav1.List, error) {
+	items := make([]runtime.RawExtension, len(results))
+	for i, result := range results {
+		raw, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = runtime.RawExtension{Raw: raw}
+	}
+	return &metav1.List{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.Unversioned.String(),
+			Kind:       "List",
+		},
+		Items: items,
+	}, nil
+}
+
+// Results writes a human-readable line for each of the provided Results for
+// which the operation succeeded. Failures are not written, as commands
+// return them as errors.
+func (p *Printer) Results(results []Result) {
+	for _, result := range results {
+		if !result.Failed() {
+			p.Successf("%s %s: %q\n", result.Kind, result.Status, result.Name)
+		}
+	}
+}
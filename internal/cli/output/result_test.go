@@ -0,0 +1,62 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+func TestNewResultList(t *testing.T) {
+	list, err := NewResultList([]Result{
+		{
+			Kind:   "Stage",
+			Name:   "test-stage",
+			Status: "Deleted",
+		},
+		{
+			Kind:   "Stage",
+			Name:   "another-stage",
+			Status: ResultStatusFailed,
+			Error:  "not found",
+		},
+	})
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	require.NoError(t, (&printers.YAMLPrinter{}).PrintObj(list, buf))
+	require.Equal(
+		t,
+		`apiVersion: v1
+items:
+- kind: Stage
+  name: test-stage
+  status: Deleted
+- error: not found
+  kind: Stage
+  name: another-stage
+  status: Failed
+kind: List
+metadata: {}
+`,
+		buf.String(),
+	)
+}
+
+func TestPrinterResults(t *testing.T) {
+	buf := &bytes.Buffer{}
+	NewPrinter(buf, true).Results([]Result{
+		{
+			Kind:   "Stage",
+			Name:   "test-stage",
+			Status: "Deleted",
+		},
+		{
+			Kind:   "Stage",
+			Name:   "another-stage",
+			Status: ResultStatusFailed,
+			Error:  "not found",
+		},
+	})
+	require.Equal(t, "Stage Deleted: \"test-stage\"\n", buf.String())
+}
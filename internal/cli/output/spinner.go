@@ -0,0 +1,90 @@
+package output
+
+import (
+	"sync"
+	"time"
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+const spinnerInterval = 100 * time.Millisecond
+
+// Spinner indicates progress of a long-running operation. It is only
+// animated if the Printer it was obtained from writes to a terminal;
+// otherwise, its message is printed once.
+type Spinner struct {
+	printer *Printer
+	message string
+
+	mu      sync.Mutex
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+	running bool
+}
+
+// Spinner returns a new, unstarted Spinner displaying the provided message.
+func (p *Printer) Spinner(message string) *Spinner {
+	return &Spinner{
+		printer: p,
+		message: message,
+	}
+}
+
+// Start starts the Spinner. Starting a Spinner that is already running has no
+// effect.
+func (s *Spinner) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		return
+	}
+	s.running = true
+	if !s.printer.tty {
+		s.printer.Printf("%s...\n", s.message)
+		return
+	}
+	s.stopCh = make(chan struct{})
+	s.doneCh = make(chan struct{})
+	go s.run()
+}
+
+func (s *Spinner) run() {
+	defer close(s.doneCh)
+	ticker := time.NewTicker(spinnerInterval)
+	defer ticker.Stop()
+	for i := 0; ; i++ {
+		s.printer.Printf(
+			"\r%s %s",
+			s.printer.Colorize(
+				StateProgressing,
+				spinnerFrames[i%len(spinnerFrames)],
+			),
+			s.message,
+		)
+		select {
+		case <-s.stopCh:
+			// Clear the line
+			s.printer.Printf("\r\x1b[K")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops the Spinner and removes it from the terminal. Stopping a Spinner
+// that is not running has no effect.
+func (s *Spinner) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.running {
+		return
+	}
+	s.running = false
+	if s.stopCh == nil {
+		return
+	}
+	close(s.stopCh)
+	<-s.doneCh
+	s.stopCh = nil
+	s.doneCh = nil
+}
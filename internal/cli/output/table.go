@@ -0,0 +1,59 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+// Column describes a column of a table.
+type Column struct {
+	Name string
+	// Stateful indicates that the cells of the column reflect the state of a
+	// resource and should be colorized accordingly.
+	Stateful bool
+}
+
+// Cell is a single cell of a table.
+type Cell struct {
+	Text string
+	// State is the State reflected by the cell. It is only used for cells in
+	// Stateful columns.
+	State State
+}
+
+// Table writes the provided columns and rows as a table whose layout matches
+// that of tables printed by kubectl.
+func (p *Printer) Table(columns []Column, rows [][]Cell) error {
+	w := tabwriter.NewWriter(p.out, 6, 4, 3, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = strings.ToUpper(column.Name)
+		if column.Stateful {
+			// Escape sequences count towards the width of a cell, so headers of
+			// colorized columns get an escape sequence of the same width as the
+			// cells beneath them.
+			headers[i] = p.Colorize(StateUnknown, headers[i])
+		}
+	}
+	if _, err := fmt.Fprintln(w, strings.Join(headers, "\t")); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = cell.Text
+			if i < len(columns) && columns[i].Stateful {
+				state := cell.State
+				if state == "" {
+					state = StateUnknown
+				}
+				cells[i] = p.Colorize(state, cell.Text)
+			}
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(cells, "\t")); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
@@ -75,17 +75,22 @@ func refreshObject(
 		}
 
 		if flag.Wait {
+			spinner := opt.ErrPrinter().Spinner(
+				fmt.Sprintf("Waiting for %s '%s/%s' to refresh", resourceType, project, name),
+			)
+			spinner.Start()
 			switch resourceType {
 			case refreshResourceTypeWarehouse:
 				err = waitForWarehouse(ctx, kargoSvcCli, project, name)
 			case refreshResourceTypeStage:
 				err = waitForStage(ctx, kargoSvcCli, project, name)
 			}
+			spinner.Stop()
 			if err != nil {
 				return errors.Wrapf(err, "wait %s", resourceType)
 			}
 		}
-		fmt.Printf("%s '%s/%s' refreshed\n", resourceType, project, name)
+		opt.Printer().Successf("%s '%s/%s' refreshed\n", resourceType, project, name)
 		return nil
 	}
 }
@@ -1,30 +1,44 @@
 package stage
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/AlecAivazis/survey/v2"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/utils/pointer"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+	apiv1alpha1 "github.com/akuity/kargo/pkg/api/v1alpha1"
 )
 
 type PromoteFlags struct {
-	Freight string
+	Freight          string
+	ProjectOfFreight string
+	ToAllDownstream  bool
+	Soak             string
+	Wait             bool
 }
 
 func newPromoteCommand(opt *option.Option) *cobra.Command {
 	var flag PromoteFlags
 	cmd := &cobra.Command{
-		Use:     "promote",
-		Args:    option.ExactArgs(2),
-		Example: "kargo stage promote (PROJECT) (NAME) [(--freight=)freight-id]",
+		Use:  "promote",
+		Args: option.ExactArgs(2),
+		Example: "kargo stage promote (PROJECT) (NAME) [(--freight=)freight-id] " +
+			"[--project-of-freight=project] [--to-all-downstream [--soak=1h]] [--wait]",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
@@ -42,33 +56,234 @@ func newPromoteCommand(opt *option.Option) *cobra.Command {
 			}
 			freight := strings.TrimSpace(flag.Freight)
 			if freight == "" {
-				// TODO: Get latest available freight if empty
-				return errors.New("freight is required")
+				if strings.TrimSpace(flag.ProjectOfFreight) != "" {
+					return errors.New("freight is required when --project-of-freight is specified")
+				}
+				if freight, err = pickFreight(ctx, opt, kargoSvcCli, project, name); err != nil {
+					return err
+				}
 			}
 
-			res, err := kargoSvcCli.PromoteStage(ctx, connect.NewRequest(&v1alpha1.PromoteStageRequest{
-				Project: project,
-				Name:    name,
-				Freight: freight,
-			}))
+			req := &v1alpha1.PromoteStageRequest{
+				Project:         project,
+				Name:            name,
+				Freight:         freight,
+				ToAllDownstream: flag.ToAllDownstream,
+			}
+			if soak := strings.TrimSpace(flag.Soak); soak != "" {
+				req.Soak = pointer.String(soak)
+			}
+			if freightProject := strings.TrimSpace(flag.ProjectOfFreight); freightProject != "" {
+				req.FreightProject = pointer.String(freightProject)
+			}
+
+			res, err := kargoSvcCli.PromoteStage(ctx, connect.NewRequest(req))
 			if err != nil {
 				return errors.Wrap(err, "promote stage")
 			}
+			promo := res.Msg.GetPromotion()
+			if flag.Wait && promo != nil {
+				spinner := opt.ErrPrinter().Spinner(
+					fmt.Sprintf("Waiting for Promotion %q to complete", promo.GetMetadata().GetName()),
+				)
+				spinner.Start()
+				promo, err = waitForPromotion(ctx, kargoSvcCli, project, name, promo.GetMetadata().GetName())
+				spinner.Stop()
+				if err != nil {
+					return errors.Wrap(err, "wait for promotion")
+				}
+			}
 			if pointer.StringDeref(opt.PrintFlags.OutputFormat, "") == "" {
-				fmt.Fprintf(opt.IOStreams.Out,
-					"Promotion Created: %q\n", res.Msg.GetPromotion().GetMetadata().GetName())
-				return nil
+				if r := res.Msg.GetRelease(); r != nil {
+					opt.Printer().Successf("Release Created: %q\n", r.GetMetadata().GetName())
+					return nil
+				}
+				if !flag.Wait {
+					opt.Printer().Successf("Promotion Created: %q\n", promo.GetMetadata().GetName())
+					return nil
+				}
+				printer := opt.Printer()
+				phase := promo.GetStatus().GetPhase()
+				printer.Printf("Promotion %q %s\n", promo.GetMetadata().GetName(), printer.Status(phase))
+				return promotionError(promo)
 			}
 			printer, err := opt.PrintFlags.ToPrinter()
 			if err != nil {
 				return errors.Wrap(err, "new printer")
 			}
-			promo := typesv1alpha1.FromPromotionProto(res.Msg.GetPromotion())
-			_ = printer.PrintObj(promo, opt.IOStreams.Out)
+			if r := res.Msg.GetRelease(); r != nil {
+				_ = printer.PrintObj(typesv1alpha1.FromReleaseProto(r), opt.IOStreams.Out)
+				return nil
+			}
+			_ = printer.PrintObj(typesv1alpha1.FromPromotionProto(promo), opt.IOStreams.Out)
+			if flag.Wait {
+				return promotionError(promo)
+			}
 			return nil
 		},
 	}
 	opt.PrintFlags.AddFlags(cmd)
 	option.Freight(&flag.Freight)(cmd.Flags())
+	option.ProjectOfFreight(&flag.ProjectOfFreight)(cmd.Flags())
+	option.ToAllDownstream(&flag.ToAllDownstream)(cmd.Flags())
+	option.Soak(&flag.Soak)(cmd.Flags())
+	option.Wait(&flag.Wait, "Wait until the Promotion completes")(cmd.Flags())
 	return cmd
 }
+
+// maxFreightSuggestions is the maximum number of pieces of Freight suggested
+// when Freight to promote was not specified and cannot be prompted for.
+const maxFreightSuggestions = 5
+
+// pickFreight prompts the user to select one of the pieces of Freight that are
+// available to the specified Stage, newest first. If the user cannot be
+// prompted because the session is not interactive, an error suggesting the
+// newest of those pieces of Freight is returned instead.
+func pickFreight(
+	ctx context.Context,
+	opt *option.Option,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	project string,
+	stage string,
+) (string, error) {
+	res, err := kargoSvcCli.QueryFreight(ctx, connect.NewRequest(&v1alpha1.QueryFreightRequest{
+		Project: project,
+		Stage:   stage,
+		Reverse: true,
+	}))
+	if err != nil {
+		return "", errors.Wrap(err, "query freight")
+	}
+	// We didn't specify any groupBy, so there should be one group with an
+	// empty key
+	freight := res.Msg.GetGroups()[""].GetFreight()
+	if len(freight) == 0 {
+		return "", errors.Errorf(
+			"freight is required, but no freight is available to stage %q; run "+
+				"\"kargo explain-promotion --project=%s --stage=%s (FREIGHT)\" to "+
+				"find out why a piece of freight is not available to it",
+			stage,
+			project,
+			stage,
+		)
+	}
+	summaries := make([]string, len(freight))
+	now := time.Now()
+	for i, f := range freight {
+		summaries[i] = summarizeFreight(f, now)
+	}
+
+	if !output.IsInteractive(opt.IOStreams.In, opt.IOStreams.Out) {
+		suggestions := summaries
+		if len(suggestions) > maxFreightSuggestions {
+			suggestions = suggestions[:maxFreightSuggestions]
+		}
+		return "", errors.Errorf(
+			"freight is required when not running interactively; specify it "+
+				"using --freight. The newest freight available to stage %q is:\n  %s",
+			stage,
+			strings.Join(suggestions, "\n  "),
+		)
+	}
+
+	var selected int
+	prompt := &survey.Select{
+		Message:  fmt.Sprintf("Select freight to promote to stage %q", stage),
+		Options:  summaries,
+		PageSize: 10,
+	}
+	// IsInteractive() has established that both streams are terminals
+	in := opt.IOStreams.In.(*os.File)   // nolint: forcetypeassert
+	out := opt.IOStreams.Out.(*os.File) // nolint: forcetypeassert
+	if err = survey.AskOne(
+		prompt,
+		&selected,
+		survey.WithStdio(in, out, opt.IOStreams.ErrOut),
+	); err != nil {
+		return "", errors.Wrap(err, "select freight")
+	}
+	return freight[selected].GetMetadata().GetName(), nil
+}
+
+// summarizeFreight returns a single line summary of the provided Freight,
+// comprising its ID, alias, age, and the artifacts it references.
+func summarizeFreight(freight *apiv1alpha1.Freight, now time.Time) string {
+	var sb strings.Builder
+	sb.WriteString(freight.GetMetadata().GetName())
+	if alias := freight.GetAlias(); alias != "" {
+		sb.WriteString(" (" + alias + ")")
+	}
+	sb.WriteString(", " + duration.HumanDuration(
+		now.Sub(freight.GetMetadata().GetCreationTimestamp().AsTime()),
+	) + " old")
+	artifacts := make([]string, 0, len(freight.GetImages())+
+		len(freight.GetCommits())+len(freight.GetCharts()))
+	for _, image := range freight.GetImages() {
+		artifacts = append(artifacts, image.GetRepoUrl()+":"+image.GetTag())
+	}
+	for _, commit := range freight.GetCommits() {
+		id := commit.GetId()
+		if len(id) > 7 {
+			id = id[:7]
+		}
+		artifacts = append(artifacts, commit.GetRepoUrl()+"@"+id)
+	}
+	for _, chart := range freight.GetCharts() {
+		artifacts = append(artifacts, chart.GetName()+":"+chart.GetVersion())
+	}
+	if len(artifacts) > 0 {
+		sb.WriteString(": " + strings.Join(artifacts, ", "))
+	}
+	return sb.String()
+}
+
+// waitForPromotion watches the specified Promotion until it reaches a terminal
+// phase and returns it in that state.
+func waitForPromotion(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	project string,
+	stage string,
+	name string,
+) (*apiv1alpha1.Promotion, error) {
+	res, err := kargoSvcCli.WatchPromotions(ctx, connect.NewRequest(&v1alpha1.WatchPromotionsRequest{
+		Project: project,
+		Stage:   pointer.String(stage),
+	}))
+	if err != nil {
+		return nil, errors.Wrap(err, "watch promotions")
+	}
+	defer func() {
+		if conn, connErr := res.Conn(); connErr == nil {
+			_ = conn.CloseRequest()
+		}
+	}()
+	for {
+		if !res.Receive() {
+			if err = res.Err(); err != nil {
+				return nil, errors.Wrap(err, "watch promotions")
+			}
+			return nil, errors.New("unexpected end of watch stream")
+		}
+		promo := res.Msg().GetPromotion()
+		if promo.GetMetadata().GetName() != name {
+			continue
+		}
+		phase := kargoapi.PromotionPhase(promo.GetStatus().GetPhase())
+		if phase.IsTerminal() {
+			return promo, nil
+		}
+	}
+}
+
+// promotionError returns an error if the provided Promotion did not succeed.
+func promotionError(promo *apiv1alpha1.Promotion) error {
+	if kargoapi.PromotionPhase(promo.GetStatus().GetPhase()) ==
+		kargoapi.PromotionPhaseSucceeded {
+		return nil
+	}
+	if msg := promo.GetStatus().GetError(); msg != "" {
+		return errors.Errorf("promotion %q failed: %s", promo.GetMetadata().GetName(), msg)
+	}
+	return errors.Errorf("promotion %q failed", promo.GetMetadata().GetName())
+}
@@ -7,6 +7,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/printers"
 	"k8s.io/utils/pointer"
 
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
@@ -16,15 +17,18 @@ import (
 )
 
 type PromoteSubscribersFlags struct {
-	Freight string
+	Freight      string
+	RolloutOrder []string
+	Soak         string
 }
 
 func newPromoteSubscribersCommand(opt *option.Option) *cobra.Command {
 	var flag PromoteSubscribersFlags
 	cmd := &cobra.Command{
-		Use:     "promote-subscribers",
-		Args:    option.ExactArgs(2),
-		Example: "kargo stage promote-subscribers (PROJECT) (NAME) [(--freight=)freight-id]",
+		Use:  "promote-subscribers",
+		Args: option.ExactArgs(2),
+		Example: "kargo stage promote-subscribers (PROJECT) (NAME) [(--freight=)freight-id] " +
+			"[--rollout-order=stage-a,stage-b [--soak=1h]]",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
@@ -45,35 +49,73 @@ func newPromoteSubscribersCommand(opt *option.Option) *cobra.Command {
 				return errors.New("freight is required")
 			}
 
-			res, promoteErr := kargoSvcCli.PromoteSubscribers(ctx, connect.NewRequest(&v1alpha1.PromoteSubscribersRequest{
+			req := &v1alpha1.PromoteSubscribersRequest{
 				Project: project,
 				Stage:   name,
 				Freight: freight,
-			}))
-			if pointer.StringDeref(opt.PrintFlags.OutputFormat, "") == "" {
-				if res != nil && res.Msg != nil {
-					for _, p := range res.Msg.GetPromotions() {
-						fmt.Fprintf(opt.IOStreams.Out, "Promotion Created: %q\n", *p.Metadata.Name)
-					}
+			}
+			for _, stage := range flag.RolloutOrder {
+				if stage = strings.TrimSpace(stage); stage != "" {
+					req.RolloutOrder = append(req.RolloutOrder, stage)
 				}
-				if promoteErr != nil {
-					return errors.Wrap(promoteErr, "promote subscribers")
+			}
+			if soak := strings.TrimSpace(flag.Soak); soak != "" {
+				req.Soak = pointer.String(soak)
+			}
+
+			var printer printers.ResourcePrinter
+			if pointer.StringDeref(opt.PrintFlags.OutputFormat, "") != "" {
+				if printer, err = opt.PrintFlags.ToPrinter(); err != nil {
+					return errors.Wrap(err, "new printer")
 				}
-				return nil
 			}
 
-			printer, printerErr := opt.PrintFlags.ToPrinter()
-			if printerErr != nil {
-				return errors.Wrap(printerErr, "new printer")
+			stream, err := kargoSvcCli.PromoteSubscribers(ctx, connect.NewRequest(req))
+			if err != nil {
+				return errors.Wrap(err, "promote subscribers")
+			}
+			defer func() {
+				if conn, connErr := stream.Conn(); connErr == nil {
+					_ = conn.CloseRequest()
+				}
+			}()
+			for stream.Receive() {
+				res := stream.Msg()
+				if printer != nil {
+					if p := res.GetPromotion(); p != nil {
+						_ = printer.PrintObj(typesv1alpha1.FromPromotionProto(p), opt.IOStreams.Out)
+					}
+					if r := res.GetRelease(); r != nil {
+						_ = printer.PrintObj(typesv1alpha1.FromReleaseProto(r), opt.IOStreams.Out)
+					}
+					continue
+				}
+				if r := res.GetRelease(); r != nil {
+					opt.Printer().Successf("Release Created: %q\n", r.GetMetadata().GetName())
+					continue
+				}
+				progress := fmt.Sprintf("[%d/%d]", res.GetCompleted(), res.GetTotal())
+				if msg := res.GetError(); msg != "" {
+					opt.ErrPrinter().Error(
+						errors.Errorf("%s promote stage %q: %s", progress, res.GetStage(), msg),
+					)
+					continue
+				}
+				opt.Printer().Successf(
+					"%s Promotion Created: %q\n",
+					progress,
+					res.GetPromotion().GetMetadata().GetName(),
+				)
 			}
-			for _, p := range res.Msg.GetPromotions() {
-				kubeP := typesv1alpha1.FromPromotionProto(p)
-				_ = printer.PrintObj(kubeP, opt.IOStreams.Out)
+			if err = stream.Err(); err != nil {
+				return errors.Wrap(err, "promote subscribers")
 			}
-			return promoteErr
+			return nil
 		},
 	}
 	opt.PrintFlags.AddFlags(cmd)
 	option.Freight(&flag.Freight)(cmd.Flags())
+	option.RolloutOrder(&flag.RolloutOrder)(cmd.Flags())
+	option.Soak(&flag.Soak)(cmd.Flags())
 	return cmd
 }
@@ -0,0 +1,59 @@
+package stage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/utils/pointer"
+
+	"github.com/akuity/kargo/pkg/api/metav1"
+	apiv1alpha1 "github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+func TestSummarizeFreight(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		freight  *apiv1alpha1.Freight
+		expected string
+	}{
+		{
+			name: "no alias or artifacts",
+			freight: &apiv1alpha1.Freight{
+				Metadata: &metav1.ObjectMeta{
+					Name:              pointer.String("fake-freight"),
+					CreationTimestamp: timestamppb.New(now.Add(-2 * time.Hour)),
+				},
+			},
+			expected: "fake-freight, 120m old",
+		},
+		{
+			name: "with alias and artifacts",
+			freight: &apiv1alpha1.Freight{
+				Metadata: &metav1.ObjectMeta{
+					Name:              pointer.String("fake-freight"),
+					CreationTimestamp: timestamppb.New(now.Add(-3 * 24 * time.Hour)),
+				},
+				Alias: "payments-1234",
+				Images: []*apiv1alpha1.Image{
+					{RepoUrl: "nginx", Tag: "1.25.0"},
+				},
+				Commits: []*apiv1alpha1.GitCommit{
+					{RepoUrl: "https://github.com/example/guestbook", Id: "abc1234def5678"},
+				},
+				Charts: []*apiv1alpha1.Chart{
+					{Name: "guestbook", Version: "1.0.0"},
+				},
+			},
+			expected: "fake-freight (payments-1234), 3d old: nginx:1.25.0, " +
+				"https://github.com/example/guestbook@abc1234, guestbook:1.0.0",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, summarizeFreight(testCase.freight, now))
+		})
+	}
+}
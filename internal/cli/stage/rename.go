@@ -0,0 +1,83 @@
+package stage
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/output"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newRenameCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rename (PROJECT) (NAME) (NEW_NAME)",
+		Short: "Rename a stage and every reference to it",
+		Args:  option.ExactArgs(3),
+		Example: `
+# Rename a stage
+kargo stage rename my-project my-stage my-renamed-stage
+
+# Rename a stage and print every resource that was updated as JSON
+kargo stage rename my-project my-stage my-renamed-stage -o json
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := strings.TrimSpace(args[0])
+			if project == "" {
+				return errors.New("project is required")
+			}
+			name := strings.TrimSpace(args[1])
+			if name == "" {
+				return errors.New("name is required")
+			}
+			newName := strings.TrimSpace(args[2])
+			if newName == "" {
+				return errors.New("new name is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return err
+			}
+			resp, err := kargoSvcCli.RenameStage(ctx, connect.NewRequest(&v1alpha1.RenameStageRequest{
+				Project: project,
+				Name:    name,
+				NewName: newName,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "rename stage")
+			}
+
+			results := []output.Result{{
+				Kind:   "Stage",
+				Name:   resp.Msg.GetStage().GetMetadata().GetName(),
+				Status: "Renamed",
+			}}
+			for _, kindNames := range []struct {
+				kind  string
+				names []string
+			}{
+				{kind: "Stage", names: resp.Msg.GetUpdatedStages()},
+				{kind: "PromotionPolicy", names: resp.Msg.GetUpdatedPromotionPolicies()},
+				{kind: "Freight", names: resp.Msg.GetUpdatedFreight()},
+			} {
+				for _, name := range kindNames.names {
+					results = append(results, output.Result{
+						Kind:   kindNames.kind,
+						Name:   name,
+						Status: "Updated",
+					})
+				}
+			}
+			return opt.PrintResults(results)
+		},
+	}
+	opt.PrintFlags.AddFlags(cmd)
+	return cmd
+}
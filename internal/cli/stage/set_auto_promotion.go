@@ -2,7 +2,6 @@ package stage
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -82,8 +81,8 @@ func setAutoPromotionForStage(ctx context.Context, opt *option.Option, project,
 		if enable {
 			res = "Enabled"
 		}
-		fmt.Fprintf(opt.IOStreams.Out,
-			"%s AutoPromotion for Stage %q", res, resp.Msg.GetPromotionPolicy().GetStage())
+		opt.Printer().Successf(
+			"%s AutoPromotion for Stage %q\n", res, resp.Msg.GetPromotionPolicy().GetStage())
 		return nil
 	}
 	printer, err := opt.PrintFlags.ToPrinter()
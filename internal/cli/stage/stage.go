@@ -15,5 +15,6 @@ func NewCommand(opt *option.Option) *cobra.Command {
 	cmd.AddCommand(newEnableAutoPromotion(opt))
 	cmd.AddCommand(newDisableAutoPromotion(opt))
 	cmd.AddCommand(newPromoteSubscribersCommand(opt))
+	cmd.AddCommand(newRenameCommand(opt))
 	return cmd
 }
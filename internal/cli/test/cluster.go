@@ -0,0 +1,63 @@
+package test
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newClusterCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cluster --project=project (NAME)",
+		Short:   "Test the connection to a cluster",
+		Args:    option.ExactArgs(1),
+		Example: "kargo test cluster --project=my-project prod-us",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project.OrElse("")
+			if project == "" {
+				return errors.New("project is required")
+			}
+			name := strings.TrimSpace(args[0])
+			if name == "" {
+				return errors.New("name is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			spinner := opt.ErrPrinter().Spinner("Testing cluster connection")
+			spinner.Start()
+			res, err := kargoSvcCli.TestClusterConnection(
+				ctx,
+				connect.NewRequest(&v1alpha1.TestClusterConnectionRequest{
+					Project: project,
+					Name:    name,
+				}),
+			)
+			spinner.Stop()
+			if err != nil {
+				return errors.Wrap(err, "test cluster connection")
+			}
+			if !res.Msg.GetSuccess() {
+				return errors.Errorf(
+					"connection test for %q failed: %s",
+					res.Msg.GetServer(),
+					res.Msg.GetMessage(),
+				)
+			}
+			opt.Printer().Successf("%s: %s\n", res.Msg.GetServer(), res.Msg.GetMessage())
+			return nil
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	return cmd
+}
@@ -0,0 +1,76 @@
+package test
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type CredentialsFlags struct {
+	RepoURL string
+}
+
+func newCredentialsCommand(opt *option.Option) *cobra.Command {
+	var flag CredentialsFlags
+	cmd := &cobra.Command{
+		Use:   "credentials --project=project (NAME)",
+		Short: "Test repository credentials stored in a secret",
+		Args:  option.ExactArgs(1),
+		Example: `
+# Test the credentials stored in a secret
+kargo test credentials --project=my-project my-repo-creds
+
+# Test a credentials template against a specific repository
+kargo test credentials --project=my-project \
+  --repo-url=https://github.com/example/config my-repo-creds-template
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project.OrElse("")
+			if project == "" {
+				return errors.New("project is required")
+			}
+			name := strings.TrimSpace(args[0])
+			if name == "" {
+				return errors.New("name is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			spinner := opt.ErrPrinter().Spinner("Testing credentials")
+			spinner.Start()
+			res, err := kargoSvcCli.TestCredentials(ctx, connect.NewRequest(&v1alpha1.TestCredentialsRequest{
+				Project: project,
+				Name:    name,
+				RepoUrl: flag.RepoURL,
+			}))
+			spinner.Stop()
+			if err != nil {
+				return errors.Wrap(err, "test credentials")
+			}
+			if !res.Msg.GetSuccess() {
+				return errors.Errorf(
+					"%s credentials test for %q failed: %s",
+					res.Msg.GetType(),
+					res.Msg.GetRepoUrl(),
+					res.Msg.GetMessage(),
+				)
+			}
+			opt.Printer().Successf("%s\n", res.Msg.GetMessage())
+			return nil
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	cmd.Flags().StringVar(&flag.RepoURL, "repo-url", "",
+		"URL of the repository to test a credentials template against")
+	return cmd
+}
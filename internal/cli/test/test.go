@@ -0,0 +1,17 @@
+package test
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test",
+		Short: "Test connections to repositories and clusters",
+	}
+	cmd.AddCommand(newCredentialsCommand(opt))
+	cmd.AddCommand(newClusterCommand(opt))
+	return cmd
+}
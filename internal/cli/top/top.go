@@ -0,0 +1,96 @@
+package top
+
+import (
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	kargosvcapi "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Display controller queue depths, latencies, and error rates",
+		Args:  option.ExactArgs(0),
+		Example: `
+# Display stats for each of the controller's reconcilers
+kargo top
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			resp, err := kargoSvcCli.GetControllerStats(
+				ctx,
+				connect.NewRequest(&kargosvcapi.GetControllerStatsRequest{}),
+			)
+			if err != nil {
+				return errors.Wrap(err, "get controller stats")
+			}
+
+			if err = printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(
+				newControllerStatsTable(resp.Msg),
+				opt.IOStreams.Out,
+			); err != nil {
+				return errors.Wrap(err, "print controller stats")
+			}
+			_, _ = fmt.Fprintf(
+				opt.IOStreams.Out,
+				"\nPromotions in flight: %d\n",
+				resp.Msg.GetPromotionsInFlight(),
+			)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newControllerStatsTable(
+	stats *kargosvcapi.GetControllerStatsResponse,
+) *metav1.Table {
+	rows := make([]metav1.TableRow, len(stats.GetReconcilers()))
+	for i, reconciler := range stats.GetReconcilers() {
+		var errorRate float64
+		if reconciler.GetReconcilesTotal() > 0 {
+			errorRate = 100 * float64(reconciler.GetErrorsTotal()) /
+				float64(reconciler.GetReconcilesTotal())
+		}
+		avgLatency := time.Duration(
+			reconciler.GetAverageReconcileSeconds() * float64(time.Second),
+		)
+		rows[i] = metav1.TableRow{
+			Cells: []any{
+				reconciler.GetController(),
+				reconciler.GetQueueDepth(),
+				reconciler.GetActiveWorkers(),
+				reconciler.GetReconcilesTotal(),
+				reconciler.GetErrorsTotal(),
+				fmt.Sprintf("%.1f%%", errorRate),
+				avgLatency.Round(time.Millisecond).String(),
+			},
+		}
+	}
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Controller", Type: "string"},
+			{Name: "Queue Depth", Type: "integer"},
+			{Name: "Active Workers", Type: "integer"},
+			{Name: "Reconciles", Type: "integer"},
+			{Name: "Errors", Type: "integer"},
+			{Name: "Error Rate", Type: "string"},
+			{Name: "Avg Latency", Type: "string"},
+		},
+		Rows: rows,
+	}
+}
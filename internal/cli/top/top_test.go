@@ -0,0 +1,39 @@
+package top
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargosvcapi "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestNewControllerStatsTable(t *testing.T) {
+	table := newControllerStatsTable(&kargosvcapi.GetControllerStatsResponse{
+		Reconcilers: []*kargosvcapi.ReconcilerStats{
+			{
+				Controller:              "promotion",
+				QueueDepth:              2,
+				ActiveWorkers:           1,
+				ReconcilesTotal:         8,
+				ErrorsTotal:             1,
+				AverageReconcileSeconds: 1.23456,
+			},
+			{
+				Controller: "stage",
+			},
+		},
+	})
+	require.Len(t, table.ColumnDefinitions, 7)
+	require.Len(t, table.Rows, 2)
+	require.Equal(
+		t,
+		[]any{"promotion", int64(2), int64(1), int64(8), int64(1), "12.5%", "1.235s"},
+		table.Rows[0].Cells,
+	)
+	require.Equal(
+		t,
+		[]any{"stage", int64(0), int64(0), int64(0), int64(0), "0.0%", "0s"},
+		table.Rows[1].Cells,
+	)
+}
@@ -0,0 +1,133 @@
+// Package cluster provides utilities for connecting to the external Kubernetes
+// clusters registered with Kargo projects using Cluster resources.
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	// SecretKeyToken is the key of a bearer token in a Secret referenced by a
+	// Cluster.
+	SecretKeyToken = "token"
+	// SecretKeyCert is the key of a PEM-encoded client certificate in a Secret
+	// referenced by a Cluster.
+	SecretKeyCert = "tls.crt"
+	// SecretKeyKey is the key of the PEM-encoded private key of a client
+	// certificate in a Secret referenced by a Cluster.
+	SecretKeyKey = "tls.key"
+
+	defaultExecAPIVersion = "client.authentication.k8s.io/v1"
+
+	// connectionTimeout is the timeout applied to requests made using a
+	// rest.Config returned by RESTConfig.
+	connectionTimeout = 10 * time.Second
+)
+
+// RESTConfig returns a rest.Config for connecting to the API server of the
+// provided Cluster. The provided client is used for retrieving any Secret
+// referenced by the Cluster. Clusters using exec credential plugins are only
+// supported if allowExec is true.
+func RESTConfig(
+	ctx context.Context,
+	c client.Client,
+	cluster *kargoapi.Cluster,
+	allowExec bool,
+) (*rest.Config, error) {
+	if cluster.Spec == nil {
+		return nil, errors.Errorf("Cluster %q has no spec", cluster.Name)
+	}
+	cfg := &rest.Config{
+		Host:    cluster.Spec.Server,
+		Timeout: connectionTimeout,
+		TLSClientConfig: rest.TLSClientConfig{
+			Insecure: cluster.Spec.InsecureSkipTLSVerify,
+			CAData:   []byte(cluster.Spec.CAData),
+		},
+	}
+	auth := cluster.Spec.Auth
+	switch {
+	case auth.SecretName != "" && auth.Exec != nil:
+		return nil, errors.New("only one of secretName or exec may be specified")
+	case auth.SecretName != "":
+		secret := &corev1.Secret{}
+		if err := c.Get(
+			ctx,
+			types.NamespacedName{
+				Namespace: cluster.Namespace,
+				Name:      auth.SecretName,
+			},
+			secret,
+		); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error getting Secret %q in namespace %q",
+				auth.SecretName,
+				cluster.Namespace,
+			)
+		}
+		switch {
+		case len(secret.Data[SecretKeyToken]) > 0:
+			cfg.BearerToken = string(secret.Data[SecretKeyToken])
+		case len(secret.Data[SecretKeyCert]) > 0 && len(secret.Data[SecretKeyKey]) > 0:
+			cfg.CertData = secret.Data[SecretKeyCert]
+			cfg.KeyData = secret.Data[SecretKeyKey]
+		default:
+			return nil, errors.Errorf(
+				"Secret %q in namespace %q holds neither a %q nor a %q and %q",
+				auth.SecretName,
+				cluster.Namespace,
+				SecretKeyToken,
+				SecretKeyCert,
+				SecretKeyKey,
+			)
+		}
+	case auth.Exec != nil:
+		if !allowExec {
+			return nil, errors.New("exec credential plugins are not enabled")
+		}
+		apiVersion := auth.Exec.APIVersion
+		if apiVersion == "" {
+			apiVersion = defaultExecAPIVersion
+		}
+		env := make([]clientcmdapi.ExecEnvVar, len(auth.Exec.Env))
+		for i, e := range auth.Exec.Env {
+			env[i] = clientcmdapi.ExecEnvVar{Name: e.Name, Value: e.Value}
+		}
+		cfg.ExecProvider = &clientcmdapi.ExecConfig{
+			Command:         auth.Exec.Command,
+			Args:            auth.Exec.Args,
+			Env:             env,
+			APIVersion:      apiVersion,
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		}
+	default:
+		return nil, errors.New("one of secretName or exec must be specified")
+	}
+	return cfg, nil
+}
+
+// ServerVersion connects to the API server described by the provided
+// rest.Config and returns the version of Kubernetes it reports.
+func ServerVersion(cfg *rest.Config) (string, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return "", errors.Wrap(err, "error creating discovery client")
+	}
+	version, err := discoveryClient.ServerVersion()
+	if err != nil {
+		return "", errors.Wrap(err, "error getting server version")
+	}
+	return version.GitVersion, nil
+}
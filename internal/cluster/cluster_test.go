@@ -0,0 +1,177 @@
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestRESTConfig(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "token",
+				Namespace: "fake-namespace",
+			},
+			Data: map[string][]byte{SecretKeyToken: []byte("fake-token")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cert",
+				Namespace: "fake-namespace",
+			},
+			Data: map[string][]byte{
+				SecretKeyCert: []byte("fake-cert"),
+				SecretKeyKey:  []byte("fake-key"),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "empty",
+				Namespace: "fake-namespace",
+			},
+		},
+	).Build()
+
+	testCases := []struct {
+		name       string
+		auth       kargoapi.ClusterAuth
+		allowExec  bool
+		assertions func(*rest.Config, error)
+	}{
+		{
+			name: "no auth",
+			assertions: func(_ *rest.Config, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "one of secretName or exec must be specified")
+			},
+		},
+		{
+			name: "both secret and exec",
+			auth: kargoapi.ClusterAuth{
+				SecretName: "token",
+				Exec:       &kargoapi.ClusterExecAuth{Command: "fake-command"},
+			},
+			assertions: func(_ *rest.Config, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "only one of secretName or exec")
+			},
+		},
+		{
+			name: "Secret not found",
+			auth: kargoapi.ClusterAuth{SecretName: "missing"},
+			assertions: func(_ *rest.Config, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error getting Secret")
+			},
+		},
+		{
+			name: "Secret without credentials",
+			auth: kargoapi.ClusterAuth{SecretName: "empty"},
+			assertions: func(_ *rest.Config, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "holds neither")
+			},
+		},
+		{
+			name: "bearer token",
+			auth: kargoapi.ClusterAuth{SecretName: "token"},
+			assertions: func(cfg *rest.Config, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "https://fake-server", cfg.Host)
+				require.Equal(t, []byte("fake-ca"), cfg.CAData)
+				require.Equal(t, "fake-token", cfg.BearerToken)
+			},
+		},
+		{
+			name: "client certificate",
+			auth: kargoapi.ClusterAuth{SecretName: "cert"},
+			assertions: func(cfg *rest.Config, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []byte("fake-cert"), cfg.CertData)
+				require.Equal(t, []byte("fake-key"), cfg.KeyData)
+			},
+		},
+		{
+			name: "exec not allowed",
+			auth: kargoapi.ClusterAuth{
+				Exec: &kargoapi.ClusterExecAuth{Command: "fake-command"},
+			},
+			assertions: func(_ *rest.Config, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "exec credential plugins are not enabled")
+			},
+		},
+		{
+			name: "exec allowed",
+			auth: kargoapi.ClusterAuth{
+				Exec: &kargoapi.ClusterExecAuth{
+					Command: "fake-command",
+					Args:    []string{"fake-arg"},
+					Env: []kargoapi.ClusterExecEnvVar{
+						{Name: "FAKE_NAME", Value: "fake-value"},
+					},
+				},
+			},
+			allowExec: true,
+			assertions: func(cfg *rest.Config, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, cfg.ExecProvider)
+				require.Equal(t, "fake-command", cfg.ExecProvider.Command)
+				require.Equal(t, []string{"fake-arg"}, cfg.ExecProvider.Args)
+				require.Equal(t, defaultExecAPIVersion, cfg.ExecProvider.APIVersion)
+				require.Len(t, cfg.ExecProvider.Env, 1)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				RESTConfig(
+					context.Background(),
+					c,
+					&kargoapi.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-cluster",
+							Namespace: "fake-namespace",
+						},
+						Spec: &kargoapi.ClusterSpec{
+							Server: "https://fake-server",
+							CAData: "fake-ca",
+							Auth:   testCase.auth,
+						},
+					},
+					testCase.allowExec,
+				),
+			)
+		})
+	}
+}
+
+func TestServerVersion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"gitVersion":"v1.28.0"}`))
+	}))
+	defer srv.Close()
+
+	version, err := ServerVersion(&rest.Config{Host: srv.URL})
+	require.NoError(t, err)
+	require.Equal(t, "v1.28.0", version)
+}
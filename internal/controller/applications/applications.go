@@ -25,6 +25,14 @@ import (
 // reconciler reconciles Argo CD Application resources.
 type reconciler struct {
 	kubeClient client.Client
+
+	// The following behaviors are overridable for testing purposes:
+
+	isProjectArchivedFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (bool, error)
 }
 
 // SetupReconcilerWithManager initializes a reconciler for Argo CD Application
@@ -43,7 +51,7 @@ func SetupReconcilerWithManager(
 	return ctrl.NewControllerManagedBy(argoMgr).
 		For(&argocd.Application{}).
 		WithEventFilter(AppHealthSyncStatusChangePredicate{logger: logger}).
-		WithOptions(controller.CommonOptions()).
+		WithOptions(controller.CommonOptions("application")).
 		Complete(newReconciler(kargoMgr.GetClient()))
 }
 
@@ -78,7 +86,8 @@ func indexStagesByApp(shardName string) func(client.Object) []string {
 
 func newReconciler(kubeClient client.Client) *reconciler {
 	return &reconciler{
-		kubeClient: kubeClient,
+		kubeClient:          kubeClient,
+		isProjectArchivedFn: kargoapi.IsProjectArchived,
 	}
 }
 
@@ -124,12 +133,20 @@ func (r *reconciler) Reconcile(
 	errs := make([]error, 0, len(stages.Items))
 	for _, e := range stages.Items {
 		stage := e // This is to sidestep implicit memory aliasing in this for loop
+		// Stages of archived Projects are not reconciled and cannot be modified
+		archived, err := r.isProjectArchivedFn(ctx, r.kubeClient, stage.Namespace)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if archived {
+			continue
+		}
 		objKey := client.ObjectKey{
 			Namespace: stage.Namespace,
 			Name:      stage.Name,
 		}
-		_, err := kargoapi.RefreshStage(ctx, r.kubeClient, objKey)
-		if err != nil {
+		if _, err = kargoapi.RefreshStage(ctx, r.kubeClient, objKey); err != nil {
 			errs = append(errs, err)
 			continue
 		}
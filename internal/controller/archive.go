@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// ProjectUnarchivedPredicate is a predicate that matches ONLY updates to
+// namespaces that remove the archived label from a Project.
+type ProjectUnarchivedPredicate struct {
+	predicate.Funcs
+}
+
+// Create implements predicate.Predicate.
+func (p ProjectUnarchivedPredicate) Create(event.CreateEvent) bool {
+	return false
+}
+
+// Delete implements predicate.Predicate.
+func (p ProjectUnarchivedPredicate) Delete(event.DeleteEvent) bool {
+	return false
+}
+
+// Generic implements predicate.Predicate.
+func (p ProjectUnarchivedPredicate) Generic(event.GenericEvent) bool {
+	return false
+}
+
+// Update implements predicate.Predicate.
+func (p ProjectUnarchivedPredicate) Update(e event.UpdateEvent) bool {
+	oldNS, ok := e.ObjectOld.(*corev1.Namespace)
+	if !ok {
+		return false
+	}
+	newNS, ok := e.ObjectNew.(*corev1.Namespace)
+	if !ok {
+		return false
+	}
+	return kargoapi.IsNamespaceArchived(oldNS) && !kargoapi.IsNamespaceArchived(newNS)
+}
+
+// WatchProjectUnarchival configures the provided controller to enqueue every
+// object in a Project's namespace when that Project is unarchived, since
+// reconciliation of those objects was skipped while the Project was archived.
+// The newList function must return an empty list of the controller's object
+// type. Only objects matched by all the provided predicates are enqueued.
+func WatchProjectUnarchival(
+	c controller.Controller,
+	kubeClient client.Client,
+	newList func() client.ObjectList,
+	preds ...predicate.Predicate,
+) error {
+	return errors.Wrap(
+		c.Watch(
+			&source.Kind{Type: &corev1.Namespace{}},
+			handler.EnqueueRequestsFromMapFunc(
+				newProjectObjectsMapFunc(kubeClient, newList, preds...),
+			),
+			ProjectUnarchivedPredicate{},
+		),
+		"unable to watch Projects",
+	)
+}
+
+func newProjectObjectsMapFunc(
+	kubeClient client.Client,
+	newList func() client.ObjectList,
+	preds ...predicate.Predicate,
+) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		ctx := context.Background()
+		logger := logging.LoggerFromContext(ctx).WithField("project", obj.GetName())
+		list := newList()
+		if err := kubeClient.List(ctx, list, client.InNamespace(obj.GetName())); err != nil {
+			logger.Errorf("error listing objects of unarchived Project: %s", err)
+			return nil
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			logger.Errorf("error extracting objects of unarchived Project: %s", err)
+			return nil
+		}
+		reqs := make([]reconcile.Request, 0, len(items))
+		for _, item := range items {
+			if itemObj, ok := item.(client.Object); ok && matchesAll(itemObj, preds) {
+				reqs = append(reqs, reconcile.Request{
+					NamespacedName: client.ObjectKeyFromObject(itemObj),
+				})
+			}
+		}
+		return reqs
+	}
+}
+
+// matchesAll returns true if the provided object is matched by all the
+// provided predicates.
+func matchesAll(obj client.Object, preds []predicate.Predicate) bool {
+	for _, pred := range preds {
+		if !pred.Generic(event.GenericEvent{Object: obj}) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestProjectUnarchivedPredicate(t *testing.T) {
+	newNamespace := func(archived bool) *corev1.Namespace {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "fake-project",
+				Labels: map[string]string{
+					kargoapi.LabelProjectKey: kargoapi.LabelTrueValue,
+				},
+			},
+		}
+		if archived {
+			ns.Labels[kargoapi.LabelArchivedKey] = kargoapi.LabelTrueValue
+		}
+		return ns
+	}
+	testCases := []struct {
+		name     string
+		old      bool
+		new      bool
+		expected bool
+	}{
+		{
+			name:     "remains active",
+			expected: false,
+		},
+		{
+			name:     "archived",
+			new:      true,
+			expected: false,
+		},
+		{
+			name:     "remains archived",
+			old:      true,
+			new:      true,
+			expected: false,
+		},
+		{
+			name:     "unarchived",
+			old:      true,
+			expected: true,
+		},
+	}
+	pred := ProjectUnarchivedPredicate{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				pred.Update(event.UpdateEvent{
+					ObjectOld: newNamespace(testCase.old),
+					ObjectNew: newNamespace(testCase.new),
+				}),
+			)
+		})
+	}
+	require.False(t, pred.Create(event.CreateEvent{Object: newNamespace(false)}))
+}
+
+func TestProjectObjectsMapFunc(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	newStage := func(namespace, name, shard string) *kargoapi.Stage {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+		}
+		if shard != "" {
+			stage.Labels = map[string]string{ShardLabelKey: shard}
+		}
+		return stage
+	}
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newStage("fake-project", "fake-stage", ""),
+		newStage("fake-project", "sharded-stage", "fake-shard"),
+		newStage("other-project", "other-stage", ""),
+	).Build()
+	shardPredicate, err := GetShardPredicate("")
+	require.NoError(t, err)
+
+	reqs := newProjectObjectsMapFunc(
+		kubeClient,
+		func() client.ObjectList { return &kargoapi.StageList{} },
+		shardPredicate,
+	)(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "fake-project"}})
+	require.Equal(
+		t,
+		[]reconcile.Request{{
+			NamespacedName: types.NamespacedName{
+				Namespace: "fake-project",
+				Name:      "fake-stage",
+			},
+		}},
+		reqs,
+	)
+}
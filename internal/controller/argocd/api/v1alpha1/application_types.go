@@ -16,9 +16,16 @@ type Application struct {
 }
 
 type ApplicationSpec struct {
-	Source     *ApplicationSource `json:"source,omitempty"`
-	SyncPolicy *SyncPolicy        `json:"syncPolicy,omitempty"`
-	Sources    ApplicationSources `json:"sources,omitempty"`
+	Source      *ApplicationSource     `json:"source,omitempty"`
+	Destination ApplicationDestination `json:"destination"`
+	SyncPolicy  *SyncPolicy            `json:"syncPolicy,omitempty"`
+	Sources     ApplicationSources     `json:"sources,omitempty"`
+}
+
+type ApplicationDestination struct {
+	Server    string `json:"server,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
 }
 
 type ApplicationSource struct {
@@ -55,8 +62,24 @@ type ApplicationSourceKustomize struct {
 }
 
 type ApplicationStatus struct {
-	Health HealthStatus `json:"health,omitempty"`
-	Sync   SyncStatus   `json:"sync,omitempty"`
+	Health         HealthStatus       `json:"health,omitempty"`
+	Sync           SyncStatus         `json:"sync,omitempty"`
+	Summary        ApplicationSummary `json:"summary,omitempty"`
+	OperationState *OperationState    `json:"operationState,omitempty"`
+	Resources      []ResourceStatus   `json:"resources,omitempty"`
+}
+
+type ResourceStatus struct {
+	Group     string        `json:"group,omitempty"`
+	Version   string        `json:"version,omitempty"`
+	Kind      string        `json:"kind,omitempty"`
+	Namespace string        `json:"namespace,omitempty"`
+	Name      string        `json:"name,omitempty"`
+	Health    *HealthStatus `json:"health,omitempty"`
+}
+
+type ApplicationSummary struct {
+	Images []string `json:"images,omitempty"`
 }
 
 type OperationInitiator struct {
@@ -71,6 +94,14 @@ type Operation struct {
 	Retry       RetryStrategy      `json:"retry,omitempty"`
 }
 
+type OperationState struct {
+	Operation  Operation      `json:"operation"`
+	Phase      OperationPhase `json:"phase"`
+	Message    string         `json:"message,omitempty"`
+	StartedAt  metav1.Time    `json:"startedAt"`
+	FinishedAt *metav1.Time   `json:"finishedAt,omitempty"`
+}
+
 type SyncOperation struct {
 	SyncOptions SyncOptions `json:"syncOptions,omitempty"`
 	Revisions   []string    `json:"revisions,omitempty"`
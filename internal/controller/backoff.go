@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// resourcesInBackoff tracks, for each controller, the number of resources
+// whose most recent reconciliation failed and that are awaiting a retry.
+var resourcesInBackoff = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "kargo_resources_in_backoff",
+		Help: "Number of resources awaiting a retry after failed reconciliations",
+	},
+	[]string{"controller"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(resourcesInBackoff)
+}
+
+// BackoffConfig represents configuration for the delays between successive
+// attempts to reconcile a resource whose reconciliation keeps failing.
+type BackoffConfig struct {
+	// BaseDelay is the delay before the first retry of a failed
+	// reconciliation. The delay doubles with each consecutive failure.
+	BaseDelay time.Duration `envconfig:"RECONCILE_BACKOFF_BASE_DELAY" default:"1s"`
+	// MaxDelay is the longest delay between retries, regardless of how many
+	// consecutive failures there have been.
+	MaxDelay time.Duration `envconfig:"RECONCILE_BACKOFF_MAX_DELAY" default:"5m"`
+	// Jitter is the fraction, between 0 and 1, of each delay by which it may be
+	// randomly shortened. This prevents resources that failed together, for
+	// instance because an external service was unavailable, from all being
+	// retried together.
+	Jitter float64 `envconfig:"RECONCILE_BACKOFF_JITTER" default:"0.2"`
+}
+
+// BackoffConfigFromEnv returns a BackoffConfig populated from environment
+// variables.
+func BackoffConfigFromEnv() BackoffConfig {
+	var cfg BackoffConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// backoffRateLimiter implements ratelimiter.RateLimiter. It delays each retry
+// of a failed reconciliation of a resource exponentially longer than the last,
+// up to a maximum, with jitter. Unlike the rate limiter used by default, it
+// does not additionally apply an overall rate limit, so one resource's
+// failures never delay the retries of another.
+type backoffRateLimiter struct {
+	cfg       BackoffConfig
+	inBackoff prometheus.Gauge
+	randFn    func() float64
+
+	mu       sync.Mutex
+	failures map[any]int
+}
+
+func newBackoffRateLimiter(
+	controllerName string,
+	cfg BackoffConfig,
+) *backoffRateLimiter {
+	if cfg.MaxDelay < cfg.BaseDelay {
+		cfg.MaxDelay = cfg.BaseDelay
+	}
+	cfg.Jitter = math.Max(0, math.Min(1, cfg.Jitter))
+	return &backoffRateLimiter{
+		cfg:       cfg,
+		inBackoff: resourcesInBackoff.WithLabelValues(controllerName),
+		randFn:    rand.Float64,
+		failures:  map[any]int{},
+	}
+}
+
+// When returns how long to wait before retrying the reconciliation of the
+// provided item and records another failure of it.
+func (b *backoffRateLimiter) When(item any) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	failures := b.failures[item]
+	b.failures[item] = failures + 1
+	b.inBackoff.Set(float64(len(b.failures)))
+
+	delay := float64(b.cfg.BaseDelay) * math.Pow(2, float64(failures))
+	if delay > float64(b.cfg.MaxDelay) {
+		delay = float64(b.cfg.MaxDelay)
+	}
+	delay -= delay * b.cfg.Jitter * b.randFn()
+	return time.Duration(delay)
+}
+
+// Forget stops tracking the failures of the provided item. It is called once
+// the item has been reconciled successfully.
+func (b *backoffRateLimiter) Forget(item any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.failures, item)
+	b.inBackoff.Set(float64(len(b.failures)))
+}
+
+// NumRequeues returns the number of consecutive failures of the provided
+// item.
+func (b *backoffRateLimiter) NumRequeues(item any) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failures[item]
+}
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBackoffRateLimiter(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        BackoffConfig
+		assertions func(*backoffRateLimiter)
+	}{
+		{
+			name: "max delay less than base delay",
+			cfg: BackoffConfig{
+				BaseDelay: time.Minute,
+				MaxDelay:  time.Second,
+				Jitter:    0.2,
+			},
+			assertions: func(b *backoffRateLimiter) {
+				require.Equal(t, time.Minute, b.cfg.MaxDelay)
+			},
+		},
+		{
+			name: "jitter out of range",
+			cfg: BackoffConfig{
+				BaseDelay: time.Second,
+				MaxDelay:  time.Minute,
+				Jitter:    1.5,
+			},
+			assertions: func(b *backoffRateLimiter) {
+				require.Equal(t, 1.0, b.cfg.Jitter)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(newBackoffRateLimiter("fake-controller", testCase.cfg))
+		})
+	}
+}
+
+func TestBackoffRateLimiter(t *testing.T) {
+	b := newBackoffRateLimiter(
+		"fake-controller",
+		BackoffConfig{
+			BaseDelay: time.Second,
+			MaxDelay:  10 * time.Second,
+			Jitter:    0.5,
+		},
+	)
+	b.randFn = func() float64 { return 0 }
+	require.Equal(t, time.Second, b.When("foo"))
+	require.Equal(t, 2*time.Second, b.When("foo"))
+	require.Equal(t, 4*time.Second, b.When("foo"))
+	require.Equal(t, 8*time.Second, b.When("foo"))
+	// The cap applies
+	require.Equal(t, 10*time.Second, b.When("foo"))
+	require.Equal(t, 5, b.NumRequeues("foo"))
+
+	// Each item backs off independently
+	require.Equal(t, time.Second, b.When("bar"))
+	require.Equal(t, 2.0, testutil.ToFloat64(b.inBackoff))
+
+	// Jitter only ever shortens the delay
+	b.randFn = func() float64 { return 1 }
+	require.Equal(t, 5*time.Second, b.When("foo"))
+
+	b.Forget("foo")
+	require.Equal(t, 0, b.NumRequeues("foo"))
+	require.Equal(t, 1.0, testutil.ToFloat64(b.inBackoff))
+	b.Forget("bar")
+	require.Equal(t, 0.0, testutil.ToFloat64(b.inBackoff))
+}
@@ -0,0 +1,203 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cluster"
+	"github.com/akuity/kargo/internal/controller"
+	"github.com/akuity/kargo/internal/kubeclient"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// ReconcilerConfig represents configuration for the Cluster reconciler.
+type ReconcilerConfig struct {
+	// ExecAuthEnabled specifies whether Clusters may use exec credential plugins
+	// to obtain credentials. Since such plugins execute arbitrary commands
+	// specified by project users, this is disabled by default.
+	ExecAuthEnabled bool `envconfig:"CLUSTER_EXEC_AUTH_ENABLED" default:"false"`
+	// ConnectionCheckInterval is how often the connection to each Cluster is
+	// checked.
+	ConnectionCheckInterval time.Duration `envconfig:"CLUSTER_CONNECTION_CHECK_INTERVAL" default:"5m"`
+}
+
+// ReconcilerConfigFromEnv returns a ReconcilerConfig populated from
+// environment variables.
+func ReconcilerConfigFromEnv() ReconcilerConfig {
+	cfg := ReconcilerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// reconciler reconciles Cluster resources.
+type reconciler struct {
+	cfg         ReconcilerConfig
+	kargoClient client.Client
+
+	// The following behaviors are overridable for testing purposes:
+
+	isProjectArchivedFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (bool, error)
+
+	restConfigFn func(
+		context.Context,
+		client.Client,
+		*kargoapi.Cluster,
+		bool,
+	) (*rest.Config, error)
+
+	serverVersionFn func(*rest.Config) (string, error)
+}
+
+// SetupReconcilerWithManager initializes a reconciler for Cluster resources and
+// registers it with the provided Manager.
+func SetupReconcilerWithManager(
+	kargoMgr manager.Manager,
+	cfg ReconcilerConfig,
+) error {
+	c, err := ctrl.NewControllerManagedBy(kargoMgr).
+		For(&kargoapi.Cluster{}).
+		WithEventFilter(
+			predicate.Or(
+				predicate.GenerationChangedPredicate{},
+				predicate.AnnotationChangedPredicate{},
+			),
+		).
+		WithOptions(controller.CommonOptions("cluster")).
+		Build(newReconciler(kargoMgr.GetClient(), cfg))
+	if err != nil {
+		return errors.Wrap(err, "error building Cluster reconciler")
+	}
+
+	// Watch Projects that were unarchived and enqueue their Clusters
+	return controller.WatchProjectUnarchival(
+		c,
+		kargoMgr.GetClient(),
+		func() client.ObjectList { return &kargoapi.ClusterList{} },
+	)
+}
+
+func newReconciler(kargoClient client.Client, cfg ReconcilerConfig) *reconciler {
+	return &reconciler{
+		cfg:                 cfg,
+		kargoClient:         kargoClient,
+		isProjectArchivedFn: kargoapi.IsProjectArchived,
+		restConfigFn:        cluster.RESTConfig,
+		serverVersionFn:     cluster.ServerVersion,
+	}
+}
+
+// Reconcile is part of the main Kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *reconciler) Reconcile(
+	ctx context.Context,
+	req ctrl.Request,
+) (ctrl.Result, error) {
+	// Connections are re-checked periodically, since credentials may expire or
+	// be revoked without the Cluster itself changing
+	result := ctrl.Result{RequeueAfter: r.cfg.ConnectionCheckInterval}
+
+	logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"namespace": req.NamespacedName.Namespace,
+		"cluster":   req.NamespacedName.Name,
+	})
+	ctx = logging.ContextWithLogger(ctx, logger)
+	logger.Debug("reconciling Cluster")
+
+	// Find the Cluster
+	c, err := kargoapi.GetCluster(ctx, r.kargoClient, req.NamespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if c == nil {
+		// Ignore if not found. This can happen if the Cluster was deleted after
+		// the current reconciliation request was issued.
+		return ctrl.Result{}, nil
+	}
+
+	// Resources of archived Projects are not reconciled. They are enqueued
+	// again when the Project is unarchived.
+	if archived, err := r.isProjectArchivedFn(ctx, r.kargoClient, req.Namespace); err != nil {
+		return ctrl.Result{}, err
+	} else if archived {
+		logger.Debug("Project is archived; skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
+
+	newStatus := r.syncCluster(ctx, c)
+	if err = kubeclient.PatchStatus(
+		ctx,
+		r.kargoClient,
+		c,
+		func(status *kargoapi.ClusterStatus) {
+			*status = newStatus
+		},
+	); err != nil {
+		logger.Errorf("error updating Cluster status: %s", err)
+		return ctrl.Result{}, err
+	}
+
+	logger.Debug("done reconciling Cluster")
+	return result, nil
+}
+
+// syncCluster checks the connection to the provided Cluster's API server and
+// returns the Cluster's updated status. Failure to connect is not an error as
+// far as reconciliation is concerned; it is reported by the Cluster's
+// Connected condition.
+func (r *reconciler) syncCluster(
+	ctx context.Context,
+	c *kargoapi.Cluster,
+) kargoapi.ClusterStatus {
+	status := *c.Status.DeepCopy()
+	status.ObservedGeneration = c.Generation
+
+	setConnected := func(s metav1.ConditionStatus, reason, message string) {
+		meta.SetStatusCondition(
+			&status.Conditions,
+			metav1.Condition{
+				Type:               kargoapi.ClusterConditionTypeConnected,
+				Status:             s,
+				ObservedGeneration: c.Generation,
+				Reason:             reason,
+				Message:            message,
+			},
+		)
+	}
+
+	cfg, err := r.restConfigFn(ctx, r.kargoClient, c, r.cfg.ExecAuthEnabled)
+	if err != nil {
+		status.ServerVersion = ""
+		setConnected(metav1.ConditionFalse, "InvalidConfiguration", err.Error())
+		return status
+	}
+	version, err := r.serverVersionFn(cfg)
+	if err != nil {
+		status.ServerVersion = ""
+		setConnected(metav1.ConditionFalse, "ConnectionFailed", err.Error())
+		return status
+	}
+	status.ServerVersion = version
+	setConnected(
+		metav1.ConditionTrue,
+		"Connected",
+		fmt.Sprintf("API server reported Kubernetes version %s", version),
+	)
+	return status
+}
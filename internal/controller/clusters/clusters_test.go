@@ -0,0 +1,137 @@
+package clusters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewReconciler(t *testing.T) {
+	kubeClient := fake.NewClientBuilder().Build()
+	r := newReconciler(kubeClient, ReconcilerConfig{})
+	require.NotNil(t, r.kargoClient)
+
+	// Assert that all overridable behaviors were initialized to a default:
+	require.NotNil(t, r.isProjectArchivedFn)
+	require.NotNil(t, r.restConfigFn)
+	require.NotNil(t, r.serverVersionFn)
+}
+
+func TestSyncCluster(t *testing.T) {
+	testCluster := &kargoapi.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "fake-cluster",
+			Namespace:  "fake-namespace",
+			Generation: 2,
+		},
+		Spec: &kargoapi.ClusterSpec{
+			Server: "https://fake-server",
+		},
+		Status: kargoapi.ClusterStatus{
+			ServerVersion: "v1.27.0",
+		},
+	}
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(kargoapi.ClusterStatus)
+	}{
+		{
+			name: "invalid configuration",
+			reconciler: &reconciler{
+				restConfigFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Cluster,
+					bool,
+				) (*rest.Config, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(status kargoapi.ClusterStatus) {
+				require.Equal(t, int64(2), status.ObservedGeneration)
+				require.Empty(t, status.ServerVersion)
+				cond := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.ClusterConditionTypeConnected,
+				)
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionFalse, cond.Status)
+				require.Equal(t, "InvalidConfiguration", cond.Reason)
+				require.Equal(t, "something went wrong", cond.Message)
+			},
+		},
+		{
+			name: "connection failed",
+			reconciler: &reconciler{
+				restConfigFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Cluster,
+					bool,
+				) (*rest.Config, error) {
+					return &rest.Config{}, nil
+				},
+				serverVersionFn: func(*rest.Config) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(status kargoapi.ClusterStatus) {
+				require.Empty(t, status.ServerVersion)
+				cond := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.ClusterConditionTypeConnected,
+				)
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionFalse, cond.Status)
+				require.Equal(t, "ConnectionFailed", cond.Reason)
+			},
+		},
+		{
+			name: "connected",
+			reconciler: &reconciler{
+				cfg: ReconcilerConfig{ExecAuthEnabled: true},
+				restConfigFn: func(
+					_ context.Context,
+					_ client.Client,
+					_ *kargoapi.Cluster,
+					allowExec bool,
+				) (*rest.Config, error) {
+					require.True(t, allowExec)
+					return &rest.Config{}, nil
+				},
+				serverVersionFn: func(*rest.Config) (string, error) {
+					return "v1.28.2", nil
+				},
+			},
+			assertions: func(status kargoapi.ClusterStatus) {
+				require.Equal(t, int64(2), status.ObservedGeneration)
+				require.Equal(t, "v1.28.2", status.ServerVersion)
+				cond := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.ClusterConditionTypeConnected,
+				)
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionTrue, cond.Status)
+				require.Equal(t, int64(2), cond.ObservedGeneration)
+				require.Equal(t, "Connected", cond.Reason)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.reconciler.syncCluster(context.Background(), testCluster),
+			)
+		})
+	}
+}
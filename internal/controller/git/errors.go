@@ -0,0 +1,57 @@
+package git
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+
+	libExec "github.com/akuity/kargo/internal/exec"
+)
+
+// authFailureMarkers are fragments of git output that indicate git was unable
+// to authenticate to a remote repository.
+var authFailureMarkers = [][]byte{
+	[]byte("authentication failed"),
+	[]byte("could not read username"),
+	[]byte("could not read password"),
+	[]byte("permission denied (publickey"),
+	[]byte("terminal prompts disabled"),
+	[]byte("invalid username or password"),
+}
+
+// pushRejectedMarkers are fragments of git output that indicate a push was
+// rejected because the remote branch contains commits that are not present
+// locally.
+var pushRejectedMarkers = [][]byte{
+	[]byte("[rejected]"),
+	[]byte("non-fast-forward"),
+	[]byte("fetch first"),
+	[]byte("updates were rejected"),
+}
+
+// IsAuthError returns true if the provided error was produced by a git command
+// that failed because it was unable to authenticate to a remote repository.
+func IsAuthError(err error) bool {
+	return outputContainsAny(err, authFailureMarkers)
+}
+
+// IsPushRejectedError returns true if the provided error was produced by a git
+// push that was rejected because the remote branch had diverged from the local
+// one.
+func IsPushRejectedError(err error) bool {
+	return outputContainsAny(err, pushRejectedMarkers)
+}
+
+func outputContainsAny(err error, markers [][]byte) bool {
+	var exitErr *libExec.ExitError
+	if !errors.As(err, &exitErr) {
+		return false
+	}
+	output := bytes.ToLower(exitErr.Output)
+	for _, marker := range markers {
+		if bytes.Contains(output, marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	libExec "github.com/akuity/kargo/internal/exec"
+)
+
+func TestIsAuthError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "nil error",
+			expected: false,
+		},
+		{
+			name:     "not an exit error",
+			err:      errors.New("authentication failed"),
+			expected: false,
+		},
+		{
+			name: "unrelated exit error",
+			err: &libExec.ExitError{
+				Output: []byte("fatal: not a git repository"),
+			},
+			expected: false,
+		},
+		{
+			name: "wrapped https auth failure",
+			err: errors.Wrap(
+				&libExec.ExitError{
+					Output: []byte(
+						"remote: Invalid username or password.\n" +
+							"fatal: Authentication failed for 'https://github.com/akuity/kargo'",
+					),
+				},
+				"error cloning repo",
+			),
+			expected: true,
+		},
+		{
+			name: "ssh auth failure",
+			err: &libExec.ExitError{
+				Output: []byte("git@github.com: Permission denied (publickey)."),
+			},
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, IsAuthError(testCase.err))
+		})
+	}
+}
+
+func TestIsPushRejectedError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "nil error",
+			expected: false,
+		},
+		{
+			name: "unrelated exit error",
+			err: &libExec.ExitError{
+				Output: []byte("fatal: Authentication failed"),
+			},
+			expected: false,
+		},
+		{
+			name: "wrapped rejected push",
+			err: errors.Wrap(
+				&libExec.ExitError{
+					Output: []byte(
+						" ! [rejected]        main -> main (fetch first)\n" +
+							"error: failed to push some refs",
+					),
+				},
+				"error pushing branch",
+			),
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, IsPushRejectedError(testCase.err))
+		})
+	}
+}
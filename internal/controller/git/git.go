@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -31,6 +32,31 @@ type RepoCredentials struct {
 	Password string `json:"password,omitempty"`
 }
 
+// FileStatus describes how a file differs between two commits.
+type FileStatus string
+
+const (
+	FileStatusAdded       FileStatus = "Added"
+	FileStatusDeleted     FileStatus = "Deleted"
+	FileStatusModified    FileStatus = "Modified"
+	FileStatusTypeChanged FileStatus = "TypeChanged"
+)
+
+// FileDiff summarizes the differences in a single file between two commits.
+type FileDiff struct {
+	// Path is the path of the file, relative to the root of the repository.
+	Path string
+	// Status describes how the file differs between the two commits.
+	Status FileStatus
+	// Additions is the number of lines added to the file.
+	Additions int
+	// Deletions is the number of lines deleted from the file.
+	Deletions int
+	// Binary indicates that the file is binary, in which case Additions and
+	// Deletions are always zero.
+	Binary bool
+}
+
 // Repo is an interface for interacting with a git repository.
 type Repo interface {
 	// AddAll stages pending changes for commit.
@@ -70,8 +96,25 @@ type Repo interface {
 	// CommitMessages returns a slice of commit messages starting with id1 and
 	// ending with id2. The results exclude id1, but include id2.
 	CommitMessages(id1, id2 string) ([]string, error)
+	// DiffFiles returns a summary of the files that differ between the commits
+	// identified by id1 and id2. If any paths, relative to the root of the
+	// repository, are specified, only differences within those paths are
+	// included.
+	DiffFiles(id1, id2 string, paths ...string) ([]FileDiff, error)
 	// Push pushes from the current branch to a remote branch by the same name.
 	Push() error
+	// PullRebase fetches the remote branch by the same name as the current
+	// branch and rebases the current branch onto it. If the rebase stops because
+	// of conflicts, the rebase is left in progress and the paths, relative to
+	// the root of the repository, of all conflicting files are returned.
+	PullRebase() ([]string, error)
+	// ResolveConflicts resolves conflicts in the specified paths of an
+	// in-progress rebase in favor of the changes being rebased (i.e. local
+	// changes) and then continues the rebase.
+	ResolveConflicts(paths []string) error
+	// AbortRebase aborts an in-progress rebase, restoring the current branch to
+	// the state it was in before the rebase began.
+	AbortRebase() error
 	// RemoteBranchExists returns a bool indicating if the specified branch exists
 	// in the remote repository.
 	RemoteBranchExists(branch string) (bool, error)
@@ -123,6 +166,31 @@ func Clone(
 	return r, r.clone()
 }
 
+// CheckAccess verifies that the remote git repository at the specified URL can
+// be read using the provided credentials. Unlike Clone, it transfers no objects
+// from the remote repository; it only lists the repository's branches.
+func CheckAccess(repoURL string, repoCreds RepoCredentials) error {
+	homeDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error creating home directory for repo %q",
+			repoURL,
+		)
+	}
+	defer os.RemoveAll(homeDir)
+	r := &repo{
+		url:     repoURL,
+		homeDir: homeDir,
+		dir:     homeDir,
+	}
+	if err = r.setupAuth(repoCreds); err != nil {
+		return err
+	}
+	_, err = libExec.Exec(r.buildCommand("ls-remote", "--heads", r.url))
+	return errors.Wrapf(err, "error listing branches of remote repo %q", r.url)
+}
+
 func (r *repo) AddAll() error {
 	_, err := libExec.Exec(r.buildCommand("add", "."))
 	return errors.Wrap(err, "error staging changes for commit")
@@ -289,12 +357,175 @@ func (r *repo) CommitMessages(id1, id2 string) ([]string, error) {
 	return msgs, nil
 }
 
+func (r *repo) DiffFiles(id1, id2 string, paths ...string) ([]FileDiff, error) {
+	diffArgs := func(format string) []string {
+		// Renames are reported as a deletion and an addition so that every file
+		// is attributed to a single path that can be matched against paths.
+		return append(
+			[]string{"diff", format, "--no-renames", "-z", id1, id2, "--"},
+			paths...,
+		)
+	}
+	statusBytes, err := libExec.Exec(r.buildCommand(diffArgs("--name-status")...))
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error obtaining changed files between commits %q and %q",
+			id1,
+			id2,
+		)
+	}
+	numStatBytes, err := libExec.Exec(r.buildCommand(diffArgs("--numstat")...))
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error obtaining line counts between commits %q and %q",
+			id1,
+			id2,
+		)
+	}
+	return parseFileDiffs(statusBytes, numStatBytes)
+}
+
+// parseFileDiffs combines the NUL-delimited output of git diff --name-status
+// and git diff --numstat into a slice of FileDiffs ordered as in the former.
+func parseFileDiffs(statusBytes, numStatBytes []byte) ([]FileDiff, error) {
+	statusFields := splitNUL(statusBytes)
+	if len(statusFields)%2 != 0 {
+		return nil, errors.New("error parsing changed files: unexpected output")
+	}
+	diffs := make([]FileDiff, 0, len(statusFields)/2)
+	indices := make(map[string]int, len(statusFields)/2)
+	for i := 0; i < len(statusFields); i += 2 {
+		var status FileStatus
+		switch statusFields[i] {
+		case "A":
+			status = FileStatusAdded
+		case "D":
+			status = FileStatusDeleted
+		case "T":
+			status = FileStatusTypeChanged
+		default:
+			status = FileStatusModified
+		}
+		indices[statusFields[i+1]] = len(diffs)
+		diffs = append(diffs, FileDiff{
+			Path:   statusFields[i+1],
+			Status: status,
+		})
+	}
+	for _, field := range splitNUL(numStatBytes) {
+		// Each field is of the form "<additions>\t<deletions>\t<path>". Binary
+		// files have "-" in place of both counts.
+		parts := strings.SplitN(field, "\t", 3)
+		if len(parts) != 3 {
+			return nil, errors.Errorf("error parsing line counts: unexpected output %q", field)
+		}
+		i, ok := indices[parts[2]]
+		if !ok {
+			continue
+		}
+		if parts[0] == "-" && parts[1] == "-" {
+			diffs[i].Binary = true
+			continue
+		}
+		var err error
+		if diffs[i].Additions, err = strconv.Atoi(parts[0]); err != nil {
+			return nil, errors.Wrapf(err, "error parsing additions to %q", parts[2])
+		}
+		if diffs[i].Deletions, err = strconv.Atoi(parts[1]); err != nil {
+			return nil, errors.Wrapf(err, "error parsing deletions from %q", parts[2])
+		}
+	}
+	return diffs, nil
+}
+
+// splitNUL splits NUL-delimited output into its non-empty fields.
+func splitNUL(b []byte) []string {
+	var fields []string
+	for _, field := range bytes.Split(b, []byte{0}) {
+		if len(field) > 0 {
+			fields = append(fields, string(field))
+		}
+	}
+	return fields
+}
+
 func (r *repo) Push() error {
 	_, err :=
 		libExec.Exec(r.buildCommand("push", "origin", r.currentBranch))
 	return errors.Wrapf(err, "error pushing branch %q", r.currentBranch)
 }
 
+func (r *repo) PullRebase() ([]string, error) {
+	if _, err := libExec.Exec(
+		r.buildCommand("fetch", "origin", r.currentBranch),
+	); err != nil {
+		return nil, errors.Wrapf(err, "error fetching branch %q", r.currentBranch)
+	}
+	_, err := libExec.Exec(
+		r.buildCommand("rebase", fmt.Sprintf("origin/%s", r.currentBranch)),
+	)
+	if err == nil {
+		return nil, nil
+	}
+	conflicts, conflictsErr := r.conflictPaths()
+	if conflictsErr != nil || len(conflicts) == 0 {
+		// The rebase failed for some reason other than conflicts
+		return nil, errors.Wrapf(
+			err,
+			"error rebasing branch %q onto remote branch",
+			r.currentBranch,
+		)
+	}
+	return conflicts, nil
+}
+
+func (r *repo) ResolveConflicts(paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+	// During a rebase, "theirs" refers to the commits being rebased
+	if _, err := libExec.Exec(
+		r.buildCommand(append([]string{"checkout", "--theirs", "--"}, paths...)...),
+	); err != nil {
+		return errors.Wrap(err, "error resolving conflicts")
+	}
+	if _, err := libExec.Exec(
+		r.buildCommand(append([]string{"add", "--"}, paths...)...),
+	); err != nil {
+		return errors.Wrap(err, "error staging resolved conflicts")
+	}
+	// Setting the editor to "true" accepts the existing commit message without
+	// prompting
+	_, err := libExec.Exec(
+		r.buildCommand("-c", "core.editor=true", "rebase", "--continue"),
+	)
+	return errors.Wrap(err, "error continuing rebase")
+}
+
+func (r *repo) AbortRebase() error {
+	_, err := libExec.Exec(r.buildCommand("rebase", "--abort"))
+	return errors.Wrap(err, "error aborting rebase")
+}
+
+func (r *repo) conflictPaths() ([]string, error) {
+	resBytes, err := libExec.Exec(
+		r.buildCommand("diff", "--name-only", "--diff-filter=U"),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing conflicting paths")
+	}
+	var paths []string
+	scanner := bufio.NewScanner(bytes.NewReader(resBytes))
+	for scanner.Scan() {
+		if path := strings.TrimSpace(scanner.Text()); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
+
 func (r *repo) RemoteBranchExists(branch string) (bool, error) {
 	_, err := libExec.Exec(r.buildCommand(
 		"ls-remote",
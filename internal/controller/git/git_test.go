@@ -0,0 +1,174 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFileDiffs(t *testing.T) {
+	testCases := []struct {
+		name         string
+		statusBytes  []byte
+		numStatBytes []byte
+		assertions   func([]FileDiff, error)
+	}{
+		{
+			name: "no differences",
+			assertions: func(diffs []FileDiff, err error) {
+				require.NoError(t, err)
+				require.Empty(t, diffs)
+			},
+		},
+		{
+			name:        "malformed status output",
+			statusBytes: []byte("M\x00"),
+			assertions: func(_ []FileDiff, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing changed files")
+			},
+		},
+		{
+			name:         "malformed line counts",
+			statusBytes:  []byte("M\x00values.yaml\x00"),
+			numStatBytes: []byte("1\x00"),
+			assertions: func(_ []FileDiff, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing line counts")
+			},
+		},
+		{
+			name: "various differences",
+			statusBytes: []byte(
+				"M\x00charts/app/values.yaml\x00" +
+					"A\x00config/new file.yaml\x00" +
+					"D\x00config/old.yaml\x00" +
+					"M\x00logo.png\x00",
+			),
+			numStatBytes: []byte(
+				"3\t1\tcharts/app/values.yaml\x00" +
+					"10\t0\tconfig/new file.yaml\x00" +
+					"0\t7\tconfig/old.yaml\x00" +
+					"-\t-\tlogo.png\x00",
+			),
+			assertions: func(diffs []FileDiff, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]FileDiff{
+						{
+							Path:      "charts/app/values.yaml",
+							Status:    FileStatusModified,
+							Additions: 3,
+							Deletions: 1,
+						},
+						{
+							Path:      "config/new file.yaml",
+							Status:    FileStatusAdded,
+							Additions: 10,
+						},
+						{
+							Path:      "config/old.yaml",
+							Status:    FileStatusDeleted,
+							Deletions: 7,
+						},
+						{
+							Path:   "logo.png",
+							Status: FileStatusModified,
+							Binary: true,
+						},
+					},
+					diffs,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				parseFileDiffs(testCase.statusBytes, testCase.numStatBytes),
+			)
+		})
+	}
+}
+
+func TestDiffFiles(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	// Create a local repository with two commits to clone from
+	remoteDir := t.TempDir()
+	runGit := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = remoteDir
+		cmd.Env = append(
+			os.Environ(),
+			"GIT_AUTHOR_NAME=test",
+			"GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test",
+			"GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return string(out)
+	}
+	writeFile := func(path, content string) {
+		path = filepath.Join(remoteDir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	runGit("init", "--quiet")
+	writeFile("charts/app/values.yaml", "replicas: 1\n")
+	writeFile("README.md", "# Test\n")
+	runGit("add", ".")
+	runGit("commit", "--quiet", "-m", "initial commit")
+	writeFile("charts/app/values.yaml", "replicas: 2\nimage: nginx\n")
+	writeFile("README.md", "# Test\n\nMore docs\n")
+	runGit("add", ".")
+	runGit("commit", "--quiet", "-m", "second commit")
+
+	r, err := Clone(remoteDir, RepoCredentials{})
+	require.NoError(t, err)
+	defer r.Close()
+
+	diffs, err := r.DiffFiles("HEAD~1", "HEAD")
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+
+	diffs, err = r.DiffFiles("HEAD~1", "HEAD", "charts")
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]FileDiff{
+			{
+				Path:      "charts/app/values.yaml",
+				Status:    FileStatusModified,
+				Additions: 2,
+				Deletions: 1,
+			},
+		},
+		diffs,
+	)
+}
+
+func TestCheckAccess(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	remoteDir := t.TempDir()
+	cmd := exec.Command("git", "init", "--quiet")
+	cmd.Dir = remoteDir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	require.NoError(t, CheckAccess(remoteDir, RepoCredentials{}))
+
+	err = CheckAccess(filepath.Join(remoteDir, "missing"), RepoCredentials{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "error listing branches of remote repo")
+}
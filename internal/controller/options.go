@@ -4,8 +4,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 )
 
-func CommonOptions() controller.Options {
+// CommonOptions returns options common to all of Kargo's controllers. The
+// provided name identifies the controller in metrics.
+func CommonOptions(controllerName string) controller.Options {
 	return controller.Options{
 		RecoverPanic: true,
+		RateLimiter:  newBackoffRateLimiter(controllerName, BackoffConfigFromEnv()),
 	}
 }
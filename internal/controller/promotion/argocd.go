@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gobwas/glob"
 	"github.com/pkg/errors"
@@ -15,11 +16,24 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
-const authorizedStageAnnotationKey = "kargo.akuity.io/authorized-stage"
+const (
+	authorizedStageAnnotationKey = "kargo.akuity.io/authorized-stage"
+
+	// defaultOperationPollInterval is how often an Argo CD Application is
+	// re-examined while waiting for an operation in progress on it to complete.
+	defaultOperationPollInterval = 5 * time.Second
+)
 
 // argoCDMechanism is an implementation of the Mechanism interface that updates
 // Argo CD Application resources.
 type argoCDMechanism struct {
+	// operationWaitTimeout is the maximum length of time to wait for an
+	// operation already in progress on an Argo CD Application to complete before
+	// syncing it. A value of zero means not to wait at all.
+	operationWaitTimeout time.Duration
+	// operationPollInterval is how often an Argo CD Application is re-examined
+	// while waiting for an operation in progress on it to complete.
+	operationPollInterval time.Duration
 	// These behaviors are overridable for testing purposes:
 	doSingleUpdateFn func(
 		ctx context.Context,
@@ -46,11 +60,17 @@ type argoCDMechanism struct {
 }
 
 // newArgoCDMechanism returns an implementation of the Mechanism interface that
-// updates Argo CD Application resources.
+// updates Argo CD Application resources. Before syncing an Application, it
+// waits up to operationWaitTimeout for any operation already in progress on
+// that Application to complete.
 func newArgoCDMechanism(
 	argoClient client.Client,
+	operationWaitTimeout time.Duration,
 ) Mechanism {
-	a := &argoCDMechanism{}
+	a := &argoCDMechanism{
+		operationWaitTimeout:  operationWaitTimeout,
+		operationPollInterval: defaultOperationPollInterval,
+	}
 	a.doSingleUpdateFn = a.doSingleUpdate
 	a.getArgoCDAppFn = getApplicationFn(argoClient)
 	a.applyArgoCDSourceUpdateFn = applyArgoCDSourceUpdate
@@ -85,7 +105,7 @@ func (a *argoCDMechanism) Promote(
 			update,
 			newFreight,
 		); err != nil {
-			return newFreight, err
+			return newFreight, classifyArgoCDError(err)
 		}
 	}
 
@@ -121,6 +141,11 @@ func (a *argoCDMechanism) doSingleUpdate(
 	if err = authorizeArgoCDAppUpdate(stageMeta, app.ObjectMeta); err != nil {
 		return err
 	}
+	// Syncing while another operation is in progress would race with that
+	// operation, so we wait for it to complete first
+	if app, err = a.waitForOperation(ctx, app); err != nil {
+		return err
+	}
 	patch := client.MergeFrom(app.DeepCopy())
 	for _, srcUpdate := range update.SourceUpdates {
 		if app.Spec.Source != nil {
@@ -200,6 +225,112 @@ func (a *argoCDMechanism) doSingleUpdate(
 	return nil
 }
 
+// waitForOperation waits for any operation in progress on the provided Argo CD
+// Application to complete and returns the latest state of the Application. If
+// the operation does not complete within the mechanism's operationWaitTimeout,
+// or that timeout is zero, a failure with reason ArgoCDOperationInProgress is
+// returned.
+func (a *argoCDMechanism) waitForOperation(
+	ctx context.Context,
+	app *argocd.Application,
+) (*argocd.Application, error) {
+	if !operationInProgress(app) {
+		return app, nil
+	}
+	if a.operationWaitTimeout <= 0 {
+		return nil, newFailure(
+			kargoapi.PromotionFailureReasonArgoCDOperationInProgress,
+			errors.Errorf("%s must complete before syncing", describeOperation(app)),
+		)
+	}
+	logger := logging.LoggerFromContext(ctx).WithField("app", app.Name)
+	logger.Debug("waiting for operation in progress on Argo CD Application")
+	timeout := time.NewTimer(a.operationWaitTimeout)
+	defer timeout.Stop()
+	ticker := time.NewTicker(a.operationPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrapf(
+				ctx.Err(),
+				"error waiting for operation in progress on Argo CD Application %q "+
+					"in namespace %q to complete",
+				app.Name,
+				app.Namespace,
+			)
+		case <-timeout.C:
+			return nil, newFailure(
+				kargoapi.PromotionFailureReasonArgoCDOperationInProgress,
+				errors.Errorf(
+					"%s did not complete within %s",
+					describeOperation(app),
+					a.operationWaitTimeout,
+				),
+			)
+		case <-ticker.C:
+		}
+		latest, err := a.getArgoCDAppFn(ctx, app.Namespace, app.Name)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error finding Argo CD Application %q in namespace %q",
+				app.Name,
+				app.Namespace,
+			)
+		}
+		if latest == nil {
+			return nil, errors.Errorf(
+				"unable to find Argo CD Application %q in namespace %q",
+				app.Name,
+				app.Namespace,
+			)
+		}
+		if !operationInProgress(latest) {
+			logger.Debug("operation in progress on Argo CD Application completed")
+			return latest, nil
+		}
+		app = latest
+	}
+}
+
+// operationInProgress returns true if an operation has been requested on the
+// provided Argo CD Application or an operation that has been started on it has
+// not yet completed.
+func operationInProgress(app *argocd.Application) bool {
+	if app.Operation != nil {
+		return true
+	}
+	state := app.Status.OperationState
+	return state != nil && !state.Phase.Completed()
+}
+
+// describeOperation returns a description of the operation in progress on the
+// provided Argo CD Application for use in error messages.
+func describeOperation(app *argocd.Application) string {
+	op := app.Operation
+	if op == nil && app.Status.OperationState != nil {
+		op = &app.Status.OperationState.Operation
+	}
+	desc := fmt.Sprintf(
+		"operation in progress on Argo CD Application %q in namespace %q",
+		app.Name,
+		app.Namespace,
+	)
+	if op != nil {
+		switch {
+		case op.InitiatedBy.Username != "":
+			desc = fmt.Sprintf("%s (initiated by %q)", desc, op.InitiatedBy.Username)
+		case op.InitiatedBy.Automated:
+			desc = fmt.Sprintf("%s (initiated by automated sync)", desc)
+		}
+	}
+	if state := app.Status.OperationState; state != nil && state.Message != "" {
+		desc = fmt.Sprintf("%s: %s", desc, state.Message)
+	}
+	return desc
+}
+
 func getApplicationFn(
 	argoClient client.Client,
 ) func(
@@ -3,6 +3,7 @@ package promotion
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -17,9 +18,12 @@ import (
 func TestNewArgoCDMechanism(t *testing.T) {
 	pm := newArgoCDMechanism(
 		fake.NewClientBuilder().Build(),
+		time.Minute,
 	)
 	apm, ok := pm.(*argoCDMechanism)
 	require.True(t, ok)
+	require.Equal(t, time.Minute, apm.operationWaitTimeout)
+	require.Equal(t, defaultOperationPollInterval, apm.operationPollInterval)
 	require.NotNil(t, apm.doSingleUpdateFn)
 	require.NotNil(t, apm.getArgoCDAppFn)
 	require.NotNil(t, apm.applyArgoCDSourceUpdateFn)
@@ -204,6 +208,44 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 				require.Contains(t, err.Error(), "does not permit mutation by")
 			},
 		},
+		{
+			name: "operation in progress",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-name",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+						Operation: &argocd.Operation{
+							InitiatedBy: argocd.OperationInitiator{
+								Username: "fake-user",
+							},
+						},
+					}, nil
+				},
+			},
+			stageMeta: metav1.ObjectMeta{
+				Name:      "fake-name",
+				Namespace: "fake-namespace",
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(
+					t,
+					kargoapi.PromotionFailureReasonArgoCDOperationInProgress,
+					FailureReason(err),
+				)
+				require.Contains(t, err.Error(), `initiated by "fake-user"`)
+			},
+		},
 		{
 			name: "error updating app.Spec.Source",
 			promoMech: &argoCDMechanism{
@@ -393,6 +435,129 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 	}
 }
 
+func TestArgoCDWaitForOperation(t *testing.T) {
+	testApp := &argocd.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-name",
+			Namespace: "fake-namespace",
+		},
+		Status: argocd.ApplicationStatus{
+			OperationState: &argocd.OperationState{
+				Operation: argocd.Operation{
+					InitiatedBy: argocd.OperationInitiator{
+						Automated: true,
+					},
+				},
+				Phase:   argocd.OperationRunning,
+				Message: "waiting for healthy state",
+			},
+		},
+	}
+	completedApp := testApp.DeepCopy()
+	completedApp.Status.OperationState.Phase = argocd.OperationSucceeded
+	testCases := []struct {
+		name       string
+		promoMech  *argoCDMechanism
+		app        *argocd.Application
+		assertions func(*argocd.Application, error)
+	}{
+		{
+			name:      "no operation in progress",
+			promoMech: &argoCDMechanism{},
+			app:       completedApp,
+			assertions: func(app *argocd.Application, err error) {
+				require.NoError(t, err)
+				require.Same(t, completedApp, app)
+			},
+		},
+		{
+			name:      "operation in progress; not waiting",
+			promoMech: &argoCDMechanism{},
+			app:       testApp,
+			assertions: func(_ *argocd.Application, err error) {
+				require.Error(t, err)
+				require.Equal(
+					t,
+					kargoapi.PromotionFailureReasonArgoCDOperationInProgress,
+					FailureReason(err),
+				)
+				require.Contains(t, err.Error(), "initiated by automated sync")
+				require.Contains(t, err.Error(), "waiting for healthy state")
+			},
+		},
+		{
+			name: "error getting Argo CD App while waiting",
+			promoMech: &argoCDMechanism{
+				operationWaitTimeout:  time.Minute,
+				operationPollInterval: time.Millisecond,
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			app: testApp,
+			assertions: func(_ *argocd.Application, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error finding Argo CD Application")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "operation does not complete in time",
+			promoMech: &argoCDMechanism{
+				operationWaitTimeout:  10 * time.Millisecond,
+				operationPollInterval: time.Millisecond,
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return testApp, nil
+				},
+			},
+			app: testApp,
+			assertions: func(_ *argocd.Application, err error) {
+				require.Error(t, err)
+				require.Equal(
+					t,
+					kargoapi.PromotionFailureReasonArgoCDOperationInProgress,
+					FailureReason(err),
+				)
+				require.Contains(t, err.Error(), "did not complete within 10ms")
+			},
+		},
+		{
+			name: "operation completes while waiting",
+			promoMech: &argoCDMechanism{
+				operationWaitTimeout:  time.Minute,
+				operationPollInterval: time.Millisecond,
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return completedApp, nil
+				},
+			},
+			app: testApp,
+			assertions: func(app *argocd.Application, err error) {
+				require.NoError(t, err)
+				require.Same(t, completedApp, app)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.promoMech.waitForOperation(context.Background(), testCase.app),
+			)
+		})
+	}
+}
+
 func TestAuthorizeArgoCDAppUpdate(t *testing.T) {
 	permErr := "does not permit mutation"
 	parseErr := "unable to parse"
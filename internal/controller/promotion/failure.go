@@ -0,0 +1,79 @@
+package promotion
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+)
+
+// failure is an error that has been classified with a PromotionFailureReason.
+type failure struct {
+	reason kargoapi.PromotionFailureReason
+	err    error
+}
+
+func (f *failure) Error() string {
+	return f.err.Error()
+}
+
+func (f *failure) Unwrap() error {
+	return f.err
+}
+
+// newFailure classifies the provided error with the provided reason. If the
+// provided error is nil, nil is returned. If the provided error has already
+// been classified, it is returned unchanged, since classification performed
+// closest to the cause of an error is assumed to be the most accurate.
+func newFailure(reason kargoapi.PromotionFailureReason, err error) error {
+	if err == nil {
+		return nil
+	}
+	var f *failure
+	if errors.As(err, &f) {
+		return err
+	}
+	return &failure{
+		reason: reason,
+		err:    err,
+	}
+}
+
+// FailureReason returns the PromotionFailureReason with which the provided
+// error, or any error it wraps, has been classified. If the error has not been
+// classified, PromotionFailureReasonUnknown is returned.
+func FailureReason(err error) kargoapi.PromotionFailureReason {
+	var f *failure
+	if errors.As(err, &f) {
+		return f.reason
+	}
+	return kargoapi.PromotionFailureReasonUnknown
+}
+
+// classifyGitError classifies the provided error if it indicates a failure to
+// authenticate to a Git repository or a rejected push. Other errors are
+// returned unchanged.
+func classifyGitError(err error) error {
+	switch {
+	case git.IsAuthError(err):
+		return newFailure(kargoapi.PromotionFailureReasonGitAuthFailed, err)
+	case git.IsPushRejectedError(err):
+		return newFailure(kargoapi.PromotionFailureReasonMergeConflict, err)
+	default:
+		return err
+	}
+}
+
+// classifyArgoCDError classifies the provided error if it indicates that an
+// operation against Argo CD timed out. Other errors are returned unchanged.
+func classifyArgoCDError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsServerTimeout(err) {
+		return newFailure(kargoapi.PromotionFailureReasonSyncTimeout, err)
+	}
+	return err
+}
@@ -0,0 +1,139 @@
+package promotion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libExec "github.com/akuity/kargo/internal/exec"
+)
+
+func TestNewFailure(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		require.NoError(
+			t,
+			newFailure(kargoapi.PromotionFailureReasonRenderError, nil),
+		)
+	})
+
+	t.Run("unclassified error", func(t *testing.T) {
+		cause := errors.New("something went wrong")
+		err := newFailure(kargoapi.PromotionFailureReasonRenderError, cause)
+		require.Equal(t, kargoapi.PromotionFailureReasonRenderError, FailureReason(err))
+		require.Equal(t, "something went wrong", err.Error())
+		require.ErrorIs(t, err, cause)
+	})
+
+	t.Run("already classified error", func(t *testing.T) {
+		err := newFailure(
+			kargoapi.PromotionFailureReasonRenderError,
+			errors.Wrap(
+				newFailure(
+					kargoapi.PromotionFailureReasonGitAuthFailed,
+					errors.New("something went wrong"),
+				),
+				"wrapped",
+			),
+		)
+		require.Equal(t, kargoapi.PromotionFailureReasonGitAuthFailed, FailureReason(err))
+	})
+}
+
+func TestFailureReason(t *testing.T) {
+	require.Equal(
+		t,
+		kargoapi.PromotionFailureReasonUnknown,
+		FailureReason(errors.New("something went wrong")),
+	)
+	require.Equal(
+		t,
+		kargoapi.PromotionFailureReasonMergeConflict,
+		FailureReason(errors.Wrap(
+			newFailure(
+				kargoapi.PromotionFailureReasonMergeConflict,
+				errors.New("something went wrong"),
+			),
+			"wrapped",
+		)),
+	)
+}
+
+func TestClassifyGitError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected kargoapi.PromotionFailureReason
+	}{
+		{
+			name:     "unrelated error",
+			err:      errors.New("something went wrong"),
+			expected: kargoapi.PromotionFailureReasonUnknown,
+		},
+		{
+			name: "auth failure",
+			err: errors.Wrap(
+				&libExec.ExitError{
+					Output: []byte("fatal: Authentication failed for 'https://github.com/akuity/kargo'"),
+				},
+				"error cloning repo",
+			),
+			expected: kargoapi.PromotionFailureReasonGitAuthFailed,
+		},
+		{
+			name: "rejected push",
+			err: errors.Wrap(
+				&libExec.ExitError{
+					Output: []byte(" ! [rejected]        main -> main (non-fast-forward)"),
+				},
+				"error pushing branch",
+			),
+			expected: kargoapi.PromotionFailureReasonMergeConflict,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				FailureReason(classifyGitError(testCase.err)),
+			)
+		})
+	}
+}
+
+func TestClassifyArgoCDError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected kargoapi.PromotionFailureReason
+	}{
+		{
+			name:     "unrelated error",
+			err:      errors.New("something went wrong"),
+			expected: kargoapi.PromotionFailureReasonUnknown,
+		},
+		{
+			name:     "context deadline exceeded",
+			err:      errors.Wrap(context.DeadlineExceeded, "error patching Argo CD Application"),
+			expected: kargoapi.PromotionFailureReasonSyncTimeout,
+		},
+		{
+			name:     "server timeout",
+			err:      apierrors.NewTimeoutError("request timed out", 1),
+			expected: kargoapi.PromotionFailureReasonSyncTimeout,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				FailureReason(classifyArgoCDError(testCase.err)),
+			)
+		})
+	}
+}
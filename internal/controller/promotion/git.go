@@ -37,6 +37,7 @@ type gitMechanism struct {
 		repoURL string,
 	) (*git.RepoCredentials, error)
 	gitCommitFn func(
+		ctx context.Context,
 		update kargoapi.GitRepoUpdate,
 		newFreight kargoapi.SimpleFreight,
 		readRef string,
@@ -49,6 +50,11 @@ type gitMechanism struct {
 		homeDir string,
 		workingDir string,
 	) ([]string, error)
+	pushFn func(
+		ctx context.Context,
+		repo git.Repo,
+		update kargoapi.GitRepoUpdate,
+	) error
 }
 
 // newGitMechanism returns an implementation of the Mechanism interface that
@@ -75,6 +81,7 @@ func newGitMechanism(
 	g.getCredentialsFn = getRepoCredentialsFn(credentialsDB)
 	g.gitCommitFn = g.gitCommit
 	g.applyConfigManagementFn = applyConfigManagementFn
+	g.pushFn = push
 	return g
 }
 
@@ -139,6 +146,7 @@ func (g *gitMechanism) doSingleUpdate(
 	}
 
 	commitID, err := g.gitCommitFn(
+		ctx,
 		update,
 		newFreight,
 		readRef,
@@ -146,7 +154,7 @@ func (g *gitMechanism) doSingleUpdate(
 		creds,
 	)
 	if err != nil {
-		return newFreight, err
+		return newFreight, classifyGitError(err)
 	}
 
 	if commitIndex > -1 {
@@ -230,10 +238,12 @@ func getRepoCredentialsFn(
 // gitCommit clones the specified git repository using the provided credentials
 // (which may be nil), checks out the specified readRef (if non-empty), applies
 // the provided update function to the cloned repository, and then commits and
-// pushes any changes to the specified writeBranch. The function returns the
-// commit ID of the last commit made to the repository, or an error if any of
-// the above fails.
+// pushes any changes to the specified writeBranch, handling any rejected push
+// according to the update's conflict resolution strategy. The function returns
+// the commit ID of the last commit made to the repository, or an error if any
+// of the above fails.
 func (g *gitMechanism) gitCommit(
+	ctx context.Context,
 	update kargoapi.GitRepoUpdate,
 	newFreight kargoapi.SimpleFreight,
 	readRef string,
@@ -269,7 +279,10 @@ func (g *gitMechanism) gitCommit(
 			repo.HomeDir(),
 			repo.WorkingDir(),
 		); err != nil {
-			return "", err
+			return "", newFailure(
+				kargoapi.PromotionFailureReasonRenderError,
+				classifyGitError(err),
+			)
 		}
 	}
 	commitMsg := buildCommitMessage(changes)
@@ -355,7 +368,7 @@ func (g *gitMechanism) gitCommit(
 				update.RepoURL,
 			)
 		}
-		if err = repo.Push(); err != nil {
+		if err = g.pushFn(ctx, repo, update); err != nil {
 			return "", errors.Wrapf(
 				err,
 				"error pushing updates to git repo %q",
@@ -41,6 +41,7 @@ func TestNewGitMechanism(t *testing.T) {
 	require.NotNil(t, gpm.getCredentialsFn)
 	require.NotNil(t, gpm.gitCommitFn)
 	require.NotNil(t, gpm.applyConfigManagementFn)
+	require.NotNil(t, gpm.pushFn)
 }
 
 func TestGitGetName(t *testing.T) {
@@ -217,6 +218,7 @@ func TestGitDoSingleUpdate(t *testing.T) {
 					return nil, nil
 				},
 				gitCommitFn: func(
+					ctx context.Context,
 					update kargoapi.GitRepoUpdate,
 					newFreight kargoapi.SimpleFreight,
 					readRef string,
@@ -253,6 +255,7 @@ func TestGitDoSingleUpdate(t *testing.T) {
 					return nil, nil
 				},
 				gitCommitFn: func(
+					ctx context.Context,
 					update kargoapi.GitRepoUpdate,
 					newFreight kargoapi.SimpleFreight,
 					readRef string,
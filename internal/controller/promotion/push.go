@@ -0,0 +1,161 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// defaultMaxPushAttempts is the number of times a push is attempted when a
+// conflict resolution strategy other than Fail is in effect and the maximum
+// number of attempts has not been specified.
+const defaultMaxPushAttempts = 3
+
+type pushAttemptsContextKey struct{}
+
+// ContextWithPushAttempts returns a context.Context that carries the provided
+// slice, to which Git-based promotion mechanisms append a record of each of
+// their attempts to push changes to a Git repository.
+func ContextWithPushAttempts(
+	ctx context.Context,
+	attempts *[]kargoapi.GitPushAttempt,
+) context.Context {
+	return context.WithValue(ctx, pushAttemptsContextKey{}, attempts)
+}
+
+// recordPushAttempt appends the provided attempt to the slice carried by the
+// provided context.Context, if any.
+func recordPushAttempt(ctx context.Context, attempt kargoapi.GitPushAttempt) {
+	if attempts, ok :=
+		ctx.Value(pushAttemptsContextKey{}).(*[]kargoapi.GitPushAttempt); ok {
+		*attempts = append(*attempts, attempt)
+	}
+}
+
+// push pushes committed changes in the provided repository to the write branch
+// of the provided update. If the push is rejected because the remote branch
+// has diverged, the update's conflict resolution strategy determines whether
+// the changes are rebased onto the remote branch and the push retried.
+func push(
+	ctx context.Context,
+	repo git.Repo,
+	update kargoapi.GitRepoUpdate,
+) error {
+	logger := logging.LoggerFromContext(ctx).WithField("repo", update.RepoURL)
+
+	strategy := kargoapi.GitConflictStrategyFail
+	maxAttempts := int32(1)
+	var oursPaths []string
+	if res := update.ConflictResolution; res != nil &&
+		res.Strategy != "" && res.Strategy != kargoapi.GitConflictStrategyFail {
+		strategy = res.Strategy
+		maxAttempts = res.MaxAttempts
+		if maxAttempts < 1 {
+			maxAttempts = defaultMaxPushAttempts
+		}
+		oursPaths = res.Paths
+	}
+
+	for attempt := int32(1); ; attempt++ {
+		record := kargoapi.GitPushAttempt{
+			RepoURL: update.RepoURL,
+			Branch:  update.WriteBranch,
+			Attempt: attempt,
+		}
+
+		err := repo.Push()
+		if err == nil {
+			record.Result = kargoapi.GitPushResultPushed
+			recordPushAttempt(ctx, record)
+			return nil
+		}
+		if !git.IsPushRejectedError(err) {
+			return err
+		}
+
+		record.Result = kargoapi.GitPushResultRejected
+		if attempt >= maxAttempts {
+			record.Message = "push was rejected because the remote branch has " +
+				"diverged and no attempts remain"
+			recordPushAttempt(ctx, record)
+			return err
+		}
+
+		conflicts, err := repo.PullRebase()
+		if err != nil {
+			record.Message = "push was rejected and rebasing onto the remote " +
+				"branch failed"
+			recordPushAttempt(ctx, record)
+			return err
+		}
+		if len(conflicts) > 0 {
+			unresolvable := conflicts
+			if strategy == kargoapi.GitConflictStrategyOursOnPath {
+				unresolvable = pathsOutside(conflicts, oursPaths)
+			}
+			if len(unresolvable) > 0 {
+				record.Result = kargoapi.GitPushResultConflicted
+				record.Message = fmt.Sprintf(
+					"rebasing onto the remote branch produced conflicts in %s",
+					strings.Join(unresolvable, ", "),
+				)
+				recordPushAttempt(ctx, record)
+				if abortErr := repo.AbortRebase(); abortErr != nil {
+					logger.Errorf("error aborting rebase: %s", abortErr)
+				}
+				return newFailure(
+					kargoapi.PromotionFailureReasonMergeConflict,
+					errors.Errorf(
+						"error rebasing changes onto remote branch %q: "+
+							"unresolvable conflicts in %s",
+						update.WriteBranch,
+						strings.Join(unresolvable, ", "),
+					),
+				)
+			}
+			if err = repo.ResolveConflicts(conflicts); err != nil {
+				record.Message = "push was rejected and resolving conflicts with " +
+					"the remote branch failed"
+				recordPushAttempt(ctx, record)
+				return err
+			}
+			record.ResolvedPaths = conflicts
+		}
+
+		record.Message = "push was rejected because the remote branch has " +
+			"diverged; rebased onto the remote branch to retry"
+		recordPushAttempt(ctx, record)
+		logger.Debugf(
+			"push attempt %d of %d was rejected; retrying",
+			attempt,
+			maxAttempts,
+		)
+	}
+}
+
+// pathsOutside returns those of the provided paths that are not equal to, and
+// are not contained in, any of the provided candidate parent paths.
+func pathsOutside(paths []string, parents []string) []string {
+	var outside []string
+	for _, path := range paths {
+		var matched bool
+		for _, parent := range parents {
+			parent = filepath.Clean(parent)
+			if path == parent || strings.HasPrefix(path, parent+"/") {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			outside = append(outside, path)
+		}
+	}
+	return outside
+}
@@ -0,0 +1,265 @@
+package promotion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	libExec "github.com/akuity/kargo/internal/exec"
+)
+
+// fakePushRepo is a git.Repo whose push and rebase behaviors are overridable.
+// Calling any other method will panic.
+type fakePushRepo struct {
+	git.Repo
+	pushFn             func() error
+	pullRebaseFn       func() ([]string, error)
+	resolveConflictsFn func([]string) error
+	abortRebaseFn      func() error
+}
+
+func (f *fakePushRepo) Push() error {
+	return f.pushFn()
+}
+
+func (f *fakePushRepo) PullRebase() ([]string, error) {
+	return f.pullRebaseFn()
+}
+
+func (f *fakePushRepo) ResolveConflicts(paths []string) error {
+	return f.resolveConflictsFn(paths)
+}
+
+func (f *fakePushRepo) AbortRebase() error {
+	return f.abortRebaseFn()
+}
+
+func TestPush(t *testing.T) {
+	rejectedErr := &libExec.ExitError{
+		Output: []byte(" ! [rejected]        main -> main (fetch first)"),
+	}
+	testCases := []struct {
+		name       string
+		resolution *kargoapi.GitConflictResolution
+		repo       *fakePushRepo
+		assertions func(error, []kargoapi.GitPushAttempt)
+	}{
+		{
+			name: "push succeeds",
+			repo: &fakePushRepo{
+				pushFn: func() error { return nil },
+			},
+			assertions: func(err error, attempts []kargoapi.GitPushAttempt) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]kargoapi.GitPushAttempt{{
+						RepoURL: "fake-url",
+						Branch:  "fake-branch",
+						Attempt: 1,
+						Result:  kargoapi.GitPushResultPushed,
+					}},
+					attempts,
+				)
+			},
+		},
+		{
+			name: "push fails for reason other than rejection",
+			resolution: &kargoapi.GitConflictResolution{
+				Strategy: kargoapi.GitConflictStrategyRebaseAndRetry,
+			},
+			repo: &fakePushRepo{
+				pushFn: func() error { return errors.New("something went wrong") },
+			},
+			assertions: func(err error, attempts []kargoapi.GitPushAttempt) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+				require.Empty(t, attempts)
+			},
+		},
+		{
+			name: "push rejected with no conflict resolution",
+			repo: &fakePushRepo{
+				pushFn: func() error { return rejectedErr },
+			},
+			assertions: func(err error, attempts []kargoapi.GitPushAttempt) {
+				require.Error(t, err)
+				require.True(t, git.IsPushRejectedError(err))
+				require.Len(t, attempts, 1)
+				require.Equal(t, kargoapi.GitPushResultRejected, attempts[0].Result)
+			},
+		},
+		{
+			name: "push rejected; rebase and retry succeeds",
+			resolution: &kargoapi.GitConflictResolution{
+				Strategy: kargoapi.GitConflictStrategyRebaseAndRetry,
+			},
+			repo: func() *fakePushRepo {
+				var pushes int
+				return &fakePushRepo{
+					pushFn: func() error {
+						if pushes++; pushes == 1 {
+							return rejectedErr
+						}
+						return nil
+					},
+					pullRebaseFn: func() ([]string, error) { return nil, nil },
+				}
+			}(),
+			assertions: func(err error, attempts []kargoapi.GitPushAttempt) {
+				require.NoError(t, err)
+				require.Len(t, attempts, 2)
+				require.Equal(t, kargoapi.GitPushResultRejected, attempts[0].Result)
+				require.Equal(t, int32(1), attempts[0].Attempt)
+				require.Equal(t, kargoapi.GitPushResultPushed, attempts[1].Result)
+				require.Equal(t, int32(2), attempts[1].Attempt)
+			},
+		},
+		{
+			name: "push rejected; attempts exhausted",
+			resolution: &kargoapi.GitConflictResolution{
+				Strategy:    kargoapi.GitConflictStrategyRebaseAndRetry,
+				MaxAttempts: 2,
+			},
+			repo: &fakePushRepo{
+				pushFn:       func() error { return rejectedErr },
+				pullRebaseFn: func() ([]string, error) { return nil, nil },
+			},
+			assertions: func(err error, attempts []kargoapi.GitPushAttempt) {
+				require.Error(t, err)
+				require.True(t, git.IsPushRejectedError(err))
+				require.Len(t, attempts, 2)
+				require.Contains(t, attempts[1].Message, "no attempts remain")
+			},
+		},
+		{
+			name: "push rejected; error rebasing",
+			resolution: &kargoapi.GitConflictResolution{
+				Strategy: kargoapi.GitConflictStrategyRebaseAndRetry,
+			},
+			repo: &fakePushRepo{
+				pushFn: func() error { return rejectedErr },
+				pullRebaseFn: func() ([]string, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error, attempts []kargoapi.GitPushAttempt) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+				require.Len(t, attempts, 1)
+			},
+		},
+		{
+			name: "push rejected; rebase and retry conflicts",
+			resolution: &kargoapi.GitConflictResolution{
+				Strategy: kargoapi.GitConflictStrategyRebaseAndRetry,
+			},
+			repo: &fakePushRepo{
+				pushFn: func() error { return rejectedErr },
+				pullRebaseFn: func() ([]string, error) {
+					return []string{"env/values.yaml"}, nil
+				},
+				abortRebaseFn: func() error { return nil },
+			},
+			assertions: func(err error, attempts []kargoapi.GitPushAttempt) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unresolvable conflicts in env/values.yaml")
+				require.Equal(
+					t,
+					kargoapi.PromotionFailureReasonMergeConflict,
+					FailureReason(err),
+				)
+				require.Len(t, attempts, 1)
+				require.Equal(t, kargoapi.GitPushResultConflicted, attempts[0].Result)
+			},
+		},
+		{
+			name: "push rejected; conflicts outside of ours paths",
+			resolution: &kargoapi.GitConflictResolution{
+				Strategy: kargoapi.GitConflictStrategyOursOnPath,
+				Paths:    []string{"env/"},
+			},
+			repo: &fakePushRepo{
+				pushFn: func() error { return rejectedErr },
+				pullRebaseFn: func() ([]string, error) {
+					return []string{"env/values.yaml", "environment.yaml"}, nil
+				},
+				abortRebaseFn: func() error { return nil },
+			},
+			assertions: func(err error, attempts []kargoapi.GitPushAttempt) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unresolvable conflicts in environment.yaml")
+				require.Len(t, attempts, 1)
+				require.Equal(t, kargoapi.GitPushResultConflicted, attempts[0].Result)
+			},
+		},
+		{
+			name: "push rejected; conflicts resolved in favor of ours",
+			resolution: &kargoapi.GitConflictResolution{
+				Strategy: kargoapi.GitConflictStrategyOursOnPath,
+				Paths:    []string{"env"},
+			},
+			repo: func() *fakePushRepo {
+				var pushes int
+				return &fakePushRepo{
+					pushFn: func() error {
+						if pushes++; pushes == 1 {
+							return rejectedErr
+						}
+						return nil
+					},
+					pullRebaseFn: func() ([]string, error) {
+						return []string{"env/values.yaml"}, nil
+					},
+					resolveConflictsFn: func(paths []string) error {
+						if len(paths) != 1 || paths[0] != "env/values.yaml" {
+							return errors.Errorf("unexpected paths %v", paths)
+						}
+						return nil
+					},
+				}
+			}(),
+			assertions: func(err error, attempts []kargoapi.GitPushAttempt) {
+				require.NoError(t, err)
+				require.Len(t, attempts, 2)
+				require.Equal(t, []string{"env/values.yaml"}, attempts[0].ResolvedPaths)
+				require.Equal(t, kargoapi.GitPushResultPushed, attempts[1].Result)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var attempts []kargoapi.GitPushAttempt
+			err := push(
+				ContextWithPushAttempts(context.Background(), &attempts),
+				testCase.repo,
+				kargoapi.GitRepoUpdate{
+					RepoURL:            "fake-url",
+					WriteBranch:        "fake-branch",
+					ConflictResolution: testCase.resolution,
+				},
+			)
+			testCase.assertions(err, attempts)
+		})
+	}
+}
+
+func TestPathsOutside(t *testing.T) {
+	require.Equal(
+		t,
+		[]string{"environment.yaml", "other/env/values.yaml"},
+		pathsOutside(
+			[]string{
+				"env/values.yaml",
+				"environment.yaml",
+				"charts/app/Chart.yaml",
+				"other/env/values.yaml",
+			},
+			[]string{"env/", "charts/app/Chart.yaml"},
+		),
+	)
+}
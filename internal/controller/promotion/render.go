@@ -150,10 +150,15 @@ func (b *kargoRenderMechanism) doSingleUpdate(
 
 	res, err := b.renderManifestsFn(req)
 	if err != nil {
-		return newFreight, errors.Wrapf(
-			err,
-			"error rendering manifests for git repo %q via Kargo Render",
-			update.RepoURL,
+		// Kargo Render performs its own Git operations, so its errors may have
+		// causes other than a failure to render manifests
+		return newFreight, newFailure(
+			kargoapi.PromotionFailureReasonRenderError,
+			classifyGitError(errors.Wrapf(
+				err,
+				"error rendering manifests for git repo %q via Kargo Render",
+				update.RepoURL,
+			)),
 		)
 	}
 	switch res.ActionTaken {
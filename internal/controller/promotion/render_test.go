@@ -256,6 +256,11 @@ func TestKargoRenderDoSingleUpdate(t *testing.T) {
 					"error rendering manifests for git repo",
 				)
 				require.Contains(t, err.Error(), "something went wrong")
+				require.Equal(
+					t,
+					kargoapi.PromotionFailureReasonRenderError,
+					FailureReason(err),
+				)
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
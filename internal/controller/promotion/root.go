@@ -2,6 +2,7 @@ package promotion
 
 import (
 	"context"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -24,10 +25,13 @@ type Mechanism interface {
 }
 
 // NewMechanisms returns the entrypoint to a hierarchical tree of promotion
-// mechanisms.
+// mechanisms. The Argo CD promotion mechanism waits up to
+// argoCDOperationWaitTimeout for operations already in progress on Argo CD
+// Applications to complete before syncing them.
 func NewMechanisms(
 	argoClient client.Client,
 	credentialsDB credentials.Database,
+	argoCDOperationWaitTimeout time.Duration,
 ) Mechanism {
 	return newCompositeMechanism(
 		"promotion mechanisms",
@@ -38,6 +42,6 @@ func NewMechanisms(
 			newKustomizeMechanism(credentialsDB),
 			newHelmMechanism(credentialsDB),
 		),
-		newArgoCDMechanism(argoClient),
+		newArgoCDMechanism(argoClient, argoCDOperationWaitTimeout),
 	)
 }
@@ -3,6 +3,7 @@ package promotion
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -15,6 +16,7 @@ func TestNewMechanisms(t *testing.T) {
 	promoMechs := NewMechanisms(
 		fake.NewClientBuilder().Build(),
 		credentials.NewKubernetesDatabase("", nil, nil),
+		time.Minute,
 	)
 	require.IsType(t, &compositeMechanism{}, promoMechs)
 }
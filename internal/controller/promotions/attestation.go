@@ -0,0 +1,214 @@
+package promotions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/provenance"
+	versionpkg "github.com/akuity/kargo/internal/version"
+)
+
+const (
+	// attestationBuildType identifies the process described by provenance
+	// attestations for Promotions.
+	attestationBuildType = "https://kargo.akuity.io/Promotion/v1alpha1"
+	// attestationBuilderID identifies the entity that executes Promotions.
+	attestationBuilderID = "https://github.com/akuity/kargo"
+)
+
+// attest returns a signed provenance attestation for the provided Promotion,
+// which is assumed to have just been executed successfully. If the reconciler
+// has not been configured with a signing key, nil is returned. If the
+// reconciler has been configured to push attestations to an OCI repository, a
+// failure to do so is logged, but does not prevent the attestation from being
+// returned.
+func (r *reconciler) attest(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+	startedAt time.Time,
+	finishedAt time.Time,
+) (*kargoapi.PromotionAttestation, error) {
+	if r.signer == nil {
+		return nil, nil
+	}
+
+	freight, err := kargoapi.GetFreight(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.FreightNamespace(),
+			Name:      promo.Spec.Freight,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error finding Freight %q in namespace %q",
+			promo.Spec.Freight,
+			promo.FreightNamespace(),
+		)
+	}
+	if freight == nil {
+		return nil, errors.Errorf(
+			"could not find Freight %q in namespace %q",
+			promo.Spec.Freight,
+			promo.FreightNamespace(),
+		)
+	}
+
+	envelope, err := r.signer.Sign(
+		newPromotionStatement(promo, *freight, startedAt, finishedAt),
+	)
+	if err != nil {
+		return nil, err
+	}
+	attestation := &kargoapi.PromotionAttestation{
+		PayloadType: envelope.PayloadType,
+		Payload:     envelope.Payload,
+		Signatures:  make([]kargoapi.AttestationSignature, len(envelope.Signatures)),
+	}
+	for i, sig := range envelope.Signatures {
+		attestation.Signatures[i] = kargoapi.AttestationSignature{
+			KeyID: sig.KeyID,
+			Sig:   sig.Sig,
+		}
+	}
+
+	if r.cfg.AttestationRepository != "" {
+		if attestation.Reference, err =
+			r.pushAttestationFn(ctx, promo, envelope); err != nil {
+			logging.LoggerFromContext(ctx).Errorf(
+				"error pushing attestation to %q: %s",
+				r.cfg.AttestationRepository,
+				err,
+			)
+		}
+	}
+
+	return attestation, nil
+}
+
+// pushAttestation pushes the provided envelope to the OCI repository the
+// reconciler has been configured to push attestations to, tagged with the UID
+// of the provided Promotion. Credentials for the repository are resolved in
+// the same manner as credentials for any other image repository.
+func (r *reconciler) pushAttestation(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+	envelope *provenance.Envelope,
+) (string, error) {
+	creds, ok, err := r.credentialsDB.Get(
+		ctx,
+		promo.Namespace,
+		credentials.TypeImage,
+		r.cfg.AttestationRepository,
+	)
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error obtaining credentials for image repo %q",
+			r.cfg.AttestationRepository,
+		)
+	}
+	var repoCreds *provenance.Credentials
+	if ok {
+		repoCreds = &provenance.Credentials{
+			Username: creds.Username,
+			Password: creds.Password,
+		}
+	}
+	return provenance.Push(
+		ctx,
+		r.cfg.AttestationRepository,
+		string(promo.UID),
+		envelope,
+		repoCreds,
+	)
+}
+
+// newPromotionStatement returns an in-toto Statement describing the execution
+// of the provided Promotion of the provided Freight.
+func newPromotionStatement(
+	promo kargoapi.Promotion,
+	freight kargoapi.Freight,
+	startedAt time.Time,
+	finishedAt time.Time,
+) provenance.Statement {
+	internalParams := map[string]any{}
+	for _, key := range []string{
+		kargoapi.LabelAutoPromotionKey,
+		kargoapi.LabelSelfHealKey,
+		kargoapi.LabelReleaseKey,
+	} {
+		if val, ok := promo.Labels[key]; ok {
+			internalParams[key] = val
+		}
+	}
+
+	deps := make(
+		[]provenance.ResourceDescriptor,
+		0,
+		len(freight.Commits)+len(freight.Images)+len(freight.Charts),
+	)
+	for _, commit := range freight.Commits {
+		deps = append(deps, provenance.ResourceDescriptor{
+			URI:    "git+" + commit.RepoURL,
+			Digest: map[string]string{"gitCommit": commit.ID},
+		})
+	}
+	for _, image := range freight.Images {
+		deps = append(deps, provenance.ResourceDescriptor{
+			URI: fmt.Sprintf("%s:%s", image.RepoURL, image.Tag),
+		})
+	}
+	for _, chart := range freight.Charts {
+		deps = append(deps, provenance.ResourceDescriptor{
+			URI: fmt.Sprintf("%s/%s:%s", chart.RegistryURL, chart.Name, chart.Version),
+		})
+	}
+
+	startedAt = startedAt.UTC()
+	finishedAt = finishedAt.UTC()
+	return provenance.NewStatement(
+		[]provenance.ResourceDescriptor{
+			{
+				Name:   freight.Name,
+				Digest: map[string]string{"sha1": freight.ID},
+			},
+		},
+		provenance.Provenance{
+			BuildDefinition: provenance.BuildDefinition{
+				BuildType: attestationBuildType,
+				ExternalParameters: map[string]any{
+					"namespace": promo.Namespace,
+					"promotion": promo.Name,
+					"stage":     promo.Spec.Stage,
+					"freight":   promo.Spec.Freight,
+					"promoter":  promo.Annotations[kargoapi.AnnotationKeyPromoter],
+				},
+				InternalParameters:   internalParams,
+				ResolvedDependencies: deps,
+			},
+			RunDetails: provenance.RunDetails{
+				Builder: provenance.Builder{
+					ID: attestationBuilderID,
+					Version: map[string]string{
+						"kargo": versionpkg.GetVersion().Version,
+					},
+				},
+				Metadata: provenance.BuildMetadata{
+					InvocationID: string(promo.UID),
+					StartedOn:    &startedAt,
+					FinishedOn:   &finishedAt,
+				},
+			},
+		},
+	)
+}
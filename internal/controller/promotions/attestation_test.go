@@ -0,0 +1,209 @@
+package promotions
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/provenance"
+)
+
+func TestAttest(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := provenance.NewSigner(key)
+	require.NoError(t, err)
+
+	startedAt := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(time.Minute)
+	testPromo := kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+			UID:       "fake-uid",
+			Labels: map[string]string{
+				kargoapi.LabelAutoPromotionKey: kargoapi.LabelTrueValue,
+			},
+			Annotations: map[string]string{
+				kargoapi.AnnotationKeyPromoter: "fake-user",
+			},
+		},
+		Spec: &kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight",
+		},
+	}
+	testFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-freight",
+		},
+		ID: "fake-freight-id",
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "https://github.com/example/repo",
+				ID:      "fake-commit",
+			},
+		},
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "example/image",
+				Tag:     "v1.0.0",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		signer            *provenance.Signer
+		cfg               ReconcilerConfig
+		objects           []client.Object
+		pushAttestationFn func(
+			context.Context,
+			kargoapi.Promotion,
+			*provenance.Envelope,
+		) (string, error)
+		assertions func(*kargoapi.PromotionAttestation, error)
+	}{
+		{
+			name: "no signer",
+			assertions: func(attestation *kargoapi.PromotionAttestation, err error) {
+				require.NoError(t, err)
+				require.Nil(t, attestation)
+			},
+		},
+		{
+			name:   "Freight not found",
+			signer: signer,
+			assertions: func(_ *kargoapi.PromotionAttestation, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "could not find Freight")
+			},
+		},
+		{
+			name:    "success",
+			signer:  signer,
+			objects: []client.Object{testFreight},
+			assertions: func(attestation *kargoapi.PromotionAttestation, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, attestation)
+				require.Empty(t, attestation.Reference)
+
+				envelope := &provenance.Envelope{
+					PayloadType: attestation.PayloadType,
+					Payload:     attestation.Payload,
+					Signatures: []provenance.Signature{
+						{
+							KeyID: attestation.Signatures[0].KeyID,
+							Sig:   attestation.Signatures[0].Sig,
+						},
+					},
+				}
+				require.NoError(t, envelope.Verify(key.Public()))
+
+				statement := provenance.Statement{}
+				require.NoError(t, json.Unmarshal(attestation.Payload, &statement))
+				require.Equal(
+					t,
+					[]provenance.ResourceDescriptor{
+						{
+							Name:   "fake-freight",
+							Digest: map[string]string{"sha1": "fake-freight-id"},
+						},
+					},
+					statement.Subject,
+				)
+				buildDef := statement.Predicate.BuildDefinition
+				require.Equal(t, "fake-user", buildDef.ExternalParameters["promoter"])
+				require.Equal(t, "fake-stage", buildDef.ExternalParameters["stage"])
+				require.Equal(
+					t,
+					kargoapi.LabelTrueValue,
+					buildDef.InternalParameters[kargoapi.LabelAutoPromotionKey],
+				)
+				require.Equal(
+					t,
+					[]provenance.ResourceDescriptor{
+						{
+							URI:    "git+https://github.com/example/repo",
+							Digest: map[string]string{"gitCommit": "fake-commit"},
+						},
+						{
+							URI: "example/image:v1.0.0",
+						},
+					},
+					buildDef.ResolvedDependencies,
+				)
+				metadata := statement.Predicate.RunDetails.Metadata
+				require.Equal(t, "fake-uid", metadata.InvocationID)
+				require.True(t, startedAt.Equal(*metadata.StartedOn))
+				require.True(t, finishedAt.Equal(*metadata.FinishedOn))
+			},
+		},
+		{
+			name:   "error pushing attestation",
+			signer: signer,
+			cfg: ReconcilerConfig{
+				AttestationRepository: "example.com/attestations",
+			},
+			objects: []client.Object{testFreight},
+			pushAttestationFn: func(
+				context.Context,
+				kargoapi.Promotion,
+				*provenance.Envelope,
+			) (string, error) {
+				return "", errors.New("something went wrong")
+			},
+			assertions: func(attestation *kargoapi.PromotionAttestation, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, attestation)
+				require.Empty(t, attestation.Reference)
+			},
+		},
+		{
+			name:   "attestation pushed",
+			signer: signer,
+			cfg: ReconcilerConfig{
+				AttestationRepository: "example.com/attestations",
+			},
+			objects: []client.Object{testFreight},
+			pushAttestationFn: func(
+				context.Context,
+				kargoapi.Promotion,
+				*provenance.Envelope,
+			) (string, error) {
+				return "example.com/attestations@sha256:fake-digest", nil
+			},
+			assertions: func(attestation *kargoapi.PromotionAttestation, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, attestation)
+				require.Equal(
+					t,
+					"example.com/attestations@sha256:fake-digest",
+					attestation.Reference,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newFakeReconciler(t, testCase.objects...)
+			r.cfg = testCase.cfg
+			r.signer = testCase.signer
+			r.pushAttestationFn = testCase.pushAttestationFn
+			testCase.assertions(
+				r.attest(context.Background(), testPromo, startedAt, finishedAt),
+			)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package promotions
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// promotionsInFlight tracks the number of Promotions that are currently being
+// executed by this controller.
+var promotionsInFlight = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "kargo_promotions_in_flight",
+		Help: "Number of Promotions currently being executed",
+	},
+)
+
+// promotionFailures counts the Promotions that have failed, broken down by
+// the reason for the failure and by whether that reason is a transient one.
+var promotionFailures = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "kargo_promotion_failures_total",
+		Help: "Number of Promotions that have failed",
+	},
+	[]string{"reason", "transient"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(promotionsInFlight, promotionFailures)
+}
@@ -1,13 +1,22 @@
 package promotions
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"path"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/kelseyhightower/envconfig"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -15,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/artifacts"
 	"github.com/akuity/kargo/internal/controller"
 	"github.com/akuity/kargo/internal/controller/promotion"
 	"github.com/akuity/kargo/internal/controller/runtime"
@@ -22,19 +32,71 @@ import (
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/provenance"
 )
 
+// ReconcilerConfig represents configuration for the Promotion reconciler.
+type ReconcilerConfig struct {
+	// AttestationSigningKeyPath is the path to a PEM-encoded private key with
+	// which to sign provenance attestations for successful Promotions. If
+	// unspecified, no attestations are produced.
+	AttestationSigningKeyPath string `envconfig:"PROMOTION_ATTESTATION_SIGNING_KEY_PATH"`
+	// AttestationRepository is an OCI repository to which signed attestations
+	// should also be pushed. If unspecified, attestations are only recorded in
+	// the status of the Promotions they attest to.
+	AttestationRepository string `envconfig:"PROMOTION_ATTESTATION_REPOSITORY"`
+	// ArgoCDOperationWaitTimeout is the maximum length of time to wait for an
+	// operation already in progress on an Argo CD Application to complete before
+	// syncing it as part of a Promotion. A value of zero fails such Promotions
+	// immediately.
+	ArgoCDOperationWaitTimeout time.Duration `envconfig:"PROMOTION_ARGOCD_OPERATION_WAIT_TIMEOUT" default:"1m"`
+}
+
+// ReconcilerConfigFromEnv returns a ReconcilerConfig populated from
+// environment variables.
+func ReconcilerConfigFromEnv() ReconcilerConfig {
+	cfg := ReconcilerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
 // reconciler reconciles Promotion resources.
 type reconciler struct {
+	cfg             ReconcilerConfig
 	kargoClient     client.Client
+	credentialsDB   credentials.Database
 	promoMechanisms promotion.Mechanism
+	signer          *provenance.Signer
+	artifactStore   artifacts.Store
+	recorder        record.EventRecorder
 
 	pqs            *promoQueues
 	initializeOnce sync.Once
 
 	// The following behaviors are overridable for testing purposes:
 
+	isProjectArchivedFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (bool, error)
+
 	promoteFn func(context.Context, kargoapi.Promotion) error
+
+	nowFn func() time.Time
+
+	attestFn func(
+		ctx context.Context,
+		promo kargoapi.Promotion,
+		startedAt time.Time,
+		finishedAt time.Time,
+	) (*kargoapi.PromotionAttestation, error)
+
+	pushAttestationFn func(
+		ctx context.Context,
+		promo kargoapi.Promotion,
+		envelope *provenance.Envelope,
+	) (string, error)
 }
 
 // SetupReconcilerWithManager initializes a reconciler for Promotion resources
@@ -44,7 +106,10 @@ func SetupReconcilerWithManager(
 	kargoMgr manager.Manager,
 	argoMgr manager.Manager,
 	credentialsDB credentials.Database,
+	artifactStore artifacts.Store,
 	shardName string,
+	cfg ReconcilerConfig,
+	clock runtime.Clock,
 ) error {
 
 	shardPredicate, err := controller.GetShardPredicate(shardName)
@@ -52,10 +117,23 @@ func SetupReconcilerWithManager(
 		return errors.Wrap(err, "error creating shard selector predicate")
 	}
 
+	var signer *provenance.Signer
+	if cfg.AttestationSigningKeyPath != "" {
+		if signer, err =
+			provenance.LoadSigner(cfg.AttestationSigningKeyPath); err != nil {
+			return errors.Wrap(err, "error loading attestation signing key")
+		}
+	}
+
 	reconciler := newReconciler(
 		kargoMgr.GetClient(),
 		argoMgr.GetClient(),
 		credentialsDB,
+		artifactStore,
+		cfg,
+		signer,
+		kargoMgr.GetEventRecorderFor("promotion-controller"),
+		clock,
 	)
 
 	changePredicate := predicate.Or(
@@ -67,7 +145,7 @@ func SetupReconcilerWithManager(
 		For(&kargoapi.Promotion{}).
 		WithEventFilter(changePredicate).
 		WithEventFilter(shardPredicate).
-		WithOptions(controller.CommonOptions()).
+		WithOptions(controller.CommonOptions("promotion")).
 		Build(reconciler)
 	if err != nil {
 		return errors.Wrap(err, "error building Promotion reconciler")
@@ -86,6 +164,16 @@ func SetupReconcilerWithManager(
 		return errors.Wrap(err, "unable to watch Promotions")
 	}
 
+	// Watch Projects that were unarchived and enqueue their Promotions
+	if err := controller.WatchProjectUnarchival(
+		c,
+		reconciler.kargoClient,
+		func() client.ObjectList { return &kargoapi.PromotionList{} },
+		shardPredicate,
+	); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -93,20 +181,35 @@ func newReconciler(
 	kargoClient client.Client,
 	argoClient client.Client,
 	credentialsDB credentials.Database,
+	artifactStore artifacts.Store,
+	cfg ReconcilerConfig,
+	signer *provenance.Signer,
+	recorder record.EventRecorder,
+	clock runtime.Clock,
 ) *reconciler {
 	pqs := promoQueues{
 		activePromoByStage:        map[types.NamespacedName]string{},
 		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
 	}
 	r := &reconciler{
-		kargoClient: kargoClient,
-		pqs:         &pqs,
+		cfg:           cfg,
+		kargoClient:   kargoClient,
+		credentialsDB: credentialsDB,
+		pqs:           &pqs,
 		promoMechanisms: promotion.NewMechanisms(
 			argoClient,
 			credentialsDB,
+			cfg.ArgoCDOperationWaitTimeout,
 		),
+		signer:        signer,
+		artifactStore: artifactStore,
+		recorder:      recorder,
 	}
+	r.isProjectArchivedFn = kargoapi.IsProjectArchived
 	r.promoteFn = r.promote
+	r.nowFn = clock.Now
+	r.attestFn = r.attest
+	r.pushAttestationFn = r.pushAttestation
 	return r
 }
 
@@ -162,6 +265,15 @@ func (r *reconciler) Reconcile(
 		return result, nil
 	}
 
+	// Resources of archived Projects are not reconciled. They are enqueued
+	// again when the Project is unarchived.
+	if archived, err := r.isProjectArchivedFn(ctx, r.kargoClient, req.Namespace); err != nil {
+		return result, err
+	} else if archived {
+		logger.Debug("Project is archived; skipping reconciliation")
+		return result, nil
+	}
+
 	if promo.Status.Phase == kargoapi.PromotionPhaseRunning {
 		// anything we've already marked Running, we allow it to continue to reconcile
 	} else if promo.Status.Phase.IsTerminal() {
@@ -190,27 +302,60 @@ func (r *reconciler) Reconcile(
 	// Update promo status as Running to give visibility in UI. Also, a promo which
 	// has already entered Running status will be allowed to continue to reconcile.
 	if promo.Status.Phase != kargoapi.PromotionPhaseRunning {
+		// Record what the promo is about to change before changing anything. If
+		// the Stage cannot be found, promoteFn() will report as much.
+		stage, err := kargoapi.GetStage(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      promo.Spec.Stage,
+			},
+		)
+		if err != nil {
+			return result, err
+		}
 		if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 			status.Phase = kargoapi.PromotionPhaseRunning
+			if stage != nil {
+				status.Impact = stage.PromotionImpact()
+			}
 		}); err != nil {
 			return result, err
 		}
 	}
 
-	promoCtx := logging.ContextWithLogger(ctx, logger)
+	// When there is somewhere to store it, the Promotion's log is captured
+	var promoLog *bytes.Buffer
+	promoLogger := logger
+	if r.artifactStore != nil {
+		promoLog = &bytes.Buffer{}
+		promoLogger = logging.Tee(logger, promoLog)
+	}
+
+	var pushAttempts []kargoapi.GitPushAttempt
+	promoCtx := promotion.ContextWithPushAttempts(
+		logging.ContextWithLogger(ctx, promoLogger),
+		&pushAttempts,
+	)
 
 	phase := kargoapi.PromotionPhaseSucceeded
 	phaseError := ""
+	var failureReason kargoapi.PromotionFailureReason
+	startedAt := r.nowFn()
 
 	// Wrap the promoteFn() call in an anonymous function to recover() any panics, so
 	// we can update the promo's phase with Error if it does. This breaks an infinite
 	// cycle of a bad promo continuously failing to reconcile, and surfaces the error.
 	func() {
+		promotionsInFlight.Inc()
+		defer promotionsInFlight.Dec()
 		defer func() {
 			if err := recover(); err != nil {
 				logger.Errorf("Promotion panic: %v", err)
 				phase = kargoapi.PromotionPhaseErrored
 				phaseError = fmt.Sprintf("%v", err)
+				failureReason = kargoapi.PromotionFailureReasonUnknown
 			}
 		}()
 		if err = r.promoteFn(
@@ -219,6 +364,7 @@ func (r *reconciler) Reconcile(
 		); err != nil {
 			phase = kargoapi.PromotionPhaseErrored
 			phaseError = err.Error()
+			failureReason = promotion.FailureReason(err)
 			logger.Errorf("error executing Promotion: %s", err)
 		}
 	}()
@@ -227,18 +373,113 @@ func (r *reconciler) Reconcile(
 		logger.Debugf("promotion %s", phase)
 	}
 
+	if failureReason != "" {
+		promotionFailures.WithLabelValues(
+			string(failureReason),
+			strconv.FormatBool(failureReason.IsTransient()),
+		).Inc()
+	}
+
+	var attestation *kargoapi.PromotionAttestation
+	if phase == kargoapi.PromotionPhaseSucceeded {
+		// The Promotion itself has succeeded, so failing to attest to it is not
+		// grounds for marking it as Errored
+		if attestation, err =
+			r.attestFn(promoCtx, *promo, startedAt, r.nowFn()); err != nil {
+			logger.Errorf("error attesting to Promotion: %s", err)
+		}
+	}
+
+	// Failing to store the Promotion's log is not grounds for marking it as
+	// Errored either
+	logURL := promo.Status.LogURL
+	if promoLog != nil && promoLog.Len() > 0 {
+		if url, err := r.storeLog(ctx, promo, promoLog.Bytes()); err != nil {
+			logger.Errorf("error storing Promotion log: %s", err)
+		} else {
+			logURL = url
+		}
+	}
+
 	err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 		status.Phase = phase
 		status.Error = phaseError
+		status.FailureReason = failureReason
+		status.PushAttempts = pushAttempts
+		status.Attestation = attestation
+		status.LogURL = logURL
 	})
 	if err != nil {
 		logger.Errorf("error updating Promotion status: %s", err)
 	}
 
+	if phase == kargoapi.PromotionPhaseErrored {
+		r.recordFailure(ctx, promo, phaseError)
+	}
+
 	// Controller runtime automatically gives us a progressive backoff if err is not nil
 	return result, err
 }
 
+// recordFailure records a Warning Event about the failure of the provided
+// Promotion. The Event is annotated with the owners of the Promotion's Stage so
+// that notification integrations can route it to them.
+func (r *reconciler) recordFailure(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	phaseError string,
+) {
+	stage, err := kargoapi.GetStage(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
+	if err != nil {
+		logging.LoggerFromContext(ctx).
+			Errorf("error getting Stage of failed Promotion: %s", err)
+	}
+	var annotations map[string]string
+	if stage != nil {
+		annotations = stage.OwnerAnnotations()
+	}
+	r.recorder.AnnotatedEventf(
+		promo,
+		annotations,
+		corev1.EventTypeWarning,
+		"PromotionFailed",
+		"Promotion of Stage %q to Freight %q failed: %s",
+		promo.Spec.Stage,
+		promo.Spec.Freight,
+		phaseError,
+	)
+}
+
+// storeLog stores the provided log of the provided Promotion's execution in
+// the artifact store and returns its URL. A Promotion that is executed more
+// than once, e.g. because the controller restarted while executing it, has its
+// log replaced by that of its latest execution.
+func (r *reconciler) storeLog(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	promoLog []byte,
+) (string, error) {
+	return r.artifactStore.Put(ctx, artifacts.Artifact{
+		Namespace: promo.Namespace,
+		Path:      path.Join("promotions", promo.Name, "log.txt"),
+		Owner: &metav1.OwnerReference{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Promotion",
+			Name:       promo.Name,
+			UID:        promo.UID,
+		},
+		ContentType: "text/plain; charset=utf-8",
+		Data:        promoLog,
+	})
+}
+
 func (r *reconciler) promote(
 	ctx context.Context,
 	promo kargoapi.Promotion,
@@ -273,33 +514,67 @@ func (r *reconciler) promote(
 	}
 	logger.Debug("found associated Stage")
 
-	if stage.Status.CurrentFreight != nil && stage.Status.CurrentFreight.ID == freightName {
+	// Freight from another Project is recorded as such in the Stage's status
+	var freightProject string
+	if freightNamespace := promo.FreightNamespace(); freightNamespace != stageNamespace {
+		freightProject = freightNamespace
+	}
+
+	// Self-healing Promotions intentionally re-apply a Stage's current Freight
+	if stage.Status.CurrentFreight != nil &&
+		stage.Status.CurrentFreight.ID == freightName &&
+		stage.Status.CurrentFreight.Project == freightProject &&
+		promo.Labels[kargoapi.LabelSelfHealKey] != kargoapi.LabelTrueValue {
 		logger.Debug("Stage already has the desired Freight")
 		return nil
 	}
 
-	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
-	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
-		upstreamStages[i] = upstreamStage.Name
-	}
-	targetFreight, err := kargoapi.GetQualifiedFreight(
-		ctx,
-		r.kargoClient,
-		types.NamespacedName{
-			Namespace: promo.Namespace,
-			Name:      promo.Spec.Freight,
-		},
-		upstreamStages,
-	)
-	if err != nil {
-		return err
-	}
-	if targetFreight == nil {
-		return errors.Errorf(
-			"no qualified Freight %q found in namespace %q",
-			promo.Spec.Freight,
-			promo.Namespace,
-		)
+	var targetFreight *kargoapi.Freight
+	if freightProject != "" {
+		// Freight from another Project is never approved for, or qualified in,
+		// any Stage of this Project. Whether the other Project shares its Freight
+		// with this one was already validated when the Promotion was created.
+		if targetFreight, err = kargoapi.GetFreight(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: freightProject,
+				Name:      freightName,
+			},
+		); err != nil {
+			return err
+		}
+		if targetFreight == nil {
+			return errors.Errorf(
+				"could not find Freight %q in namespace %q",
+				freightName,
+				freightProject,
+			)
+		}
+	} else {
+		upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
+		for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
+			upstreamStages[i] = upstreamStage.Name
+		}
+		if targetFreight, err = kargoapi.GetPromotableFreight(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      promo.Spec.Freight,
+			},
+			stage.Name,
+			upstreamStages,
+		); err != nil {
+			return err
+		}
+		if targetFreight == nil {
+			return errors.Errorf(
+				"no qualified Freight %q found in namespace %q",
+				promo.Spec.Freight,
+				promo.Namespace,
+			)
+		}
 	}
 
 	simpleTargetFreight := kargoapi.SimpleFreight{
@@ -307,6 +582,7 @@ func (r *reconciler) promote(
 		Commits: targetFreight.Commits,
 		Images:  targetFreight.Images,
 		Charts:  targetFreight.Charts,
+		Project: freightProject,
 	}
 
 	err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
@@ -324,6 +600,10 @@ func (r *reconciler) promote(
 		return err
 	}
 
+	// Freight that this Promotion coalesced is recorded in the history, beneath
+	// the Freight that was actually applied, so it isn't lost track of
+	superseded := r.getSupersededFreight(ctx, promo)
+
 	// The assumption is that controller does not process multiple promotions in one stage
 	// so we are safe from race conditions and can just update the status
 	err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
@@ -334,7 +614,16 @@ func (r *reconciler) promote(
 		// control-flow stages in the first place)
 		if stage.Spec.PromotionMechanisms != nil {
 			status.CurrentFreight = &nextFreight
-			status.History.Push(nextFreight)
+			// The Stage's health, including how long it has been Healthy, must be
+			// re-assessed now that the Freight has been (re-)applied
+			status.Health = nil
+			// Don't record re-application of the current Freight (i.e. by a
+			// self-healing Promotion) as a new entry in the history
+			if top, ok := status.History.Top(); !ok || top.ID != nextFreight.ID {
+				status.History.Push(
+					append([]kargoapi.SimpleFreight{nextFreight}, superseded...)...,
+				)
+			}
 		}
 	})
 
@@ -345,3 +634,44 @@ func (r *reconciler) promote(
 		stageNamespace,
 	)
 }
+
+// getSupersededFreight returns the Freight recorded by the provided
+// Promotion's superseded Freight annotation, ordered from newest to oldest and
+// marked as superseded. Freight that can no longer be found is omitted.
+func (r *reconciler) getSupersededFreight(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+) []kargoapi.SimpleFreight {
+	ids := promo.Annotations[kargoapi.AnnotationKeySupersededFreight]
+	if ids == "" {
+		return nil
+	}
+	logger := logging.LoggerFromContext(ctx)
+	var superseded []kargoapi.SimpleFreight
+	for _, id := range strings.Split(ids, ",") {
+		freight, err := kargoapi.GetFreight(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      id,
+			},
+		)
+		if err != nil {
+			logger.Errorf("error finding superseded Freight %q: %s", id, err)
+			continue
+		}
+		if freight == nil {
+			logger.Debugf("superseded Freight %q no longer exists", id)
+			continue
+		}
+		superseded = append(superseded, kargoapi.SimpleFreight{
+			ID:         freight.ID,
+			Commits:    freight.Commits,
+			Images:     freight.Images,
+			Charts:     freight.Charts,
+			Superseded: true,
+		})
+	}
+	return superseded
+}
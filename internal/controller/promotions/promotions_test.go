@@ -3,18 +3,26 @@ package promotions
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/akuity/kargo/api/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/artifacts"
+	"github.com/akuity/kargo/internal/controller/runtime"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/logging"
 )
 
 func TestNewPromotionReconciler(t *testing.T) {
@@ -23,14 +31,26 @@ func TestNewPromotionReconciler(t *testing.T) {
 		kubeClient,
 		kubeClient,
 		&credentials.FakeDB{},
+		nil,
+		ReconcilerConfig{},
+		nil,
+		record.NewFakeRecorder(10),
+		runtime.RealClock,
 	)
 	require.NotNil(t, r.kargoClient)
+	require.NotNil(t, r.credentialsDB)
 	require.NotNil(t, r.pqs.pendingPromoQueuesByStage)
+	require.NotNil(t, r.isProjectArchivedFn)
 	require.NotNil(t, r.promoteFn)
+	require.NotNil(t, r.nowFn)
+	require.NotNil(t, r.attestFn)
+	require.NotNil(t, r.pushAttestationFn)
+	require.NotNil(t, r.recorder)
 }
 
 func newFakeReconciler(t *testing.T, objects ...client.Object) *reconciler {
 	scheme := k8sruntime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
 	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
 	kargoClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
 	kubeClient := fake.NewClientBuilder().Build()
@@ -38,17 +58,33 @@ func newFakeReconciler(t *testing.T, objects ...client.Object) *reconciler {
 		kargoClient,
 		kubeClient,
 		&credentials.FakeDB{},
+		nil,
+		ReconcilerConfig{},
+		nil,
+		record.NewFakeRecorder(10),
+		runtime.RealClock,
 	)
 }
 
 func TestReconcile(t *testing.T) {
 	testCases := []struct {
-		name                  string
-		promos                []client.Object
-		promoteFn             func(context.Context, v1alpha1.Promotion) error
+		name      string
+		promos    []client.Object
+		promoteFn func(context.Context, v1alpha1.Promotion) error
+		attestFn  func(
+			context.Context,
+			kargoapi.Promotion,
+			time.Time,
+			time.Time,
+		) (*kargoapi.PromotionAttestation, error)
+		artifactStore         artifacts.Store
 		promoToReconcile      *types.NamespacedName // if nil, uses the first of the promos
 		expectPromoteFnCalled bool
 		expectedPhase         kargoapi.PromotionPhase
+		expectedFailureReason kargoapi.PromotionFailureReason
+		expectAttestation     bool
+		expectedImpact        *kargoapi.PromotionImpact
+		expectedLogURL        string
 	}{
 		{
 			name:                  "normal reconcile",
@@ -58,6 +94,30 @@ func TestReconcile(t *testing.T) {
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
 			},
 		},
+		{
+			name:                  "impact recorded",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			promos: []client.Object{
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-stage",
+					},
+					Spec: &kargoapi.StageSpec{
+						PromotionMechanisms: &kargoapi.PromotionMechanisms{
+							GitRepoUpdates: []kargoapi.GitRepoUpdate{
+								{RepoURL: "https://github.com/example/repo"},
+							},
+						},
+					},
+				},
+			},
+			expectedImpact: &kargoapi.PromotionImpact{
+				Repos: []string{"https://github.com/example/repo"},
+			},
+		},
 		{
 			name:                  "promo doesn't exist",
 			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
@@ -103,6 +163,7 @@ func TestReconcile(t *testing.T) {
 			name:                  "promoteFn panics",
 			expectPromoteFnCalled: true,
 			expectedPhase:         kargoapi.PromotionPhaseErrored,
+			expectedFailureReason: kargoapi.PromotionFailureReasonUnknown,
 			promos: []client.Object{
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
 			},
@@ -114,6 +175,7 @@ func TestReconcile(t *testing.T) {
 			name:                  "promoteFn errors",
 			expectPromoteFnCalled: true,
 			expectedPhase:         kargoapi.PromotionPhaseErrored,
+			expectedFailureReason: kargoapi.PromotionFailureReasonUnknown,
 			promos: []client.Object{
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
 			},
@@ -121,6 +183,81 @@ func TestReconcile(t *testing.T) {
 				return errors.New("expected error")
 			},
 		},
+		{
+			name:                  "promo attested",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			promos: []client.Object{
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
+			},
+			attestFn: func(
+				context.Context,
+				kargoapi.Promotion,
+				time.Time,
+				time.Time,
+			) (*kargoapi.PromotionAttestation, error) {
+				return &kargoapi.PromotionAttestation{
+					PayloadType: "fake-payload-type",
+				}, nil
+			},
+			expectAttestation: true,
+		},
+		{
+			name:                  "attestFn errors",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			promos: []client.Object{
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
+			},
+			attestFn: func(
+				context.Context,
+				kargoapi.Promotion,
+				time.Time,
+				time.Time,
+			) (*kargoapi.PromotionAttestation, error) {
+				return nil, errors.New("expected error")
+			},
+		},
+		{
+			name:                  "log stored",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			promos: []client.Object{
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
+			},
+			promoteFn: func(ctx context.Context, _ v1alpha1.Promotion) error {
+				logging.LoggerFromContext(ctx).Info("fake log line")
+				return nil
+			},
+			artifactStore: &artifacts.FakeStore{
+				PutFn: func(_ context.Context, a artifacts.Artifact) (string, error) {
+					if a.Namespace != "fake-namespace" ||
+						a.Path != "promotions/fake-promo/log.txt" ||
+						!strings.Contains(string(a.Data), "fake log line") {
+						return "", errors.New("unexpected artifact")
+					}
+					return "fake-url", nil
+				},
+			},
+			expectedLogURL: "fake-url",
+		},
+		{
+			name:                  "error storing log",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			promos: []client.Object{
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
+			},
+			promoteFn: func(ctx context.Context, _ v1alpha1.Promotion) error {
+				logging.LoggerFromContext(ctx).Info("fake log line")
+				return nil
+			},
+			artifactStore: &artifacts.FakeStore{
+				PutFn: func(context.Context, artifacts.Artifact) (string, error) {
+					return "", errors.New("expected error")
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -138,6 +275,10 @@ func TestReconcile(t *testing.T) {
 				}
 				return nil
 			}
+			if tc.attestFn != nil {
+				r.attestFn = tc.attestFn
+			}
+			r.artifactStore = tc.artifactStore
 			var req ctrl.Request
 			if tc.promoToReconcile != nil {
 				req = ctrl.Request{NamespacedName: *tc.promoToReconcile}
@@ -158,7 +299,106 @@ func TestReconcile(t *testing.T) {
 				err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
 				require.NoError(t, err)
 				require.Equal(t, tc.expectedPhase, updatedPromo.Status.Phase)
+				require.Equal(t, tc.expectedFailureReason, updatedPromo.Status.FailureReason)
+				require.Equal(t, tc.expectAttestation, updatedPromo.Status.Attestation != nil)
+				require.Equal(t, tc.expectedImpact, updatedPromo.Status.Impact)
+				require.Equal(t, tc.expectedLogURL, updatedPromo.Status.LogURL)
+			}
+		})
+	}
+}
+
+// annotationRecorder is a record.EventRecorder that retains the annotations of
+// the last annotated Event recorded.
+type annotationRecorder struct {
+	*record.FakeRecorder
+	annotations map[string]string
+}
+
+func (a *annotationRecorder) AnnotatedEventf(
+	object k8sruntime.Object,
+	annotations map[string]string,
+	eventtype string,
+	reason string,
+	messageFmt string,
+	args ...any,
+) {
+	a.annotations = annotations
+	a.FakeRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+}
+
+func TestRecordFailure(t *testing.T) {
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		assertions func(event string, annotations map[string]string)
+	}{
+		{
+			name: "Stage not found",
+			assertions: func(event string, annotations map[string]string) {
+				require.Equal(
+					t,
+					`Warning PromotionFailed Promotion of Stage "fake-stage" to `+
+						`Freight "fake-freight" failed: something went wrong`,
+					event,
+				)
+				require.Nil(t, annotations)
+			},
+		},
+		{
+			name: "Stage has owners",
+			objects: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-stage",
+					},
+					Spec: &kargoapi.StageSpec{
+						Owners: []kargoapi.StageOwner{
+							{
+								Kind:  kargoapi.StageOwnerKindUser,
+								Name:  "alice",
+								Email: "alice@example.com",
+							},
+							{
+								Kind: kargoapi.StageOwnerKindTeam,
+								Name: "platform-team",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(event string, annotations map[string]string) {
+				require.True(t, strings.HasPrefix(event, "Warning PromotionFailed "))
+				require.Equal(
+					t,
+					map[string]string{
+						kargoapi.AnnotationKeyOwnerUsers:  "alice",
+						kargoapi.AnnotationKeyOwnerTeams:  "platform-team",
+						kargoapi.AnnotationKeyOwnerEmails: "alice@example.com",
+					},
+					annotations,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newFakeReconciler(t, testCase.objects...)
+			recorder := &annotationRecorder{FakeRecorder: record.NewFakeRecorder(1)}
+			r.recorder = recorder
+			promo := &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promo",
+				},
+				Spec: &kargoapi.PromotionSpec{
+					Stage:   "fake-stage",
+					Freight: "fake-freight",
+				},
 			}
+			r.recordFailure(context.Background(), promo, "something went wrong")
+			testCase.assertions(<-recorder.Events, recorder.annotations)
 		})
 	}
 }
@@ -182,3 +422,79 @@ func TestReconcileInitializeQueues(t *testing.T) {
 	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
 	require.Equal(t, 2, r.pqs.pendingPromoQueuesByStage[stageKey].Depth())
 }
+
+func TestGetSupersededFreight(t *testing.T) {
+	freight := []client.Object{
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-freight-2",
+			},
+			ID: "fake-freight-2",
+			Images: []kargoapi.Image{
+				{RepoURL: "fake-repo", Tag: "v2"},
+			},
+		},
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-freight-1",
+			},
+			ID: "fake-freight-1",
+		},
+	}
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		assertions  func([]kargoapi.SimpleFreight)
+	}{
+		{
+			name: "no superseded Freight",
+			assertions: func(superseded []kargoapi.SimpleFreight) {
+				require.Empty(t, superseded)
+			},
+		},
+		{
+			name: "superseded Freight",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeySupersededFreight: "fake-freight-2,missing-freight,fake-freight-1",
+			},
+			assertions: func(superseded []kargoapi.SimpleFreight) {
+				require.Equal(
+					t,
+					[]kargoapi.SimpleFreight{
+						{
+							ID: "fake-freight-2",
+							Images: []kargoapi.Image{
+								{RepoURL: "fake-repo", Tag: "v2"},
+							},
+							Superseded: true,
+						},
+						{
+							ID:         "fake-freight-1",
+							Superseded: true,
+						},
+					},
+					superseded,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newFakeReconciler(t, freight...)
+			testCase.assertions(
+				r.getSupersededFreight(
+					context.Background(),
+					kargoapi.Promotion{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:   "fake-namespace",
+							Name:        "fake-promo",
+							Annotations: testCase.annotations,
+						},
+					},
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,521 @@
+package releases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller"
+	"github.com/akuity/kargo/internal/controller/runtime"
+	"github.com/akuity/kargo/internal/kargo"
+	"github.com/akuity/kargo/internal/kubeclient"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// soakCheckInterval is how often the health of a Stage is re-checked while a
+// Release step is in its soak period.
+const soakCheckInterval = 30 * time.Second
+
+// reconciler reconciles Release resources.
+type reconciler struct {
+	kargoClient client.Client
+
+	// The following behaviors are overridable for testing purposes:
+
+	isProjectArchivedFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (bool, error)
+
+	nowFn func() time.Time
+
+	requeueAfterFn func(time.Duration) time.Duration
+
+	getStageFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error)
+
+	getPromotionFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Promotion, error)
+
+	getQualifiedFreightFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+		[]string,
+	) (*kargoapi.Freight, error)
+
+	listPromosFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
+	createPromotionFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+}
+
+// SetupReconcilerWithManager initializes a reconciler for Release resources and
+// registers it with the provided Manager.
+func SetupReconcilerWithManager(
+	ctx context.Context,
+	kargoMgr manager.Manager,
+	shardName string,
+	clock runtime.Clock,
+) error {
+	shardPredicate, err := controller.GetShardPredicate(shardName)
+	if err != nil {
+		return errors.Wrap(err, "error creating shard predicate")
+	}
+
+	c, err := ctrl.NewControllerManagedBy(kargoMgr).
+		For(&kargoapi.Release{}).
+		WithEventFilter(
+			predicate.Or(
+				predicate.GenerationChangedPredicate{},
+				predicate.AnnotationChangedPredicate{},
+			),
+		).
+		WithEventFilter(shardPredicate).
+		WithOptions(controller.CommonOptions("release")).
+		Build(newReconciler(kargoMgr.GetClient(), clock))
+	if err != nil {
+		return errors.Wrap(err, "error building Release reconciler")
+	}
+
+	// Watch Promotions that were created by a Release and completed and
+	// enqueue the Release
+	logger := logging.LoggerFromContext(ctx)
+	promoWentTerminal := kargo.NewPromoWentTerminalPredicate(logger)
+	if err := c.Watch(
+		&source.Kind{Type: &kargoapi.Promotion{}},
+		handler.EnqueueRequestsFromMapFunc(releaseForPromotion),
+		promoWentTerminal,
+	); err != nil {
+		return errors.Wrap(err, "unable to watch Promotions")
+	}
+
+	// Watch Projects that were unarchived and enqueue their Releases
+	if err := controller.WatchProjectUnarchival(
+		c,
+		kargoMgr.GetClient(),
+		func() client.ObjectList { return &kargoapi.ReleaseList{} },
+		shardPredicate,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// releaseForPromotion maps a Promotion to the Release, if any, that created it.
+func releaseForPromotion(obj client.Object) []reconcile.Request {
+	releaseName := obj.GetLabels()[kargoapi.LabelReleaseKey]
+	if releaseName == "" {
+		return nil
+	}
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Namespace: obj.GetNamespace(),
+				Name:      releaseName,
+			},
+		},
+	}
+}
+
+func newReconciler(kargoClient client.Client, clock runtime.Clock) *reconciler {
+	r := &reconciler{
+		kargoClient: kargoClient,
+	}
+	r.isProjectArchivedFn = kargoapi.IsProjectArchived
+	r.nowFn = clock.Now
+	r.requeueAfterFn = clock.RequeueAfter
+	r.getStageFn = kargoapi.GetStage
+	r.getPromotionFn = kargoapi.GetPromotion
+	r.getQualifiedFreightFn = kargoapi.GetQualifiedFreight
+	r.listPromosFn = kargoClient.List
+	r.createPromotionFn = kargoClient.Create
+	return r
+}
+
+// Reconcile is part of the main Kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *reconciler) Reconcile(
+	ctx context.Context,
+	req ctrl.Request,
+) (ctrl.Result, error) {
+	result := ctrl.Result{}
+
+	logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"namespace": req.NamespacedName.Namespace,
+		"release":   req.NamespacedName.Name,
+	})
+	ctx = logging.ContextWithLogger(ctx, logger)
+	logger.Debug("reconciling Release")
+
+	// Find the Release
+	release, err := kargoapi.GetRelease(ctx, r.kargoClient, req.NamespacedName)
+	if err != nil {
+		return result, err
+	}
+	if release == nil {
+		// Ignore if not found. This can happen if the Release was deleted after
+		// the current reconciliation request was issued.
+		return result, nil
+	}
+	if release.Status.Phase.IsTerminal() {
+		logger.Debug("Release is already complete")
+		return result, nil
+	}
+
+	// Resources of archived Projects are not reconciled. They are enqueued
+	// again when the Project is unarchived.
+	if archived, err := r.isProjectArchivedFn(ctx, r.kargoClient, req.Namespace); err != nil {
+		return ctrl.Result{}, err
+	} else if archived {
+		logger.Debug("Project is archived; skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
+
+	newStatus, requeueAfter, err := r.syncRelease(ctx, release)
+	if err != nil {
+		newStatus.Error = err.Error()
+		logger.Errorf("error syncing Release: %s", err)
+	} else if newStatus.Phase != kargoapi.ReleasePhaseFailed {
+		// Be sure to blank this out in case there's an error in this field from
+		// the previous reconciliation
+		newStatus.Error = ""
+	}
+	// The clock need not keep real time
+	result.RequeueAfter = r.requeueAfterFn(requeueAfter)
+
+	updateErr := kubeclient.PatchStatus(
+		ctx,
+		r.kargoClient,
+		release,
+		func(status *kargoapi.ReleaseStatus) {
+			*status = newStatus
+		},
+	)
+	if updateErr != nil {
+		logger.Errorf("error updating Release status: %s", updateErr)
+	}
+
+	// If we had no error, but couldn't update, then we DO have an error. But we
+	// do it this way so that a failure to update is never counted as THE failure
+	// when something else more serious occurred first.
+	if err == nil {
+		err = updateErr
+	}
+	logger.Debug("done reconciling Release")
+
+	// Controller runtime automatically gives us a progressive backoff if err is
+	// not nil
+	return result, err
+}
+
+// syncRelease advances the provided Release as far as is currently possible
+// and returns its updated status. If the Release cannot advance until some
+// time in the future, the amount of time to wait is also returned.
+func (r *reconciler) syncRelease(
+	ctx context.Context,
+	release *kargoapi.Release,
+) (kargoapi.ReleaseStatus, time.Duration, error) {
+	status := *release.Status.DeepCopy()
+	logger := logging.LoggerFromContext(ctx)
+	now := r.nowFn()
+
+	if status.StartedAt == nil {
+		if release.Spec.StartTime != nil && now.Before(release.Spec.StartTime.Time) {
+			logger.Debug("Release start time has not yet arrived")
+			status.Phase = kargoapi.ReleasePhasePending
+			return status, release.Spec.StartTime.Sub(now), nil
+		}
+		status.StartedAt = &metav1.Time{Time: now}
+	}
+	status.Phase = kargoapi.ReleasePhaseRunning
+
+	prevCompletedAt := status.StartedAt.Time
+	for i, step := range release.Spec.Steps {
+		stepLogger := logger.WithField("stage", step.Stage)
+
+		if i < len(status.Steps) {
+			// This step was already started. Check on its progress.
+			stepStatus := &status.Steps[i]
+			if stepStatus.Phase != kargoapi.PromotionPhaseSucceeded {
+				promo, err := r.getPromotionFn(
+					ctx,
+					r.kargoClient,
+					types.NamespacedName{
+						Namespace: release.Namespace,
+						Name:      stepStatus.Promotion,
+					},
+				)
+				if err != nil {
+					return status, 0, err
+				}
+				if promo == nil {
+					status.Phase = kargoapi.ReleasePhaseFailed
+					status.Error = fmt.Sprintf(
+						"Promotion %q for Stage %q no longer exists",
+						stepStatus.Promotion,
+						step.Stage,
+					)
+					return status, 0, nil
+				}
+				stepStatus.Phase = promo.Status.Phase
+				if !promo.Status.Phase.IsTerminal() {
+					stepLogger.WithField("promotion", promo.Name).
+						Debug("waiting for Promotion to complete")
+					return status, 0, nil
+				}
+				stepStatus.CompletedAt = &metav1.Time{Time: now}
+				if promo.Status.Phase != kargoapi.PromotionPhaseSucceeded {
+					status.Phase = kargoapi.ReleasePhaseFailed
+					status.Error = fmt.Sprintf(
+						"Promotion %q for Stage %q did not succeed: %s",
+						promo.Name,
+						step.Stage,
+						promo.Status.Error,
+					)
+					return status, 0, nil
+				}
+			}
+			if step.Soak != nil && stepStatus.VerifiedAt == nil {
+				requeueAfter, failure, err :=
+					r.verifyStep(ctx, release, step, *stepStatus, now)
+				if err != nil {
+					return status, 0, err
+				}
+				if failure != "" {
+					status.Phase = kargoapi.ReleasePhaseFailed
+					status.Error = failure
+					return status, 0, nil
+				}
+				if requeueAfter > 0 {
+					stepLogger.Debug("waiting for Stage to complete its soak period")
+					return status, requeueAfter, nil
+				}
+				stepStatus.VerifiedAt = &metav1.Time{Time: now}
+			}
+			if stepStatus.VerifiedAt != nil {
+				prevCompletedAt = stepStatus.VerifiedAt.Time
+			} else if stepStatus.CompletedAt != nil {
+				prevCompletedAt = stepStatus.CompletedAt.Time
+			}
+			continue
+		}
+
+		// If we get to here, this step has not been started yet
+		if step.Delay != nil {
+			if startAt := prevCompletedAt.Add(step.Delay.Duration); now.Before(startAt) {
+				stepLogger.Debug("waiting for delay to elapse before starting step")
+				return status, startAt.Sub(now), nil
+			}
+		}
+		promo, err := r.getOrCreatePromotion(ctx, release, step.Stage)
+		if err != nil {
+			return status, 0, err
+		}
+		if promo == nil {
+			status.Phase = kargoapi.ReleasePhaseFailed
+			status.Error = fmt.Sprintf(
+				"could not find Stage %q in namespace %q",
+				step.Stage,
+				release.Namespace,
+			)
+			return status, 0, nil
+		}
+		stepLogger.WithField("promotion", promo.Name).Debug("started step")
+		status.Steps = append(
+			status.Steps,
+			kargoapi.ReleaseStepStatus{
+				Stage:     step.Stage,
+				Promotion: promo.Name,
+				Phase:     promo.Status.Phase,
+			},
+		)
+		return status, 0, nil
+	}
+
+	status.Phase = kargoapi.ReleasePhaseSucceeded
+	return status, 0, nil
+}
+
+// verifyStep checks on a step that is in its soak period. If the Stage has
+// done anything during that period that should halt the Release, a non-empty
+// description of the problem is returned. Otherwise, if the step cannot be
+// considered verified yet, the amount of time to wait before checking again is
+// returned. A zero duration and empty description together indicate the step
+// has been verified.
+func (r *reconciler) verifyStep(
+	ctx context.Context,
+	release *kargoapi.Release,
+	step kargoapi.ReleaseStep,
+	stepStatus kargoapi.ReleaseStepStatus,
+	now time.Time,
+) (time.Duration, string, error) {
+	stage, err := r.getStageFn(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: release.Namespace,
+			Name:      step.Stage,
+		},
+	)
+	if err != nil {
+		return 0, "", err
+	}
+	if stage == nil {
+		return 0, fmt.Sprintf(
+			"could not find Stage %q in namespace %q",
+			step.Stage,
+			release.Namespace,
+		), nil
+	}
+	if stage.Status.CurrentFreight == nil ||
+		stage.Status.CurrentFreight.ID != release.Spec.Freight {
+		return 0, fmt.Sprintf(
+			"Stage %q was promoted to different Freight during its soak period",
+			step.Stage,
+		), nil
+	}
+	health := stage.Status.Health
+	if health != nil && health.Status == kargoapi.HealthStateUnhealthy {
+		return 0, fmt.Sprintf(
+			"Stage %q became Unhealthy during its soak period: %s",
+			step.Stage,
+			strings.Join(health.Issues, "; "),
+		), nil
+	}
+
+	// The Stage's health isn't watched, so even while the remainder of the soak
+	// period is long, check back periodically.
+	soakEnd := now
+	if stepStatus.CompletedAt != nil {
+		soakEnd = stepStatus.CompletedAt.Add(step.Soak.Duration)
+	}
+	if now.Before(soakEnd) {
+		if remaining := soakEnd.Sub(now); remaining < soakCheckInterval {
+			return remaining, "", nil
+		}
+		return soakCheckInterval, "", nil
+	}
+	if health != nil && health.Status != kargoapi.HealthStateHealthy {
+		// The soak period is over, but we cannot proceed until the Stage is
+		// actually Healthy.
+		return soakCheckInterval, "", nil
+	}
+
+	// Finally, wait for the Freight to have qualified for the Stage. Besides
+	// being the Stage's own verdict on the Freight, this is what permits the
+	// Freight to be promoted into any Stages downstream from this one.
+	freight, err := r.getQualifiedFreightFn(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: release.Namespace,
+			Name:      release.Spec.Freight,
+		},
+		[]string{step.Stage},
+	)
+	if err != nil {
+		return 0, "", err
+	}
+	if freight == nil {
+		return soakCheckInterval, "", nil
+	}
+	return 0, "", nil
+}
+
+// getOrCreatePromotion returns the Promotion the provided Release created for
+// the specified Stage, creating it first if necessary. Looking for an existing
+// Promotion first guards against creating duplicates in the event that a
+// previous attempt to record the Promotion in the Release's status failed. If
+// the Stage does not exist, nil is returned.
+func (r *reconciler) getOrCreatePromotion(
+	ctx context.Context,
+	release *kargoapi.Release,
+	stageName string,
+) (*kargoapi.Promotion, error) {
+	promos := kargoapi.PromotionList{}
+	if err := r.listPromosFn(
+		ctx,
+		&promos,
+		&client.ListOptions{
+			Namespace: release.Namespace,
+			LabelSelector: labels.SelectorFromSet(
+				map[string]string{
+					kargoapi.LabelReleaseKey: release.Name,
+				},
+			),
+		},
+	); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error listing Promotions for Release %q in namespace %q",
+			release.Name,
+			release.Namespace,
+		)
+	}
+	for _, promo := range promos.Items {
+		if promo.Spec != nil && promo.Spec.Stage == stageName {
+			return promo.DeepCopy(), nil
+		}
+	}
+
+	stage, err := r.getStageFn(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: release.Namespace,
+			Name:      stageName,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if stage == nil {
+		return nil, nil
+	}
+
+	promo := kargo.NewPromotion(*stage, release.Spec.Freight)
+	if promo.Labels == nil {
+		promo.Labels = map[string]string{}
+	}
+	promo.Labels[kargoapi.LabelReleaseKey] = release.Name
+	if err = r.createPromotionFn(ctx, &promo, &client.CreateOptions{}); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error creating Promotion of Stage %q in namespace %q to Freight %q",
+			stageName,
+			release.Namespace,
+			release.Spec.Freight,
+		)
+	}
+	return &promo, nil
+}
@@ -0,0 +1,888 @@
+package releases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/runtime"
+)
+
+func TestNewReconciler(t *testing.T) {
+	kubeClient := fake.NewClientBuilder().Build()
+	r := newReconciler(kubeClient, runtime.RealClock)
+	require.NotNil(t, r.kargoClient)
+	// Assert that all overridable behaviors were initialized to a default:
+	require.NotNil(t, r.isProjectArchivedFn)
+	require.NotNil(t, r.nowFn)
+	require.NotNil(t, r.requeueAfterFn)
+	require.NotNil(t, r.getStageFn)
+	require.NotNil(t, r.getPromotionFn)
+	require.NotNil(t, r.listPromosFn)
+	require.NotNil(t, r.createPromotionFn)
+}
+
+func TestReconcile(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&kargoapi.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-release",
+			},
+			Spec: &kargoapi.ReleaseSpec{
+				Freight:   "fake-freight",
+				StartTime: &metav1.Time{Time: start.Add(time.Hour)},
+				Steps: []kargoapi.ReleaseStep{{
+					Stage: "fake-stage",
+				}},
+			},
+		},
+	).Build()
+	testCases := []struct {
+		name                 string
+		clock                runtime.Clock
+		expectedRequeueAfter time.Duration
+	}{
+		{
+			name:                 "clock stopped",
+			clock:                runtime.NewSimulatedClock(start, 0),
+			expectedRequeueAfter: time.Hour,
+		},
+		{
+			name:                 "clock fast-forwarded",
+			clock:                runtime.NewSimulatedClock(start, 60),
+			expectedRequeueAfter: time.Minute,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newReconciler(kubeClient, testCase.clock)
+			result, err := r.Reconcile(
+				context.Background(),
+				ctrl.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: "fake-namespace",
+						Name:      "fake-release",
+					},
+				},
+			)
+			require.NoError(t, err)
+			require.InDelta(
+				t,
+				float64(testCase.expectedRequeueAfter),
+				float64(result.RequeueAfter),
+				float64(time.Second),
+			)
+		})
+	}
+}
+
+func TestReconcileArchivedProject(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "fake-namespace",
+				Labels: map[string]string{
+					kargoapi.LabelProjectKey:  kargoapi.LabelTrueValue,
+					kargoapi.LabelArchivedKey: kargoapi.LabelTrueValue,
+				},
+			},
+		},
+		&kargoapi.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-release",
+			},
+			Spec: &kargoapi.ReleaseSpec{
+				Freight: "fake-freight",
+				Steps: []kargoapi.ReleaseStep{{
+					Stage: "fake-stage",
+				}},
+			},
+		},
+	).Build()
+	r := newReconciler(kubeClient, runtime.RealClock)
+	r.getStageFn = func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error) {
+		require.FailNow(t, "Release of an archived Project should not be synced")
+		return nil, nil
+	}
+	result, err := r.Reconcile(
+		context.Background(),
+		ctrl.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: "fake-namespace",
+				Name:      "fake-release",
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Zero(t, result.RequeueAfter)
+	release, err := kargoapi.GetRelease(
+		context.Background(),
+		kubeClient,
+		types.NamespacedName{Namespace: "fake-namespace", Name: "fake-release"},
+	)
+	require.NoError(t, err)
+	require.Empty(t, release.Status.Phase)
+}
+
+func TestReleaseForPromotion(t *testing.T) {
+	require.Empty(t, releaseForPromotion(&kargoapi.Promotion{}))
+	require.Equal(
+		t,
+		types.NamespacedName{
+			Namespace: "fake-namespace",
+			Name:      "fake-release",
+		},
+		releaseForPromotion(
+			&kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Labels: map[string]string{
+						kargoapi.LabelReleaseKey: "fake-release",
+					},
+				},
+			},
+		)[0].NamespacedName,
+	)
+}
+
+func TestSyncRelease(t *testing.T) {
+	testNow := time.Now()
+	noPromosFn := func(context.Context, client.ObjectList, ...client.ListOption) error {
+		return nil
+	}
+	testSpec := &kargoapi.ReleaseSpec{
+		Freight: "fake-freight",
+		Steps: []kargoapi.ReleaseStep{
+			{
+				Stage: "fake-stage",
+			},
+			{
+				Stage: "another-fake-stage",
+				Delay: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		release    *kargoapi.Release
+		reconciler *reconciler
+		assertions func(kargoapi.ReleaseStatus, time.Duration, error)
+	}{
+		{
+			name: "start time has not arrived",
+			release: &kargoapi.Release{
+				Spec: &kargoapi.ReleaseSpec{
+					Freight:   "fake-freight",
+					StartTime: &metav1.Time{Time: testNow.Add(time.Hour)},
+					Steps:     testSpec.Steps,
+				},
+			},
+			reconciler: &reconciler{},
+			assertions: func(
+				status kargoapi.ReleaseStatus,
+				requeueAfter time.Duration,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.ReleasePhasePending, status.Phase)
+				require.Nil(t, status.StartedAt)
+				require.Equal(t, time.Hour, requeueAfter)
+			},
+		},
+		{
+			name: "error listing Promotions",
+			release: &kargoapi.Release{
+				Spec: testSpec,
+			},
+			reconciler: &reconciler{
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ kargoapi.ReleaseStatus,
+				_ time.Duration,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error listing Promotions")
+			},
+		},
+		{
+			name: "Stage not found",
+			release: &kargoapi.Release{
+				Spec: testSpec,
+			},
+			reconciler: &reconciler{
+				listPromosFn: noPromosFn,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				status kargoapi.ReleaseStatus,
+				_ time.Duration,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.ReleasePhaseFailed, status.Phase)
+				require.Contains(t, status.Error, "could not find Stage")
+			},
+		},
+		{
+			name: "error creating Promotion",
+			release: &kargoapi.Release{
+				Spec: testSpec,
+			},
+			reconciler: &reconciler{
+				listPromosFn: noPromosFn,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ kargoapi.ReleaseStatus,
+				_ time.Duration,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error creating Promotion")
+			},
+		},
+		{
+			name: "first step started",
+			release: &kargoapi.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-release",
+					Namespace: "fake-namespace",
+				},
+				Spec: testSpec,
+			},
+			reconciler: &reconciler{
+				listPromosFn: noPromosFn,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-stage",
+							Namespace: "fake-namespace",
+						},
+					}, nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					promo, ok := obj.(*kargoapi.Promotion)
+					require.True(t, ok)
+					require.Equal(t, "fake-stage", promo.Spec.Stage)
+					require.Equal(t, "fake-freight", promo.Spec.Freight)
+					require.Equal(
+						t,
+						"fake-release",
+						promo.Labels[kargoapi.LabelReleaseKey],
+					)
+					return nil
+				},
+			},
+			assertions: func(
+				status kargoapi.ReleaseStatus,
+				_ time.Duration,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.ReleasePhaseRunning, status.Phase)
+				require.Equal(t, testNow, status.StartedAt.Time)
+				require.Len(t, status.Steps, 1)
+				require.Equal(t, "fake-stage", status.Steps[0].Stage)
+				require.NotEmpty(t, status.Steps[0].Promotion)
+			},
+		},
+		{
+			name: "existing Promotion is adopted",
+			release: &kargoapi.Release{
+				Spec: testSpec,
+			},
+			reconciler: &reconciler{
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-promotion",
+							},
+							Spec: &kargoapi.PromotionSpec{
+								Stage: "fake-stage",
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				status kargoapi.ReleaseStatus,
+				_ time.Duration,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, status.Steps, 1)
+				require.Equal(t, "fake-promotion", status.Steps[0].Promotion)
+			},
+		},
+		{
+			name: "step still in progress",
+			release: &kargoapi.Release{
+				Spec: testSpec,
+				Status: kargoapi.ReleaseStatus{
+					StartedAt: &metav1.Time{Time: testNow},
+					Steps: []kargoapi.ReleaseStepStatus{
+						{
+							Stage:     "fake-stage",
+							Promotion: "fake-promotion",
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return &kargoapi.Promotion{
+						Status: kargoapi.PromotionStatus{
+							Phase: kargoapi.PromotionPhaseRunning,
+						},
+					}, nil
+				},
+			},
+			assertions: func(
+				status kargoapi.ReleaseStatus,
+				requeueAfter time.Duration,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.ReleasePhaseRunning, status.Phase)
+				require.Equal(
+					t,
+					kargoapi.PromotionPhaseRunning,
+					status.Steps[0].Phase,
+				)
+				require.Zero(t, requeueAfter)
+			},
+		},
+		{
+			name: "Promotion errored",
+			release: &kargoapi.Release{
+				Spec: testSpec,
+				Status: kargoapi.ReleaseStatus{
+					StartedAt: &metav1.Time{Time: testNow},
+					Steps: []kargoapi.ReleaseStepStatus{
+						{
+							Stage:     "fake-stage",
+							Promotion: "fake-promotion",
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return &kargoapi.Promotion{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-promotion",
+						},
+						Status: kargoapi.PromotionStatus{
+							Phase: kargoapi.PromotionPhaseErrored,
+							Error: "something went wrong",
+						},
+					}, nil
+				},
+			},
+			assertions: func(
+				status kargoapi.ReleaseStatus,
+				_ time.Duration,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.ReleasePhaseFailed, status.Phase)
+				require.Contains(t, status.Error, "fake-promotion")
+				require.Contains(t, status.Error, "something went wrong")
+				require.NotNil(t, status.Steps[0].CompletedAt)
+			},
+		},
+		{
+			name: "waiting for delay before next step",
+			release: &kargoapi.Release{
+				Spec: testSpec,
+				Status: kargoapi.ReleaseStatus{
+					StartedAt: &metav1.Time{Time: testNow.Add(-time.Hour)},
+					Steps: []kargoapi.ReleaseStepStatus{
+						{
+							Stage:     "fake-stage",
+							Promotion: "fake-promotion",
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return &kargoapi.Promotion{
+						Status: kargoapi.PromotionStatus{
+							Phase: kargoapi.PromotionPhaseSucceeded,
+						},
+					}, nil
+				},
+			},
+			assertions: func(
+				status kargoapi.ReleaseStatus,
+				requeueAfter time.Duration,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.ReleasePhaseRunning, status.Phase)
+				require.Len(t, status.Steps, 1)
+				require.Equal(
+					t,
+					kargoapi.PromotionPhaseSucceeded,
+					status.Steps[0].Phase,
+				)
+				require.Equal(t, testNow, status.Steps[0].CompletedAt.Time)
+				require.Equal(t, time.Hour, requeueAfter)
+			},
+		},
+		{
+			name: "step soaking",
+			release: &kargoapi.Release{
+				Spec: &kargoapi.ReleaseSpec{
+					Freight: "fake-freight",
+					Steps: []kargoapi.ReleaseStep{
+						{
+							Stage: "fake-stage",
+							Soak:  &metav1.Duration{Duration: time.Hour},
+						},
+						{
+							Stage: "another-fake-stage",
+						},
+					},
+				},
+				Status: kargoapi.ReleaseStatus{
+					StartedAt: &metav1.Time{Time: testNow.Add(-time.Hour)},
+					Steps: []kargoapi.ReleaseStepStatus{
+						{
+							Stage:       "fake-stage",
+							Promotion:   "fake-promotion",
+							Phase:       kargoapi.PromotionPhaseSucceeded,
+							CompletedAt: &metav1.Time{Time: testNow.Add(-time.Minute)},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Status: kargoapi.StageStatus{
+							CurrentFreight: &kargoapi.SimpleFreight{
+								ID: "fake-freight",
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(
+				status kargoapi.ReleaseStatus,
+				requeueAfter time.Duration,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.ReleasePhaseRunning, status.Phase)
+				require.Len(t, status.Steps, 1)
+				require.Nil(t, status.Steps[0].VerifiedAt)
+				require.Equal(t, soakCheckInterval, requeueAfter)
+			},
+		},
+		{
+			name: "all steps succeeded",
+			release: &kargoapi.Release{
+				Spec: testSpec,
+				Status: kargoapi.ReleaseStatus{
+					StartedAt: &metav1.Time{Time: testNow.Add(-2 * time.Hour)},
+					Steps: []kargoapi.ReleaseStepStatus{
+						{
+							Stage:       "fake-stage",
+							Promotion:   "fake-promotion",
+							Phase:       kargoapi.PromotionPhaseSucceeded,
+							CompletedAt: &metav1.Time{Time: testNow.Add(-2 * time.Hour)},
+						},
+						{
+							Stage:       "another-fake-stage",
+							Promotion:   "another-fake-promotion",
+							Phase:       kargoapi.PromotionPhaseSucceeded,
+							CompletedAt: &metav1.Time{Time: testNow},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{},
+			assertions: func(
+				status kargoapi.ReleaseStatus,
+				requeueAfter time.Duration,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.ReleasePhaseSucceeded, status.Phase)
+				require.Zero(t, requeueAfter)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.reconciler.nowFn = func() time.Time {
+				return testNow
+			}
+			testCase.assertions(
+				testCase.reconciler.syncRelease(
+					context.Background(),
+					testCase.release,
+				),
+			)
+		})
+	}
+}
+
+func TestVerifyStep(t *testing.T) {
+	testNow := time.Now()
+	testRelease := &kargoapi.Release{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+		},
+		Spec: &kargoapi.ReleaseSpec{
+			Freight: "fake-freight",
+		},
+	}
+	testStep := kargoapi.ReleaseStep{
+		Stage: "fake-stage",
+		Soak:  &metav1.Duration{Duration: time.Hour},
+	}
+	testCases := []struct {
+		name       string
+		stepStatus kargoapi.ReleaseStepStatus
+		getStageFn func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Stage, error)
+		getQualifiedFreightFn func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+			[]string,
+		) (*kargoapi.Freight, error)
+		assertions func(time.Duration, string, error)
+	}{
+		{
+			name: "error getting Stage",
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(_ time.Duration, _ string, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "Stage not found",
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return nil, nil
+			},
+			assertions: func(_ time.Duration, failure string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, failure, "could not find Stage")
+			},
+		},
+		{
+			name: "Stage promoted to different Freight",
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{
+					Status: kargoapi.StageStatus{
+						CurrentFreight: &kargoapi.SimpleFreight{
+							ID: "another-fake-freight",
+						},
+					},
+				}, nil
+			},
+			assertions: func(_ time.Duration, failure string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, failure, "promoted to different Freight")
+			},
+		},
+		{
+			name: "Stage became Unhealthy",
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{
+					Status: kargoapi.StageStatus{
+						CurrentFreight: &kargoapi.SimpleFreight{
+							ID: "fake-freight",
+						},
+						Health: &kargoapi.Health{
+							Status: kargoapi.HealthStateUnhealthy,
+							Issues: []string{"fake-issue"},
+						},
+					},
+				}, nil
+			},
+			assertions: func(_ time.Duration, failure string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, failure, "became Unhealthy")
+				require.Contains(t, failure, "fake-issue")
+			},
+		},
+		{
+			name: "soak period has not elapsed",
+			stepStatus: kargoapi.ReleaseStepStatus{
+				CompletedAt: &metav1.Time{Time: testNow},
+			},
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{
+					Status: kargoapi.StageStatus{
+						CurrentFreight: &kargoapi.SimpleFreight{
+							ID: "fake-freight",
+						},
+					},
+				}, nil
+			},
+			assertions: func(requeueAfter time.Duration, failure string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, failure)
+				require.Equal(t, soakCheckInterval, requeueAfter)
+			},
+		},
+		{
+			name: "soak period elapsed but Stage is not Healthy",
+			stepStatus: kargoapi.ReleaseStepStatus{
+				CompletedAt: &metav1.Time{Time: testNow.Add(-2 * time.Hour)},
+			},
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{
+					Status: kargoapi.StageStatus{
+						CurrentFreight: &kargoapi.SimpleFreight{
+							ID: "fake-freight",
+						},
+						Health: &kargoapi.Health{
+							Status: kargoapi.HealthStateProgressing,
+						},
+					},
+				}, nil
+			},
+			assertions: func(requeueAfter time.Duration, failure string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, failure)
+				require.Equal(t, soakCheckInterval, requeueAfter)
+			},
+		},
+		{
+			name: "error getting qualified Freight",
+			stepStatus: kargoapi.ReleaseStepStatus{
+				CompletedAt: &metav1.Time{Time: testNow.Add(-2 * time.Hour)},
+			},
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{
+					Status: kargoapi.StageStatus{
+						CurrentFreight: &kargoapi.SimpleFreight{
+							ID: "fake-freight",
+						},
+					},
+				}, nil
+			},
+			getQualifiedFreightFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+				[]string,
+			) (*kargoapi.Freight, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(_ time.Duration, _ string, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "Freight not yet qualified for Stage",
+			stepStatus: kargoapi.ReleaseStepStatus{
+				CompletedAt: &metav1.Time{Time: testNow.Add(-2 * time.Hour)},
+			},
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{
+					Status: kargoapi.StageStatus{
+						CurrentFreight: &kargoapi.SimpleFreight{
+							ID: "fake-freight",
+						},
+					},
+				}, nil
+			},
+			getQualifiedFreightFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+				[]string,
+			) (*kargoapi.Freight, error) {
+				return nil, nil
+			},
+			assertions: func(requeueAfter time.Duration, failure string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, failure)
+				require.Equal(t, soakCheckInterval, requeueAfter)
+			},
+		},
+		{
+			name: "verified",
+			stepStatus: kargoapi.ReleaseStepStatus{
+				CompletedAt: &metav1.Time{Time: testNow.Add(-2 * time.Hour)},
+			},
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{
+					Status: kargoapi.StageStatus{
+						CurrentFreight: &kargoapi.SimpleFreight{
+							ID: "fake-freight",
+						},
+						Health: &kargoapi.Health{
+							Status: kargoapi.HealthStateHealthy,
+						},
+					},
+				}, nil
+			},
+			getQualifiedFreightFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+				[]string,
+			) (*kargoapi.Freight, error) {
+				return &kargoapi.Freight{}, nil
+			},
+			assertions: func(requeueAfter time.Duration, failure string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, failure)
+				require.Zero(t, requeueAfter)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				getStageFn:            testCase.getStageFn,
+				getQualifiedFreightFn: testCase.getQualifiedFreightFn,
+			}
+			testCase.assertions(
+				r.verifyStep(
+					context.Background(),
+					testRelease,
+					testStep,
+					testCase.stepStatus,
+					testNow,
+				),
+			)
+		})
+	}
+}
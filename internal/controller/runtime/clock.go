@@ -0,0 +1,96 @@
+package runtime
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of the current time for reconcilers. It also schedules
+// requeues, so that a reconciler waiting for some amount of time to elapse
+// (e.g. for a soak period to end) reconciles again once that much time has
+// elapsed according to the Clock, which need not be in step with real time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// RequeueAfter returns how long to wait, in real time, before reconciling
+	// a resource again so that the specified duration will have elapsed
+	// according to the Clock. A zero or negative duration is returned as-is.
+	RequeueAfter(time.Duration) time.Duration
+}
+
+// RealClock is a Clock that keeps real time.
+var RealClock Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) RequeueAfter(d time.Duration) time.Duration {
+	return d
+}
+
+// SimulatedClock is a Clock that starts at an arbitrary time and then runs at
+// a multiple of real time. It can also be advanced arbitrarily. It is safe for
+// concurrent use by multiple goroutines.
+type SimulatedClock struct {
+	mu    sync.Mutex
+	speed float64
+	// base is the simulated time at the moment, in real time, given by
+	// realBase.
+	base     time.Time
+	realBase time.Time
+	// realNowFn returns the current real time. It is overridable for testing
+	// purposes.
+	realNowFn func() time.Time
+}
+
+// NewSimulatedClock returns a SimulatedClock that starts at the specified time
+// and runs speed times faster than real time. A speed of zero stops the clock
+// entirely, so that it is advanced only using Advance, which makes it fully
+// deterministic.
+func NewSimulatedClock(start time.Time, speed float64) *SimulatedClock {
+	return &SimulatedClock{
+		speed:     speed,
+		base:      start,
+		realBase:  time.Now(),
+		realNowFn: time.Now,
+	}
+}
+
+// Now implements Clock.
+func (s *SimulatedClock) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.now()
+}
+
+// RequeueAfter implements Clock. If the clock is stopped, the specified
+// duration is returned as-is, as there is no way of knowing when it will have
+// elapsed.
+func (s *SimulatedClock) RequeueAfter(d time.Duration) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d <= 0 || s.speed <= 0 {
+		return d
+	}
+	// Never requeue immediately, as that would mean not requeuing at all
+	if scaled := time.Duration(float64(d) / s.speed); scaled > 0 {
+		return scaled
+	}
+	return time.Nanosecond
+}
+
+// Advance moves the clock forward by the specified duration.
+func (s *SimulatedClock) Advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.base = s.now().Add(d)
+	s.realBase = s.realNowFn()
+}
+
+func (s *SimulatedClock) now() time.Time {
+	elapsed := s.realNowFn().Sub(s.realBase)
+	return s.base.Add(time.Duration(float64(elapsed) * s.speed))
+}
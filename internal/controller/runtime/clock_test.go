@@ -0,0 +1,67 @@
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRealClock(t *testing.T) {
+	require.WithinDuration(t, time.Now(), RealClock.Now(), time.Second)
+	require.Equal(t, time.Minute, RealClock.RequeueAfter(time.Minute))
+}
+
+func TestSimulatedClock(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name       string
+		speed      float64
+		assertions func(clock *SimulatedClock, realNow *time.Time)
+	}{
+		{
+			name:  "stopped",
+			speed: 0,
+			assertions: func(clock *SimulatedClock, realNow *time.Time) {
+				*realNow = realNow.Add(time.Hour)
+				require.Equal(t, start, clock.Now())
+				clock.Advance(time.Minute)
+				require.Equal(t, start.Add(time.Minute), clock.Now())
+				require.Equal(t, time.Minute, clock.RequeueAfter(time.Minute))
+			},
+		},
+		{
+			name:  "real time",
+			speed: 1,
+			assertions: func(clock *SimulatedClock, realNow *time.Time) {
+				*realNow = realNow.Add(time.Minute)
+				require.Equal(t, start.Add(time.Minute), clock.Now())
+				require.Equal(t, time.Minute, clock.RequeueAfter(time.Minute))
+			},
+		},
+		{
+			name:  "fast-forwarded",
+			speed: 60,
+			assertions: func(clock *SimulatedClock, realNow *time.Time) {
+				*realNow = realNow.Add(time.Minute)
+				require.Equal(t, start.Add(time.Hour), clock.Now())
+				clock.Advance(time.Hour)
+				require.Equal(t, start.Add(2*time.Hour), clock.Now())
+				*realNow = realNow.Add(time.Second)
+				require.Equal(t, start.Add(2*time.Hour+time.Minute), clock.Now())
+				require.Equal(t, time.Minute, clock.RequeueAfter(time.Hour))
+				require.Equal(t, time.Duration(0), clock.RequeueAfter(0))
+				require.Equal(t, time.Nanosecond, clock.RequeueAfter(time.Nanosecond))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			realNow := time.Now()
+			clock := NewSimulatedClock(start, testCase.speed)
+			clock.realBase = realNow
+			clock.realNowFn = func() time.Time { return realNow }
+			testCase.assertions(clock, &realNow)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package stages
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+)
+
+// checkDrift compares the specified Freight to what is actually running in
+// each of the specified Argo CD Applications and returns a description of
+// each divergence that is found. Applications that cannot be found, that are
+// being synced, or that have multiple sources are not assessed, since in any of
+// those cases, it is not possible to reliably determine what is running.
+func (r *reconciler) checkDrift(
+	ctx context.Context,
+	currentFreight kargoapi.SimpleFreight,
+	argoCDAppUpdates []kargoapi.ArgoCDAppUpdate,
+) []string {
+	var drifts []string
+	for _, update := range argoCDAppUpdates {
+		app, err := r.getArgoCDAppFn(
+			ctx,
+			r.argoClient,
+			update.AppNamespaceOrDefault(),
+			update.AppName,
+		)
+		if err != nil || app == nil {
+			// Failure to find the Application is already reported by health checks
+			continue
+		}
+		if app.Spec.Source == nil || len(app.Spec.Sources) > 0 {
+			continue
+		}
+		if app.Operation != nil && app.Operation.Sync != nil {
+			continue
+		}
+
+		if desiredRevision := getDesiredRevision(currentFreight, app); desiredRevision != "" &&
+			app.Status.Sync.Revision != "" &&
+			app.Status.Sync.Revision != desiredRevision {
+			drifts = append(
+				drifts,
+				fmt.Sprintf(
+					"Argo CD Application %q in namespace %q is synced to revision %q "+
+						"instead of %q",
+					app.Name,
+					app.Namespace,
+					app.Status.Sync.Revision,
+					desiredRevision,
+				),
+			)
+		}
+
+		for _, image := range currentFreight.Images {
+			if !isImageUpdatedBy(image.RepoURL, update) {
+				continue
+			}
+			if running, drifted :=
+				getImageDrift(image, app.Status.Summary.Images); drifted {
+				drifts = append(
+					drifts,
+					fmt.Sprintf(
+						"Argo CD Application %q in namespace %q is running image %q "+
+							"instead of %q",
+						app.Name,
+						app.Namespace,
+						running,
+						fmt.Sprintf("%s:%s", image.RepoURL, image.Tag),
+					),
+				)
+			}
+		}
+	}
+	return drifts
+}
+
+// getDesiredRevision returns the revision that the specified Argo CD
+// Application's source should be synced to, given the specified Freight. If
+// the Freight does not reference the Application's source, an empty string is
+// returned.
+func getDesiredRevision(
+	freight kargoapi.SimpleFreight,
+	app *argocd.Application,
+) string {
+	for _, commit := range freight.Commits {
+		if commit.RepoURL == app.Spec.Source.RepoURL {
+			if commit.HealthCheckCommit != "" {
+				return commit.HealthCheckCommit
+			}
+			return commit.ID
+		}
+	}
+	for _, chart := range freight.Charts {
+		if chart.RegistryURL == app.Spec.Source.RepoURL &&
+			chart.Name == app.Spec.Source.Chart {
+			return chart.Version
+		}
+	}
+	return ""
+}
+
+// isImageUpdatedBy returns a bool indicating whether the specified
+// ArgoCDAppUpdate updates the specified image.
+func isImageUpdatedBy(repoURL string, update kargoapi.ArgoCDAppUpdate) bool {
+	for _, srcUpdate := range update.SourceUpdates {
+		if srcUpdate.Kustomize != nil {
+			for _, image := range srcUpdate.Kustomize.Images {
+				if image == repoURL {
+					return true
+				}
+			}
+		}
+		if srcUpdate.Helm != nil {
+			for _, image := range srcUpdate.Helm.Images {
+				if image.Image == repoURL {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// getImageDrift inspects the images reported as running by an Argo CD
+// Application and returns a bool indicating whether a tag of the specified
+// image other than the expected one is running. When one is, that image
+// reference is also returned. Images referenced by digest are disregarded,
+// since they cannot be compared to a tag.
+func getImageDrift(
+	image kargoapi.Image,
+	runningImages []string,
+) (string, bool) {
+	var drifted string
+	for _, running := range runningImages {
+		if strings.Contains(running, "@") {
+			continue
+		}
+		repo, tag := splitImageReference(running)
+		if repo != image.RepoURL {
+			continue
+		}
+		if tag == image.Tag {
+			return "", false
+		}
+		drifted = running
+	}
+	return drifted, drifted != ""
+}
+
+// splitImageReference splits an image reference of the form <repo>:<tag> into
+// its repository and tag. If the reference has no tag, the tag returned is an
+// empty string.
+func splitImageReference(ref string) (string, string) {
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		return ref[:i], ref[i+1:]
+	}
+	return ref, ""
+}
@@ -0,0 +1,261 @@
+package stages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+)
+
+func TestCheckDrift(t *testing.T) {
+	testFreight := kargoapi.SimpleFreight{
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "fake-url",
+				ID:      "fake-revision",
+			},
+		},
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "fake-image",
+				Tag:     "fake-tag",
+			},
+		},
+	}
+	testArgoCDAppUpdates := []kargoapi.ArgoCDAppUpdate{
+		{
+			AppName:      "fake-app",
+			AppNamespace: "fake-namespace",
+			SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+				{
+					RepoURL: "fake-url",
+					Kustomize: &kargoapi.ArgoCDKustomize{
+						Images: []string{"fake-image"},
+					},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name             string
+		argoCDAppUpdates []kargoapi.ArgoCDAppUpdate
+		getArgoCDAppFn   func(
+			context.Context,
+			client.Client,
+			string,
+			string,
+		) (*argocd.Application, error)
+		assertions func([]string)
+	}{
+		{
+			name: "no argoCDAppUpdates are defined",
+			assertions: func(drifts []string) {
+				require.Empty(t, drifts)
+			},
+		},
+		{
+			name:             "error finding Argo CD App",
+			argoCDAppUpdates: testArgoCDAppUpdates,
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(drifts []string) {
+				require.Empty(t, drifts)
+			},
+		},
+		{
+			name:             "Argo CD App is being synced",
+			argoCDAppUpdates: testArgoCDAppUpdates,
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return &argocd.Application{
+					Spec: argocd.ApplicationSpec{
+						Source: &argocd.ApplicationSource{
+							RepoURL: "fake-url",
+						},
+					},
+					Status: argocd.ApplicationStatus{
+						Sync: argocd.SyncStatus{
+							Revision: "another-fake-revision",
+						},
+					},
+					Operation: &argocd.Operation{
+						Sync: &argocd.SyncOperation{},
+					},
+				}, nil
+			},
+			assertions: func(drifts []string) {
+				require.Empty(t, drifts)
+			},
+		},
+		{
+			name:             "Argo CD App is synced to a different revision",
+			argoCDAppUpdates: testArgoCDAppUpdates,
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return &argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-app",
+						Namespace: "fake-namespace",
+					},
+					Spec: argocd.ApplicationSpec{
+						Source: &argocd.ApplicationSource{
+							RepoURL: "fake-url",
+						},
+					},
+					Status: argocd.ApplicationStatus{
+						Sync: argocd.SyncStatus{
+							Revision: "another-fake-revision",
+						},
+						Summary: argocd.ApplicationSummary{
+							Images: []string{"fake-image:fake-tag"},
+						},
+					},
+				}, nil
+			},
+			assertions: func(drifts []string) {
+				require.Len(t, drifts, 1)
+				require.Contains(t, drifts[0], `synced to revision "another-fake-revision"`)
+				require.Contains(t, drifts[0], `instead of "fake-revision"`)
+			},
+		},
+		{
+			name:             "Argo CD App is running a different image",
+			argoCDAppUpdates: testArgoCDAppUpdates,
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return &argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-app",
+						Namespace: "fake-namespace",
+					},
+					Spec: argocd.ApplicationSpec{
+						Source: &argocd.ApplicationSource{
+							RepoURL: "fake-url",
+						},
+					},
+					Status: argocd.ApplicationStatus{
+						Sync: argocd.SyncStatus{
+							Revision: "fake-revision",
+						},
+						Summary: argocd.ApplicationSummary{
+							Images: []string{
+								"unrelated-image:another-fake-tag",
+								"fake-image:another-fake-tag",
+							},
+						},
+					},
+				}, nil
+			},
+			assertions: func(drifts []string) {
+				require.Len(t, drifts, 1)
+				require.Contains(t, drifts[0], `running image "fake-image:another-fake-tag"`)
+				require.Contains(t, drifts[0], `instead of "fake-image:fake-tag"`)
+			},
+		},
+		{
+			name: "image is not updated by the Stage",
+			argoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+				{
+					AppName:      "fake-app",
+					AppNamespace: "fake-namespace",
+				},
+			},
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return &argocd.Application{
+					Spec: argocd.ApplicationSpec{
+						Source: &argocd.ApplicationSource{
+							RepoURL: "fake-url",
+						},
+					},
+					Status: argocd.ApplicationStatus{
+						Sync: argocd.SyncStatus{
+							Revision: "fake-revision",
+						},
+						Summary: argocd.ApplicationSummary{
+							Images: []string{"fake-image:another-fake-tag"},
+						},
+					},
+				}, nil
+			},
+			assertions: func(drifts []string) {
+				require.Empty(t, drifts)
+			},
+		},
+		{
+			name:             "no drift",
+			argoCDAppUpdates: testArgoCDAppUpdates,
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return &argocd.Application{
+					Spec: argocd.ApplicationSpec{
+						Source: &argocd.ApplicationSource{
+							RepoURL: "fake-url",
+						},
+					},
+					Status: argocd.ApplicationStatus{
+						Sync: argocd.SyncStatus{
+							Revision: "fake-revision",
+						},
+						Summary: argocd.ApplicationSummary{
+							Images: []string{
+								"fake-image:another-fake-tag",
+								"fake-image:fake-tag",
+								"fake-image@sha256:fake-digest",
+							},
+						},
+					},
+				}, nil
+			},
+			assertions: func(drifts []string) {
+				require.Empty(t, drifts)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := reconciler{
+				getArgoCDAppFn: testCase.getArgoCDAppFn,
+			}
+			testCase.assertions(
+				r.checkDrift(
+					context.Background(),
+					testFreight,
+					testCase.argoCDAppUpdates,
+				),
+			)
+		})
+	}
+}
@@ -3,9 +3,17 @@ package stages
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+	healthv1alpha1 "github.com/akuity/kargo/pkg/api/health/v1alpha1"
 )
 
 func (r *reconciler) checkHealth(
@@ -76,6 +84,10 @@ func (r *reconciler) checkHealth(
 			continue
 		}
 
+		h.ArgoCDApps[i].Cluster = app.Spec.Destination.Name
+		if h.ArgoCDApps[i].Cluster == "" {
+			h.ArgoCDApps[i].Cluster = app.Spec.Destination.Server
+		}
 		h.ArgoCDApps[i].HealthStatus = kargoapi.ArgoCDAppHealthStatus{
 			Status:  kargoapi.ArgoCDAppHealthState(app.Status.Health.Status),
 			Message: app.Status.Health.Message,
@@ -102,7 +114,10 @@ func (r *reconciler) checkHealth(
 			continue
 		}
 
-		stageHealth, issue := stageHealthForAppHealth(app)
+		stageHealth, issue := stageHealthForAppHealth(
+			app,
+			appHealthWithOverrides(app, updates.HealthOverrides),
+		)
 		h.Status = h.Status.Merge(stageHealth)
 		if issue != "" {
 			h.Issues = append(h.Issues, issue)
@@ -141,10 +156,204 @@ func (r *reconciler) checkHealth(
 	return &h
 }
 
+// mergeProviderHealth polls each of the external health providers referenced
+// by the Stage's HealthChecks and merges their reports into the provided
+// Health, which may be nil if the Stage has no Argo CD Applications. Issues
+// reported by each provider are labeled with the provider's name.
+func (r *reconciler) mergeProviderHealth(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	currentFreight kargoapi.SimpleFreight,
+	h *kargoapi.Health,
+) *kargoapi.Health {
+	if len(stage.Spec.HealthChecks) == 0 {
+		return h
+	}
+	if h == nil {
+		h = &kargoapi.Health{
+			// We'll start healthy and degrade as we find issues
+			Status: kargoapi.HealthStateHealthy,
+			Issues: []string{},
+		}
+	}
+	h.Providers = make([]kargoapi.HealthProviderStatus, len(stage.Spec.HealthChecks))
+	for i, check := range stage.Spec.HealthChecks {
+		providerHealth := r.checkProviderHealthFn(ctx, stage, currentFreight, check)
+		h.Providers[i] = providerHealth
+		h.Status = h.Status.Merge(providerHealth.Status)
+		if providerHealth.Status == kargoapi.HealthStateHealthy {
+			continue
+		}
+		for _, issue := range providerHealth.Issues {
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf("[%s] %s", check.Provider, issue),
+			)
+		}
+	}
+	return h
+}
+
+// dampHealth prevents the provided Health of a Stage from transitioning from
+// Unhealthy to Healthy until the provided HealthDampingPolicy, if any, is
+// satisfied. Until then, the Stage continues to be reported as Unhealthy and
+// its progress toward satisfying the policy is recorded in the Health.
+func (r *reconciler) dampHealth(
+	policy *kargoapi.HealthDampingPolicy,
+	prevHealth *kargoapi.Health,
+	h *kargoapi.Health,
+) {
+	if policy == nil ||
+		h.Status != kargoapi.HealthStateHealthy ||
+		prevHealth == nil ||
+		prevHealth.Status != kargoapi.HealthStateUnhealthy {
+		return
+	}
+	now := r.nowFn()
+	recovery := kargoapi.HealthRecovery{
+		Since:  metav1.Time{Time: now},
+		Checks: 1,
+	}
+	if prevHealth.Recovery != nil {
+		// The Stage was already recovering
+		recovery = *prevHealth.Recovery.DeepCopy()
+		recovery.Checks++
+	}
+	if policy.Satisfied(recovery, now) {
+		return
+	}
+	h.Status = kargoapi.HealthStateUnhealthy
+	h.Recovery = &recovery
+	h.Issues = append(
+		h.Issues,
+		fmt.Sprintf(
+			"Stage has been observed to be Healthy in %d consecutive health "+
+				"check(s) since %s after being Unhealthy; its health damping policy "+
+				"is not yet satisfied",
+			recovery.Checks,
+			recovery.Since.UTC().Format(time.RFC3339),
+		),
+	)
+}
+
+// checkProviderHealth polls the external health provider referenced by the
+// provided HealthCheck for the health of the provided Stage with the provided
+// Freight. A failure to invoke the provider, or an unrecognized response from
+// it, is reported as Unknown health.
+func (r *reconciler) checkProviderHealth(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	currentFreight kargoapi.SimpleFreight,
+	check kargoapi.HealthCheck,
+) kargoapi.HealthProviderStatus {
+	status := kargoapi.HealthProviderStatus{
+		Provider: check.Provider,
+		Status:   kargoapi.HealthStateUnknown,
+	}
+
+	client, ok := r.healthProviders[check.Provider]
+	if !ok {
+		status.Issues = []string{
+			"health provider is not registered",
+		}
+		return status
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.HealthCheckTimeout)
+	defer cancel()
+	res, err := client.CheckHealth(
+		ctx,
+		connect.NewRequest(&healthv1alpha1.CheckHealthRequest{
+			Namespace:  stage.Namespace,
+			Stage:      stage.Name,
+			Freight:    typesv1alpha1.ToSimpleFreightProto(currentFreight, nil),
+			Parameters: check.Parameters,
+		}),
+	)
+	if err != nil {
+		logging.LoggerFromContext(ctx).WithField("provider", check.Provider).
+			Errorf("error invoking health provider: %s", err)
+		status.Issues = []string{
+			fmt.Sprintf("error invoking health provider: %s", err),
+		}
+		return status
+	}
+
+	status.Issues = res.Msg.GetIssues()
+	switch state := kargoapi.HealthState(res.Msg.GetStatus()); state {
+	case kargoapi.HealthStateHealthy,
+		kargoapi.HealthStateUnhealthy,
+		kargoapi.HealthStateProgressing,
+		kargoapi.HealthStateUnknown:
+		status.Status = state
+	default:
+		status.Issues = append(
+			status.Issues,
+			fmt.Sprintf("health provider reported unrecognized health state %q", state),
+		)
+	}
+	return status
+}
+
+// argoCDHealthOrder lists Argo CD health states from best to worst, in the
+// order Argo CD itself uses when aggregating the health of an Application's
+// resources.
+var argoCDHealthOrder = []argocd.HealthStatusCode{
+	argocd.HealthStatusHealthy,
+	argocd.HealthStatusSuspended,
+	argocd.HealthStatusProgressing,
+	argocd.HealthStatusMissing,
+	argocd.HealthStatusDegraded,
+	argocd.HealthStatusUnknown,
+}
+
+// appHealthWithOverrides returns the health of the provided Argo CD
+// Application, re-aggregated from the health of its resources after applying
+// the provided overrides to them. If no override applies to any resource, the
+// health reported by Argo CD is returned unchanged.
+func appHealthWithOverrides(
+	app *argocd.Application,
+	overrides []kargoapi.ArgoCDResourceHealthOverride,
+) argocd.HealthStatusCode {
+	if len(overrides) == 0 {
+		return app.Status.Health.Status
+	}
+	var overridden bool
+	health := argocd.HealthStatusHealthy
+	for _, res := range app.Status.Resources {
+		if res.Health == nil {
+			// Argo CD does not assess the health of every resource
+			continue
+		}
+		resHealth := res.Health.Status
+		for _, override := range overrides {
+			if override.Matches(
+				res.Group,
+				res.Kind,
+				res.Name,
+				kargoapi.ArgoCDAppHealthState(resHealth),
+			) {
+				resHealth = argocd.HealthStatusCode(override.Health)
+				overridden = true
+				break
+			}
+		}
+		if slices.Index(argoCDHealthOrder, resHealth) >
+			slices.Index(argoCDHealthOrder, health) {
+			health = resHealth
+		}
+	}
+	if !overridden {
+		return app.Status.Health.Status
+	}
+	return health
+}
+
 func stageHealthForAppHealth(
 	app *argocd.Application,
+	health argocd.HealthStatusCode,
 ) (kargoapi.HealthState, string) {
-	switch app.Status.Health.Status {
+	switch health {
 	case argocd.HealthStatusProgressing, "":
 		return kargoapi.HealthStateProgressing,
 			fmt.Sprintf(
@@ -160,7 +369,7 @@ func stageHealthForAppHealth(
 				"Argo CD Application %q in namespace %q has health state %q",
 				app.Name,
 				app.Namespace,
-				app.Status.Health.Status,
+				health,
 			)
 	}
 }
@@ -2,14 +2,23 @@ package stages
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	healthv1alpha1 "github.com/akuity/kargo/pkg/api/health/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/health/v1alpha1/healthv1alpha1connect"
+	"github.com/akuity/kargo/pkg/health"
 )
 
 func TestCheckHealth(t *testing.T) {
@@ -356,6 +365,76 @@ func TestCheckHealth(t *testing.T) {
 				require.Empty(t, health.Issues)
 			},
 		},
+
+		{
+			name: "Argo CD App health overridden",
+			argoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+				{
+					AppName:      "fake-app",
+					AppNamespace: "fake-namespace",
+					HealthOverrides: []kargoapi.ArgoCDResourceHealthOverride{
+						{
+							Group:  "example.com",
+							Kind:   "Widget",
+							When:   []kargoapi.ArgoCDAppHealthState{kargoapi.ArgoCDAppHealthStateDegraded},
+							Health: kargoapi.ArgoCDAppHealthStateHealthy,
+						},
+					},
+				},
+			},
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return &argocd.Application{
+					Spec: argocd.ApplicationSpec{
+						Destination: argocd.ApplicationDestination{
+							Server: "https://kubernetes.default.svc",
+							Name:   "in-cluster",
+						},
+					},
+					Status: argocd.ApplicationStatus{
+						Health: argocd.HealthStatus{
+							Status: argocd.HealthStatusDegraded,
+						},
+						Sync: argocd.SyncStatus{
+							Status: argocd.SyncStatusCodeSynced,
+						},
+						Resources: []argocd.ResourceStatus{
+							{
+								Group: "apps",
+								Kind:  "Deployment",
+								Name:  "fake-deployment",
+								Health: &argocd.HealthStatus{
+									Status: argocd.HealthStatusHealthy,
+								},
+							},
+							{
+								Group: "example.com",
+								Kind:  "Widget",
+								Name:  "fake-widget",
+								Health: &argocd.HealthStatus{
+									Status: argocd.HealthStatusDegraded,
+								},
+							},
+						},
+					},
+				}, nil
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Equal(t, "in-cluster", health.ArgoCDApps[0].Cluster)
+				// The health reported by Argo CD is recorded as is
+				require.Equal(
+					t,
+					kargoapi.ArgoCDAppHealthStateDegraded,
+					health.ArgoCDApps[0].HealthStatus.Status,
+				)
+				require.Empty(t, health.Issues)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -372,3 +451,471 @@ func TestCheckHealth(t *testing.T) {
 		})
 	}
 }
+
+func TestAppHealthWithOverrides(t *testing.T) {
+	app := &argocd.Application{
+		Status: argocd.ApplicationStatus{
+			Health: argocd.HealthStatus{
+				Status: argocd.HealthStatusDegraded,
+			},
+			Resources: []argocd.ResourceStatus{
+				{
+					Kind: "ConfigMap",
+					Name: "fake-configmap",
+				},
+				{
+					Group: "apps",
+					Kind:  "Deployment",
+					Name:  "fake-deployment",
+					Health: &argocd.HealthStatus{
+						Status: argocd.HealthStatusProgressing,
+					},
+				},
+				{
+					Group: "example.com",
+					Kind:  "Widget",
+					Name:  "fake-widget",
+					Health: &argocd.HealthStatus{
+						Status: argocd.HealthStatusDegraded,
+					},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name      string
+		overrides []kargoapi.ArgoCDResourceHealthOverride
+		expected  argocd.HealthStatusCode
+	}{
+		{
+			name:     "no overrides",
+			expected: argocd.HealthStatusDegraded,
+		},
+		{
+			name: "no override matches",
+			overrides: []kargoapi.ArgoCDResourceHealthOverride{
+				{
+					Group:  "example.com",
+					Kind:   "Gadget",
+					Health: kargoapi.ArgoCDAppHealthStateHealthy,
+				},
+			},
+			expected: argocd.HealthStatusDegraded,
+		},
+		{
+			name: "override matches",
+			overrides: []kargoapi.ArgoCDResourceHealthOverride{
+				{
+					Group:  "example.com",
+					Kind:   "Widget",
+					Health: kargoapi.ArgoCDAppHealthStateHealthy,
+				},
+			},
+			// The Deployment is still progressing
+			expected: argocd.HealthStatusProgressing,
+		},
+		{
+			name: "first matching override applies",
+			overrides: []kargoapi.ArgoCDResourceHealthOverride{
+				{
+					Group:  "apps",
+					Kind:   "Deployment",
+					Health: kargoapi.ArgoCDAppHealthStateHealthy,
+				},
+				{
+					Group:  "apps",
+					Kind:   "Deployment",
+					Health: kargoapi.ArgoCDAppHealthStateUnknown,
+				},
+				{
+					Group:  "example.com",
+					Kind:   "Widget",
+					Health: kargoapi.ArgoCDAppHealthStateSuspended,
+				},
+			},
+			expected: argocd.HealthStatusSuspended,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				appHealthWithOverrides(app, testCase.overrides),
+			)
+		})
+	}
+}
+
+func TestMergeProviderHealth(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		healthChecks          []kargoapi.HealthCheck
+		health                *kargoapi.Health
+		checkProviderHealthFn func(
+			context.Context,
+			*kargoapi.Stage,
+			kargoapi.SimpleFreight,
+			kargoapi.HealthCheck,
+		) kargoapi.HealthProviderStatus
+		assertions func(*kargoapi.Health)
+	}{
+		{
+			name: "no health checks",
+			assertions: func(health *kargoapi.Health) {
+				require.Nil(t, health)
+			},
+		},
+		{
+			name: "no Argo CD Applications",
+			healthChecks: []kargoapi.HealthCheck{
+				{Provider: "foo"},
+			},
+			checkProviderHealthFn: func(
+				_ context.Context,
+				_ *kargoapi.Stage,
+				_ kargoapi.SimpleFreight,
+				check kargoapi.HealthCheck,
+			) kargoapi.HealthProviderStatus {
+				return kargoapi.HealthProviderStatus{
+					Provider: check.Provider,
+					Status:   kargoapi.HealthStateHealthy,
+				}
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.NotNil(t, health)
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Empty(t, health.Issues)
+				require.Equal(
+					t,
+					[]kargoapi.HealthProviderStatus{
+						{
+							Provider: "foo",
+							Status:   kargoapi.HealthStateHealthy,
+						},
+					},
+					health.Providers,
+				)
+			},
+		},
+		{
+			name: "providers degrade health",
+			healthChecks: []kargoapi.HealthCheck{
+				{Provider: "foo"},
+				{Provider: "bar"},
+			},
+			health: &kargoapi.Health{
+				Status: kargoapi.HealthStateProgressing,
+				Issues: []string{"fake-issue"},
+			},
+			checkProviderHealthFn: func(
+				_ context.Context,
+				_ *kargoapi.Stage,
+				_ kargoapi.SimpleFreight,
+				check kargoapi.HealthCheck,
+			) kargoapi.HealthProviderStatus {
+				if check.Provider == "foo" {
+					return kargoapi.HealthProviderStatus{
+						Provider: check.Provider,
+						Status:   kargoapi.HealthStateHealthy,
+						Issues:   []string{"ignored"},
+					}
+				}
+				return kargoapi.HealthProviderStatus{
+					Provider: check.Provider,
+					Status:   kargoapi.HealthStateUnhealthy,
+					Issues:   []string{"something is wrong"},
+				}
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.NotNil(t, health)
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.Equal(
+					t,
+					[]string{"fake-issue", "[bar] something is wrong"},
+					health.Issues,
+				)
+				require.Len(t, health.Providers, 2)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				checkProviderHealthFn: testCase.checkProviderHealthFn,
+			}
+			testCase.assertions(
+				r.mergeProviderHealth(
+					context.Background(),
+					&kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							HealthChecks: testCase.healthChecks,
+						},
+					},
+					kargoapi.SimpleFreight{},
+					testCase.health,
+				),
+			)
+		})
+	}
+}
+
+func TestDampHealth(t *testing.T) {
+	testNow := time.Now()
+	twoMinutesAgo := metav1.NewTime(testNow.Add(-2 * time.Minute))
+	policy := &kargoapi.HealthDampingPolicy{ConsecutiveChecks: 3}
+	testCases := []struct {
+		name       string
+		policy     *kargoapi.HealthDampingPolicy
+		prevHealth *kargoapi.Health
+		health     kargoapi.Health
+		assertions func(kargoapi.Health)
+	}{
+		{
+			name: "no policy",
+			prevHealth: &kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+			},
+			health: kargoapi.Health{
+				Status: kargoapi.HealthStateHealthy,
+			},
+			assertions: func(health kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Nil(t, health.Recovery)
+			},
+		},
+		{
+			name:   "not Healthy",
+			policy: policy,
+			prevHealth: &kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+				Recovery: &kargoapi.HealthRecovery{
+					Since:  twoMinutesAgo,
+					Checks: 2,
+				},
+			},
+			health: kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+			},
+			assertions: func(health kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.Nil(t, health.Recovery)
+			},
+		},
+		{
+			name:   "previously Progressing",
+			policy: policy,
+			prevHealth: &kargoapi.Health{
+				Status: kargoapi.HealthStateProgressing,
+			},
+			health: kargoapi.Health{
+				Status: kargoapi.HealthStateHealthy,
+			},
+			assertions: func(health kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Nil(t, health.Recovery)
+			},
+		},
+		{
+			name:   "recovery begins",
+			policy: policy,
+			prevHealth: &kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+			},
+			health: kargoapi.Health{
+				Status: kargoapi.HealthStateHealthy,
+			},
+			assertions: func(health kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.Equal(
+					t,
+					&kargoapi.HealthRecovery{
+						Since:  metav1.Time{Time: testNow},
+						Checks: 1,
+					},
+					health.Recovery,
+				)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], "health damping policy")
+			},
+		},
+		{
+			name:   "recovery continues",
+			policy: policy,
+			prevHealth: &kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+				Recovery: &kargoapi.HealthRecovery{
+					Since:  twoMinutesAgo,
+					Checks: 1,
+				},
+			},
+			health: kargoapi.Health{
+				Status: kargoapi.HealthStateHealthy,
+			},
+			assertions: func(health kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.Equal(
+					t,
+					&kargoapi.HealthRecovery{
+						Since:  twoMinutesAgo,
+						Checks: 2,
+					},
+					health.Recovery,
+				)
+			},
+		},
+		{
+			name:   "recovery complete",
+			policy: policy,
+			prevHealth: &kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+				Recovery: &kargoapi.HealthRecovery{
+					Since:  twoMinutesAgo,
+					Checks: 2,
+				},
+			},
+			health: kargoapi.Health{
+				Status: kargoapi.HealthStateHealthy,
+			},
+			assertions: func(health kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Nil(t, health.Recovery)
+				require.Empty(t, health.Issues)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				nowFn: func() time.Time {
+					return testNow
+				},
+			}
+			health := testCase.health
+			r.dampHealth(testCase.policy, testCase.prevHealth, &health)
+			testCase.assertions(health)
+		})
+	}
+}
+
+func TestCheckProviderHealth(t *testing.T) {
+	testCases := []struct {
+		name       string
+		provider   health.ProviderFunc
+		assertions func(kargoapi.HealthProviderStatus)
+	}{
+		{
+			name: "provider not registered",
+			assertions: func(status kargoapi.HealthProviderStatus) {
+				require.Equal(t, kargoapi.HealthStateUnknown, status.Status)
+				require.Equal(
+					t,
+					[]string{"health provider is not registered"},
+					status.Issues,
+				)
+			},
+		},
+		{
+			name: "error invoking provider",
+			provider: func(
+				context.Context,
+				*healthv1alpha1.CheckHealthRequest,
+			) (*healthv1alpha1.CheckHealthResponse, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(status kargoapi.HealthProviderStatus) {
+				require.Equal(t, kargoapi.HealthStateUnknown, status.Status)
+				require.Len(t, status.Issues, 1)
+				require.Contains(t, status.Issues[0], "error invoking health provider")
+				require.Contains(t, status.Issues[0], "something went wrong")
+			},
+		},
+		{
+			name: "unrecognized health state",
+			provider: func(
+				context.Context,
+				*healthv1alpha1.CheckHealthRequest,
+			) (*healthv1alpha1.CheckHealthResponse, error) {
+				return &healthv1alpha1.CheckHealthResponse{
+					Status: "Bogus",
+				}, nil
+			},
+			assertions: func(status kargoapi.HealthProviderStatus) {
+				require.Equal(t, kargoapi.HealthStateUnknown, status.Status)
+				require.Equal(
+					t,
+					[]string{
+						`health provider reported unrecognized health state "Bogus"`,
+					},
+					status.Issues,
+				)
+			},
+		},
+		{
+			name: "success",
+			provider: func(
+				_ context.Context,
+				req *healthv1alpha1.CheckHealthRequest,
+			) (*healthv1alpha1.CheckHealthResponse, error) {
+				return &healthv1alpha1.CheckHealthResponse{
+					Status: string(kargoapi.HealthStateUnhealthy),
+					Issues: []string{
+						req.GetNamespace() + "/" + req.GetStage(),
+						req.GetFreight().GetId(),
+						req.GetParameters()["check"],
+					},
+				}, nil
+			},
+			assertions: func(status kargoapi.HealthProviderStatus) {
+				require.Equal(
+					t,
+					kargoapi.HealthProviderStatus{
+						Provider: "fake-provider",
+						Status:   kargoapi.HealthStateUnhealthy,
+						Issues: []string{
+							"fake-namespace/fake-stage",
+							"fake-freight",
+							"fake-check",
+						},
+					},
+					status,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				cfg: ReconcilerConfig{
+					HealthCheckTimeout: time.Minute,
+				},
+				healthProviders: map[string]healthv1alpha1connect.HealthProviderServiceClient{},
+			}
+			if testCase.provider != nil {
+				mux := http.NewServeMux()
+				mux.Handle(health.NewHandler(testCase.provider))
+				server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+				defer server.Close()
+				r.healthProviders["fake-provider"] = health.NewClient(server.URL)
+			}
+			testCase.assertions(
+				r.checkProviderHealth(
+					context.Background(),
+					&kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "fake-stage",
+						},
+					},
+					kargoapi.SimpleFreight{ID: "fake-freight"},
+					kargoapi.HealthCheck{
+						Provider: "fake-provider",
+						Parameters: map[string]string{
+							"check": "fake-check",
+						},
+					},
+				),
+			)
+		})
+	}
+}
@@ -3,12 +3,20 @@ package stages
 import (
 	"context"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/kelseyhightower/envconfig"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -18,20 +26,98 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/artifacts"
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/runtime"
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/pkg/api/health/v1alpha1/healthv1alpha1connect"
+	"github.com/akuity/kargo/pkg/api/verification/v1alpha1/verificationv1alpha1connect"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/verification"
 )
 
+// ReconcilerConfig represents configuration for the Stage reconciler.
+type ReconcilerConfig struct {
+	// AbortDownstreamAutoPromotionsOnFailure specifies whether, when a Stage's
+	// current Freight is found to be unhealthy, Pending Promotions of that
+	// Freight that were automatically created for downstream Stages should be
+	// deleted before they can be executed.
+	AbortDownstreamAutoPromotionsOnFailure bool `envconfig:"ABORT_DOWNSTREAM_AUTO_PROMOTIONS_ON_FAILURE" default:"false"`
+	// SelfHealMinInterval is the minimum amount of time that must elapse after
+	// the creation of one self-healing Promotion for a Stage before another may
+	// be created. This prevents Kargo from endlessly fighting with whatever is
+	// causing a Stage to drift.
+	SelfHealMinInterval time.Duration `envconfig:"SELF_HEAL_MIN_INTERVAL" default:"10m"`
+	// VerificationProviders maps the names of external verification providers
+	// that may be referenced by Stages' QualificationPolicies to the URLs at
+	// which those providers are served.
+	VerificationProviders ProviderURLMap `envconfig:"VERIFICATION_PROVIDERS"`
+	// HealthProviders maps the names of external health providers that may be
+	// referenced by Stages' HealthChecks to the URLs at which those providers
+	// are served.
+	HealthProviders ProviderURLMap `envconfig:"HEALTH_PROVIDERS"`
+	// HealthCheckTimeout is the maximum amount of time to wait for an external
+	// health provider to respond.
+	HealthCheckTimeout time.Duration `envconfig:"HEALTH_CHECK_TIMEOUT" default:"30s"`
+	// VerificationTimeout is the maximum amount of time to wait for an external
+	// verification provider to respond.
+	VerificationTimeout time.Duration `envconfig:"VERIFICATION_TIMEOUT" default:"30s"`
+}
+
+// ReconcilerConfigFromEnv returns a ReconcilerConfig populated from
+// environment variables.
+func ReconcilerConfigFromEnv() ReconcilerConfig {
+	cfg := ReconcilerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// ProviderURLMap is a mapping from the names of external providers to the
+// URLs at which they are served. It is decoded from a
+// comma-separated list of <name>=<URL> pairs.
+type ProviderURLMap map[string]string
+
+// Decode implements envconfig.Decoder.
+func (v *ProviderURLMap) Decode(value string) error {
+	urls := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kvpair := strings.SplitN(pair, "=", 2)
+		if len(kvpair) != 2 {
+			return errors.Errorf("invalid map item: %q. expected <name>=<URL>", pair)
+		}
+		urls[strings.TrimSpace(kvpair[0])] = strings.TrimSpace(kvpair[1])
+	}
+	*v = ProviderURLMap(urls)
+	return nil
+}
+
 // reconciler reconciles Stage resources.
 type reconciler struct {
-	kargoClient client.Client
-	argoClient  client.Client
+	cfg                   ReconcilerConfig
+	kargoClient           client.Client
+	argoClient            client.Client
+	recorder              record.EventRecorder
+	artifactStore         artifacts.Store
+	verificationProviders map[string]verificationv1alpha1connect.VerificationProviderServiceClient
+	healthProviders       map[string]healthv1alpha1connect.HealthProviderServiceClient
 
 	// The following behaviors are overridable for testing purposes:
 
+	// Archival:
+
+	isProjectArchivedFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (bool, error)
+
 	// Loop guard:
 
 	hasNonTerminalPromotionsFn func(
@@ -54,6 +140,13 @@ type reconciler struct {
 		[]kargoapi.ArgoCDAppUpdate,
 	) *kargoapi.Health
 
+	checkProviderHealthFn func(
+		context.Context,
+		*kargoapi.Stage,
+		kargoapi.SimpleFreight,
+		kargoapi.HealthCheck,
+	) kargoapi.HealthProviderStatus
+
 	getArgoCDAppFn func(
 		ctx context.Context,
 		client client.Client,
@@ -61,6 +154,32 @@ type reconciler struct {
 		name string,
 	) (*argocd.Application, error)
 
+	// Drift detection:
+
+	checkDriftFn func(
+		context.Context,
+		kargoapi.SimpleFreight,
+		[]kargoapi.ArgoCDAppUpdate,
+	) []string
+
+	// Self-healing:
+
+	selfHealFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		freightID string,
+	) (bool, error)
+
+	isSelfHealPermittedFn func(
+		ctx context.Context,
+		namespace string,
+		stageName string,
+	) (bool, error)
+
+	nowFn func() time.Time
+
+	requeueAfterFn func(time.Duration) time.Duration
+
 	// Freight qualification:
 
 	getFreightFn func(
@@ -82,6 +201,36 @@ type reconciler struct {
 		newStatus kargoapi.FreightStatus,
 	) error
 
+	// Freight verification:
+
+	verifyFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		freight kargoapi.SimpleFreight,
+		criterion kargoapi.VerificationCriterion,
+	) kargoapi.VerificationResult
+
+	// Freight qualification revocation:
+
+	revokeFreightQualificationFn func(
+		ctx context.Context,
+		namespace string,
+		freightName string,
+		stageName string,
+	) error
+
+	listStagesFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
+	deletePromotionFn func(
+		context.Context,
+		client.Object,
+		...client.DeleteOption,
+	) error
+
 	// Auto-promotion:
 
 	isAutoPromotionPermittedFn func(
@@ -90,6 +239,36 @@ type reconciler struct {
 		stageName string,
 	) (bool, error)
 
+	getAutoPromotionHoldbackFn func(
+		ctx context.Context,
+		namespace string,
+		stageName string,
+	) (time.Duration, error)
+
+	exceedsMaxFreightAgeFn func(
+		ctx context.Context,
+		namespace string,
+		stageName string,
+		freight *kargoapi.Freight,
+	) (bool, error)
+
+	isAutoPromotionCoalescingEnabledFn func(
+		ctx context.Context,
+		namespace string,
+		stageName string,
+	) (bool, error)
+
+	abortSupersededAutoPromotionsFn func(
+		context.Context,
+		*kargoapi.Stage,
+	) (bool, error)
+
+	getSupersededFreightFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		latestFreight *kargoapi.Freight,
+	) ([]string, error)
+
 	listPromoPoliciesFn func(
 		context.Context,
 		client.ObjectList,
@@ -147,7 +326,10 @@ func SetupReconcilerWithManager(
 	ctx context.Context,
 	kargoMgr manager.Manager,
 	argoMgr manager.Manager,
+	artifactStore artifacts.Store,
 	shardName string,
+	cfg ReconcilerConfig,
+	clock runtime.Clock,
 ) error {
 	// Index Promotions in non-terminal states by Stage
 	if err := kubeclient.IndexNonTerminalPromotionsByStage(ctx, kargoMgr); err != nil {
@@ -203,8 +385,17 @@ func SetupReconcilerWithManager(
 			),
 		).
 		WithEventFilter(shardPredicate).
-		WithOptions(controller.CommonOptions()).
-		Build(newReconciler(kargoMgr.GetClient(), argoMgr.GetClient()))
+		WithOptions(controller.CommonOptions("stage")).
+		Build(
+			newReconciler(
+				kargoMgr.GetClient(),
+				argoMgr.GetClient(),
+				kargoMgr.GetEventRecorderFor("stage-controller"),
+				artifactStore,
+				cfg,
+				clock,
+			),
+		)
 	if err != nil {
 		return errors.Wrap(err, "error building Stage reconciler")
 	}
@@ -225,27 +416,82 @@ func SetupReconcilerWithManager(
 	if err := c.Watch(&source.Kind{Type: &kargoapi.Freight{}}, downstreamEvtHandler); err != nil {
 		return errors.Wrap(err, "unable to watch Freight")
 	}
+
+	// Watch Projects that were unarchived and enqueue their Stages
+	if err := controller.WatchProjectUnarchival(
+		c,
+		kargoMgr.GetClient(),
+		func() client.ObjectList { return &kargoapi.StageList{} },
+		shardPredicate,
+	); err != nil {
+		return err
+	}
 	return nil
 }
 
-func newReconciler(kargoClient, argoClient client.Client) *reconciler {
+func newReconciler(
+	kargoClient client.Client,
+	argoClient client.Client,
+	recorder record.EventRecorder,
+	artifactStore artifacts.Store,
+	cfg ReconcilerConfig,
+	clock runtime.Clock,
+) *reconciler {
 	r := &reconciler{
-		kargoClient: kargoClient,
-		argoClient:  argoClient,
+		cfg:           cfg,
+		kargoClient:   kargoClient,
+		argoClient:    argoClient,
+		recorder:      recorder,
+		artifactStore: artifactStore,
+		verificationProviders: make(
+			map[string]verificationv1alpha1connect.VerificationProviderServiceClient,
+			len(cfg.VerificationProviders),
+		),
+	}
+	for name, url := range cfg.VerificationProviders {
+		r.verificationProviders[name] = verification.NewClient(url)
+	}
+	r.healthProviders = make(
+		map[string]healthv1alpha1connect.HealthProviderServiceClient,
+		len(cfg.HealthProviders),
+	)
+	for name, url := range cfg.HealthProviders {
+		r.healthProviders[name] = health.NewClient(url)
 	}
 	// The following default behaviors are overridable for testing purposes:
+	// Archival:
+	r.isProjectArchivedFn = kargoapi.IsProjectArchived
 	// Loop guard:
 	r.hasNonTerminalPromotionsFn = r.hasNonTerminalPromotions
 	r.listPromosFn = r.kargoClient.List
 	// Health checks:
 	r.checkHealthFn = r.checkHealth
+	r.checkProviderHealthFn = r.checkProviderHealth
 	r.getArgoCDAppFn = argocd.GetApplication
+	// Drift detection:
+	r.checkDriftFn = r.checkDrift
+	// Self-healing:
+	r.selfHealFn = r.selfHeal
+	r.isSelfHealPermittedFn = r.isSelfHealPermitted
+	r.nowFn = clock.Now
+	r.requeueAfterFn = clock.RequeueAfter
 	// Freight qualification:
 	r.getFreightFn = kargoapi.GetFreight
 	r.qualifyFreightFn = r.qualifyFreight
 	r.patchFreightStatusFn = r.patchFreightStatus
+	// Freight verification:
+	r.verifyFn = r.verify
+	// Freight qualification revocation:
+	r.revokeFreightQualificationFn = r.revokeFreightQualification
+	r.listStagesFn = r.kargoClient.List
+	r.deletePromotionFn = r.kargoClient.Delete
 	// Auto-promotion:
 	r.isAutoPromotionPermittedFn = r.isAutoPromotionPermitted
+	r.getAutoPromotionHoldbackFn = r.getAutoPromotionHoldback
+	r.exceedsMaxFreightAgeFn = r.exceedsMaxFreightAge
+	r.isAutoPromotionCoalescingEnabledFn = r.isAutoPromotionCoalescingEnabled
+	r.abortSupersededAutoPromotionsFn = r.abortSupersededAutoPromotions
+	r.getSupersededFreightFn = r.getSupersededFreight
 	r.listPromoPoliciesFn = r.kargoClient.List
 	r.createPromotionFn = kargoClient.Create
 	// Discovering latest Freight:
@@ -265,11 +511,10 @@ func (r *reconciler) Reconcile(
 	req ctrl.Request,
 ) (ctrl.Result, error) {
 	result := ctrl.Result{
-		// TODO: Make this configurable
 		// Note: If there is a failure, controller runtime ignores this and uses
 		// progressive backoff instead. So this value only affects when we will
 		// reconcile next if THIS reconciliation succeeds.
-		RequeueAfter: 5 * time.Minute,
+		RequeueAfter: defaultRequeueInterval,
 	}
 
 	logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
@@ -291,6 +536,16 @@ func (r *reconciler) Reconcile(
 		return result, nil
 	}
 	logger.Debug("found Stage")
+	result.RequeueAfter = getRequeueInterval(stage)
+
+	// Resources of archived Projects are not reconciled. They are enqueued
+	// again when the Project is unarchived.
+	if archived, err := r.isProjectArchivedFn(ctx, r.kargoClient, req.Namespace); err != nil {
+		return ctrl.Result{}, err
+	} else if archived {
+		logger.Debug("Project is archived; skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
 
 	var newStatus kargoapi.StageStatus
 	if stage.Spec.PromotionMechanisms == nil {
@@ -298,6 +553,14 @@ func (r *reconciler) Reconcile(
 	} else {
 		newStatus, err = r.syncNormalStage(ctx, stage)
 	}
+	// If auto-promotion is being held back, make sure we reconcile again as soon
+	// as the holdback elapses
+	if hold := newStatus.AutoPromotionHold; hold != nil {
+		if remaining := hold.Until.Sub(r.nowFn()); remaining > 0 &&
+			remaining < result.RequeueAfter {
+			result.RequeueAfter = remaining
+		}
+	}
 	if err != nil {
 		newStatus.Error = err.Error()
 		logger.Errorf("error syncing Stage: %s", stage.Status.Error)
@@ -329,11 +592,28 @@ func (r *reconciler) Reconcile(
 	}
 	logger.Debug("done reconciling Stage")
 
+	// The clock need not keep real time
+	result.RequeueAfter = r.requeueAfterFn(result.RequeueAfter)
+
 	// Controller runtime automatically gives us a progressive backoff if err is
 	// not nil
 	return result, err
 }
 
+// defaultRequeueInterval is the interval at which a Stage is reconciled when
+// its spec does not specify one.
+const defaultRequeueInterval = 5 * time.Minute
+
+// getRequeueInterval returns the interval at which the provided Stage is to be
+// reconciled.
+func getRequeueInterval(stage *kargoapi.Stage) time.Duration {
+	if stage.Spec != nil && stage.Spec.ReconcileInterval != nil &&
+		stage.Spec.ReconcileInterval.Duration > 0 {
+		return stage.Spec.ReconcileInterval.Duration
+	}
+	return defaultRequeueInterval
+}
+
 func (r *reconciler) syncControlFlowStage(
 	ctx context.Context,
 	stage *kargoapi.Stage,
@@ -424,38 +704,129 @@ func (r *reconciler) syncNormalStage(
 	); err != nil {
 		return status, err
 	} else if hasNonTerminalPromos {
-		logger.Debug(
-			"Stage has one or more Promotions in a non-terminal phase; skipping " +
-				"this reconciliation loop",
-		)
-		return status, nil
+		// If auto-Promotions are coalesced, Pending auto-Promotions of Freight
+		// that has since been superseded are aborted, which may permit this
+		// reconciliation loop to continue
+		cleared, err := r.abortSupersededAutoPromotionsFn(ctx, stage)
+		if err != nil {
+			return status, errors.Wrapf(
+				err,
+				"error aborting superseded auto-Promotions of Stage %q in "+
+					"namespace %q",
+				stage.Name,
+				stage.Namespace,
+			)
+		}
+		if !cleared {
+			logger.Debug(
+				"Stage has one or more Promotions in a non-terminal phase; " +
+					"skipping this reconciliation loop",
+			)
+			return status, nil
+		}
 	}
 
 	status.ObservedGeneration = stage.Generation
+	prevHealth := status.Health
 	status.Health = nil // Reset health
 	status.CurrentPromotion = nil
 
 	if status.CurrentFreight == nil {
 		logger.Debug("Stage has no current Freight; no health checks to perform")
+		meta.RemoveStatusCondition(
+			&status.Conditions,
+			kargoapi.StageConditionTypeDrifted,
+		)
+		meta.RemoveStatusCondition(
+			&status.Conditions,
+			kargoapi.StageConditionTypeFreightQualified,
+		)
+		status.Verifications = nil
 	} else { //  Check health and qualify current Freight if applicable
 		freightLogger := logger.WithField("freight", status.CurrentFreight.ID)
 
 		// Check health
-		status.Health = r.checkHealthFn(
+		status.Health = r.mergeProviderHealth(
 			ctx,
+			stage,
 			*status.CurrentFreight,
-			stage.Spec.PromotionMechanisms.ArgoCDAppUpdates,
+			r.checkHealthFn(
+				ctx,
+				*status.CurrentFreight,
+				stage.Spec.PromotionMechanisms.ArgoCDAppUpdates,
+			),
 		)
 		if status.Health != nil {
+			r.dampHealth(stage.Spec.HealthDamping, prevHealth, status.Health)
+			if status.Health.Status == kargoapi.HealthStateHealthy {
+				// Keep track of how long the Stage has been Healthy with its
+				// current Freight. The Promotion controller resets the Stage's
+				// health whenever it changes the current Freight.
+				if prevHealth != nil &&
+					prevHealth.Status == kargoapi.HealthStateHealthy &&
+					prevHealth.HealthySince != nil {
+					status.Health.HealthySince = prevHealth.HealthySince
+				} else {
+					status.Health.HealthySince = &metav1.Time{Time: r.nowFn()}
+				}
+			}
 			freightLogger.WithField("health", status.Health.Status).
 				Debug("Stage health assessed")
 		} else {
 			freightLogger.Debug("Stage health deemed not applicable")
 		}
 
-		// If health is not applicable or healthy, qualify the current Freight for
-		// this Stage
-		if status.Health == nil || status.Health.Status == kargoapi.HealthStateHealthy {
+		// Check for drift between the current Freight and what is actually
+		// running
+		if len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates) == 0 {
+			meta.RemoveStatusCondition(
+				&status.Conditions,
+				kargoapi.StageConditionTypeDrifted,
+			)
+		} else {
+			drifts := r.checkDriftFn(
+				ctx,
+				*status.CurrentFreight,
+				stage.Spec.PromotionMechanisms.ArgoCDAppUpdates,
+			)
+			r.updateDriftedCondition(stage, &status, drifts)
+			if len(drifts) > 0 {
+				healing, err := r.selfHealFn(ctx, stage, status.CurrentFreight.ID)
+				if err != nil {
+					return status, errors.Wrapf(
+						err,
+						"error self-healing Stage %q in namespace %q",
+						stage.Name,
+						stage.Namespace,
+					)
+				}
+				if healing {
+					freightLogger.Debug(
+						"Stage is being self-healed; skipping the remainder of this " +
+							"reconciliation loop",
+					)
+					return status, nil
+				}
+			}
+		}
+
+		// If the current Freight satisfies the Stage's qualification policy,
+		// qualify it for this Stage. Freight from another Project is never
+		// qualified, since qualifications only have meaning within the Project
+		// the Freight belongs to.
+		ownFreight := status.CurrentFreight.Project == ""
+		qualified, unmet, err := r.evaluateQualification(ctx, stage, &status)
+		if err != nil {
+			return status, errors.Wrapf(
+				err,
+				"error evaluating qualification of Freight %q in namespace %q for "+
+					"Stage %q",
+				status.CurrentFreight.ID,
+				stage.Namespace,
+				stage.Name,
+			)
+		}
+		if qualified && ownFreight {
 			if err := r.qualifyFreightFn(
 				ctx,
 				stage.Namespace,
@@ -471,8 +842,43 @@ func (r *reconciler) syncNormalStage(
 				)
 			}
 		}
+
+		// If the current Freight is unhealthy, revoke its qualification for this
+		// Stage so that it stops flowing downstream
+		if ownFreight && status.Health != nil &&
+			status.Health.Status == kargoapi.HealthStateUnhealthy {
+			if err := r.revokeFreightQualificationFn(
+				ctx,
+				stage.Namespace,
+				status.CurrentFreight.ID,
+				stage.Name,
+			); err != nil {
+				return status, errors.Wrapf(
+					err,
+					"error revoking qualification of Freight %q in namespace %q for "+
+						"Stage %q",
+					status.CurrentFreight.ID,
+					stage.Namespace,
+					stage.Name,
+				)
+			}
+		}
+
+		// Only Stages with their own qualification policy report on it
+		if stage.Spec.Qualification == nil {
+			meta.RemoveStatusCondition(
+				&status.Conditions,
+				kargoapi.StageConditionTypeFreightQualified,
+			)
+		} else {
+			updateFreightQualifiedCondition(stage, &status, unmet)
+		}
 	}
 
+	// Any hold on auto-promotion is re-established below if it still applies
+	prevHold := status.AutoPromotionHold
+	status.AutoPromotionHold = nil
+
 	// All of these conditions disqualify auto-promotion
 	if stage.Spec.Subscriptions == nil || // No subs at all
 		(stage.Spec.Subscriptions.Warehouse == "" && len(stage.Spec.Subscriptions.UpstreamStages) == 0) || // No subs at all
@@ -558,11 +964,107 @@ func (r *reconciler) syncNormalStage(
 		return status, nil
 	}
 
+	// Stale Freight is never auto-promoted
+	if tooOld, err := r.exceedsMaxFreightAgeFn(
+		ctx,
+		stage.Namespace,
+		stage.Name,
+		latestFreight,
+	); err != nil {
+		status.AutoPromotionHold = prevHold
+		return status, errors.Wrapf(
+			err,
+			"error checking age of Freight %q against the maximum permitted for "+
+				"Stage %q in namespace %q",
+			latestFreight.Name,
+			stage.Name,
+			stage.Namespace,
+		)
+	} else if tooOld {
+		logger.Debug(
+			"Freight exceeds the maximum age permitted by the Stage's " +
+				"PromotionPolicy; it will not be auto-promoted",
+		)
+		return status, nil
+	}
+
+	// Hold back auto-promotion, if so configured, so that Freight that becomes
+	// available in rapid succession is batched into a single Promotion
+	holdback, err :=
+		r.getAutoPromotionHoldbackFn(ctx, stage.Namespace, stage.Name)
+	if err != nil {
+		status.AutoPromotionHold = prevHold
+		return status, errors.Wrapf(
+			err,
+			"error determining auto-promotion holdback for Stage %q in namespace %q",
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+	if holdback > 0 {
+		now := r.nowFn()
+		hold := kargoapi.AutoPromotionHold{
+			Since: metav1.Time{Time: now},
+		}
+		if prevHold != nil {
+			hold.Since = prevHold.Since
+		}
+		hold.Until = metav1.Time{Time: hold.Since.Add(holdback)}
+		if now.Before(hold.Until.Time) {
+			logger.WithField("until", hold.Until.Time).
+				Debug("auto-promotion is being held back")
+			status.AutoPromotionHold = &hold
+			return status, nil
+		}
+	}
+
 	logger.Debug("auto-promotion will proceed")
 
 	promo := kargo.NewPromotion(*stage, latestFreight.ID)
+	if promo.Labels == nil {
+		promo.Labels = map[string]string{}
+	}
+	promo.Labels[kargoapi.LabelAutoPromotionKey] = kargoapi.LabelTrueValue
+
+	// If auto-Promotions are coalesced, note any Freight this Promotion
+	// supersedes so it can be recorded in the Stage's history
+	coalesce, err :=
+		r.isAutoPromotionCoalescingEnabledFn(ctx, stage.Namespace, stage.Name)
+	if err != nil {
+		status.AutoPromotionHold = prevHold
+		return status, errors.Wrapf(
+			err,
+			"error checking if auto-Promotions of Stage %q in namespace %q are "+
+				"coalesced",
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+	if coalesce {
+		superseded, err := r.getSupersededFreightFn(ctx, stage, latestFreight)
+		if err != nil {
+			status.AutoPromotionHold = prevHold
+			return status, errors.Wrapf(
+				err,
+				"error finding Freight superseded by Freight %q for Stage %q in "+
+					"namespace %q",
+				latestFreight.Name,
+				stage.Name,
+				stage.Namespace,
+			)
+		}
+		if len(superseded) > 0 {
+			if promo.Annotations == nil {
+				promo.Annotations = map[string]string{}
+			}
+			promo.Annotations[kargoapi.AnnotationKeySupersededFreight] =
+				strings.Join(superseded, ",")
+		}
+	}
 	if err :=
 		r.createPromotionFn(ctx, &promo, &client.CreateOptions{}); err != nil {
+		// Don't restart the holdback just because we failed to promote
+		status.AutoPromotionHold = prevHold
 		return status, errors.Wrapf(
 			err,
 			"error creating Promotion of Stage %q in namespace %q to Freight %q",
@@ -660,6 +1162,183 @@ func (r *reconciler) qualifyFreight(
 	return nil
 }
 
+// revokeFreightQualification revokes the specified Stage's qualification of
+// the specified Freight, if any. Because control flow Stages qualify Freight
+// solely on the basis of their upstream Stages having done so, qualifications
+// by downstream control flow Stages are revoked as well, unless they are still
+// warranted by another upstream Stage. If so configured, Pending Promotions of
+// the Freight that were automatically created for downstream Stages are also
+// deleted.
+func (r *reconciler) revokeFreightQualification(
+	ctx context.Context,
+	namespace string,
+	freightName string,
+	stageName string,
+) error {
+	freight, err := r.getFreightFn(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      freightName,
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error finding Freight %q in namespace %q; could not revoke its "+
+				"qualification for Stage %q",
+			freightName,
+			namespace,
+			stageName,
+		)
+	}
+	if freight == nil {
+		// Nothing to revoke
+		return nil
+	}
+	return r.revokeQualification(ctx, freight, stageName)
+}
+
+func (r *reconciler) revokeQualification(
+	ctx context.Context,
+	freight *kargoapi.Freight,
+	stageName string,
+) error {
+	logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"freight": freight.Name,
+		"stage":   stageName,
+	})
+
+	// Only try to revoke if actually qualified
+	if _, ok := freight.Status.Qualifications[stageName]; !ok {
+		return nil
+	}
+
+	newStatus := *freight.Status.DeepCopy()
+	delete(newStatus.Qualifications, stageName)
+	if err := r.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
+		return err
+	}
+	freight.Status = newStatus
+	logger.Debug("revoked Freight qualification for Stage")
+
+	downstreamStages := kargoapi.StageList{}
+	if err := r.listStagesFn(
+		ctx,
+		&downstreamStages,
+		&client.ListOptions{
+			Namespace: freight.Namespace,
+			FieldSelector: fields.OneTermEqualSelector(
+				kubeclient.StagesByUpstreamStagesIndexField,
+				stageName,
+			),
+		},
+	); err != nil {
+		return errors.Wrapf(
+			err,
+			"error listing Stages downstream from Stage %q in namespace %q",
+			stageName,
+			freight.Namespace,
+		)
+	}
+
+	for _, downstream := range downstreamStages.Items {
+		if r.cfg.AbortDownstreamAutoPromotionsOnFailure {
+			if err := r.abortPendingAutoPromotions(
+				ctx,
+				freight,
+				downstream.Name,
+			); err != nil {
+				return err
+			}
+		}
+		// Normal Stages qualify Freight on the basis of their own health, so only
+		// control flow Stages are of further interest
+		if downstream.Spec == nil || downstream.Spec.PromotionMechanisms != nil {
+			continue
+		}
+		if isQualifiedForAnyUpstreamStage(freight, downstream) {
+			continue
+		}
+		if err := r.revokeQualification(ctx, freight, downstream.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// abortPendingAutoPromotions deletes any Pending Promotions of the specified
+// Freight that were automatically created for the specified Stage.
+func (r *reconciler) abortPendingAutoPromotions(
+	ctx context.Context,
+	freight *kargoapi.Freight,
+	stageName string,
+) error {
+	promos := kargoapi.PromotionList{}
+	if err := r.listPromosFn(
+		ctx,
+		&promos,
+		&client.ListOptions{
+			Namespace: freight.Namespace,
+			FieldSelector: fields.Set(
+				map[string]string{
+					kubeclient.PromotionsByStageAndFreightIndexField: kubeclient.
+						StageAndFreightKey(stageName, freight.Name),
+				},
+			).AsSelector(),
+		},
+	); err != nil {
+		return errors.Wrapf(
+			err,
+			"error listing Promotions of Freight %q for Stage %q in namespace %q",
+			freight.Name,
+			stageName,
+			freight.Namespace,
+		)
+	}
+	for _, p := range promos.Items {
+		promo := p // Avoid implicit memory aliasing
+		if promo.Labels[kargoapi.LabelAutoPromotionKey] != kargoapi.LabelTrueValue {
+			continue
+		}
+		if promo.Status.Phase != "" &&
+			promo.Status.Phase != kargoapi.PromotionPhasePending {
+			continue
+		}
+		if err := r.deletePromotionFn(ctx, &promo); err != nil {
+			if err = client.IgnoreNotFound(err); err != nil {
+				return errors.Wrapf(
+					err,
+					"error deleting Promotion %q in namespace %q",
+					promo.Name,
+					promo.Namespace,
+				)
+			}
+		}
+		logging.LoggerFromContext(ctx).WithField("promotion", promo.Name).
+			Debug("deleted Pending Promotion of unhealthy Freight")
+	}
+	return nil
+}
+
+// isQualifiedForAnyUpstreamStage returns true if the specified Freight is
+// qualified for any of the specified Stage's upstream Stages.
+func isQualifiedForAnyUpstreamStage(
+	freight *kargoapi.Freight,
+	stage kargoapi.Stage,
+) bool {
+	if stage.Spec.Subscriptions == nil {
+		return false
+	}
+	for _, upstream := range stage.Spec.Subscriptions.UpstreamStages {
+		if _, ok := freight.Status.Qualifications[upstream.Name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *reconciler) patchFreightStatus(
 	ctx context.Context,
 	freight *kargoapi.Freight,
@@ -681,11 +1360,422 @@ func (r *reconciler) patchFreightStatus(
 	)
 }
 
+// updateDriftedCondition sets the Drifted condition of the provided
+// StageStatus according to the provided drift descriptions. A Warning event is
+// recorded for the Stage whenever new drift is detected.
+func (r *reconciler) updateDriftedCondition(
+	stage *kargoapi.Stage,
+	status *kargoapi.StageStatus,
+	drifts []string,
+) {
+	if len(drifts) == 0 {
+		meta.SetStatusCondition(
+			&status.Conditions,
+			metav1.Condition{
+				Type:               kargoapi.StageConditionTypeDrifted,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: stage.Generation,
+				Reason:             "NoDriftDetected",
+				Message: "Argo CD Applications are running the Stage's " +
+					"current Freight",
+			},
+		)
+		return
+	}
+	message := strings.Join(drifts, "; ")
+	var alreadyDrifted bool
+	if prev := meta.FindStatusCondition(
+		status.Conditions,
+		kargoapi.StageConditionTypeDrifted,
+	); prev != nil {
+		alreadyDrifted = prev.Status == metav1.ConditionTrue &&
+			prev.Message == message
+	}
+	meta.SetStatusCondition(
+		&status.Conditions,
+		metav1.Condition{
+			Type:               kargoapi.StageConditionTypeDrifted,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: stage.Generation,
+			Reason:             "LiveStateDiverged",
+			Message:            message,
+		},
+	)
+	if !alreadyDrifted {
+		r.recorder.Event(stage, corev1.EventTypeWarning, "Drifted", message)
+	}
+}
+
+// evaluateQualification evaluates the Stage's qualification policy against
+// the provided status of the Stage and its current Freight. If the policy is
+// not satisfied, a description of each unsatisfied criterion is also returned.
+func (r *reconciler) evaluateQualification(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	status *kargoapi.StageStatus,
+) (bool, []string, error) {
+	var approved bool
+	// Only bother looking up the Freight if the policy actually cares whether
+	// it was approved
+	if stage.Spec.Qualification.HasManualApprovalCriterion() {
+		freight, err := r.getFreightFn(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: stage.Namespace,
+				Name:      status.CurrentFreight.ID,
+			},
+		)
+		if err != nil {
+			return false, nil, err
+		}
+		if freight != nil {
+			_, approved = freight.Status.Approvals[stage.Name]
+		}
+	}
+	status.Verifications = r.verifyCurrentFreight(ctx, stage, *status)
+	qualified, unmet := stage.Spec.Qualification.Evaluate(
+		status.Health,
+		approved,
+		status.Verifications,
+		r.nowFn(),
+	)
+	return qualified, unmet, nil
+}
+
+// updateFreightQualifiedCondition records the outcome of evaluating a Stage's
+// qualification policy in the Stage's status.
+func updateFreightQualifiedCondition(
+	stage *kargoapi.Stage,
+	status *kargoapi.StageStatus,
+	unmet []string,
+) {
+	if len(unmet) == 0 {
+		meta.SetStatusCondition(
+			&status.Conditions,
+			metav1.Condition{
+				Type:               kargoapi.StageConditionTypeFreightQualified,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: stage.Generation,
+				Reason:             "CriteriaSatisfied",
+				Message: "The Stage's current Freight satisfies the Stage's " +
+					"qualification criteria",
+			},
+		)
+		return
+	}
+	meta.SetStatusCondition(
+		&status.Conditions,
+		metav1.Condition{
+			Type:               kargoapi.StageConditionTypeFreightQualified,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: stage.Generation,
+			Reason:             "CriteriaNotSatisfied",
+			Message:            strings.Join(unmet, "; "),
+		},
+	)
+}
+
+// selfHeal creates a new Promotion of the specified Freight for the specified
+// Stage if self-healing is permitted for the Stage and the Stage has not
+// already been self-healed too recently. A bool is returned indicating whether
+// such a Promotion was created.
+func (r *reconciler) selfHeal(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freightID string,
+) (bool, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("freight", freightID)
+
+	permitted, err := r.isSelfHealPermittedFn(ctx, stage.Namespace, stage.Name)
+	if err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error checking if self-heal is permitted for Stage %q in namespace %q",
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+	if !permitted {
+		logger.Debug("self-heal is not permitted for the Stage")
+		return false, nil
+	}
+
+	// Rate limit self-healing by looking for recent self-healing Promotions of
+	// the same Freight
+	promos := kargoapi.PromotionList{}
+	if err = r.listPromosFn(
+		ctx,
+		&promos,
+		&client.ListOptions{
+			Namespace: stage.Namespace,
+			FieldSelector: fields.Set(
+				map[string]string{
+					kubeclient.PromotionsByStageAndFreightIndexField: kubeclient.
+						StageAndFreightKey(stage.Name, freightID),
+				},
+			).AsSelector(),
+			LabelSelector: labels.SelectorFromSet(
+				map[string]string{
+					kargoapi.LabelSelfHealKey: kargoapi.LabelTrueValue,
+				},
+			),
+		},
+	); err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error listing existing self-healing Promotions for Freight %q in "+
+				"namespace %q",
+			freightID,
+			stage.Namespace,
+		)
+	}
+	now := r.nowFn()
+	for _, promo := range promos.Items {
+		if now.Sub(promo.CreationTimestamp.Time) < r.cfg.SelfHealMinInterval {
+			logger.WithField("promotion", promo.Name).Debug(
+				"Stage was self-healed too recently to be self-healed again",
+			)
+			return false, nil
+		}
+	}
+
+	promo := kargo.NewPromotion(*stage, freightID)
+	if promo.Labels == nil {
+		promo.Labels = map[string]string{}
+	}
+	promo.Labels[kargoapi.LabelSelfHealKey] = kargoapi.LabelTrueValue
+	if err =
+		r.createPromotionFn(ctx, &promo, &client.CreateOptions{}); err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error creating Promotion of Stage %q in namespace %q to Freight %q",
+			stage.Name,
+			stage.Namespace,
+			freightID,
+		)
+	}
+	logger.WithField("promotion", promo.Name).
+		Debug("created self-healing Promotion resource")
+	r.recorder.Eventf(
+		stage,
+		corev1.EventTypeNormal,
+		"SelfHealing",
+		"Created Promotion %q to restore Freight %q",
+		promo.Name,
+		freightID,
+	)
+	return true, nil
+}
+
 func (r *reconciler) isAutoPromotionPermitted(
 	ctx context.Context,
 	namespace string,
 	stageName string,
 ) (bool, error) {
+	logger := logging.LoggerFromContext(ctx)
+	policy, err := r.getPromotionPolicy(ctx, namespace, stageName)
+	if err != nil || policy == nil {
+		return false, err
+	}
+	if !policy.EnableAutoPromotion {
+		logger.Debug(
+			"PromotionPolicy does not enable auto-promotion for the Stage",
+		)
+		return false, nil
+	}
+	return true, nil
+}
+
+// getAutoPromotionHoldback returns the length of time for which auto-promotion
+// of the specified Stage should be held back, as specified by its
+// PromotionPolicy. Zero is returned if no holdback is specified.
+func (r *reconciler) getAutoPromotionHoldback(
+	ctx context.Context,
+	namespace string,
+	stageName string,
+) (time.Duration, error) {
+	policy, err := r.getPromotionPolicy(ctx, namespace, stageName)
+	if err != nil || policy == nil || policy.AutoPromotionHoldback == nil {
+		return 0, err
+	}
+	return policy.AutoPromotionHoldback.Duration, nil
+}
+
+// exceedsMaxFreightAge returns true if the provided Freight is older than the
+// maximum age permitted for the specified Stage by its PromotionPolicy.
+func (r *reconciler) exceedsMaxFreightAge(
+	ctx context.Context,
+	namespace string,
+	stageName string,
+	freight *kargoapi.Freight,
+) (bool, error) {
+	policy, err := r.getPromotionPolicy(ctx, namespace, stageName)
+	if err != nil {
+		return false, err
+	}
+	return policy.ExceedsMaxFreightAge(freight, r.nowFn()), nil
+}
+
+// isAutoPromotionCoalescingEnabled returns true if the PromotionPolicy
+// associated with the specified Stage enables the coalescing of
+// auto-Promotions.
+func (r *reconciler) isAutoPromotionCoalescingEnabled(
+	ctx context.Context,
+	namespace string,
+	stageName string,
+) (bool, error) {
+	policy, err := r.getPromotionPolicy(ctx, namespace, stageName)
+	if err != nil || policy == nil {
+		return false, err
+	}
+	return policy.EnableAutoPromotion && policy.CoalesceAutoPromotions, nil
+}
+
+// abortSupersededAutoPromotions deletes any Pending auto-Promotions of the
+// provided Stage to Freight other than the latest Freight available to it,
+// provided the Stage's PromotionPolicy enables the coalescing of
+// auto-Promotions. It returns true if, as a result, the Stage no longer has any
+// Promotions in a non-terminal phase.
+func (r *reconciler) abortSupersededAutoPromotions(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) (bool, error) {
+	if stage.Spec.Subscriptions == nil {
+		return false, nil
+	}
+	coalesce, err :=
+		r.isAutoPromotionCoalescingEnabledFn(ctx, stage.Namespace, stage.Name)
+	if err != nil || !coalesce {
+		return false, err
+	}
+	latestFreight, err :=
+		r.getLatestAvailableFreightFn(ctx, stage.Namespace, *stage.Spec.Subscriptions)
+	if err != nil || latestFreight == nil {
+		return false, err
+	}
+	promos := kargoapi.PromotionList{}
+	if err = r.listPromosFn(
+		ctx,
+		&promos,
+		&client.ListOptions{
+			Namespace: stage.Namespace,
+			FieldSelector: fields.Set(map[string]string{
+				kubeclient.NonTerminalPromotionsByStageIndexField: stage.Name,
+			}).AsSelector(),
+		},
+	); err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error listing Promotions in non-terminal phases for Stage %q in "+
+				"namespace %q",
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+	cleared := true
+	for _, p := range promos.Items {
+		promo := p // Avoid implicit memory aliasing
+		if promo.Labels[kargoapi.LabelAutoPromotionKey] != kargoapi.LabelTrueValue ||
+			(promo.Status.Phase != "" &&
+				promo.Status.Phase != kargoapi.PromotionPhasePending) ||
+			promo.Spec.Freight == latestFreight.Name {
+			cleared = false
+			continue
+		}
+		// The precondition ensures we don't delete a Promotion that began
+		// running after we listed it
+		if err = r.deletePromotionFn(
+			ctx,
+			&promo,
+			client.Preconditions{ResourceVersion: &promo.ResourceVersion},
+		); err != nil {
+			if apierrors.IsConflict(err) {
+				cleared = false
+				continue
+			}
+			if err = client.IgnoreNotFound(err); err != nil {
+				return false, errors.Wrapf(
+					err,
+					"error deleting Promotion %q in namespace %q",
+					promo.Name,
+					promo.Namespace,
+				)
+			}
+		}
+		logging.LoggerFromContext(ctx).WithField("promotion", promo.Name).
+			Debug("deleted Pending auto-Promotion of superseded Freight")
+	}
+	return cleared, nil
+}
+
+// getSupersededFreight returns the IDs of all Freight available to the
+// provided Stage that is newer than the Stage's current Freight, but older than
+// the provided latest Freight, from newest to oldest. If the Stage has no
+// current Freight, or its current Freight is not among the Freight available to
+// it, nothing is returned.
+func (r *reconciler) getSupersededFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	latestFreight *kargoapi.Freight,
+) ([]string, error) {
+	if stage.Status.CurrentFreight == nil {
+		return nil, nil
+	}
+	subs := stage.Spec.Subscriptions
+	var available []kargoapi.Freight
+	var err error
+	if subs.Warehouse != "" {
+		available, err =
+			r.getAllFreightFromWarehouseFn(ctx, stage.Namespace, subs.Warehouse)
+	} else {
+		available, err = r.getAllFreightQualifiedForUpstreamStagesFn(
+			ctx,
+			stage.Namespace,
+			subs.UpstreamStages,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Available Freight is sorted from newest to oldest
+	var superseded []string
+	for _, freight := range available {
+		if freight.Name == stage.Status.CurrentFreight.ID {
+			return superseded, nil
+		}
+		if freight.Name != latestFreight.Name {
+			superseded = append(superseded, freight.Name)
+		}
+	}
+	return nil, nil
+}
+
+func (r *reconciler) isSelfHealPermitted(
+	ctx context.Context,
+	namespace string,
+	stageName string,
+) (bool, error) {
+	logger := logging.LoggerFromContext(ctx)
+	policy, err := r.getPromotionPolicy(ctx, namespace, stageName)
+	if err != nil || policy == nil {
+		return false, err
+	}
+	if !policy.EnableSelfHeal {
+		logger.Debug("PromotionPolicy does not enable self-heal for the Stage")
+		return false, nil
+	}
+	return true, nil
+}
+
+// getPromotionPolicy returns the PromotionPolicy associated with the specified
+// Stage. If no PromotionPolicy, or more than one PromotionPolicy, is associated
+// with the Stage, nil is returned.
+func (r *reconciler) getPromotionPolicy(
+	ctx context.Context,
+	namespace string,
+	stageName string,
+) (*kargoapi.PromotionPolicy, error) {
 	logger := logging.LoggerFromContext(ctx)
 	policies := kargoapi.PromotionPolicyList{}
 	if err := r.listPromoPoliciesFn(
@@ -698,7 +1788,7 @@ func (r *reconciler) isAutoPromotionPermitted(
 			}).AsSelector(),
 		},
 	); err != nil {
-		return false, errors.Wrapf(
+		return nil, errors.Wrapf(
 			err,
 			"error listing PromotionPolicies for Stage %q in namespace %q",
 			stageName,
@@ -707,19 +1797,13 @@ func (r *reconciler) isAutoPromotionPermitted(
 	}
 	if len(policies.Items) == 0 {
 		logger.Debug("no PromotionPolicy is associated with the Stage")
-		return false, nil
+		return nil, nil
 	}
 	if len(policies.Items) > 1 {
 		logger.Debug("multiple PromotionPolicies are associated with the Stage")
-		return false, nil
-	}
-	if !policies.Items[0].EnableAutoPromotion {
-		logger.Debug(
-			"PromotionPolicy does not enable auto-promotion for the Stage",
-		)
-		return false, nil
+		return nil, nil
 	}
-	return true, nil
+	return &policies.Items[0], nil
 }
 
 func (r *reconciler) getLatestAvailableFreight(
@@ -7,35 +7,67 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/runtime"
+	"github.com/akuity/kargo/internal/kubeclient"
 )
 
 func TestNewReconciler(t *testing.T) {
 	kubeClient := fake.NewClientBuilder().Build()
+	recorder := record.NewFakeRecorder(1)
 	e := newReconciler(
 		kubeClient,
 		kubeClient,
+		recorder,
+		nil,
+		ReconcilerConfig{},
+		runtime.RealClock,
 	)
 	require.NotNil(t, e.kargoClient)
 	require.NotNil(t, e.argoClient)
+	require.NotNil(t, e.recorder)
 	// Assert that all overridable behaviors were initialized to a default:
+	// Archival:
+	require.NotNil(t, e.isProjectArchivedFn)
 	// Loop guard:
 	require.NotNil(t, e.hasNonTerminalPromotionsFn)
 	require.NotNil(t, e.listPromosFn)
 	// Health checks:
 	require.NotNil(t, e.checkHealthFn)
+	require.NotNil(t, e.checkProviderHealthFn)
 	require.NotNil(t, e.getArgoCDAppFn)
+	// Drift detection:
+	require.NotNil(t, e.checkDriftFn)
+	// Self-healing:
+	require.NotNil(t, e.selfHealFn)
+	require.NotNil(t, e.isSelfHealPermittedFn)
+	require.NotNil(t, e.nowFn)
+	require.NotNil(t, e.requeueAfterFn)
 	// Freight qualification:
 	require.NotNil(t, e.getFreightFn)
 	require.NotNil(t, e.qualifyFreightFn)
 	require.NotNil(t, e.patchFreightStatusFn)
+	// Freight verification:
+	require.NotNil(t, e.verifyFn)
+	// Freight qualification revocation:
+	require.NotNil(t, e.revokeFreightQualificationFn)
+	require.NotNil(t, e.listStagesFn)
+	require.NotNil(t, e.deletePromotionFn)
 	// Auto-promotion:
 	require.NotNil(t, e.isAutoPromotionPermittedFn)
+	require.NotNil(t, e.getAutoPromotionHoldbackFn)
+	require.NotNil(t, e.exceedsMaxFreightAgeFn)
+	require.NotNil(t, e.isAutoPromotionCoalescingEnabledFn)
+	require.NotNil(t, e.abortSupersededAutoPromotionsFn)
+	require.NotNil(t, e.getSupersededFreightFn)
 	require.NotNil(t, e.listPromoPoliciesFn)
 	require.NotNil(t, e.createPromotionFn)
 	// Discovering latest Freight:
@@ -47,6 +79,69 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, e.listFreightFn)
 }
 
+func TestGetRequeueInterval(t *testing.T) {
+	require.Equal(
+		t,
+		defaultRequeueInterval,
+		getRequeueInterval(&kargoapi.Stage{Spec: &kargoapi.StageSpec{}}),
+	)
+	require.Equal(
+		t,
+		30*time.Second,
+		getRequeueInterval(&kargoapi.Stage{
+			Spec: &kargoapi.StageSpec{
+				ReconcileInterval: &metav1.Duration{Duration: 30 * time.Second},
+			},
+		}),
+	)
+}
+
+func TestProviderURLMapDecode(t *testing.T) {
+	testCases := []struct {
+		name       string
+		value      string
+		assertions func(ProviderURLMap, error)
+	}{
+		{
+			name: "empty",
+			assertions: func(urls ProviderURLMap, err error) {
+				require.NoError(t, err)
+				require.Empty(t, urls)
+			},
+		},
+		{
+			name:  "invalid item",
+			value: "fake-provider",
+			assertions: func(_ ProviderURLMap, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid map item")
+			},
+		},
+		{
+			name:  "success",
+			value: "foo=http://foo.example.com:8080, bar=https://bar.example.com,",
+			assertions: func(urls ProviderURLMap, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					ProviderURLMap{
+						"foo": "http://foo.example.com:8080",
+						"bar": "https://bar.example.com",
+					},
+					urls,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var urls ProviderURLMap
+			err := urls.Decode(testCase.value)
+			testCase.assertions(urls, err)
+		})
+	}
+}
+
 func TestSyncControlFlowStage(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -228,6 +323,28 @@ func TestSyncNormalStage(t *testing.T) {
 	) (bool, error) {
 		return false, nil
 	}
+	noAutoPromotionHoldbackFn := func(
+		context.Context,
+		string,
+		string,
+	) (time.Duration, error) {
+		return 0, nil
+	}
+	noMaxFreightAgeFn := func(
+		context.Context,
+		string,
+		string,
+		*kargoapi.Freight,
+	) (bool, error) {
+		return false, nil
+	}
+	noAutoPromotionCoalescingFn := func(
+		context.Context,
+		string,
+		string,
+	) (bool, error) {
+		return false, nil
+	}
 
 	testCases := []struct {
 		name       string
@@ -263,6 +380,41 @@ func TestSyncNormalStage(t *testing.T) {
 			},
 		},
 
+		{
+			name:  "error aborting superseded auto-Promotions",
+			stage: &kargoapi.Stage{},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				abortSupersededAutoPromotionsFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) (bool, error) {
+					return false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(
+					t,
+					err.Error(),
+					"error aborting superseded auto-Promotions",
+				)
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
 		{
 			name:  "non-terminal promotions found",
 			stage: &kargoapi.Stage{},
@@ -274,6 +426,12 @@ func TestSyncNormalStage(t *testing.T) {
 				) (bool, error) {
 					return true, nil
 				},
+				abortSupersededAutoPromotionsFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) (bool, error) {
+					return false, nil
+				},
 			},
 			assertions: func(
 				initialStatus kargoapi.StageStatus,
@@ -322,6 +480,47 @@ func TestSyncNormalStage(t *testing.T) {
 			},
 		},
 
+		{
+			name: "error revoking Freight qualification",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return &kargoapi.Health{
+						Status: kargoapi.HealthStateUnhealthy,
+					}
+				},
+				revokeFreightQualificationFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error revoking qualification of Freight")
+			},
+		},
+
 		{
 			name: "auto-promotion not possible",
 			stage: &kargoapi.Stage{
@@ -684,7 +883,7 @@ func TestSyncNormalStage(t *testing.T) {
 		},
 
 		{
-			name: "error creating Promotion",
+			name: "error determining auto-promotion holdback",
 			stage: &kargoapi.Stage{
 				Spec: &kargoapi.StageSpec{
 					Subscriptions: &kargoapi.Subscriptions{
@@ -730,12 +929,13 @@ func TestSyncNormalStage(t *testing.T) {
 				) error {
 					return nil
 				},
-				createPromotionFn: func(
+				exceedsMaxFreightAgeFn: noMaxFreightAgeFn,
+				getAutoPromotionHoldbackFn: func(
 					context.Context,
-					client.Object,
-					...client.CreateOption,
-				) error {
-					return errors.New("something went wrong")
+					string,
+					string,
+				) (time.Duration, error) {
+					return 0, errors.New("something went wrong")
 				},
 			},
 			assertions: func(
@@ -745,30 +945,25 @@ func TestSyncNormalStage(t *testing.T) {
 			) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "something went wrong")
-				require.Contains(t, err.Error(), "error creating Promotion of Stage")
+				require.Contains(
+					t,
+					err.Error(),
+					"error determining auto-promotion holdback",
+				)
 				// Status should be returned unchanged
 				require.Equal(t, initialStatus, newStatus)
 			},
 		},
 
 		{
-			name: "success",
-			// Note: In this final case, we will also assert than anything that should
-			// have been cleared or modified in the Stage's status was.
+			name: "Freight exceeds maximum age",
 			stage: &kargoapi.Stage{
-				ObjectMeta: metav1.ObjectMeta{
-					Generation: 42,
-				},
 				Spec: &kargoapi.StageSpec{
 					Subscriptions: &kargoapi.Subscriptions{
 						Warehouse: "fake-warehouse",
 					},
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
-				Status: kargoapi.StageStatus{
-					CurrentPromotion: &kargoapi.PromotionInfo{},
-					CurrentFreight:   &kargoapi.SimpleFreight{},
-				},
 			},
 			reconciler: &reconciler{
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
@@ -777,9 +972,7 @@ func TestSyncNormalStage(t *testing.T) {
 					kargoapi.SimpleFreight,
 					[]kargoapi.ArgoCDAppUpdate,
 				) *kargoapi.Health {
-					return &kargoapi.Health{
-						Status: kargoapi.HealthStateHealthy,
-					}
+					return nil
 				},
 				qualifyFreightFn: func(context.Context, string, string, string) error {
 					return nil
@@ -809,87 +1002,1733 @@ func TestSyncNormalStage(t *testing.T) {
 				) error {
 					return nil
 				},
+				exceedsMaxFreightAgeFn: func(
+					context.Context,
+					string,
+					string,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return true, nil
+				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
 					...client.CreateOption,
 				) error {
+					require.Fail(t, "Promotion should not have been created")
 					return nil
 				},
 			},
 			assertions: func(
-				initialStatus kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
 				newStatus kargoapi.StageStatus,
 				err error,
 			) {
 				require.NoError(t, err)
-				require.Equal(t, int64(42), newStatus.ObservedGeneration) // Set
-				require.NotNil(t, newStatus.Health)                       // Set
-				require.Nil(t, newStatus.CurrentPromotion)                // Cleared
+				require.Nil(t, newStatus.AutoPromotionHold)
 			},
 		},
-	}
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			newStatus, err :=
-				testCase.reconciler.syncNormalStage(context.Background(), testCase.stage)
-			testCase.assertions(testCase.stage.Status, newStatus, err)
-		})
-	}
-}
 
-func TestHasNonTerminalPromotions(t *testing.T) {
-	testCases := []struct {
-		name       string
-		reconciler *reconciler
-		assertions func(bool, error)
-	}{
 		{
-			name: "error listing Promotions",
+			name: "auto-promotion held back",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
 			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return nil
+				},
+				qualifyFreightFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					kargoapi.Subscriptions,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
 				listPromosFn: func(
 					context.Context,
 					client.ObjectList,
 					...client.ListOption,
 				) error {
-					return errors.New("something went wrong")
+					return nil
 				},
-			},
-			assertions: func(_ bool, err error) {
-				require.Error(t, err)
-				require.Contains(t, err.Error(), "something went wrong")
-			},
-		},
-		{
-			name: "has non-terminal Promotions",
-			reconciler: &reconciler{
-				listPromosFn: func(
-					_ context.Context,
-					objList client.ObjectList,
-					_ ...client.ListOption,
+				exceedsMaxFreightAgeFn: noMaxFreightAgeFn,
+				getAutoPromotionHoldbackFn: func(
+					context.Context,
+					string,
+					string,
+				) (time.Duration, error) {
+					return time.Hour, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
 				) error {
-					promos, ok := objList.(*kargoapi.PromotionList)
-					require.True(t, ok)
-					promos.Items = []kargoapi.Promotion{{}}
+					require.Fail(t, "Promotion should not have been created")
 					return nil
 				},
 			},
-			assertions: func(result bool, err error) {
+			assertions: func(
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
 				require.NoError(t, err)
-				require.True(t, result)
+				hold := newStatus.AutoPromotionHold
+				require.NotNil(t, hold)
+				require.Equal(t, time.Hour, hold.Until.Sub(hold.Since.Time))
+				require.True(t, hold.Until.After(time.Now()))
 			},
 		},
+
 		{
-			name: "does not have non-terminal Promotions",
+			name: "auto-promotion holdback elapsed",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					AutoPromotionHold: &kargoapi.AutoPromotionHold{
+						Since: metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+						Until: metav1.Time{Time: time.Now().Add(-time.Hour)},
+					},
+				},
+			},
 			reconciler: &reconciler{
-				listPromosFn: func(
-					_ context.Context,
-					objList client.ObjectList,
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return nil
+				},
+				qualifyFreightFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					kargoapi.Subscriptions,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				exceedsMaxFreightAgeFn: noMaxFreightAgeFn,
+				getAutoPromotionHoldbackFn: func(
+					context.Context,
+					string,
+					string,
+				) (time.Duration, error) {
+					return time.Hour, nil
+				},
+				isAutoPromotionCoalescingEnabledFn: noAutoPromotionCoalescingFn,
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Nil(t, newStatus.AutoPromotionHold) // Cleared
+			},
+		},
+
+		{
+			name: "coalesced auto-Promotion",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return nil
+				},
+				qualifyFreightFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					kargoapi.Subscriptions,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				exceedsMaxFreightAgeFn:     noMaxFreightAgeFn,
+				getAutoPromotionHoldbackFn: noAutoPromotionHoldbackFn,
+				isAutoPromotionCoalescingEnabledFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getSupersededFreightFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Freight,
+				) ([]string, error) {
+					return []string{"fake-freight-2", "fake-freight-1"}, nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					require.Equal(
+						t,
+						"fake-freight-2,fake-freight-1",
+						obj.GetAnnotations()[kargoapi.AnnotationKeySupersededFreight],
+					)
+					return nil
+				},
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+			},
+		},
+
+		{
+			name: "error creating Promotion",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return nil
+				},
+				qualifyFreightFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					kargoapi.Subscriptions,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				exceedsMaxFreightAgeFn:             noMaxFreightAgeFn,
+				getAutoPromotionHoldbackFn:         noAutoPromotionHoldbackFn,
+				isAutoPromotionCoalescingEnabledFn: noAutoPromotionCoalescingFn,
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error creating Promotion of Stage")
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
+		{
+			name: "success",
+			// Note: In this final case, we will also assert than anything that should
+			// have been cleared or modified in the Stage's status was.
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 42,
+				},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentPromotion: &kargoapi.PromotionInfo{},
+					CurrentFreight:   &kargoapi.SimpleFreight{},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					}
+				},
+				qualifyFreightFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					kargoapi.Subscriptions,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				exceedsMaxFreightAgeFn:             noMaxFreightAgeFn,
+				getAutoPromotionHoldbackFn:         noAutoPromotionHoldbackFn,
+				isAutoPromotionCoalescingEnabledFn: noAutoPromotionCoalescingFn,
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, int64(42), newStatus.ObservedGeneration) // Set
+				require.NotNil(t, newStatus.Health)                       // Set
+				require.Nil(t, newStatus.CurrentPromotion)                // Cleared
+			},
+		},
+		{
+			name: "Freight qualified per Stage's qualification policy",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Qualification: &kargoapi.QualificationPolicy{
+						Criteria: []kargoapi.QualificationCriterion{
+							{
+								Healthy: &kargoapi.HealthyCriterion{
+									For: &metav1.Duration{Duration: time.Hour},
+								},
+							},
+						},
+					},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{},
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+						HealthySince: &metav1.Time{
+							Time: time.Now().Add(-2 * time.Hour),
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					}
+				},
+				qualifyFreightFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, newStatus.Health)
+				// HealthySince should be carried over
+				require.Equal(
+					t,
+					initialStatus.Health.HealthySince,
+					newStatus.Health.HealthySince,
+				)
+				cond := meta.FindStatusCondition(
+					newStatus.Conditions,
+					kargoapi.StageConditionTypeFreightQualified,
+				)
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionTrue, cond.Status)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.reconciler.nowFn = time.Now
+			newStatus, err :=
+				testCase.reconciler.syncNormalStage(context.Background(), testCase.stage)
+			testCase.assertions(testCase.stage.Status, newStatus, err)
+		})
+	}
+}
+
+func TestHasNonTerminalPromotions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(bool, error)
+	}{
+		{
+			name: "error listing Promotions",
+			reconciler: &reconciler{
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "has non-terminal Promotions",
+			reconciler: &reconciler{
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{{}}
+					return nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.True(t, result)
+			},
+		},
+		{
+			name: "does not have non-terminal Promotions",
+			reconciler: &reconciler{
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{}
+					return nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := testCase.reconciler.hasNonTerminalPromotions(
+				context.Background(),
+				"fake-namespace",
+				"fake-stage",
+			)
+			testCase.assertions(result, err)
+		})
+	}
+}
+
+func TestQualifyFreight(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(error)
+	}{
+		{
+			name: "error getting Freight",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error finding Freight")
+			},
+		},
+		{
+			name: "Freight not found",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "found no Freight")
+			},
+		},
+		{
+			name: "Freight already qualified for Stage",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							Qualifications: map[string]kargoapi.Qualification{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error Patching Freight status",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.reconciler.qualifyFreight(
+					context.Background(),
+					"fake-namespace",
+					"fake-freight",
+					"fake-stage",
+				),
+			)
+		})
+	}
+}
+
+func TestRevokeFreightQualification(t *testing.T) {
+	testFreight := &kargoapi.Freight{
+		Status: kargoapi.FreightStatus{
+			Qualifications: map[string]kargoapi.Qualification{
+				"fake-stage":              {},
+				"fake-control-flow-stage": {},
+				"fake-normal-stage":       {},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(error)
+	}{
+		{
+			name: "error getting Freight",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error finding Freight")
+			},
+		},
+		{
+			name: "Freight not found",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Freight not qualified for Stage",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error patching Freight status",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							Qualifications: map[string]kargoapi.Qualification{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "error listing downstream Stages",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							Qualifications: map[string]kargoapi.Qualification{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return nil
+				},
+				listStagesFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error listing Stages downstream")
+			},
+		},
+		{
+			name: "error deleting Pending auto-Promotion",
+			reconciler: &reconciler{
+				cfg: ReconcilerConfig{
+					AbortDownstreamAutoPromotionsOnFailure: true,
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							Qualifications: map[string]kargoapi.Qualification{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return nil
+				},
+				listStagesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					stages := objList.(*kargoapi.StageList) // nolint: forcetypeassert
+					stages.Items = []kargoapi.Stage{{}}
+					return nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos := objList.(*kargoapi.PromotionList) // nolint: forcetypeassert
+					promos.Items = []kargoapi.Promotion{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{
+									kargoapi.LabelAutoPromotionKey: kargoapi.LabelTrueValue,
+								},
+							},
+						},
+					}
+					return nil
+				},
+				deletePromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error deleting Promotion")
+			},
+		},
+		{
+			name: "success",
+			reconciler: &reconciler{
+				cfg: ReconcilerConfig{
+					AbortDownstreamAutoPromotionsOnFailure: true,
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return testFreight, nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return nil
+				},
+				listStagesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					opts ...client.ListOption,
+				) error {
+					listOpts := &client.ListOptions{}
+					listOpts.ApplyOptions(opts)
+					if !listOpts.FieldSelector.Matches(fields.Set{
+						kubeclient.StagesByUpstreamStagesIndexField: "fake-stage",
+					}) {
+						return nil
+					}
+					stages := objList.(*kargoapi.StageList) // nolint: forcetypeassert
+					stages.Items = []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-control-flow-stage",
+							},
+							Spec: &kargoapi.StageSpec{
+								Subscriptions: &kargoapi.Subscriptions{
+									UpstreamStages: []kargoapi.StageSubscription{
+										{
+											Name: "fake-stage",
+										},
+									},
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-normal-stage",
+							},
+							Spec: &kargoapi.StageSpec{
+								Subscriptions: &kargoapi.Subscriptions{
+									UpstreamStages: []kargoapi.StageSubscription{
+										{
+											Name: "fake-stage",
+										},
+									},
+								},
+								PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+							},
+						},
+					}
+					return nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos := objList.(*kargoapi.PromotionList) // nolint: forcetypeassert
+					promos.Items = []kargoapi.Promotion{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-auto-promotion",
+								Labels: map[string]string{
+									kargoapi.LabelAutoPromotionKey: kargoapi.LabelTrueValue,
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-manual-promotion",
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-running-auto-promotion",
+								Labels: map[string]string{
+									kargoapi.LabelAutoPromotionKey: kargoapi.LabelTrueValue,
+								},
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseRunning,
+							},
+						},
+					}
+					return nil
+				},
+				deletePromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.DeleteOption,
+				) error {
+					require.Equal(t, "fake-auto-promotion", obj.GetName())
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+				// Qualifications derived from the unhealthy Stage are revoked, but
+				// normal downstream Stages' own qualifications are left alone
+				require.Equal(
+					t,
+					map[string]kargoapi.Qualification{
+						"fake-normal-stage": {},
+					},
+					testFreight.Status.Qualifications,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.reconciler.revokeFreightQualification(
+					context.Background(),
+					"fake-namespace",
+					"fake-freight",
+					"fake-stage",
+				),
+			)
+		})
+	}
+}
+
+func TestUpdateDriftedCondition(t *testing.T) {
+	testCases := []struct {
+		name       string
+		conditions []metav1.Condition
+		drifts     []string
+		assertions func([]metav1.Condition, *record.FakeRecorder)
+	}{
+		{
+			name: "no drift",
+			assertions: func(
+				conditions []metav1.Condition,
+				recorder *record.FakeRecorder,
+			) {
+				require.Len(t, conditions, 1)
+				require.Equal(
+					t,
+					kargoapi.StageConditionTypeDrifted,
+					conditions[0].Type,
+				)
+				require.Equal(t, metav1.ConditionFalse, conditions[0].Status)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name:   "new drift",
+			drifts: []string{"fake-drift", "another-fake-drift"},
+			assertions: func(
+				conditions []metav1.Condition,
+				recorder *record.FakeRecorder,
+			) {
+				require.Len(t, conditions, 1)
+				require.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+				require.Equal(
+					t,
+					"fake-drift; another-fake-drift",
+					conditions[0].Message,
+				)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Contains(t, event, "Drifted")
+				require.Contains(t, event, "fake-drift; another-fake-drift")
+			},
+		},
+		{
+			name: "drift already recorded",
+			conditions: []metav1.Condition{
+				{
+					Type:    kargoapi.StageConditionTypeDrifted,
+					Status:  metav1.ConditionTrue,
+					Reason:  "LiveStateDiverged",
+					Message: "fake-drift",
+				},
+			},
+			drifts: []string{"fake-drift"},
+			assertions: func(
+				conditions []metav1.Condition,
+				recorder *record.FakeRecorder,
+			) {
+				require.Len(t, conditions, 1)
+				require.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "drift resolved",
+			conditions: []metav1.Condition{
+				{
+					Type:    kargoapi.StageConditionTypeDrifted,
+					Status:  metav1.ConditionTrue,
+					Reason:  "LiveStateDiverged",
+					Message: "fake-drift",
+				},
+			},
+			assertions: func(
+				conditions []metav1.Condition,
+				recorder *record.FakeRecorder,
+			) {
+				require.Len(t, conditions, 1)
+				require.Equal(t, metav1.ConditionFalse, conditions[0].Status)
+				require.Empty(t, recorder.Events)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			r := &reconciler{
+				recorder: recorder,
+			}
+			status := kargoapi.StageStatus{
+				Conditions: testCase.conditions,
+			}
+			r.updateDriftedCondition(&kargoapi.Stage{}, &status, testCase.drifts)
+			testCase.assertions(status.Conditions, recorder)
+		})
+	}
+}
+
+func TestEvaluateQualification(t *testing.T) {
+	testStatus := kargoapi.StageStatus{
+		CurrentFreight: &kargoapi.SimpleFreight{
+			ID: "fake-freight",
+		},
+	}
+	manualApprovalPolicy := &kargoapi.QualificationPolicy{
+		Criteria: []kargoapi.QualificationCriterion{
+			{
+				ManualApproval: true,
+			},
+		},
+	}
+	verificationPolicy := &kargoapi.QualificationPolicy{
+		Criteria: []kargoapi.QualificationCriterion{
+			{
+				Verification: &kargoapi.VerificationCriterion{
+					Provider: "fake-provider",
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name         string
+		policy       *kargoapi.QualificationPolicy
+		status       *kargoapi.StageStatus
+		getFreightFn func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Freight, error)
+		verifyFn func(
+			context.Context,
+			*kargoapi.Stage,
+			kargoapi.SimpleFreight,
+			kargoapi.VerificationCriterion,
+		) kargoapi.VerificationResult
+		assertions func(kargoapi.StageStatus, bool, []string, error)
+	}{
+		{
+			name: "default policy",
+			assertions: func(
+				_ kargoapi.StageStatus,
+				qualified bool,
+				unmet []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, qualified)
+				require.Empty(t, unmet)
+			},
+		},
+		{
+			name:   "error getting Freight",
+			policy: manualApprovalPolicy,
+			getFreightFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Freight, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				_ bool,
+				_ []string,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name:   "Freight not approved",
+			policy: manualApprovalPolicy,
+			getFreightFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Freight, error) {
+				return &kargoapi.Freight{}, nil
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				qualified bool,
+				unmet []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, qualified)
+				require.Len(t, unmet, 1)
+			},
+		},
+		{
+			name:   "Freight approved",
+			policy: manualApprovalPolicy,
+			getFreightFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Freight, error) {
+				return &kargoapi.Freight{
+					Status: kargoapi.FreightStatus{
+						Approvals: map[string]kargoapi.Approval{
+							"fake-stage": {},
+						},
+					},
+				}, nil
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				qualified bool,
+				unmet []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, qualified)
+				require.Empty(t, unmet)
+			},
+		},
+		{
+			name:   "Freight not yet verified",
+			policy: verificationPolicy,
+			verifyFn: func(
+				_ context.Context,
+				_ *kargoapi.Stage,
+				freight kargoapi.SimpleFreight,
+				criterion kargoapi.VerificationCriterion,
+			) kargoapi.VerificationResult {
+				return kargoapi.VerificationResult{
+					Provider: criterion.Provider,
+					Freight:  freight.ID,
+					Message:  "something is wrong",
+				}
+			},
+			assertions: func(
+				status kargoapi.StageStatus,
+				qualified bool,
+				unmet []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, qualified)
+				require.Len(t, unmet, 1)
+				require.Contains(t, unmet[0], "did not pass verification")
+				require.Len(t, status.Verifications, 1)
+				require.False(t, status.Verifications[0].Passed)
+			},
+		},
+		{
+			name:   "Freight verified",
+			policy: verificationPolicy,
+			verifyFn: func(
+				_ context.Context,
+				_ *kargoapi.Stage,
+				freight kargoapi.SimpleFreight,
+				criterion kargoapi.VerificationCriterion,
+			) kargoapi.VerificationResult {
+				return kargoapi.VerificationResult{
+					Provider: criterion.Provider,
+					Freight:  freight.ID,
+					Passed:   true,
+				}
+			},
+			assertions: func(
+				status kargoapi.StageStatus,
+				qualified bool,
+				unmet []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, qualified)
+				require.Empty(t, unmet)
+				require.Len(t, status.Verifications, 1)
+				require.True(t, status.Verifications[0].Passed)
+			},
+		},
+		{
+			name:   "Freight previously verified",
+			policy: verificationPolicy,
+			status: &kargoapi.StageStatus{
+				CurrentFreight: testStatus.CurrentFreight,
+				Verifications: []kargoapi.VerificationResult{
+					{
+						Provider: "fake-provider",
+						Freight:  "fake-freight",
+						Passed:   true,
+					},
+				},
+			},
+			// verifyFn is nil, so this will panic if the provider is invoked
+			assertions: func(
+				status kargoapi.StageStatus,
+				qualified bool,
+				unmet []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, qualified)
+				require.Empty(t, unmet)
+				require.Len(t, status.Verifications, 1)
+			},
+		},
+		{
+			name:   "Freight fails verification again for the same reason",
+			policy: verificationPolicy,
+			status: &kargoapi.StageStatus{
+				CurrentFreight: testStatus.CurrentFreight,
+				Verifications: []kargoapi.VerificationResult{
+					{
+						Provider:   "fake-provider",
+						Freight:    "fake-freight",
+						Message:    "something is wrong",
+						VerifiedAt: &metav1.Time{Time: time.Unix(0, 0)},
+					},
+				},
+			},
+			verifyFn: func(
+				_ context.Context,
+				_ *kargoapi.Stage,
+				freight kargoapi.SimpleFreight,
+				criterion kargoapi.VerificationCriterion,
+			) kargoapi.VerificationResult {
+				return kargoapi.VerificationResult{
+					Provider:   criterion.Provider,
+					Freight:    freight.ID,
+					Message:    "something is wrong",
+					VerifiedAt: &metav1.Time{Time: time.Now()},
+				}
+			},
+			assertions: func(
+				status kargoapi.StageStatus,
+				qualified bool,
+				unmet []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, qualified)
+				require.Len(t, unmet, 1)
+				require.Len(t, status.Verifications, 1)
+				// The prior result should be retained so the status is unchanged
+				require.True(
+					t,
+					time.Unix(0, 0).Equal(status.Verifications[0].VerifiedAt.Time),
+				)
+			},
+		},
+		{
+			name:   "Stage not healthy",
+			policy: verificationPolicy,
+			status: &kargoapi.StageStatus{
+				CurrentFreight: testStatus.CurrentFreight,
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateUnhealthy,
+				},
+				Verifications: []kargoapi.VerificationResult{
+					{
+						Provider: "fake-provider",
+						Freight:  "old-freight",
+						Passed:   true,
+					},
+				},
+			},
+			// verifyFn is nil, so this will panic if the provider is invoked
+			assertions: func(
+				status kargoapi.StageStatus,
+				qualified bool,
+				unmet []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, qualified)
+				require.Len(t, unmet, 1)
+				require.Contains(t, unmet[0], "has not yet been verified")
+				require.Empty(t, status.Verifications)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := reconciler{
+				getFreightFn: testCase.getFreightFn,
+				verifyFn:     testCase.verifyFn,
+				nowFn:        time.Now,
+			}
+			status := testStatus
+			if testCase.status != nil {
+				status = *testCase.status
+			}
+			qualified, unmet, err := r.evaluateQualification(
+				context.Background(),
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Spec: &kargoapi.StageSpec{
+						Qualification: testCase.policy,
+					},
+				},
+				&status,
+			)
+			testCase.assertions(status, qualified, unmet, err)
+		})
+	}
+}
+
+func TestSelfHeal(t *testing.T) {
+	testNow := time.Now()
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-namespace",
+		},
+	}
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(bool, error, *record.FakeRecorder)
+	}{
+		{
+			name: "error checking if self-heal is permitted",
+			reconciler: &reconciler{
+				isSelfHealPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(healing bool, err error, _ *record.FakeRecorder) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(
+					t,
+					err.Error(),
+					"error checking if self-heal is permitted",
+				)
+				require.False(t, healing)
+			},
+		},
+		{
+			name: "self-heal not permitted",
+			reconciler: &reconciler{
+				isSelfHealPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+			},
+			assertions: func(healing bool, err error, _ *record.FakeRecorder) {
+				require.NoError(t, err)
+				require.False(t, healing)
+			},
+		},
+		{
+			name: "error listing Promotions",
+			reconciler: &reconciler{
+				isSelfHealPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(healing bool, err error, _ *record.FakeRecorder) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(
+					t,
+					err.Error(),
+					"error listing existing self-healing Promotions",
+				)
+				require.False(t, healing)
+			},
+		},
+		{
+			name: "self-healed too recently",
+			reconciler: &reconciler{
+				cfg: ReconcilerConfig{
+					SelfHealMinInterval: 10 * time.Minute,
+				},
+				nowFn: func() time.Time {
+					return testNow
+				},
+				isSelfHealPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								CreationTimestamp: metav1.NewTime(
+									testNow.Add(-5 * time.Minute),
+								),
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(healing bool, err error, _ *record.FakeRecorder) {
+				require.NoError(t, err)
+				require.False(t, healing)
+			},
+		},
+		{
+			name: "error creating Promotion",
+			reconciler: &reconciler{
+				nowFn: time.Now,
+				isSelfHealPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(healing bool, err error, _ *record.FakeRecorder) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error creating Promotion")
+				require.False(t, healing)
+			},
+		},
+		{
+			name: "success",
+			reconciler: &reconciler{
+				cfg: ReconcilerConfig{
+					SelfHealMinInterval: 10 * time.Minute,
+				},
+				nowFn: func() time.Time {
+					return testNow
+				},
+				isSelfHealPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								CreationTimestamp: metav1.NewTime(
+									testNow.Add(-time.Hour),
+								),
+							},
+						},
+					}
+					return nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					promo, ok := obj.(*kargoapi.Promotion)
+					require.True(t, ok)
+					require.Equal(t, "fake-stage", promo.Spec.Stage)
+					require.Equal(t, "fake-freight", promo.Spec.Freight)
+					require.Equal(
+						t,
+						kargoapi.LabelTrueValue,
+						promo.Labels[kargoapi.LabelSelfHealKey],
+					)
+					return nil
+				},
+			},
+			assertions: func(
+				healing bool,
+				err error,
+				recorder *record.FakeRecorder,
+			) {
+				require.NoError(t, err)
+				require.True(t, healing)
+				require.Len(t, recorder.Events, 1)
+				require.Contains(t, <-recorder.Events, "SelfHealing")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			testCase.reconciler.recorder = recorder
+			healing, err := testCase.reconciler.selfHeal(
+				context.Background(),
+				testStage,
+				"fake-freight",
+			)
+			testCase.assertions(healing, err, recorder)
+		})
+	}
+}
+
+func TestIsAutoPromotionPermitted(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(bool, error)
+	}{
+		{
+			name: "error listing PromotionPolicies",
+			reconciler: &reconciler{
+				listPromoPoliciesFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(
+					t,
+					err.Error(),
+					"error listing PromotionPolicies for Stage",
+				)
+			},
+		},
+		{
+			name: "no PromotionPolicies found",
+			reconciler: &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
 					_ ...client.ListOption,
 				) error {
-					promos, ok := objList.(*kargoapi.PromotionList)
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
 					require.True(t, ok)
-					promos.Items = []kargoapi.Promotion{}
+					policies.Items = []kargoapi.PromotionPolicy{}
+					return nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+		{
+			name: "more than one PromotionPolicy found",
+			reconciler: &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = []kargoapi.PromotionPolicy{{}, {}}
+					return nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+		{
+			name: "not permitted",
+			reconciler: &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = []kargoapi.PromotionPolicy{{}}
 					return nil
 				},
 			},
@@ -898,199 +2737,577 @@ func TestHasNonTerminalPromotions(t *testing.T) {
 				require.False(t, result)
 			},
 		},
+		{
+			name: "permitted",
+			reconciler: &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = []kargoapi.PromotionPolicy{
+						{
+							EnableAutoPromotion: true,
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.True(t, result)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			result, err := testCase.reconciler.hasNonTerminalPromotions(
-				context.Background(),
-				"fake-namespace",
-				"fake-stage",
+			testCase.assertions(
+				testCase.reconciler.isAutoPromotionPermitted(
+					context.Background(),
+					"fake-namespace",
+					"fake-stage",
+				),
+			)
+		})
+	}
+}
+
+func TestGetAutoPromotionHoldback(t *testing.T) {
+	testCases := []struct {
+		name       string
+		policies   []kargoapi.PromotionPolicy
+		assertions func(time.Duration, error)
+	}{
+		{
+			name:     "no PromotionPolicies found",
+			policies: []kargoapi.PromotionPolicy{},
+			assertions: func(holdback time.Duration, err error) {
+				require.NoError(t, err)
+				require.Zero(t, holdback)
+			},
+		},
+		{
+			name: "no holdback specified",
+			policies: []kargoapi.PromotionPolicy{
+				{
+					EnableAutoPromotion: true,
+				},
+			},
+			assertions: func(holdback time.Duration, err error) {
+				require.NoError(t, err)
+				require.Zero(t, holdback)
+			},
+		},
+		{
+			name: "holdback specified",
+			policies: []kargoapi.PromotionPolicy{
+				{
+					EnableAutoPromotion: true,
+					AutoPromotionHoldback: &metav1.Duration{
+						Duration: 15 * time.Minute,
+					},
+				},
+			},
+			assertions: func(holdback time.Duration, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 15*time.Minute, holdback)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = testCase.policies
+					return nil
+				},
+			}
+			testCase.assertions(
+				r.getAutoPromotionHoldback(
+					context.Background(),
+					"fake-namespace",
+					"fake-stage",
+				),
+			)
+		})
+	}
+}
+
+func TestExceedsMaxFreightAge(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.Time{Time: now.Add(-48 * time.Hour)},
+		},
+	}
+	testCases := []struct {
+		name       string
+		policies   []kargoapi.PromotionPolicy
+		assertions func(bool, error)
+	}{
+		{
+			name:     "no PromotionPolicies found",
+			policies: []kargoapi.PromotionPolicy{},
+			assertions: func(tooOld bool, err error) {
+				require.NoError(t, err)
+				require.False(t, tooOld)
+			},
+		},
+		{
+			name: "Freight younger than max age",
+			policies: []kargoapi.PromotionPolicy{
+				{
+					MaxFreightAge: &metav1.Duration{Duration: 72 * time.Hour},
+				},
+			},
+			assertions: func(tooOld bool, err error) {
+				require.NoError(t, err)
+				require.False(t, tooOld)
+			},
+		},
+		{
+			name: "Freight older than max age",
+			policies: []kargoapi.PromotionPolicy{
+				{
+					MaxFreightAge: &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			},
+			assertions: func(tooOld bool, err error) {
+				require.NoError(t, err)
+				require.True(t, tooOld)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = testCase.policies
+					return nil
+				},
+				nowFn: func() time.Time { return now },
+			}
+			testCase.assertions(
+				r.exceedsMaxFreightAge(
+					context.Background(),
+					"fake-namespace",
+					"fake-stage",
+					freight,
+				),
+			)
+		})
+	}
+}
+
+func TestIsAutoPromotionCoalescingEnabled(t *testing.T) {
+	testCases := []struct {
+		name       string
+		policies   []kargoapi.PromotionPolicy
+		assertions func(bool, error)
+	}{
+		{
+			name:     "no PromotionPolicies found",
+			policies: []kargoapi.PromotionPolicy{},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+		{
+			name: "auto-promotion not enabled",
+			policies: []kargoapi.PromotionPolicy{
+				{
+					CoalesceAutoPromotions: true,
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+		{
+			name: "enabled",
+			policies: []kargoapi.PromotionPolicy{
+				{
+					EnableAutoPromotion:    true,
+					CoalesceAutoPromotions: true,
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.True(t, result)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = testCase.policies
+					return nil
+				},
+			}
+			testCase.assertions(
+				r.isAutoPromotionCoalescingEnabled(
+					context.Background(),
+					"fake-namespace",
+					"fake-stage",
+				),
 			)
-			testCase.assertions(result, err)
 		})
 	}
 }
 
-func TestQualifyFreight(t *testing.T) {
+func TestAbortSupersededAutoPromotions(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-namespace",
+		},
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
+		},
+	}
+	coalescingEnabledFn := func(context.Context, string, string) (bool, error) {
+		return true, nil
+	}
+	latestFreightFn := func(
+		context.Context,
+		string,
+		kargoapi.Subscriptions,
+	) (*kargoapi.Freight, error) {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{Name: "latest-freight"},
+		}, nil
+	}
+	autoPromoLabels := map[string]string{
+		kargoapi.LabelAutoPromotionKey: kargoapi.LabelTrueValue,
+	}
 	testCases := []struct {
 		name       string
+		promos     []kargoapi.Promotion
 		reconciler *reconciler
-		assertions func(error)
+		assertions func(cleared bool, deleted []string, err error)
 	}{
 		{
-			name: "error getting Freight",
+			name: "coalescing not enabled",
 			reconciler: &reconciler{
-				getFreightFn: func(
+				isAutoPromotionCoalescingEnabledFn: func(
 					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*kargoapi.Freight, error) {
-					return nil, errors.New("something went wrong")
+					string,
+					string,
+				) (bool, error) {
+					return false, nil
 				},
 			},
-			assertions: func(err error) {
-				require.Error(t, err)
-				require.Contains(t, err.Error(), "something went wrong")
-				require.Contains(t, err.Error(), "error finding Freight")
+			assertions: func(cleared bool, deleted []string, err error) {
+				require.NoError(t, err)
+				require.False(t, cleared)
+				require.Empty(t, deleted)
 			},
 		},
 		{
-			name: "Freight not found",
+			name: "error getting latest Freight",
 			reconciler: &reconciler{
-				getFreightFn: func(
+				isAutoPromotionCoalescingEnabledFn: coalescingEnabledFn,
+				getLatestAvailableFreightFn: func(
 					context.Context,
-					client.Client,
-					types.NamespacedName,
+					string,
+					kargoapi.Subscriptions,
 				) (*kargoapi.Freight, error) {
-					return nil, nil
+					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(cleared bool, _ []string, err error) {
 				require.Error(t, err)
-				require.Contains(t, err.Error(), "found no Freight")
+				require.Equal(t, "something went wrong", err.Error())
+				require.False(t, cleared)
 			},
 		},
 		{
-			name: "Freight already qualified for Stage",
-			reconciler: &reconciler{
-				getFreightFn: func(
-					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{
-						Status: kargoapi.FreightStatus{
-							Qualifications: map[string]kargoapi.Qualification{
-								"fake-stage": {},
-							},
-						},
-					}, nil
+			name: "error deleting Promotion",
+			promos: []kargoapi.Promotion{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "stale-promo",
+						Labels: autoPromoLabels,
+					},
+					Spec: &kargoapi.PromotionSpec{Freight: "old-freight"},
 				},
 			},
-			assertions: func(err error) {
-				require.NoError(t, err)
-			},
-		},
-		{
-			name: "error Patching Freight status",
 			reconciler: &reconciler{
-				getFreightFn: func(
-					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{}, nil
-				},
-				patchFreightStatusFn: func(
+				isAutoPromotionCoalescingEnabledFn: coalescingEnabledFn,
+				getLatestAvailableFreightFn:        latestFreightFn,
+				deletePromotionFn: func(
 					context.Context,
-					*kargoapi.Freight,
-					kargoapi.FreightStatus,
+					client.Object,
+					...client.DeleteOption,
 				) error {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(cleared bool, _ []string, err error) {
 				require.Error(t, err)
-				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error deleting Promotion")
+				require.False(t, cleared)
 			},
 		},
 		{
-			name: "success",
-			reconciler: &reconciler{
-				getFreightFn: func(
-					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{}, nil
+			name: "only superseded Pending auto-Promotions are aborted",
+			promos: []kargoapi.Promotion{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "stale-promo",
+						Labels: autoPromoLabels,
+					},
+					Spec: &kargoapi.PromotionSpec{Freight: "old-freight"},
 				},
-				patchFreightStatusFn: func(
-					context.Context,
-					*kargoapi.Freight,
-					kargoapi.FreightStatus,
-				) error {
-					return nil
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "running-promo",
+						Labels: autoPromoLabels,
+					},
+					Spec: &kargoapi.PromotionSpec{Freight: "older-freight"},
+					Status: kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhaseRunning,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "manual-promo",
+					},
+					Spec: &kargoapi.PromotionSpec{Freight: "old-freight"},
 				},
 			},
-			assertions: func(err error) {
+			reconciler: &reconciler{
+				isAutoPromotionCoalescingEnabledFn: coalescingEnabledFn,
+				getLatestAvailableFreightFn:        latestFreightFn,
+			},
+			assertions: func(cleared bool, deleted []string, err error) {
 				require.NoError(t, err)
+				require.False(t, cleared)
+				require.Equal(t, []string{"stale-promo"}, deleted)
+			},
+		},
+		{
+			name: "all non-terminal Promotions aborted",
+			promos: []kargoapi.Promotion{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "stale-promo",
+						Labels: autoPromoLabels,
+					},
+					Spec: &kargoapi.PromotionSpec{Freight: "old-freight"},
+					Status: kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhasePending,
+					},
+				},
+			},
+			reconciler: &reconciler{
+				isAutoPromotionCoalescingEnabledFn: coalescingEnabledFn,
+				getLatestAvailableFreightFn:        latestFreightFn,
+			},
+			assertions: func(cleared bool, deleted []string, err error) {
+				require.NoError(t, err)
+				require.True(t, cleared)
+				require.Equal(t, []string{"stale-promo"}, deleted)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				testCase.reconciler.qualifyFreight(
-					context.Background(),
-					"fake-namespace",
-					"fake-freight",
-					"fake-stage",
-				),
+			var deleted []string
+			testCase.reconciler.listPromosFn = func(
+				_ context.Context,
+				objList client.ObjectList,
+				_ ...client.ListOption,
+			) error {
+				promos, ok := objList.(*kargoapi.PromotionList)
+				require.True(t, ok)
+				promos.Items = testCase.promos
+				return nil
+			}
+			if testCase.reconciler.deletePromotionFn == nil {
+				testCase.reconciler.deletePromotionFn = func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.DeleteOption,
+				) error {
+					deleted = append(deleted, obj.GetName())
+					return nil
+				}
+			}
+			cleared, err := testCase.reconciler.abortSupersededAutoPromotions(
+				context.Background(),
+				testStage,
 			)
+			testCase.assertions(cleared, deleted, err)
 		})
 	}
 }
 
-func TestIsAutoPromotionPermitted(t *testing.T) {
+func TestGetSupersededFreight(t *testing.T) {
+	latestFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{Name: "freight-4"},
+	}
+	available := []kargoapi.Freight{
+		{ObjectMeta: metav1.ObjectMeta{Name: "freight-4"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "freight-3"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "freight-2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "freight-1"}},
+	}
 	testCases := []struct {
-		name       string
-		reconciler *reconciler
-		assertions func(bool, error)
+		name           string
+		currentFreight *kargoapi.SimpleFreight
+		subs           *kargoapi.Subscriptions
+		reconciler     *reconciler
+		assertions     func([]string, error)
 	}{
 		{
-			name: "error listing PromotionPolicies",
+			name: "no current Freight",
+			subs: &kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
+			reconciler: &reconciler{},
+			assertions: func(superseded []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, superseded)
+			},
+		},
+		{
+			name:           "error listing Freight",
+			currentFreight: &kargoapi.SimpleFreight{ID: "freight-2"},
+			subs: &kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
 			reconciler: &reconciler{
-				listPromoPoliciesFn: func(
+				getAllFreightFromWarehouseFn: func(
 					context.Context,
-					client.ObjectList,
-					...client.ListOption,
-				) error {
-					return errors.New("something went wrong")
+					string,
+					string,
+				) ([]kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(_ bool, err error) {
+			assertions: func(_ []string, err error) {
 				require.Error(t, err)
-				require.Contains(t, err.Error(), "something went wrong")
-				require.Contains(
-					t,
-					err.Error(),
-					"error listing PromotionPolicies for Stage",
-				)
+				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
 		{
-			name: "no PromotionPolicies found",
+			name:           "current Freight not available",
+			currentFreight: &kargoapi.SimpleFreight{ID: "freight-0"},
+			subs: &kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
 			reconciler: &reconciler{
-				listPromoPoliciesFn: func(
-					_ context.Context,
-					objList client.ObjectList,
-					_ ...client.ListOption,
-				) error {
-					policies, ok := objList.(*kargoapi.PromotionPolicyList)
-					require.True(t, ok)
-					policies.Items = []kargoapi.PromotionPolicy{}
-					return nil
+				getAllFreightFromWarehouseFn: func(
+					context.Context,
+					string,
+					string,
+				) ([]kargoapi.Freight, error) {
+					return available, nil
 				},
 			},
-			assertions: func(result bool, err error) {
+			assertions: func(superseded []string, err error) {
 				require.NoError(t, err)
-				require.False(t, result)
+				require.Empty(t, superseded)
 			},
 		},
 		{
-			name: "more than one PromotionPolicy found",
+			name:           "Freight from Warehouse superseded",
+			currentFreight: &kargoapi.SimpleFreight{ID: "freight-1"},
+			subs: &kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
 			reconciler: &reconciler{
-				listPromoPoliciesFn: func(
-					_ context.Context,
-					objList client.ObjectList,
-					_ ...client.ListOption,
-				) error {
-					policies, ok := objList.(*kargoapi.PromotionPolicyList)
-					require.True(t, ok)
-					policies.Items = []kargoapi.PromotionPolicy{{}, {}}
-					return nil
+				getAllFreightFromWarehouseFn: func(
+					context.Context,
+					string,
+					string,
+				) ([]kargoapi.Freight, error) {
+					return available, nil
+				},
+			},
+			assertions: func(superseded []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"freight-3", "freight-2"}, superseded)
+			},
+		},
+		{
+			name:           "Freight from upstream Stages superseded",
+			currentFreight: &kargoapi.SimpleFreight{ID: "freight-2"},
+			subs: &kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{
+					{Name: "fake-upstream-stage"},
 				},
 			},
+			reconciler: &reconciler{
+				getAllFreightQualifiedForUpstreamStagesFn: func(
+					context.Context,
+					string,
+					[]kargoapi.StageSubscription,
+				) ([]kargoapi.Freight, error) {
+					return available, nil
+				},
+			},
+			assertions: func(superseded []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"freight-3"}, superseded)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.reconciler.getSupersededFreight(
+					context.Background(),
+					&kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: testCase.subs,
+						},
+						Status: kargoapi.StageStatus{
+							CurrentFreight: testCase.currentFreight,
+						},
+					},
+					latestFreight,
+				),
+			)
+		})
+	}
+}
+
+func TestIsSelfHealPermitted(t *testing.T) {
+	testCases := []struct {
+		name       string
+		policies   []kargoapi.PromotionPolicy
+		assertions func(bool, error)
+	}{
+		{
+			name:     "no PromotionPolicies found",
+			policies: []kargoapi.PromotionPolicy{},
 			assertions: func(result bool, err error) {
 				require.NoError(t, err)
 				require.False(t, result)
@@ -1098,16 +3315,9 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 		},
 		{
 			name: "not permitted",
-			reconciler: &reconciler{
-				listPromoPoliciesFn: func(
-					_ context.Context,
-					objList client.ObjectList,
-					_ ...client.ListOption,
-				) error {
-					policies, ok := objList.(*kargoapi.PromotionPolicyList)
-					require.True(t, ok)
-					policies.Items = []kargoapi.PromotionPolicy{{}}
-					return nil
+			policies: []kargoapi.PromotionPolicy{
+				{
+					EnableAutoPromotion: true,
 				},
 			},
 			assertions: func(result bool, err error) {
@@ -1117,20 +3327,9 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 		},
 		{
 			name: "permitted",
-			reconciler: &reconciler{
-				listPromoPoliciesFn: func(
-					_ context.Context,
-					objList client.ObjectList,
-					_ ...client.ListOption,
-				) error {
-					policies, ok := objList.(*kargoapi.PromotionPolicyList)
-					require.True(t, ok)
-					policies.Items = []kargoapi.PromotionPolicy{
-						{
-							EnableAutoPromotion: true,
-						},
-					}
-					return nil
+			policies: []kargoapi.PromotionPolicy{
+				{
+					EnableSelfHeal: true,
 				},
 			},
 			assertions: func(result bool, err error) {
@@ -1141,8 +3340,20 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = testCase.policies
+					return nil
+				},
+			}
 			testCase.assertions(
-				testCase.reconciler.isAutoPromotionPermitted(
+				r.isSelfHealPermitted(
 					context.Background(),
 					"fake-namespace",
 					"fake-stage",
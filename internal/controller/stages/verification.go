@@ -0,0 +1,222 @@
+package stages
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"connectrpc.com/connect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/artifacts"
+	"github.com/akuity/kargo/internal/logging"
+	verificationv1alpha1 "github.com/akuity/kargo/pkg/api/verification/v1alpha1"
+)
+
+// verifyCurrentFreight returns the outcome of every Verification criterion in
+// the Stage's qualification policy for the Stage's current Freight. Results
+// previously recorded in the provided status are reused if the Freight has
+// already passed a given provider's checks, or if the Stage is not healthy, in
+// which case there would be no point in invoking providers. Otherwise,
+// providers are invoked again so that Freight that previously failed
+// verification is retried on every reconciliation. When a retry's outcome is
+// unchanged, the prior result is reused as is, so that a Stage whose Freight
+// keeps failing verification for the same reason does not have its status
+// rewritten on every reconciliation.
+func (r *reconciler) verifyCurrentFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	status kargoapi.StageStatus,
+) []kargoapi.VerificationResult {
+	criteria := stage.Spec.Qualification.VerificationCriteria()
+	if len(criteria) == 0 || status.CurrentFreight == nil {
+		return nil
+	}
+	freight := *status.CurrentFreight
+	healthy := status.Health == nil ||
+		status.Health.Status == kargoapi.HealthStateHealthy
+	results := make([]kargoapi.VerificationResult, 0, len(criteria))
+	for _, criterion := range criteria {
+		prior, found := findVerificationResult(
+			status.Verifications,
+			criterion.Provider,
+			freight.ID,
+		)
+		if found && (prior.Passed || !healthy) {
+			results = append(results, prior)
+			continue
+		}
+		if !healthy {
+			continue
+		}
+		result := r.verifyFn(ctx, stage, freight, criterion)
+		if found && sameVerificationOutcome(prior, result) {
+			result = prior
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// findVerificationResult returns the result of verification of the specified
+// Freight by the specified provider, if one exists among the provided results.
+func findVerificationResult(
+	results []kargoapi.VerificationResult,
+	provider string,
+	freightID string,
+) (kargoapi.VerificationResult, bool) {
+	for _, result := range results {
+		if result.Provider == provider && result.Freight == freightID {
+			return result, true
+		}
+	}
+	return kargoapi.VerificationResult{}, false
+}
+
+// sameVerificationOutcome returns whether the provided results record the same
+// outcome, disregarding when each verification completed.
+func sameVerificationOutcome(a, b kargoapi.VerificationResult) bool {
+	return a.Passed == b.Passed &&
+		a.Message == b.Message &&
+		equalStringMaps(a.Details, b.Details) &&
+		equalStringMaps(a.ArtifactURLs, b.ArtifactURLs)
+}
+
+// equalStringMaps returns whether the provided maps have the same entries.
+// Nil and empty maps are considered equal, since they are indistinguishable
+// once recorded in a Stage's status.
+func equalStringMaps(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// verify invokes the verification provider referenced by the provided
+// criterion to check the provided Freight in the provided Stage. A failure to
+// invoke the provider is reported as a failed verification rather than as an
+// error, since the provider will be invoked again on the next reconciliation.
+func (r *reconciler) verify(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.SimpleFreight,
+	criterion kargoapi.VerificationCriterion,
+) (result kargoapi.VerificationResult) {
+	logger := logging.LoggerFromContext(ctx).WithField("provider", criterion.Provider)
+	result = kargoapi.VerificationResult{
+		Provider: criterion.Provider,
+		Freight:  freight.ID,
+	}
+	defer func() {
+		now := metav1.NewTime(r.nowFn())
+		result.VerifiedAt = &now
+	}()
+
+	client, ok := r.verificationProviders[criterion.Provider]
+	if !ok {
+		result.Message = fmt.Sprintf(
+			"verification provider %q is not registered",
+			criterion.Provider,
+		)
+		return result
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.VerificationTimeout)
+	defer cancel()
+	res, err := client.Verify(
+		ctx,
+		connect.NewRequest(&verificationv1alpha1.VerifyRequest{
+			Namespace:  stage.Namespace,
+			Stage:      stage.Name,
+			Freight:    typesv1alpha1.ToSimpleFreightProto(freight, nil),
+			Parameters: criterion.Parameters,
+		}),
+	)
+	if err != nil {
+		logger.Errorf("error invoking verification provider: %s", err)
+		result.Message = fmt.Sprintf(
+			"error invoking verification provider %q: %s",
+			criterion.Provider,
+			err,
+		)
+		return result
+	}
+	result.Passed = res.Msg.GetPassed()
+	result.Message = res.Msg.GetMessage()
+	result.Details = res.Msg.GetDetails()
+	result.ArtifactURLs = r.storeVerificationArtifacts(
+		ctx,
+		stage,
+		freight,
+		criterion.Provider,
+		res.Msg.GetArtifacts(),
+	)
+	logger.WithField("passed", result.Passed).Debug("verified Freight")
+	return result
+}
+
+// storeVerificationArtifacts stores the provided artifacts, reported by the
+// specified provider when verifying the provided Freight in the provided Stage,
+// in the artifact store and returns a map of their names to their URLs. Failing
+// to store an artifact does not fail the verification, so any artifact that
+// cannot be stored is logged and omitted.
+func (r *reconciler) storeVerificationArtifacts(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.SimpleFreight,
+	provider string,
+	artifactsByName map[string][]byte,
+) map[string]string {
+	if len(artifactsByName) == 0 {
+		return nil
+	}
+	logger := logging.LoggerFromContext(ctx).WithField("provider", provider)
+	if r.artifactStore == nil {
+		logger.Debug("no artifact store is configured; discarding verification artifacts")
+		return nil
+	}
+	urls := make(map[string]string, len(artifactsByName))
+	for name, data := range artifactsByName {
+		// Names come from the provider, so they are confined to this
+		// verification's own path
+		artifactPath := strings.TrimPrefix(path.Join("/", name), "/")
+		if artifactPath == "" {
+			logger.Errorf("ignoring verification artifact with invalid name %q", name)
+			continue
+		}
+		url, err := r.artifactStore.Put(ctx, artifacts.Artifact{
+			Namespace: stage.Namespace,
+			Path: path.Join(
+				"stages",
+				stage.Name,
+				"verifications",
+				freight.ID,
+				provider,
+				artifactPath,
+			),
+			Owner: &metav1.OwnerReference{
+				APIVersion: kargoapi.GroupVersion.String(),
+				Kind:       "Stage",
+				Name:       stage.Name,
+				UID:        stage.UID,
+			},
+			ContentType: http.DetectContentType(data),
+			Data:        data,
+		})
+		if err != nil {
+			logger.Errorf("error storing verification artifact %q: %s", name, err)
+			continue
+		}
+		urls[name] = url
+	}
+	return urls
+}
@@ -0,0 +1,281 @@
+package stages
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/artifacts"
+	verificationv1alpha1 "github.com/akuity/kargo/pkg/api/verification/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/verification/v1alpha1/verificationv1alpha1connect"
+	"github.com/akuity/kargo/pkg/verification"
+)
+
+func TestVerify(t *testing.T) {
+	testNow := time.Now()
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	testFreight := kargoapi.SimpleFreight{
+		ID: "fake-freight",
+	}
+	testCases := []struct {
+		name       string
+		provider   verification.ProviderFunc
+		assertions func(kargoapi.VerificationResult)
+	}{
+		{
+			name: "provider not registered",
+			assertions: func(result kargoapi.VerificationResult) {
+				require.False(t, result.Passed)
+				require.Contains(t, result.Message, "is not registered")
+				require.True(t, testNow.Equal(result.VerifiedAt.Time))
+			},
+		},
+		{
+			name: "error invoking provider",
+			provider: func(
+				context.Context,
+				*verificationv1alpha1.VerifyRequest,
+			) (*verificationv1alpha1.VerifyResponse, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(result kargoapi.VerificationResult) {
+				require.False(t, result.Passed)
+				require.Contains(t, result.Message, "error invoking verification provider")
+				require.Contains(t, result.Message, "something went wrong")
+				require.True(t, testNow.Equal(result.VerifiedAt.Time))
+			},
+		},
+		{
+			name: "success",
+			provider: func(
+				_ context.Context,
+				req *verificationv1alpha1.VerifyRequest,
+			) (*verificationv1alpha1.VerifyResponse, error) {
+				return &verificationv1alpha1.VerifyResponse{
+					Passed:  true,
+					Message: "all good",
+					Details: map[string]string{
+						"freight": req.GetFreight().GetId(),
+						"check":   req.GetParameters()["check"],
+					},
+				}, nil
+			},
+			assertions: func(result kargoapi.VerificationResult) {
+				require.Equal(
+					t,
+					kargoapi.VerificationResult{
+						Provider: "fake-provider",
+						Freight:  "fake-freight",
+						Passed:   true,
+						Message:  "all good",
+						Details: map[string]string{
+							"freight": "fake-freight",
+							"check":   "fake-check",
+						},
+						VerifiedAt: result.VerifiedAt,
+					},
+					result,
+				)
+				require.True(t, testNow.Equal(result.VerifiedAt.Time))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				cfg: ReconcilerConfig{
+					VerificationTimeout: time.Minute,
+				},
+				verificationProviders: map[string]verificationv1alpha1connect.VerificationProviderServiceClient{},
+				nowFn: func() time.Time {
+					return testNow
+				},
+			}
+			if testCase.provider != nil {
+				mux := http.NewServeMux()
+				mux.Handle(verification.NewHandler(testCase.provider))
+				server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+				defer server.Close()
+				r.verificationProviders["fake-provider"] =
+					verification.NewClient(server.URL)
+			}
+			testCase.assertions(
+				r.verify(
+					context.Background(),
+					testStage,
+					testFreight,
+					kargoapi.VerificationCriterion{
+						Provider: "fake-provider",
+						Parameters: map[string]string{
+							"check": "fake-check",
+						},
+					},
+				),
+			)
+		})
+	}
+}
+
+func TestSameVerificationOutcome(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        kargoapi.VerificationResult
+		b        kargoapi.VerificationResult
+		expected bool
+	}{
+		{
+			name: "same outcome at different times",
+			a: kargoapi.VerificationResult{
+				Message:    "something is wrong",
+				Details:    map[string]string{"check": "failed"},
+				VerifiedAt: &metav1.Time{Time: time.Unix(0, 0)},
+			},
+			b: kargoapi.VerificationResult{
+				Message:    "something is wrong",
+				Details:    map[string]string{"check": "failed"},
+				VerifiedAt: &metav1.Time{Time: time.Now()},
+			},
+			expected: true,
+		},
+		{
+			name: "nil and empty details",
+			a:    kargoapi.VerificationResult{},
+			b: kargoapi.VerificationResult{
+				Details: map[string]string{},
+			},
+			expected: true,
+		},
+		{
+			name: "different passed",
+			a:    kargoapi.VerificationResult{},
+			b: kargoapi.VerificationResult{
+				Passed: true,
+			},
+		},
+		{
+			name: "different message",
+			a: kargoapi.VerificationResult{
+				Message: "something is wrong",
+			},
+			b: kargoapi.VerificationResult{
+				Message: "something else is wrong",
+			},
+		},
+		{
+			name: "different details",
+			a: kargoapi.VerificationResult{
+				Details: map[string]string{"check": "failed"},
+			},
+			b: kargoapi.VerificationResult{
+				Details: map[string]string{"check": "timed out"},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				sameVerificationOutcome(testCase.a, testCase.b),
+			)
+		})
+	}
+}
+
+func TestStoreVerificationArtifacts(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	testFreight := kargoapi.SimpleFreight{
+		ID: "fake-freight",
+	}
+	testCases := []struct {
+		name          string
+		artifactStore artifacts.Store
+		artifacts     map[string][]byte
+		assertions    func(map[string]string)
+	}{
+		{
+			name: "no artifacts",
+			artifactStore: &artifacts.FakeStore{
+				PutFn: func(context.Context, artifacts.Artifact) (string, error) {
+					return "", errors.New("should not be called")
+				},
+			},
+			assertions: func(urls map[string]string) {
+				require.Nil(t, urls)
+			},
+		},
+		{
+			name: "no artifact store",
+			artifacts: map[string][]byte{
+				"report.xml": []byte("<testsuites/>"),
+			},
+			assertions: func(urls map[string]string) {
+				require.Nil(t, urls)
+			},
+		},
+		{
+			name: "artifacts stored",
+			artifactStore: &artifacts.FakeStore{
+				PutFn: func(_ context.Context, a artifacts.Artifact) (string, error) {
+					if a.Data == nil {
+						return "", errors.New("something went wrong")
+					}
+					return "fake://" + a.Namespace + "/" + a.Path, nil
+				},
+			},
+			artifacts: map[string][]byte{
+				"report.xml":       []byte("<testsuites/>"),
+				"../../escape.txt": []byte("escaped"),
+				"failed.txt":       nil,
+				"/":                []byte("invalid"),
+			},
+			assertions: func(urls map[string]string) {
+				require.Equal(
+					t,
+					map[string]string{
+						"report.xml": "fake://fake-namespace/stages/fake-stage/" +
+							"verifications/fake-freight/fake-provider/report.xml",
+						"../../escape.txt": "fake://fake-namespace/stages/fake-stage/" +
+							"verifications/fake-freight/fake-provider/escape.txt",
+					},
+					urls,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				artifactStore: testCase.artifactStore,
+			}
+			testCase.assertions(
+				r.storeVerificationArtifacts(
+					context.Background(),
+					testStage,
+					testFreight,
+					"fake-provider",
+					testCase.artifacts,
+				),
+			)
+		})
+	}
+}
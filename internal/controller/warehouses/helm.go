@@ -16,8 +16,9 @@ func (r *reconciler) getLatestCharts(
 	ctx context.Context,
 	namespace string,
 	subs []kargoapi.RepoSubscription,
-) ([]kargoapi.Chart, error) {
+) ([]kargoapi.Chart, []kargoapi.SubscriptionRejections, error) {
 	charts := make([]kargoapi.Chart, 0, len(subs))
+	var rejections []kargoapi.SubscriptionRejections
 
 	for _, s := range subs {
 		if s.Chart == nil {
@@ -34,7 +35,7 @@ func (r *reconciler) getLatestCharts(
 		creds, ok, err :=
 			r.credentialsDB.Get(ctx, namespace, credentials.TypeHelm, sub.RegistryURL)
 		if err != nil {
-			return nil, errors.Wrapf(
+			return nil, rejections, errors.Wrapf(
 				err,
 				"error obtaining credentials for chart registry %q",
 				sub.RegistryURL,
@@ -52,15 +53,18 @@ func (r *reconciler) getLatestCharts(
 			logger.Debug("found no credentials for chart repo")
 		}
 
-		vers, err := r.getLatestChartVersionFn(
+		vers, rejected, err := r.getLatestChartVersionFn(
 			ctx,
 			sub.RegistryURL,
 			sub.Name,
 			sub.SemverConstraint,
 			helmCreds,
 		)
+		if subRejections := newSubscriptionRejections(sub.RegistryURL, sub.Name, rejected); subRejections != nil {
+			rejections = append(rejections, *subRejections)
+		}
 		if err != nil {
-			return nil, errors.Wrapf(
+			return nil, rejections, errors.Wrapf(
 				err,
 				"error searching for latest version of chart %q in registry %q",
 				sub.Name,
@@ -70,7 +74,7 @@ func (r *reconciler) getLatestCharts(
 
 		if vers == "" {
 			logger.Error("found no suitable chart version")
-			return nil, errors.Errorf(
+			return nil, rejections, errors.Errorf(
 				"found no suitable version of chart %q in registry %q",
 				sub.Name,
 				sub.RegistryURL,
@@ -89,5 +93,5 @@ func (r *reconciler) getLatestCharts(
 		)
 	}
 
-	return charts, nil
+	return charts, rejections, nil
 }
@@ -22,8 +22,8 @@ func TestGetLatestCharts(t *testing.T) {
 			string,
 			string,
 			*helm.Credentials,
-		) (string, error)
-		assertions func([]kargoapi.Chart, error)
+		) (string, []kargoapi.RejectedVersion, error)
+		assertions func([]kargoapi.Chart, []kargoapi.SubscriptionRejections, error)
 	}{
 		{
 			name: "error getting registry credentials",
@@ -38,7 +38,7 @@ func TestGetLatestCharts(t *testing.T) {
 						errors.New("something went wrong")
 				},
 			},
-			assertions: func(_ []kargoapi.Chart, err error) {
+			assertions: func(_ []kargoapi.Chart, _ []kargoapi.SubscriptionRejections, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -67,10 +67,10 @@ func TestGetLatestCharts(t *testing.T) {
 				string,
 				string,
 				*helm.Credentials,
-			) (string, error) {
-				return "", errors.New("something went wrong")
+			) (string, []kargoapi.RejectedVersion, error) {
+				return "", nil, errors.New("something went wrong")
 			},
-			assertions: func(_ []kargoapi.Chart, err error) {
+			assertions: func(_ []kargoapi.Chart, _ []kargoapi.SubscriptionRejections, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -99,11 +99,27 @@ func TestGetLatestCharts(t *testing.T) {
 				string,
 				string,
 				*helm.Credentials,
-			) (string, error) {
-				return "", nil
+			) (string, []kargoapi.RejectedVersion, error) {
+				return "", []kargoapi.RejectedVersion{
+					{Version: "1.0.0", Reason: "fake-reason"},
+				}, nil
 			},
-			assertions: func(_ []kargoapi.Chart, err error) {
+			assertions: func(_ []kargoapi.Chart, rejections []kargoapi.SubscriptionRejections, err error) {
 				require.Error(t, err)
+				// Rejections should be reported even though no version was found
+				require.Equal(
+					t,
+					[]kargoapi.SubscriptionRejections{
+						{
+							RepoURL: "fake-url",
+							Chart:   "fake-chart",
+							Versions: []kargoapi.RejectedVersion{
+								{Version: "1.0.0", Reason: "fake-reason"},
+							},
+						},
+					},
+					rejections,
+				)
 				require.Contains(t, err.Error(), "found no suitable version of chart")
 			},
 		},
@@ -126,10 +142,10 @@ func TestGetLatestCharts(t *testing.T) {
 				string,
 				string,
 				*helm.Credentials,
-			) (string, error) {
-				return "1.0.0", nil
+			) (string, []kargoapi.RejectedVersion, error) {
+				return "1.0.0", nil, nil
 			},
-			assertions: func(charts []kargoapi.Chart, err error) {
+			assertions: func(charts []kargoapi.Chart, _ []kargoapi.SubscriptionRejections, err error) {
 				require.NoError(t, err)
 				require.Len(t, charts, 1)
 				require.Equal(
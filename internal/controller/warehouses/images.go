@@ -18,8 +18,9 @@ func (r *reconciler) getLatestImages(
 	ctx context.Context,
 	namespace string,
 	subs []kargoapi.RepoSubscription,
-) ([]kargoapi.Image, error) {
+) ([]kargoapi.Image, []kargoapi.SubscriptionRejections, error) {
 	imgs := make([]kargoapi.Image, 0, len(subs))
+	var rejections []kargoapi.SubscriptionRejections
 	for _, s := range subs {
 		if s.Image == nil {
 			continue
@@ -32,7 +33,7 @@ func (r *reconciler) getLatestImages(
 		creds, ok, err :=
 			r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
 		if err != nil {
-			return nil, errors.Wrapf(
+			return nil, rejections, errors.Wrapf(
 				err,
 				"error obtaining credentials for image repo %q",
 				sub.RepoURL,
@@ -49,7 +50,7 @@ func (r *reconciler) getLatestImages(
 			logger.Debug("found no credentials for image repo")
 		}
 
-		tag, err := r.getLatestTagFn(
+		tag, rejected, err := r.getLatestTagFn(
 			sub.RepoURL,
 			sub.UpdateStrategy,
 			sub.SemverConstraint,
@@ -58,8 +59,11 @@ func (r *reconciler) getLatestImages(
 			sub.Platform,
 			regCreds,
 		)
+		if subRejections := newSubscriptionRejections(sub.RepoURL, "", rejected); subRejections != nil {
+			rejections = append(rejections, *subRejections)
+		}
 		if err != nil {
-			return nil, errors.Wrapf(
+			return nil, rejections, errors.Wrapf(
 				err,
 				"error getting latest suitable tag for image %q",
 				sub.RepoURL,
@@ -76,7 +80,7 @@ func (r *reconciler) getLatestImages(
 		logger.WithField("tag", tag).
 			Debug("found latest suitable image tag")
 	}
-	return imgs, nil
+	return imgs, rejections, nil
 }
 
 const (
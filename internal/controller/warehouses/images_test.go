@@ -25,8 +25,8 @@ func TestGetLatestImages(t *testing.T) {
 			[]string,
 			string,
 			*images.Credentials,
-		) (string, error)
-		assertions func([]kargoapi.Image, error)
+		) (string, []kargoapi.RejectedVersion, error)
+		assertions func([]kargoapi.Image, []kargoapi.SubscriptionRejections, error)
 	}{
 		{
 			name: "error getting latest version of an image",
@@ -48,11 +48,26 @@ func TestGetLatestImages(t *testing.T) {
 				ignoreTags []string,
 				platform string,
 				creds *images.Credentials,
-			) (string, error) {
-				return "", errors.New("something went wrong")
+			) (string, []kargoapi.RejectedVersion, error) {
+				return "", []kargoapi.RejectedVersion{
+					{Version: "1.0.0", Reason: "fake-reason"},
+				}, errors.New("something went wrong")
 			},
-			assertions: func(_ []kargoapi.Image, err error) {
+			assertions: func(_ []kargoapi.Image, rejections []kargoapi.SubscriptionRejections, err error) {
 				require.Error(t, err)
+				// Rejections should be reported even though an error occurred
+				require.Equal(
+					t,
+					[]kargoapi.SubscriptionRejections{
+						{
+							RepoURL: "fake-url",
+							Versions: []kargoapi.RejectedVersion{
+								{Version: "1.0.0", Reason: "fake-reason"},
+							},
+						},
+					},
+					rejections,
+				)
 				require.Contains(
 					t,
 					err.Error(),
@@ -82,11 +97,12 @@ func TestGetLatestImages(t *testing.T) {
 				ignoreTags []string,
 				platform string,
 				creds *images.Credentials,
-			) (string, error) {
-				return "fake-tag", nil
+			) (string, []kargoapi.RejectedVersion, error) {
+				return "fake-tag", nil, nil
 			},
-			assertions: func(images []kargoapi.Image, err error) {
+			assertions: func(images []kargoapi.Image, rejections []kargoapi.SubscriptionRejections, err error) {
 				require.NoError(t, err)
+				require.Empty(t, rejections)
 				require.Len(t, images, 1)
 				require.Equal(
 					t,
@@ -0,0 +1,58 @@
+package warehouses
+
+import (
+	"sort"
+
+	"github.com/Masterminds/semver"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// maxRejectedVersions is the maximum number of rejected versions recorded in a
+// Warehouse's status for any one subscription. Repositories can contain
+// thousands of versions, so only the newest are recorded.
+const maxRejectedVersions = 10
+
+// newSubscriptionRejections returns a SubscriptionRejections describing the
+// newest of the provided rejected versions of the artifacts found at the
+// specified repository. Versions are ordered semantically where possible and
+// lexically otherwise, with semantic versions first. nil is returned if there
+// are no rejected versions.
+func newSubscriptionRejections(
+	repoURL string,
+	chart string,
+	rejected []kargoapi.RejectedVersion,
+) *kargoapi.SubscriptionRejections {
+	if len(rejected) == 0 {
+		return nil
+	}
+	versions := make([]kargoapi.RejectedVersion, len(rejected))
+	copy(versions, rejected)
+	semvers := make(map[string]*semver.Version, len(versions))
+	for _, v := range versions {
+		if sv, err := semver.NewVersion(v.Version); err == nil {
+			semvers[v.Version] = sv
+		}
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		iSemver, jSemver := semvers[versions[i].Version], semvers[versions[j].Version]
+		switch {
+		case iSemver != nil && jSemver != nil:
+			return iSemver.GreaterThan(jSemver)
+		case iSemver != nil || jSemver != nil:
+			return iSemver != nil
+		default:
+			return versions[i].Version > versions[j].Version
+		}
+	})
+	rejections := &kargoapi.SubscriptionRejections{
+		RepoURL:  repoURL,
+		Chart:    chart,
+		Versions: versions,
+	}
+	if len(versions) > maxRejectedVersions {
+		rejections.Versions = versions[:maxRejectedVersions]
+		rejections.Omitted = int32(len(versions) - maxRejectedVersions)
+	}
+	return rejections
+}
@@ -0,0 +1,76 @@
+package warehouses
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewSubscriptionRejections(t *testing.T) {
+	testCases := []struct {
+		name       string
+		rejected   []kargoapi.RejectedVersion
+		assertions func(*kargoapi.SubscriptionRejections)
+	}{
+		{
+			name: "no rejected versions",
+			assertions: func(rejections *kargoapi.SubscriptionRejections) {
+				require.Nil(t, rejections)
+			},
+		},
+		{
+			name: "rejected versions are sorted",
+			rejected: []kargoapi.RejectedVersion{
+				{Version: "alpha", Reason: "fake-reason"},
+				{Version: "1.2.0", Reason: "fake-reason"},
+				{Version: "beta", Reason: "fake-reason"},
+				{Version: "1.10.0", Reason: "fake-reason"},
+			},
+			assertions: func(rejections *kargoapi.SubscriptionRejections) {
+				require.Equal(
+					t,
+					&kargoapi.SubscriptionRejections{
+						RepoURL: "fake-url",
+						Chart:   "fake-chart",
+						Versions: []kargoapi.RejectedVersion{
+							{Version: "1.10.0", Reason: "fake-reason"},
+							{Version: "1.2.0", Reason: "fake-reason"},
+							{Version: "beta", Reason: "fake-reason"},
+							{Version: "alpha", Reason: "fake-reason"},
+						},
+					},
+					rejections,
+				)
+			},
+		},
+		{
+			name: "rejected versions are capped",
+			rejected: func() []kargoapi.RejectedVersion {
+				rejected := make([]kargoapi.RejectedVersion, maxRejectedVersions+5)
+				for i := range rejected {
+					rejected[i] = kargoapi.RejectedVersion{
+						Version: fmt.Sprintf("1.0.%d", i),
+						Reason:  "fake-reason",
+					}
+				}
+				return rejected
+			}(),
+			assertions: func(rejections *kargoapi.SubscriptionRejections) {
+				require.NotNil(t, rejections)
+				require.Len(t, rejections.Versions, maxRejectedVersions)
+				require.Equal(t, "1.0.14", rejections.Versions[0].Version)
+				require.Equal(t, int32(5), rejections.Omitted)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				newSubscriptionRejections("fake-url", "fake-chart", testCase.rejected),
+			)
+		})
+	}
+}
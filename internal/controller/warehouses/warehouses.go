@@ -2,6 +2,7 @@ package warehouses
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -31,10 +32,16 @@ type reconciler struct {
 
 	// The following behaviors are overridable for testing purposes:
 
+	isProjectArchivedFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (bool, error)
+
 	getLatestFreightFromReposFn func(
 		context.Context,
 		*kargoapi.Warehouse,
-	) (*kargoapi.Freight, error)
+	) (*kargoapi.Freight, []kargoapi.SubscriptionRejections, error)
 
 	getLatestCommitsFn func(
 		ctx context.Context,
@@ -46,7 +53,7 @@ type reconciler struct {
 		ctx context.Context,
 		namespace string,
 		subs []kargoapi.RepoSubscription,
-	) ([]kargoapi.Image, error)
+	) ([]kargoapi.Image, []kargoapi.SubscriptionRejections, error)
 
 	getLatestTagFn func(
 		repoURL string,
@@ -56,13 +63,13 @@ type reconciler struct {
 		ignoreTags []string,
 		platform string,
 		creds *images.Credentials,
-	) (string, error)
+	) (string, []kargoapi.RejectedVersion, error)
 
 	getLatestChartsFn func(
 		ctx context.Context,
 		namespace string,
 		subs []kargoapi.RepoSubscription,
-	) ([]kargoapi.Chart, error)
+	) ([]kargoapi.Chart, []kargoapi.SubscriptionRejections, error)
 
 	getLatestChartVersionFn func(
 		ctx context.Context,
@@ -70,7 +77,7 @@ type reconciler struct {
 		chart string,
 		semverConstraint string,
 		creds *helm.Credentials,
-	) (string, error)
+	) (string, []kargoapi.RejectedVersion, error)
 
 	getLatestCommitMetaFn func(
 		ctx context.Context,
@@ -84,6 +91,18 @@ type reconciler struct {
 		client.Object,
 		...client.CreateOption,
 	) error
+
+	listFreightFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
+	patchFreightStatusFn func(
+		ctx context.Context,
+		freight *kargoapi.Freight,
+		update func(status *kargoapi.FreightStatus),
+	) error
 }
 
 // SetupReconcilerWithManager initializes a reconciler for Warehouse resources
@@ -92,26 +111,33 @@ func SetupReconcilerWithManager(
 	mgr manager.Manager,
 	credentialsDB credentials.Database,
 ) error {
-	return errors.Wrap(
-		ctrl.NewControllerManagedBy(mgr).
-			For(&kargoapi.Warehouse{}).
-			WithEventFilter(
-				predicate.Funcs{
-					DeleteFunc: func(event.DeleteEvent) bool {
-						// We're not interested in any deletes
-						return false
-					},
+	c, err := ctrl.NewControllerManagedBy(mgr).
+		For(&kargoapi.Warehouse{}).
+		WithEventFilter(
+			predicate.Funcs{
+				DeleteFunc: func(event.DeleteEvent) bool {
+					// We're not interested in any deletes
+					return false
 				},
-			).
-			WithEventFilter(
-				predicate.Or(
-					predicate.GenerationChangedPredicate{},
-					predicate.AnnotationChangedPredicate{},
-				),
-			).
-			WithOptions(controller.CommonOptions()).
-			Complete(newReconciler(mgr.GetClient(), credentialsDB)),
-		"error building Warehouse reconciler",
+			},
+		).
+		WithEventFilter(
+			predicate.Or(
+				predicate.GenerationChangedPredicate{},
+				predicate.AnnotationChangedPredicate{},
+			),
+		).
+		WithOptions(controller.CommonOptions("warehouse")).
+		Build(newReconciler(mgr.GetClient(), credentialsDB))
+	if err != nil {
+		return errors.Wrap(err, "error building Warehouse reconciler")
+	}
+
+	// Watch Projects that were unarchived and enqueue their Warehouses
+	return controller.WatchProjectUnarchival(
+		c,
+		mgr.GetClient(),
+		func() client.ObjectList { return &kargoapi.WarehouseList{} },
 	)
 }
 
@@ -126,6 +152,7 @@ func newReconciler(
 			githubURLPrefix: getGithubImageSourceURL,
 		},
 	}
+	r.isProjectArchivedFn = kargoapi.IsProjectArchived
 	r.getLatestFreightFromReposFn = r.getLatestFreightFromRepos
 	r.getLatestCommitsFn = r.getLatestCommits
 	r.getLatestImagesFn = r.getLatestImages
@@ -134,6 +161,8 @@ func newReconciler(
 	r.getLatestChartVersionFn = helm.GetLatestChartVersion
 	r.getLatestCommitMetaFn = getLatestCommitMeta
 	r.createFreightFn = kubeClient.Create
+	r.listFreightFn = kubeClient.List
+	r.patchFreightStatusFn = r.patchFreightStatus
 	return r
 }
 
@@ -144,7 +173,6 @@ func (r *reconciler) Reconcile(
 	req ctrl.Request,
 ) (ctrl.Result, error) {
 	result := ctrl.Result{
-		// TODO: Make this configurable
 		// Note: If there is a failure, controller runtime ignores this and uses
 		// progressive backoff instead. So this value only affects when we will
 		// reconcile next if THIS reconciliation succeeds.
@@ -170,8 +198,21 @@ func (r *reconciler) Reconcile(
 		// the current reconciliation request was issued.
 		return result, nil
 	}
+	result.RequeueAfter = getRequeueInterval(warehouse)
+
+	// Resources of archived Projects are not reconciled. They are enqueued
+	// again when the Project is unarchived.
+	if archived, err := r.isProjectArchivedFn(ctx, r.client, req.Namespace); err != nil {
+		return ctrl.Result{}, err
+	} else if archived {
+		logger.Debug("Project is archived; skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
 
 	newStatus, err := r.syncWarehouse(ctx, warehouse)
+	if err == nil {
+		err = r.mergeBuildInfo(ctx, warehouse)
+	}
 	if err != nil {
 		newStatus.Error = err.Error()
 		logger.Errorf("error syncing Warehouse: %s", err)
@@ -205,6 +246,17 @@ func (r *reconciler) Reconcile(
 	return result, err
 }
 
+// getRequeueInterval returns the interval at which the provided Warehouse is
+// to be reconciled. Zero is returned if the Warehouse's spec does not specify
+// one, in which case the Warehouse is not requeued.
+func getRequeueInterval(warehouse *kargoapi.Warehouse) time.Duration {
+	if warehouse.Spec != nil && warehouse.Spec.ReconcileInterval != nil &&
+		warehouse.Spec.ReconcileInterval.Duration > 0 {
+		return warehouse.Spec.ReconcileInterval.Duration
+	}
+	return 0
+}
+
 func (r *reconciler) syncWarehouse(
 	ctx context.Context,
 	warehouse *kargoapi.Warehouse,
@@ -215,7 +267,10 @@ func (r *reconciler) syncWarehouse(
 
 	logger := logging.LoggerFromContext(ctx)
 
-	freight, err := r.getLatestFreightFromReposFn(ctx, warehouse)
+	freight, rejections, err := r.getLatestFreightFromReposFn(ctx, warehouse)
+	// Rejections are recorded even if discovery failed, since they are most
+	// useful for understanding why no suitable version was found.
+	status.Rejections = rejections
 	if err != nil {
 		return status,
 			errors.Wrap(err, "error getting latest Freight from repositories")
@@ -251,10 +306,72 @@ func (r *reconciler) syncWarehouse(
 	return status, nil
 }
 
+// mergeBuildInfo merges build metadata recorded on the provided Warehouse onto
+// each piece of Freight it has produced that carries the artifact described by
+// that metadata.
+func (r *reconciler) mergeBuildInfo(
+	ctx context.Context,
+	warehouse *kargoapi.Warehouse,
+) error {
+	if len(warehouse.Status.BuildInfo) == 0 {
+		return nil
+	}
+	var freightList kargoapi.FreightList
+	if err := r.listFreightFn(
+		ctx,
+		&freightList,
+		client.InNamespace(warehouse.Namespace),
+	); err != nil {
+		return errors.Wrapf(
+			err,
+			"error listing Freight in namespace %q",
+			warehouse.Namespace,
+		)
+	}
+	for i := range freightList.Items {
+		freight := &freightList.Items[i]
+		if !metav1.IsControlledBy(freight, warehouse) {
+			continue
+		}
+		var updates []kargoapi.BuildInfo
+		for _, buildInfo := range warehouse.Status.BuildInfo {
+			if freight.CarriesArtifact(buildInfo.RepoURL, buildInfo.Revision) {
+				updates = append(updates, buildInfo)
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+		if err := r.patchFreightStatusFn(
+			ctx,
+			freight,
+			func(status *kargoapi.FreightStatus) {
+				status.BuildInfo = kargoapi.MergeBuildInfo(status.BuildInfo, updates...)
+			},
+		); err != nil {
+			return errors.Wrapf(
+				err,
+				"error merging build info onto Freight %q in namespace %q",
+				freight.Name,
+				freight.Namespace,
+			)
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) patchFreightStatus(
+	ctx context.Context,
+	freight *kargoapi.Freight,
+	update func(status *kargoapi.FreightStatus),
+) error {
+	return kubeclient.PatchStatus(ctx, r.client, freight, update)
+}
+
 func (r *reconciler) getLatestFreightFromRepos(
 	ctx context.Context,
 	warehouse *kargoapi.Warehouse,
-) (*kargoapi.Freight, error) {
+) (*kargoapi.Freight, []kargoapi.SubscriptionRejections, error) {
 	logger := logging.LoggerFromContext(ctx)
 
 	latestCommits, err := r.getLatestCommitsFn(
@@ -263,27 +380,30 @@ func (r *reconciler) getLatestFreightFromRepos(
 		warehouse.Spec.Subscriptions,
 	)
 	if err != nil {
-		return nil, errors.Wrap(err, "error syncing git repo subscriptions")
+		return nil, nil, errors.Wrap(err, "error syncing git repo subscriptions")
 	}
 	logger.Debug("synced git repo subscriptions")
 
-	latestImages, err := r.getLatestImagesFn(
+	latestImages, imageRejections, err := r.getLatestImagesFn(
 		ctx,
 		warehouse.Namespace,
 		warehouse.Spec.Subscriptions,
 	)
 	if err != nil {
-		return nil, errors.Wrap(err, "error syncing image repo subscriptions")
+		return nil, imageRejections,
+			errors.Wrap(err, "error syncing image repo subscriptions")
 	}
 	logger.Debug("synced image repo subscriptions")
 
-	latestCharts, err := r.getLatestChartsFn(
+	latestCharts, chartRejections, err := r.getLatestChartsFn(
 		ctx,
 		warehouse.Namespace,
 		warehouse.Spec.Subscriptions,
 	)
+	rejections := append(imageRejections, chartRejections...)
 	if err != nil {
-		return nil, errors.Wrap(err, "error syncing chart repo subscriptions")
+		return nil, rejections,
+			errors.Wrap(err, "error syncing chart repo subscriptions")
 	}
 	logger.Debug("synced chart repo subscriptions")
 
@@ -302,5 +422,5 @@ func (r *reconciler) getLatestFreightFromRepos(
 	}
 	freight.UpdateID()
 	freight.ObjectMeta.Name = freight.ID
-	return freight, nil
+	return freight, rejections, nil
 }
@@ -3,6 +3,7 @@ package warehouses
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -28,6 +29,7 @@ func TestNewReconciler(t *testing.T) {
 	require.NotEmpty(t, e.imageSourceURLFnsByBaseURL)
 
 	// Assert that all overridable behaviors were initialized to a default:
+	require.NotNil(t, e.isProjectArchivedFn)
 	require.NotNil(t, e.getLatestFreightFromReposFn)
 	require.NotNil(t, e.getLatestCommitsFn)
 	require.NotNil(t, e.getLatestImagesFn)
@@ -36,6 +38,24 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, e.getLatestChartVersionFn)
 	require.NotNil(t, e.getLatestCommitMetaFn)
 	require.NotNil(t, e.createFreightFn)
+	require.NotNil(t, e.listFreightFn)
+	require.NotNil(t, e.patchFreightStatusFn)
+}
+
+func TestGetRequeueInterval(t *testing.T) {
+	require.Zero(t, getRequeueInterval(&kargoapi.Warehouse{}))
+	require.Zero(t, getRequeueInterval(&kargoapi.Warehouse{
+		Spec: &kargoapi.WarehouseSpec{},
+	}))
+	require.Equal(
+		t,
+		time.Minute,
+		getRequeueInterval(&kargoapi.Warehouse{
+			Spec: &kargoapi.WarehouseSpec{
+				ReconcileInterval: &metav1.Duration{Duration: time.Minute},
+			},
+		}),
+	)
 }
 
 func TestSyncWarehouse(t *testing.T) {
@@ -47,7 +67,7 @@ func TestSyncWarehouse(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func(error)
+		assertions func(kargoapi.WarehouseStatus, error)
 	}{
 		{
 			name: "error getting latest Freight from repos",
@@ -55,13 +75,21 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
-				) (*kargoapi.Freight, error) {
-					return nil, errors.New("something went wrong")
+				) (*kargoapi.Freight, []kargoapi.SubscriptionRejections, error) {
+					return nil, []kargoapi.SubscriptionRejections{
+						{RepoURL: "fake-url"},
+					}, errors.New("something went wrong")
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "something went wrong")
+				// Rejections should be recorded even though an error occurred
+				require.Equal(
+					t,
+					[]kargoapi.SubscriptionRejections{{RepoURL: "fake-url"}},
+					status.Rejections,
+				)
 				require.Contains(
 					t,
 					err.Error(),
@@ -76,11 +104,11 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
-				) (*kargoapi.Freight, error) {
-					return nil, nil
+				) (*kargoapi.Freight, []kargoapi.SubscriptionRejections, error) {
+					return nil, nil, nil
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(_ kargoapi.WarehouseStatus, err error) {
 				require.NoError(t, err)
 			},
 		},
@@ -91,8 +119,8 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{}, nil
+				) (*kargoapi.Freight, []kargoapi.SubscriptionRejections, error) {
+					return &kargoapi.Freight{}, nil, nil
 				},
 				createFreightFn: func(
 					context.Context,
@@ -108,7 +136,7 @@ func TestSyncWarehouse(t *testing.T) {
 					)
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(_ kargoapi.WarehouseStatus, err error) {
 				require.NoError(t, err)
 			},
 		},
@@ -119,8 +147,8 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{}, nil
+				) (*kargoapi.Freight, []kargoapi.SubscriptionRejections, error) {
+					return &kargoapi.Freight{}, nil, nil
 				},
 				createFreightFn: func(
 					context.Context,
@@ -130,7 +158,7 @@ func TestSyncWarehouse(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(_ kargoapi.WarehouseStatus, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "something went wrong")
 				require.Contains(t, err.Error(), "error creating Freight")
@@ -143,13 +171,13 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
-				) (*kargoapi.Freight, error) {
+				) (*kargoapi.Freight, []kargoapi.SubscriptionRejections, error) {
 					return &kargoapi.Freight{
 						ObjectMeta: metav1.ObjectMeta{
 							Name:      "fake-freight",
 							Namespace: "fake-namespace",
 						},
-					}, nil
+					}, nil, nil
 				},
 				createFreightFn: func(
 					context.Context,
@@ -159,25 +187,171 @@ func TestSyncWarehouse(t *testing.T) {
 					return nil
 				},
 			},
+			assertions: func(_ kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.reconciler.syncWarehouse(context.Background(), testWarehouse),
+			)
+		})
+	}
+}
+
+func TestMergeBuildInfo(t *testing.T) {
+	testWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-warehouse",
+			Namespace: "fake-namespace",
+			UID:       "fake-uid",
+		},
+		Status: kargoapi.WarehouseStatus{
+			BuildInfo: []kargoapi.BuildInfo{
+				{
+					RepoURL:     "fake-image-repo",
+					Revision:    "fake-tag",
+					PipelineURL: "fake-pipeline-url",
+				},
+			},
+		},
+	}
+	newFreight := func(name string, owned bool, tag string) kargoapi.Freight {
+		freight := kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "fake-namespace",
+			},
+			Images: []kargoapi.Image{
+				{
+					RepoURL: "fake-image-repo",
+					Tag:     tag,
+				},
+			},
+		}
+		if owned {
+			freight.OwnerReferences = []metav1.OwnerReference{
+				*metav1.NewControllerRef(
+					testWarehouse,
+					kargoapi.GroupVersion.WithKind("Warehouse"),
+				),
+			}
+		}
+		return freight
+	}
+	testCases := []struct {
+		name       string
+		warehouse  *kargoapi.Warehouse
+		reconciler *reconciler
+		assertions func(error)
+	}{
+		{
+			name:       "no build info",
+			warehouse:  &kargoapi.Warehouse{},
+			reconciler: &reconciler{},
 			assertions: func(err error) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name:      "error listing Freight",
+			warehouse: testWarehouse,
+			reconciler: &reconciler{
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error listing Freight")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name:      "error patching Freight status",
+			warehouse: testWarehouse,
+			reconciler: &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freightList := objList.(*kargoapi.FreightList) // nolint: forcetypeassert
+					freightList.Items = []kargoapi.Freight{
+						newFreight("fake-freight", true, "fake-tag"),
+					}
+					return nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					func(*kargoapi.FreightStatus),
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error merging build info onto Freight")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			_, err :=
-				testCase.reconciler.syncWarehouse(context.Background(), testWarehouse)
-			testCase.assertions(err)
+			testCase.assertions(
+				testCase.reconciler.mergeBuildInfo(
+					context.Background(),
+					testCase.warehouse,
+				),
+			)
 		})
 	}
+
+	t.Run("success", func(t *testing.T) {
+		var patched []string
+		r := &reconciler{
+			listFreightFn: func(
+				_ context.Context,
+				objList client.ObjectList,
+				_ ...client.ListOption,
+			) error {
+				freightList := objList.(*kargoapi.FreightList) // nolint: forcetypeassert
+				freightList.Items = []kargoapi.Freight{
+					newFreight("carries-artifact", true, "fake-tag"),
+					newFreight("not-owned", false, "fake-tag"),
+					newFreight("different-artifact", true, "other-tag"),
+				}
+				return nil
+			},
+			patchFreightStatusFn: func(
+				_ context.Context,
+				freight *kargoapi.Freight,
+				update func(*kargoapi.FreightStatus),
+			) error {
+				patched = append(patched, freight.Name)
+				status := &kargoapi.FreightStatus{}
+				update(status)
+				require.Equal(t, testWarehouse.Status.BuildInfo, status.BuildInfo)
+				return nil
+			},
+		}
+		require.NoError(t, r.mergeBuildInfo(context.Background(), testWarehouse))
+		require.Equal(t, []string{"carries-artifact"}, patched)
+	})
 }
 
 func TestGetLatestFreightFromRepos(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func(*kargoapi.Freight, error)
+		assertions func(*kargoapi.Freight, []kargoapi.SubscriptionRejections, error)
 	}{
 		{
 			name: "error getting latest git commits",
@@ -190,7 +364,7 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(freight *kargoapi.Freight, err error) {
+			assertions: func(freight *kargoapi.Freight, _ []kargoapi.SubscriptionRejections, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error syncing git repo subscription")
 				require.Contains(t, err.Error(), "something went wrong")
@@ -211,11 +385,11 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Image, error) {
-					return nil, errors.New("something went wrong")
+				) ([]kargoapi.Image, []kargoapi.SubscriptionRejections, error) {
+					return nil, nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(freight *kargoapi.Freight, err error) {
+			assertions: func(freight *kargoapi.Freight, _ []kargoapi.SubscriptionRejections, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -240,18 +414,18 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Image, error) {
-					return nil, nil
+				) ([]kargoapi.Image, []kargoapi.SubscriptionRejections, error) {
+					return nil, nil, nil
 				},
 				getLatestChartsFn: func(
 					context.Context,
 					string,
 					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Chart, error) {
-					return nil, errors.New("something went wrong")
+				) ([]kargoapi.Chart, []kargoapi.SubscriptionRejections, error) {
+					return nil, nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(freight *kargoapi.Freight, err error) {
+			assertions: func(freight *kargoapi.Freight, _ []kargoapi.SubscriptionRejections, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -281,29 +455,29 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Image, error) {
+				) ([]kargoapi.Image, []kargoapi.SubscriptionRejections, error) {
 					return []kargoapi.Image{
 						{
 							RepoURL: "fake-url",
 							Tag:     "fake-tag",
 						},
-					}, nil
+					}, nil, nil
 				},
 				getLatestChartsFn: func(
 					context.Context,
 					string,
 					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Chart, error) {
+				) ([]kargoapi.Chart, []kargoapi.SubscriptionRejections, error) {
 					return []kargoapi.Chart{
 						{
 							RegistryURL: "fake-registry",
 							Name:        "fake-chart",
 							Version:     "fake-version",
 						},
-					}, nil
+					}, nil, nil
 				},
 			},
-			assertions: func(freight *kargoapi.Freight, err error) {
+			assertions: func(freight *kargoapi.Freight, _ []kargoapi.SubscriptionRejections, err error) {
 				require.NoError(t, err)
 				require.NotNil(t, freight)
 				require.NotEmpty(t, freight.Name)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
 	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,6 +43,60 @@ type Credentials struct {
 	SSHPrivateKey string
 }
 
+// SecretCredentials represents the Credentials stored in a Kubernetes Secret,
+// along with the type and URL of the repository or repositories they apply to.
+type SecretCredentials struct {
+	Credentials
+	// Type is the type of repository the Credentials apply to.
+	Type Type
+	// URL is the URL of the repository the Credentials apply to or, if Template
+	// is true, a prefix of the URLs of all repositories they apply to.
+	URL string
+	// Template indicates whether the Secret holds a credentials template.
+	Template bool
+}
+
+// FromSecret returns the SecretCredentials stored in the provided Secret. An
+// error is returned if the Secret is not labeled as holding Kargo repository
+// credentials or if it does not specify a valid repository type and URL.
+func FromSecret(secret *corev1.Secret) (*SecretCredentials, error) {
+	creds := &SecretCredentials{
+		Credentials: secretToCreds(secret),
+		Type:        Type(secret.Data["type"]),
+		URL:         string(secret.Data["url"]),
+	}
+	switch secret.Labels[kargoSecretTypeLabel] {
+	case common.LabelValueSecretTypeRepository:
+	case common.LabelValueSecretTypeRepoCreds:
+		creds.Template = true
+	default:
+		return nil, errors.Errorf(
+			"Secret %q is not labeled %s=%s or %s=%s",
+			secret.Name,
+			kargoSecretTypeLabel,
+			common.LabelValueSecretTypeRepository,
+			kargoSecretTypeLabel,
+			common.LabelValueSecretTypeRepoCreds,
+		)
+	}
+	switch creds.Type {
+	case TypeGit, TypeHelm, TypeImage:
+	default:
+		return nil, errors.Errorf(
+			"Secret %q specifies invalid repository type %q",
+			secret.Name,
+			creds.Type,
+		)
+	}
+	if creds.URL == "" {
+		return nil, errors.Errorf(
+			"Secret %q does not specify a repository URL",
+			secret.Name,
+		)
+	}
+	return creds, nil
+}
+
 // Database is an interface for a Credentials store.
 type Database interface {
 	Get(
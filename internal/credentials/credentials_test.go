@@ -148,3 +148,95 @@ func TestSecretToCreds(t *testing.T) {
 	require.Equal(t, string(secret.Data["password"]), creds.Password)
 	require.Equal(t, string(secret.Data["sshPrivateKey"]), creds.SSHPrivateKey)
 }
+
+func TestFromSecret(t *testing.T) {
+	testCases := []struct {
+		name       string
+		secret     *corev1.Secret
+		assertions func(*SecretCredentials, error)
+	}{
+		{
+			name: "not labeled as credentials",
+			secret: &corev1.Secret{
+				ObjectMeta: v1.ObjectMeta{Name: "fake-secret"},
+				Data: map[string][]byte{
+					"type": []byte(TypeGit),
+					"url":  []byte("https://github.com/example/example.git"),
+				},
+			},
+			assertions: func(_ *SecretCredentials, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is not labeled")
+			},
+		},
+		{
+			name: "invalid type",
+			secret: &corev1.Secret{
+				ObjectMeta: v1.ObjectMeta{
+					Name:   "fake-secret",
+					Labels: map[string]string{kargoSecretTypeLabel: "repository"},
+				},
+				Data: map[string][]byte{
+					"type": []byte("bogus"),
+					"url":  []byte("https://github.com/example/example.git"),
+				},
+			},
+			assertions: func(_ *SecretCredentials, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid repository type")
+			},
+		},
+		{
+			name: "missing URL",
+			secret: &corev1.Secret{
+				ObjectMeta: v1.ObjectMeta{
+					Name:   "fake-secret",
+					Labels: map[string]string{kargoSecretTypeLabel: "repository"},
+				},
+				Data: map[string][]byte{
+					"type": []byte(TypeImage),
+				},
+			},
+			assertions: func(_ *SecretCredentials, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "does not specify a repository URL")
+			},
+		},
+		{
+			name: "credentials template",
+			secret: &corev1.Secret{
+				ObjectMeta: v1.ObjectMeta{
+					Name:   "fake-secret",
+					Labels: map[string]string{kargoSecretTypeLabel: "repo-creds"},
+				},
+				Data: map[string][]byte{
+					"type":     []byte(TypeGit),
+					"url":      []byte("https://github.com/example"),
+					"username": []byte("fake-username"),
+					"password": []byte("fake-password"),
+				},
+			},
+			assertions: func(creds *SecretCredentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&SecretCredentials{
+						Credentials: Credentials{
+							Username: "fake-username",
+							Password: "fake-password",
+						},
+						Type:     TypeGit,
+						URL:      "https://github.com/example",
+						Template: true,
+					},
+					creds,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(FromSecret(testCase.secret))
+		})
+	}
+}
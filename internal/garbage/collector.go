@@ -148,7 +148,11 @@ func (c *collector) Run(ctx context.Context) error {
 	}()
 
 	// Distribute work across workers
-	for _, project := range projects.Items {
+	for i, project := range projects.Items {
+		// The history of archived Projects is retained in full
+		if kargoapi.IsNamespaceArchived(&projects.Items[i]) {
+			continue
+		}
 		select {
 		case projectCh <- project.Name:
 		case <-ctx.Done():
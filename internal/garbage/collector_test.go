@@ -141,6 +141,42 @@ func TestRun(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+
+		{
+			name: "archived Projects are skipped",
+			listProjectsFn: func(
+				_ context.Context,
+				objList client.ObjectList,
+				_ ...client.ListOption,
+			) error {
+				projects, ok := objList.(*corev1.NamespaceList)
+				require.True(t, ok)
+				projects.Items = []corev1.Namespace{{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-project",
+						Labels: map[string]string{
+							kargoapi.LabelArchivedKey: kargoapi.LabelTrueValue,
+						},
+					},
+				}}
+				return nil
+			},
+			cleanProjectsFn: func(
+				ctx context.Context,
+				projectCh <-chan string,
+				_ chan<- struct{},
+			) {
+				select {
+				case project, ok := <-projectCh:
+					require.False(t, ok, "archived Project %q was collected", project)
+				case <-ctx.Done():
+					require.FailNow(t, "timed out waiting for the channel to close")
+				}
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -16,6 +16,7 @@ import (
 	"oras.land/oras-go/pkg/registry/remote"
 	"oras.land/oras-go/pkg/registry/remote/auth"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	libExec "github.com/akuity/kargo/internal/exec"
 )
 
@@ -26,15 +27,17 @@ import (
 // the version that is semantically greatest will be returned. If a
 // semverConstraint is specified, then the semantically greatest version
 // satisfying that constraint will be returned. If no version satisfies the
-// constraint, the empty string is returned. Provided credentials may be nil for
-// public registries, but must be non-nil for private registries.
+// constraint, the empty string is returned. Versions that do not satisfy the
+// constraint are also returned, whether or not a suitable version was found.
+// Provided credentials may be nil for public registries, but must be non-nil
+// for private registries.
 func GetLatestChartVersion(
 	ctx context.Context,
 	registryURL string,
 	chart string,
 	semverConstraint string,
 	creds *Credentials,
-) (string, error) {
+) (string, []kargoapi.RejectedVersion, error) {
 	var versions []string
 	var err error
 	if strings.HasPrefix(registryURL, "http://") ||
@@ -45,18 +48,18 @@ func GetLatestChartVersion(
 		versions, err =
 			getChartVersionsFromOCIRegistry(ctx, registryURL, chart, creds)
 	} else {
-		return "", errors.Errorf("registry URL %q is invalid", registryURL)
+		return "", nil, errors.Errorf("registry URL %q is invalid", registryURL)
 	}
 	if err != nil {
-		return "", errors.Wrapf(
+		return "", nil, errors.Wrapf(
 			err,
 			"error retrieving versions of chart %q from registry %q",
 			chart,
 			registryURL,
 		)
 	}
-	latestVersion, err := getLatestVersion(versions, semverConstraint)
-	return latestVersion, errors.Wrapf(
+	latestVersion, rejected, err := getLatestVersion(versions, semverConstraint)
+	return latestVersion, rejected, errors.Wrapf(
 		err,
 		"error determining latest version of chart %q from  registry %q",
 		chart,
@@ -64,6 +67,66 @@ func GetLatestChartVersion(
 	)
 }
 
+// CheckAccess verifies that the Helm chart registry specified by registryURL can
+// be read using the provided credentials, which may be nil for public
+// registries. For a classic chart registry (using HTTP/S), this is done by
+// retrieving the registry's index. For an OCI registry, this is done by
+// querying the registry's API version endpoint, which obtains a token from the
+// registry, if the registry requires one.
+func CheckAccess(
+	ctx context.Context,
+	registryURL string,
+	creds *Credentials,
+) error {
+	var checkURL string
+	var doFn func(*http.Request) (*http.Response, error)
+	if strings.HasPrefix(registryURL, "http://") ||
+		strings.HasPrefix(registryURL, "https://") {
+		checkURL =
+			fmt.Sprintf("%s/index.yaml", strings.TrimSuffix(registryURL, "/"))
+		doFn = func(req *http.Request) (*http.Response, error) {
+			if creds != nil {
+				req.SetBasicAuth(creds.Username, creds.Password)
+			}
+			return http.DefaultClient.Do(req)
+		}
+	} else if strings.HasPrefix(registryURL, "oci://") {
+		host, _, _ := strings.Cut(strings.TrimPrefix(registryURL, "oci://"), "/")
+		checkURL = fmt.Sprintf("https://%s/v2/", host)
+		authClient := &auth.Client{
+			Credential: func(context.Context, string) (auth.Credential, error) {
+				if creds != nil {
+					return auth.Credential{
+						Username: creds.Username,
+						Password: creds.Password,
+					}, nil
+				}
+				return auth.Credential{}, nil
+			},
+		}
+		doFn = authClient.Do
+	} else {
+		return errors.Errorf("registry URL %q is invalid", registryURL)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, checkURL, nil)
+	if err != nil {
+		return errors.Wrapf(err, "error preparing HTTP/S request to %q", checkURL)
+	}
+	res, err := doFn(req)
+	if err != nil {
+		return errors.Wrapf(err, "error querying registry at %q", checkURL)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf(
+			"received unexpected HTTP %d when querying registry at %q",
+			res.StatusCode,
+			checkURL,
+		)
+	}
+	return nil
+}
+
 // getChartVersionsFromClassicRegistry connects to the classic (HTTP/S) chart
 // registry specified by registryURL and retrieves all available versions of the
 // specified chart. The provided registryURL MUST begin with protocol http:// or
@@ -169,29 +232,46 @@ func getChartVersionsFromOCIRegistry(
 // provided which satisfies the provided constraints. If no constraints are
 // specified (the empty string is passed), the absolute semantically greatest
 // version will be returned. The empty string will be returned when the provided
-// list of versions is nil or empty.
-func getLatestVersion(versions []string, constraintStr string) (string, error) {
+// list of versions is nil or empty. All versions that do not satisfy the
+// constraints are also returned, greatest first.
+func getLatestVersion(
+	versions []string,
+	constraintStr string,
+) (string, []kargoapi.RejectedVersion, error) {
 	semvers := make([]*semver.Version, len(versions))
 	for i, version := range versions {
 		var err error
 		if semvers[i], err = semver.NewVersion(version); err != nil {
-			return "", errors.Wrapf(err, "error parsing version %q", version)
+			return "", nil, errors.Wrapf(err, "error parsing version %q", version)
 		}
 	}
 	sort.Sort(semver.Collection(semvers))
 	if constraintStr == "" {
-		return semvers[len(semvers)-1].String(), nil
+		if len(semvers) == 0 {
+			return "", nil, nil
+		}
+		return semvers[len(semvers)-1].String(), nil, nil
 	}
 	constraint, err := semver.NewConstraint(constraintStr)
 	if err != nil {
-		return "", errors.Wrapf(err, "error parsing constraint %q", constraintStr)
+		return "", nil, errors.Wrapf(err, "error parsing constraint %q", constraintStr)
 	}
+	var latest string
+	var rejected []kargoapi.RejectedVersion
 	for i := len(semvers) - 1; i >= 0; i-- {
-		if constraint.Check(semvers[i]) {
-			return semvers[i].String(), nil
+		if !constraint.Check(semvers[i]) {
+			rejected = append(rejected, kargoapi.RejectedVersion{
+				Version: semvers[i].Original(),
+				Reason: fmt.Sprintf(
+					"does not satisfy semver constraint %q",
+					constraintStr,
+				),
+			})
+		} else if latest == "" {
+			latest = semvers[i].String()
 		}
 	}
-	return "", nil
+	return latest, rejected, nil
 }
 
 func UpdateChartDependencies(homePath, chartPath string) error {
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 )
 
 func TestGetChartVersionsFromClassicRegistry(t *testing.T) {
@@ -95,6 +97,71 @@ func TestGetChartVersionsFromClassicRegistry(t *testing.T) {
 	}
 }
 
+func TestCheckAccess(t *testing.T) {
+	// This is a mock registry that requires basic auth
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if username, password, ok := r.BasicAuth(); !ok ||
+					username != "fake-user" || password != "fake-password" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if r.URL.Path != "/fake-registry/index.yaml" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+		),
+	)
+	defer testServer.Close()
+	testRegistryURL := fmt.Sprintf("%s/fake-registry", testServer.URL)
+
+	testCases := []struct {
+		name        string
+		registryURL string
+		creds       *Credentials
+		assertions  func(error)
+	}{
+		{
+			name:        "invalid registry URL",
+			registryURL: "ftp://fake-registry",
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is invalid")
+			},
+		},
+		{
+			name:        "missing credentials",
+			registryURL: testRegistryURL,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "received unexpected HTTP 401")
+			},
+		},
+		{
+			name:        "valid credentials",
+			registryURL: testRegistryURL,
+			creds: &Credentials{
+				Username: "fake-user",
+				Password: "fake-password",
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				CheckAccess(context.Background(), testCase.registryURL, testCase.creds),
+			)
+		})
+	}
+}
+
 func TestGetChartVersionsFromOCIRegistry(t *testing.T) {
 	// Instead of mocking out an OCI registry, it's more expedient to use Kargo's
 	// own chart repo on ghcr.io to test this.
@@ -115,12 +182,12 @@ func TestGetLatestVersion(t *testing.T) {
 		name       string
 		unsorted   []string
 		constraint string
-		assertions func(latest string, err error)
+		assertions func(latest string, rejected []kargoapi.RejectedVersion, err error)
 	}{
 		{
 			name:     "error parsing versions",
 			unsorted: []string{"not-semantic"},
-			assertions: func(_ string, err error) {
+			assertions: func(_ string, _ []kargoapi.RejectedVersion, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error parsing version")
 			},
@@ -129,7 +196,7 @@ func TestGetLatestVersion(t *testing.T) {
 			name:       "error parsing constraint",
 			unsorted:   []string{"1.0.0"},
 			constraint: "invalid",
-			assertions: func(_ string, err error) {
+			assertions: func(_ string, _ []kargoapi.RejectedVersion, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error parsing constraint")
 			},
@@ -138,26 +205,36 @@ func TestGetLatestVersion(t *testing.T) {
 			name:       "success with constraint",
 			unsorted:   []string{"2.0.0", "1.0.0", "1.1.0"},
 			constraint: "^1.0.0",
-			assertions: func(latest string, err error) {
+			assertions: func(latest string, rejected []kargoapi.RejectedVersion, err error) {
 				require.NoError(t, err)
 				require.Equal(t, "1.1.0", latest)
+				require.Equal(
+					t,
+					[]kargoapi.RejectedVersion{
+						{Version: "2.0.0", Reason: `does not satisfy semver constraint "^1.0.0"`},
+					},
+					rejected,
+				)
 			},
 		},
 		{
 			name:     "success with no constraint",
 			unsorted: []string{"2.0.0", "1.0.0", "1.1.0"},
-			assertions: func(latest string, err error) {
+			assertions: func(latest string, rejected []kargoapi.RejectedVersion, err error) {
 				require.NoError(t, err)
 				require.Equal(t, "2.0.0", latest)
+				require.Empty(t, rejected)
 			},
 		},
 		{
 			name:       "success with no constraint",
 			unsorted:   []string{"2.0.0", "1.0.0", "1.1.0"},
 			constraint: "^3.0.0",
-			assertions: func(latest string, err error) {
+			assertions: func(latest string, rejected []kargoapi.RejectedVersion, err error) {
 				require.NoError(t, err)
 				require.Equal(t, "", latest)
+				require.Len(t, rejected, 3)
+				require.Equal(t, "2.0.0", rejected[0].Version)
 			},
 		},
 	}
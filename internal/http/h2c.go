@@ -0,0 +1,46 @@
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// ListenAndServeH2C serves the provided handler on the specified address. Since
+// gRPC requires HTTP/2, HTTP/2 without TLS (h2c) is supported.
+func ListenAndServeH2C(addr string, handler http.Handler) error {
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           h2c.NewHandler(handler, &http2.Server{}),
+		ReadHeaderTimeout: time.Minute,
+	}
+	return srv.ListenAndServe()
+}
+
+// NewH2CClient returns an HTTP client suitable for making gRPC requests to the
+// specified base URL. For base URLs with the http scheme, HTTP/2 without TLS
+// (h2c) is used.
+func NewH2CClient(baseURL string) *http.Client {
+	if !strings.HasPrefix(baseURL, "http://") {
+		return http.DefaultClient
+	}
+	return &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(
+				ctx context.Context,
+				network string,
+				addr string,
+				_ *tls.Config,
+			) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, addr)
+			},
+		},
+	}
+}
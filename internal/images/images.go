@@ -3,11 +3,14 @@ package images
 import (
 	"fmt"
 	"log"
+	"strings"
 
+	"github.com/Masterminds/semver"
 	"github.com/argoproj-labs/argocd-image-updater/pkg/image"
 	argoLog "github.com/argoproj-labs/argocd-image-updater/pkg/log"
 	"github.com/argoproj-labs/argocd-image-updater/pkg/options"
 	"github.com/argoproj-labs/argocd-image-updater/pkg/registry"
+	"github.com/argoproj-labs/argocd-image-updater/pkg/tag"
 	"github.com/pkg/errors"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -20,6 +23,10 @@ func init() {
 	}
 }
 
+// GetLatestTag returns the newest tag of the specified image that satisfies
+// all the provided constraints. Tags that were discovered in the image
+// repository, but rejected by those constraints, are also returned, whether or
+// not a suitable tag was found.
 func GetLatestTag(
 	repoURL string,
 	updateStrategy kargoapi.ImageUpdateStrategy,
@@ -28,7 +35,7 @@ func GetLatestTag(
 	ignoreTags []string,
 	platform string,
 	creds *Credentials,
-) (string, error) {
+) (string, []kargoapi.RejectedVersion, error) {
 	img := image.NewFromIdentifier(repoURL)
 	vc := &image.VersionConstraint{
 		Constraint: semverConstraint,
@@ -44,7 +51,7 @@ func GetLatestTag(
 	if platform != "" {
 		os, arch, variant, err := image.ParsePlatform(platform)
 		if err != nil {
-			return "", errors.Wrapf(
+			return "", nil, errors.Wrapf(
 				err,
 				"error parsing platform %q for image %q",
 				platform,
@@ -57,7 +64,7 @@ func GetLatestTag(
 
 	rep, err := registry.GetRegistryEndpoint(img.RegistryURL)
 	if err != nil {
-		return "", errors.Wrapf(
+		return "", nil, errors.Wrapf(
 			err,
 			"error getting container registry endpoint for image %q",
 			repoURL,
@@ -67,38 +74,149 @@ func GetLatestTag(
 	if creds == nil {
 		creds = &Credentials{}
 	}
-	regClient, err := registry.NewClient(rep, creds.Username, creds.Password)
+	client, err := registry.NewClient(rep, creds.Username, creds.Password)
 	if err != nil {
-		return "", errors.Wrapf(
+		return "", nil, errors.Wrapf(
 			err,
 			"error creating registry client for image %q",
 			repoURL,
 		)
 	}
 
+	// Record all tags in the repository so that we can report on those that
+	// get filtered out
+	regClient := &recordingClient{RegistryClient: client}
 	tags, err := rep.GetTags(img, regClient, vc)
 	if err != nil {
-		return "", errors.Wrapf(
+		return "", nil, errors.Wrapf(
 			err,
 			"error fetching tags for image %q",
 			repoURL,
 		)
 	}
 
+	rejected := rejectedTags(vc, regClient.tags, tags, platform)
+
 	upImg, err := img.GetNewestVersionFromTags(vc, tags)
 	if err != nil {
-		return "", errors.Wrapf(
+		return "", rejected, errors.Wrapf(
 			err,
 			"error finding newest tag for %q",
 			repoURL,
 		)
 	}
 	if upImg == nil {
-		return "", errors.Errorf(
+		return "", rejected, errors.Errorf(
 			"found no suitable version of image %q",
 			repoURL,
 		)
 	}
 
-	return upImg.TagName, nil
+	return upImg.TagName, rejected, nil
+}
+
+// CheckAccess verifies that tags of the specified image can be listed using the
+// provided credentials, which may be nil for public images. In the process, a
+// token is obtained from the image's registry, if the registry requires one.
+func CheckAccess(repoURL string, creds *Credentials) error {
+	img := image.NewFromIdentifier(repoURL)
+	rep, err := registry.GetRegistryEndpoint(img.RegistryURL)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error getting container registry endpoint for image %q",
+			repoURL,
+		)
+	}
+	if creds == nil {
+		creds = &Credentials{}
+	}
+	client, err := registry.NewClient(rep, creds.Username, creds.Password)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error creating registry client for image %q",
+			repoURL,
+		)
+	}
+	// Some registries have a default namespace that is used when the image name
+	// doesn't specify one. For example, at Docker Hub, this is "library".
+	nameInRegistry := img.ImageName
+	if !strings.Contains(nameInRegistry, "/") && rep.DefaultNS != "" {
+		nameInRegistry = rep.DefaultNS + "/" + nameInRegistry
+	}
+	if err = client.NewRepository(nameInRegistry); err != nil {
+		return errors.Wrapf(err, "error connecting to registry for image %q", repoURL)
+	}
+	_, err = client.Tags()
+	return errors.Wrapf(err, "error fetching tags for image %q", repoURL)
+}
+
+// recordingClient is a registry.RegistryClient that records the tags it has
+// listed.
+type recordingClient struct {
+	registry.RegistryClient
+	tags []string
+}
+
+func (r *recordingClient) Tags() ([]string, error) {
+	tags, err := r.RegistryClient.Tags()
+	r.tags = tags
+	return tags, err
+}
+
+// rejectedTags returns all tags among those listed in the repository that were
+// rejected by the provided version constraint, along with the reason each was
+// rejected. Accepted tags are those that remained after the registry filtered
+// the listed tags.
+func rejectedTags(
+	vc *image.VersionConstraint,
+	listed []string,
+	accepted *tag.ImageTagList,
+	platform string,
+) []kargoapi.RejectedVersion {
+	if vc.Strategy.WantsOnlyConstraintTag() {
+		// Every tag but the one specified by the constraint is ignored, so there
+		// is nothing useful to report
+		return nil
+	}
+	acceptedTags := map[string]struct{}{}
+	for _, t := range accepted.Tags() {
+		acceptedTags[t] = struct{}{}
+	}
+	var semverConstraint *semver.Constraints
+	if vc.Strategy == image.StrategySemVer && vc.Constraint != "" {
+		// An invalid constraint results in an error elsewhere
+		semverConstraint, _ = semver.NewConstraint(vc.Constraint)
+	}
+	var rejected []kargoapi.RejectedVersion
+	reject := func(t string, reason string, args ...any) {
+		rejected = append(rejected, kargoapi.RejectedVersion{
+			Version: t,
+			Reason:  fmt.Sprintf(reason, args...),
+		})
+	}
+	for _, t := range listed {
+		_, isAccepted := acceptedTags[t]
+		switch {
+		case vc.MatchFunc != nil && !vc.MatchFunc(t, vc.MatchArgs):
+			reject(t, "does not match allowed tags")
+		case vc.IsTagIgnored(t):
+			reject(t, "matches ignored tags")
+		case !isAccepted:
+			if platform != "" {
+				reject(t, "has no image for platform %q", platform)
+			} else {
+				reject(t, "metadata could not be retrieved")
+			}
+		case vc.Strategy == image.StrategySemVer:
+			ver, err := semver.NewVersion(t)
+			if err != nil {
+				reject(t, "is not a semantic version")
+			} else if semverConstraint != nil && !semverConstraint.Check(ver) {
+				reject(t, "does not satisfy semver constraint %q", vc.Constraint)
+			}
+		}
+	}
+	return rejected
 }
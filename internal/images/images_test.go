@@ -2,8 +2,11 @@ package images
 
 import (
 	"testing"
+	"time"
 
 	"github.com/Masterminds/semver"
+	"github.com/argoproj-labs/argocd-image-updater/pkg/image"
+	"github.com/argoproj-labs/argocd-image-updater/pkg/tag"
 	"github.com/stretchr/testify/require"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -15,13 +18,13 @@ func TestGetLatestTag(t *testing.T) {
 		repoURL          string
 		platform         string
 		semverConstraint string
-		assertions       func(string, error)
+		assertions       func(string, []kargoapi.RejectedVersion, error)
 	}{
 		{
 			name:     "error parsing platform",
 			repoURL:  "nginx",
 			platform: "bogus",
-			assertions: func(s string, err error) {
+			assertions: func(_ string, _ []kargoapi.RejectedVersion, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error parsing platform")
 			},
@@ -31,7 +34,7 @@ func TestGetLatestTag(t *testing.T) {
 			name: "error getting tags",
 			// This will force a failure because this repo doesn't exist
 			repoURL: "bogus",
-			assertions: func(s string, err error) {
+			assertions: func(_ string, _ []kargoapi.RejectedVersion, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error fetching tags for image")
 			},
@@ -41,9 +44,19 @@ func TestGetLatestTag(t *testing.T) {
 			name:             "no suitable version found",
 			repoURL:          "nginx",
 			semverConstraint: "^15.0.0", // Doesn't exist
-			assertions: func(tag string, err error) {
+			assertions: func(_ string, rejected []kargoapi.RejectedVersion, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "found no suitable version of image")
+				// Every semantically versioned tag should have been rejected by the
+				// constraint
+				require.Contains(
+					t,
+					rejected,
+					kargoapi.RejectedVersion{
+						Version: "1.25.3",
+						Reason:  `does not satisfy semver constraint "^15.0.0"`,
+					},
+				)
 			},
 		},
 
@@ -52,7 +65,7 @@ func TestGetLatestTag(t *testing.T) {
 			repoURL:          "nginx",
 			platform:         "linux/amd64",
 			semverConstraint: "^1.0.0",
-			assertions: func(tag string, err error) {
+			assertions: func(tag string, _ []kargoapi.RejectedVersion, err error) {
 				require.NoError(t, err)
 				ver, err := semver.NewVersion(tag)
 				require.NoError(t, err)
@@ -76,3 +89,102 @@ func TestGetLatestTag(t *testing.T) {
 		})
 	}
 }
+
+func TestRejectedTags(t *testing.T) {
+	img := image.NewFromIdentifier("nginx")
+	newConstraint := func(constraint string, allowTags string, ignoreTags ...string) *image.VersionConstraint {
+		vc := &image.VersionConstraint{
+			Constraint: constraint,
+			Strategy:   image.StrategySemVer,
+			IgnoreList: ignoreTags,
+		}
+		if allowTags != "" {
+			vc.MatchFunc, vc.MatchArgs = img.ParseMatchfunc("regexp:" + allowTags)
+		}
+		return vc
+	}
+	newTagList := func(tags ...string) *tag.ImageTagList {
+		list := tag.NewImageTagList()
+		for _, t := range tags {
+			list.Add(tag.NewImageTag(t, time.Time{}, ""))
+		}
+		return list
+	}
+
+	testCases := []struct {
+		name       string
+		vc         *image.VersionConstraint
+		listed     []string
+		accepted   *tag.ImageTagList
+		platform   string
+		assertions func([]kargoapi.RejectedVersion)
+	}{
+		{
+			name: "digest strategy",
+			vc: &image.VersionConstraint{
+				Constraint: "latest",
+				Strategy:   image.StrategyDigest,
+			},
+			listed:   []string{"latest", "1.0.0"},
+			accepted: newTagList("latest"),
+			assertions: func(rejected []kargoapi.RejectedVersion) {
+				require.Empty(t, rejected)
+			},
+		},
+		{
+			name:     "nothing rejected",
+			vc:       newConstraint("", ""),
+			listed:   []string{"1.0.0", "1.1.0"},
+			accepted: newTagList("1.0.0", "1.1.0"),
+			assertions: func(rejected []kargoapi.RejectedVersion) {
+				require.Empty(t, rejected)
+			},
+		},
+		{
+			name:     "tags rejected for various reasons",
+			vc:       newConstraint("^1.0.0", `^\d`, "*-rc*"),
+			listed:   []string{"1.0.0", "2.0.0", "1.1.0-rc1", "latest", "1.2.0", "1.3"},
+			accepted: newTagList("1.0.0", "2.0.0", "1.3"),
+			platform: "linux/arm64",
+			assertions: func(rejected []kargoapi.RejectedVersion) {
+				require.Equal(
+					t,
+					[]kargoapi.RejectedVersion{
+						{Version: "2.0.0", Reason: `does not satisfy semver constraint "^1.0.0"`},
+						{Version: "1.1.0-rc1", Reason: "matches ignored tags"},
+						{Version: "latest", Reason: "does not match allowed tags"},
+						{Version: "1.2.0", Reason: `has no image for platform "linux/arm64"`},
+					},
+					rejected,
+				)
+			},
+		},
+		{
+			name:     "tags that are not semantic versions",
+			vc:       newConstraint("", ""),
+			listed:   []string{"1.0.0", "stable"},
+			accepted: newTagList("1.0.0", "stable"),
+			assertions: func(rejected []kargoapi.RejectedVersion) {
+				require.Equal(
+					t,
+					[]kargoapi.RejectedVersion{
+						{Version: "stable", Reason: "is not a semantic version"},
+					},
+					rejected,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				rejectedTags(
+					testCase.vc,
+					testCase.listed,
+					testCase.accepted,
+					testCase.platform,
+				),
+			)
+		})
+	}
+}
@@ -58,6 +58,46 @@ func NewPromotion(stage kargoapi.Stage, freight string) kargoapi.Promotion {
 	return promotion
 }
 
+// NewRelease returns a new Release of the given freight into the given steps,
+// named after the given stage, with our naming convention. Carries over any
+// shard labels from the stage.
+func NewRelease(
+	stage kargoapi.Stage,
+	freight string,
+	steps []kargoapi.ReleaseStep,
+) kargoapi.Release {
+	shortHash := freight
+	if len(shortHash) > 7 {
+		shortHash = freight[0:7]
+	}
+	shortStageName := stage.Name
+	if len(stage.Name) > maxStageNamePrefixLength {
+		shortStageName = shortStageName[0:maxStageNamePrefixLength]
+	}
+
+	releaseName := strings.ToLower(
+		fmt.Sprintf("%s.%s.%s", shortStageName, ulid.Make(), shortHash),
+	)
+
+	release := kargoapi.Release{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      releaseName,
+			Namespace: stage.Namespace,
+		},
+		Spec: &kargoapi.ReleaseSpec{
+			Freight: freight,
+			Steps:   steps,
+		},
+	}
+	if stage.Labels != nil &&
+		stage.Labels[controller.ShardLabelKey] != "" {
+		release.ObjectMeta.Labels = map[string]string{
+			controller.ShardLabelKey: stage.Labels[controller.ShardLabelKey],
+		}
+	}
+	return release
+}
+
 func NewPromoWentTerminalPredicate(logger *log.Entry) PromoWentTerminal {
 	return PromoWentTerminal{
 		logger: logger,
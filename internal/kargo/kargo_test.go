@@ -93,3 +93,32 @@ func TestNewPromotion(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRelease(t *testing.T) {
+	const testFreight = "f08b2e72c9b2b7b263da6d55f9536e49b5ce972c"
+	testSteps := []kargoapi.ReleaseStep{
+		{
+			Stage: "prod-us",
+		},
+		{
+			Stage: "prod-eu",
+		},
+	}
+	stage := kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "uat",
+			Namespace: "kargo-demo",
+			Labels: map[string]string{
+				controller.ShardLabelKey: "another-shard",
+			},
+		},
+	}
+	release := NewRelease(stage, testFreight, testSteps)
+	parts := strings.Split(release.Name, ".")
+	require.Equal(t, "uat", parts[0])
+	require.Equal(t, testFreight[0:7], parts[2])
+	require.Equal(t, "kargo-demo", release.Namespace)
+	require.Equal(t, "another-shard", release.Labels[controller.ShardLabelKey])
+	require.Equal(t, testFreight, release.Spec.Freight)
+	require.Equal(t, testSteps, release.Spec.Steps)
+}
@@ -1,6 +1,7 @@
 package manifest
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -11,6 +12,7 @@ import (
 	"sigs.k8s.io/kustomize/api/resmap"
 	"sigs.k8s.io/kustomize/api/resource"
 	"sigs.k8s.io/kustomize/kyaml/openapi"
+	"sigs.k8s.io/kustomize/kyaml/resid"
 )
 
 var (
@@ -19,8 +21,20 @@ var (
 
 type ParseFunc func(data []byte) (cluster, namespaced []*unstructured.Unstructured, err error)
 
+// kindOrder ranks the kinds of namespaced resources that depend on one
+// another, so that each is created after any of the others it may reference.
+// Kinds that are not listed, such as Secrets holding credentials, rank ahead
+// of all of these.
+var kindOrder = map[string]int{
+	"Warehouse":       1,
+	"Stage":           2,
+	"PromotionPolicy": 3,
+}
+
 // NewParser returns a new parser that parses Kubernetes manifest and
-// returns parsed objects in cluster - namespaced order.
+// returns parsed objects in cluster - namespaced order. Namespaced objects are
+// additionally ordered by kind, according to kindOrder, and otherwise retain
+// the order in which they appear in the manifest.
 func NewParser(scheme *runtime.Scheme) ParseFunc {
 	initSchemaOnce.Do(func() {
 		_ = openapi.Schema()
@@ -35,23 +49,21 @@ func NewParser(scheme *runtime.Scheme) ParseFunc {
 			return nil, nil, errors.Wrap(err, "new resmap from data")
 		}
 		cluster = make([]*unstructured.Unstructured, 0, resMap.Size())
-		for _, r := range resMap.ClusterScoped() {
+		namespaced = make([]*unstructured.Unstructured, 0, resMap.Size())
+		for _, r := range resMap.Resources() {
 			u, err := resourceToUnstructured(deserializer, r)
 			if err != nil {
 				return nil, nil, errors.Wrap(err, "resource to unstructured")
 			}
-			cluster = append(cluster, u)
-		}
-		namespaced = make([]*unstructured.Unstructured, 0, resMap.Size()-len(cluster))
-		for _, resources := range resMap.GroupedByOriginalNamespace() {
-			for _, r := range resources {
-				u, err := resourceToUnstructured(deserializer, r)
-				if err != nil {
-					return nil, nil, errors.Wrap(err, "resource to unstructured")
-				}
+			if r.OrgId().EffectiveNamespace() == resid.TotallyNotANamespace {
+				cluster = append(cluster, u)
+			} else {
 				namespaced = append(namespaced, u)
 			}
 		}
+		sort.SliceStable(namespaced, func(i, j int) bool {
+			return kindOrder[namespaced[i].GetKind()] < kindOrder[namespaced[j].GetKind()]
+		})
 		return cluster, namespaced, nil
 	}
 }
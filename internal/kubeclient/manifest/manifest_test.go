@@ -129,6 +129,91 @@ metadata:
 				},
 			},
 		},
+		"namespaced objects ordered by kind": {
+			data: []byte(`---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: PromotionPolicy
+metadata:
+  name: test
+  namespace: kargo-demo
+stage: test
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: test
+  namespace: kargo-demo
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: test
+  namespace: kargo-demo
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: creds
+  namespace: kargo-demo
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: kargo-demo
+`),
+			expectedObjects: []runtime.Object{
+				&corev1.Namespace{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "Namespace",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "kargo-demo",
+					},
+				},
+				&corev1.Secret{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "Secret",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kargo-demo",
+						Name:      "creds",
+					},
+				},
+				&kargoapi.Warehouse{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: kargoapi.GroupVersion.String(),
+						Kind:       "Warehouse",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kargo-demo",
+						Name:      "test",
+					},
+				},
+				&kargoapi.Stage{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: kargoapi.GroupVersion.String(),
+						Kind:       "Stage",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kargo-demo",
+						Name:      "test",
+					},
+				},
+				&kargoapi.PromotionPolicy{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: kargoapi.GroupVersion.String(),
+						Kind:       "PromotionPolicy",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kargo-demo",
+						Name:      "test",
+					},
+					Stage: "test",
+				},
+			},
+		},
 	}
 
 	scheme := runtime.NewScheme()
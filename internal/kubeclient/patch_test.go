@@ -0,0 +1,112 @@
+package kubeclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// patchCountingClient is a client.Client that counts the status patches it
+// sends.
+type patchCountingClient struct {
+	client.Client
+	patches int
+}
+
+func (c *patchCountingClient) Status() client.StatusWriter {
+	return &patchCountingStatusWriter{
+		StatusWriter: c.Client.Status(),
+		client:       c,
+	}
+}
+
+type patchCountingStatusWriter struct {
+	client.StatusWriter
+	client *patchCountingClient
+}
+
+func (w *patchCountingStatusWriter) Patch(
+	ctx context.Context,
+	obj client.Object,
+	patch client.Patch,
+	opts ...client.PatchOption,
+) error {
+	w.client.patches++
+	return w.StatusWriter.Patch(ctx, obj, patch, opts...)
+}
+
+func TestPatchStatus(t *testing.T) {
+	testCases := []struct {
+		name       string
+		update     func(*kargoapi.WarehouseStatus)
+		assertions func(*kargoapi.Warehouse, int, error)
+	}{
+		{
+			name:   "no changes",
+			update: func(*kargoapi.WarehouseStatus) {},
+			assertions: func(_ *kargoapi.Warehouse, patches int, err error) {
+				require.NoError(t, err)
+				require.Zero(t, patches)
+			},
+		},
+		{
+			name: "unchanged values rewritten",
+			update: func(status *kargoapi.WarehouseStatus) {
+				status.ObservedGeneration = 1
+				status.Error = "something went wrong"
+			},
+			assertions: func(_ *kargoapi.Warehouse, patches int, err error) {
+				require.NoError(t, err)
+				require.Zero(t, patches)
+			},
+		},
+		{
+			name: "changes",
+			update: func(status *kargoapi.WarehouseStatus) {
+				status.Error = ""
+			},
+			assertions: func(warehouse *kargoapi.Warehouse, patches int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, patches)
+				require.Empty(t, warehouse.Status.Error)
+				require.Equal(t, int64(1), warehouse.Status.ObservedGeneration)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, kargoapi.AddToScheme(scheme))
+			warehouse := &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-warehouse",
+				},
+				Status: kargoapi.WarehouseStatus{
+					ObservedGeneration: 1,
+					Error:              "something went wrong",
+				},
+			}
+			c := &patchCountingClient{
+				Client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(warehouse).
+					Build(),
+			}
+			err := PatchStatus(
+				context.Background(),
+				c,
+				warehouse,
+				testCase.update,
+			)
+			testCase.assertions(warehouse, c.patches, err)
+		})
+	}
+}
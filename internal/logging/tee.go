@@ -0,0 +1,25 @@
+package logging
+
+import (
+	"io"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Tee returns a *log.Entry with the same fields, level, and formatting as the
+// provided *log.Entry that additionally writes everything it logs to the
+// provided io.Writer. It is used to capture the log of a single operation
+// without affecting where that log is otherwise written.
+func Tee(logger *log.Entry, w io.Writer) *log.Entry {
+	teeLogger := log.New()
+	teeLogger.SetOutput(io.MultiWriter(logger.Logger.Out, w))
+	teeLogger.SetFormatter(logger.Logger.Formatter)
+	teeLogger.SetLevel(logger.Logger.GetLevel())
+	teeLogger.SetReportCaller(logger.Logger.ReportCaller)
+	hooks := make(log.LevelHooks, len(logger.Logger.Hooks))
+	for level, levelHooks := range logger.Logger.Hooks {
+		hooks[level] = append([]log.Hook(nil), levelHooks...)
+	}
+	teeLogger.ReplaceHooks(hooks)
+	return teeLogger.WithFields(logger.Data)
+}
@@ -0,0 +1,26 @@
+package logging
+
+import (
+	"bytes"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTee(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := log.New()
+	logger.SetOutput(out)
+	logger.SetLevel(log.InfoLevel)
+	logger.SetFormatter(&log.TextFormatter{DisableTimestamp: true})
+
+	captured := &bytes.Buffer{}
+	teeLogger := Tee(logger.WithField("promotion", "fake-promotion"), captured)
+	teeLogger.Debug("not logged")
+	teeLogger.Info("logged")
+
+	const expected = "level=info msg=logged promotion=fake-promotion\n"
+	require.Equal(t, expected, out.String())
+	require.Equal(t, expected, captured.String())
+}
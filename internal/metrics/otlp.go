@@ -0,0 +1,364 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// otlpAggregationTemporalityCumulative is the OTLP AggregationTemporality
+// value indicating that each data point of a sum or histogram covers the
+// entire time since the metric's start time, as Prometheus metrics do.
+const otlpAggregationTemporalityCumulative = 2
+
+// otlpExporter pushes metrics to an OTLP/HTTP receiver using the JSON encoding
+// of OTLP. All data points of cumulative metrics share the time at which the
+// exporter was created as their start time.
+type otlpExporter struct {
+	url         string
+	headers     map[string]string
+	serviceName string
+	startTime   time.Time
+	client      *http.Client
+	// nowFn returns the current time. It is overridable for testing purposes.
+	nowFn func() time.Time
+}
+
+func newOTLPExporter(
+	endpoint string,
+	headers map[string]string,
+	serviceName string,
+) *otlpExporter {
+	return &otlpExporter{
+		url:         strings.TrimSuffix(endpoint, "/") + "/v1/metrics",
+		headers:     headers,
+		serviceName: serviceName,
+		startTime:   time.Now(),
+		client:      &http.Client{Timeout: 30 * time.Second},
+		nowFn:       time.Now,
+	}
+}
+
+func (o *otlpExporter) name() string {
+	return fmt.Sprintf("OTLP receiver %q", o.url)
+}
+
+func (o *otlpExporter) export(ctx context.Context, families []*dto.MetricFamily) error {
+	body, err := json.Marshal(o.toRequest(families))
+	if err != nil {
+		return errors.Wrap(err, "error marshaling metrics")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "error creating request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range o.headers {
+		req.Header.Set(name, value)
+	}
+	res, err := o.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "error sending metrics")
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return errors.Errorf(
+			"receiver responded with status %d: %s",
+			res.StatusCode,
+			strings.TrimSpace(string(resBody)),
+		)
+	}
+	return nil
+}
+
+// The following types are the subset of the JSON encoding of an OTLP
+// ExportMetricsServiceRequest that is needed to represent Prometheus metrics.
+// Per the protobuf JSON mapping, 64-bit integers are encoded as strings.
+
+type otlpRequest struct {
+	ResourceMetrics []otlpResourceMetrics `json:"resourceMetrics"`
+}
+
+type otlpResourceMetrics struct {
+	Resource     otlpResource       `json:"resource"`
+	ScopeMetrics []otlpScopeMetrics `json:"scopeMetrics"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeMetrics struct {
+	Scope   otlpScope    `json:"scope"`
+	Metrics []otlpMetric `json:"metrics"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpMetric struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Gauge       *otlpGauge     `json:"gauge,omitempty"`
+	Sum         *otlpSum       `json:"sum,omitempty"`
+	Histogram   *otlpHistogram `json:"histogram,omitempty"`
+	Summary     *otlpSummary   `json:"summary,omitempty"`
+}
+
+type otlpGauge struct {
+	DataPoints []otlpNumberDataPoint `json:"dataPoints"`
+}
+
+type otlpSum struct {
+	DataPoints             []otlpNumberDataPoint `json:"dataPoints"`
+	AggregationTemporality int                   `json:"aggregationTemporality"`
+	IsMonotonic            bool                  `json:"isMonotonic"`
+}
+
+type otlpHistogram struct {
+	DataPoints             []otlpHistogramDataPoint `json:"dataPoints"`
+	AggregationTemporality int                      `json:"aggregationTemporality"`
+}
+
+type otlpSummary struct {
+	DataPoints []otlpSummaryDataPoint `json:"dataPoints"`
+}
+
+type otlpNumberDataPoint struct {
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string          `json:"timeUnixNano"`
+	AsDouble          otlpDouble      `json:"asDouble"`
+}
+
+type otlpHistogramDataPoint struct {
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	TimeUnixNano      string          `json:"timeUnixNano"`
+	Count             string          `json:"count"`
+	Sum               otlpDouble      `json:"sum"`
+	BucketCounts      []string        `json:"bucketCounts"`
+	ExplicitBounds    []float64       `json:"explicitBounds"`
+}
+
+type otlpSummaryDataPoint struct {
+	Attributes        []otlpAttribute     `json:"attributes,omitempty"`
+	StartTimeUnixNano string              `json:"startTimeUnixNano"`
+	TimeUnixNano      string              `json:"timeUnixNano"`
+	Count             string              `json:"count"`
+	Sum               otlpDouble          `json:"sum"`
+	QuantileValues    []otlpQuantileValue `json:"quantileValues"`
+}
+
+type otlpQuantileValue struct {
+	Quantile float64    `json:"quantile"`
+	Value    otlpDouble `json:"value"`
+}
+
+// otlpDouble is a float64 that is encoded per the protobuf JSON mapping, under
+// which the non-finite values that Prometheus reports for, e.g., summaries
+// without observations are encoded as the strings "NaN", "Infinity", and
+// "-Infinity".
+type otlpDouble float64
+
+func (d otlpDouble) MarshalJSON() ([]byte, error) {
+	f := float64(d)
+	switch {
+	case math.IsNaN(f):
+		return []byte(`"NaN"`), nil
+	case math.IsInf(f, 1):
+		return []byte(`"Infinity"`), nil
+	case math.IsInf(f, -1):
+		return []byte(`"-Infinity"`), nil
+	}
+	return json.Marshal(f)
+}
+
+func (d *otlpDouble) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var f float64
+		if err = json.Unmarshal(data, &f); err != nil {
+			return err
+		}
+		*d = otlpDouble(f)
+		return nil
+	}
+	switch s {
+	case "NaN":
+		*d = otlpDouble(math.NaN())
+	case "Infinity":
+		*d = otlpDouble(math.Inf(1))
+	case "-Infinity":
+		*d = otlpDouble(math.Inf(-1))
+	default:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return errors.Wrapf(err, "error parsing double %q", s)
+		}
+		*d = otlpDouble(f)
+	}
+	return nil
+}
+
+type otlpAttribute struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+func (o *otlpExporter) toRequest(families []*dto.MetricFamily) otlpRequest {
+	now := unixNano(o.nowFn())
+	start := unixNano(o.startTime)
+	metrics := make([]otlpMetric, 0, len(families))
+	for _, family := range families {
+		metric := otlpMetric{
+			Name:        family.GetName(),
+			Description: family.GetHelp(),
+		}
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			metric.Sum = &otlpSum{
+				AggregationTemporality: otlpAggregationTemporalityCumulative,
+				IsMonotonic:            true,
+			}
+			for _, m := range family.GetMetric() {
+				metric.Sum.DataPoints = append(metric.Sum.DataPoints, otlpNumberDataPoint{
+					Attributes:        otlpAttributes(m),
+					StartTimeUnixNano: start,
+					TimeUnixNano:      now,
+					AsDouble:          otlpDouble(m.GetCounter().GetValue()),
+				})
+			}
+		case dto.MetricType_HISTOGRAM:
+			metric.Histogram = &otlpHistogram{
+				AggregationTemporality: otlpAggregationTemporalityCumulative,
+			}
+			for _, m := range family.GetMetric() {
+				metric.Histogram.DataPoints = append(
+					metric.Histogram.DataPoints,
+					otlpHistogramPoint(m, start, now),
+				)
+			}
+		case dto.MetricType_SUMMARY:
+			metric.Summary = &otlpSummary{}
+			for _, m := range family.GetMetric() {
+				s := m.GetSummary()
+				point := otlpSummaryDataPoint{
+					Attributes:        otlpAttributes(m),
+					StartTimeUnixNano: start,
+					TimeUnixNano:      now,
+					Count:             strconv.FormatUint(s.GetSampleCount(), 10),
+					Sum:               otlpDouble(s.GetSampleSum()),
+					QuantileValues:    []otlpQuantileValue{},
+				}
+				for _, q := range s.GetQuantile() {
+					point.QuantileValues = append(point.QuantileValues, otlpQuantileValue{
+						Quantile: q.GetQuantile(),
+						Value:    otlpDouble(q.GetValue()),
+					})
+				}
+				metric.Summary.DataPoints = append(metric.Summary.DataPoints, point)
+			}
+		default:
+			metric.Gauge = &otlpGauge{}
+			for _, m := range family.GetMetric() {
+				value := m.GetGauge().GetValue()
+				if family.GetType() == dto.MetricType_UNTYPED {
+					value = m.GetUntyped().GetValue()
+				}
+				metric.Gauge.DataPoints = append(metric.Gauge.DataPoints, otlpNumberDataPoint{
+					Attributes:   otlpAttributes(m),
+					TimeUnixNano: now,
+					AsDouble:     otlpDouble(value),
+				})
+			}
+		}
+		metrics = append(metrics, metric)
+	}
+	return otlpRequest{
+		ResourceMetrics: []otlpResourceMetrics{{
+			Resource: otlpResource{
+				Attributes: []otlpAttribute{{
+					Key:   "service.name",
+					Value: otlpAnyValue{StringValue: o.serviceName},
+				}},
+			},
+			ScopeMetrics: []otlpScopeMetrics{{
+				Scope:   otlpScope{Name: "github.com/akuity/kargo"},
+				Metrics: metrics,
+			}},
+		}},
+	}
+}
+
+// otlpHistogramPoint converts the provided Prometheus histogram to an OTLP
+// histogram data point. Prometheus buckets are cumulative and include an
+// implicit +Inf bucket, whereas OTLP buckets are not cumulative and the +Inf
+// bucket is explicit.
+func otlpHistogramPoint(m *dto.Metric, start, now string) otlpHistogramDataPoint {
+	h := m.GetHistogram()
+	point := otlpHistogramDataPoint{
+		Attributes:        otlpAttributes(m),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      now,
+		Count:             strconv.FormatUint(h.GetSampleCount(), 10),
+		Sum:               otlpDouble(h.GetSampleSum()),
+		BucketCounts:      []string{},
+		ExplicitBounds:    []float64{},
+	}
+	var prev uint64
+	for _, b := range h.GetBucket() {
+		if math.IsInf(b.GetUpperBound(), 1) {
+			continue
+		}
+		point.ExplicitBounds = append(point.ExplicitBounds, b.GetUpperBound())
+		point.BucketCounts = append(
+			point.BucketCounts,
+			strconv.FormatUint(b.GetCumulativeCount()-prev, 10),
+		)
+		prev = b.GetCumulativeCount()
+	}
+	point.BucketCounts = append(
+		point.BucketCounts,
+		strconv.FormatUint(h.GetSampleCount()-prev, 10),
+	)
+	return point
+}
+
+// otlpAttributes returns the labels of the provided metric as OTLP attributes,
+// sorted by name.
+func otlpAttributes(m *dto.Metric) []otlpAttribute {
+	labels := labelsOf(m)
+	attrs := make([]otlpAttribute, 0, len(labels))
+	for name, value := range labels {
+		attrs = append(attrs, otlpAttribute{
+			Key:   name,
+			Value: otlpAnyValue{StringValue: value},
+		})
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		return attrs[i].Key < attrs[j].Key
+	})
+	return attrs
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
@@ -0,0 +1,161 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOTLPExporter(t *testing.T) {
+	var (
+		reqPath   string
+		reqHeader http.Header
+		reqBody   otlpRequest
+		status    = http.StatusOK
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqPath = r.URL.Path
+		reqHeader = r.Header
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	registry, counter, gauge, histogram := newTestRegistry(t)
+	counter.WithLabelValues("timeout").Add(3)
+	gauge.Set(2)
+	histogram.Observe(0.5)
+	histogram.Observe(5)
+	histogram.Observe(50)
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	e := newOTLPExporter(srv.URL+"/", map[string]string{"X-Api-Key": "fake-key"}, "kargo-test")
+	e.startTime = time.Unix(1, 0)
+	e.nowFn = func() time.Time { return time.Unix(2, 0) }
+	require.NoError(t, e.export(context.Background(), families))
+
+	require.Equal(t, "/v1/metrics", reqPath)
+	require.Equal(t, "application/json", reqHeader.Get("Content-Type"))
+	require.Equal(t, "fake-key", reqHeader.Get("X-Api-Key"))
+	require.Len(t, reqBody.ResourceMetrics, 1)
+	resource := reqBody.ResourceMetrics[0]
+	require.Equal(
+		t,
+		[]otlpAttribute{{Key: "service.name", Value: otlpAnyValue{StringValue: "kargo-test"}}},
+		resource.Resource.Attributes,
+	)
+	metrics := map[string]otlpMetric{}
+	for _, m := range resource.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m
+	}
+
+	sum := metrics["test_total"].Sum
+	require.NotNil(t, sum)
+	require.True(t, sum.IsMonotonic)
+	require.Equal(t, otlpAggregationTemporalityCumulative, sum.AggregationTemporality)
+	require.Equal(
+		t,
+		[]otlpNumberDataPoint{{
+			Attributes:        []otlpAttribute{{Key: "reason", Value: otlpAnyValue{StringValue: "timeout"}}},
+			StartTimeUnixNano: "1000000000",
+			TimeUnixNano:      "2000000000",
+			AsDouble:          3,
+		}},
+		sum.DataPoints,
+	)
+
+	gaugeMetric := metrics["test_in_flight"]
+	require.Equal(t, "A test gauge", gaugeMetric.Description)
+	require.NotNil(t, gaugeMetric.Gauge)
+	require.Equal(t, otlpDouble(2), gaugeMetric.Gauge.DataPoints[0].AsDouble)
+
+	hist := metrics["test_duration_seconds"].Histogram
+	require.NotNil(t, hist)
+	require.Equal(t, "3", hist.DataPoints[0].Count)
+	require.Equal(t, otlpDouble(55.5), hist.DataPoints[0].Sum)
+	require.Equal(t, []float64{1, 10}, hist.DataPoints[0].ExplicitBounds)
+	require.Equal(t, []string{"1", "1", "1"}, hist.DataPoints[0].BucketCounts)
+
+	status = http.StatusBadRequest
+	require.ErrorContains(
+		t,
+		e.export(context.Background(), families),
+		"receiver responded with status 400",
+	)
+}
+
+func TestOTLPExporterNonFiniteValues(t *testing.T) {
+	var reqBody otlpRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+	}))
+	defer srv.Close()
+
+	registry, _, gauge, _ := newTestRegistry(t)
+	gauge.Set(math.Inf(1))
+	// A summary without observations reports NaN for each of its quantiles
+	summary := prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "test_summary_seconds",
+		Help:       "A test summary",
+		Objectives: map[float64]float64{0.5: 0.05},
+	})
+	require.NoError(t, registry.Register(summary))
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	e := newOTLPExporter(srv.URL, nil, "kargo-test")
+	require.NoError(t, e.export(context.Background(), families))
+
+	metrics := map[string]otlpMetric{}
+	for _, m := range reqBody.ResourceMetrics[0].ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m
+	}
+	require.True(
+		t,
+		math.IsInf(float64(metrics["test_in_flight"].Gauge.DataPoints[0].AsDouble), 1),
+	)
+	quantiles := metrics["test_summary_seconds"].Summary.DataPoints[0].QuantileValues
+	require.Len(t, quantiles, 1)
+	require.True(t, math.IsNaN(float64(quantiles[0].Value)))
+}
+
+func TestOTLPDouble(t *testing.T) {
+	testCases := []struct {
+		value   float64
+		encoded string
+	}{
+		{value: 1.5, encoded: `1.5`},
+		{value: math.NaN(), encoded: `"NaN"`},
+		{value: math.Inf(1), encoded: `"Infinity"`},
+		{value: math.Inf(-1), encoded: `"-Infinity"`},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.encoded, func(t *testing.T) {
+			encoded, err := json.Marshal(otlpNumberDataPoint{
+				TimeUnixNano: "1",
+				AsDouble:     otlpDouble(testCase.value),
+			})
+			require.NoError(t, err)
+			require.JSONEq(
+				t,
+				`{"timeUnixNano":"1","asDouble":`+testCase.encoded+`}`,
+				string(encoded),
+			)
+			var decoded otlpNumberDataPoint
+			require.NoError(t, json.Unmarshal(encoded, &decoded))
+			if math.IsNaN(testCase.value) {
+				require.True(t, math.IsNaN(float64(decoded.AsDouble)))
+			} else {
+				require.Equal(t, testCase.value, float64(decoded.AsDouble))
+			}
+		})
+	}
+}
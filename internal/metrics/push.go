@@ -0,0 +1,147 @@
+// Package metrics pushes Prometheus metrics to external systems, for the
+// benefit of organizations whose telemetry pipelines are push-based rather
+// than scrape-based.
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// PushConfig represents configuration for pushing metrics to external
+// systems. Metrics are pushed to each system that is configured.
+type PushConfig struct {
+	// Interval specifies how often metrics are pushed.
+	Interval time.Duration `envconfig:"METRICS_PUSH_INTERVAL" default:"30s"`
+	// StatsDAddress optionally specifies the host and port of a StatsD server
+	// to which metrics are pushed over UDP.
+	StatsDAddress string `envconfig:"METRICS_STATSD_ADDRESS"`
+	// StatsDPrefix optionally specifies a prefix for the names of all metrics
+	// pushed to StatsD; e.g. "kargo.".
+	StatsDPrefix string `envconfig:"METRICS_STATSD_PREFIX"`
+	// OTLPEndpoint optionally specifies the base URL of an OTLP/HTTP receiver,
+	// such as an OpenTelemetry Collector, to which metrics are pushed. e.g.
+	// http://otel-collector:4318
+	OTLPEndpoint string `envconfig:"METRICS_OTLP_ENDPOINT"`
+	// OTLPHeaders optionally specifies headers to be included in every request
+	// to the OTLP receiver, in the form name1:value1,name2:value2.
+	OTLPHeaders map[string]string `envconfig:"METRICS_OTLP_HEADERS"`
+}
+
+// PushConfigFromEnv returns a PushConfig populated from environment variables.
+func PushConfigFromEnv() PushConfig {
+	var cfg PushConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// exporter is the interface for pushing gathered metrics to an external
+// system.
+type exporter interface {
+	// name returns a name for the external system, for use in log messages.
+	name() string
+	// export pushes the provided metrics to the external system.
+	export(context.Context, []*dto.MetricFamily) error
+}
+
+// Pusher periodically pushes the metrics gathered from a prometheus.Gatherer
+// to every external system specified by a PushConfig.
+type Pusher struct {
+	interval  time.Duration
+	gatherer  prometheus.Gatherer
+	exporters []exporter
+}
+
+// NewPusher returns a Pusher that pushes the metrics gathered from the provided
+// prometheus.Gatherer as specified by the provided PushConfig. The service name
+// identifies the source of the metrics to systems that support doing so. If no
+// external systems are specified, a nil Pusher is returned.
+func NewPusher(
+	cfg PushConfig,
+	serviceName string,
+	gatherer prometheus.Gatherer,
+) (*Pusher, error) {
+	p := &Pusher{
+		interval: cfg.Interval,
+		gatherer: gatherer,
+	}
+	if cfg.StatsDAddress != "" {
+		e, err := newStatsDExporter(cfg.StatsDAddress, cfg.StatsDPrefix)
+		if err != nil {
+			return nil, err
+		}
+		p.exporters = append(p.exporters, e)
+	}
+	if cfg.OTLPEndpoint != "" {
+		p.exporters = append(
+			p.exporters,
+			newOTLPExporter(cfg.OTLPEndpoint, cfg.OTLPHeaders, serviceName),
+		)
+	}
+	if len(p.exporters) == 0 {
+		return nil, nil
+	}
+	if p.interval <= 0 {
+		return nil, errors.Errorf(
+			"invalid metrics push interval %s; must be positive",
+			p.interval,
+		)
+	}
+	return p, nil
+}
+
+// Run pushes metrics at regular intervals until the provided context is
+// canceled, at which point metrics are pushed one last time. Failures to push
+// are logged, but are otherwise non-fatal.
+func (p *Pusher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.push(ctx)
+		case <-ctx.Done():
+			// The final push must not be bound by the canceled context
+			pushCtx, cancel := context.WithTimeout(
+				logging.ContextWithLogger(
+					context.Background(),
+					logging.LoggerFromContext(ctx),
+				),
+				p.interval,
+			)
+			p.push(pushCtx)
+			cancel()
+			return nil
+		}
+	}
+}
+
+func (p *Pusher) push(ctx context.Context) {
+	logger := logging.LoggerFromContext(ctx)
+	families, err := p.gatherer.Gather()
+	if err != nil {
+		// Gather returns whatever metrics it could gather along with any error
+		logger.Errorf("error gathering metrics: %s", err)
+	}
+	for _, e := range p.exporters {
+		if err = e.export(ctx, families); err != nil {
+			logger.Errorf("error pushing metrics to %s: %s", e.name(), err)
+		}
+	}
+}
+
+// labelsOf returns the labels of the provided metric as a map.
+func labelsOf(m *dto.Metric) map[string]string {
+	labels := make(map[string]string, len(m.GetLabel()))
+	for _, l := range m.GetLabel() {
+		labels[l.GetName()] = l.GetValue()
+	}
+	return labels
+}
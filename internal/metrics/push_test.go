@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPusher(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        PushConfig
+		assertions func(*Pusher, error)
+	}{
+		{
+			name: "no external systems",
+			cfg: PushConfig{
+				Interval: time.Minute,
+			},
+			assertions: func(p *Pusher, err error) {
+				require.NoError(t, err)
+				require.Nil(t, p)
+			},
+		},
+		{
+			name: "invalid interval",
+			cfg: PushConfig{
+				OTLPEndpoint: "http://otel-collector:4318",
+			},
+			assertions: func(_ *Pusher, err error) {
+				require.ErrorContains(t, err, "invalid metrics push interval")
+			},
+		},
+		{
+			name: "invalid StatsD address",
+			cfg: PushConfig{
+				Interval:      time.Minute,
+				StatsDAddress: "statsd",
+			},
+			assertions: func(_ *Pusher, err error) {
+				require.ErrorContains(t, err, "error resolving StatsD address")
+			},
+		},
+		{
+			name: "StatsD and OTLP",
+			cfg: PushConfig{
+				Interval:      time.Minute,
+				StatsDAddress: "127.0.0.1:8125",
+				OTLPEndpoint:  "http://otel-collector:4318",
+			},
+			assertions: func(p *Pusher, err error) {
+				require.NoError(t, err)
+				require.Len(t, p.exporters, 2)
+				require.Equal(t, time.Minute, p.interval)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				NewPusher(testCase.cfg, "kargo-test", prometheus.NewRegistry()),
+			)
+		})
+	}
+}
+
+// newTestRegistry returns a registry containing one metric of each commonly
+// used type.
+func newTestRegistry(t *testing.T) (
+	*prometheus.Registry,
+	*prometheus.CounterVec,
+	prometheus.Gauge,
+	prometheus.Histogram,
+) {
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "test_total",
+			Help: "A test counter",
+		},
+		[]string{"reason"},
+	)
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "test_in_flight",
+		Help: "A test gauge",
+	})
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "test_duration_seconds",
+		Help:    "A test histogram",
+		Buckets: []float64{1, 10},
+	})
+	require.NoError(t, registry.Register(counter))
+	require.NoError(t, registry.Register(gauge))
+	require.NoError(t, registry.Register(histogram))
+	return registry, counter, gauge, histogram
+}
@@ -0,0 +1,173 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// maxStatsDPacketSize is the maximum size of a UDP packet sent to a StatsD
+// server. It is small enough to avoid fragmentation on typical networks.
+const maxStatsDPacketSize = 1432
+
+// statsDExporter pushes metrics to a StatsD server using the StatsD line
+// protocol, with labels represented as DogStatsD-style tags. Prometheus
+// counters are pushed as StatsD counters, which are incremented by the amount
+// the Prometheus counter has grown since the previous push. Gauges are pushed
+// as StatsD gauges. Histograms and summaries are pushed as counters of their
+// sums and counts.
+type statsDExporter struct {
+	address string
+	prefix  string
+	conn    net.Conn
+
+	mu sync.Mutex
+	// previous holds the value of every counter as of the previous push.
+	previous map[string]float64
+}
+
+func newStatsDExporter(address, prefix string) (*statsDExporter, error) {
+	// Dialing a UDP address performs no I/O, so this fails only if the address
+	// is malformed or cannot be resolved.
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error resolving StatsD address %q", address)
+	}
+	return &statsDExporter{
+		address:  address,
+		prefix:   prefix,
+		conn:     conn,
+		previous: map[string]float64{},
+	}, nil
+}
+
+func (s *statsDExporter) name() string {
+	return fmt.Sprintf("StatsD server %q", s.address)
+}
+
+func (s *statsDExporter) export(_ context.Context, families []*dto.MetricFamily) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var lines []string
+	for _, family := range families {
+		name := family.GetName()
+		for _, m := range family.GetMetric() {
+			tags := statsDTags(m)
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				lines = s.appendCounter(lines, name, tags, m.GetCounter().GetValue())
+			case dto.MetricType_HISTOGRAM:
+				h := m.GetHistogram()
+				lines = s.appendCounter(lines, name+"_sum", tags, h.GetSampleSum())
+				lines = s.appendCounter(lines, name+"_count", tags, float64(h.GetSampleCount()))
+			case dto.MetricType_SUMMARY:
+				sm := m.GetSummary()
+				lines = s.appendCounter(lines, name+"_sum", tags, sm.GetSampleSum())
+				lines = s.appendCounter(lines, name+"_count", tags, float64(sm.GetSampleCount()))
+			case dto.MetricType_GAUGE:
+				lines = s.appendGauge(lines, name, tags, m.GetGauge().GetValue())
+			default:
+				lines = s.appendGauge(lines, name, tags, m.GetUntyped().GetValue())
+			}
+		}
+	}
+	return s.send(lines)
+}
+
+// appendCounter appends a line incrementing the specified counter by the
+// amount it has grown since the previous push, if it has grown at all. A
+// counter that has shrunk has been reset, so it is incremented by its entire
+// value.
+func (s *statsDExporter) appendCounter(
+	lines []string,
+	name string,
+	tags string,
+	value float64,
+) []string {
+	key := name + tags
+	delta := value - s.previous[key]
+	if delta < 0 {
+		delta = value
+	}
+	s.previous[key] = value
+	if delta == 0 {
+		return lines
+	}
+	return append(lines, s.line(name, delta, "c", tags))
+}
+
+// appendGauge appends lines setting the specified gauge to the provided value.
+// StatsD interprets a signed gauge value as a change to the gauge's current
+// value, so a negative value is set by first zeroing the gauge.
+func (s *statsDExporter) appendGauge(
+	lines []string,
+	name string,
+	tags string,
+	value float64,
+) []string {
+	if value < 0 {
+		lines = append(lines, s.line(name, 0, "g", tags))
+	}
+	return append(lines, s.line(name, value, "g", tags))
+}
+
+func (s *statsDExporter) line(name string, value float64, kind, tags string) string {
+	return fmt.Sprintf(
+		"%s%s:%s|%s%s",
+		s.prefix,
+		name,
+		strconv.FormatFloat(value, 'f', -1, 64),
+		kind,
+		tags,
+	)
+}
+
+// send sends the provided lines to the StatsD server, batching as many lines
+// as will fit into each packet.
+func (s *statsDExporter) send(lines []string) error {
+	var packet bytes.Buffer
+	flush := func() error {
+		if packet.Len() == 0 {
+			return nil
+		}
+		_, err := s.conn.Write(packet.Bytes())
+		packet.Reset()
+		return err
+	}
+	for _, line := range lines {
+		if packet.Len() > 0 && packet.Len()+1+len(line) > maxStatsDPacketSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		if packet.Len() > 0 {
+			packet.WriteByte('\n')
+		}
+		packet.WriteString(line)
+	}
+	return flush()
+}
+
+// statsDTags returns the labels of the provided metric formatted as a
+// DogStatsD-style tag suffix, sorted by name, or an empty string if the metric
+// has no labels.
+func statsDTags(m *dto.Metric) string {
+	labels := labelsOf(m)
+	if len(labels) == 0 {
+		return ""
+	}
+	tags := make([]string, 0, len(labels))
+	for name, value := range labels {
+		tags = append(tags, name+":"+value)
+	}
+	sort.Strings(tags)
+	return "|#" + strings.Join(tags, ",")
+}
@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsDExporter(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	receive := func() []string {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		buf := make([]byte, maxStatsDPacketSize)
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		lines := strings.Split(string(buf[:n]), "\n")
+		sort.Strings(lines)
+		return lines
+	}
+
+	registry, counter, gauge, histogram := newTestRegistry(t)
+	e, err := newStatsDExporter(conn.LocalAddr().String(), "kargo.")
+	require.NoError(t, err)
+
+	counter.WithLabelValues("timeout").Add(3)
+	gauge.Set(-2)
+	histogram.Observe(5)
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.NoError(t, e.export(context.Background(), families))
+	require.Equal(
+		t,
+		[]string{
+			"kargo.test_duration_seconds_count:1|c",
+			"kargo.test_duration_seconds_sum:5|c",
+			"kargo.test_in_flight:-2|g",
+			"kargo.test_in_flight:0|g",
+			"kargo.test_total:3|c|#reason:timeout",
+		},
+		receive(),
+	)
+
+	// Counters are incremented only by how much they have grown
+	counter.WithLabelValues("timeout").Add(1)
+	gauge.Set(1)
+	families, err = registry.Gather()
+	require.NoError(t, err)
+	require.NoError(t, e.export(context.Background(), families))
+	require.Equal(
+		t,
+		[]string{
+			"kargo.test_in_flight:1|g",
+			"kargo.test_total:1|c|#reason:timeout",
+		},
+		receive(),
+	)
+}
+
+func TestStatsDExporterBatching(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	e, err := newStatsDExporter(conn.LocalAddr().String(), "")
+	require.NoError(t, err)
+
+	line := strings.Repeat("a", maxStatsDPacketSize/2)
+	require.NoError(t, e.send([]string{line, line, line}))
+	var packets []string
+	buf := make([]byte, maxStatsDPacketSize)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		packets = append(packets, string(buf[:n]))
+	}
+	require.Equal(t, []string{line, line, line}, packets)
+}
@@ -0,0 +1,180 @@
+package provenance
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// Envelope is a DSSE (Dead Simple Signing Envelope) wrapping a signed payload.
+// When marshaled to JSON, it conforms to the DSSE envelope format.
+type Envelope struct {
+	PayloadType string      `json:"payloadType"`
+	Payload     []byte      `json:"payload"`
+	Signatures  []Signature `json:"signatures"`
+}
+
+// Signature is a single signature over a DSSE envelope's payload.
+type Signature struct {
+	KeyID string `json:"keyid,omitempty"`
+	Sig   []byte `json:"sig"`
+}
+
+// Signer signs in-toto Statements using a private key.
+type Signer struct {
+	keyID string
+	key   crypto.Signer
+}
+
+// LoadSigner returns a Signer that signs using the PEM-encoded ECDSA, Ed25519,
+// or RSA private key found at the specified path.
+func LoadSigner(path string) (*Signer, error) {
+	keyBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading signing key from %q", path)
+	}
+	block, _ := pem.Decode(keyBytes)
+	if block == nil {
+		return nil, errors.Errorf("no PEM-encoded key found in %q", path)
+	}
+	var key any
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing signing key from %q", path)
+	}
+	return NewSigner(key)
+}
+
+// NewSigner returns a Signer that signs using the provided ECDSA, Ed25519, or
+// RSA private key.
+func NewSigner(key any) (*Signer, error) {
+	var signer crypto.Signer
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		signer = k
+	case ed25519.PrivateKey:
+		signer = k
+	case *rsa.PrivateKey:
+		signer = k
+	default:
+		return nil, errors.Errorf("unsupported signing key type %T", key)
+	}
+	keyID, err := KeyID(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{
+		keyID: keyID,
+		key:   signer,
+	}, nil
+}
+
+// KeyID returns the ID of the provided public key. This is the hex-encoded
+// SHA-256 digest of the key's DER-encoded PKIX form.
+func KeyID(pub crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", errors.Wrap(err, "error marshaling public key")
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// KeyID returns the ID of the Signer's key.
+func (s *Signer) KeyID() string {
+	return s.keyID
+}
+
+// Sign marshals the provided Statement and returns a DSSE envelope containing
+// it along with a signature over it.
+func (s *Signer) Sign(statement Statement) (*Envelope, error) {
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling statement")
+	}
+	message := pae(PayloadType, payload)
+	var sig []byte
+	if _, ok := s.key.(ed25519.PrivateKey); ok {
+		// Ed25519 signs the message itself rather than a digest of it
+		sig, err = s.key.Sign(rand.Reader, message, crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256(message)
+		sig, err = s.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "error signing statement")
+	}
+	return &Envelope{
+		PayloadType: PayloadType,
+		Payload:     payload,
+		Signatures: []Signature{
+			{
+				KeyID: s.keyID,
+				Sig:   sig,
+			},
+		},
+	}, nil
+}
+
+// Verify returns an error if the envelope does not carry a valid signature by
+// the private key corresponding to the provided public key.
+func (e *Envelope) Verify(pub crypto.PublicKey) error {
+	keyID, err := KeyID(pub)
+	if err != nil {
+		return err
+	}
+	message := pae(e.PayloadType, e.Payload)
+	digest := sha256.Sum256(message)
+	for _, sig := range e.Signatures {
+		if sig.KeyID != "" && sig.KeyID != keyID {
+			continue
+		}
+		var valid bool
+		switch k := pub.(type) {
+		case *ecdsa.PublicKey:
+			valid = ecdsa.VerifyASN1(k, digest[:], sig.Sig)
+		case ed25519.PublicKey:
+			valid = ed25519.Verify(k, message, sig.Sig)
+		case *rsa.PublicKey:
+			valid = rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig.Sig) == nil
+		default:
+			return errors.Errorf("unsupported public key type %T", pub)
+		}
+		if valid {
+			return nil
+		}
+	}
+	return errors.Errorf("no valid signature found for key %q", keyID)
+}
+
+// pae returns the DSSE pre-authentication encoding of the provided payload
+// type and payload. This, rather than the payload itself, is what gets signed.
+func pae(payloadType string, payload []byte) []byte {
+	return []byte(
+		fmt.Sprintf(
+			"DSSEv1 %d %s %d %s",
+			len(payloadType),
+			payloadType,
+			len(payload),
+			payload,
+		),
+	)
+}
@@ -0,0 +1,184 @@
+package provenance
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSigner(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecDER, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(t, err)
+	pkcs8DER, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		contents   []byte
+		assertions func(*Signer, error)
+	}{
+		{
+			name:     "not PEM-encoded",
+			contents: []byte("not a key"),
+			assertions: func(_ *Signer, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "no PEM-encoded key found")
+			},
+		},
+		{
+			name: "invalid key",
+			contents: pem.EncodeToMemory(&pem.Block{
+				Type:  "PRIVATE KEY",
+				Bytes: []byte("not a key"),
+			}),
+			assertions: func(_ *Signer, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing signing key")
+			},
+		},
+		{
+			name: "EC private key",
+			contents: pem.EncodeToMemory(&pem.Block{
+				Type:  "EC PRIVATE KEY",
+				Bytes: ecDER,
+			}),
+			assertions: func(signer *Signer, err error) {
+				require.NoError(t, err)
+				keyID, err := KeyID(ecKey.Public())
+				require.NoError(t, err)
+				require.Equal(t, keyID, signer.KeyID())
+			},
+		},
+		{
+			name: "PKCS #8 private key",
+			contents: pem.EncodeToMemory(&pem.Block{
+				Type:  "PRIVATE KEY",
+				Bytes: pkcs8DER,
+			}),
+			assertions: func(signer *Signer, err error) {
+				require.NoError(t, err)
+				keyID, err := KeyID(ecKey.Public())
+				require.NoError(t, err)
+				require.Equal(t, keyID, signer.KeyID())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "key.pem")
+			require.NoError(t, os.WriteFile(path, testCase.contents, 0600))
+			testCase.assertions(LoadSigner(path))
+		})
+	}
+}
+
+func TestNewSigner(t *testing.T) {
+	_, err := NewSigner("not a key")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported signing key type")
+}
+
+func TestSignAndVerify(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	statement := NewStatement(
+		[]ResourceDescriptor{
+			{
+				Name:   "fake-subject",
+				Digest: map[string]string{"sha1": "fake-digest"},
+			},
+		},
+		Provenance{
+			BuildDefinition: BuildDefinition{
+				BuildType:          "fake-build-type",
+				ExternalParameters: map[string]any{"fake-key": "fake-value"},
+			},
+			RunDetails: RunDetails{
+				Builder: Builder{ID: "fake-builder"},
+			},
+		},
+	)
+
+	testCases := []struct {
+		name       string
+		key        crypto.Signer
+		assertions func(*Envelope, crypto.Signer)
+	}{
+		{
+			name: "ECDSA",
+			key:  ecKey,
+			assertions: func(envelope *Envelope, key crypto.Signer) {
+				require.NoError(t, envelope.Verify(key.Public()))
+			},
+		},
+		{
+			name: "Ed25519",
+			key:  edKey,
+			assertions: func(envelope *Envelope, key crypto.Signer) {
+				require.NoError(t, envelope.Verify(key.Public()))
+			},
+		},
+		{
+			name: "RSA",
+			key:  rsaKey,
+			assertions: func(envelope *Envelope, key crypto.Signer) {
+				require.NoError(t, envelope.Verify(key.Public()))
+			},
+		},
+		{
+			name: "wrong key",
+			key:  ecKey,
+			assertions: func(envelope *Envelope, _ crypto.Signer) {
+				err := envelope.Verify(otherKey.Public())
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "no valid signature found")
+			},
+		},
+		{
+			name: "tampered payload",
+			key:  ecKey,
+			assertions: func(envelope *Envelope, key crypto.Signer) {
+				envelope.Payload = append(envelope.Payload, ' ')
+				err := envelope.Verify(key.Public())
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "no valid signature found")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			signer, err := NewSigner(testCase.key)
+			require.NoError(t, err)
+			envelope, err := signer.Sign(statement)
+			require.NoError(t, err)
+			require.Equal(t, PayloadType, envelope.PayloadType)
+			require.Len(t, envelope.Signatures, 1)
+			require.Equal(t, signer.KeyID(), envelope.Signatures[0].KeyID)
+			signed := Statement{}
+			require.NoError(t, json.Unmarshal(envelope.Payload, &signed))
+			require.Equal(t, StatementType, signed.Type)
+			require.Equal(t, PredicateTypeSLSAProvenance, signed.PredicateType)
+			testCase.assertions(envelope, testCase.key)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package provenance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"oras.land/oras-go/pkg/registry"
+	"oras.land/oras-go/pkg/registry/remote/auth"
+)
+
+const (
+	// ArtifactType is the OCI artifact type of pushed attestations.
+	ArtifactType = "application/vnd.akuity.kargo.attestation.v1+json"
+	// MediaTypeEnvelope is the OCI media type of a DSSE envelope.
+	MediaTypeEnvelope = "application/vnd.dsse.envelope.v1+json"
+)
+
+// Credentials represents the credentials for connecting to a private OCI
+// repository.
+type Credentials struct {
+	// Username identifies a principal, which combined with the value of the
+	// Password field, can be used for reading from and writing to some OCI
+	// repository.
+	Username string
+	// Password, when combined with the principal identified by the Username
+	// field, can be used for reading from and writing to some OCI repository.
+	Password string
+}
+
+// Push pushes the provided envelope to the specified OCI repository as an
+// artifact tagged with the specified tag and returns a digest-qualified
+// reference to it. Provided credentials may be nil for repositories that do
+// not require authentication.
+func Push(
+	ctx context.Context,
+	repoURL string,
+	tag string,
+	envelope *Envelope,
+	creds *Credentials,
+) (string, error) {
+	return push(ctx, "https", repoURL, tag, envelope, creds)
+}
+
+func push(
+	ctx context.Context,
+	scheme string,
+	repoURL string,
+	tag string,
+	envelope *Envelope,
+	creds *Credentials,
+) (string, error) {
+	ref, err := registry.ParseReference(repoURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "error parsing OCI repository %q", repoURL)
+	}
+	p := &pusher{
+		baseURL: fmt.Sprintf("%s://%s/v2/%s", scheme, ref.Host(), ref.Repository),
+		client: &auth.Client{
+			Credential: func(context.Context, string) (auth.Credential, error) {
+				if creds != nil {
+					return auth.Credential{
+						Username: creds.Username,
+						Password: creds.Password,
+					}, nil
+				}
+				return auth.Credential{}, nil
+			},
+		},
+	}
+	ctx = auth.WithScopes(
+		ctx,
+		auth.ScopeRepository(ref.Repository, auth.ActionPull, auth.ActionPush),
+	)
+
+	envelopeBytes, err := json.Marshal(envelope)
+	if err != nil {
+		return "", errors.Wrap(err, "error marshaling envelope")
+	}
+	layer := ocispec.Descriptor{
+		MediaType: MediaTypeEnvelope,
+		Digest:    digest.FromBytes(envelopeBytes),
+		Size:      int64(len(envelopeBytes)),
+	}
+	if err = p.pushBlob(ctx, layer.Digest, envelopeBytes); err != nil {
+		return "", errors.Wrapf(err, "error pushing envelope to %q", repoURL)
+	}
+	if err = p.pushBlob(
+		ctx,
+		ocispec.DescriptorEmptyJSON.Digest,
+		ocispec.DescriptorEmptyJSON.Data,
+	); err != nil {
+		return "", errors.Wrapf(err, "error pushing config to %q", repoURL)
+	}
+
+	manifestBytes, err := json.Marshal(ocispec.Manifest{
+		Versioned:    specs.Versioned{SchemaVersion: 2},
+		MediaType:    ocispec.MediaTypeImageManifest,
+		ArtifactType: ArtifactType,
+		Config:       ocispec.DescriptorEmptyJSON,
+		Layers:       []ocispec.Descriptor{layer},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "error marshaling manifest")
+	}
+	if err = p.pushManifest(ctx, tag, manifestBytes); err != nil {
+		return "", errors.Wrapf(err, "error pushing manifest to %q", repoURL)
+	}
+	return fmt.Sprintf("%s@%s", repoURL, digest.FromBytes(manifestBytes)), nil
+}
+
+// pusher pushes content to a single OCI repository using the OCI distribution
+// API.
+type pusher struct {
+	baseURL string
+	client  *auth.Client
+}
+
+// pushBlob pushes the provided blob to the repository, unless the repository
+// already has it.
+func (p *pusher) pushBlob(
+	ctx context.Context,
+	dgst digest.Digest,
+	blob []byte,
+) error {
+	res, err := p.do(ctx, http.MethodHead, p.baseURL+"/blobs/"+dgst.String(), "", nil)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	if res, err = p.do(
+		ctx,
+		http.MethodPost,
+		p.baseURL+"/blobs/uploads/",
+		"",
+		nil,
+	); err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusAccepted {
+		return errors.Errorf("unexpected status %d starting upload", res.StatusCode)
+	}
+	location, err := res.Location()
+	if err != nil {
+		return errors.Wrap(err, "error reading upload location")
+	}
+	query := location.Query()
+	query.Set("digest", dgst.String())
+	location.RawQuery = query.Encode()
+
+	if res, err = p.do(
+		ctx,
+		http.MethodPut,
+		location.String(),
+		"application/octet-stream",
+		blob,
+	); err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusCreated {
+		return errors.Errorf("unexpected status %d completing upload", res.StatusCode)
+	}
+	return nil
+}
+
+// pushManifest pushes the provided image manifest to the repository under the
+// provided tag.
+func (p *pusher) pushManifest(
+	ctx context.Context,
+	tag string,
+	manifest []byte,
+) error {
+	res, err := p.do(
+		ctx,
+		http.MethodPut,
+		p.baseURL+"/manifests/"+url.PathEscape(tag),
+		ocispec.MediaTypeImageManifest,
+		manifest,
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusCreated {
+		return errors.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// do sends a request to the repository and returns the response with its body
+// already drained and closed.
+func (p *pusher) do(
+	ctx context.Context,
+	method string,
+	reqURL string,
+	contentType string,
+	body []byte,
+) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		method,
+		reqURL,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating request")
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error sending %s request", method)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+	return res, nil
+}
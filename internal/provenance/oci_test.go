@@ -0,0 +1,100 @@
+package provenance
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRegistry is a minimal, in-memory implementation of the parts of the OCI
+// distribution API that are used for pushing.
+type fakeRegistry struct {
+	mu        sync.Mutex
+	blobs     map[string][]byte
+	manifests map[string][]byte
+}
+
+func (f *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	const prefix = "/v2/fake-repo"
+	path := strings.TrimPrefix(r.URL.Path, prefix)
+	switch {
+	case r.Method == http.MethodHead && strings.HasPrefix(path, "/blobs/"):
+		if _, ok := f.blobs[strings.TrimPrefix(path, "/blobs/")]; ok {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case r.Method == http.MethodPost && path == "/blobs/uploads/":
+		w.Header().Set("Location", prefix+"/blobs/uploads/fake-upload")
+		w.WriteHeader(http.StatusAccepted)
+	case r.Method == http.MethodPut && path == "/blobs/uploads/fake-upload":
+		body, _ := io.ReadAll(r.Body)
+		f.blobs[r.URL.Query().Get("digest")] = body
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && strings.HasPrefix(path, "/manifests/"):
+		body, _ := io.ReadAll(r.Body)
+		f.manifests[strings.TrimPrefix(path, "/manifests/")] = body
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestPush(t *testing.T) {
+	registry := &fakeRegistry{
+		blobs:     map[string][]byte{},
+		manifests: map[string][]byte{},
+	}
+	server := httptest.NewServer(registry)
+	defer server.Close()
+	repoURL := strings.TrimPrefix(server.URL, "http://") + "/fake-repo"
+
+	envelope := &Envelope{
+		PayloadType: PayloadType,
+		Payload:     []byte("fake-payload"),
+		Signatures: []Signature{
+			{
+				KeyID: "fake-key-id",
+				Sig:   []byte("fake-sig"),
+			},
+		},
+	}
+	ref, err := push(
+		context.Background(),
+		"http",
+		repoURL,
+		"fake-tag",
+		envelope,
+		nil,
+	)
+	require.NoError(t, err)
+
+	manifestBytes, ok := registry.manifests["fake-tag"]
+	require.True(t, ok)
+	require.Equal(t, repoURL+"@"+digest.FromBytes(manifestBytes).String(), ref)
+
+	manifest := ocispec.Manifest{}
+	require.NoError(t, json.Unmarshal(manifestBytes, &manifest))
+	require.Equal(t, ArtifactType, manifest.ArtifactType)
+	require.Len(t, manifest.Layers, 1)
+	require.Equal(t, MediaTypeEnvelope, manifest.Layers[0].MediaType)
+
+	envelopeBytes, ok := registry.blobs[manifest.Layers[0].Digest.String()]
+	require.True(t, ok)
+	pushed := &Envelope{}
+	require.NoError(t, json.Unmarshal(envelopeBytes, pushed))
+	require.Equal(t, envelope, pushed)
+	_, ok = registry.blobs[manifest.Config.Digest.String()]
+	require.True(t, ok)
+}
@@ -0,0 +1,80 @@
+package provenance
+
+import "time"
+
+const (
+	// StatementType is the type of an in-toto Statement.
+	StatementType = "https://in-toto.io/Statement/v1"
+	// PredicateTypeSLSAProvenance is the type of a SLSA provenance predicate.
+	PredicateTypeSLSAProvenance = "https://slsa.dev/provenance/v1"
+	// PayloadType is the DSSE payload type of an in-toto Statement.
+	PayloadType = "application/vnd.in-toto+json"
+)
+
+// Statement is an in-toto Statement. It binds a predicate (in practice, always
+// a SLSA provenance predicate) to the artifacts it describes.
+type Statement struct {
+	Type          string               `json:"_type"`
+	Subject       []ResourceDescriptor `json:"subject"`
+	PredicateType string               `json:"predicateType"`
+	Predicate     Provenance           `json:"predicate"`
+}
+
+// NewStatement returns a Statement that binds the provided SLSA provenance
+// predicate to the provided subjects.
+func NewStatement(
+	subjects []ResourceDescriptor,
+	predicate Provenance,
+) Statement {
+	return Statement{
+		Type:          StatementType,
+		Subject:       subjects,
+		PredicateType: PredicateTypeSLSAProvenance,
+		Predicate:     predicate,
+	}
+}
+
+// ResourceDescriptor describes an artifact, either by name and digest or by
+// URI.
+type ResourceDescriptor struct {
+	Name   string            `json:"name,omitempty"`
+	URI    string            `json:"uri,omitempty"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// Provenance is a SLSA provenance predicate.
+type Provenance struct {
+	BuildDefinition BuildDefinition `json:"buildDefinition"`
+	RunDetails      RunDetails      `json:"runDetails"`
+}
+
+// BuildDefinition describes the inputs to the process that produced the
+// subjects of a Statement.
+type BuildDefinition struct {
+	BuildType            string               `json:"buildType"`
+	ExternalParameters   map[string]any       `json:"externalParameters"`
+	InternalParameters   map[string]any       `json:"internalParameters,omitempty"`
+	ResolvedDependencies []ResourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+// RunDetails describes a single run of the process that produced the subjects
+// of a Statement.
+type RunDetails struct {
+	Builder  Builder       `json:"builder"`
+	Metadata BuildMetadata `json:"metadata"`
+}
+
+// Builder identifies the entity that executed the process that produced the
+// subjects of a Statement.
+type Builder struct {
+	ID      string            `json:"id"`
+	Version map[string]string `json:"version,omitempty"`
+}
+
+// BuildMetadata describes when and as part of what invocation the subjects of
+// a Statement were produced.
+type BuildMetadata struct {
+	InvocationID string     `json:"invocationId,omitempty"`
+	StartedOn    *time.Time `json:"startedOn,omitempty"`
+	FinishedOn   *time.Time `json:"finishedOn,omitempty"`
+}
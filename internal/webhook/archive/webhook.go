@@ -0,0 +1,84 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// path is the path at which the webhook is served. It is registered for the
+// creation, update, and deletion of every Kargo resource that belongs to a
+// Project.
+const path = "/validate-kargo-akuity-io-v1alpha1-archive"
+
+// webhook rejects the creation, update, or deletion of any resource belonging
+// to an archived Project. Resources of an archived Project may still be
+// deleted while the Project's namespace is itself being deleted, as that
+// deletion would otherwise never complete.
+type webhook struct {
+	// The following behaviors are overridable for testing purposes:
+
+	getNamespaceFn func(
+		context.Context,
+		client.ObjectKey,
+		client.Object,
+		...client.GetOption,
+	) error
+}
+
+func SetupWebhookWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(
+		path,
+		&admission.Webhook{Handler: newWebhook(mgr.GetClient())},
+	)
+	return nil
+}
+
+func newWebhook(kubeClient client.Client) *webhook {
+	return &webhook{
+		getNamespaceFn: kubeClient.Get,
+	}
+}
+
+func (w *webhook) Handle(
+	ctx context.Context,
+	req admission.Request,
+) admission.Response {
+	if req.Namespace == "" {
+		return admission.Allowed("")
+	}
+	ns := &corev1.Namespace{}
+	if err := w.getNamespaceFn(
+		ctx,
+		types.NamespacedName{Name: req.Namespace},
+		ns,
+	); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			// Whether the namespace is a Project is validated elsewhere
+			return admission.Allowed("")
+		}
+		return admission.Errored(
+			http.StatusInternalServerError,
+			errors.Wrapf(err, "error getting namespace %q", req.Namespace),
+		)
+	}
+	if !kargoapi.IsNamespaceArchived(ns) || ns.DeletionTimestamp != nil {
+		return admission.Allowed("")
+	}
+	return admission.Denied(
+		fmt.Sprintf(
+			"project %q is archived and must be unarchived before its "+
+				"resources can be modified",
+			req.Namespace,
+		),
+	)
+}
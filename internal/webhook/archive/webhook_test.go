@@ -0,0 +1,126 @@
+package archive
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestHandle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	newProject := func(archived bool, deleting bool) *corev1.Namespace {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "fake-project",
+				Labels: map[string]string{
+					kargoapi.LabelProjectKey: kargoapi.LabelTrueValue,
+				},
+			},
+		}
+		if archived {
+			ns.Labels[kargoapi.LabelArchivedKey] = kargoapi.LabelTrueValue
+		}
+		if deleting {
+			now := metav1.Now()
+			ns.DeletionTimestamp = &now
+			ns.Finalizers = []string{"kubernetes"}
+		}
+		return ns
+	}
+
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		assertions func(admission.Response)
+	}{
+		{
+			name: "error getting namespace",
+			webhook: &webhook{
+				getNamespaceFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(res admission.Response) {
+				require.False(t, res.Allowed)
+				require.Equal(t, int32(http.StatusInternalServerError), res.Result.Code)
+				require.Contains(t, res.Result.Message, "something went wrong")
+			},
+		},
+		{
+			name:    "namespace not found",
+			webhook: newWebhook(fake.NewClientBuilder().WithScheme(scheme).Build()),
+			assertions: func(res admission.Response) {
+				require.True(t, res.Allowed)
+			},
+		},
+		{
+			name: "project not archived",
+			webhook: newWebhook(
+				fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					newProject(false, false),
+				).Build(),
+			),
+			assertions: func(res admission.Response) {
+				require.True(t, res.Allowed)
+			},
+		},
+		{
+			name: "archived project being deleted",
+			webhook: newWebhook(
+				fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					newProject(true, true),
+				).Build(),
+			),
+			assertions: func(res admission.Response) {
+				require.True(t, res.Allowed)
+			},
+		},
+		{
+			name: "project archived",
+			webhook: newWebhook(
+				fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					newProject(true, false),
+				).Build(),
+			),
+			assertions: func(res admission.Response) {
+				require.False(t, res.Allowed)
+				require.Equal(t, int32(http.StatusForbidden), res.Result.Code)
+				require.Contains(t, string(res.Result.Reason), `project "fake-project" is archived`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.webhook.Handle(
+					context.Background(),
+					admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Namespace: "fake-project",
+							Operation: admissionv1.Update,
+						},
+					},
+				),
+			)
+		})
+	}
+}
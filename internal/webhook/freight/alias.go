@@ -0,0 +1,212 @@
+package freight
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// maxDictionaryAliasAttempts is the number of randomly generated aliases that
+// will be tried before giving up on finding one that isn't already in use.
+const maxDictionaryAliasAttempts = 10
+
+var aliasAdjectives = []string{
+	"agile", "amber", "bold", "brave", "bright", "calm", "clever", "cosmic",
+	"crisp", "daring", "eager", "early", "fancy", "fierce", "gentle", "golden",
+	"happy", "hidden", "humble", "jolly", "keen", "lively", "lucky", "mellow",
+	"mighty", "nimble", "noble", "polite", "proud", "quick", "quiet", "rapid",
+	"shiny", "silent", "silver", "smooth", "snappy", "steady", "sunny", "swift",
+	"tidy", "vivid", "wise", "witty", "zesty",
+}
+
+var aliasNouns = []string{
+	"badger", "beacon", "canyon", "comet", "coral", "crane", "delta", "dolphin",
+	"falcon", "fern", "glacier", "harbor", "heron", "island", "jaguar", "lagoon",
+	"lantern", "lotus", "maple", "meadow", "meteor", "nebula", "orbit", "otter",
+	"panda", "pebble", "phoenix", "pine", "quartz", "raven", "reef", "river",
+	"salmon", "summit", "thistle", "tiger", "tundra", "valley", "walrus",
+	"willow", "zebra",
+}
+
+// assignAlias generates an alias for the provided Freight according to the
+// Freight alias policy, if any, of the Project the Freight belongs to. If the
+// Project has no Freight alias policy, the Freight is left without an alias.
+// When dryRun is true, no state is modified in the course of generating an
+// alias.
+func (w *webhook) assignAlias(
+	ctx context.Context,
+	freight *kargoapi.Freight,
+	dryRun bool,
+) error {
+	project := &corev1.Namespace{}
+	if err := w.client.Get(
+		ctx,
+		client.ObjectKey{Name: freight.Namespace},
+		project,
+	); err != nil {
+		return apierrors.NewInternalError(
+			errors.Wrapf(err, "error getting project %q", freight.Namespace),
+		)
+	}
+	policy := kargoapi.FreightAliasPolicy(
+		project.Annotations[kargoapi.AnnotationKeyFreightAliasPolicy],
+	)
+	if policy == "" {
+		return nil
+	}
+	prefix := project.Annotations[kargoapi.AnnotationKeyFreightAliasPrefix]
+	if prefix == "" {
+		prefix = project.Name
+	}
+
+	switch policy {
+	case kargoapi.FreightAliasPolicySequence:
+		n, err := w.reserveAliasSequenceNumberFn(ctx, project.Name, dryRun)
+		if err != nil {
+			return apierrors.NewInternalError(
+				errors.Wrapf(
+					err,
+					"error reserving Freight alias sequence number in project %q",
+					project.Name,
+				),
+			)
+		}
+		freight.Alias = fmt.Sprintf("%s-%d", prefix, n)
+		return nil
+	case kargoapi.FreightAliasPolicyDictionary:
+		for i := 0; i < maxDictionaryAliasAttempts; i++ {
+			alias, err := newDictionaryAlias(prefix)
+			if err != nil {
+				return apierrors.NewInternalError(
+					errors.Wrap(err, "error generating Freight alias"),
+				)
+			}
+			inUse, err := w.isAliasInUseFn(ctx, project.Name, alias)
+			if err != nil {
+				return apierrors.NewInternalError(
+					errors.Wrapf(
+						err,
+						"error checking whether Freight alias %q is in use",
+						alias,
+					),
+				)
+			}
+			if !inUse {
+				freight.Alias = alias
+				return nil
+			}
+		}
+		return apierrors.NewInternalError(
+			errors.Errorf(
+				"unable to generate an unused Freight alias in project %q after %d "+
+					"attempts",
+				project.Name,
+				maxDictionaryAliasAttempts,
+			),
+		)
+	default:
+		return apierrors.NewBadRequest(
+			fmt.Sprintf(
+				"project %q has invalid Freight alias policy %q; expected %q or %q",
+				project.Name,
+				policy,
+				kargoapi.FreightAliasPolicySequence,
+				kargoapi.FreightAliasPolicyDictionary,
+			),
+		)
+	}
+}
+
+// reserveAliasSequenceNumber increments the Freight alias sequence number
+// recorded on the specified Project's namespace and returns the new value.
+// Updates to the namespace are subject to optimistic concurrency control, so
+// no two callers can reserve the same number. When dryRun is true, the next
+// number is returned without being reserved.
+func (w *webhook) reserveAliasSequenceNumber(
+	ctx context.Context,
+	project string,
+	dryRun bool,
+) (int64, error) {
+	var n int64
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		ns := &corev1.Namespace{}
+		if err := w.client.Get(ctx, client.ObjectKey{Name: project}, ns); err != nil {
+			return err
+		}
+		n = 0
+		if val, ok :=
+			ns.Annotations[kargoapi.AnnotationKeyFreightAliasSequence]; ok {
+			var err error
+			if n, err = strconv.ParseInt(val, 10, 64); err != nil {
+				return errors.Wrapf(
+					err,
+					"error parsing value %q of annotation %q",
+					val,
+					kargoapi.AnnotationKeyFreightAliasSequence,
+				)
+			}
+		}
+		n++
+		if dryRun {
+			return nil
+		}
+		if ns.Annotations == nil {
+			ns.Annotations = map[string]string{}
+		}
+		ns.Annotations[kargoapi.AnnotationKeyFreightAliasSequence] =
+			strconv.FormatInt(n, 10)
+		return w.client.Update(ctx, ns)
+	})
+	return n, err
+}
+
+// isAliasInUse returns a boolean indicating whether any Freight in the
+// specified Project already has the specified alias.
+func (w *webhook) isAliasInUse(
+	ctx context.Context,
+	project string,
+	alias string,
+) (bool, error) {
+	freight := kargoapi.FreightList{}
+	if err := w.client.List(
+		ctx,
+		&freight,
+		client.InNamespace(project),
+		client.MatchingLabels{kargoapi.LabelAliasKey: alias},
+	); err != nil {
+		return false, err
+	}
+	return len(freight.Items) > 0, nil
+}
+
+// newDictionaryAlias returns an alias of the form <prefix>-<adjective>-<noun>
+// using randomly selected words.
+func newDictionaryAlias(prefix string) (string, error) {
+	adjective, err := randomWord(aliasAdjectives)
+	if err != nil {
+		return "", err
+	}
+	noun, err := randomWord(aliasNouns)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s-%s", prefix, adjective, noun), nil
+}
+
+func randomWord(words []string) (string, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(len(words))))
+	if err != nil {
+		return "", err
+	}
+	return words[i.Int64()], nil
+}
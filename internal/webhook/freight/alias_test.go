@@ -0,0 +1,325 @@
+package freight
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestAssignAlias(t *testing.T) {
+	newProject := func(annotations map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "fake-project",
+				Annotations: annotations,
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		project    *corev1.Namespace
+		webhook    *webhook
+		assertions func(*kargoapi.Freight, error)
+	}{
+		{
+			name: "error getting project",
+			webhook: &webhook{
+				client: fake.NewClientBuilder().Build(),
+			},
+			assertions: func(_ *kargoapi.Freight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error getting project")
+			},
+		},
+		{
+			name:    "project has no alias policy",
+			project: newProject(nil),
+			webhook: &webhook{},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Empty(t, freight.Alias)
+			},
+		},
+		{
+			name: "project has invalid alias policy",
+			project: newProject(map[string]string{
+				kargoapi.AnnotationKeyFreightAliasPolicy: "Bogus",
+			}),
+			webhook: &webhook{},
+			assertions: func(_ *kargoapi.Freight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid Freight alias policy")
+			},
+		},
+		{
+			name: "error reserving sequence number",
+			project: newProject(map[string]string{
+				kargoapi.AnnotationKeyFreightAliasPolicy: "Sequence",
+			}),
+			webhook: &webhook{
+				reserveAliasSequenceNumberFn: func(
+					context.Context,
+					string,
+					bool,
+				) (int64, error) {
+					return 0, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *kargoapi.Freight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "sequence policy with default prefix",
+			project: newProject(map[string]string{
+				kargoapi.AnnotationKeyFreightAliasPolicy: "Sequence",
+			}),
+			webhook: &webhook{
+				reserveAliasSequenceNumberFn: func(
+					context.Context,
+					string,
+					bool,
+				) (int64, error) {
+					return 42, nil
+				},
+			},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-project-42", freight.Alias)
+			},
+		},
+		{
+			name: "sequence policy with prefix",
+			project: newProject(map[string]string{
+				kargoapi.AnnotationKeyFreightAliasPolicy: "Sequence",
+				kargoapi.AnnotationKeyFreightAliasPrefix: "payments",
+			}),
+			webhook: &webhook{
+				reserveAliasSequenceNumberFn: func(
+					context.Context,
+					string,
+					bool,
+				) (int64, error) {
+					return 1234, nil
+				},
+			},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "payments-1234", freight.Alias)
+			},
+		},
+		{
+			name: "dictionary policy cannot find unused alias",
+			project: newProject(map[string]string{
+				kargoapi.AnnotationKeyFreightAliasPolicy: "Dictionary",
+			}),
+			webhook: &webhook{
+				isAliasInUseFn: func(context.Context, string, string) (bool, error) {
+					return true, nil
+				},
+			},
+			assertions: func(_ *kargoapi.Freight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unable to generate an unused")
+			},
+		},
+		{
+			name: "dictionary policy",
+			project: newProject(map[string]string{
+				kargoapi.AnnotationKeyFreightAliasPolicy: "Dictionary",
+				kargoapi.AnnotationKeyFreightAliasPrefix: "payments",
+			}),
+			webhook: &webhook{
+				isAliasInUseFn: func(context.Context, string, string) (bool, error) {
+					return false, nil
+				},
+			},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				parts := strings.Split(freight.Alias, "-")
+				require.Len(t, parts, 3)
+				require.Equal(t, "payments", parts[0])
+				require.Contains(t, aliasAdjectives, parts[1])
+				require.Contains(t, aliasNouns, parts[2])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.project != nil {
+				testCase.webhook.client = fake.NewClientBuilder().
+					WithObjects(testCase.project).
+					Build()
+			}
+			freight := &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+				},
+			}
+			testCase.assertions(
+				freight,
+				testCase.webhook.assignAlias(context.Background(), freight, false),
+			)
+		})
+	}
+}
+
+func TestReserveAliasSequenceNumber(t *testing.T) {
+	testCases := []struct {
+		name       string
+		project    *corev1.Namespace
+		dryRun     bool
+		assertions func(client.Client, int64, error)
+	}{
+		{
+			name: "invalid sequence number",
+			project: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-project",
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyFreightAliasSequence: "bogus",
+					},
+				},
+			},
+			assertions: func(_ client.Client, _ int64, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing value")
+			},
+		},
+		{
+			name: "first sequence number",
+			project: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-project",
+				},
+			},
+			assertions: func(c client.Client, n int64, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(1), n)
+				ns := &corev1.Namespace{}
+				err = c.Get(
+					context.Background(),
+					client.ObjectKey{Name: "fake-project"},
+					ns,
+				)
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"1",
+					ns.Annotations[kargoapi.AnnotationKeyFreightAliasSequence],
+				)
+			},
+		},
+		{
+			name: "subsequent sequence number",
+			project: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-project",
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyFreightAliasSequence: "41",
+					},
+				},
+			},
+			assertions: func(c client.Client, n int64, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(42), n)
+				ns := &corev1.Namespace{}
+				err = c.Get(
+					context.Background(),
+					client.ObjectKey{Name: "fake-project"},
+					ns,
+				)
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"42",
+					ns.Annotations[kargoapi.AnnotationKeyFreightAliasSequence],
+				)
+			},
+		},
+		{
+			name: "dry run",
+			project: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-project",
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyFreightAliasSequence: "41",
+					},
+				},
+			},
+			dryRun: true,
+			assertions: func(c client.Client, n int64, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(42), n)
+				ns := &corev1.Namespace{}
+				err = c.Get(
+					context.Background(),
+					client.ObjectKey{Name: "fake-project"},
+					ns,
+				)
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"41",
+					ns.Annotations[kargoapi.AnnotationKeyFreightAliasSequence],
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithObjects(testCase.project).Build()
+			w := &webhook{client: c}
+			n, err := w.reserveAliasSequenceNumber(
+				context.Background(),
+				"fake-project",
+				testCase.dryRun,
+			)
+			testCase.assertions(c, n, err)
+		})
+	}
+}
+
+func TestIsAliasInUse(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	w := &webhook{
+		client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-freight",
+						Namespace: "fake-project",
+						Labels: map[string]string{
+							kargoapi.LabelAliasKey: "payments-1",
+						},
+					},
+				},
+			).
+			Build(),
+	}
+	inUse, err :=
+		w.isAliasInUse(context.Background(), "fake-project", "payments-1")
+	require.NoError(t, err)
+	require.True(t, inUse)
+	inUse, err =
+		w.isAliasInUse(context.Background(), "fake-project", "payments-2")
+	require.NoError(t, err)
+	require.False(t, inUse)
+	inUse, err =
+		w.isAliasInUse(context.Background(), "another-project", "payments-1")
+	require.NoError(t, err)
+	require.False(t, inUse)
+}
@@ -2,13 +2,18 @@ package freight
 
 import (
 	"context"
+	"strings"
 
+	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
@@ -30,6 +35,26 @@ type webhook struct {
 		schema.GroupKind,
 		client.Object,
 	) error
+
+	admissionRequestFromContextFn func(context.Context) (admission.Request, error)
+
+	assignAliasFn func(
+		ctx context.Context,
+		freight *kargoapi.Freight,
+		dryRun bool,
+	) error
+
+	reserveAliasSequenceNumberFn func(
+		ctx context.Context,
+		project string,
+		dryRun bool,
+	) (int64, error)
+
+	isAliasInUseFn func(
+		ctx context.Context,
+		project string,
+		alias string,
+	) (bool, error)
 }
 
 func SetupWebhookWithManager(mgr ctrl.Manager) error {
@@ -42,13 +67,18 @@ func SetupWebhookWithManager(mgr ctrl.Manager) error {
 }
 
 func newWebhook(kubeClient client.Client) *webhook {
-	return &webhook{
-		client:            kubeClient,
-		validateProjectFn: libWebhook.ValidateProject,
+	w := &webhook{
+		client: kubeClient,
 	}
+	w.validateProjectFn = libWebhook.ValidateProject
+	w.admissionRequestFromContextFn = admission.RequestFromContext
+	w.assignAliasFn = w.assignAlias
+	w.reserveAliasSequenceNumberFn = w.reserveAliasSequenceNumber
+	w.isAliasInUseFn = w.isAliasInUse
+	return w
 }
 
-func (w *webhook) Default(_ context.Context, obj runtime.Object) error {
+func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 	freight := obj.(*kargoapi.Freight) // nolint: forcetypeassert
 	// Re-calculate ID in case it wasn't set correctly to begin with -- possible
 	// if/when we allow users to create their own Freight.
@@ -56,6 +86,33 @@ func (w *webhook) Default(_ context.Context, obj runtime.Object) error {
 	// TODO: For now, we'll force Name to be the same as ID, but be can change
 	// this later if/when we allow users to create their own Freight.
 	freight.Name = freight.ID
+
+	req, err := w.admissionRequestFromContextFn(ctx)
+	if err != nil {
+		return apierrors.NewInternalError(
+			errors.Wrap(err, "error retrieving admission request from context"),
+		)
+	}
+	// Aliases are only ever generated for new Freight
+	if freight.Alias == "" && req.Operation == admissionv1.Create {
+		if err = w.assignAliasFn(
+			ctx,
+			freight,
+			req.DryRun != nil && *req.DryRun,
+		); err != nil {
+			return err
+		}
+	}
+	// Keep the alias label in sync with the alias so Freight can be looked up
+	// by alias
+	if freight.Alias != "" {
+		if freight.Labels == nil {
+			freight.Labels = map[string]string{}
+		}
+		freight.Labels[kargoapi.LabelAliasKey] = freight.Alias
+	} else {
+		delete(freight.Labels, kargoapi.LabelAliasKey)
+	}
 	return nil
 }
 
@@ -83,11 +140,11 @@ func (w *webhook) ValidateCreate(
 			},
 		)
 	}
-	return nil
+	return w.validateAlias(ctx, freight)
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
+	ctx context.Context,
 	oldObj runtime.Object,
 	newObj runtime.Object,
 ) error {
@@ -108,6 +165,49 @@ func (w *webhook) ValidateUpdate(
 			},
 		)
 	}
+	if freight.Alias != (oldObj.(*kargoapi.Freight)).Alias { // nolint: forcetypeassert
+		return w.validateAlias(ctx, freight)
+	}
+	return nil
+}
+
+// validateAlias ensures that the provided Freight's alias, if it has one, is
+// well-formed and is not already in use by other Freight in the same Project.
+func (w *webhook) validateAlias(
+	ctx context.Context,
+	freight *kargoapi.Freight,
+) error {
+	if freight.Alias == "" {
+		return nil
+	}
+	aliasPath := field.NewPath("alias")
+	// The alias is reflected by a label, so it must be a valid label value
+	if errs := validation.IsValidLabelValue(freight.Alias); len(errs) > 0 {
+		return apierrors.NewInvalid(
+			freightGroupKind,
+			freight.Name,
+			field.ErrorList{
+				field.Invalid(aliasPath, freight.Alias, strings.Join(errs, "; ")),
+			},
+		)
+	}
+	inUse, err := w.isAliasInUseFn(ctx, freight.Namespace, freight.Alias)
+	if err != nil {
+		return apierrors.NewInternalError(
+			errors.Wrapf(
+				err,
+				"error checking whether Freight alias %q is in use",
+				freight.Alias,
+			),
+		)
+	}
+	if inUse {
+		return apierrors.NewInvalid(
+			freightGroupKind,
+			freight.Name,
+			field.ErrorList{field.Duplicate(aliasPath, freight.Alias)},
+		)
+	}
 	return nil
 }
 
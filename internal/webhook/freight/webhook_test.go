@@ -6,10 +6,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 )
@@ -19,23 +21,123 @@ func TestNewWebhook(t *testing.T) {
 	w := newWebhook(kubeClient)
 	// Assert that all overridable behaviors were initialized to a default:
 	require.NotNil(t, w.validateProjectFn)
+	require.NotNil(t, w.admissionRequestFromContextFn)
+	require.NotNil(t, w.assignAliasFn)
+	require.NotNil(t, w.reserveAliasSequenceNumberFn)
+	require.NotNil(t, w.isAliasInUseFn)
 }
 
 func TestDefault(t *testing.T) {
-	freight := &kargoapi.Freight{
-		Commits: []kargoapi.GitCommit{
-			{
-				RepoURL: "fake-repo-url",
-				ID:      "fake-id",
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		freight    *kargoapi.Freight
+		assertions func(*kargoapi.Freight, error)
+	}{
+		{
+			name: "error getting admission request",
+			webhook: &webhook{
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{}, errors.New("something went wrong")
+				},
+			},
+			freight: &kargoapi.Freight{},
+			assertions: func(_ *kargoapi.Freight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "error assigning alias",
+			webhook: &webhook{
+				admissionRequestFromContextFn: newAdmissionRequest(admissionv1.Create),
+				assignAliasFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					bool,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			freight: &kargoapi.Freight{},
+			assertions: func(_ *kargoapi.Freight, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "alias assigned on create",
+			webhook: &webhook{
+				admissionRequestFromContextFn: newAdmissionRequest(admissionv1.Create),
+				assignAliasFn: func(
+					_ context.Context,
+					freight *kargoapi.Freight,
+					_ bool,
+				) error {
+					freight.Alias = "payments-1"
+					return nil
+				},
+			},
+			freight: &kargoapi.Freight{
+				Commits: []kargoapi.GitCommit{
+					{
+						RepoURL: "fake-repo-url",
+						ID:      "fake-id",
+					},
+				},
+			},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, freight.ID)
+				require.NotEmpty(t, freight.Name)
+				require.Equal(t, freight.ID, freight.Name)
+				require.Equal(t, "payments-1", freight.Alias)
+				require.Equal(t, "payments-1", freight.Labels[kargoapi.LabelAliasKey])
+			},
+		},
+		{
+			name: "alias not assigned on update",
+			webhook: &webhook{
+				admissionRequestFromContextFn: newAdmissionRequest(admissionv1.Update),
+			},
+			freight: &kargoapi.Freight{
+				ObjectMeta: v1.ObjectMeta{
+					Labels: map[string]string{
+						kargoapi.LabelAliasKey: "payments-1",
+					},
+				},
+			},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Empty(t, freight.Alias)
+				require.NotContains(t, freight.Labels, kargoapi.LabelAliasKey)
+			},
+		},
+		{
+			name: "alias explicitly specified",
+			webhook: &webhook{
+				admissionRequestFromContextFn: newAdmissionRequest(admissionv1.Create),
+			},
+			freight: &kargoapi.Freight{
+				Alias: "my-alias",
+			},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "my-alias", freight.Alias)
+				require.Equal(t, "my-alias", freight.Labels[kargoapi.LabelAliasKey])
 			},
 		},
 	}
-	w := &webhook{}
-	err := w.Default(context.Background(), freight)
-	require.NoError(t, err)
-	require.NotEmpty(t, freight.ID)
-	require.NotEmpty(t, freight.Name)
-	require.Equal(t, freight.ID, freight.Name)
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.freight,
+				testCase.webhook.Default(context.Background(), testCase.freight),
+			)
+		})
+	}
 }
 
 func TestValidateCreate(t *testing.T) {
@@ -83,6 +185,75 @@ func TestValidateCreate(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "invalid alias",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+			},
+			freight: kargoapi.Freight{
+				Alias:   "not a valid alias",
+				Commits: []kargoapi.GitCommit{{}},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "alias: Invalid value")
+			},
+		},
+		{
+			name: "error checking whether alias is in use",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				isAliasInUseFn: func(context.Context, string, string) (bool, error) {
+					return false, errors.New("something went wrong")
+				},
+			},
+			freight: kargoapi.Freight{
+				Alias:   "payments-1",
+				Commits: []kargoapi.GitCommit{{}},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "alias already in use",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				isAliasInUseFn: func(context.Context, string, string) (bool, error) {
+					return true, nil
+				},
+			},
+			freight: kargoapi.Freight{
+				Alias:   "payments-1",
+				Commits: []kargoapi.GitCommit{{}},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "alias: Duplicate value")
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -139,7 +310,26 @@ func TestValidateUpdate(t *testing.T) {
 				require.Contains(t, err.Error(), "freight is immutable")
 			},
 		},
-
+		{
+			name: "attempt to change alias to one already in use",
+			setup: func() (*kargoapi.Freight, *kargoapi.Freight) {
+				oldFreight := &kargoapi.Freight{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+					},
+					ID:    "fake-id",
+					Alias: "payments-1",
+				}
+				newFreight := oldFreight.DeepCopy()
+				newFreight.Alias = "payments-2"
+				return oldFreight, newFreight
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "alias: Duplicate value")
+			},
+		},
 		{
 			name: "update without mutation",
 			setup: func() (*kargoapi.Freight, *kargoapi.Freight) {
@@ -160,7 +350,11 @@ func TestValidateUpdate(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			w := &webhook{}
+			w := &webhook{
+				isAliasInUseFn: func(context.Context, string, string) (bool, error) {
+					return true, nil
+				},
+			}
 			oldFreight, newFreight := testCase.setup()
 			testCase.assertions(
 				w.ValidateUpdate(context.Background(), oldFreight, newFreight),
@@ -179,3 +373,15 @@ func TestValidateDelete(t *testing.T) {
 		),
 	)
 }
+
+func newAdmissionRequest(
+	operation admissionv1.Operation,
+) func(context.Context) (admission.Request, error) {
+	return func(context.Context) (admission.Request, error) {
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: operation,
+			},
+		}, nil
+	}
+}
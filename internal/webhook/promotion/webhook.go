@@ -3,7 +3,9 @@ package promotion
 import (
 	"context"
 
+	"github.com/kelseyhightower/envconfig"
 	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
 	authzv1 "k8s.io/api/authorization/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,8 +33,28 @@ var (
 	}
 )
 
+// WebhookConfig represents configuration for the Promotion webhook.
+type WebhookConfig struct {
+	// APIServerUsername is the username with which the Kargo API server
+	// authenticates to Kubernetes; e.g.
+	// system:serviceaccount:kargo:kargo-api. Since the API server creates
+	// Promotions on behalf of its own users, the promoter it records on a
+	// Promotion is trusted only when the Promotion is created by this user. If
+	// empty, the promoter is always the user that created the Promotion.
+	APIServerUsername string `envconfig:"API_SERVER_USERNAME"`
+}
+
+// WebhookConfigFromEnv returns a WebhookConfig populated from environment
+// variables.
+func WebhookConfigFromEnv() WebhookConfig {
+	var cfg WebhookConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
 type webhook struct {
 	client client.Client
+	cfg    WebhookConfig
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -55,6 +77,13 @@ type webhook struct {
 		action string,
 	) error
 
+	isFreightSharedWithFn func(
+		ctx context.Context,
+		client client.Client,
+		freightProject string,
+		project string,
+	) (bool, error)
+
 	admissionRequestFromContextFn func(context.Context) (admission.Request, error)
 
 	createSubjectAccessReviewFn func(
@@ -64,8 +93,8 @@ type webhook struct {
 	) error
 }
 
-func SetupWebhookWithManager(mgr ctrl.Manager) error {
-	w := newWebhook(mgr.GetClient())
+func SetupWebhookWithManager(mgr ctrl.Manager, cfg WebhookConfig) error {
+	w := newWebhook(mgr.GetClient(), cfg)
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&kargoapi.Promotion{}).
 		WithDefaulter(w).
@@ -73,13 +102,15 @@ func SetupWebhookWithManager(mgr ctrl.Manager) error {
 		Complete()
 }
 
-func newWebhook(kubeClient client.Client) *webhook {
+func newWebhook(kubeClient client.Client, cfg WebhookConfig) *webhook {
 	w := &webhook{
 		client: kubeClient,
+		cfg:    cfg,
 	}
 	w.getStageFn = kargoapi.GetStage
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.authorizeFn = w.authorize
+	w.isFreightSharedWithFn = kargoapi.IsFreightSharedWith
 	w.admissionRequestFromContextFn = admission.RequestFromContext
 	w.createSubjectAccessReviewFn = w.client.Create
 	return w
@@ -113,6 +144,25 @@ func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 	ownerRef :=
 		metav1.NewControllerRef(stage, kargoapi.GroupVersion.WithKind("Stage"))
 	promo.ObjectMeta.OwnerReferences = []metav1.OwnerReference{*ownerRef}
+
+	// Record the subject that requested the Promotion so that it can be attested
+	// to once the Promotion has been executed. The API server creates Promotions
+	// on behalf of its own users and records which user that was, so that is
+	// the only case in which a promoter that is already present is kept.
+	req, err := w.admissionRequestFromContextFn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error retrieving admission request from context")
+	}
+	if req.Operation == admissionv1.Create {
+		if promo.Annotations == nil {
+			promo.Annotations = map[string]string{}
+		}
+		if w.cfg.APIServerUsername == "" ||
+			req.UserInfo.Username != w.cfg.APIServerUsername ||
+			promo.Annotations[kargoapi.AnnotationKeyPromoter] == "" {
+			promo.Annotations[kargoapi.AnnotationKeyPromoter] = req.UserInfo.Username
+		}
+	}
 	return nil
 }
 
@@ -125,7 +175,10 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, promotionGroupKind, promo); err != nil {
 		return err
 	}
-	return w.authorizeFn(ctx, promo, "create")
+	if err := w.authorizeFn(ctx, promo, "create"); err != nil {
+		return err
+	}
+	return w.validateFreightProject(ctx, promo)
 }
 
 func (w *webhook) ValidateUpdate(
@@ -138,8 +191,10 @@ func (w *webhook) ValidateUpdate(
 		return err
 	}
 
+	oldPromo := oldObj.(*kargoapi.Promotion) // nolint: forcetypeassert
+
 	// PromotionSpecs are meant to be immutable
-	if *promo.Spec != *(oldObj.(*kargoapi.Promotion).Spec) { // nolint: forcetypeassert
+	if *promo.Spec != *oldPromo.Spec {
 		return apierrors.NewInvalid(
 			promotionGroupKind,
 			promo.Name,
@@ -152,6 +207,23 @@ func (w *webhook) ValidateUpdate(
 			},
 		)
 	}
+
+	// So is the record of who requested the Promotion
+	promoter := promo.Annotations[kargoapi.AnnotationKeyPromoter]
+	if promoter != oldPromo.Annotations[kargoapi.AnnotationKeyPromoter] {
+		return apierrors.NewInvalid(
+			promotionGroupKind,
+			promo.Name,
+			field.ErrorList{
+				field.Invalid(
+					field.NewPath("metadata", "annotations").
+						Key(kargoapi.AnnotationKeyPromoter),
+					promoter,
+					"promoter is immutable",
+				),
+			},
+		)
+	}
 	return nil
 }
 
@@ -163,6 +235,35 @@ func (w *webhook) ValidateDelete(
 	return w.authorizeFn(ctx, promo, "delete")
 }
 
+// validateFreightProject returns an error if the Freight referenced by the
+// provided Promotion belongs to another Project that does not share its
+// Freight with the Promotion's own Project.
+func (w *webhook) validateFreightProject(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) error {
+	freightProject := promo.FreightNamespace()
+	if freightProject == promo.Namespace {
+		return nil
+	}
+	shared, err := w.isFreightSharedWithFn(ctx, w.client, freightProject, promo.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if !shared {
+		return apierrors.NewForbidden(
+			promotionGroupResource,
+			promo.Name,
+			errors.Errorf(
+				"Project %q does not share its Freight with Project %q",
+				freightProject,
+				promo.Namespace,
+			),
+		)
+	}
+	return nil
+}
+
 func (w *webhook) authorize(
 	ctx context.Context,
 	promo *kargoapi.Promotion,
@@ -6,6 +6,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authnv1 "k8s.io/api/authentication/v1"
 	authzv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -19,19 +21,22 @@ import (
 
 func TestNewWebhook(t *testing.T) {
 	kubeClient := fake.NewClientBuilder().Build()
-	w := newWebhook(kubeClient)
+	w := newWebhook(kubeClient, WebhookConfig{})
 	// Assert that all overridable behaviors were initialized to a default:
 	require.NotNil(t, w.getStageFn)
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.authorizeFn)
+	require.NotNil(t, w.isFreightSharedWithFn)
 	require.NotNil(t, w.admissionRequestFromContextFn)
 	require.NotNil(t, w.createSubjectAccessReviewFn)
 }
 
 func TestDefault(t *testing.T) {
+	const apiServerUsername = "system:serviceaccount:kargo:kargo-api"
 	testCases := []struct {
 		name       string
 		webhook    *webhook
+		promoter   string
 		assertions func(*kargoapi.Promotion, error)
 	}{
 		{
@@ -66,6 +71,27 @@ func TestDefault(t *testing.T) {
 				require.Contains(t, err.Error(), "could not find Stage")
 			},
 		},
+		{
+			name: "error getting admission request",
+			webhook: &webhook{
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{}, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *kargoapi.Promotion, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -76,10 +102,162 @@ func TestDefault(t *testing.T) {
 				) (*kargoapi.Stage, error) {
 					return &kargoapi.Stage{}, nil
 				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Operation: admissionv1.Create,
+							UserInfo: authnv1.UserInfo{
+								Username: "fake-user",
+							},
+						},
+					}, nil
+				},
 			},
 			assertions: func(promo *kargoapi.Promotion, err error) {
 				require.NoError(t, err)
 				require.NotEmpty(t, promo.OwnerReferences)
+				require.Equal(
+					t,
+					"fake-user",
+					promo.Annotations[kargoapi.AnnotationKeyPromoter],
+				)
+			},
+		},
+		{
+			name: "promoter recorded by API server is kept",
+			webhook: &webhook{
+				cfg: WebhookConfig{
+					APIServerUsername: apiServerUsername,
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Operation: admissionv1.Create,
+							UserInfo: authnv1.UserInfo{
+								Username: apiServerUsername,
+							},
+						},
+					}, nil
+				},
+			},
+			promoter: "fake-user",
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"fake-user",
+					promo.Annotations[kargoapi.AnnotationKeyPromoter],
+				)
+			},
+		},
+		{
+			name: "API server without recorded promoter",
+			webhook: &webhook{
+				cfg: WebhookConfig{
+					APIServerUsername: apiServerUsername,
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Operation: admissionv1.Create,
+							UserInfo: authnv1.UserInfo{
+								Username: apiServerUsername,
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					apiServerUsername,
+					promo.Annotations[kargoapi.AnnotationKeyPromoter],
+				)
+			},
+		},
+		{
+			name: "promoter recorded by other user is overwritten",
+			webhook: &webhook{
+				cfg: WebhookConfig{
+					APIServerUsername: apiServerUsername,
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Operation: admissionv1.Create,
+							UserInfo: authnv1.UserInfo{
+								Username: "fake-user",
+							},
+						},
+					}, nil
+				},
+			},
+			promoter: "another-fake-user",
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"fake-user",
+					promo.Annotations[kargoapi.AnnotationKeyPromoter],
+				)
+			},
+		},
+		{
+			name: "update does not record promoter",
+			webhook: &webhook{
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Operation: admissionv1.Update,
+							UserInfo: authnv1.UserInfo{
+								Username: "fake-user",
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.NotContains(t, promo.Annotations, kargoapi.AnnotationKeyPromoter)
 			},
 		},
 	}
@@ -90,6 +268,11 @@ func TestDefault(t *testing.T) {
 					Stage: "fake-stage",
 				},
 			}
+			if testCase.promoter != "" {
+				promo.Annotations = map[string]string{
+					kargoapi.AnnotationKeyPromoter: testCase.promoter,
+				}
+			}
 			err := testCase.webhook.Default(context.Background(), promo)
 			testCase.assertions(promo, err)
 		})
@@ -97,9 +280,20 @@ func TestDefault(t *testing.T) {
 }
 
 func TestValidateCreate(t *testing.T) {
+	freightFromOtherProject := &kargoapi.Promotion{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: "fake-project",
+		},
+		Spec: &kargoapi.PromotionSpec{
+			Stage:          "fake-stage",
+			Freight:        "fake-freight",
+			FreightProject: "other-project",
+		},
+	}
 	testCases := []struct {
 		name       string
 		webhook    *webhook
+		promo      *kargoapi.Promotion
 		assertions func(error)
 	}{
 		{
@@ -158,14 +352,76 @@ func TestValidateCreate(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "Freight Project does not share its Freight",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				isFreightSharedWithFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+			},
+			promo: freightFromOtherProject,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					`Project "other-project" does not share its Freight with Project "fake-project"`,
+				)
+			},
+		},
+		{
+			name: "success with Freight from another Project",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				isFreightSharedWithFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+			},
+			promo: freightFromOtherProject,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			promo := testCase.promo
+			if promo == nil {
+				promo = &kargoapi.Promotion{}
+			}
 			testCase.assertions(
-				testCase.webhook.ValidateCreate(
-					context.Background(),
-					&kargoapi.Promotion{},
-				),
+				testCase.webhook.ValidateCreate(context.Background(), promo),
 			)
 		})
 	}
@@ -223,6 +479,36 @@ func TestValidateUpdate(t *testing.T) {
 			},
 		},
 
+		{
+			name: "attempt to mutate promoter",
+			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
+				oldPromo := &kargoapi.Promotion{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+						Annotations: map[string]string{
+							kargoapi.AnnotationKeyPromoter: "fake-user",
+						},
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+					},
+				}
+				newPromo := oldPromo.DeepCopy()
+				newPromo.Annotations[kargoapi.AnnotationKeyPromoter] = "another-fake-user"
+				return oldPromo, newPromo
+			},
+			authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "\"fake-name\" is invalid")
+				require.Contains(t, err.Error(), "promoter is immutable")
+			},
+		},
+
 		{
 			name: "update without mutation",
 			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// MinReconcileInterval is the shortest interval at which any Stage or
+// Warehouse may be reconciled. Shorter intervals would place undue load on the
+// controller and on the systems it polls.
+const MinReconcileInterval = 30 * time.Second
+
+// ValidateReconcileInterval returns an error if the provided reconcile
+// interval is specified, but is shorter than MinReconcileInterval.
+func ValidateReconcileInterval(
+	f *field.Path,
+	interval *metav1.Duration,
+) field.ErrorList {
+	if interval == nil || interval.Duration >= MinReconcileInterval {
+		return nil
+	}
+	return field.ErrorList{
+		field.Invalid(
+			f,
+			interval.Duration.String(),
+			fmt.Sprintf("must not be less than %s", MinReconcileInterval),
+		),
+	}
+}
@@ -0,0 +1,188 @@
+package release
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	authzv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+	libWebhook "github.com/akuity/kargo/internal/webhook"
+)
+
+var (
+	releaseGroupKind = schema.GroupKind{
+		Group: kargoapi.GroupVersion.Group,
+		Kind:  "Release",
+	}
+	releaseGroupResource = schema.GroupResource{
+		Group:    kargoapi.GroupVersion.Group,
+		Resource: "Release",
+	}
+)
+
+type webhook struct {
+	client client.Client
+
+	// The following behaviors are overridable for testing purposes:
+
+	validateProjectFn func(
+		context.Context,
+		client.Client,
+		schema.GroupKind,
+		client.Object,
+	) error
+
+	authorizeFn func(
+		ctx context.Context,
+		release *kargoapi.Release,
+		action string,
+	) error
+
+	admissionRequestFromContextFn func(context.Context) (admission.Request, error)
+
+	createSubjectAccessReviewFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+}
+
+func SetupWebhookWithManager(mgr ctrl.Manager) error {
+	w := newWebhook(mgr.GetClient())
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&kargoapi.Release{}).
+		WithValidator(w).
+		Complete()
+}
+
+func newWebhook(kubeClient client.Client) *webhook {
+	w := &webhook{
+		client: kubeClient,
+	}
+	w.validateProjectFn = libWebhook.ValidateProject
+	w.authorizeFn = w.authorize
+	w.admissionRequestFromContextFn = admission.RequestFromContext
+	w.createSubjectAccessReviewFn = w.client.Create
+	return w
+}
+
+func (w *webhook) ValidateCreate(
+	ctx context.Context,
+	obj runtime.Object,
+) error {
+	release := obj.(*kargoapi.Release) // nolint: forcetypeassert
+	if err :=
+		w.validateProjectFn(ctx, w.client, releaseGroupKind, release); err != nil {
+		return err
+	}
+	return w.authorizeFn(ctx, release, "create")
+}
+
+func (w *webhook) ValidateUpdate(
+	ctx context.Context,
+	oldObj runtime.Object,
+	newObj runtime.Object,
+) error {
+	release := newObj.(*kargoapi.Release) // nolint: forcetypeassert
+	if err := w.authorizeFn(ctx, release, "update"); err != nil {
+		return err
+	}
+
+	// ReleaseSpecs are meant to be immutable
+	if !reflect.DeepEqual(release.Spec, oldObj.(*kargoapi.Release).Spec) { // nolint: forcetypeassert
+		return apierrors.NewInvalid(
+			releaseGroupKind,
+			release.Name,
+			field.ErrorList{
+				field.Invalid(
+					field.NewPath("spec"),
+					release.Spec,
+					"spec is immutable",
+				),
+			},
+		)
+	}
+	return nil
+}
+
+func (w *webhook) ValidateDelete(context.Context, runtime.Object) error {
+	// No-op
+	return nil
+}
+
+// authorize ensures that the subject attempting to create or update a Release
+// is permitted to promote into every Stage the Release references, since the
+// Release controller will create Promotions on that subject's behalf.
+func (w *webhook) authorize(
+	ctx context.Context,
+	release *kargoapi.Release,
+	action string,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	req, err := w.admissionRequestFromContextFn(ctx)
+	if err != nil {
+		logger.Error(err)
+		return apierrors.NewForbidden(
+			releaseGroupResource,
+			release.Name,
+			errors.Errorf(
+				"error retrieving admission request from context; refusing to "+
+					"%s Release",
+				action,
+			),
+		)
+	}
+
+	for _, step := range release.Spec.Steps {
+		accessReview := &authzv1.SubjectAccessReview{
+			Spec: authzv1.SubjectAccessReviewSpec{
+				User:   req.UserInfo.Username,
+				Groups: req.UserInfo.Groups,
+				ResourceAttributes: &authzv1.ResourceAttributes{
+					Group:     kargoapi.GroupVersion.Group,
+					Resource:  "stages",
+					Name:      step.Stage,
+					Verb:      "promote",
+					Namespace: release.Namespace,
+				},
+			},
+		}
+		if err := w.createSubjectAccessReviewFn(ctx, accessReview); err != nil {
+			logger.Error(err)
+			return apierrors.NewForbidden(
+				releaseGroupResource,
+				release.Name,
+				errors.Errorf(
+					"error creating SubjectAccessReview; refusing to %s Release",
+					action,
+				),
+			)
+		}
+		if !accessReview.Status.Allowed {
+			return apierrors.NewForbidden(
+				releaseGroupResource,
+				release.Name,
+				errors.Errorf(
+					"subject %q is not permitted to %s Releases that promote into "+
+						"Stage %q",
+					req.UserInfo.Username,
+					action,
+					step.Stage,
+				),
+			)
+		}
+	}
+
+	return nil
+}
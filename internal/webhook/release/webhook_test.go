@@ -0,0 +1,316 @@
+package release
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	authzv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewWebhook(t *testing.T) {
+	kubeClient := fake.NewClientBuilder().Build()
+	w := newWebhook(kubeClient)
+	// Assert that all overridable behaviors were initialized to a default:
+	require.NotNil(t, w.validateProjectFn)
+	require.NotNil(t, w.authorizeFn)
+	require.NotNil(t, w.admissionRequestFromContextFn)
+	require.NotNil(t, w.createSubjectAccessReviewFn)
+}
+
+func TestValidateCreate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		assertions func(error)
+	}{
+		{
+			name: "error validating project",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "authorization error",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Release, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "success",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Release, string) error {
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.webhook.ValidateCreate(
+					context.Background(),
+					&kargoapi.Release{},
+				),
+			)
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func() (*kargoapi.Release, *kargoapi.Release)
+		authorizeFn func(
+			ctx context.Context,
+			release *kargoapi.Release,
+			action string,
+		) error
+		assertions func(error)
+	}{
+		{
+			name: "authorization error",
+			setup: func() (*kargoapi.Release, *kargoapi.Release) {
+				return &kargoapi.Release{}, &kargoapi.Release{}
+			},
+			authorizeFn: func(context.Context, *kargoapi.Release, string) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+
+		{
+			name: "attempt to mutate",
+			setup: func() (*kargoapi.Release, *kargoapi.Release) {
+				oldRelease := &kargoapi.Release{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+					},
+					Spec: &kargoapi.ReleaseSpec{
+						Freight: "fake-freight",
+						Steps: []kargoapi.ReleaseStep{
+							{
+								Stage: "fake-stage",
+							},
+						},
+					},
+				}
+				newRelease := oldRelease.DeepCopy()
+				newRelease.Spec.Steps[0].Stage = "another-fake-stage"
+				return oldRelease, newRelease
+			},
+			authorizeFn: func(context.Context, *kargoapi.Release, string) error {
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "\"fake-name\" is invalid")
+				require.Contains(t, err.Error(), "spec is immutable")
+			},
+		},
+
+		{
+			name: "update without mutation",
+			setup: func() (*kargoapi.Release, *kargoapi.Release) {
+				oldRelease := &kargoapi.Release{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+					},
+					Spec: &kargoapi.ReleaseSpec{
+						Freight: "fake-freight",
+						Steps: []kargoapi.ReleaseStep{
+							{
+								Stage: "fake-stage",
+							},
+						},
+					},
+				}
+				newRelease := oldRelease.DeepCopy()
+				return oldRelease, newRelease
+			},
+			authorizeFn: func(context.Context, *kargoapi.Release, string) error {
+				return nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				authorizeFn: testCase.authorizeFn,
+			}
+			oldRelease, newRelease := testCase.setup()
+			testCase.assertions(
+				w.ValidateUpdate(context.Background(), oldRelease, newRelease),
+			)
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	testCases := []struct {
+		name                          string
+		admissionRequestFromContextFn func(
+			context.Context,
+		) (admission.Request, error)
+		createSubjectAccessReviewFn func(
+			context.Context,
+			client.Object,
+			...client.CreateOption,
+		) error
+		assertions func(err error)
+	}{
+		{
+			name: "error getting admission request bound to context",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, errors.New("something went wrong")
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"error retrieving admission request from context; refusing to",
+				)
+			},
+		},
+		{
+			name: "error creating subject access review",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				context.Context,
+				client.Object,
+				...client.CreateOption,
+			) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error creating SubjectAccessReview")
+			},
+		},
+		{
+			name: "subject is not authorized",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				obj.(*authzv1.SubjectAccessReview).Status.Allowed = false // nolint: forcetypeassert
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is not permitted")
+			},
+		},
+		{
+			name: "subject is authorized",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				obj.(*authzv1.SubjectAccessReview).Status.Allowed = true // nolint: forcetypeassert
+				return nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				admissionRequestFromContextFn: testCase.admissionRequestFromContextFn,
+				createSubjectAccessReviewFn:   testCase.createSubjectAccessReviewFn,
+			}
+			testCase.assertions(
+				w.authorize(
+					context.Background(),
+					&kargoapi.Release{
+						ObjectMeta: v1.ObjectMeta{
+							Name:      "fake-release",
+							Namespace: "fake-namespace",
+						},
+						Spec: &kargoapi.ReleaseSpec{
+							Steps: []kargoapi.ReleaseStep{
+								{
+									Stage: "fake-stage",
+								},
+							},
+						},
+					},
+					"create",
+				),
+			)
+		})
+	}
+}
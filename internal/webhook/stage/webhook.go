@@ -3,6 +3,7 @@ package stage
 import (
 	"context"
 	"fmt"
+	"net/mail"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -98,12 +99,172 @@ func (w *webhook) validateSpec(
 		return nil
 	}
 	errs := w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
-	return append(
+	errs = append(
 		errs,
 		w.validatePromotionMechanisms(
 			f.Child("promotionMechanisms"),
 			spec.PromotionMechanisms)...,
 	)
+	errs = append(
+		errs,
+		w.validateQualification(
+			f.Child("qualification"),
+			spec.Qualification)...,
+	)
+	errs = append(
+		errs,
+		w.validateHealthChecks(
+			f.Child("healthChecks"),
+			spec.HealthChecks)...,
+	)
+	errs = append(
+		errs,
+		w.validateHealthDamping(
+			f.Child("healthDamping"),
+			spec.HealthDamping)...,
+	)
+	errs = append(
+		errs,
+		w.validateOwners(
+			f.Child("owners"),
+			spec.Owners)...,
+	)
+	return append(
+		errs,
+		libWebhook.ValidateReconcileInterval(
+			f.Child("reconcileInterval"),
+			spec.ReconcileInterval)...,
+	)
+}
+
+func (w *webhook) validateHealthChecks(
+	f *field.Path,
+	checks []kargoapi.HealthCheck,
+) field.ErrorList {
+	var errs field.ErrorList
+	// Provider health is tracked per provider, so each provider may only be
+	// referenced once
+	providers := make(map[string]struct{}, len(checks))
+	for i, check := range checks {
+		if _, ok := providers[check.Provider]; ok {
+			errs = append(
+				errs,
+				field.Duplicate(f.Index(i).Child("provider"), check.Provider),
+			)
+		}
+		providers[check.Provider] = struct{}{}
+	}
+	return errs
+}
+
+func (w *webhook) validateHealthDamping(
+	f *field.Path,
+	policy *kargoapi.HealthDampingPolicy,
+) field.ErrorList {
+	// A policy that specifies nothing would be satisfied immediately
+	if policy == nil || policy.ConsecutiveChecks > 0 || policy.For != nil {
+		return nil
+	}
+	return field.ErrorList{
+		field.Invalid(
+			f,
+			policy,
+			fmt.Sprintf(
+				"at least one of %s.consecutiveChecks or %s.for must be defined",
+				f.String(),
+				f.String(),
+			),
+		),
+	}
+}
+
+func (w *webhook) validateOwners(
+	f *field.Path,
+	owners []kargoapi.StageOwner,
+) field.ErrorList {
+	var errs field.ErrorList
+	// Owners are notified by name, so each may only be listed once
+	names := make(map[kargoapi.StageOwnerKind]map[string]struct{}, 2)
+	for i, owner := range owners {
+		if names[owner.Kind] == nil {
+			names[owner.Kind] = map[string]struct{}{}
+		}
+		if _, ok := names[owner.Kind][owner.Name]; ok {
+			errs = append(
+				errs,
+				field.Duplicate(f.Index(i).Child("name"), owner.Name),
+			)
+		}
+		names[owner.Kind][owner.Name] = struct{}{}
+		if owner.Email == "" {
+			continue
+		}
+		if addr, err := mail.ParseAddress(owner.Email); err != nil ||
+			addr.Address != owner.Email {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Index(i).Child("email"),
+					owner.Email,
+					"must be a valid email address",
+				),
+			)
+		}
+	}
+	return errs
+}
+
+func (w *webhook) validateQualification(
+	f *field.Path,
+	policy *kargoapi.QualificationPolicy,
+) field.ErrorList {
+	if policy == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	providers := map[string]struct{}{}
+	for i, criterion := range policy.Criteria {
+		criterionPath := f.Child("criteria").Index(i)
+		var conditions int
+		if criterion.Healthy != nil {
+			conditions++
+		}
+		if criterion.ManualApproval {
+			conditions++
+		}
+		if criterion.Verification != nil {
+			conditions++
+			// Verification results are tracked per provider, so each provider may
+			// only be referenced once
+			if _, ok := providers[criterion.Verification.Provider]; ok {
+				errs = append(
+					errs,
+					field.Duplicate(
+						criterionPath.Child("verification", "provider"),
+						criterion.Verification.Provider,
+					),
+				)
+			}
+			providers[criterion.Verification.Provider] = struct{}{}
+		}
+		if conditions != 1 {
+			errs = append(
+				errs,
+				field.Invalid(
+					criterionPath,
+					criterion,
+					fmt.Sprintf(
+						"exactly one of %s.healthy, %s.manualApproval, or "+
+							"%s.verification must be defined",
+						criterionPath.String(),
+						criterionPath.String(),
+						criterionPath.String(),
+					),
+				),
+			)
+		}
+	}
+	return errs
 }
 
 func (w *webhook) validateSubs(
@@ -200,7 +361,41 @@ func (w *webhook) validateGitRepoUpdate(
 			),
 		}
 	}
-	return w.validateHelmPromotionMechanism(f.Child("helm"), update.Helm)
+	errs := w.validateConflictResolution(f, update)
+	return append(
+		errs,
+		w.validateHelmPromotionMechanism(f.Child("helm"), update.Helm)...,
+	)
+}
+
+func (w *webhook) validateConflictResolution(
+	f *field.Path,
+	update kargoapi.GitRepoUpdate,
+) field.ErrorList {
+	res := update.ConflictResolution
+	if res == nil {
+		return nil
+	}
+	f = f.Child("conflictResolution")
+	// Kargo Render performs its own Git operations
+	if update.Render != nil {
+		return field.ErrorList{
+			field.Forbidden(f, "conflict resolution is not supported with Kargo Render"),
+		}
+	}
+	if res.Strategy == kargoapi.GitConflictStrategyOursOnPath &&
+		len(res.Paths) == 0 {
+		return field.ErrorList{
+			field.Required(
+				f.Child("paths"),
+				fmt.Sprintf(
+					"paths must be specified when strategy is %s",
+					kargoapi.GitConflictStrategyOursOnPath,
+				),
+			),
+		}
+	}
+	return nil
 }
 
 func (w *webhook) validateHelmPromotionMechanism(
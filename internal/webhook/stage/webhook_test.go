@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -243,6 +245,27 @@ func TestValidateSpec(t *testing.T) {
 			},
 		},
 
+		{
+			name: "reconcile interval too short",
+			spec: &kargoapi.StageSpec{
+				ReconcileInterval: &metav1.Duration{Duration: 10 * time.Second},
+			},
+			assertions: func(_ *kargoapi.StageSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.reconcileInterval",
+							BadValue: "10s",
+							Detail:   "must not be less than 30s",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
 		{
 			name: "valid",
 			spec: &kargoapi.StageSpec{
@@ -269,6 +292,319 @@ func TestValidateSpec(t *testing.T) {
 	}
 }
 
+func TestValidateQualification(t *testing.T) {
+	testCases := []struct {
+		name       string
+		policy     *kargoapi.QualificationPolicy
+		assertions func(*kargoapi.QualificationPolicy, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(_ *kargoapi.QualificationPolicy, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "criteria with no condition or multiple conditions",
+			policy: &kargoapi.QualificationPolicy{
+				Criteria: []kargoapi.QualificationCriterion{
+					{},
+					{
+						Healthy:        &kargoapi.HealthyCriterion{},
+						ManualApproval: true,
+					},
+				},
+			},
+			assertions: func(
+				policy *kargoapi.QualificationPolicy,
+				errs field.ErrorList,
+			) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "qualification.criteria[0]",
+							BadValue: policy.Criteria[0],
+							Detail: "exactly one of qualification.criteria[0].healthy, " +
+								"qualification.criteria[0].manualApproval, or " +
+								"qualification.criteria[0].verification must be defined",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "qualification.criteria[1]",
+							BadValue: policy.Criteria[1],
+							Detail: "exactly one of qualification.criteria[1].healthy, " +
+								"qualification.criteria[1].manualApproval, or " +
+								"qualification.criteria[1].verification must be defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "provider referenced by multiple criteria",
+			policy: &kargoapi.QualificationPolicy{
+				Criteria: []kargoapi.QualificationCriterion{
+					{
+						Verification: &kargoapi.VerificationCriterion{
+							Provider: "fake-provider",
+						},
+					},
+					{
+						Verification: &kargoapi.VerificationCriterion{
+							Provider: "fake-provider",
+						},
+					},
+				},
+			},
+			assertions: func(_ *kargoapi.QualificationPolicy, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeDuplicate,
+							Field:    "qualification.criteria[1].verification.provider",
+							BadValue: "fake-provider",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			policy: &kargoapi.QualificationPolicy{
+				Operator: kargoapi.QualificationOperatorAnyOf,
+				Criteria: []kargoapi.QualificationCriterion{
+					{
+						Healthy: &kargoapi.HealthyCriterion{},
+					},
+					{
+						ManualApproval: true,
+					},
+					{
+						Verification: &kargoapi.VerificationCriterion{
+							Provider: "fake-provider",
+						},
+					},
+				},
+			},
+			assertions: func(_ *kargoapi.QualificationPolicy, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.policy,
+				w.validateQualification(
+					field.NewPath("qualification"),
+					testCase.policy,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateHealthChecks(t *testing.T) {
+	testCases := []struct {
+		name       string
+		checks     []kargoapi.HealthCheck
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "provider referenced by multiple health checks",
+			checks: []kargoapi.HealthCheck{
+				{Provider: "fake-provider"},
+				{Provider: "fake-provider"},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeDuplicate,
+							Field:    "healthChecks[1].provider",
+							BadValue: "fake-provider",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			checks: []kargoapi.HealthCheck{
+				{Provider: "foo"},
+				{Provider: "bar"},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateHealthChecks(
+					field.NewPath("healthChecks"),
+					testCase.checks,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateHealthDamping(t *testing.T) {
+	testCases := []struct {
+		name       string
+		policy     *kargoapi.HealthDampingPolicy
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name:   "empty",
+			policy: &kargoapi.HealthDampingPolicy{},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "healthDamping", errs[0].Field)
+			},
+		},
+
+		{
+			name: "valid",
+			policy: &kargoapi.HealthDampingPolicy{
+				ConsecutiveChecks: 3,
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateHealthDamping(
+					field.NewPath("healthDamping"),
+					testCase.policy,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateOwners(t *testing.T) {
+	testCases := []struct {
+		name       string
+		owners     []kargoapi.StageOwner
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "owner listed more than once",
+			owners: []kargoapi.StageOwner{
+				{Kind: kargoapi.StageOwnerKindUser, Name: "alice"},
+				{Kind: kargoapi.StageOwnerKindUser, Name: "alice"},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeDuplicate,
+							Field:    "owners[1].name",
+							BadValue: "alice",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "invalid email",
+			owners: []kargoapi.StageOwner{
+				{
+					Kind:  kargoapi.StageOwnerKindTeam,
+					Name:  "platform-team",
+					Email: "Platform Team <platform@example.com>",
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "owners[0].email",
+							BadValue: "Platform Team <platform@example.com>",
+							Detail:   "must be a valid email address",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			owners: []kargoapi.StageOwner{
+				{Kind: kargoapi.StageOwnerKindUser, Name: "alice"},
+				// A user and a team may share a name
+				{
+					Kind:  kargoapi.StageOwnerKindTeam,
+					Name:  "alice",
+					Email: "alice-team@example.com",
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateOwners(
+					field.NewPath("owners"),
+					testCase.owners,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateSubs(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -529,6 +865,91 @@ func TestValidateGitRepoUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateConflictResolution(t *testing.T) {
+	testCases := []struct {
+		name       string
+		update     kargoapi.GitRepoUpdate
+		assertions func(field.ErrorList)
+	}{
+		{
+			name:   "nil",
+			update: kargoapi.GitRepoUpdate{},
+			assertions: func(errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "used with Kargo Render",
+			update: kargoapi.GitRepoUpdate{
+				Render: &kargoapi.KargoRenderPromotionMechanism{},
+				ConflictResolution: &kargoapi.GitConflictResolution{
+					Strategy: kargoapi.GitConflictStrategyRebaseAndRetry,
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeForbidden,
+							BadValue: "",
+							Field:    "gitRepoUpdate.conflictResolution",
+							Detail:   "conflict resolution is not supported with Kargo Render",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "OursOnPath without paths",
+			update: kargoapi.GitRepoUpdate{
+				ConflictResolution: &kargoapi.GitConflictResolution{
+					Strategy: kargoapi.GitConflictStrategyOursOnPath,
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							BadValue: "",
+							Field:    "gitRepoUpdate.conflictResolution.paths",
+							Detail:   "paths must be specified when strategy is OursOnPath",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize: &kargoapi.KustomizePromotionMechanism{},
+				ConflictResolution: &kargoapi.GitConflictResolution{
+					Strategy: kargoapi.GitConflictStrategyOursOnPath,
+					Paths:    []string{"env"},
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateConflictResolution(
+					field.NewPath("gitRepoUpdate"),
+					testCase.update,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateHelmPromotionMechanism(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -102,7 +102,14 @@ func (w *webhook) validateSpec(
 	if spec == nil { // nil spec is caught by declarative validations
 		return nil
 	}
-	return w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	errs := w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	return append(
+		errs,
+		libWebhook.ValidateReconcileInterval(
+			f.Child("reconcileInterval"),
+			spec.ReconcileInterval,
+		)...,
+	)
 }
 
 func (w *webhook) validateSubs(
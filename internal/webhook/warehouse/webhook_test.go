@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -251,6 +253,26 @@ func TestValidateSpec(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "reconcile interval too short",
+			spec: &kargoapi.WarehouseSpec{
+				ReconcileInterval: &metav1.Duration{Duration: 10 * time.Second},
+			},
+			assertions: func(_ *kargoapi.WarehouseSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.reconcileInterval",
+							BadValue: "10s",
+							Detail:   "must not be less than 30s",
+						},
+					},
+					errs,
+				)
+			},
+		},
 		{
 			name: "valid",
 			spec: &kargoapi.WarehouseSpec{
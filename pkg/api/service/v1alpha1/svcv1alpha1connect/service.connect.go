@@ -43,6 +43,18 @@ const (
 	KargoServiceGetPublicConfigProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetPublicConfig"
 	// KargoServiceAdminLoginProcedure is the fully-qualified name of the KargoService's AdminLogin RPC.
 	KargoServiceAdminLoginProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/AdminLogin"
+	// KargoServiceKubernetesLoginProcedure is the fully-qualified name of the KargoService's
+	// KubernetesLogin RPC.
+	KargoServiceKubernetesLoginProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/KubernetesLogin"
+	// KargoServiceCreateViewerTokenProcedure is the fully-qualified name of the KargoService's
+	// CreateViewerToken RPC.
+	KargoServiceCreateViewerTokenProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateViewerToken"
+	// KargoServiceRevokeTokenProcedure is the fully-qualified name of the KargoService's RevokeToken
+	// RPC.
+	KargoServiceRevokeTokenProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RevokeToken"
+	// KargoServiceGetControllerStatsProcedure is the fully-qualified name of the KargoService's
+	// GetControllerStats RPC.
+	KargoServiceGetControllerStatsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetControllerStats"
 	// KargoServiceCreateResourceProcedure is the fully-qualified name of the KargoService's
 	// CreateResource RPC.
 	KargoServiceCreateResourceProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateResource"
@@ -55,6 +67,9 @@ const (
 	// KargoServiceDeleteResourceProcedure is the fully-qualified name of the KargoService's
 	// DeleteResource RPC.
 	KargoServiceDeleteResourceProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteResource"
+	// KargoServiceUpdateMetadataProcedure is the fully-qualified name of the KargoService's
+	// UpdateMetadata RPC.
+	KargoServiceUpdateMetadataProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/UpdateMetadata"
 	// KargoServiceCreateStageProcedure is the fully-qualified name of the KargoService's CreateStage
 	// RPC.
 	KargoServiceCreateStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateStage"
@@ -71,9 +86,15 @@ const (
 	// KargoServiceDeleteStageProcedure is the fully-qualified name of the KargoService's DeleteStage
 	// RPC.
 	KargoServiceDeleteStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteStage"
+	// KargoServiceRenameStageProcedure is the fully-qualified name of the KargoService's RenameStage
+	// RPC.
+	KargoServiceRenameStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RenameStage"
 	// KargoServicePromoteStageProcedure is the fully-qualified name of the KargoService's PromoteStage
 	// RPC.
 	KargoServicePromoteStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PromoteStage"
+	// KargoServiceExplainPromotionEligibilityProcedure is the fully-qualified name of the
+	// KargoService's ExplainPromotionEligibility RPC.
+	KargoServiceExplainPromotionEligibilityProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ExplainPromotionEligibility"
 	// KargoServicePromoteSubscribersProcedure is the fully-qualified name of the KargoService's
 	// PromoteSubscribers RPC.
 	KargoServicePromoteSubscribersProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PromoteSubscribers"
@@ -119,9 +140,30 @@ const (
 	// KargoServiceDeleteProjectProcedure is the fully-qualified name of the KargoService's
 	// DeleteProject RPC.
 	KargoServiceDeleteProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteProject"
+	// KargoServiceArchiveProjectProcedure is the fully-qualified name of the KargoService's
+	// ArchiveProject RPC.
+	KargoServiceArchiveProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ArchiveProject"
+	// KargoServiceUnarchiveProjectProcedure is the fully-qualified name of the KargoService's
+	// UnarchiveProject RPC.
+	KargoServiceUnarchiveProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/UnarchiveProject"
+	// KargoServiceGetBackstageEntityStatusProcedure is the fully-qualified name of the KargoService's
+	// GetBackstageEntityStatus RPC.
+	KargoServiceGetBackstageEntityStatusProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetBackstageEntityStatus"
 	// KargoServiceQueryFreightProcedure is the fully-qualified name of the KargoService's QueryFreight
 	// RPC.
 	KargoServiceQueryFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/QueryFreight"
+	// KargoServiceApproveFreightProcedure is the fully-qualified name of the KargoService's
+	// ApproveFreight RPC.
+	KargoServiceApproveFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ApproveFreight"
+	// KargoServiceAttachBuildInfoProcedure is the fully-qualified name of the KargoService's
+	// AttachBuildInfo RPC.
+	KargoServiceAttachBuildInfoProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/AttachBuildInfo"
+	// KargoServiceDiffFreightProcedure is the fully-qualified name of the KargoService's DiffFreight
+	// RPC.
+	KargoServiceDiffFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DiffFreight"
+	// KargoServiceExplainDiscoveryProcedure is the fully-qualified name of the KargoService's
+	// ExplainDiscovery RPC.
+	KargoServiceExplainDiscoveryProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ExplainDiscovery"
 	// KargoServiceListWarehousesProcedure is the fully-qualified name of the KargoService's
 	// ListWarehouses RPC.
 	KargoServiceListWarehousesProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListWarehouses"
@@ -143,6 +185,29 @@ const (
 	// KargoServiceRefreshWarehouseProcedure is the fully-qualified name of the KargoService's
 	// RefreshWarehouse RPC.
 	KargoServiceRefreshWarehouseProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RefreshWarehouse"
+	// KargoServiceGenerateWarehouseFromArgoCDAppProcedure is the fully-qualified name of the
+	// KargoService's GenerateWarehouseFromArgoCDApp RPC.
+	KargoServiceGenerateWarehouseFromArgoCDAppProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GenerateWarehouseFromArgoCDApp"
+	// KargoServiceStartOperationProcedure is the fully-qualified name of the KargoService's
+	// StartOperation RPC.
+	KargoServiceStartOperationProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/StartOperation"
+	// KargoServiceGetOperationProcedure is the fully-qualified name of the KargoService's GetOperation
+	// RPC.
+	KargoServiceGetOperationProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetOperation"
+	// KargoServiceListOperationsProcedure is the fully-qualified name of the KargoService's
+	// ListOperations RPC.
+	KargoServiceListOperationsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListOperations"
+	// KargoServiceCancelOperationProcedure is the fully-qualified name of the KargoService's
+	// CancelOperation RPC.
+	KargoServiceCancelOperationProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CancelOperation"
+	// KargoServiceTestCredentialsProcedure is the fully-qualified name of the KargoService's
+	// TestCredentials RPC.
+	KargoServiceTestCredentialsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/TestCredentials"
+	// KargoServiceTestClusterConnectionProcedure is the fully-qualified name of the KargoService's
+	// TestClusterConnection RPC.
+	KargoServiceTestClusterConnectionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/TestClusterConnection"
+	// KargoServiceListEventsProcedure is the fully-qualified name of the KargoService's ListEvents RPC.
+	KargoServiceListEventsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListEvents"
 )
 
 // KargoServiceClient is a client for the akuity.io.kargo.service.v1alpha1.KargoService service.
@@ -151,20 +216,27 @@ type KargoServiceClient interface {
 	GetConfig(context.Context, *connect.Request[v1alpha1.GetConfigRequest]) (*connect.Response[v1alpha1.GetConfigResponse], error)
 	GetPublicConfig(context.Context, *connect.Request[v1alpha1.GetPublicConfigRequest]) (*connect.Response[v1alpha1.GetPublicConfigResponse], error)
 	AdminLogin(context.Context, *connect.Request[v1alpha1.AdminLoginRequest]) (*connect.Response[v1alpha1.AdminLoginResponse], error)
+	KubernetesLogin(context.Context, *connect.Request[v1alpha1.KubernetesLoginRequest]) (*connect.Response[v1alpha1.KubernetesLoginResponse], error)
+	CreateViewerToken(context.Context, *connect.Request[v1alpha1.CreateViewerTokenRequest]) (*connect.Response[v1alpha1.CreateViewerTokenResponse], error)
+	RevokeToken(context.Context, *connect.Request[v1alpha1.RevokeTokenRequest]) (*connect.Response[v1alpha1.RevokeTokenResponse], error)
+	GetControllerStats(context.Context, *connect.Request[v1alpha1.GetControllerStatsRequest]) (*connect.Response[v1alpha1.GetControllerStatsResponse], error)
 	// TODO(devholic): Add ApplyResource API
 	// rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
 	CreateResource(context.Context, *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error)
 	CreateOrUpdateResource(context.Context, *connect.Request[v1alpha1.CreateOrUpdateResourceRequest]) (*connect.Response[v1alpha1.CreateOrUpdateResourceResponse], error)
 	UpdateResource(context.Context, *connect.Request[v1alpha1.UpdateResourceRequest]) (*connect.Response[v1alpha1.UpdateResourceResponse], error)
 	DeleteResource(context.Context, *connect.Request[v1alpha1.DeleteResourceRequest]) (*connect.Response[v1alpha1.DeleteResourceResponse], error)
+	UpdateMetadata(context.Context, *connect.Request[v1alpha1.UpdateMetadataRequest]) (*connect.Response[v1alpha1.UpdateMetadataResponse], error)
 	CreateStage(context.Context, *connect.Request[v1alpha1.CreateStageRequest]) (*connect.Response[v1alpha1.CreateStageResponse], error)
 	ListStages(context.Context, *connect.Request[v1alpha1.ListStagesRequest]) (*connect.Response[v1alpha1.ListStagesResponse], error)
 	GetStage(context.Context, *connect.Request[v1alpha1.GetStageRequest]) (*connect.Response[v1alpha1.GetStageResponse], error)
 	WatchStages(context.Context, *connect.Request[v1alpha1.WatchStagesRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchStagesResponse], error)
 	UpdateStage(context.Context, *connect.Request[v1alpha1.UpdateStageRequest]) (*connect.Response[v1alpha1.UpdateStageResponse], error)
 	DeleteStage(context.Context, *connect.Request[v1alpha1.DeleteStageRequest]) (*connect.Response[v1alpha1.DeleteStageResponse], error)
+	RenameStage(context.Context, *connect.Request[v1alpha1.RenameStageRequest]) (*connect.Response[v1alpha1.RenameStageResponse], error)
 	PromoteStage(context.Context, *connect.Request[v1alpha1.PromoteStageRequest]) (*connect.Response[v1alpha1.PromoteStageResponse], error)
-	PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error)
+	ExplainPromotionEligibility(context.Context, *connect.Request[v1alpha1.ExplainPromotionEligibilityRequest]) (*connect.Response[v1alpha1.ExplainPromotionEligibilityResponse], error)
+	PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.ServerStreamForClient[v1alpha1.PromoteSubscribersResponse], error)
 	RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error)
 	// Promotion APIs
 	ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error)
@@ -180,7 +252,14 @@ type KargoServiceClient interface {
 	CreateProject(context.Context, *connect.Request[v1alpha1.CreateProjectRequest]) (*connect.Response[v1alpha1.CreateProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
+	ArchiveProject(context.Context, *connect.Request[v1alpha1.ArchiveProjectRequest]) (*connect.Response[v1alpha1.ArchiveProjectResponse], error)
+	UnarchiveProject(context.Context, *connect.Request[v1alpha1.UnarchiveProjectRequest]) (*connect.Response[v1alpha1.UnarchiveProjectResponse], error)
+	GetBackstageEntityStatus(context.Context, *connect.Request[v1alpha1.GetBackstageEntityStatusRequest]) (*connect.Response[v1alpha1.GetBackstageEntityStatusResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
+	ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error)
+	AttachBuildInfo(context.Context, *connect.Request[v1alpha1.AttachBuildInfoRequest]) (*connect.Response[v1alpha1.AttachBuildInfoResponse], error)
+	DiffFreight(context.Context, *connect.Request[v1alpha1.DiffFreightRequest]) (*connect.Response[v1alpha1.DiffFreightResponse], error)
+	ExplainDiscovery(context.Context, *connect.Request[v1alpha1.ExplainDiscoveryRequest]) (*connect.Response[v1alpha1.ExplainDiscoveryResponse], error)
 	ListWarehouses(context.Context, *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error)
 	GetWarehouse(context.Context, *connect.Request[v1alpha1.GetWarehouseRequest]) (*connect.Response[v1alpha1.GetWarehouseResponse], error)
 	WatchWarehouses(context.Context, *connect.Request[v1alpha1.WatchWarehousesRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchWarehousesResponse], error)
@@ -188,6 +267,14 @@ type KargoServiceClient interface {
 	UpdateWarehouse(context.Context, *connect.Request[v1alpha1.UpdateWarehouseRequest]) (*connect.Response[v1alpha1.UpdateWarehouseResponse], error)
 	DeleteWarehouse(context.Context, *connect.Request[v1alpha1.DeleteWarehouseRequest]) (*connect.Response[v1alpha1.DeleteWarehouseResponse], error)
 	RefreshWarehouse(context.Context, *connect.Request[v1alpha1.RefreshWarehouseRequest]) (*connect.Response[v1alpha1.RefreshWarehouseResponse], error)
+	GenerateWarehouseFromArgoCDApp(context.Context, *connect.Request[v1alpha1.GenerateWarehouseFromArgoCDAppRequest]) (*connect.Response[v1alpha1.GenerateWarehouseFromArgoCDAppResponse], error)
+	StartOperation(context.Context, *connect.Request[v1alpha1.StartOperationRequest]) (*connect.Response[v1alpha1.StartOperationResponse], error)
+	GetOperation(context.Context, *connect.Request[v1alpha1.GetOperationRequest]) (*connect.Response[v1alpha1.GetOperationResponse], error)
+	ListOperations(context.Context, *connect.Request[v1alpha1.ListOperationsRequest]) (*connect.Response[v1alpha1.ListOperationsResponse], error)
+	CancelOperation(context.Context, *connect.Request[v1alpha1.CancelOperationRequest]) (*connect.Response[v1alpha1.CancelOperationResponse], error)
+	TestCredentials(context.Context, *connect.Request[v1alpha1.TestCredentialsRequest]) (*connect.Response[v1alpha1.TestCredentialsResponse], error)
+	TestClusterConnection(context.Context, *connect.Request[v1alpha1.TestClusterConnectionRequest]) (*connect.Response[v1alpha1.TestClusterConnectionResponse], error)
+	ListEvents(context.Context, *connect.Request[v1alpha1.ListEventsRequest]) (*connect.Response[v1alpha1.ListEventsResponse], error)
 }
 
 // NewKargoServiceClient constructs a client for the akuity.io.kargo.service.v1alpha1.KargoService
@@ -220,6 +307,26 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceAdminLoginProcedure,
 			opts...,
 		),
+		kubernetesLogin: connect.NewClient[v1alpha1.KubernetesLoginRequest, v1alpha1.KubernetesLoginResponse](
+			httpClient,
+			baseURL+KargoServiceKubernetesLoginProcedure,
+			opts...,
+		),
+		createViewerToken: connect.NewClient[v1alpha1.CreateViewerTokenRequest, v1alpha1.CreateViewerTokenResponse](
+			httpClient,
+			baseURL+KargoServiceCreateViewerTokenProcedure,
+			opts...,
+		),
+		revokeToken: connect.NewClient[v1alpha1.RevokeTokenRequest, v1alpha1.RevokeTokenResponse](
+			httpClient,
+			baseURL+KargoServiceRevokeTokenProcedure,
+			opts...,
+		),
+		getControllerStats: connect.NewClient[v1alpha1.GetControllerStatsRequest, v1alpha1.GetControllerStatsResponse](
+			httpClient,
+			baseURL+KargoServiceGetControllerStatsProcedure,
+			opts...,
+		),
 		createResource: connect.NewClient[v1alpha1.CreateResourceRequest, v1alpha1.CreateResourceResponse](
 			httpClient,
 			baseURL+KargoServiceCreateResourceProcedure,
@@ -240,6 +347,11 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceDeleteResourceProcedure,
 			opts...,
 		),
+		updateMetadata: connect.NewClient[v1alpha1.UpdateMetadataRequest, v1alpha1.UpdateMetadataResponse](
+			httpClient,
+			baseURL+KargoServiceUpdateMetadataProcedure,
+			opts...,
+		),
 		createStage: connect.NewClient[v1alpha1.CreateStageRequest, v1alpha1.CreateStageResponse](
 			httpClient,
 			baseURL+KargoServiceCreateStageProcedure,
@@ -270,11 +382,21 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceDeleteStageProcedure,
 			opts...,
 		),
+		renameStage: connect.NewClient[v1alpha1.RenameStageRequest, v1alpha1.RenameStageResponse](
+			httpClient,
+			baseURL+KargoServiceRenameStageProcedure,
+			opts...,
+		),
 		promoteStage: connect.NewClient[v1alpha1.PromoteStageRequest, v1alpha1.PromoteStageResponse](
 			httpClient,
 			baseURL+KargoServicePromoteStageProcedure,
 			opts...,
 		),
+		explainPromotionEligibility: connect.NewClient[v1alpha1.ExplainPromotionEligibilityRequest, v1alpha1.ExplainPromotionEligibilityResponse](
+			httpClient,
+			baseURL+KargoServiceExplainPromotionEligibilityProcedure,
+			opts...,
+		),
 		promoteSubscribers: connect.NewClient[v1alpha1.PromoteSubscribersRequest, v1alpha1.PromoteSubscribersResponse](
 			httpClient,
 			baseURL+KargoServicePromoteSubscribersProcedure,
@@ -350,11 +472,46 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceDeleteProjectProcedure,
 			opts...,
 		),
+		archiveProject: connect.NewClient[v1alpha1.ArchiveProjectRequest, v1alpha1.ArchiveProjectResponse](
+			httpClient,
+			baseURL+KargoServiceArchiveProjectProcedure,
+			opts...,
+		),
+		unarchiveProject: connect.NewClient[v1alpha1.UnarchiveProjectRequest, v1alpha1.UnarchiveProjectResponse](
+			httpClient,
+			baseURL+KargoServiceUnarchiveProjectProcedure,
+			opts...,
+		),
+		getBackstageEntityStatus: connect.NewClient[v1alpha1.GetBackstageEntityStatusRequest, v1alpha1.GetBackstageEntityStatusResponse](
+			httpClient,
+			baseURL+KargoServiceGetBackstageEntityStatusProcedure,
+			opts...,
+		),
 		queryFreight: connect.NewClient[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse](
 			httpClient,
 			baseURL+KargoServiceQueryFreightProcedure,
 			opts...,
 		),
+		approveFreight: connect.NewClient[v1alpha1.ApproveFreightRequest, v1alpha1.ApproveFreightResponse](
+			httpClient,
+			baseURL+KargoServiceApproveFreightProcedure,
+			opts...,
+		),
+		attachBuildInfo: connect.NewClient[v1alpha1.AttachBuildInfoRequest, v1alpha1.AttachBuildInfoResponse](
+			httpClient,
+			baseURL+KargoServiceAttachBuildInfoProcedure,
+			opts...,
+		),
+		diffFreight: connect.NewClient[v1alpha1.DiffFreightRequest, v1alpha1.DiffFreightResponse](
+			httpClient,
+			baseURL+KargoServiceDiffFreightProcedure,
+			opts...,
+		),
+		explainDiscovery: connect.NewClient[v1alpha1.ExplainDiscoveryRequest, v1alpha1.ExplainDiscoveryResponse](
+			httpClient,
+			baseURL+KargoServiceExplainDiscoveryProcedure,
+			opts...,
+		),
 		listWarehouses: connect.NewClient[v1alpha1.ListWarehousesRequest, v1alpha1.ListWarehousesResponse](
 			httpClient,
 			baseURL+KargoServiceListWarehousesProcedure,
@@ -390,49 +547,111 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceRefreshWarehouseProcedure,
 			opts...,
 		),
+		generateWarehouseFromArgoCDApp: connect.NewClient[v1alpha1.GenerateWarehouseFromArgoCDAppRequest, v1alpha1.GenerateWarehouseFromArgoCDAppResponse](
+			httpClient,
+			baseURL+KargoServiceGenerateWarehouseFromArgoCDAppProcedure,
+			opts...,
+		),
+		startOperation: connect.NewClient[v1alpha1.StartOperationRequest, v1alpha1.StartOperationResponse](
+			httpClient,
+			baseURL+KargoServiceStartOperationProcedure,
+			opts...,
+		),
+		getOperation: connect.NewClient[v1alpha1.GetOperationRequest, v1alpha1.GetOperationResponse](
+			httpClient,
+			baseURL+KargoServiceGetOperationProcedure,
+			opts...,
+		),
+		listOperations: connect.NewClient[v1alpha1.ListOperationsRequest, v1alpha1.ListOperationsResponse](
+			httpClient,
+			baseURL+KargoServiceListOperationsProcedure,
+			opts...,
+		),
+		cancelOperation: connect.NewClient[v1alpha1.CancelOperationRequest, v1alpha1.CancelOperationResponse](
+			httpClient,
+			baseURL+KargoServiceCancelOperationProcedure,
+			opts...,
+		),
+		testCredentials: connect.NewClient[v1alpha1.TestCredentialsRequest, v1alpha1.TestCredentialsResponse](
+			httpClient,
+			baseURL+KargoServiceTestCredentialsProcedure,
+			opts...,
+		),
+		testClusterConnection: connect.NewClient[v1alpha1.TestClusterConnectionRequest, v1alpha1.TestClusterConnectionResponse](
+			httpClient,
+			baseURL+KargoServiceTestClusterConnectionProcedure,
+			opts...,
+		),
+		listEvents: connect.NewClient[v1alpha1.ListEventsRequest, v1alpha1.ListEventsResponse](
+			httpClient,
+			baseURL+KargoServiceListEventsProcedure,
+			opts...,
+		),
 	}
 }
 
 // kargoServiceClient implements KargoServiceClient.
 type kargoServiceClient struct {
-	getVersionInfo           *connect.Client[v1alpha1.GetVersionInfoRequest, v1alpha1.GetVersionInfoResponse]
-	getConfig                *connect.Client[v1alpha1.GetConfigRequest, v1alpha1.GetConfigResponse]
-	getPublicConfig          *connect.Client[v1alpha1.GetPublicConfigRequest, v1alpha1.GetPublicConfigResponse]
-	adminLogin               *connect.Client[v1alpha1.AdminLoginRequest, v1alpha1.AdminLoginResponse]
-	createResource           *connect.Client[v1alpha1.CreateResourceRequest, v1alpha1.CreateResourceResponse]
-	createOrUpdateResource   *connect.Client[v1alpha1.CreateOrUpdateResourceRequest, v1alpha1.CreateOrUpdateResourceResponse]
-	updateResource           *connect.Client[v1alpha1.UpdateResourceRequest, v1alpha1.UpdateResourceResponse]
-	deleteResource           *connect.Client[v1alpha1.DeleteResourceRequest, v1alpha1.DeleteResourceResponse]
-	createStage              *connect.Client[v1alpha1.CreateStageRequest, v1alpha1.CreateStageResponse]
-	listStages               *connect.Client[v1alpha1.ListStagesRequest, v1alpha1.ListStagesResponse]
-	getStage                 *connect.Client[v1alpha1.GetStageRequest, v1alpha1.GetStageResponse]
-	watchStages              *connect.Client[v1alpha1.WatchStagesRequest, v1alpha1.WatchStagesResponse]
-	updateStage              *connect.Client[v1alpha1.UpdateStageRequest, v1alpha1.UpdateStageResponse]
-	deleteStage              *connect.Client[v1alpha1.DeleteStageRequest, v1alpha1.DeleteStageResponse]
-	promoteStage             *connect.Client[v1alpha1.PromoteStageRequest, v1alpha1.PromoteStageResponse]
-	promoteSubscribers       *connect.Client[v1alpha1.PromoteSubscribersRequest, v1alpha1.PromoteSubscribersResponse]
-	refreshStage             *connect.Client[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse]
-	listPromotions           *connect.Client[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse]
-	watchPromotions          *connect.Client[v1alpha1.WatchPromotionsRequest, v1alpha1.WatchPromotionsResponse]
-	getPromotion             *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
-	watchPromotion           *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
-	setAutoPromotionForStage *connect.Client[v1alpha1.SetAutoPromotionForStageRequest, v1alpha1.SetAutoPromotionForStageResponse]
-	createPromotionPolicy    *connect.Client[v1alpha1.CreatePromotionPolicyRequest, v1alpha1.CreatePromotionPolicyResponse]
-	listPromotionPolicies    *connect.Client[v1alpha1.ListPromotionPoliciesRequest, v1alpha1.ListPromotionPoliciesResponse]
-	getPromotionPolicy       *connect.Client[v1alpha1.GetPromotionPolicyRequest, v1alpha1.GetPromotionPolicyResponse]
-	updatePromotionPolicy    *connect.Client[v1alpha1.UpdatePromotionPolicyRequest, v1alpha1.UpdatePromotionPolicyResponse]
-	deletePromotionPolicy    *connect.Client[v1alpha1.DeletePromotionPolicyRequest, v1alpha1.DeletePromotionPolicyResponse]
-	createProject            *connect.Client[v1alpha1.CreateProjectRequest, v1alpha1.CreateProjectResponse]
-	listProjects             *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
-	deleteProject            *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
-	queryFreight             *connect.Client[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse]
-	listWarehouses           *connect.Client[v1alpha1.ListWarehousesRequest, v1alpha1.ListWarehousesResponse]
-	getWarehouse             *connect.Client[v1alpha1.GetWarehouseRequest, v1alpha1.GetWarehouseResponse]
-	watchWarehouses          *connect.Client[v1alpha1.WatchWarehousesRequest, v1alpha1.WatchWarehousesResponse]
-	createWarehouse          *connect.Client[v1alpha1.CreateWarehouseRequest, v1alpha1.CreateWarehouseResponse]
-	updateWarehouse          *connect.Client[v1alpha1.UpdateWarehouseRequest, v1alpha1.UpdateWarehouseResponse]
-	deleteWarehouse          *connect.Client[v1alpha1.DeleteWarehouseRequest, v1alpha1.DeleteWarehouseResponse]
-	refreshWarehouse         *connect.Client[v1alpha1.RefreshWarehouseRequest, v1alpha1.RefreshWarehouseResponse]
+	getVersionInfo                 *connect.Client[v1alpha1.GetVersionInfoRequest, v1alpha1.GetVersionInfoResponse]
+	getConfig                      *connect.Client[v1alpha1.GetConfigRequest, v1alpha1.GetConfigResponse]
+	getPublicConfig                *connect.Client[v1alpha1.GetPublicConfigRequest, v1alpha1.GetPublicConfigResponse]
+	adminLogin                     *connect.Client[v1alpha1.AdminLoginRequest, v1alpha1.AdminLoginResponse]
+	kubernetesLogin                *connect.Client[v1alpha1.KubernetesLoginRequest, v1alpha1.KubernetesLoginResponse]
+	createViewerToken              *connect.Client[v1alpha1.CreateViewerTokenRequest, v1alpha1.CreateViewerTokenResponse]
+	revokeToken                    *connect.Client[v1alpha1.RevokeTokenRequest, v1alpha1.RevokeTokenResponse]
+	getControllerStats             *connect.Client[v1alpha1.GetControllerStatsRequest, v1alpha1.GetControllerStatsResponse]
+	createResource                 *connect.Client[v1alpha1.CreateResourceRequest, v1alpha1.CreateResourceResponse]
+	createOrUpdateResource         *connect.Client[v1alpha1.CreateOrUpdateResourceRequest, v1alpha1.CreateOrUpdateResourceResponse]
+	updateResource                 *connect.Client[v1alpha1.UpdateResourceRequest, v1alpha1.UpdateResourceResponse]
+	deleteResource                 *connect.Client[v1alpha1.DeleteResourceRequest, v1alpha1.DeleteResourceResponse]
+	updateMetadata                 *connect.Client[v1alpha1.UpdateMetadataRequest, v1alpha1.UpdateMetadataResponse]
+	createStage                    *connect.Client[v1alpha1.CreateStageRequest, v1alpha1.CreateStageResponse]
+	listStages                     *connect.Client[v1alpha1.ListStagesRequest, v1alpha1.ListStagesResponse]
+	getStage                       *connect.Client[v1alpha1.GetStageRequest, v1alpha1.GetStageResponse]
+	watchStages                    *connect.Client[v1alpha1.WatchStagesRequest, v1alpha1.WatchStagesResponse]
+	updateStage                    *connect.Client[v1alpha1.UpdateStageRequest, v1alpha1.UpdateStageResponse]
+	deleteStage                    *connect.Client[v1alpha1.DeleteStageRequest, v1alpha1.DeleteStageResponse]
+	renameStage                    *connect.Client[v1alpha1.RenameStageRequest, v1alpha1.RenameStageResponse]
+	promoteStage                   *connect.Client[v1alpha1.PromoteStageRequest, v1alpha1.PromoteStageResponse]
+	explainPromotionEligibility    *connect.Client[v1alpha1.ExplainPromotionEligibilityRequest, v1alpha1.ExplainPromotionEligibilityResponse]
+	promoteSubscribers             *connect.Client[v1alpha1.PromoteSubscribersRequest, v1alpha1.PromoteSubscribersResponse]
+	refreshStage                   *connect.Client[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse]
+	listPromotions                 *connect.Client[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse]
+	watchPromotions                *connect.Client[v1alpha1.WatchPromotionsRequest, v1alpha1.WatchPromotionsResponse]
+	getPromotion                   *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
+	watchPromotion                 *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
+	setAutoPromotionForStage       *connect.Client[v1alpha1.SetAutoPromotionForStageRequest, v1alpha1.SetAutoPromotionForStageResponse]
+	createPromotionPolicy          *connect.Client[v1alpha1.CreatePromotionPolicyRequest, v1alpha1.CreatePromotionPolicyResponse]
+	listPromotionPolicies          *connect.Client[v1alpha1.ListPromotionPoliciesRequest, v1alpha1.ListPromotionPoliciesResponse]
+	getPromotionPolicy             *connect.Client[v1alpha1.GetPromotionPolicyRequest, v1alpha1.GetPromotionPolicyResponse]
+	updatePromotionPolicy          *connect.Client[v1alpha1.UpdatePromotionPolicyRequest, v1alpha1.UpdatePromotionPolicyResponse]
+	deletePromotionPolicy          *connect.Client[v1alpha1.DeletePromotionPolicyRequest, v1alpha1.DeletePromotionPolicyResponse]
+	createProject                  *connect.Client[v1alpha1.CreateProjectRequest, v1alpha1.CreateProjectResponse]
+	listProjects                   *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
+	deleteProject                  *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
+	archiveProject                 *connect.Client[v1alpha1.ArchiveProjectRequest, v1alpha1.ArchiveProjectResponse]
+	unarchiveProject               *connect.Client[v1alpha1.UnarchiveProjectRequest, v1alpha1.UnarchiveProjectResponse]
+	getBackstageEntityStatus       *connect.Client[v1alpha1.GetBackstageEntityStatusRequest, v1alpha1.GetBackstageEntityStatusResponse]
+	queryFreight                   *connect.Client[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse]
+	approveFreight                 *connect.Client[v1alpha1.ApproveFreightRequest, v1alpha1.ApproveFreightResponse]
+	attachBuildInfo                *connect.Client[v1alpha1.AttachBuildInfoRequest, v1alpha1.AttachBuildInfoResponse]
+	diffFreight                    *connect.Client[v1alpha1.DiffFreightRequest, v1alpha1.DiffFreightResponse]
+	explainDiscovery               *connect.Client[v1alpha1.ExplainDiscoveryRequest, v1alpha1.ExplainDiscoveryResponse]
+	listWarehouses                 *connect.Client[v1alpha1.ListWarehousesRequest, v1alpha1.ListWarehousesResponse]
+	getWarehouse                   *connect.Client[v1alpha1.GetWarehouseRequest, v1alpha1.GetWarehouseResponse]
+	watchWarehouses                *connect.Client[v1alpha1.WatchWarehousesRequest, v1alpha1.WatchWarehousesResponse]
+	createWarehouse                *connect.Client[v1alpha1.CreateWarehouseRequest, v1alpha1.CreateWarehouseResponse]
+	updateWarehouse                *connect.Client[v1alpha1.UpdateWarehouseRequest, v1alpha1.UpdateWarehouseResponse]
+	deleteWarehouse                *connect.Client[v1alpha1.DeleteWarehouseRequest, v1alpha1.DeleteWarehouseResponse]
+	refreshWarehouse               *connect.Client[v1alpha1.RefreshWarehouseRequest, v1alpha1.RefreshWarehouseResponse]
+	generateWarehouseFromArgoCDApp *connect.Client[v1alpha1.GenerateWarehouseFromArgoCDAppRequest, v1alpha1.GenerateWarehouseFromArgoCDAppResponse]
+	startOperation                 *connect.Client[v1alpha1.StartOperationRequest, v1alpha1.StartOperationResponse]
+	getOperation                   *connect.Client[v1alpha1.GetOperationRequest, v1alpha1.GetOperationResponse]
+	listOperations                 *connect.Client[v1alpha1.ListOperationsRequest, v1alpha1.ListOperationsResponse]
+	cancelOperation                *connect.Client[v1alpha1.CancelOperationRequest, v1alpha1.CancelOperationResponse]
+	testCredentials                *connect.Client[v1alpha1.TestCredentialsRequest, v1alpha1.TestCredentialsResponse]
+	testClusterConnection          *connect.Client[v1alpha1.TestClusterConnectionRequest, v1alpha1.TestClusterConnectionResponse]
+	listEvents                     *connect.Client[v1alpha1.ListEventsRequest, v1alpha1.ListEventsResponse]
 }
 
 // GetVersionInfo calls akuity.io.kargo.service.v1alpha1.KargoService.GetVersionInfo.
@@ -455,6 +674,26 @@ func (c *kargoServiceClient) AdminLogin(ctx context.Context, req *connect.Reques
 	return c.adminLogin.CallUnary(ctx, req)
 }
 
+// KubernetesLogin calls akuity.io.kargo.service.v1alpha1.KargoService.KubernetesLogin.
+func (c *kargoServiceClient) KubernetesLogin(ctx context.Context, req *connect.Request[v1alpha1.KubernetesLoginRequest]) (*connect.Response[v1alpha1.KubernetesLoginResponse], error) {
+	return c.kubernetesLogin.CallUnary(ctx, req)
+}
+
+// CreateViewerToken calls akuity.io.kargo.service.v1alpha1.KargoService.CreateViewerToken.
+func (c *kargoServiceClient) CreateViewerToken(ctx context.Context, req *connect.Request[v1alpha1.CreateViewerTokenRequest]) (*connect.Response[v1alpha1.CreateViewerTokenResponse], error) {
+	return c.createViewerToken.CallUnary(ctx, req)
+}
+
+// RevokeToken calls akuity.io.kargo.service.v1alpha1.KargoService.RevokeToken.
+func (c *kargoServiceClient) RevokeToken(ctx context.Context, req *connect.Request[v1alpha1.RevokeTokenRequest]) (*connect.Response[v1alpha1.RevokeTokenResponse], error) {
+	return c.revokeToken.CallUnary(ctx, req)
+}
+
+// GetControllerStats calls akuity.io.kargo.service.v1alpha1.KargoService.GetControllerStats.
+func (c *kargoServiceClient) GetControllerStats(ctx context.Context, req *connect.Request[v1alpha1.GetControllerStatsRequest]) (*connect.Response[v1alpha1.GetControllerStatsResponse], error) {
+	return c.getControllerStats.CallUnary(ctx, req)
+}
+
 // CreateResource calls akuity.io.kargo.service.v1alpha1.KargoService.CreateResource.
 func (c *kargoServiceClient) CreateResource(ctx context.Context, req *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error) {
 	return c.createResource.CallUnary(ctx, req)
@@ -476,6 +715,11 @@ func (c *kargoServiceClient) DeleteResource(ctx context.Context, req *connect.Re
 	return c.deleteResource.CallUnary(ctx, req)
 }
 
+// UpdateMetadata calls akuity.io.kargo.service.v1alpha1.KargoService.UpdateMetadata.
+func (c *kargoServiceClient) UpdateMetadata(ctx context.Context, req *connect.Request[v1alpha1.UpdateMetadataRequest]) (*connect.Response[v1alpha1.UpdateMetadataResponse], error) {
+	return c.updateMetadata.CallUnary(ctx, req)
+}
+
 // CreateStage calls akuity.io.kargo.service.v1alpha1.KargoService.CreateStage.
 func (c *kargoServiceClient) CreateStage(ctx context.Context, req *connect.Request[v1alpha1.CreateStageRequest]) (*connect.Response[v1alpha1.CreateStageResponse], error) {
 	return c.createStage.CallUnary(ctx, req)
@@ -506,14 +750,25 @@ func (c *kargoServiceClient) DeleteStage(ctx context.Context, req *connect.Reque
 	return c.deleteStage.CallUnary(ctx, req)
 }
 
+// RenameStage calls akuity.io.kargo.service.v1alpha1.KargoService.RenameStage.
+func (c *kargoServiceClient) RenameStage(ctx context.Context, req *connect.Request[v1alpha1.RenameStageRequest]) (*connect.Response[v1alpha1.RenameStageResponse], error) {
+	return c.renameStage.CallUnary(ctx, req)
+}
+
 // PromoteStage calls akuity.io.kargo.service.v1alpha1.KargoService.PromoteStage.
 func (c *kargoServiceClient) PromoteStage(ctx context.Context, req *connect.Request[v1alpha1.PromoteStageRequest]) (*connect.Response[v1alpha1.PromoteStageResponse], error) {
 	return c.promoteStage.CallUnary(ctx, req)
 }
 
+// ExplainPromotionEligibility calls
+// akuity.io.kargo.service.v1alpha1.KargoService.ExplainPromotionEligibility.
+func (c *kargoServiceClient) ExplainPromotionEligibility(ctx context.Context, req *connect.Request[v1alpha1.ExplainPromotionEligibilityRequest]) (*connect.Response[v1alpha1.ExplainPromotionEligibilityResponse], error) {
+	return c.explainPromotionEligibility.CallUnary(ctx, req)
+}
+
 // PromoteSubscribers calls akuity.io.kargo.service.v1alpha1.KargoService.PromoteSubscribers.
-func (c *kargoServiceClient) PromoteSubscribers(ctx context.Context, req *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error) {
-	return c.promoteSubscribers.CallUnary(ctx, req)
+func (c *kargoServiceClient) PromoteSubscribers(ctx context.Context, req *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.ServerStreamForClient[v1alpha1.PromoteSubscribersResponse], error) {
+	return c.promoteSubscribers.CallServerStream(ctx, req)
 }
 
 // RefreshStage calls akuity.io.kargo.service.v1alpha1.KargoService.RefreshStage.
@@ -587,11 +842,47 @@ func (c *kargoServiceClient) DeleteProject(ctx context.Context, req *connect.Req
 	return c.deleteProject.CallUnary(ctx, req)
 }
 
+// ArchiveProject calls akuity.io.kargo.service.v1alpha1.KargoService.ArchiveProject.
+func (c *kargoServiceClient) ArchiveProject(ctx context.Context, req *connect.Request[v1alpha1.ArchiveProjectRequest]) (*connect.Response[v1alpha1.ArchiveProjectResponse], error) {
+	return c.archiveProject.CallUnary(ctx, req)
+}
+
+// UnarchiveProject calls akuity.io.kargo.service.v1alpha1.KargoService.UnarchiveProject.
+func (c *kargoServiceClient) UnarchiveProject(ctx context.Context, req *connect.Request[v1alpha1.UnarchiveProjectRequest]) (*connect.Response[v1alpha1.UnarchiveProjectResponse], error) {
+	return c.unarchiveProject.CallUnary(ctx, req)
+}
+
+// GetBackstageEntityStatus calls
+// akuity.io.kargo.service.v1alpha1.KargoService.GetBackstageEntityStatus.
+func (c *kargoServiceClient) GetBackstageEntityStatus(ctx context.Context, req *connect.Request[v1alpha1.GetBackstageEntityStatusRequest]) (*connect.Response[v1alpha1.GetBackstageEntityStatusResponse], error) {
+	return c.getBackstageEntityStatus.CallUnary(ctx, req)
+}
+
 // QueryFreight calls akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight.
 func (c *kargoServiceClient) QueryFreight(ctx context.Context, req *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return c.queryFreight.CallUnary(ctx, req)
 }
 
+// ApproveFreight calls akuity.io.kargo.service.v1alpha1.KargoService.ApproveFreight.
+func (c *kargoServiceClient) ApproveFreight(ctx context.Context, req *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error) {
+	return c.approveFreight.CallUnary(ctx, req)
+}
+
+// AttachBuildInfo calls akuity.io.kargo.service.v1alpha1.KargoService.AttachBuildInfo.
+func (c *kargoServiceClient) AttachBuildInfo(ctx context.Context, req *connect.Request[v1alpha1.AttachBuildInfoRequest]) (*connect.Response[v1alpha1.AttachBuildInfoResponse], error) {
+	return c.attachBuildInfo.CallUnary(ctx, req)
+}
+
+// DiffFreight calls akuity.io.kargo.service.v1alpha1.KargoService.DiffFreight.
+func (c *kargoServiceClient) DiffFreight(ctx context.Context, req *connect.Request[v1alpha1.DiffFreightRequest]) (*connect.Response[v1alpha1.DiffFreightResponse], error) {
+	return c.diffFreight.CallUnary(ctx, req)
+}
+
+// ExplainDiscovery calls akuity.io.kargo.service.v1alpha1.KargoService.ExplainDiscovery.
+func (c *kargoServiceClient) ExplainDiscovery(ctx context.Context, req *connect.Request[v1alpha1.ExplainDiscoveryRequest]) (*connect.Response[v1alpha1.ExplainDiscoveryResponse], error) {
+	return c.explainDiscovery.CallUnary(ctx, req)
+}
+
 // ListWarehouses calls akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses.
 func (c *kargoServiceClient) ListWarehouses(ctx context.Context, req *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error) {
 	return c.listWarehouses.CallUnary(ctx, req)
@@ -627,6 +918,47 @@ func (c *kargoServiceClient) RefreshWarehouse(ctx context.Context, req *connect.
 	return c.refreshWarehouse.CallUnary(ctx, req)
 }
 
+// GenerateWarehouseFromArgoCDApp calls
+// akuity.io.kargo.service.v1alpha1.KargoService.GenerateWarehouseFromArgoCDApp.
+func (c *kargoServiceClient) GenerateWarehouseFromArgoCDApp(ctx context.Context, req *connect.Request[v1alpha1.GenerateWarehouseFromArgoCDAppRequest]) (*connect.Response[v1alpha1.GenerateWarehouseFromArgoCDAppResponse], error) {
+	return c.generateWarehouseFromArgoCDApp.CallUnary(ctx, req)
+}
+
+// StartOperation calls akuity.io.kargo.service.v1alpha1.KargoService.StartOperation.
+func (c *kargoServiceClient) StartOperation(ctx context.Context, req *connect.Request[v1alpha1.StartOperationRequest]) (*connect.Response[v1alpha1.StartOperationResponse], error) {
+	return c.startOperation.CallUnary(ctx, req)
+}
+
+// GetOperation calls akuity.io.kargo.service.v1alpha1.KargoService.GetOperation.
+func (c *kargoServiceClient) GetOperation(ctx context.Context, req *connect.Request[v1alpha1.GetOperationRequest]) (*connect.Response[v1alpha1.GetOperationResponse], error) {
+	return c.getOperation.CallUnary(ctx, req)
+}
+
+// ListOperations calls akuity.io.kargo.service.v1alpha1.KargoService.ListOperations.
+func (c *kargoServiceClient) ListOperations(ctx context.Context, req *connect.Request[v1alpha1.ListOperationsRequest]) (*connect.Response[v1alpha1.ListOperationsResponse], error) {
+	return c.listOperations.CallUnary(ctx, req)
+}
+
+// CancelOperation calls akuity.io.kargo.service.v1alpha1.KargoService.CancelOperation.
+func (c *kargoServiceClient) CancelOperation(ctx context.Context, req *connect.Request[v1alpha1.CancelOperationRequest]) (*connect.Response[v1alpha1.CancelOperationResponse], error) {
+	return c.cancelOperation.CallUnary(ctx, req)
+}
+
+// TestCredentials calls akuity.io.kargo.service.v1alpha1.KargoService.TestCredentials.
+func (c *kargoServiceClient) TestCredentials(ctx context.Context, req *connect.Request[v1alpha1.TestCredentialsRequest]) (*connect.Response[v1alpha1.TestCredentialsResponse], error) {
+	return c.testCredentials.CallUnary(ctx, req)
+}
+
+// TestClusterConnection calls akuity.io.kargo.service.v1alpha1.KargoService.TestClusterConnection.
+func (c *kargoServiceClient) TestClusterConnection(ctx context.Context, req *connect.Request[v1alpha1.TestClusterConnectionRequest]) (*connect.Response[v1alpha1.TestClusterConnectionResponse], error) {
+	return c.testClusterConnection.CallUnary(ctx, req)
+}
+
+// ListEvents calls akuity.io.kargo.service.v1alpha1.KargoService.ListEvents.
+func (c *kargoServiceClient) ListEvents(ctx context.Context, req *connect.Request[v1alpha1.ListEventsRequest]) (*connect.Response[v1alpha1.ListEventsResponse], error) {
+	return c.listEvents.CallUnary(ctx, req)
+}
+
 // KargoServiceHandler is an implementation of the akuity.io.kargo.service.v1alpha1.KargoService
 // service.
 type KargoServiceHandler interface {
@@ -634,20 +966,27 @@ type KargoServiceHandler interface {
 	GetConfig(context.Context, *connect.Request[v1alpha1.GetConfigRequest]) (*connect.Response[v1alpha1.GetConfigResponse], error)
 	GetPublicConfig(context.Context, *connect.Request[v1alpha1.GetPublicConfigRequest]) (*connect.Response[v1alpha1.GetPublicConfigResponse], error)
 	AdminLogin(context.Context, *connect.Request[v1alpha1.AdminLoginRequest]) (*connect.Response[v1alpha1.AdminLoginResponse], error)
+	KubernetesLogin(context.Context, *connect.Request[v1alpha1.KubernetesLoginRequest]) (*connect.Response[v1alpha1.KubernetesLoginResponse], error)
+	CreateViewerToken(context.Context, *connect.Request[v1alpha1.CreateViewerTokenRequest]) (*connect.Response[v1alpha1.CreateViewerTokenResponse], error)
+	RevokeToken(context.Context, *connect.Request[v1alpha1.RevokeTokenRequest]) (*connect.Response[v1alpha1.RevokeTokenResponse], error)
+	GetControllerStats(context.Context, *connect.Request[v1alpha1.GetControllerStatsRequest]) (*connect.Response[v1alpha1.GetControllerStatsResponse], error)
 	// TODO(devholic): Add ApplyResource API
 	// rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
 	CreateResource(context.Context, *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error)
 	CreateOrUpdateResource(context.Context, *connect.Request[v1alpha1.CreateOrUpdateResourceRequest]) (*connect.Response[v1alpha1.CreateOrUpdateResourceResponse], error)
 	UpdateResource(context.Context, *connect.Request[v1alpha1.UpdateResourceRequest]) (*connect.Response[v1alpha1.UpdateResourceResponse], error)
 	DeleteResource(context.Context, *connect.Request[v1alpha1.DeleteResourceRequest]) (*connect.Response[v1alpha1.DeleteResourceResponse], error)
+	UpdateMetadata(context.Context, *connect.Request[v1alpha1.UpdateMetadataRequest]) (*connect.Response[v1alpha1.UpdateMetadataResponse], error)
 	CreateStage(context.Context, *connect.Request[v1alpha1.CreateStageRequest]) (*connect.Response[v1alpha1.CreateStageResponse], error)
 	ListStages(context.Context, *connect.Request[v1alpha1.ListStagesRequest]) (*connect.Response[v1alpha1.ListStagesResponse], error)
 	GetStage(context.Context, *connect.Request[v1alpha1.GetStageRequest]) (*connect.Response[v1alpha1.GetStageResponse], error)
 	WatchStages(context.Context, *connect.Request[v1alpha1.WatchStagesRequest], *connect.ServerStream[v1alpha1.WatchStagesResponse]) error
 	UpdateStage(context.Context, *connect.Request[v1alpha1.UpdateStageRequest]) (*connect.Response[v1alpha1.UpdateStageResponse], error)
 	DeleteStage(context.Context, *connect.Request[v1alpha1.DeleteStageRequest]) (*connect.Response[v1alpha1.DeleteStageResponse], error)
+	RenameStage(context.Context, *connect.Request[v1alpha1.RenameStageRequest]) (*connect.Response[v1alpha1.RenameStageResponse], error)
 	PromoteStage(context.Context, *connect.Request[v1alpha1.PromoteStageRequest]) (*connect.Response[v1alpha1.PromoteStageResponse], error)
-	PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error)
+	ExplainPromotionEligibility(context.Context, *connect.Request[v1alpha1.ExplainPromotionEligibilityRequest]) (*connect.Response[v1alpha1.ExplainPromotionEligibilityResponse], error)
+	PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest], *connect.ServerStream[v1alpha1.PromoteSubscribersResponse]) error
 	RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error)
 	// Promotion APIs
 	ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error)
@@ -663,7 +1002,14 @@ type KargoServiceHandler interface {
 	CreateProject(context.Context, *connect.Request[v1alpha1.CreateProjectRequest]) (*connect.Response[v1alpha1.CreateProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
+	ArchiveProject(context.Context, *connect.Request[v1alpha1.ArchiveProjectRequest]) (*connect.Response[v1alpha1.ArchiveProjectResponse], error)
+	UnarchiveProject(context.Context, *connect.Request[v1alpha1.UnarchiveProjectRequest]) (*connect.Response[v1alpha1.UnarchiveProjectResponse], error)
+	GetBackstageEntityStatus(context.Context, *connect.Request[v1alpha1.GetBackstageEntityStatusRequest]) (*connect.Response[v1alpha1.GetBackstageEntityStatusResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
+	ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error)
+	AttachBuildInfo(context.Context, *connect.Request[v1alpha1.AttachBuildInfoRequest]) (*connect.Response[v1alpha1.AttachBuildInfoResponse], error)
+	DiffFreight(context.Context, *connect.Request[v1alpha1.DiffFreightRequest]) (*connect.Response[v1alpha1.DiffFreightResponse], error)
+	ExplainDiscovery(context.Context, *connect.Request[v1alpha1.ExplainDiscoveryRequest]) (*connect.Response[v1alpha1.ExplainDiscoveryResponse], error)
 	ListWarehouses(context.Context, *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error)
 	GetWarehouse(context.Context, *connect.Request[v1alpha1.GetWarehouseRequest]) (*connect.Response[v1alpha1.GetWarehouseResponse], error)
 	WatchWarehouses(context.Context, *connect.Request[v1alpha1.WatchWarehousesRequest], *connect.ServerStream[v1alpha1.WatchWarehousesResponse]) error
@@ -671,6 +1017,14 @@ type KargoServiceHandler interface {
 	UpdateWarehouse(context.Context, *connect.Request[v1alpha1.UpdateWarehouseRequest]) (*connect.Response[v1alpha1.UpdateWarehouseResponse], error)
 	DeleteWarehouse(context.Context, *connect.Request[v1alpha1.DeleteWarehouseRequest]) (*connect.Response[v1alpha1.DeleteWarehouseResponse], error)
 	RefreshWarehouse(context.Context, *connect.Request[v1alpha1.RefreshWarehouseRequest]) (*connect.Response[v1alpha1.RefreshWarehouseResponse], error)
+	GenerateWarehouseFromArgoCDApp(context.Context, *connect.Request[v1alpha1.GenerateWarehouseFromArgoCDAppRequest]) (*connect.Response[v1alpha1.GenerateWarehouseFromArgoCDAppResponse], error)
+	StartOperation(context.Context, *connect.Request[v1alpha1.StartOperationRequest]) (*connect.Response[v1alpha1.StartOperationResponse], error)
+	GetOperation(context.Context, *connect.Request[v1alpha1.GetOperationRequest]) (*connect.Response[v1alpha1.GetOperationResponse], error)
+	ListOperations(context.Context, *connect.Request[v1alpha1.ListOperationsRequest]) (*connect.Response[v1alpha1.ListOperationsResponse], error)
+	CancelOperation(context.Context, *connect.Request[v1alpha1.CancelOperationRequest]) (*connect.Response[v1alpha1.CancelOperationResponse], error)
+	TestCredentials(context.Context, *connect.Request[v1alpha1.TestCredentialsRequest]) (*connect.Response[v1alpha1.TestCredentialsResponse], error)
+	TestClusterConnection(context.Context, *connect.Request[v1alpha1.TestClusterConnectionRequest]) (*connect.Response[v1alpha1.TestClusterConnectionResponse], error)
+	ListEvents(context.Context, *connect.Request[v1alpha1.ListEventsRequest]) (*connect.Response[v1alpha1.ListEventsResponse], error)
 }
 
 // NewKargoServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -699,6 +1053,26 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.AdminLogin,
 		opts...,
 	)
+	kargoServiceKubernetesLoginHandler := connect.NewUnaryHandler(
+		KargoServiceKubernetesLoginProcedure,
+		svc.KubernetesLogin,
+		opts...,
+	)
+	kargoServiceCreateViewerTokenHandler := connect.NewUnaryHandler(
+		KargoServiceCreateViewerTokenProcedure,
+		svc.CreateViewerToken,
+		opts...,
+	)
+	kargoServiceRevokeTokenHandler := connect.NewUnaryHandler(
+		KargoServiceRevokeTokenProcedure,
+		svc.RevokeToken,
+		opts...,
+	)
+	kargoServiceGetControllerStatsHandler := connect.NewUnaryHandler(
+		KargoServiceGetControllerStatsProcedure,
+		svc.GetControllerStats,
+		opts...,
+	)
 	kargoServiceCreateResourceHandler := connect.NewUnaryHandler(
 		KargoServiceCreateResourceProcedure,
 		svc.CreateResource,
@@ -719,6 +1093,11 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.DeleteResource,
 		opts...,
 	)
+	kargoServiceUpdateMetadataHandler := connect.NewUnaryHandler(
+		KargoServiceUpdateMetadataProcedure,
+		svc.UpdateMetadata,
+		opts...,
+	)
 	kargoServiceCreateStageHandler := connect.NewUnaryHandler(
 		KargoServiceCreateStageProcedure,
 		svc.CreateStage,
@@ -749,12 +1128,22 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.DeleteStage,
 		opts...,
 	)
+	kargoServiceRenameStageHandler := connect.NewUnaryHandler(
+		KargoServiceRenameStageProcedure,
+		svc.RenameStage,
+		opts...,
+	)
 	kargoServicePromoteStageHandler := connect.NewUnaryHandler(
 		KargoServicePromoteStageProcedure,
 		svc.PromoteStage,
 		opts...,
 	)
-	kargoServicePromoteSubscribersHandler := connect.NewUnaryHandler(
+	kargoServiceExplainPromotionEligibilityHandler := connect.NewUnaryHandler(
+		KargoServiceExplainPromotionEligibilityProcedure,
+		svc.ExplainPromotionEligibility,
+		opts...,
+	)
+	kargoServicePromoteSubscribersHandler := connect.NewServerStreamHandler(
 		KargoServicePromoteSubscribersProcedure,
 		svc.PromoteSubscribers,
 		opts...,
@@ -829,11 +1218,46 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.DeleteProject,
 		opts...,
 	)
+	kargoServiceArchiveProjectHandler := connect.NewUnaryHandler(
+		KargoServiceArchiveProjectProcedure,
+		svc.ArchiveProject,
+		opts...,
+	)
+	kargoServiceUnarchiveProjectHandler := connect.NewUnaryHandler(
+		KargoServiceUnarchiveProjectProcedure,
+		svc.UnarchiveProject,
+		opts...,
+	)
+	kargoServiceGetBackstageEntityStatusHandler := connect.NewUnaryHandler(
+		KargoServiceGetBackstageEntityStatusProcedure,
+		svc.GetBackstageEntityStatus,
+		opts...,
+	)
 	kargoServiceQueryFreightHandler := connect.NewUnaryHandler(
 		KargoServiceQueryFreightProcedure,
 		svc.QueryFreight,
 		opts...,
 	)
+	kargoServiceApproveFreightHandler := connect.NewUnaryHandler(
+		KargoServiceApproveFreightProcedure,
+		svc.ApproveFreight,
+		opts...,
+	)
+	kargoServiceAttachBuildInfoHandler := connect.NewUnaryHandler(
+		KargoServiceAttachBuildInfoProcedure,
+		svc.AttachBuildInfo,
+		opts...,
+	)
+	kargoServiceDiffFreightHandler := connect.NewUnaryHandler(
+		KargoServiceDiffFreightProcedure,
+		svc.DiffFreight,
+		opts...,
+	)
+	kargoServiceExplainDiscoveryHandler := connect.NewUnaryHandler(
+		KargoServiceExplainDiscoveryProcedure,
+		svc.ExplainDiscovery,
+		opts...,
+	)
 	kargoServiceListWarehousesHandler := connect.NewUnaryHandler(
 		KargoServiceListWarehousesProcedure,
 		svc.ListWarehouses,
@@ -869,6 +1293,46 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.RefreshWarehouse,
 		opts...,
 	)
+	kargoServiceGenerateWarehouseFromArgoCDAppHandler := connect.NewUnaryHandler(
+		KargoServiceGenerateWarehouseFromArgoCDAppProcedure,
+		svc.GenerateWarehouseFromArgoCDApp,
+		opts...,
+	)
+	kargoServiceStartOperationHandler := connect.NewUnaryHandler(
+		KargoServiceStartOperationProcedure,
+		svc.StartOperation,
+		opts...,
+	)
+	kargoServiceGetOperationHandler := connect.NewUnaryHandler(
+		KargoServiceGetOperationProcedure,
+		svc.GetOperation,
+		opts...,
+	)
+	kargoServiceListOperationsHandler := connect.NewUnaryHandler(
+		KargoServiceListOperationsProcedure,
+		svc.ListOperations,
+		opts...,
+	)
+	kargoServiceCancelOperationHandler := connect.NewUnaryHandler(
+		KargoServiceCancelOperationProcedure,
+		svc.CancelOperation,
+		opts...,
+	)
+	kargoServiceTestCredentialsHandler := connect.NewUnaryHandler(
+		KargoServiceTestCredentialsProcedure,
+		svc.TestCredentials,
+		opts...,
+	)
+	kargoServiceTestClusterConnectionHandler := connect.NewUnaryHandler(
+		KargoServiceTestClusterConnectionProcedure,
+		svc.TestClusterConnection,
+		opts...,
+	)
+	kargoServiceListEventsHandler := connect.NewUnaryHandler(
+		KargoServiceListEventsProcedure,
+		svc.ListEvents,
+		opts...,
+	)
 	return "/akuity.io.kargo.service.v1alpha1.KargoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case KargoServiceGetVersionInfoProcedure:
@@ -879,6 +1343,14 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceGetPublicConfigHandler.ServeHTTP(w, r)
 		case KargoServiceAdminLoginProcedure:
 			kargoServiceAdminLoginHandler.ServeHTTP(w, r)
+		case KargoServiceKubernetesLoginProcedure:
+			kargoServiceKubernetesLoginHandler.ServeHTTP(w, r)
+		case KargoServiceCreateViewerTokenProcedure:
+			kargoServiceCreateViewerTokenHandler.ServeHTTP(w, r)
+		case KargoServiceRevokeTokenProcedure:
+			kargoServiceRevokeTokenHandler.ServeHTTP(w, r)
+		case KargoServiceGetControllerStatsProcedure:
+			kargoServiceGetControllerStatsHandler.ServeHTTP(w, r)
 		case KargoServiceCreateResourceProcedure:
 			kargoServiceCreateResourceHandler.ServeHTTP(w, r)
 		case KargoServiceCreateOrUpdateResourceProcedure:
@@ -887,6 +1359,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceUpdateResourceHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteResourceProcedure:
 			kargoServiceDeleteResourceHandler.ServeHTTP(w, r)
+		case KargoServiceUpdateMetadataProcedure:
+			kargoServiceUpdateMetadataHandler.ServeHTTP(w, r)
 		case KargoServiceCreateStageProcedure:
 			kargoServiceCreateStageHandler.ServeHTTP(w, r)
 		case KargoServiceListStagesProcedure:
@@ -899,8 +1373,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceUpdateStageHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteStageProcedure:
 			kargoServiceDeleteStageHandler.ServeHTTP(w, r)
+		case KargoServiceRenameStageProcedure:
+			kargoServiceRenameStageHandler.ServeHTTP(w, r)
 		case KargoServicePromoteStageProcedure:
 			kargoServicePromoteStageHandler.ServeHTTP(w, r)
+		case KargoServiceExplainPromotionEligibilityProcedure:
+			kargoServiceExplainPromotionEligibilityHandler.ServeHTTP(w, r)
 		case KargoServicePromoteSubscribersProcedure:
 			kargoServicePromoteSubscribersHandler.ServeHTTP(w, r)
 		case KargoServiceRefreshStageProcedure:
@@ -931,8 +1409,22 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceListProjectsHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteProjectProcedure:
 			kargoServiceDeleteProjectHandler.ServeHTTP(w, r)
+		case KargoServiceArchiveProjectProcedure:
+			kargoServiceArchiveProjectHandler.ServeHTTP(w, r)
+		case KargoServiceUnarchiveProjectProcedure:
+			kargoServiceUnarchiveProjectHandler.ServeHTTP(w, r)
+		case KargoServiceGetBackstageEntityStatusProcedure:
+			kargoServiceGetBackstageEntityStatusHandler.ServeHTTP(w, r)
 		case KargoServiceQueryFreightProcedure:
 			kargoServiceQueryFreightHandler.ServeHTTP(w, r)
+		case KargoServiceApproveFreightProcedure:
+			kargoServiceApproveFreightHandler.ServeHTTP(w, r)
+		case KargoServiceAttachBuildInfoProcedure:
+			kargoServiceAttachBuildInfoHandler.ServeHTTP(w, r)
+		case KargoServiceDiffFreightProcedure:
+			kargoServiceDiffFreightHandler.ServeHTTP(w, r)
+		case KargoServiceExplainDiscoveryProcedure:
+			kargoServiceExplainDiscoveryHandler.ServeHTTP(w, r)
 		case KargoServiceListWarehousesProcedure:
 			kargoServiceListWarehousesHandler.ServeHTTP(w, r)
 		case KargoServiceGetWarehouseProcedure:
@@ -947,6 +1439,22 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceDeleteWarehouseHandler.ServeHTTP(w, r)
 		case KargoServiceRefreshWarehouseProcedure:
 			kargoServiceRefreshWarehouseHandler.ServeHTTP(w, r)
+		case KargoServiceGenerateWarehouseFromArgoCDAppProcedure:
+			kargoServiceGenerateWarehouseFromArgoCDAppHandler.ServeHTTP(w, r)
+		case KargoServiceStartOperationProcedure:
+			kargoServiceStartOperationHandler.ServeHTTP(w, r)
+		case KargoServiceGetOperationProcedure:
+			kargoServiceGetOperationHandler.ServeHTTP(w, r)
+		case KargoServiceListOperationsProcedure:
+			kargoServiceListOperationsHandler.ServeHTTP(w, r)
+		case KargoServiceCancelOperationProcedure:
+			kargoServiceCancelOperationHandler.ServeHTTP(w, r)
+		case KargoServiceTestCredentialsProcedure:
+			kargoServiceTestCredentialsHandler.ServeHTTP(w, r)
+		case KargoServiceTestClusterConnectionProcedure:
+			kargoServiceTestClusterConnectionHandler.ServeHTTP(w, r)
+		case KargoServiceListEventsProcedure:
+			kargoServiceListEventsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -972,6 +1480,22 @@ func (UnimplementedKargoServiceHandler) AdminLogin(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.AdminLogin is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) KubernetesLogin(context.Context, *connect.Request[v1alpha1.KubernetesLoginRequest]) (*connect.Response[v1alpha1.KubernetesLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.KubernetesLogin is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) CreateViewerToken(context.Context, *connect.Request[v1alpha1.CreateViewerTokenRequest]) (*connect.Response[v1alpha1.CreateViewerTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateViewerToken is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) RevokeToken(context.Context, *connect.Request[v1alpha1.RevokeTokenRequest]) (*connect.Response[v1alpha1.RevokeTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RevokeToken is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) GetControllerStats(context.Context, *connect.Request[v1alpha1.GetControllerStatsRequest]) (*connect.Response[v1alpha1.GetControllerStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetControllerStats is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) CreateResource(context.Context, *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateResource is not implemented"))
 }
@@ -988,6 +1512,10 @@ func (UnimplementedKargoServiceHandler) DeleteResource(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteResource is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) UpdateMetadata(context.Context, *connect.Request[v1alpha1.UpdateMetadataRequest]) (*connect.Response[v1alpha1.UpdateMetadataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.UpdateMetadata is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) CreateStage(context.Context, *connect.Request[v1alpha1.CreateStageRequest]) (*connect.Response[v1alpha1.CreateStageResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateStage is not implemented"))
 }
@@ -1012,12 +1540,20 @@ func (UnimplementedKargoServiceHandler) DeleteStage(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteStage is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) RenameStage(context.Context, *connect.Request[v1alpha1.RenameStageRequest]) (*connect.Response[v1alpha1.RenameStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RenameStage is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) PromoteStage(context.Context, *connect.Request[v1alpha1.PromoteStageRequest]) (*connect.Response[v1alpha1.PromoteStageResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PromoteStage is not implemented"))
 }
 
-func (UnimplementedKargoServiceHandler) PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PromoteSubscribers is not implemented"))
+func (UnimplementedKargoServiceHandler) ExplainPromotionEligibility(context.Context, *connect.Request[v1alpha1.ExplainPromotionEligibilityRequest]) (*connect.Response[v1alpha1.ExplainPromotionEligibilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ExplainPromotionEligibility is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest], *connect.ServerStream[v1alpha1.PromoteSubscribersResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PromoteSubscribers is not implemented"))
 }
 
 func (UnimplementedKargoServiceHandler) RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error) {
@@ -1076,10 +1612,38 @@ func (UnimplementedKargoServiceHandler) DeleteProject(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) ArchiveProject(context.Context, *connect.Request[v1alpha1.ArchiveProjectRequest]) (*connect.Response[v1alpha1.ArchiveProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ArchiveProject is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) UnarchiveProject(context.Context, *connect.Request[v1alpha1.UnarchiveProjectRequest]) (*connect.Response[v1alpha1.UnarchiveProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.UnarchiveProject is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) GetBackstageEntityStatus(context.Context, *connect.Request[v1alpha1.GetBackstageEntityStatusRequest]) (*connect.Response[v1alpha1.GetBackstageEntityStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetBackstageEntityStatus is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ApproveFreight is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) AttachBuildInfo(context.Context, *connect.Request[v1alpha1.AttachBuildInfoRequest]) (*connect.Response[v1alpha1.AttachBuildInfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.AttachBuildInfo is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) DiffFreight(context.Context, *connect.Request[v1alpha1.DiffFreightRequest]) (*connect.Response[v1alpha1.DiffFreightResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DiffFreight is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) ExplainDiscovery(context.Context, *connect.Request[v1alpha1.ExplainDiscoveryRequest]) (*connect.Response[v1alpha1.ExplainDiscoveryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ExplainDiscovery is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ListWarehouses(context.Context, *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses is not implemented"))
 }
@@ -1107,3 +1671,35 @@ func (UnimplementedKargoServiceHandler) DeleteWarehouse(context.Context, *connec
 func (UnimplementedKargoServiceHandler) RefreshWarehouse(context.Context, *connect.Request[v1alpha1.RefreshWarehouseRequest]) (*connect.Response[v1alpha1.RefreshWarehouseResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RefreshWarehouse is not implemented"))
 }
+
+func (UnimplementedKargoServiceHandler) GenerateWarehouseFromArgoCDApp(context.Context, *connect.Request[v1alpha1.GenerateWarehouseFromArgoCDAppRequest]) (*connect.Response[v1alpha1.GenerateWarehouseFromArgoCDAppResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GenerateWarehouseFromArgoCDApp is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) StartOperation(context.Context, *connect.Request[v1alpha1.StartOperationRequest]) (*connect.Response[v1alpha1.StartOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.StartOperation is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) GetOperation(context.Context, *connect.Request[v1alpha1.GetOperationRequest]) (*connect.Response[v1alpha1.GetOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetOperation is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) ListOperations(context.Context, *connect.Request[v1alpha1.ListOperationsRequest]) (*connect.Response[v1alpha1.ListOperationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListOperations is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) CancelOperation(context.Context, *connect.Request[v1alpha1.CancelOperationRequest]) (*connect.Response[v1alpha1.CancelOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CancelOperation is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) TestCredentials(context.Context, *connect.Request[v1alpha1.TestCredentialsRequest]) (*connect.Response[v1alpha1.TestCredentialsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.TestCredentials is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) TestClusterConnection(context.Context, *connect.Request[v1alpha1.TestClusterConnectionRequest]) (*connect.Response[v1alpha1.TestClusterConnectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.TestClusterConnection is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) ListEvents(context.Context, *connect.Request[v1alpha1.ListEventsRequest]) (*connect.Response[v1alpha1.ListEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListEvents is not implemented"))
+}
@@ -0,0 +1,98 @@
+// Package health is an SDK for implementing external health providers. Kargo's
+// Stage controller polls registered health providers over gRPC whenever it
+// assesses the health of a Stage that references them, and merges their
+// reports into the Stage's health, which allows organizations to incorporate
+// health signals from sources other than Argo CD (e.g. monitoring or incident
+// management systems) without forking Kargo.
+package health
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	httputil "github.com/akuity/kargo/internal/http"
+	healthv1alpha1 "github.com/akuity/kargo/pkg/api/health/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/health/v1alpha1/healthv1alpha1connect"
+)
+
+// Provider is the interface implemented by external health providers.
+type Provider interface {
+	// CheckHealth assesses the health of the Stage described by the request with
+	// the Freight described by the request. A Stage that is not healthy should
+	// be reported via the response rather than via an error. Errors should be
+	// reserved for cases where the provider was unable to assess the Stage's
+	// health.
+	CheckHealth(
+		context.Context,
+		*healthv1alpha1.CheckHealthRequest,
+	) (*healthv1alpha1.CheckHealthResponse, error)
+}
+
+// ProviderFunc is an adapter that allows an ordinary function to be used as a
+// Provider.
+type ProviderFunc func(
+	context.Context,
+	*healthv1alpha1.CheckHealthRequest,
+) (*healthv1alpha1.CheckHealthResponse, error)
+
+// CheckHealth implements Provider.
+func (p ProviderFunc) CheckHealth(
+	ctx context.Context,
+	req *healthv1alpha1.CheckHealthRequest,
+) (*healthv1alpha1.CheckHealthResponse, error) {
+	return p(ctx, req)
+}
+
+// NewHandler returns an http.Handler that serves the provided Provider using
+// the gRPC, gRPC-Web, and Connect protocols, along with the path on which the
+// handler should be mounted.
+func NewHandler(
+	provider Provider,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return healthv1alpha1connect.NewHealthProviderServiceHandler(
+		&handler{provider: provider},
+		opts...,
+	)
+}
+
+// ListenAndServe serves the provided Provider on the specified address. Since
+// gRPC requires HTTP/2, HTTP/2 without TLS (h2c) is supported.
+func ListenAndServe(addr string, provider Provider) error {
+	mux := http.NewServeMux()
+	mux.Handle(NewHandler(provider))
+	return httputil.ListenAndServeH2C(addr, mux)
+}
+
+// NewClient returns a client for the health provider served at the specified
+// base URL. The client uses the gRPC protocol. For base URLs with the http
+// scheme, HTTP/2 without TLS (h2c) is used.
+func NewClient(
+	baseURL string,
+	opts ...connect.ClientOption,
+) healthv1alpha1connect.HealthProviderServiceClient {
+	return healthv1alpha1connect.NewHealthProviderServiceClient(
+		httputil.NewH2CClient(baseURL),
+		baseURL,
+		append([]connect.ClientOption{connect.WithGRPC()}, opts...)...,
+	)
+}
+
+// handler adapts a Provider to the generated HealthProviderServiceHandler
+// interface.
+type handler struct {
+	provider Provider
+}
+
+func (h *handler) CheckHealth(
+	ctx context.Context,
+	req *connect.Request[healthv1alpha1.CheckHealthRequest],
+) (*connect.Response[healthv1alpha1.CheckHealthResponse], error) {
+	res, err := h.provider.CheckHealth(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
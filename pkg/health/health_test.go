@@ -0,0 +1,101 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	healthv1alpha1 "github.com/akuity/kargo/pkg/api/health/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+func TestClientAndHandler(t *testing.T) {
+	testCases := []struct {
+		name       string
+		provider   ProviderFunc
+		assertions func(*connect.Response[healthv1alpha1.CheckHealthResponse], error)
+	}{
+		{
+			name: "provider returns an error",
+			provider: func(
+				context.Context,
+				*healthv1alpha1.CheckHealthRequest,
+			) (*healthv1alpha1.CheckHealthResponse, error) {
+				return nil, connect.NewError(
+					connect.CodeUnavailable,
+					errors.New("something went wrong"),
+				)
+			},
+			assertions: func(
+				_ *connect.Response[healthv1alpha1.CheckHealthResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			provider: func(
+				_ context.Context,
+				req *healthv1alpha1.CheckHealthRequest,
+			) (*healthv1alpha1.CheckHealthResponse, error) {
+				return &healthv1alpha1.CheckHealthResponse{
+					Status: "Unhealthy",
+					Issues: []string{
+						req.GetNamespace() + "/" + req.GetStage(),
+						req.GetFreight().GetId(),
+						req.GetParameters()["check"],
+					},
+				}, nil
+			},
+			assertions: func(
+				res *connect.Response[healthv1alpha1.CheckHealthResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "Unhealthy", res.Msg.GetStatus())
+				require.Equal(
+					t,
+					[]string{
+						"fake-namespace/fake-stage",
+						"fake-freight",
+						"fake-check",
+					},
+					res.Msg.GetIssues(),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.Handle(NewHandler(testCase.provider))
+			server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+			defer server.Close()
+			testCase.assertions(
+				NewClient(server.URL).CheckHealth(
+					context.Background(),
+					connect.NewRequest(&healthv1alpha1.CheckHealthRequest{
+						Namespace: "fake-namespace",
+						Stage:     "fake-stage",
+						Freight: &v1alpha1.SimpleFreight{
+							Id: "fake-freight",
+						},
+						Parameters: map[string]string{
+							"check": "fake-check",
+						},
+					}),
+				),
+			)
+		})
+	}
+}
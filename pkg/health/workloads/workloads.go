@@ -0,0 +1,328 @@
+// Package workloads implements a health provider that assesses the health of a
+// Stage by inspecting the Deployments and StatefulSets it is deployed as,
+// directly, in the cluster they run in. It is intended for Stages whose
+// Freight is deployed by some means other than Argo CD, in which case Kargo
+// has no Argo CD Applications from which to derive the Stage's health.
+package workloads
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cluster"
+	healthv1alpha1 "github.com/akuity/kargo/pkg/api/health/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+const (
+	// ParameterNamespace is the name of the health check parameter that
+	// specifies the namespace in which a Stage's workloads run.
+	ParameterNamespace = "namespace"
+	// ParameterSelector is the name of the health check parameter that
+	// specifies a label selector matching a Stage's workloads.
+	ParameterSelector = "selector"
+	// ParameterCluster is the name of the optional health check parameter that
+	// specifies a Cluster, in the same project as a Stage, that the Stage's
+	// workloads run in.
+	ParameterCluster = "cluster"
+)
+
+// ProviderOptions are options for a health.Provider returned by NewProvider.
+type ProviderOptions struct {
+	// KargoClient, if non-nil, is used for looking up the Cluster resources
+	// referenced by health checks and any Secrets they reference. If nil, health
+	// checks may not reference Clusters.
+	KargoClient client.Client
+	// ClusterExecAuthEnabled specifies whether Clusters may use exec credential
+	// plugins to obtain credentials.
+	ClusterExecAuthEnabled bool
+}
+
+// provider is a health.Provider that assesses the health of Deployments and
+// StatefulSets.
+type provider struct {
+	client client.Client
+	opts   ProviderOptions
+
+	newClientFn func(*rest.Config) (client.Client, error)
+}
+
+// NewProvider returns a health.Provider that assesses the health of the
+// Deployments and StatefulSets that match the label selector specified by a
+// Stage's health check. Workloads are found in the Cluster referenced by the
+// health check, if any, or otherwise in the cluster accessed using the provided
+// client, whose scheme must include the apps/v1 API. A Stage is Healthy once
+// all such workloads have been completely rolled out and all of their replicas
+// are ready.
+func NewProvider(c client.Client, opts ProviderOptions) health.Provider {
+	return &provider{
+		client:      c,
+		opts:        opts,
+		newClientFn: newWorkloadClient,
+	}
+}
+
+// CheckHealth implements health.Provider.
+func (p *provider) CheckHealth(
+	ctx context.Context,
+	req *healthv1alpha1.CheckHealthRequest,
+) (*healthv1alpha1.CheckHealthResponse, error) {
+	namespace := req.GetParameters()[ParameterNamespace]
+	if namespace == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("%s parameter should not be empty", ParameterNamespace),
+		)
+	}
+	selector, err := labels.Parse(req.GetParameters()[ParameterSelector])
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Wrapf(err, "error parsing %s parameter", ParameterSelector),
+		)
+	}
+	if selector.Empty() {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("%s parameter should not be empty", ParameterSelector),
+		)
+	}
+	workloadClient, err := p.getWorkloadClient(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	listOpts := []client.ListOption{
+		client.InNamespace(namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err = workloadClient.List(ctx, deployments, listOpts...); err != nil {
+		return nil, connect.NewError(
+			connect.CodeUnavailable,
+			errors.Wrapf(err, "error listing Deployments in namespace %q", namespace),
+		)
+	}
+	statefulSets := &appsv1.StatefulSetList{}
+	if err = workloadClient.List(ctx, statefulSets, listOpts...); err != nil {
+		return nil, connect.NewError(
+			connect.CodeUnavailable,
+			errors.Wrapf(err, "error listing StatefulSets in namespace %q", namespace),
+		)
+	}
+
+	if len(deployments.Items) == 0 && len(statefulSets.Items) == 0 {
+		return &healthv1alpha1.CheckHealthResponse{
+			Status: string(kargoapi.HealthStateUnknown),
+			Issues: []string{
+				fmt.Sprintf(
+					"found no Deployments or StatefulSets matching selector %q in "+
+						"namespace %q",
+					selector,
+					namespace,
+				),
+			},
+		}, nil
+	}
+
+	// We'll start healthy and degrade as we find issues
+	status := kargoapi.HealthStateHealthy
+	var issues []string
+	for i := range deployments.Items {
+		state, issue := deploymentHealth(&deployments.Items[i])
+		status = status.Merge(state)
+		if issue != "" {
+			issues = append(issues, issue)
+		}
+	}
+	for i := range statefulSets.Items {
+		state, issue := statefulSetHealth(&statefulSets.Items[i])
+		status = status.Merge(state)
+		if issue != "" {
+			issues = append(issues, issue)
+		}
+	}
+	return &healthv1alpha1.CheckHealthResponse{
+		Status: string(status),
+		Issues: issues,
+	}, nil
+}
+
+// getWorkloadClient returns a client for the cluster in which the workloads of
+// the Stage described by the provided request run.
+func (p *provider) getWorkloadClient(
+	ctx context.Context,
+	req *healthv1alpha1.CheckHealthRequest,
+) (client.Client, error) {
+	clusterName := req.GetParameters()[ParameterCluster]
+	if clusterName == "" {
+		return p.client, nil
+	}
+	if p.opts.KargoClient == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("%s parameter is not supported by this provider", ParameterCluster),
+		)
+	}
+	c, err := kargoapi.GetCluster(
+		ctx,
+		p.opts.KargoClient,
+		types.NamespacedName{
+			Namespace: req.GetNamespace(),
+			Name:      clusterName,
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	if c == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Cluster %q not found in namespace %q",
+				clusterName,
+				req.GetNamespace(),
+			),
+		)
+	}
+	restCfg, err := cluster.RESTConfig(
+		ctx,
+		p.opts.KargoClient,
+		c,
+		p.opts.ClusterExecAuthEnabled,
+	)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Wrapf(err, "error configuring connection to Cluster %q", clusterName),
+		)
+	}
+	workloadClient, err := p.newClientFn(restCfg)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeUnavailable,
+			errors.Wrapf(err, "error connecting to Cluster %q", clusterName),
+		)
+	}
+	return workloadClient, nil
+}
+
+// newWorkloadClient returns a client, for the cluster described by the
+// provided rest.Config, whose scheme includes the apps/v1 API.
+func newWorkloadClient(cfg *rest.Config) (client.Client, error) {
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return client.New(cfg, client.Options{Scheme: scheme})
+}
+
+// deploymentHealth assesses the health of the provided Deployment in the same
+// manner as `kubectl rollout status`.
+func deploymentHealth(d *appsv1.Deployment) (kargoapi.HealthState, string) {
+	if d.Status.ObservedGeneration < d.Generation {
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf("waiting for the latest spec of Deployment %q to be observed", d.Name)
+	}
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing &&
+			cond.Status == corev1.ConditionFalse &&
+			cond.Reason == "ProgressDeadlineExceeded" {
+			return kargoapi.HealthStateUnhealthy,
+				fmt.Sprintf("Deployment %q exceeded its progress deadline", d.Name)
+		}
+	}
+	replicas := replicasOrDefault(d.Spec.Replicas)
+	switch {
+	case d.Status.UpdatedReplicas < replicas:
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf(
+				"%d of %d replicas of Deployment %q have been updated",
+				d.Status.UpdatedReplicas,
+				replicas,
+				d.Name,
+			)
+	case d.Status.Replicas > d.Status.UpdatedReplicas:
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf(
+				"%d old replicas of Deployment %q are pending termination",
+				d.Status.Replicas-d.Status.UpdatedReplicas,
+				d.Name,
+			)
+	case d.Status.AvailableReplicas < d.Status.UpdatedReplicas:
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf(
+				"%d of %d updated replicas of Deployment %q are available",
+				d.Status.AvailableReplicas,
+				d.Status.UpdatedReplicas,
+				d.Name,
+			)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+// statefulSetHealth assesses the health of the provided StatefulSet in the same
+// manner as `kubectl rollout status`.
+func statefulSetHealth(s *appsv1.StatefulSet) (kargoapi.HealthState, string) {
+	if s.Status.ObservedGeneration < s.Generation {
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf("waiting for the latest spec of StatefulSet %q to be observed", s.Name)
+	}
+	replicas := replicasOrDefault(s.Spec.Replicas)
+	if s.Status.ReadyReplicas < replicas {
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf(
+				"%d of %d replicas of StatefulSet %q are ready",
+				s.Status.ReadyReplicas,
+				replicas,
+				s.Name,
+			)
+	}
+	if s.Spec.UpdateStrategy.Type != appsv1.RollingUpdateStatefulSetStrategyType {
+		// Rollouts of StatefulSets using the OnDelete strategy are driven
+		// manually, so there is nothing else to wait for
+		return kargoapi.HealthStateHealthy, ""
+	}
+	if ru := s.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		// Only replicas with an ordinal greater than or equal to the partition are
+		// updated
+		if expected := replicas - *ru.Partition; s.Status.UpdatedReplicas < expected {
+			return kargoapi.HealthStateProgressing,
+				fmt.Sprintf(
+					"%d of %d replicas of StatefulSet %q have been updated",
+					s.Status.UpdatedReplicas,
+					expected,
+					s.Name,
+				)
+		}
+		return kargoapi.HealthStateHealthy, ""
+	}
+	if s.Status.UpdateRevision != s.Status.CurrentRevision {
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf(
+				"%d of %d replicas of StatefulSet %q have been updated",
+				s.Status.UpdatedReplicas,
+				replicas,
+				s.Name,
+			)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
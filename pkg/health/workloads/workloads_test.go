@@ -0,0 +1,404 @@
+package workloads
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	healthv1alpha1 "github.com/akuity/kargo/pkg/api/health/v1alpha1"
+)
+
+func TestCheckHealth(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(scheme))
+
+	testParams := map[string]string{
+		ParameterNamespace: "fake-namespace",
+		ParameterSelector:  "app=fake-app",
+	}
+	testLabels := map[string]string{"app": "fake-app"}
+
+	testCases := []struct {
+		name       string
+		params     map[string]string
+		objects    []client.Object
+		assertions func(*healthv1alpha1.CheckHealthResponse, error)
+	}{
+		{
+			name:   "namespace not specified",
+			params: map[string]string{ParameterSelector: "app=fake-app"},
+			assertions: func(_ *healthv1alpha1.CheckHealthResponse, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "namespace parameter should not be empty")
+			},
+		},
+		{
+			name:   "selector not specified",
+			params: map[string]string{ParameterNamespace: "fake-namespace"},
+			assertions: func(_ *healthv1alpha1.CheckHealthResponse, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "selector parameter should not be empty")
+			},
+		},
+		{
+			name:   "no matching workloads",
+			params: testParams,
+			objects: []client.Object{
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other-app",
+						Namespace: "fake-namespace",
+						Labels:    map[string]string{"app": "other-app"},
+					},
+				},
+			},
+			assertions: func(res *healthv1alpha1.CheckHealthResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, string(kargoapi.HealthStateUnknown), res.GetStatus())
+				require.Len(t, res.GetIssues(), 1)
+			},
+		},
+		{
+			name:   "rolling out",
+			params: testParams,
+			objects: []client.Object{
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-deployment",
+						Namespace: "fake-namespace",
+						Labels:    testLabels,
+					},
+					Spec: appsv1.DeploymentSpec{Replicas: pointer.Int32(3)},
+					Status: appsv1.DeploymentStatus{
+						Replicas:          3,
+						UpdatedReplicas:   3,
+						AvailableReplicas: 3,
+					},
+				},
+				&appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-statefulset",
+						Namespace: "fake-namespace",
+						Labels:    testLabels,
+					},
+					Spec: appsv1.StatefulSetSpec{
+						Replicas: pointer.Int32(2),
+						UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+							Type: appsv1.RollingUpdateStatefulSetStrategyType,
+						},
+					},
+					Status: appsv1.StatefulSetStatus{
+						ReadyReplicas:   2,
+						UpdatedReplicas: 1,
+						CurrentRevision: "old",
+						UpdateRevision:  "new",
+					},
+				},
+			},
+			assertions: func(res *healthv1alpha1.CheckHealthResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, string(kargoapi.HealthStateProgressing), res.GetStatus())
+				require.Equal(
+					t,
+					[]string{`1 of 2 replicas of StatefulSet "fake-statefulset" have been updated`},
+					res.GetIssues(),
+				)
+			},
+		},
+		{
+			name:   "healthy",
+			params: testParams,
+			objects: []client.Object{
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-deployment",
+						Namespace: "fake-namespace",
+						Labels:    testLabels,
+					},
+					Status: appsv1.DeploymentStatus{
+						Replicas:          1,
+						UpdatedReplicas:   1,
+						AvailableReplicas: 1,
+					},
+				},
+			},
+			assertions: func(res *healthv1alpha1.CheckHealthResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, string(kargoapi.HealthStateHealthy), res.GetStatus())
+				require.Empty(t, res.GetIssues())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			p := NewProvider(
+				fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+				ProviderOptions{},
+			)
+			testCase.assertions(
+				p.CheckHealth(
+					context.Background(),
+					&healthv1alpha1.CheckHealthRequest{Parameters: testCase.params},
+				),
+			)
+		})
+	}
+}
+
+func TestDeploymentHealth(t *testing.T) {
+	testCases := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		expected   kargoapi.HealthState
+	}{
+		{
+			name: "spec not yet observed",
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "progress deadline exceeded",
+			deployment: &appsv1.Deployment{
+				Status: appsv1.DeploymentStatus{
+					Conditions: []appsv1.DeploymentCondition{
+						{
+							Type:   appsv1.DeploymentProgressing,
+							Status: corev1.ConditionFalse,
+							Reason: "ProgressDeadlineExceeded",
+						},
+					},
+				},
+			},
+			expected: kargoapi.HealthStateUnhealthy,
+		},
+		{
+			name: "old replicas pending termination",
+			deployment: &appsv1.Deployment{
+				Status: appsv1.DeploymentStatus{
+					Replicas:          2,
+					UpdatedReplicas:   1,
+					AvailableReplicas: 1,
+				},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "updated replicas not yet available",
+			deployment: &appsv1.Deployment{
+				Status: appsv1.DeploymentStatus{
+					Replicas:        1,
+					UpdatedReplicas: 1,
+				},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "scaled to zero",
+			deployment: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{Replicas: pointer.Int32(0)},
+			},
+			expected: kargoapi.HealthStateHealthy,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			state, _ := deploymentHealth(testCase.deployment)
+			require.Equal(t, testCase.expected, state)
+		})
+	}
+}
+
+func TestStatefulSetHealth(t *testing.T) {
+	testCases := []struct {
+		name        string
+		statefulSet *appsv1.StatefulSet
+		expected    kargoapi.HealthState
+	}{
+		{
+			name: "replicas not ready",
+			statefulSet: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{Replicas: pointer.Int32(2)},
+				Status: appsv1.StatefulSetStatus{
+					ReadyReplicas: 1,
+				},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "OnDelete strategy",
+			statefulSet: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type: appsv1.OnDeleteStatefulSetStrategyType,
+					},
+				},
+				Status: appsv1.StatefulSetStatus{
+					ReadyReplicas:   1,
+					CurrentRevision: "old",
+					UpdateRevision:  "new",
+				},
+			},
+			expected: kargoapi.HealthStateHealthy,
+		},
+		{
+			name: "partitioned rolling update complete",
+			statefulSet: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: pointer.Int32(3),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type: appsv1.RollingUpdateStatefulSetStrategyType,
+						RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+							Partition: pointer.Int32(2),
+						},
+					},
+				},
+				Status: appsv1.StatefulSetStatus{
+					ReadyReplicas:   3,
+					UpdatedReplicas: 1,
+					CurrentRevision: "old",
+					UpdateRevision:  "new",
+				},
+			},
+			expected: kargoapi.HealthStateHealthy,
+		},
+		{
+			name: "rolling update complete",
+			statefulSet: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type: appsv1.RollingUpdateStatefulSetStrategyType,
+					},
+				},
+				Status: appsv1.StatefulSetStatus{
+					ReadyReplicas:   1,
+					UpdatedReplicas: 1,
+					CurrentRevision: "new",
+					UpdateRevision:  "new",
+				},
+			},
+			expected: kargoapi.HealthStateHealthy,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			state, _ := statefulSetHealth(testCase.statefulSet)
+			require.Equal(t, testCase.expected, state)
+		})
+	}
+}
+
+func TestGetWorkloadClient(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	localClient := fake.NewClientBuilder().Build()
+	remoteClient := fake.NewClientBuilder().Build()
+	kargoClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&kargoapi.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-cluster",
+					Namespace: "fake-project",
+				},
+				Spec: &kargoapi.ClusterSpec{
+					Server: "https://fake-server",
+					Auth: kargoapi.ClusterAuth{
+						SecretName: "fake-secret",
+					},
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-secret",
+					Namespace: "fake-project",
+				},
+				Data: map[string][]byte{"token": []byte("fake-token")},
+			},
+		).
+		Build()
+
+	testCases := []struct {
+		name       string
+		opts       ProviderOptions
+		params     map[string]string
+		assertions func(client.Client, error)
+	}{
+		{
+			name:   "cluster not specified",
+			params: map[string]string{},
+			assertions: func(c client.Client, err error) {
+				require.NoError(t, err)
+				require.Same(t, localClient, c)
+			},
+		},
+		{
+			name:   "clusters not supported",
+			params: map[string]string{ParameterCluster: "fake-cluster"},
+			assertions: func(_ client.Client, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name:   "cluster not found",
+			opts:   ProviderOptions{KargoClient: kargoClient},
+			params: map[string]string{ParameterCluster: "missing-cluster"},
+			assertions: func(_ client.Client, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name:   "cluster found",
+			opts:   ProviderOptions{KargoClient: kargoClient},
+			params: map[string]string{ParameterCluster: "fake-cluster"},
+			assertions: func(c client.Client, err error) {
+				require.NoError(t, err)
+				require.Same(t, remoteClient, c)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			p := &provider{
+				client: localClient,
+				opts:   testCase.opts,
+				newClientFn: func(cfg *rest.Config) (client.Client, error) {
+					require.Equal(t, "https://fake-server", cfg.Host)
+					require.Equal(t, "fake-token", cfg.BearerToken)
+					return remoteClient, nil
+				},
+			}
+			testCase.assertions(
+				p.getWorkloadClient(
+					context.Background(),
+					&healthv1alpha1.CheckHealthRequest{
+						Namespace:  "fake-project",
+						Parameters: testCase.params,
+					},
+				),
+			)
+		})
+	}
+}
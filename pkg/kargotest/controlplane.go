@@ -0,0 +1,92 @@
+package kargotest
+
+import (
+	"context"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kuberuntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// ControlPlane is a Kubernetes control plane -- an API server backed by etcd --
+// with Kargo's CRDs installed. No controllers run against it, so tests are in
+// full control of the status of every resource.
+type ControlPlane struct {
+	// Config is the configuration for connecting to the API server.
+	Config *rest.Config
+	// Client is a client for the API server whose scheme includes Kargo's
+	// types.
+	Client client.Client
+	t      testing.TB
+}
+
+// StartControlPlane starts a ControlPlane that is stopped when the test
+// completes. The kube-apiserver and etcd binaries are located in the same
+// manner as by envtest; i.e. the KUBEBUILDER_ASSETS environment variable,
+// if set, must specify the directory containing them.
+func StartControlPlane(t testing.TB) *ControlPlane {
+	t.Helper()
+	scheme := kuberuntime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("error adding Kubernetes types to scheme: %s", err)
+	}
+	if err := kargoapi.AddToScheme(scheme); err != nil {
+		t.Fatalf("error adding Kargo types to scheme: %s", err)
+	}
+	env := &envtest.Environment{
+		Scheme:                scheme,
+		CRDDirectoryPaths:     []string{crdDirectory()},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		t.Fatalf("error starting control plane: %s", err)
+	}
+	t.Cleanup(func() {
+		if err := env.Stop(); err != nil {
+			t.Errorf("error stopping control plane: %s", err)
+		}
+	})
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+	return &ControlPlane{
+		Config: cfg,
+		Client: c,
+		t:      t,
+	}
+}
+
+// CreateProject creates a namespace with the specified name that is labeled
+// as a Kargo project.
+func (c *ControlPlane) CreateProject(ctx context.Context, name string) {
+	c.t.Helper()
+	if err := c.Client.Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				kargoapi.LabelProjectKey: kargoapi.LabelTrueValue,
+			},
+		},
+	}); err != nil {
+		c.t.Fatalf("error creating project %q: %s", name, err)
+	}
+}
+
+// crdDirectory returns the path to the directory containing Kargo's CRDs. The
+// CRDs are shipped with this module's source, which is always present wherever
+// this package is compiled, so their location relative to this file is stable.
+func crdDirectory() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "charts", "kargo", "crds")
+}
@@ -0,0 +1,39 @@
+package kargotest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestStartControlPlane(t *testing.T) {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set")
+	}
+	ctx := context.Background()
+	cp := StartControlPlane(t)
+	cp.CreateProject(ctx, "fake-project")
+
+	warehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-warehouse",
+		},
+		Spec: &kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{{
+				Image: &kargoapi.ImageSubscription{
+					RepoURL: "example/app",
+				},
+			}},
+		},
+	}
+	require.NoError(t, cp.Client.Create(ctx, warehouse))
+	require.NoError(t, cp.Client.Get(ctx, client.ObjectKeyFromObject(warehouse), warehouse))
+	require.Equal(t, "example/app", warehouse.Spec.Subscriptions[0].Image.RepoURL)
+}
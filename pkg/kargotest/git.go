@@ -0,0 +1,130 @@
+package kargotest
+
+import (
+	"fmt"
+	"net/http/cgi"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	libExec "github.com/akuity/kargo/internal/exec"
+)
+
+// GitServer is an in-process git server that serves bare repositories over
+// HTTP using git's own smart HTTP implementation. It requires the git binary
+// to be installed.
+type GitServer struct {
+	// URL is the base URL of the server. The URL of a repository is this URL
+	// followed by the repository's name.
+	URL string
+	t   testing.TB
+	dir string
+}
+
+// GitServerOptions represents optional configuration for a GitServer.
+type GitServerOptions struct {
+	// Credentials, if non-nil, are required of all clients.
+	Credentials *Credentials
+}
+
+// NewGitServer starts a GitServer that is stopped, and whose repositories are
+// deleted, when the test completes.
+func NewGitServer(t testing.TB, opts *GitServerOptions) *GitServer {
+	t.Helper()
+	if opts == nil {
+		opts = &GitServerOptions{}
+	}
+	gitPath, err := exec.LookPath("git")
+	if err != nil {
+		t.Fatalf("error locating git binary: %s", err)
+	}
+	dir := t.TempDir()
+	server := httptest.NewServer(withBasicAuth(opts.Credentials, &cgi.Handler{
+		Path: gitPath,
+		Args: []string{"http-backend"},
+		Env: []string{
+			"GIT_PROJECT_ROOT=" + dir,
+			"GIT_HTTP_EXPORT_ALL=1",
+		},
+	}))
+	t.Cleanup(server.Close)
+	return &GitServer{
+		URL: server.URL,
+		t:   t,
+		dir: dir,
+	}
+}
+
+// NewRepository creates an empty repository with the specified name, whose
+// default branch is main, and returns its URL.
+func (g *GitServer) NewRepository(name string) string {
+	g.t.Helper()
+	repoDir := filepath.Join(g.dir, name)
+	g.git("", "init", "--bare", repoDir)
+	g.git(repoDir, "symbolic-ref", "HEAD", "refs/heads/main")
+	// Permit pushes from unauthenticated clients
+	g.git(repoDir, "config", "http.receivepack", "true")
+	return g.RepositoryURL(name)
+}
+
+// RepositoryURL returns the URL of the repository with the specified name.
+func (g *GitServer) RepositoryURL(name string) string {
+	return fmt.Sprintf("%s/%s", g.URL, name)
+}
+
+// Commit commits the provided files, keyed by their paths relative to the root
+// of the repository, to the specified branch of the repository with the
+// specified name and returns the ID of the new commit. The branch is created
+// from the default branch if it does not already exist. Files that already
+// exist in the branch, but are not provided, are left unchanged.
+func (g *GitServer) Commit(
+	name string,
+	branch string,
+	files map[string]string,
+	message string,
+) string {
+	g.t.Helper()
+	workDir := g.t.TempDir()
+	g.git("", "clone", "--quiet", filepath.Join(g.dir, name), workDir)
+	if g.git(workDir, "ls-remote", "--heads", "origin", branch) != "" {
+		g.git(workDir, "checkout", "--quiet", branch)
+	} else {
+		g.git(workDir, "checkout", "--quiet", "-b", branch)
+	}
+	for path, content := range files {
+		absPath := filepath.Join(workDir, path)
+		if err := os.MkdirAll(filepath.Dir(absPath), 0o755); err != nil {
+			g.t.Fatalf("error creating directory for %q: %s", path, err)
+		}
+		if err := os.WriteFile(absPath, []byte(content), 0o600); err != nil {
+			g.t.Fatalf("error writing %q: %s", path, err)
+		}
+	}
+	g.git(workDir, "add", ".")
+	g.git(
+		workDir,
+		"-c", "user.name=kargotest",
+		"-c", "user.email=kargotest@example.com",
+		"commit", "--quiet", "--allow-empty", "--message", message,
+	)
+	g.git(workDir, "push", "--quiet", "origin", branch)
+	return g.git(workDir, "rev-parse", "HEAD")
+}
+
+// git executes git with the provided arguments in the specified directory and
+// returns its trimmed output. The test fails if git exits with an error.
+func (g *GitServer) git(dir string, args ...string) string {
+	g.t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	// Insulate the command from the configuration of the user running the tests
+	cmd.Env = append(os.Environ(), "GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_NOSYSTEM=1")
+	res, err := libExec.Exec(cmd)
+	if err != nil {
+		g.t.Fatalf("%s", err)
+	}
+	return strings.TrimSpace(string(res))
+}
@@ -0,0 +1,65 @@
+package kargotest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/controller/git"
+)
+
+func TestGitServer(t *testing.T) {
+	creds := &Credentials{
+		Username: "fake-user",
+		Password: "fake-password",
+	}
+	server := NewGitServer(t, &GitServerOptions{Credentials: creds})
+	repoURL := server.NewRepository("example.git")
+	commitID := server.Commit(
+		"example.git",
+		"main",
+		map[string]string{"base/values.yaml": "replicas: 1\n"},
+		"initial commit",
+	)
+	require.NotEmpty(t, commitID)
+
+	require.Error(t, git.CheckAccess(repoURL, git.RepoCredentials{}))
+
+	repo, err := git.Clone(repoURL, git.RepoCredentials{
+		Username: creds.Username,
+		Password: creds.Password,
+	})
+	require.NoError(t, err)
+	defer repo.Close()
+	lastCommitID, err := repo.LastCommitID()
+	require.NoError(t, err)
+	require.Equal(t, commitID, lastCommitID)
+
+	// Changes pushed by clients are visible to subsequent commits
+	require.NoError(t, repo.CreateChildBranch("stage/test"))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(repo.WorkingDir(), "manifests.yaml"),
+		[]byte("kind: Deployment\n"),
+		0o600,
+	))
+	require.NoError(t, repo.AddAllAndCommit("render manifests"))
+	require.NoError(t, repo.Push())
+	childCommitID, err := repo.LastCommitID()
+	require.NoError(t, err)
+
+	commitID = server.Commit(
+		"example.git",
+		"stage/test",
+		map[string]string{"README.md": "# example\n"},
+		"add readme",
+	)
+	require.NotEqual(t, childCommitID, commitID)
+	conflicts, err := repo.PullRebase()
+	require.NoError(t, err)
+	require.Empty(t, conflicts)
+	lastCommitID, err = repo.LastCommitID()
+	require.NoError(t, err)
+	require.Equal(t, commitID, lastCommitID)
+}
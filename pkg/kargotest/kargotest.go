@@ -0,0 +1,35 @@
+// Package kargotest provides in-process fakes of the systems that Kargo
+// interacts with -- git servers, OCI registries, and a Kubernetes control plane
+// with Kargo's CRDs installed -- so that custom verification providers, health
+// checks, and other integrations can be tested against realistic behavior
+// without any external infrastructure.
+package kargotest
+
+import (
+	"net/http"
+)
+
+// Credentials are a username and password that clients of a fake server must
+// present, using HTTP basic auth, to be granted access.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// withBasicAuth returns an http.Handler that delegates to the provided handler
+// only when requests present the specified credentials. When creds is nil, all
+// requests are delegated.
+func withBasicAuth(creds *Credentials, next http.Handler) http.Handler {
+	if creds == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != creds.Username || password != creds.Password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="kargotest"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,454 @@
+package kargotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// Registry is an in-process, in-memory OCI registry implementing the parts of
+// the OCI distribution API that are used to push, pull, and list the tags of
+// images and charts.
+type Registry struct {
+	// Host is the host and port at which the registry is listening. References
+	// to images in the registry are this host followed by the name of a
+	// repository; e.g. Host + "/example/app:v1.0.0".
+	Host string
+	// URL is the base URL of the registry.
+	URL string
+
+	mu           sync.Mutex
+	blobs        map[digest.Digest][]byte
+	repositories map[string]*registryRepository
+	uploads      map[string]*bytes.Buffer
+	nextUploadID int
+}
+
+type registryRepository struct {
+	manifests map[digest.Digest]registryManifest
+	tags      map[string]digest.Digest
+}
+
+type registryManifest struct {
+	mediaType string
+	content   []byte
+}
+
+// RegistryOptions represents optional configuration for a Registry.
+type RegistryOptions struct {
+	// Credentials, if non-nil, are required of all clients.
+	Credentials *Credentials
+}
+
+// NewRegistry starts a Registry that is stopped when the test completes.
+func NewRegistry(t testing.TB, opts *RegistryOptions) *Registry {
+	t.Helper()
+	if opts == nil {
+		opts = &RegistryOptions{}
+	}
+	r := &Registry{
+		blobs:        map[digest.Digest][]byte{},
+		repositories: map[string]*registryRepository{},
+		uploads:      map[string]*bytes.Buffer{},
+	}
+	server := httptest.NewServer(withBasicAuth(opts.Credentials, r))
+	t.Cleanup(server.Close)
+	r.URL = server.URL
+	r.Host = strings.TrimPrefix(server.URL, "http://")
+	return r
+}
+
+// PushBlob stores the provided content and returns its digest.
+func (r *Registry) PushBlob(content []byte) digest.Digest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d := digest.FromBytes(content)
+	r.blobs[d] = content
+	return d
+}
+
+// PushManifest stores the provided manifest, of the specified media type, in
+// the repository with the specified name and returns its digest. If tag is
+// non-empty, the manifest is also tagged with it.
+func (r *Registry) PushManifest(
+	repository string,
+	tag string,
+	mediaType string,
+	content []byte,
+) digest.Digest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.putManifest(repository, tag, mediaType, content)
+}
+
+// PushImage stores a minimal single-layer image in the repository with the
+// specified name, tagged with each of the specified tags, and returns the
+// digest of its manifest. Every image pushed to a repository is unique, so
+// pushing the same tag again moves the tag to a new digest.
+func (r *Registry) PushImage(repository string, tags ...string) digest.Digest {
+	r.mu.Lock()
+	count := len(r.repository(repository).manifests)
+	r.mu.Unlock()
+	config := r.PushBlob([]byte(fmt.Sprintf(
+		`{"architecture":"amd64","os":"linux","config":{"Labels":{"kargotest/index":"%d"}}}`,
+		count,
+	)))
+	layer := r.PushBlob([]byte(fmt.Sprintf("%s#%d", repository, count)))
+	manifest, _ := json.Marshal(ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config: ocispec.Descriptor{
+			MediaType: ocispec.MediaTypeImageConfig,
+			Digest:    config,
+			Size:      int64(len(r.blob(config))),
+		},
+		Layers: []ocispec.Descriptor{{
+			MediaType: ocispec.MediaTypeImageLayer,
+			Digest:    layer,
+			Size:      int64(len(r.blob(layer))),
+		}},
+	})
+	var d digest.Digest
+	for _, tag := range tags {
+		d = r.PushManifest(repository, tag, ocispec.MediaTypeImageManifest, manifest)
+	}
+	if d == "" {
+		d = r.PushManifest(repository, "", ocispec.MediaTypeImageManifest, manifest)
+	}
+	return d
+}
+
+// Tags returns the sorted tags of the repository with the specified name.
+func (r *Registry) Tags(repository string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.sortedTags(repository)
+}
+
+// Manifest returns the media type and content of the manifest in the
+// repository with the specified name that is identified by the specified tag
+// or digest. ok is false if there is no such manifest.
+func (r *Registry) Manifest(
+	repository string,
+	reference string,
+) (mediaType string, content []byte, ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m, ok := r.manifest(repository, reference)
+	return m.mediaType, m.content, ok
+}
+
+// ServeHTTP implements http.Handler.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	path := req.URL.Path
+	if path == "/v2/" || path == "/v2" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if !strings.HasPrefix(path, "/v2/") {
+		writeRegistryError(w, http.StatusNotFound, "NAME_UNKNOWN", "not found")
+		return
+	}
+	path = strings.TrimPrefix(path, "/v2/")
+	switch {
+	case strings.HasSuffix(path, "/tags/list"):
+		r.serveTags(w, req, strings.TrimSuffix(path, "/tags/list"))
+	case strings.Contains(path, "/manifests/"):
+		i := strings.LastIndex(path, "/manifests/")
+		r.serveManifest(w, req, path[:i], path[i+len("/manifests/"):])
+	case strings.Contains(path, "/blobs/uploads"):
+		i := strings.LastIndex(path, "/blobs/uploads")
+		id := strings.TrimPrefix(path[i+len("/blobs/uploads"):], "/")
+		r.serveUpload(w, req, path[:i], id)
+	case strings.Contains(path, "/blobs/"):
+		i := strings.LastIndex(path, "/blobs/")
+		r.serveBlob(w, req, digest.Digest(path[i+len("/blobs/"):]))
+	default:
+		writeRegistryError(w, http.StatusNotFound, "NAME_UNKNOWN", "not found")
+	}
+}
+
+func (r *Registry) serveTags(w http.ResponseWriter, req *http.Request, repository string) {
+	if req.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if _, ok := r.repositories[repository]; !ok {
+		writeRegistryError(w, http.StatusNotFound, "NAME_UNKNOWN", "repository not found")
+		return
+	}
+	tags := r.sortedTags(repository)
+	// Support pagination as described by the distribution spec
+	if last := req.URL.Query().Get("last"); last != "" {
+		i := sort.SearchStrings(tags, last)
+		if i < len(tags) && tags[i] == last {
+			i++
+		}
+		tags = tags[i:]
+	}
+	if n, err := strconv.Atoi(req.URL.Query().Get("n")); err == nil && n >= 0 && n < len(tags) {
+		tags = tags[:n]
+		next := url.URL{
+			Path:     req.URL.Path,
+			RawQuery: url.Values{"n": {strconv.Itoa(n)}, "last": {tags[len(tags)-1]}}.Encode(),
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next.String()))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(struct {
+		Name string   `json:"name"`
+		Tags []string `json:"tags"`
+	}{
+		Name: repository,
+		Tags: tags,
+	})
+}
+
+func (r *Registry) serveManifest(
+	w http.ResponseWriter,
+	req *http.Request,
+	repository string,
+	reference string,
+) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		m, ok := r.manifest(repository, reference)
+		if !ok {
+			writeRegistryError(w, http.StatusNotFound, "MANIFEST_UNKNOWN", "manifest unknown")
+			return
+		}
+		w.Header().Set("Content-Type", m.mediaType)
+		w.Header().Set("Docker-Content-Digest", digest.FromBytes(m.content).String())
+		w.Header().Set("Content-Length", strconv.Itoa(len(m.content)))
+		w.WriteHeader(http.StatusOK)
+		if req.Method == http.MethodGet {
+			_, _ = w.Write(m.content)
+		}
+	case http.MethodPut:
+		content, err := io.ReadAll(req.Body)
+		if err != nil {
+			writeRegistryError(w, http.StatusBadRequest, "MANIFEST_INVALID", err.Error())
+			return
+		}
+		var tag string
+		if _, err = digest.Parse(reference); err != nil {
+			tag = reference
+		} else if digest.FromBytes(content).String() != reference {
+			writeRegistryError(w, http.StatusBadRequest, "DIGEST_INVALID", "digest mismatch")
+			return
+		}
+		d := r.putManifest(repository, tag, req.Header.Get("Content-Type"), content)
+		w.Header().Set("Docker-Content-Digest", d.String())
+		w.Header().Set("Location", fmt.Sprintf("/v2/%s/manifests/%s", repository, d))
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		repo, ok := r.repositories[repository]
+		if !ok {
+			writeRegistryError(w, http.StatusNotFound, "MANIFEST_UNKNOWN", "manifest unknown")
+			return
+		}
+		if _, ok = repo.tags[reference]; ok {
+			delete(repo.tags, reference)
+		} else if _, ok = repo.manifests[digest.Digest(reference)]; ok {
+			delete(repo.manifests, digest.Digest(reference))
+			for tag, d := range repo.tags {
+				if d == digest.Digest(reference) {
+					delete(repo.tags, tag)
+				}
+			}
+		} else {
+			writeRegistryError(w, http.StatusNotFound, "MANIFEST_UNKNOWN", "manifest unknown")
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (r *Registry) serveBlob(w http.ResponseWriter, req *http.Request, d digest.Digest) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	content, ok := r.blobs[d]
+	if !ok {
+		writeRegistryError(w, http.StatusNotFound, "BLOB_UNKNOWN", "blob unknown")
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Docker-Content-Digest", d.String())
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.WriteHeader(http.StatusOK)
+	if req.Method == http.MethodGet {
+		_, _ = w.Write(content)
+	}
+}
+
+func (r *Registry) serveUpload(
+	w http.ResponseWriter,
+	req *http.Request,
+	repository string,
+	id string,
+) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		writeRegistryError(w, http.StatusBadRequest, "BLOB_UPLOAD_INVALID", err.Error())
+		return
+	}
+	if req.Method == http.MethodPost && id == "" {
+		// A digest indicates a monolithic upload. Otherwise, an upload session
+		// is started.
+		if d := req.URL.Query().Get("digest"); d != "" {
+			r.completeUpload(w, repository, d, body)
+			return
+		}
+		r.nextUploadID++
+		id = strconv.Itoa(r.nextUploadID)
+		r.uploads[id] = bytes.NewBuffer(body)
+		r.writeUploadStatus(w, repository, id, http.StatusAccepted)
+		return
+	}
+	buf, ok := r.uploads[id]
+	if !ok {
+		writeRegistryError(w, http.StatusNotFound, "BLOB_UPLOAD_UNKNOWN", "upload unknown")
+		return
+	}
+	switch req.Method {
+	case http.MethodPatch:
+		buf.Write(body)
+		r.writeUploadStatus(w, repository, id, http.StatusAccepted)
+	case http.MethodPut:
+		buf.Write(body)
+		delete(r.uploads, id)
+		r.completeUpload(w, repository, req.URL.Query().Get("digest"), buf.Bytes())
+	case http.MethodGet:
+		r.writeUploadStatus(w, repository, id, http.StatusNoContent)
+	case http.MethodDelete:
+		delete(r.uploads, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (r *Registry) completeUpload(
+	w http.ResponseWriter,
+	repository string,
+	expected string,
+	content []byte,
+) {
+	d := digest.FromBytes(content)
+	if d.String() != expected {
+		writeRegistryError(w, http.StatusBadRequest, "DIGEST_INVALID", "digest mismatch")
+		return
+	}
+	r.blobs[d] = content
+	w.Header().Set("Docker-Content-Digest", d.String())
+	w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/%s", repository, d))
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (r *Registry) writeUploadStatus(
+	w http.ResponseWriter,
+	repository string,
+	id string,
+	status int,
+) {
+	w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/uploads/%s", repository, id))
+	end := r.uploads[id].Len() - 1
+	if end < 0 {
+		end = 0
+	}
+	w.Header().Set("Range", fmt.Sprintf("0-%d", end))
+	w.Header().Set("Docker-Upload-UUID", id)
+	w.WriteHeader(status)
+}
+
+func (r *Registry) repository(name string) *registryRepository {
+	repo, ok := r.repositories[name]
+	if !ok {
+		repo = &registryRepository{
+			manifests: map[digest.Digest]registryManifest{},
+			tags:      map[string]digest.Digest{},
+		}
+		r.repositories[name] = repo
+	}
+	return repo
+}
+
+func (r *Registry) putManifest(
+	repository string,
+	tag string,
+	mediaType string,
+	content []byte,
+) digest.Digest {
+	repo := r.repository(repository)
+	d := digest.FromBytes(content)
+	repo.manifests[d] = registryManifest{
+		mediaType: mediaType,
+		content:   content,
+	}
+	if tag != "" {
+		repo.tags[tag] = d
+	}
+	return d
+}
+
+func (r *Registry) manifest(repository, reference string) (registryManifest, bool) {
+	repo, ok := r.repositories[repository]
+	if !ok {
+		return registryManifest{}, false
+	}
+	d, ok := repo.tags[reference]
+	if !ok {
+		d = digest.Digest(reference)
+	}
+	m, ok := repo.manifests[d]
+	return m, ok
+}
+
+func (r *Registry) blob(d digest.Digest) []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.blobs[d]
+}
+
+func (r *Registry) sortedTags(repository string) []string {
+	repo, ok := r.repositories[repository]
+	if !ok {
+		return nil
+	}
+	tags := make([]string, 0, len(repo.tags))
+	for tag := range repo.tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+func writeRegistryError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]string{{
+			"code":    code,
+			"message": message,
+		}},
+	})
+}
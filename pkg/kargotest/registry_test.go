@@ -0,0 +1,100 @@
+package kargotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	creds := &Credentials{
+		Username: "fake-user",
+		Password: "fake-password",
+	}
+	registry := NewRegistry(t, &RegistryOptions{Credentials: creds})
+	do := func(method, path string, body []byte) *http.Response {
+		req, err := http.NewRequest(method, registry.URL+path, bytes.NewReader(body))
+		require.NoError(t, err)
+		req.SetBasicAuth(creds.Username, creds.Password)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { res.Body.Close() })
+		return res
+	}
+
+	t.Run("credentials required", func(t *testing.T) {
+		res, err := http.Get(registry.URL + "/v2/")
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+
+	t.Run("chunked blob upload", func(t *testing.T) {
+		res := do(http.MethodPost, "/v2/example/app/blobs/uploads/", nil)
+		require.Equal(t, http.StatusAccepted, res.StatusCode)
+		location := res.Header.Get("Location")
+		res = do(http.MethodPatch, location, []byte("fake-"))
+		require.Equal(t, http.StatusAccepted, res.StatusCode)
+		blob := []byte("fake-blob")
+		res = do(
+			http.MethodPut,
+			fmt.Sprintf("%s?digest=%s", location, digest.FromBytes(blob)),
+			[]byte("blob"),
+		)
+		require.Equal(t, http.StatusCreated, res.StatusCode)
+
+		res = do(http.MethodGet, "/v2/example/app/blobs/"+digest.FromBytes(blob).String(), nil)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Equal(t, blob, body)
+	})
+
+	t.Run("pull image", func(t *testing.T) {
+		d := registry.PushImage("example/app", "v1.0.0")
+		res := do(http.MethodGet, "/v2/example/app/manifests/v1.0.0", nil)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, ocispec.MediaTypeImageManifest, res.Header.Get("Content-Type"))
+		require.Equal(t, d.String(), res.Header.Get("Docker-Content-Digest"))
+		var manifest ocispec.Manifest
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&manifest))
+		require.Len(t, manifest.Layers, 1)
+		res = do(http.MethodHead, "/v2/example/app/blobs/"+manifest.Layers[0].Digest.String(), nil)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		// Pushing the same tag again moves it
+		require.NotEqual(t, d, registry.PushImage("example/app", "v1.0.0"))
+	})
+
+	t.Run("unknown manifest", func(t *testing.T) {
+		res := do(http.MethodGet, "/v2/example/app/manifests/nonexistent", nil)
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("list tags", func(t *testing.T) {
+		registry.PushImage("example/tags", "v1.0.0", "v1.1.0", "v2.0.0")
+		require.Equal(t, []string{"v1.0.0", "v1.1.0", "v2.0.0"}, registry.Tags("example/tags"))
+
+		res := do(http.MethodGet, "/v2/example/tags/tags/list?n=2", nil)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.NotEmpty(t, res.Header.Get("Link"))
+		tagList := struct {
+			Tags []string `json:"tags"`
+		}{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&tagList))
+		require.Equal(t, []string{"v1.0.0", "v1.1.0"}, tagList.Tags)
+
+		res = do(http.MethodGet, "/v2/example/tags/tags/list?n=2&last=v1.1.0", nil)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Empty(t, res.Header.Get("Link"))
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&tagList))
+		require.Equal(t, []string{"v2.0.0"}, tagList.Tags)
+	})
+}
@@ -0,0 +1,96 @@
+// Package verification is an SDK for implementing external verification
+// providers. Kargo's Stage controller invokes registered verification providers
+// over gRPC to determine whether a Stage's current Freight satisfies a
+// Verification qualification criterion, which allows organizations to
+// implement their own checks without forking Kargo.
+package verification
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	httputil "github.com/akuity/kargo/internal/http"
+	verificationv1alpha1 "github.com/akuity/kargo/pkg/api/verification/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/verification/v1alpha1/verificationv1alpha1connect"
+)
+
+// Provider is the interface implemented by external verification providers.
+type Provider interface {
+	// Verify checks the Freight described by the request in the Stage described
+	// by the request. A Freight that does not pass the provider's checks should
+	// be reported via the response rather than via an error. Errors should be
+	// reserved for cases where the provider was unable to perform its checks.
+	Verify(
+		context.Context,
+		*verificationv1alpha1.VerifyRequest,
+	) (*verificationv1alpha1.VerifyResponse, error)
+}
+
+// ProviderFunc is an adapter that allows an ordinary function to be used as a
+// Provider.
+type ProviderFunc func(
+	context.Context,
+	*verificationv1alpha1.VerifyRequest,
+) (*verificationv1alpha1.VerifyResponse, error)
+
+// Verify implements Provider.
+func (p ProviderFunc) Verify(
+	ctx context.Context,
+	req *verificationv1alpha1.VerifyRequest,
+) (*verificationv1alpha1.VerifyResponse, error) {
+	return p(ctx, req)
+}
+
+// NewHandler returns an http.Handler that serves the provided Provider using
+// the gRPC, gRPC-Web, and Connect protocols, along with the path on which the
+// handler should be mounted.
+func NewHandler(
+	provider Provider,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return verificationv1alpha1connect.NewVerificationProviderServiceHandler(
+		&handler{provider: provider},
+		opts...,
+	)
+}
+
+// ListenAndServe serves the provided Provider on the specified address. Since
+// gRPC requires HTTP/2, HTTP/2 without TLS (h2c) is supported.
+func ListenAndServe(addr string, provider Provider) error {
+	mux := http.NewServeMux()
+	mux.Handle(NewHandler(provider))
+	return httputil.ListenAndServeH2C(addr, mux)
+}
+
+// NewClient returns a client for the verification provider served at the
+// specified base URL. The client uses the gRPC protocol. For base URLs with the
+// http scheme, HTTP/2 without TLS (h2c) is used.
+func NewClient(
+	baseURL string,
+	opts ...connect.ClientOption,
+) verificationv1alpha1connect.VerificationProviderServiceClient {
+	return verificationv1alpha1connect.NewVerificationProviderServiceClient(
+		httputil.NewH2CClient(baseURL),
+		baseURL,
+		append([]connect.ClientOption{connect.WithGRPC()}, opts...)...,
+	)
+}
+
+// handler adapts a Provider to the generated
+// VerificationProviderServiceHandler interface.
+type handler struct {
+	provider Provider
+}
+
+func (h *handler) Verify(
+	ctx context.Context,
+	req *connect.Request[verificationv1alpha1.VerifyRequest],
+) (*connect.Response[verificationv1alpha1.VerifyResponse], error) {
+	res, err := h.provider.Verify(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,101 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/akuity/kargo/pkg/api/v1alpha1"
+	verificationv1alpha1 "github.com/akuity/kargo/pkg/api/verification/v1alpha1"
+)
+
+func TestClientAndHandler(t *testing.T) {
+	testCases := []struct {
+		name       string
+		provider   ProviderFunc
+		assertions func(*connect.Response[verificationv1alpha1.VerifyResponse], error)
+	}{
+		{
+			name: "provider returns an error",
+			provider: func(
+				context.Context,
+				*verificationv1alpha1.VerifyRequest,
+			) (*verificationv1alpha1.VerifyResponse, error) {
+				return nil, connect.NewError(
+					connect.CodeUnavailable,
+					errors.New("something went wrong"),
+				)
+			},
+			assertions: func(
+				_ *connect.Response[verificationv1alpha1.VerifyResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			provider: func(
+				_ context.Context,
+				req *verificationv1alpha1.VerifyRequest,
+			) (*verificationv1alpha1.VerifyResponse, error) {
+				return &verificationv1alpha1.VerifyResponse{
+					Passed:  true,
+					Message: "verified " + req.GetFreight().GetId(),
+					Details: map[string]string{
+						"stage": req.GetNamespace() + "/" + req.GetStage(),
+						"check": req.GetParameters()["check"],
+					},
+				}, nil
+			},
+			assertions: func(
+				res *connect.Response[verificationv1alpha1.VerifyResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, res.Msg.GetPassed())
+				require.Equal(t, "verified fake-freight", res.Msg.GetMessage())
+				require.Equal(
+					t,
+					map[string]string{
+						"stage": "fake-namespace/fake-stage",
+						"check": "fake-check",
+					},
+					res.Msg.GetDetails(),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.Handle(NewHandler(testCase.provider))
+			server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+			defer server.Close()
+			testCase.assertions(
+				NewClient(server.URL).Verify(
+					context.Background(),
+					connect.NewRequest(&verificationv1alpha1.VerifyRequest{
+						Namespace: "fake-namespace",
+						Stage:     "fake-stage",
+						Freight: &v1alpha1.SimpleFreight{
+							Id: "fake-freight",
+						},
+						Parameters: map[string]string{
+							"check": "fake-check",
+						},
+					}),
+				),
+			)
+		})
+	}
+}
@@ -1,4 +1,5 @@
-import { Timestamp } from '@bufbuild/protobuf';
+import { createPromiseClient } from '@bufbuild/connect';
+import { PartialMessage, Timestamp } from '@bufbuild/protobuf';
 import { faDocker, faGit } from '@fortawesome/free-brands-svg-icons';
 import { IconDefinition, faTimeline } from '@fortawesome/free-solid-svg-icons';
 import { FontAwesomeIcon } from '@fortawesome/react-fontawesome';
@@ -7,12 +8,14 @@ import { Tooltip, message } from 'antd';
 import { formatDistance } from 'date-fns';
 import React, { useContext, useEffect, useState } from 'react';
 
+import { transport } from '@ui/config/transport';
 import { ColorContext } from '@ui/context/colors';
 import {
   promoteStage,
-  promoteSubscribers,
   queryFreight
 } from '@ui/gen/service/v1alpha1/service-KargoService_connectquery';
+import { KargoService } from '@ui/gen/service/v1alpha1/service_connect';
+import { PromoteSubscribersRequest } from '@ui/gen/service/v1alpha1/service_pb';
 import { Freight, GitCommit, Stage } from '@ui/gen/v1alpha1/types_pb';
 
 export type PromotionType = 'default' | 'subscribers';
@@ -54,18 +57,29 @@ export const Freightline = (props: {
     isLoading
   } = useQuery(queryFreight.useQuery({ project, stage: promotingStage?.metadata?.name || '' }));
 
-  const { mutate: promoteSubscribersAction } = useMutation({
-    ...promoteSubscribers.useMutation(),
-    onError: (err) => {
-      message.error(err?.toString());
-    },
-    onSuccess: () => {
-      message.success(
-        `All subscribers of "${promotingStage?.metadata?.name}" stage have been promoted.`
-      );
+  // PromoteSubscribers streams progress one subscriber at a time, which is shown
+  // to the user until all subscribers have been promoted.
+  const promoteSubscribersAction = async (req: PartialMessage<PromoteSubscribersRequest>) => {
+    const key = `promote-subscribers-${req.stage}`;
+    const promiseClient = createPromiseClient(KargoService, transport);
+    try {
+      for await (const res of promiseClient.promoteSubscribers(req)) {
+        const progress = `${res.completed}/${res.total}`;
+        message.loading({
+          key,
+          content: `Promoting subscribers of "${req.stage}" stage (${progress})...`,
+          duration: 0
+        });
+      }
+      message.success({
+        key,
+        content: `All subscribers of "${req.stage}" stage have been promoted.`
+      });
       setPromotingStage(undefined);
+    } catch (err) {
+      message.error({ key, content: err?.toString() });
     }
-  });
+  };
 
   const { mutate: promoteAction } = useMutation({
     ...promoteStage.useMutation(),
@@ -362,7 +376,7 @@ const FreightItem = (props: {
             }
             placement='bottom'
           >
-            {freight?.metadata?.name?.substring(0, 7)}
+            {freight?.alias || freight?.metadata?.name?.substring(0, 7)}
           </Tooltip>
         </div>
       </div>
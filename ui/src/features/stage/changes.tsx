@@ -0,0 +1,98 @@
+import { useQuery } from '@tanstack/react-query';
+import { Empty, Table, Tag, Typography } from 'antd';
+import { ColumnsType } from 'antd/es/table';
+
+import { LoadingState } from '@ui/features/common';
+import { diffFreight } from '@ui/gen/service/v1alpha1/service-KargoService_connectquery';
+import { FileDiff } from '@ui/gen/service/v1alpha1/service_pb';
+import { Stage } from '@ui/gen/v1alpha1/types_pb';
+
+const statusColors: { [key: string]: string } = {
+  Added: 'green',
+  Deleted: 'red',
+  Modified: 'gold',
+  TypeChanged: 'gold'
+};
+
+const columns: ColumnsType<FileDiff> = [
+  {
+    title: 'Status',
+    width: 120,
+    render: (_, file) => <Tag color={statusColors[file.status]}>{file.status}</Tag>
+  },
+  {
+    title: 'Path',
+    dataIndex: 'path'
+  },
+  {
+    title: 'Changes',
+    width: 160,
+    render: (_, file) =>
+      file.binary ? (
+        <Typography.Text type='secondary'>binary</Typography.Text>
+      ) : (
+        <>
+          <Typography.Text type='success'>+{file.additions}</Typography.Text>{' '}
+          <Typography.Text type='danger'>-{file.deletions}</Typography.Text>
+        </>
+      )
+  }
+];
+
+const RepoChanges = (props: { project?: string; from: string; to: string; repoUrl: string }) => {
+  const { data, isLoading } = useQuery(diffFreight.useQuery(props));
+
+  if (isLoading) {
+    return <LoadingState />;
+  }
+
+  return (
+    <div className='mb-6'>
+      <Typography.Title level={5}>
+        {props.repoUrl}{' '}
+        <Typography.Text type='secondary' style={{ fontWeight: 'normal' }}>
+          {data?.fromCommit.substring(0, 7)}..{data?.toCommit.substring(0, 7)}
+        </Typography.Text>
+      </Typography.Title>
+      <Table
+        columns={columns}
+        dataSource={data?.files}
+        size='small'
+        pagination={false}
+        rowKey={(file) => file.path}
+        locale={{ emptyText: 'No changes' }}
+      />
+    </div>
+  );
+};
+
+// Changes summarizes the changes to each Git repository between the Freight
+// currently deployed to a Stage and the Freight that was deployed before it.
+export const Changes = ({ stage, project }: { stage: Stage; project?: string }) => {
+  const [current, previous] = stage.status?.history || [];
+  if (!current || !previous) {
+    return <Empty description='No previous Freight to compare with' />;
+  }
+
+  const previousRepos = new Set(previous.commits.map((commit) => commit.repoUrl));
+  const repoUrls = current.commits
+    .map((commit) => commit.repoUrl)
+    .filter((repoUrl) => previousRepos.has(repoUrl));
+  if (repoUrls.length === 0) {
+    return <Empty description='No Git repositories in common with the previous Freight' />;
+  }
+
+  return (
+    <>
+      {repoUrls.map((repoUrl) => (
+        <RepoChanges
+          key={repoUrl}
+          project={project}
+          from={previous.id}
+          to={current.id}
+          repoUrl={repoUrl}
+        />
+      ))}
+    </>
+  );
+};
@@ -6,6 +6,7 @@ import { HealthStatusIcon } from '@ui/features/common/health-status/health-statu
 import { Subscriptions } from '@ui/features/stage/subscriptions';
 import { Stage } from '@ui/gen/v1alpha1/types_pb';
 
+import { Changes } from './changes';
 import { ManifestPreview } from './manifest-preview';
 import { Promotions } from './promotions';
 import { StageActions } from './stage-actions';
@@ -51,6 +52,11 @@ export const StageDetails = ({ stage }: { stage: Stage }) => {
                 },
                 {
                   key: '2',
+                  label: 'Changes',
+                  children: <Changes stage={stage} project={projectName} />
+                },
+                {
+                  key: '3',
                   label: 'Live Manifest',
                   className: 'h-full pb-2',
                   children: <ManifestPreview stage={stage} />
@@ -0,0 +1,27 @@
+// @generated by protoc-gen-connect-query v0.4.1 with parameter "target=ts"
+// @generated from file health/v1alpha1/health.proto (package akuity.io.kargo.health.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { createQueryService } from "@bufbuild/connect-query";
+import { MethodKind } from "@bufbuild/protobuf";
+import { CheckHealthRequest, CheckHealthResponse } from "./health_pb.js";
+
+export const typeName = "akuity.io.kargo.health.v1alpha1.HealthProviderService";
+
+/**
+ * @generated from rpc akuity.io.kargo.health.v1alpha1.HealthProviderService.CheckHealth
+ */
+export const checkHealth = createQueryService({
+  service: {
+    methods: {
+      checkHealth: {
+        name: "CheckHealth",
+        kind: MethodKind.Unary,
+        I: CheckHealthRequest,
+        O: CheckHealthResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.health.v1alpha1.HealthProviderService",
+  },
+}).checkHealth;
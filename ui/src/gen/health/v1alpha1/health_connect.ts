@@ -0,0 +1,30 @@
+// @generated by protoc-gen-connect-es v0.12.0 with parameter "target=ts"
+// @generated from file health/v1alpha1/health.proto (package akuity.io.kargo.health.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CheckHealthRequest, CheckHealthResponse } from "./health_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * HealthProviderService is implemented by external health providers. Kargo's
+ * Stage controller polls it, along with any Argo CD Applications, whenever it
+ * assesses the health of a Stage that references the provider.
+ *
+ * @generated from service akuity.io.kargo.health.v1alpha1.HealthProviderService
+ */
+export const HealthProviderService = {
+  typeName: "akuity.io.kargo.health.v1alpha1.HealthProviderService",
+  methods: {
+    /**
+     * @generated from rpc akuity.io.kargo.health.v1alpha1.HealthProviderService.CheckHealth
+     */
+    checkHealth: {
+      name: "CheckHealth",
+      I: CheckHealthRequest,
+      O: CheckHealthResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
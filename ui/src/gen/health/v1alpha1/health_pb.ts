@@ -0,0 +1,121 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=ts"
+// @generated from file health/v1alpha1/health.proto (package akuity.io.kargo.health.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import { SimpleFreight } from "../../v1alpha1/types_pb.js";
+
+/**
+ * @generated from message akuity.io.kargo.health.v1alpha1.CheckHealthRequest
+ */
+export class CheckHealthRequest extends Message<CheckHealthRequest> {
+  /**
+   * namespace is the namespace (i.e. project) of the Stage.
+   *
+   * @generated from field: string namespace = 1;
+   */
+  namespace = "";
+
+  /**
+   * stage is the name of the Stage.
+   *
+   * @generated from field: string stage = 2;
+   */
+  stage = "";
+
+  /**
+   * freight is the Stage's current Freight.
+   *
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.SimpleFreight freight = 3;
+   */
+  freight?: SimpleFreight;
+
+  /**
+   * parameters are the provider-specific parameters specified by the Stage's
+   * health check.
+   *
+   * @generated from field: map<string, string> parameters = 4;
+   */
+  parameters: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<CheckHealthRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.health.v1alpha1.CheckHealthRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "freight", kind: "message", T: SimpleFreight },
+    { no: 4, name: "parameters", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckHealthRequest {
+    return new CheckHealthRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckHealthRequest {
+    return new CheckHealthRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckHealthRequest {
+    return new CheckHealthRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CheckHealthRequest | PlainMessage<CheckHealthRequest> | undefined, b: CheckHealthRequest | PlainMessage<CheckHealthRequest> | undefined): boolean {
+    return proto3.util.equals(CheckHealthRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.health.v1alpha1.CheckHealthResponse
+ */
+export class CheckHealthResponse extends Message<CheckHealthResponse> {
+  /**
+   * status is the health of the Stage. It must be one of Healthy, Unhealthy,
+   * Progressing, or Unknown. Any other value is treated as Unknown.
+   *
+   * @generated from field: string status = 1;
+   */
+  status = "";
+
+  /**
+   * issues clarifies why the Stage is in any state other than Healthy.
+   *
+   * @generated from field: repeated string issues = 2;
+   */
+  issues: string[] = [];
+
+  constructor(data?: PartialMessage<CheckHealthResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.health.v1alpha1.CheckHealthResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "issues", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckHealthResponse {
+    return new CheckHealthResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckHealthResponse {
+    return new CheckHealthResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckHealthResponse {
+    return new CheckHealthResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CheckHealthResponse | PlainMessage<CheckHealthResponse> | undefined, b: CheckHealthResponse | PlainMessage<CheckHealthResponse> | undefined): boolean {
+    return proto3.util.equals(CheckHealthResponse, a, b);
+  }
+}
+
@@ -5,7 +5,7 @@
 
 import { createQueryService } from "@bufbuild/connect-query";
 import { MethodKind } from "@bufbuild/protobuf";
-import { AdminLoginRequest, AdminLoginResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreatePromotionPolicyRequest, CreatePromotionPolicyResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteProjectRequest, DeleteProjectResponse, DeletePromotionPolicyRequest, DeletePromotionPolicyResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, GetConfigRequest, GetConfigResponse, GetPromotionPolicyRequest, GetPromotionPolicyResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionPoliciesRequest, ListPromotionPoliciesResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UpdatePromotionPolicyRequest, UpdatePromotionPolicyResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse } from "./service_pb.js";
+import { AdminLoginRequest, AdminLoginResponse, ApproveFreightRequest, ApproveFreightResponse, ArchiveProjectRequest, ArchiveProjectResponse, AttachBuildInfoRequest, AttachBuildInfoResponse, CancelOperationRequest, CancelOperationResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreatePromotionPolicyRequest, CreatePromotionPolicyResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateViewerTokenRequest, CreateViewerTokenResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteProjectRequest, DeleteProjectResponse, DeletePromotionPolicyRequest, DeletePromotionPolicyResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, DiffFreightRequest, DiffFreightResponse, ExplainDiscoveryRequest, ExplainDiscoveryResponse, ExplainPromotionEligibilityRequest, ExplainPromotionEligibilityResponse, GenerateWarehouseFromArgoCDAppRequest, GenerateWarehouseFromArgoCDAppResponse, GetBackstageEntityStatusRequest, GetBackstageEntityStatusResponse, GetConfigRequest, GetConfigResponse, GetControllerStatsRequest, GetControllerStatsResponse, GetOperationRequest, GetOperationResponse, GetPromotionPolicyRequest, GetPromotionPolicyResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, KubernetesLoginRequest, KubernetesLoginResponse, ListEventsRequest, ListEventsResponse, ListOperationsRequest, ListOperationsResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionPoliciesRequest, ListPromotionPoliciesResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, PromoteStageRequest, PromoteStageResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, RenameStageRequest, RenameStageResponse, RevokeTokenRequest, RevokeTokenResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, StartOperationRequest, StartOperationResponse, TestClusterConnectionRequest, TestClusterConnectionResponse, TestCredentialsRequest, TestCredentialsResponse, UnarchiveProjectRequest, UnarchiveProjectResponse, UpdateMetadataRequest, UpdateMetadataResponse, UpdatePromotionPolicyRequest, UpdatePromotionPolicyResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse } from "./service_pb.js";
 
 export const typeName = "akuity.io.kargo.service.v1alpha1.KargoService";
 
@@ -77,6 +77,74 @@ export const adminLogin = createQueryService({
   },
 }).adminLogin;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.KubernetesLogin
+ */
+export const kubernetesLogin = createQueryService({
+  service: {
+    methods: {
+      kubernetesLogin: {
+        name: "KubernetesLogin",
+        kind: MethodKind.Unary,
+        I: KubernetesLoginRequest,
+        O: KubernetesLoginResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).kubernetesLogin;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateViewerToken
+ */
+export const createViewerToken = createQueryService({
+  service: {
+    methods: {
+      createViewerToken: {
+        name: "CreateViewerToken",
+        kind: MethodKind.Unary,
+        I: CreateViewerTokenRequest,
+        O: CreateViewerTokenResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).createViewerToken;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RevokeToken
+ */
+export const revokeToken = createQueryService({
+  service: {
+    methods: {
+      revokeToken: {
+        name: "RevokeToken",
+        kind: MethodKind.Unary,
+        I: RevokeTokenRequest,
+        O: RevokeTokenResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).revokeToken;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetControllerStats
+ */
+export const getControllerStats = createQueryService({
+  service: {
+    methods: {
+      getControllerStats: {
+        name: "GetControllerStats",
+        kind: MethodKind.Unary,
+        I: GetControllerStatsRequest,
+        O: GetControllerStatsResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).getControllerStats;
+
 /**
  * TODO(devholic): Add ApplyResource API
  * rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
@@ -148,6 +216,23 @@ export const deleteResource = createQueryService({
   },
 }).deleteResource;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UpdateMetadata
+ */
+export const updateMetadata = createQueryService({
+  service: {
+    methods: {
+      updateMetadata: {
+        name: "UpdateMetadata",
+        kind: MethodKind.Unary,
+        I: UpdateMetadataRequest,
+        O: UpdateMetadataResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).updateMetadata;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateStage
  */
@@ -233,6 +318,23 @@ export const deleteStage = createQueryService({
   },
 }).deleteStage;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RenameStage
+ */
+export const renameStage = createQueryService({
+  service: {
+    methods: {
+      renameStage: {
+        name: "RenameStage",
+        kind: MethodKind.Unary,
+        I: RenameStageRequest,
+        O: RenameStageResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).renameStage;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PromoteStage
  */
@@ -251,21 +353,21 @@ export const promoteStage = createQueryService({
 }).promoteStage;
 
 /**
- * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PromoteSubscribers
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ExplainPromotionEligibility
  */
-export const promoteSubscribers = createQueryService({
+export const explainPromotionEligibility = createQueryService({
   service: {
     methods: {
-      promoteSubscribers: {
-        name: "PromoteSubscribers",
+      explainPromotionEligibility: {
+        name: "ExplainPromotionEligibility",
         kind: MethodKind.Unary,
-        I: PromoteSubscribersRequest,
-        O: PromoteSubscribersResponse,
+        I: ExplainPromotionEligibilityRequest,
+        O: ExplainPromotionEligibilityResponse,
       },
     },
     typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
   },
-}).promoteSubscribers;
+}).explainPromotionEligibility;
 
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RefreshStage
@@ -473,6 +575,57 @@ export const deleteProject = createQueryService({
   },
 }).deleteProject;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ArchiveProject
+ */
+export const archiveProject = createQueryService({
+  service: {
+    methods: {
+      archiveProject: {
+        name: "ArchiveProject",
+        kind: MethodKind.Unary,
+        I: ArchiveProjectRequest,
+        O: ArchiveProjectResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).archiveProject;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UnarchiveProject
+ */
+export const unarchiveProject = createQueryService({
+  service: {
+    methods: {
+      unarchiveProject: {
+        name: "UnarchiveProject",
+        kind: MethodKind.Unary,
+        I: UnarchiveProjectRequest,
+        O: UnarchiveProjectResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).unarchiveProject;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetBackstageEntityStatus
+ */
+export const getBackstageEntityStatus = createQueryService({
+  service: {
+    methods: {
+      getBackstageEntityStatus: {
+        name: "GetBackstageEntityStatus",
+        kind: MethodKind.Unary,
+        I: GetBackstageEntityStatusRequest,
+        O: GetBackstageEntityStatusResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).getBackstageEntityStatus;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight
  */
@@ -490,6 +643,74 @@ export const queryFreight = createQueryService({
   },
 }).queryFreight;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ApproveFreight
+ */
+export const approveFreight = createQueryService({
+  service: {
+    methods: {
+      approveFreight: {
+        name: "ApproveFreight",
+        kind: MethodKind.Unary,
+        I: ApproveFreightRequest,
+        O: ApproveFreightResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).approveFreight;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.AttachBuildInfo
+ */
+export const attachBuildInfo = createQueryService({
+  service: {
+    methods: {
+      attachBuildInfo: {
+        name: "AttachBuildInfo",
+        kind: MethodKind.Unary,
+        I: AttachBuildInfoRequest,
+        O: AttachBuildInfoResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).attachBuildInfo;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DiffFreight
+ */
+export const diffFreight = createQueryService({
+  service: {
+    methods: {
+      diffFreight: {
+        name: "DiffFreight",
+        kind: MethodKind.Unary,
+        I: DiffFreightRequest,
+        O: DiffFreightResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).diffFreight;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ExplainDiscovery
+ */
+export const explainDiscovery = createQueryService({
+  service: {
+    methods: {
+      explainDiscovery: {
+        name: "ExplainDiscovery",
+        kind: MethodKind.Unary,
+        I: ExplainDiscoveryRequest,
+        O: ExplainDiscoveryResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).explainDiscovery;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses
  */
@@ -591,3 +812,139 @@ export const refreshWarehouse = createQueryService({
     typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
   },
 }).refreshWarehouse;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GenerateWarehouseFromArgoCDApp
+ */
+export const generateWarehouseFromArgoCDApp = createQueryService({
+  service: {
+    methods: {
+      generateWarehouseFromArgoCDApp: {
+        name: "GenerateWarehouseFromArgoCDApp",
+        kind: MethodKind.Unary,
+        I: GenerateWarehouseFromArgoCDAppRequest,
+        O: GenerateWarehouseFromArgoCDAppResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).generateWarehouseFromArgoCDApp;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.StartOperation
+ */
+export const startOperation = createQueryService({
+  service: {
+    methods: {
+      startOperation: {
+        name: "StartOperation",
+        kind: MethodKind.Unary,
+        I: StartOperationRequest,
+        O: StartOperationResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).startOperation;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetOperation
+ */
+export const getOperation = createQueryService({
+  service: {
+    methods: {
+      getOperation: {
+        name: "GetOperation",
+        kind: MethodKind.Unary,
+        I: GetOperationRequest,
+        O: GetOperationResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).getOperation;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListOperations
+ */
+export const listOperations = createQueryService({
+  service: {
+    methods: {
+      listOperations: {
+        name: "ListOperations",
+        kind: MethodKind.Unary,
+        I: ListOperationsRequest,
+        O: ListOperationsResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).listOperations;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CancelOperation
+ */
+export const cancelOperation = createQueryService({
+  service: {
+    methods: {
+      cancelOperation: {
+        name: "CancelOperation",
+        kind: MethodKind.Unary,
+        I: CancelOperationRequest,
+        O: CancelOperationResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).cancelOperation;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.TestCredentials
+ */
+export const testCredentials = createQueryService({
+  service: {
+    methods: {
+      testCredentials: {
+        name: "TestCredentials",
+        kind: MethodKind.Unary,
+        I: TestCredentialsRequest,
+        O: TestCredentialsResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).testCredentials;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.TestClusterConnection
+ */
+export const testClusterConnection = createQueryService({
+  service: {
+    methods: {
+      testClusterConnection: {
+        name: "TestClusterConnection",
+        kind: MethodKind.Unary,
+        I: TestClusterConnectionRequest,
+        O: TestClusterConnectionResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).testClusterConnection;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListEvents
+ */
+export const listEvents = createQueryService({
+  service: {
+    methods: {
+      listEvents: {
+        name: "ListEvents",
+        kind: MethodKind.Unary,
+        I: ListEventsRequest,
+        O: ListEventsResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).listEvents;
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AdminLoginRequest, AdminLoginResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreatePromotionPolicyRequest, CreatePromotionPolicyResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteProjectRequest, DeleteProjectResponse, DeletePromotionPolicyRequest, DeletePromotionPolicyResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, GetConfigRequest, GetConfigResponse, GetPromotionPolicyRequest, GetPromotionPolicyResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionPoliciesRequest, ListPromotionPoliciesResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UpdatePromotionPolicyRequest, UpdatePromotionPolicyResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse, WatchPromotionRequest, WatchPromotionResponse, WatchPromotionsRequest, WatchPromotionsResponse, WatchStagesRequest, WatchStagesResponse, WatchWarehousesRequest, WatchWarehousesResponse } from "./service_pb.js";
+import { AdminLoginRequest, AdminLoginResponse, ApproveFreightRequest, ApproveFreightResponse, ArchiveProjectRequest, ArchiveProjectResponse, AttachBuildInfoRequest, AttachBuildInfoResponse, CancelOperationRequest, CancelOperationResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreatePromotionPolicyRequest, CreatePromotionPolicyResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateViewerTokenRequest, CreateViewerTokenResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteProjectRequest, DeleteProjectResponse, DeletePromotionPolicyRequest, DeletePromotionPolicyResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, DiffFreightRequest, DiffFreightResponse, ExplainDiscoveryRequest, ExplainDiscoveryResponse, ExplainPromotionEligibilityRequest, ExplainPromotionEligibilityResponse, GenerateWarehouseFromArgoCDAppRequest, GenerateWarehouseFromArgoCDAppResponse, GetBackstageEntityStatusRequest, GetBackstageEntityStatusResponse, GetConfigRequest, GetConfigResponse, GetControllerStatsRequest, GetControllerStatsResponse, GetOperationRequest, GetOperationResponse, GetPromotionPolicyRequest, GetPromotionPolicyResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, KubernetesLoginRequest, KubernetesLoginResponse, ListEventsRequest, ListEventsResponse, ListOperationsRequest, ListOperationsResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionPoliciesRequest, ListPromotionPoliciesResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, RenameStageRequest, RenameStageResponse, RevokeTokenRequest, RevokeTokenResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, StartOperationRequest, StartOperationResponse, TestClusterConnectionRequest, TestClusterConnectionResponse, TestCredentialsRequest, TestCredentialsResponse, UnarchiveProjectRequest, UnarchiveProjectResponse, UpdateMetadataRequest, UpdateMetadataResponse, UpdatePromotionPolicyRequest, UpdatePromotionPolicyResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse, WatchPromotionRequest, WatchPromotionResponse, WatchPromotionsRequest, WatchPromotionsResponse, WatchStagesRequest, WatchStagesResponse, WatchWarehousesRequest, WatchWarehousesResponse } from "./service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -48,6 +48,42 @@ export const KargoService = {
       O: AdminLoginResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.KubernetesLogin
+     */
+    kubernetesLogin: {
+      name: "KubernetesLogin",
+      I: KubernetesLoginRequest,
+      O: KubernetesLoginResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateViewerToken
+     */
+    createViewerToken: {
+      name: "CreateViewerToken",
+      I: CreateViewerTokenRequest,
+      O: CreateViewerTokenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RevokeToken
+     */
+    revokeToken: {
+      name: "RevokeToken",
+      I: RevokeTokenRequest,
+      O: RevokeTokenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetControllerStats
+     */
+    getControllerStats: {
+      name: "GetControllerStats",
+      I: GetControllerStatsRequest,
+      O: GetControllerStatsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * TODO(devholic): Add ApplyResource API
      * rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
@@ -87,6 +123,15 @@ export const KargoService = {
       O: DeleteResourceResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UpdateMetadata
+     */
+    updateMetadata: {
+      name: "UpdateMetadata",
+      I: UpdateMetadataRequest,
+      O: UpdateMetadataResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateStage
      */
@@ -141,6 +186,15 @@ export const KargoService = {
       O: DeleteStageResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RenameStage
+     */
+    renameStage: {
+      name: "RenameStage",
+      I: RenameStageRequest,
+      O: RenameStageResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PromoteStage
      */
@@ -150,6 +204,15 @@ export const KargoService = {
       O: PromoteStageResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ExplainPromotionEligibility
+     */
+    explainPromotionEligibility: {
+      name: "ExplainPromotionEligibility",
+      I: ExplainPromotionEligibilityRequest,
+      O: ExplainPromotionEligibilityResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PromoteSubscribers
      */
@@ -157,7 +220,7 @@ export const KargoService = {
       name: "PromoteSubscribers",
       I: PromoteSubscribersRequest,
       O: PromoteSubscribersResponse,
-      kind: MethodKind.Unary,
+      kind: MethodKind.ServerStreaming,
     },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RefreshStage
@@ -287,6 +350,33 @@ export const KargoService = {
       O: DeleteProjectResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ArchiveProject
+     */
+    archiveProject: {
+      name: "ArchiveProject",
+      I: ArchiveProjectRequest,
+      O: ArchiveProjectResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UnarchiveProject
+     */
+    unarchiveProject: {
+      name: "UnarchiveProject",
+      I: UnarchiveProjectRequest,
+      O: UnarchiveProjectResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetBackstageEntityStatus
+     */
+    getBackstageEntityStatus: {
+      name: "GetBackstageEntityStatus",
+      I: GetBackstageEntityStatusRequest,
+      O: GetBackstageEntityStatusResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight
      */
@@ -296,6 +386,42 @@ export const KargoService = {
       O: QueryFreightResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ApproveFreight
+     */
+    approveFreight: {
+      name: "ApproveFreight",
+      I: ApproveFreightRequest,
+      O: ApproveFreightResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.AttachBuildInfo
+     */
+    attachBuildInfo: {
+      name: "AttachBuildInfo",
+      I: AttachBuildInfoRequest,
+      O: AttachBuildInfoResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DiffFreight
+     */
+    diffFreight: {
+      name: "DiffFreight",
+      I: DiffFreightRequest,
+      O: DiffFreightResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ExplainDiscovery
+     */
+    explainDiscovery: {
+      name: "ExplainDiscovery",
+      I: ExplainDiscoveryRequest,
+      O: ExplainDiscoveryResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses
      */
@@ -359,6 +485,78 @@ export const KargoService = {
       O: RefreshWarehouseResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GenerateWarehouseFromArgoCDApp
+     */
+    generateWarehouseFromArgoCDApp: {
+      name: "GenerateWarehouseFromArgoCDApp",
+      I: GenerateWarehouseFromArgoCDAppRequest,
+      O: GenerateWarehouseFromArgoCDAppResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.StartOperation
+     */
+    startOperation: {
+      name: "StartOperation",
+      I: StartOperationRequest,
+      O: StartOperationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetOperation
+     */
+    getOperation: {
+      name: "GetOperation",
+      I: GetOperationRequest,
+      O: GetOperationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListOperations
+     */
+    listOperations: {
+      name: "ListOperations",
+      I: ListOperationsRequest,
+      O: ListOperationsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CancelOperation
+     */
+    cancelOperation: {
+      name: "CancelOperation",
+      I: CancelOperationRequest,
+      O: CancelOperationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.TestCredentials
+     */
+    testCredentials: {
+      name: "TestCredentials",
+      I: TestCredentialsRequest,
+      O: TestCredentialsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.TestClusterConnection
+     */
+    testClusterConnection: {
+      name: "TestClusterConnection",
+      I: TestClusterConnectionRequest,
+      O: TestClusterConnectionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListEvents
+     */
+    listEvents: {
+      name: "ListEvents",
+      I: ListEventsRequest,
+      O: ListEventsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
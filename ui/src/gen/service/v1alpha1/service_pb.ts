@@ -4,8 +4,8 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
-import { Freight, Promotion, PromotionPolicy, Stage, StageSpec, Warehouse, WarehouseSpec } from "../../v1alpha1/types_pb.js";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+import { BuildInfo, Freight, Promotion, PromotionImpact, PromotionPolicy, Release, Stage, StageSpec, Warehouse, WarehouseSpec } from "../../v1alpha1/types_pb.js";
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ComponentVersions
@@ -347,6 +347,11 @@ export class GetPublicConfigResponse extends Message<GetPublicConfigResponse> {
    */
   adminAccountEnabled = false;
 
+  /**
+   * @generated from field: bool kubernetes_login_enabled = 3;
+   */
+  kubernetesLoginEnabled = false;
+
   constructor(data?: PartialMessage<GetPublicConfigResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -357,6 +362,7 @@ export class GetPublicConfigResponse extends Message<GetPublicConfigResponse> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "oidc_config", kind: "message", T: OIDCConfig },
     { no: 2, name: "admin_account_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "kubernetes_login_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPublicConfigResponse {
@@ -505,6 +511,379 @@ export class AdminLoginResponse extends Message<AdminLoginResponse> {
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.KubernetesLoginRequest
+ */
+export class KubernetesLoginRequest extends Message<KubernetesLoginRequest> {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<KubernetesLoginRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.KubernetesLoginRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KubernetesLoginRequest {
+    return new KubernetesLoginRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KubernetesLoginRequest {
+    return new KubernetesLoginRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KubernetesLoginRequest {
+    return new KubernetesLoginRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KubernetesLoginRequest | PlainMessage<KubernetesLoginRequest> | undefined, b: KubernetesLoginRequest | PlainMessage<KubernetesLoginRequest> | undefined): boolean {
+    return proto3.util.equals(KubernetesLoginRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.KubernetesLoginResponse
+ */
+export class KubernetesLoginResponse extends Message<KubernetesLoginResponse> {
+  /**
+   * @generated from field: string id_token = 1;
+   */
+  idToken = "";
+
+  constructor(data?: PartialMessage<KubernetesLoginResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.KubernetesLoginResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KubernetesLoginResponse {
+    return new KubernetesLoginResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KubernetesLoginResponse {
+    return new KubernetesLoginResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KubernetesLoginResponse {
+    return new KubernetesLoginResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KubernetesLoginResponse | PlainMessage<KubernetesLoginResponse> | undefined, b: KubernetesLoginResponse | PlainMessage<KubernetesLoginResponse> | undefined): boolean {
+    return proto3.util.equals(KubernetesLoginResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateViewerTokenRequest
+ */
+export class CreateViewerTokenRequest extends Message<CreateViewerTokenRequest> {
+  /**
+   * @generated from field: optional string ttl = 1;
+   */
+  ttl?: string;
+
+  constructor(data?: PartialMessage<CreateViewerTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateViewerTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ttl", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateViewerTokenRequest {
+    return new CreateViewerTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateViewerTokenRequest {
+    return new CreateViewerTokenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateViewerTokenRequest {
+    return new CreateViewerTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateViewerTokenRequest | PlainMessage<CreateViewerTokenRequest> | undefined, b: CreateViewerTokenRequest | PlainMessage<CreateViewerTokenRequest> | undefined): boolean {
+    return proto3.util.equals(CreateViewerTokenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateViewerTokenResponse
+ */
+export class CreateViewerTokenResponse extends Message<CreateViewerTokenResponse> {
+  /**
+   * @generated from field: string id_token = 1;
+   */
+  idToken = "";
+
+  constructor(data?: PartialMessage<CreateViewerTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateViewerTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateViewerTokenResponse {
+    return new CreateViewerTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateViewerTokenResponse {
+    return new CreateViewerTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateViewerTokenResponse {
+    return new CreateViewerTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateViewerTokenResponse | PlainMessage<CreateViewerTokenResponse> | undefined, b: CreateViewerTokenResponse | PlainMessage<CreateViewerTokenResponse> | undefined): boolean {
+    return proto3.util.equals(CreateViewerTokenResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RevokeTokenRequest
+ */
+export class RevokeTokenRequest extends Message<RevokeTokenRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp expire_time = 2;
+   */
+  expireTime?: Timestamp;
+
+  constructor(data?: PartialMessage<RevokeTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RevokeTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "expire_time", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeTokenRequest {
+    return new RevokeTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeTokenRequest {
+    return new RevokeTokenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeTokenRequest {
+    return new RevokeTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeTokenRequest | PlainMessage<RevokeTokenRequest> | undefined, b: RevokeTokenRequest | PlainMessage<RevokeTokenRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeTokenRequest, a, b);
+  }
+}
+
+/**
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.RevokeTokenResponse
+ */
+export class RevokeTokenResponse extends Message<RevokeTokenResponse> {
+  constructor(data?: PartialMessage<RevokeTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RevokeTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeTokenResponse {
+    return new RevokeTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeTokenResponse {
+    return new RevokeTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeTokenResponse {
+    return new RevokeTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeTokenResponse | PlainMessage<RevokeTokenResponse> | undefined, b: RevokeTokenResponse | PlainMessage<RevokeTokenResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeTokenResponse, a, b);
+  }
+}
+
+/**
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetControllerStatsRequest
+ */
+export class GetControllerStatsRequest extends Message<GetControllerStatsRequest> {
+  constructor(data?: PartialMessage<GetControllerStatsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetControllerStatsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetControllerStatsRequest {
+    return new GetControllerStatsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetControllerStatsRequest {
+    return new GetControllerStatsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetControllerStatsRequest {
+    return new GetControllerStatsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetControllerStatsRequest | PlainMessage<GetControllerStatsRequest> | undefined, b: GetControllerStatsRequest | PlainMessage<GetControllerStatsRequest> | undefined): boolean {
+    return proto3.util.equals(GetControllerStatsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetControllerStatsResponse
+ */
+export class GetControllerStatsResponse extends Message<GetControllerStatsResponse> {
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.ReconcilerStats reconcilers = 1;
+   */
+  reconcilers: ReconcilerStats[] = [];
+
+  /**
+   * @generated from field: int64 promotions_in_flight = 2;
+   */
+  promotionsInFlight = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetControllerStatsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetControllerStatsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reconcilers", kind: "message", T: ReconcilerStats, repeated: true },
+    { no: 2, name: "promotions_in_flight", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetControllerStatsResponse {
+    return new GetControllerStatsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetControllerStatsResponse {
+    return new GetControllerStatsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetControllerStatsResponse {
+    return new GetControllerStatsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetControllerStatsResponse | PlainMessage<GetControllerStatsResponse> | undefined, b: GetControllerStatsResponse | PlainMessage<GetControllerStatsResponse> | undefined): boolean {
+    return proto3.util.equals(GetControllerStatsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ReconcilerStats
+ */
+export class ReconcilerStats extends Message<ReconcilerStats> {
+  /**
+   * controller is the name of the controller, which is derived from the kind
+   * of resource it reconciles.
+   *
+   * @generated from field: string controller = 1;
+   */
+  controller = "";
+
+  /**
+   * @generated from field: int64 queue_depth = 2;
+   */
+  queueDepth = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 active_workers = 3;
+   */
+  activeWorkers = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 reconciles_total = 4;
+   */
+  reconcilesTotal = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 errors_total = 5;
+   */
+  errorsTotal = protoInt64.zero;
+
+  /**
+   * average_reconcile_seconds is the average duration of a reconciliation since
+   * the controller started.
+   *
+   * @generated from field: double average_reconcile_seconds = 6;
+   */
+  averageReconcileSeconds = 0;
+
+  constructor(data?: PartialMessage<ReconcilerStats>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ReconcilerStats";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "controller", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "queue_depth", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "active_workers", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "reconciles_total", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "errors_total", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "average_reconcile_seconds", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconcilerStats {
+    return new ReconcilerStats().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconcilerStats {
+    return new ReconcilerStats().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconcilerStats {
+    return new ReconcilerStats().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReconcilerStats | PlainMessage<ReconcilerStats> | undefined, b: ReconcilerStats | PlainMessage<ReconcilerStats> | undefined): boolean {
+    return proto3.util.equals(ReconcilerStats, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.TypedStageSpec
  */
@@ -687,6 +1066,14 @@ export class CreateOrUpdateResourceRequest extends Message<CreateOrUpdateResourc
    */
   manifest = new Uint8Array(0);
 
+  /**
+   * strict, if true, causes resources containing unknown or duplicate fields
+   * to be rejected instead of having those fields silently dropped.
+   *
+   * @generated from field: bool strict = 2;
+   */
+  strict = false;
+
   constructor(data?: PartialMessage<CreateOrUpdateResourceRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -696,6 +1083,7 @@ export class CreateOrUpdateResourceRequest extends Message<CreateOrUpdateResourc
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateOrUpdateResourceRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "manifest", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "strict", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrUpdateResourceRequest {
@@ -742,6 +1130,24 @@ export class CreateOrUpdateResourceResult extends Message<CreateOrUpdateResource
     case: "error";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
+  /**
+   * kind, namespace, and name identify the resource the result is for, even
+   * if it could not be created or updated.
+   *
+   * @generated from field: string kind = 4;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string namespace = 5;
+   */
+  namespace = "";
+
+  /**
+   * @generated from field: string name = 6;
+   */
+  name = "";
+
   constructor(data?: PartialMessage<CreateOrUpdateResourceResult>) {
     super();
     proto3.util.initPartial(data, this);
@@ -753,6 +1159,9 @@ export class CreateOrUpdateResourceResult extends Message<CreateOrUpdateResource
     { no: 1, name: "created_resource_manifest", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "result" },
     { no: 2, name: "updated_resource_manifest", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "result" },
     { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "result" },
+    { no: 4, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrUpdateResourceResult {
@@ -1058,24 +1467,140 @@ export class DeleteResourceResponse extends Message<DeleteResourceResponse> {
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.CreateStageRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.UpdateMetadataRequest
  */
-export class CreateStageRequest extends Message<CreateStageRequest> {
+export class UpdateMetadataRequest extends Message<UpdateMetadataRequest> {
   /**
-   * @generated from oneof akuity.io.kargo.service.v1alpha1.CreateStageRequest.stage
+   * @generated from field: string project = 1;
    */
-  stage: {
-    /**
-     * @generated from field: akuity.io.kargo.service.v1alpha1.TypedStageSpec typed = 1;
-     */
-    value: TypedStageSpec;
-    case: "typed";
-  } | {
-    /**
-     * @generated from field: string yaml = 2;
-     */
-    value: string;
-    case: "yaml";
+  project = "";
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: map<string, string> labels = 4;
+   */
+  labels: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: repeated string remove_labels = 5;
+   */
+  removeLabels: string[] = [];
+
+  /**
+   * @generated from field: map<string, string> annotations = 6;
+   */
+  annotations: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: repeated string remove_annotations = 7;
+   */
+  removeAnnotations: string[] = [];
+
+  constructor(data?: PartialMessage<UpdateMetadataRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateMetadataRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 5, name: "remove_labels", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 7, name: "remove_annotations", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMetadataRequest {
+    return new UpdateMetadataRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMetadataRequest {
+    return new UpdateMetadataRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMetadataRequest {
+    return new UpdateMetadataRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMetadataRequest | PlainMessage<UpdateMetadataRequest> | undefined, b: UpdateMetadataRequest | PlainMessage<UpdateMetadataRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateMetadataRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UpdateMetadataResponse
+ */
+export class UpdateMetadataResponse extends Message<UpdateMetadataResponse> {
+  /**
+   * @generated from field: map<string, string> labels = 1;
+   */
+  labels: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: map<string, string> annotations = 2;
+   */
+  annotations: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<UpdateMetadataResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateMetadataResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 2, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMetadataResponse {
+    return new UpdateMetadataResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMetadataResponse {
+    return new UpdateMetadataResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMetadataResponse {
+    return new UpdateMetadataResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMetadataResponse | PlainMessage<UpdateMetadataResponse> | undefined, b: UpdateMetadataResponse | PlainMessage<UpdateMetadataResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateMetadataResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateStageRequest
+ */
+export class CreateStageRequest extends Message<CreateStageRequest> {
+  /**
+   * @generated from oneof akuity.io.kargo.service.v1alpha1.CreateStageRequest.stage
+   */
+  stage: {
+    /**
+     * @generated from field: akuity.io.kargo.service.v1alpha1.TypedStageSpec typed = 1;
+     */
+    value: TypedStageSpec;
+    case: "typed";
+  } | {
+    /**
+     * @generated from field: string yaml = 2;
+     */
+    value: string;
+    case: "yaml";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<CreateStageRequest>) {
@@ -1153,6 +1678,11 @@ export class ListStagesRequest extends Message<ListStagesRequest> {
    */
   project = "";
 
+  /**
+   * @generated from field: bool include_table = 2;
+   */
+  includeTable = false;
+
   constructor(data?: PartialMessage<ListStagesRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1162,6 +1692,7 @@ export class ListStagesRequest extends Message<ListStagesRequest> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListStagesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "include_table", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStagesRequest {
@@ -1190,6 +1721,11 @@ export class ListStagesResponse extends Message<ListStagesResponse> {
    */
   stages: Stage[] = [];
 
+  /**
+   * @generated from field: akuity.io.kargo.service.v1alpha1.Table table = 2;
+   */
+  table?: Table;
+
   constructor(data?: PartialMessage<ListStagesResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1199,6 +1735,7 @@ export class ListStagesResponse extends Message<ListStagesResponse> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListStagesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "stages", kind: "message", T: Stage, repeated: true },
+    { no: 2, name: "table", kind: "message", T: Table },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStagesResponse {
@@ -1547,6 +2084,125 @@ export class DeleteStageResponse extends Message<DeleteStageResponse> {
   }
 }
 
+/**
+ * RenameStageRequest requests that a Stage be renamed. Kubernetes resources
+ * cannot be renamed in place, so a Stage with the new name is created from the
+ * original, every reference to the original is rewritten, and the original is
+ * deleted. A rename that fails part way through can be resumed by repeating
+ * the request.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.RenameStageRequest
+ */
+export class RenameStageRequest extends Message<RenameStageRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string new_name = 3;
+   */
+  newName = "";
+
+  constructor(data?: PartialMessage<RenameStageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RenameStageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "new_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameStageRequest {
+    return new RenameStageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameStageRequest {
+    return new RenameStageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameStageRequest {
+    return new RenameStageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenameStageRequest | PlainMessage<RenameStageRequest> | undefined, b: RenameStageRequest | PlainMessage<RenameStageRequest> | undefined): boolean {
+    return proto3.util.equals(RenameStageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RenameStageResponse
+ */
+export class RenameStageResponse extends Message<RenameStageResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Stage stage = 1;
+   */
+  stage?: Stage;
+
+  /**
+   * updated_stages are the names of the Stages whose subscriptions were
+   * rewritten to refer to the renamed Stage.
+   *
+   * @generated from field: repeated string updated_stages = 2;
+   */
+  updatedStages: string[] = [];
+
+  /**
+   * updated_promotion_policies are the names of the PromotionPolicies that
+   * were rewritten to refer to the renamed Stage.
+   *
+   * @generated from field: repeated string updated_promotion_policies = 3;
+   */
+  updatedPromotionPolicies: string[] = [];
+
+  /**
+   * updated_freight are the IDs of the Freight whose qualifications and
+   * approvals were rewritten to refer to the renamed Stage.
+   *
+   * @generated from field: repeated string updated_freight = 4;
+   */
+  updatedFreight: string[] = [];
+
+  constructor(data?: PartialMessage<RenameStageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RenameStageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "message", T: Stage },
+    { no: 2, name: "updated_stages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "updated_promotion_policies", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "updated_freight", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameStageResponse {
+    return new RenameStageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameStageResponse {
+    return new RenameStageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameStageResponse {
+    return new RenameStageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenameStageResponse | PlainMessage<RenameStageResponse> | undefined, b: RenameStageResponse | PlainMessage<RenameStageResponse> | undefined): boolean {
+    return proto3.util.equals(RenameStageResponse, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.PromoteStageRequest
  */
@@ -1566,6 +2222,33 @@ export class PromoteStageRequest extends Message<PromoteStageRequest> {
    */
   freight = "";
 
+  /**
+   * to_all_downstream, when true, creates a Release that promotes the Freight
+   * into the Stage and then, one at a time, into every Stage downstream from
+   * it, waiting for the Freight to qualify for each before continuing.
+   *
+   * @generated from field: bool to_all_downstream = 4;
+   */
+  toAllDownstream = false;
+
+  /**
+   * soak optionally specifies how long each Stage must remain healthy before
+   * the next is promoted. e.g. "1h". Only valid with to_all_downstream.
+   *
+   * @generated from field: optional string soak = 5;
+   */
+  soak?: string;
+
+  /**
+   * freight_project optionally specifies the Project to which the Freight
+   * belongs, if not the Stage's own. This is only permitted if that Project
+   * shares its Freight with the Stage's Project, and cannot be combined with
+   * to_all_downstream.
+   *
+   * @generated from field: optional string freight_project = 6;
+   */
+  freightProject?: string;
+
   constructor(data?: PartialMessage<PromoteStageRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1577,6 +2260,9 @@ export class PromoteStageRequest extends Message<PromoteStageRequest> {
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "to_all_downstream", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "soak", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "freight_project", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteStageRequest {
@@ -1605,6 +2291,11 @@ export class PromoteStageResponse extends Message<PromoteStageResponse> {
    */
   promotion?: Promotion;
 
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.Release release = 2;
+   */
+  release?: Release;
+
   constructor(data?: PartialMessage<PromoteStageResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1614,6 +2305,7 @@ export class PromoteStageResponse extends Message<PromoteStageResponse> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.PromoteStageResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "promotion", kind: "message", T: Promotion },
+    { no: 2, name: "release", kind: "message", T: Release, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteStageResponse {
@@ -1634,9 +2326,9 @@ export class PromoteStageResponse extends Message<PromoteStageResponse> {
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.PromoteSubscribersRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.ExplainPromotionEligibilityRequest
  */
-export class PromoteSubscribersRequest extends Message<PromoteSubscribersRequest> {
+export class ExplainPromotionEligibilityRequest extends Message<ExplainPromotionEligibilityRequest> {
   /**
    * @generated from field: string project = 1;
    */
@@ -1652,135 +2344,372 @@ export class PromoteSubscribersRequest extends Message<PromoteSubscribersRequest
    */
   freight = "";
 
-  constructor(data?: PartialMessage<PromoteSubscribersRequest>) {
+  constructor(data?: PartialMessage<ExplainPromotionEligibilityRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PromoteSubscribersRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ExplainPromotionEligibilityRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteSubscribersRequest {
-    return new PromoteSubscribersRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainPromotionEligibilityRequest {
+    return new ExplainPromotionEligibilityRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteSubscribersRequest {
-    return new PromoteSubscribersRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainPromotionEligibilityRequest {
+    return new ExplainPromotionEligibilityRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteSubscribersRequest {
-    return new PromoteSubscribersRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainPromotionEligibilityRequest {
+    return new ExplainPromotionEligibilityRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromoteSubscribersRequest | PlainMessage<PromoteSubscribersRequest> | undefined, b: PromoteSubscribersRequest | PlainMessage<PromoteSubscribersRequest> | undefined): boolean {
-    return proto3.util.equals(PromoteSubscribersRequest, a, b);
+  static equals(a: ExplainPromotionEligibilityRequest | PlainMessage<ExplainPromotionEligibilityRequest> | undefined, b: ExplainPromotionEligibilityRequest | PlainMessage<ExplainPromotionEligibilityRequest> | undefined): boolean {
+    return proto3.util.equals(ExplainPromotionEligibilityRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.PromoteSubscribersResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.ExplainPromotionEligibilityResponse
  */
-export class PromoteSubscribersResponse extends Message<PromoteSubscribersResponse> {
+export class ExplainPromotionEligibilityResponse extends Message<ExplainPromotionEligibilityResponse> {
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Promotion promotions = 1;
+   * eligible indicates whether every gate was passed, i.e. whether the Freight
+   * can currently be promoted to the Stage.
+   *
+   * @generated from field: bool eligible = 1;
    */
-  promotions: Promotion[] = [];
+  eligible = false;
 
-  constructor(data?: PartialMessage<PromoteSubscribersResponse>) {
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.PromotionGate gates = 2;
+   */
+  gates: PromotionGate[] = [];
+
+  /**
+   * impact describes what promoting the Freight to the Stage would change.
+   * It is absent if the Stage has no promotion mechanisms.
+   *
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.PromotionImpact impact = 3;
+   */
+  impact?: PromotionImpact;
+
+  constructor(data?: PartialMessage<ExplainPromotionEligibilityResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PromoteSubscribersResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ExplainPromotionEligibilityResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "promotions", kind: "message", T: Promotion, repeated: true },
+    { no: 1, name: "eligible", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "gates", kind: "message", T: PromotionGate, repeated: true },
+    { no: 3, name: "impact", kind: "message", T: PromotionImpact, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteSubscribersResponse {
-    return new PromoteSubscribersResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainPromotionEligibilityResponse {
+    return new ExplainPromotionEligibilityResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteSubscribersResponse {
-    return new PromoteSubscribersResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainPromotionEligibilityResponse {
+    return new ExplainPromotionEligibilityResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteSubscribersResponse {
-    return new PromoteSubscribersResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainPromotionEligibilityResponse {
+    return new ExplainPromotionEligibilityResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromoteSubscribersResponse | PlainMessage<PromoteSubscribersResponse> | undefined, b: PromoteSubscribersResponse | PlainMessage<PromoteSubscribersResponse> | undefined): boolean {
-    return proto3.util.equals(PromoteSubscribersResponse, a, b);
+  static equals(a: ExplainPromotionEligibilityResponse | PlainMessage<ExplainPromotionEligibilityResponse> | undefined, b: ExplainPromotionEligibilityResponse | PlainMessage<ExplainPromotionEligibilityResponse> | undefined): boolean {
+    return proto3.util.equals(ExplainPromotionEligibilityResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.RefreshStageRequest
+ * PromotionGate describes the outcome of a single check that must pass for a
+ * piece of Freight to be promoted to a Stage.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.PromotionGate
  */
-export class RefreshStageRequest extends Message<RefreshStageRequest> {
+export class PromotionGate extends Message<PromotionGate> {
   /**
-   * @generated from field: string project = 1;
+   * name is one of "FreightExists", "ProjectNotArchived", "Authorized",
+   * "Qualified", or "FreightAge".
+   *
+   * @generated from field: string name = 1;
    */
-  project = "";
+  name = "";
 
   /**
-   * @generated from field: string name = 2;
+   * status is one of "Passed", "Blocked", or "NotEvaluated". Gates that depend
+   * upon the Freight existing are not evaluated if it does not.
+   *
+   * @generated from field: string status = 2;
    */
-  name = "";
+  status = "";
 
-  constructor(data?: PartialMessage<RefreshStageRequest>) {
+  /**
+   * reason elaborates on the status.
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  /**
+   * details optionally elaborate further on the reason. e.g. Why the Freight
+   * has not yet qualified for each of the Stage's upstream Stages.
+   *
+   * @generated from field: repeated string details = 4;
+   */
+  details: string[] = [];
+
+  constructor(data?: PartialMessage<PromotionGate>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshStageRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PromotionGate";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "details", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshStageRequest {
-    return new RefreshStageRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionGate {
+    return new PromotionGate().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshStageRequest {
-    return new RefreshStageRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionGate {
+    return new PromotionGate().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshStageRequest {
-    return new RefreshStageRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionGate {
+    return new PromotionGate().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RefreshStageRequest | PlainMessage<RefreshStageRequest> | undefined, b: RefreshStageRequest | PlainMessage<RefreshStageRequest> | undefined): boolean {
-    return proto3.util.equals(RefreshStageRequest, a, b);
+  static equals(a: PromotionGate | PlainMessage<PromotionGate> | undefined, b: PromotionGate | PlainMessage<PromotionGate> | undefined): boolean {
+    return proto3.util.equals(PromotionGate, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.RefreshStageResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.PromoteSubscribersRequest
  */
-export class RefreshStageResponse extends Message<RefreshStageResponse> {
+export class PromoteSubscribersRequest extends Message<PromoteSubscribersRequest> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Stage stage = 1;
+   * @generated from field: string project = 1;
    */
-  stage?: Stage;
+  project = "";
 
-  constructor(data?: PartialMessage<RefreshStageResponse>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
+  /**
+   * @generated from field: string stage = 2;
+   */
+  stage = "";
 
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshStageResponse";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "stage", kind: "message", T: Stage },
-  ]);
+  /**
+   * @generated from field: string freight = 3;
+   */
+  freight = "";
+
+  /**
+   * rollout_order optionally lists every subscriber of the Stage, in the order
+   * in which they should be promoted. When specified, a Release is created to
+   * promote the subscribers one at a time instead of all at once.
+   *
+   * @generated from field: repeated string rollout_order = 4;
+   */
+  rolloutOrder: string[] = [];
+
+  /**
+   * soak optionally specifies how long each subscriber must remain healthy
+   * before the next is promoted. e.g. "1h". Only valid with rollout_order.
+   *
+   * @generated from field: optional string soak = 5;
+   */
+  soak?: string;
+
+  constructor(data?: PartialMessage<PromoteSubscribersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PromoteSubscribersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "rollout_order", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "soak", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteSubscribersRequest {
+    return new PromoteSubscribersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteSubscribersRequest {
+    return new PromoteSubscribersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteSubscribersRequest {
+    return new PromoteSubscribersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromoteSubscribersRequest | PlainMessage<PromoteSubscribersRequest> | undefined, b: PromoteSubscribersRequest | PlainMessage<PromoteSubscribersRequest> | undefined): boolean {
+    return proto3.util.equals(PromoteSubscribersRequest, a, b);
+  }
+}
+
+/**
+ * PromoteSubscribersResponse reports progress in promoting the subscribers of a
+ * Stage. When subscribers are promoted all at once, one message is streamed per
+ * subscriber as soon as its Promotion has been created, or has failed to be
+ * created. When a rollout order is specified, a single message containing the
+ * Release is streamed instead.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.PromoteSubscribersResponse
+ */
+export class PromoteSubscribersResponse extends Message<PromoteSubscribersResponse> {
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.Release release = 2;
+   */
+  release?: Release;
+
+  /**
+   * stage is the name of the subscriber the message reports on.
+   *
+   * @generated from field: string stage = 3;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.Promotion promotion = 4;
+   */
+  promotion?: Promotion;
+
+  /**
+   * error, if set, describes why the subscriber could not be promoted.
+   *
+   * @generated from field: string error = 5;
+   */
+  error = "";
+
+  /**
+   * completed is the number of subscribers processed so far, including the one
+   * the message reports on.
+   *
+   * @generated from field: int32 completed = 6;
+   */
+  completed = 0;
+
+  /**
+   * total is the number of subscribers being promoted.
+   *
+   * @generated from field: int32 total = 7;
+   */
+  total = 0;
+
+  constructor(data?: PartialMessage<PromoteSubscribersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PromoteSubscribersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 2, name: "release", kind: "message", T: Release, opt: true },
+    { no: 3, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "promotion", kind: "message", T: Promotion, opt: true },
+    { no: 5, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "completed", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "total", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteSubscribersResponse {
+    return new PromoteSubscribersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteSubscribersResponse {
+    return new PromoteSubscribersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteSubscribersResponse {
+    return new PromoteSubscribersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromoteSubscribersResponse | PlainMessage<PromoteSubscribersResponse> | undefined, b: PromoteSubscribersResponse | PlainMessage<PromoteSubscribersResponse> | undefined): boolean {
+    return proto3.util.equals(PromoteSubscribersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RefreshStageRequest
+ */
+export class RefreshStageRequest extends Message<RefreshStageRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<RefreshStageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshStageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshStageRequest {
+    return new RefreshStageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshStageRequest {
+    return new RefreshStageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshStageRequest {
+    return new RefreshStageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshStageRequest | PlainMessage<RefreshStageRequest> | undefined, b: RefreshStageRequest | PlainMessage<RefreshStageRequest> | undefined): boolean {
+    return proto3.util.equals(RefreshStageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RefreshStageResponse
+ */
+export class RefreshStageResponse extends Message<RefreshStageResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Stage stage = 1;
+   */
+  stage?: Stage;
+
+  constructor(data?: PartialMessage<RefreshStageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshStageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "message", T: Stage },
+  ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshStageResponse {
     return new RefreshStageResponse().fromBinary(bytes, options);
@@ -1823,6 +2752,11 @@ export class TypedPromotionPolicySpec extends Message<TypedPromotionPolicySpec>
    */
   enableAutoPromotion = false;
 
+  /**
+   * @generated from field: bool enable_self_heal = 5;
+   */
+  enableSelfHeal = false;
+
   constructor(data?: PartialMessage<TypedPromotionPolicySpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1835,6 +2769,7 @@ export class TypedPromotionPolicySpec extends Message<TypedPromotionPolicySpec>
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "enable_auto_promotion", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "enable_self_heal", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TypedPromotionPolicySpec {
@@ -1868,6 +2803,11 @@ export class ListPromotionsRequest extends Message<ListPromotionsRequest> {
    */
   stage?: string;
 
+  /**
+   * @generated from field: bool include_table = 3;
+   */
+  includeTable = false;
+
   constructor(data?: PartialMessage<ListPromotionsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1878,6 +2818,7 @@ export class ListPromotionsRequest extends Message<ListPromotionsRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "include_table", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPromotionsRequest {
@@ -1906,6 +2847,11 @@ export class ListPromotionsResponse extends Message<ListPromotionsResponse> {
    */
   promotions: Promotion[] = [];
 
+  /**
+   * @generated from field: akuity.io.kargo.service.v1alpha1.Table table = 2;
+   */
+  table?: Table;
+
   constructor(data?: PartialMessage<ListPromotionsResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1915,6 +2861,7 @@ export class ListPromotionsResponse extends Message<ListPromotionsResponse> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListPromotionsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "promotions", kind: "message", T: Promotion, repeated: true },
+    { no: 2, name: "table", kind: "message", T: Table },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPromotionsResponse {
@@ -2690,6 +3637,32 @@ export class Project extends Message<Project> {
    */
   createTime?: Timestamp;
 
+  /**
+   * archived indicates whether the Project is archived. The resources of an
+   * archived Project remain readable, but are no longer reconciled and cannot
+   * be modified.
+   *
+   * @generated from field: bool archived = 3;
+   */
+  archived = false;
+
+  /**
+   * backstage_entities are the normalized refs (e.g.
+   * "component:default/guestbook") of the Backstage catalog entities that the
+   * Project is annotated as deploying.
+   *
+   * @generated from field: repeated string backstage_entities = 4;
+   */
+  backstageEntities: string[] = [];
+
+  /**
+   * catalog_annotations are the annotations to add to the metadata of a
+   * Backstage catalog entity to associate it with the Project.
+   *
+   * @generated from field: map<string, string> catalog_annotations = 5;
+   */
+  catalogAnnotations: { [key: string]: string } = {};
+
   constructor(data?: PartialMessage<Project>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2700,6 +3673,9 @@ export class Project extends Message<Project> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "create_time", kind: "message", T: Timestamp },
+    { no: 3, name: "archived", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "backstage_entities", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "catalog_annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Project {
@@ -2794,11 +3770,14 @@ export class CreateProjectResponse extends Message<CreateProjectResponse> {
 }
 
 /**
- * explicitly empty 
- *
  * @generated from message akuity.io.kargo.service.v1alpha1.ListProjectsRequest
  */
 export class ListProjectsRequest extends Message<ListProjectsRequest> {
+  /**
+   * @generated from field: bool include_table = 1;
+   */
+  includeTable = false;
+
   constructor(data?: PartialMessage<ListProjectsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2807,6 +3786,7 @@ export class ListProjectsRequest extends Message<ListProjectsRequest> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListProjectsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "include_table", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectsRequest {
@@ -2835,6 +3815,11 @@ export class ListProjectsResponse extends Message<ListProjectsResponse> {
    */
   projects: Project[] = [];
 
+  /**
+   * @generated from field: akuity.io.kargo.service.v1alpha1.Table table = 2;
+   */
+  table?: Table;
+
   constructor(data?: PartialMessage<ListProjectsResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2844,6 +3829,7 @@ export class ListProjectsResponse extends Message<ListProjectsResponse> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListProjectsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "projects", kind: "message", T: Project, repeated: true },
+    { no: 2, name: "table", kind: "message", T: Table },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectsResponse {
@@ -2934,390 +3920,2458 @@ export class DeleteProjectResponse extends Message<DeleteProjectResponse> {
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.QueryFreightRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.ArchiveProjectRequest
  */
-export class QueryFreightRequest extends Message<QueryFreightRequest> {
-  /**
-   * @generated from field: string project = 1;
-   */
-  project = "";
-
-  /**
-   * @generated from field: string stage = 2;
-   */
-  stage = "";
-
-  /**
-   * @generated from field: string group_by = 3;
-   */
-  groupBy = "";
-
-  /**
-   * @generated from field: string group = 4;
-   */
-  group = "";
-
-  /**
-   * @generated from field: string order_by = 5;
-   */
-  orderBy = "";
-
+export class ArchiveProjectRequest extends Message<ArchiveProjectRequest> {
   /**
-   * @generated from field: bool reverse = 6;
+   * @generated from field: string name = 1;
    */
-  reverse = false;
+  name = "";
 
-  constructor(data?: PartialMessage<QueryFreightRequest>) {
+  constructor(data?: PartialMessage<ArchiveProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.QueryFreightRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ArchiveProjectRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "group_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "group", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "order_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "reverse", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryFreightRequest {
-    return new QueryFreightRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArchiveProjectRequest {
+    return new ArchiveProjectRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryFreightRequest {
-    return new QueryFreightRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArchiveProjectRequest {
+    return new ArchiveProjectRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryFreightRequest {
-    return new QueryFreightRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArchiveProjectRequest {
+    return new ArchiveProjectRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: QueryFreightRequest | PlainMessage<QueryFreightRequest> | undefined, b: QueryFreightRequest | PlainMessage<QueryFreightRequest> | undefined): boolean {
-    return proto3.util.equals(QueryFreightRequest, a, b);
+  static equals(a: ArchiveProjectRequest | PlainMessage<ArchiveProjectRequest> | undefined, b: ArchiveProjectRequest | PlainMessage<ArchiveProjectRequest> | undefined): boolean {
+    return proto3.util.equals(ArchiveProjectRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.QueryFreightResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.ArchiveProjectResponse
  */
-export class QueryFreightResponse extends Message<QueryFreightResponse> {
+export class ArchiveProjectResponse extends Message<ArchiveProjectResponse> {
   /**
-   * @generated from field: map<string, akuity.io.kargo.service.v1alpha1.FreightList> groups = 1;
+   * @generated from field: akuity.io.kargo.service.v1alpha1.Project project = 1;
    */
-  groups: { [key: string]: FreightList } = {};
+  project?: Project;
 
-  constructor(data?: PartialMessage<QueryFreightResponse>) {
+  constructor(data?: PartialMessage<ArchiveProjectResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.QueryFreightResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ArchiveProjectResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "groups", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: FreightList} },
+    { no: 1, name: "project", kind: "message", T: Project },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryFreightResponse {
-    return new QueryFreightResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArchiveProjectResponse {
+    return new ArchiveProjectResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryFreightResponse {
-    return new QueryFreightResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArchiveProjectResponse {
+    return new ArchiveProjectResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryFreightResponse {
-    return new QueryFreightResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArchiveProjectResponse {
+    return new ArchiveProjectResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: QueryFreightResponse | PlainMessage<QueryFreightResponse> | undefined, b: QueryFreightResponse | PlainMessage<QueryFreightResponse> | undefined): boolean {
-    return proto3.util.equals(QueryFreightResponse, a, b);
+  static equals(a: ArchiveProjectResponse | PlainMessage<ArchiveProjectResponse> | undefined, b: ArchiveProjectResponse | PlainMessage<ArchiveProjectResponse> | undefined): boolean {
+    return proto3.util.equals(ArchiveProjectResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.FreightList
+ * @generated from message akuity.io.kargo.service.v1alpha1.UnarchiveProjectRequest
  */
-export class FreightList extends Message<FreightList> {
+export class UnarchiveProjectRequest extends Message<UnarchiveProjectRequest> {
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Freight freight = 1;
+   * @generated from field: string name = 1;
    */
-  freight: Freight[] = [];
+  name = "";
 
-  constructor(data?: PartialMessage<FreightList>) {
+  constructor(data?: PartialMessage<UnarchiveProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.FreightList";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UnarchiveProjectRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "freight", kind: "message", T: Freight, repeated: true },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightList {
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnarchiveProjectRequest {
+    return new UnarchiveProjectRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnarchiveProjectRequest {
+    return new UnarchiveProjectRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnarchiveProjectRequest {
+    return new UnarchiveProjectRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnarchiveProjectRequest | PlainMessage<UnarchiveProjectRequest> | undefined, b: UnarchiveProjectRequest | PlainMessage<UnarchiveProjectRequest> | undefined): boolean {
+    return proto3.util.equals(UnarchiveProjectRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UnarchiveProjectResponse
+ */
+export class UnarchiveProjectResponse extends Message<UnarchiveProjectResponse> {
+  /**
+   * @generated from field: akuity.io.kargo.service.v1alpha1.Project project = 1;
+   */
+  project?: Project;
+
+  constructor(data?: PartialMessage<UnarchiveProjectResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UnarchiveProjectResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "message", T: Project },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnarchiveProjectResponse {
+    return new UnarchiveProjectResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnarchiveProjectResponse {
+    return new UnarchiveProjectResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnarchiveProjectResponse {
+    return new UnarchiveProjectResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnarchiveProjectResponse | PlainMessage<UnarchiveProjectResponse> | undefined, b: UnarchiveProjectResponse | PlainMessage<UnarchiveProjectResponse> | undefined): boolean {
+    return proto3.util.equals(UnarchiveProjectResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetBackstageEntityStatusRequest
+ */
+export class GetBackstageEntityStatusRequest extends Message<GetBackstageEntityStatusRequest> {
+  /**
+   * entity_ref is the ref of a Backstage catalog entity, in the form
+   * [<kind>:][<namespace>/]<name>. The kind defaults to "component" and the
+   * namespace defaults to "default".
+   *
+   * @generated from field: string entity_ref = 1;
+   */
+  entityRef = "";
+
+  constructor(data?: PartialMessage<GetBackstageEntityStatusRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetBackstageEntityStatusRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entity_ref", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBackstageEntityStatusRequest {
+    return new GetBackstageEntityStatusRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBackstageEntityStatusRequest {
+    return new GetBackstageEntityStatusRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBackstageEntityStatusRequest {
+    return new GetBackstageEntityStatusRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBackstageEntityStatusRequest | PlainMessage<GetBackstageEntityStatusRequest> | undefined, b: GetBackstageEntityStatusRequest | PlainMessage<GetBackstageEntityStatusRequest> | undefined): boolean {
+    return proto3.util.equals(GetBackstageEntityStatusRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetBackstageEntityStatusResponse
+ */
+export class GetBackstageEntityStatusResponse extends Message<GetBackstageEntityStatusResponse> {
+  /**
+   * entity_ref is the normalized form of the requested entity ref.
+   *
+   * @generated from field: string entity_ref = 1;
+   */
+  entityRef = "";
+
+  /**
+   * pipelines describes, for each Project annotated as deploying the entity,
+   * the status of the Project's pipeline.
+   *
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.BackstagePipeline pipelines = 2;
+   */
+  pipelines: BackstagePipeline[] = [];
+
+  constructor(data?: PartialMessage<GetBackstageEntityStatusResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetBackstageEntityStatusResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entity_ref", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "pipelines", kind: "message", T: BackstagePipeline, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBackstageEntityStatusResponse {
+    return new GetBackstageEntityStatusResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBackstageEntityStatusResponse {
+    return new GetBackstageEntityStatusResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBackstageEntityStatusResponse {
+    return new GetBackstageEntityStatusResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBackstageEntityStatusResponse | PlainMessage<GetBackstageEntityStatusResponse> | undefined, b: GetBackstageEntityStatusResponse | PlainMessage<GetBackstageEntityStatusResponse> | undefined): boolean {
+    return proto3.util.equals(GetBackstageEntityStatusResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.BackstagePipeline
+ */
+export class BackstagePipeline extends Message<BackstagePipeline> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * status is the most severe status of any of the deployments, where
+   * "Unhealthy" is more severe than "Unknown", which is more severe than
+   * "Progressing", which is more severe than "Healthy". It is "Unknown" if
+   * there are no deployments.
+   *
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * deployments describes each Stage of the Project that deploys the entity.
+   *
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.BackstageDeployment deployments = 3;
+   */
+  deployments: BackstageDeployment[] = [];
+
+  constructor(data?: PartialMessage<BackstagePipeline>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.BackstagePipeline";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "deployments", kind: "message", T: BackstageDeployment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BackstagePipeline {
+    return new BackstagePipeline().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BackstagePipeline {
+    return new BackstagePipeline().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BackstagePipeline {
+    return new BackstagePipeline().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BackstagePipeline | PlainMessage<BackstagePipeline> | undefined, b: BackstagePipeline | PlainMessage<BackstagePipeline> | undefined): boolean {
+    return proto3.util.equals(BackstagePipeline, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.BackstageDeployment
+ */
+export class BackstageDeployment extends Message<BackstageDeployment> {
+  /**
+   * @generated from field: string stage = 1;
+   */
+  stage = "";
+
+  /**
+   * status is "Progressing" if a Promotion to the Stage is in progress and is
+   * otherwise the Stage's health. i.e. One of "Healthy", "Unhealthy",
+   * "Progressing", or "Unknown".
+   *
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * freight is the ID of the Freight currently deployed to the Stage.
+   *
+   * @generated from field: string freight = 3;
+   */
+  freight = "";
+
+  /**
+   * images are the container images deployed to the Stage, in the form
+   * <repo>:<tag>.
+   *
+   * @generated from field: repeated string images = 4;
+   */
+  images: string[] = [];
+
+  /**
+   * commits are the Git commits deployed to the Stage, in the form
+   * <repo>@<id>.
+   *
+   * @generated from field: repeated string commits = 5;
+   */
+  commits: string[] = [];
+
+  /**
+   * charts are the Helm charts deployed to the Stage, in the form
+   * <registry>/<name>:<version>.
+   *
+   * @generated from field: repeated string charts = 6;
+   */
+  charts: string[] = [];
+
+  /**
+   * promotion is the name of the Promotion to the Stage that is in progress,
+   * if any.
+   *
+   * @generated from field: string promotion = 7;
+   */
+  promotion = "";
+
+  constructor(data?: PartialMessage<BackstageDeployment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.BackstageDeployment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "images", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "commits", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "charts", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "promotion", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BackstageDeployment {
+    return new BackstageDeployment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BackstageDeployment {
+    return new BackstageDeployment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BackstageDeployment {
+    return new BackstageDeployment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BackstageDeployment | PlainMessage<BackstageDeployment> | undefined, b: BackstageDeployment | PlainMessage<BackstageDeployment> | undefined): boolean {
+    return proto3.util.equals(BackstageDeployment, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.QueryFreightRequest
+ */
+export class QueryFreightRequest extends Message<QueryFreightRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string stage = 2;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: string group_by = 3;
+   */
+  groupBy = "";
+
+  /**
+   * @generated from field: string group = 4;
+   */
+  group = "";
+
+  /**
+   * @generated from field: string order_by = 5;
+   */
+  orderBy = "";
+
+  /**
+   * @generated from field: bool reverse = 6;
+   */
+  reverse = false;
+
+  constructor(data?: PartialMessage<QueryFreightRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.QueryFreightRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "group_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "group", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "order_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "reverse", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryFreightRequest {
+    return new QueryFreightRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryFreightRequest {
+    return new QueryFreightRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryFreightRequest {
+    return new QueryFreightRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueryFreightRequest | PlainMessage<QueryFreightRequest> | undefined, b: QueryFreightRequest | PlainMessage<QueryFreightRequest> | undefined): boolean {
+    return proto3.util.equals(QueryFreightRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.QueryFreightResponse
+ */
+export class QueryFreightResponse extends Message<QueryFreightResponse> {
+  /**
+   * @generated from field: map<string, akuity.io.kargo.service.v1alpha1.FreightList> groups = 1;
+   */
+  groups: { [key: string]: FreightList } = {};
+
+  constructor(data?: PartialMessage<QueryFreightResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.QueryFreightResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "groups", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: FreightList} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryFreightResponse {
+    return new QueryFreightResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryFreightResponse {
+    return new QueryFreightResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryFreightResponse {
+    return new QueryFreightResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueryFreightResponse | PlainMessage<QueryFreightResponse> | undefined, b: QueryFreightResponse | PlainMessage<QueryFreightResponse> | undefined): boolean {
+    return proto3.util.equals(QueryFreightResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.DiffFreightRequest
+ */
+export class DiffFreightRequest extends Message<DiffFreightRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * from is the ID of the Freight to diff from.
+   *
+   * @generated from field: string from = 2;
+   */
+  from = "";
+
+  /**
+   * to is the ID of the Freight to diff to.
+   *
+   * @generated from field: string to = 3;
+   */
+  to = "";
+
+  /**
+   * repo_url selects which Git repository is diffed when the two Freight
+   * reference commits from more than one repository in common. It may be
+   * omitted otherwise.
+   *
+   * @generated from field: string repo_url = 4;
+   */
+  repoUrl = "";
+
+  /**
+   * paths, if specified, restricts the diff to the specified paths, relative
+   * to the root of the repository.
+   *
+   * @generated from field: repeated string paths = 5;
+   */
+  paths: string[] = [];
+
+  constructor(data?: PartialMessage<DiffFreightRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DiffFreightRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiffFreightRequest {
+    return new DiffFreightRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiffFreightRequest {
+    return new DiffFreightRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiffFreightRequest {
+    return new DiffFreightRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiffFreightRequest | PlainMessage<DiffFreightRequest> | undefined, b: DiffFreightRequest | PlainMessage<DiffFreightRequest> | undefined): boolean {
+    return proto3.util.equals(DiffFreightRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.DiffFreightResponse
+ */
+export class DiffFreightResponse extends Message<DiffFreightResponse> {
+  /**
+   * @generated from field: string repo_url = 1;
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string from_commit = 2;
+   */
+  fromCommit = "";
+
+  /**
+   * @generated from field: string to_commit = 3;
+   */
+  toCommit = "";
+
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.FileDiff files = 4;
+   */
+  files: FileDiff[] = [];
+
+  constructor(data?: PartialMessage<DiffFreightResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DiffFreightResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from_commit", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to_commit", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "files", kind: "message", T: FileDiff, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiffFreightResponse {
+    return new DiffFreightResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiffFreightResponse {
+    return new DiffFreightResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiffFreightResponse {
+    return new DiffFreightResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiffFreightResponse | PlainMessage<DiffFreightResponse> | undefined, b: DiffFreightResponse | PlainMessage<DiffFreightResponse> | undefined): boolean {
+    return proto3.util.equals(DiffFreightResponse, a, b);
+  }
+}
+
+/**
+ * FileDiff summarizes the differences in a single file between two commits.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.FileDiff
+ */
+export class FileDiff extends Message<FileDiff> {
+  /**
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * status is one of "Added", "Deleted", "Modified", or "TypeChanged".
+   *
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * @generated from field: int32 additions = 3;
+   */
+  additions = 0;
+
+  /**
+   * @generated from field: int32 deletions = 4;
+   */
+  deletions = 0;
+
+  /**
+   * binary indicates that the file is binary, in which case additions and
+   * deletions are always zero.
+   *
+   * @generated from field: bool binary = 5;
+   */
+  binary = false;
+
+  constructor(data?: PartialMessage<FileDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.FileDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "additions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "deletions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "binary", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FileDiff {
+    return new FileDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FileDiff {
+    return new FileDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FileDiff {
+    return new FileDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FileDiff | PlainMessage<FileDiff> | undefined, b: FileDiff | PlainMessage<FileDiff> | undefined): boolean {
+    return proto3.util.equals(FileDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ExplainDiscoveryRequest
+ */
+export class ExplainDiscoveryRequest extends Message<ExplainDiscoveryRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * revision is an image tag, a chart version, or a Git commit ID. Git commit
+   * IDs may be abbreviated.
+   *
+   * @generated from field: string revision = 2;
+   */
+  revision = "";
+
+  /**
+   * repo_url, if specified, restricts the explanation to subscriptions to the
+   * image repository, Git repository, or chart registry with this URL.
+   *
+   * @generated from field: string repo_url = 3;
+   */
+  repoUrl = "";
+
+  constructor(data?: PartialMessage<ExplainDiscoveryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ExplainDiscoveryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "revision", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainDiscoveryRequest {
+    return new ExplainDiscoveryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainDiscoveryRequest {
+    return new ExplainDiscoveryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainDiscoveryRequest {
+    return new ExplainDiscoveryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExplainDiscoveryRequest | PlainMessage<ExplainDiscoveryRequest> | undefined, b: ExplainDiscoveryRequest | PlainMessage<ExplainDiscoveryRequest> | undefined): boolean {
+    return proto3.util.equals(ExplainDiscoveryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ExplainDiscoveryResponse
+ */
+export class ExplainDiscoveryResponse extends Message<ExplainDiscoveryResponse> {
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.DiscoveryExplanation explanations = 1;
+   */
+  explanations: DiscoveryExplanation[] = [];
+
+  constructor(data?: PartialMessage<ExplainDiscoveryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ExplainDiscoveryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "explanations", kind: "message", T: DiscoveryExplanation, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainDiscoveryResponse {
+    return new ExplainDiscoveryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainDiscoveryResponse {
+    return new ExplainDiscoveryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainDiscoveryResponse {
+    return new ExplainDiscoveryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExplainDiscoveryResponse | PlainMessage<ExplainDiscoveryResponse> | undefined, b: ExplainDiscoveryResponse | PlainMessage<ExplainDiscoveryResponse> | undefined): boolean {
+    return proto3.util.equals(ExplainDiscoveryResponse, a, b);
+  }
+}
+
+/**
+ * DiscoveryExplanation explains what became of a revision with respect to a
+ * single subscription of a single Warehouse.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.DiscoveryExplanation
+ */
+export class DiscoveryExplanation extends Message<DiscoveryExplanation> {
+  /**
+   * @generated from field: string warehouse = 1;
+   */
+  warehouse = "";
+
+  /**
+   * @generated from field: string repo_url = 2;
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: optional string chart = 3;
+   */
+  chart?: string;
+
+  /**
+   * outcome is one of "Discovered", "Rejected", or "NotDiscovered".
+   *
+   * @generated from field: string outcome = 4;
+   */
+  outcome = "";
+
+  /**
+   * reason elaborates on the outcome.
+   *
+   * @generated from field: string reason = 5;
+   */
+  reason = "";
+
+  /**
+   * freight is the name of the Freight carrying the revision, if it was
+   * discovered.
+   *
+   * @generated from field: optional string freight = 6;
+   */
+  freight?: string;
+
+  /**
+   * @generated from field: optional string alias = 7;
+   */
+  alias?: string;
+
+  /**
+   * qualified_stages are the Stages the Freight has qualified for.
+   *
+   * @generated from field: repeated string qualified_stages = 8;
+   */
+  qualifiedStages: string[] = [];
+
+  /**
+   * approved_stages are the Stages the Freight has been manually approved for.
+   *
+   * @generated from field: repeated string approved_stages = 9;
+   */
+  approvedStages: string[] = [];
+
+  constructor(data?: PartialMessage<DiscoveryExplanation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DiscoveryExplanation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouse", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "chart", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "outcome", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "alias", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "qualified_stages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "approved_stages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiscoveryExplanation {
+    return new DiscoveryExplanation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiscoveryExplanation {
+    return new DiscoveryExplanation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiscoveryExplanation {
+    return new DiscoveryExplanation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiscoveryExplanation | PlainMessage<DiscoveryExplanation> | undefined, b: DiscoveryExplanation | PlainMessage<DiscoveryExplanation> | undefined): boolean {
+    return proto3.util.equals(DiscoveryExplanation, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.FreightList
+ */
+export class FreightList extends Message<FreightList> {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Freight freight = 1;
+   */
+  freight: Freight[] = [];
+
+  constructor(data?: PartialMessage<FreightList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.FreightList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "freight", kind: "message", T: Freight, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightList {
     return new FreightList().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FreightList {
-    return new FreightList().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FreightList {
+    return new FreightList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FreightList {
+    return new FreightList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FreightList | PlainMessage<FreightList> | undefined, b: FreightList | PlainMessage<FreightList> | undefined): boolean {
+    return proto3.util.equals(FreightList, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ApproveFreightRequest
+ */
+export class ApproveFreightRequest extends Message<ApproveFreightRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string stage = 3;
+   */
+  stage = "";
+
+  constructor(data?: PartialMessage<ApproveFreightRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ApproveFreightRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveFreightRequest {
+    return new ApproveFreightRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveFreightRequest {
+    return new ApproveFreightRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveFreightRequest {
+    return new ApproveFreightRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveFreightRequest | PlainMessage<ApproveFreightRequest> | undefined, b: ApproveFreightRequest | PlainMessage<ApproveFreightRequest> | undefined): boolean {
+    return proto3.util.equals(ApproveFreightRequest, a, b);
+  }
+}
+
+/**
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.ApproveFreightResponse
+ */
+export class ApproveFreightResponse extends Message<ApproveFreightResponse> {
+  constructor(data?: PartialMessage<ApproveFreightResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ApproveFreightResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveFreightResponse {
+    return new ApproveFreightResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveFreightResponse {
+    return new ApproveFreightResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveFreightResponse {
+    return new ApproveFreightResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveFreightResponse | PlainMessage<ApproveFreightResponse> | undefined, b: ApproveFreightResponse | PlainMessage<ApproveFreightResponse> | undefined): boolean {
+    return proto3.util.equals(ApproveFreightResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.AttachBuildInfoRequest
+ */
+export class AttachBuildInfoRequest extends Message<AttachBuildInfoRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.BuildInfo build_info = 2;
+   */
+  buildInfo: BuildInfo[] = [];
+
+  constructor(data?: PartialMessage<AttachBuildInfoRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.AttachBuildInfoRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "build_info", kind: "message", T: BuildInfo, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttachBuildInfoRequest {
+    return new AttachBuildInfoRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttachBuildInfoRequest {
+    return new AttachBuildInfoRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttachBuildInfoRequest {
+    return new AttachBuildInfoRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AttachBuildInfoRequest | PlainMessage<AttachBuildInfoRequest> | undefined, b: AttachBuildInfoRequest | PlainMessage<AttachBuildInfoRequest> | undefined): boolean {
+    return proto3.util.equals(AttachBuildInfoRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.AttachBuildInfoResponse
+ */
+export class AttachBuildInfoResponse extends Message<AttachBuildInfoResponse> {
+  /**
+   * @generated from field: repeated string warehouses = 1;
+   */
+  warehouses: string[] = [];
+
+  constructor(data?: PartialMessage<AttachBuildInfoResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.AttachBuildInfoResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouses", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttachBuildInfoResponse {
+    return new AttachBuildInfoResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttachBuildInfoResponse {
+    return new AttachBuildInfoResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttachBuildInfoResponse {
+    return new AttachBuildInfoResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AttachBuildInfoResponse | PlainMessage<AttachBuildInfoResponse> | undefined, b: AttachBuildInfoResponse | PlainMessage<AttachBuildInfoResponse> | undefined): boolean {
+    return proto3.util.equals(AttachBuildInfoResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListWarehousesRequest
+ */
+export class ListWarehousesRequest extends Message<ListWarehousesRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: bool include_table = 2;
+   */
+  includeTable = false;
+
+  constructor(data?: PartialMessage<ListWarehousesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListWarehousesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "include_table", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWarehousesRequest {
+    return new ListWarehousesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWarehousesRequest {
+    return new ListWarehousesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWarehousesRequest {
+    return new ListWarehousesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWarehousesRequest | PlainMessage<ListWarehousesRequest> | undefined, b: ListWarehousesRequest | PlainMessage<ListWarehousesRequest> | undefined): boolean {
+    return proto3.util.equals(ListWarehousesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListWarehousesResponse
+ */
+export class ListWarehousesResponse extends Message<ListWarehousesResponse> {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouses = 1;
+   */
+  warehouses: Warehouse[] = [];
+
+  /**
+   * @generated from field: akuity.io.kargo.service.v1alpha1.Table table = 2;
+   */
+  table?: Table;
+
+  constructor(data?: PartialMessage<ListWarehousesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListWarehousesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouses", kind: "message", T: Warehouse, repeated: true },
+    { no: 2, name: "table", kind: "message", T: Table },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWarehousesResponse {
+    return new ListWarehousesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWarehousesResponse {
+    return new ListWarehousesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWarehousesResponse {
+    return new ListWarehousesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWarehousesResponse | PlainMessage<ListWarehousesResponse> | undefined, b: ListWarehousesResponse | PlainMessage<ListWarehousesResponse> | undefined): boolean {
+    return proto3.util.equals(ListWarehousesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetWarehouseRequest
+ */
+export class GetWarehouseRequest extends Message<GetWarehouseRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<GetWarehouseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetWarehouseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWarehouseRequest {
+    return new GetWarehouseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWarehouseRequest {
+    return new GetWarehouseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWarehouseRequest {
+    return new GetWarehouseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWarehouseRequest | PlainMessage<GetWarehouseRequest> | undefined, b: GetWarehouseRequest | PlainMessage<GetWarehouseRequest> | undefined): boolean {
+    return proto3.util.equals(GetWarehouseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetWarehouseResponse
+ */
+export class GetWarehouseResponse extends Message<GetWarehouseResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   */
+  warehouse?: Warehouse;
+
+  constructor(data?: PartialMessage<GetWarehouseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetWarehouseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWarehouseResponse {
+    return new GetWarehouseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWarehouseResponse {
+    return new GetWarehouseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWarehouseResponse {
+    return new GetWarehouseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWarehouseResponse | PlainMessage<GetWarehouseResponse> | undefined, b: GetWarehouseResponse | PlainMessage<GetWarehouseResponse> | undefined): boolean {
+    return proto3.util.equals(GetWarehouseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.WatchWarehousesRequest
+ */
+export class WatchWarehousesRequest extends Message<WatchWarehousesRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<WatchWarehousesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.WatchWarehousesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchWarehousesRequest {
+    return new WatchWarehousesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchWarehousesRequest {
+    return new WatchWarehousesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchWarehousesRequest {
+    return new WatchWarehousesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchWarehousesRequest | PlainMessage<WatchWarehousesRequest> | undefined, b: WatchWarehousesRequest | PlainMessage<WatchWarehousesRequest> | undefined): boolean {
+    return proto3.util.equals(WatchWarehousesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.WatchWarehousesResponse
+ */
+export class WatchWarehousesResponse extends Message<WatchWarehousesResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   */
+  warehouse?: Warehouse;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  constructor(data?: PartialMessage<WatchWarehousesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.WatchWarehousesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchWarehousesResponse {
+    return new WatchWarehousesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchWarehousesResponse {
+    return new WatchWarehousesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchWarehousesResponse {
+    return new WatchWarehousesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchWarehousesResponse | PlainMessage<WatchWarehousesResponse> | undefined, b: WatchWarehousesResponse | PlainMessage<WatchWarehousesResponse> | undefined): boolean {
+    return proto3.util.equals(WatchWarehousesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec
+ */
+export class TypedWarehouseSpec extends Message<TypedWarehouseSpec> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec spec = 3;
+   */
+  spec?: WarehouseSpec;
+
+  constructor(data?: PartialMessage<TypedWarehouseSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "spec", kind: "message", T: WarehouseSpec },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TypedWarehouseSpec {
+    return new TypedWarehouseSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TypedWarehouseSpec {
+    return new TypedWarehouseSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TypedWarehouseSpec {
+    return new TypedWarehouseSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TypedWarehouseSpec | PlainMessage<TypedWarehouseSpec> | undefined, b: TypedWarehouseSpec | PlainMessage<TypedWarehouseSpec> | undefined): boolean {
+    return proto3.util.equals(TypedWarehouseSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest
+ */
+export class CreateWarehouseRequest extends Message<CreateWarehouseRequest> {
+  /**
+   * @generated from oneof akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest.warehouse
+   */
+  warehouse: {
+    /**
+     * @generated from field: akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec typed = 1;
+     */
+    value: TypedWarehouseSpec;
+    case: "typed";
+  } | {
+    /**
+     * @generated from field: string yaml = 2;
+     */
+    value: string;
+    case: "yaml";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<CreateWarehouseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "typed", kind: "message", T: TypedWarehouseSpec, oneof: "warehouse" },
+    { no: 2, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "warehouse" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWarehouseRequest {
+    return new CreateWarehouseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWarehouseRequest {
+    return new CreateWarehouseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWarehouseRequest {
+    return new CreateWarehouseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWarehouseRequest | PlainMessage<CreateWarehouseRequest> | undefined, b: CreateWarehouseRequest | PlainMessage<CreateWarehouseRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWarehouseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateWarehouseResponse
+ */
+export class CreateWarehouseResponse extends Message<CreateWarehouseResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   */
+  warehouse?: Warehouse;
+
+  constructor(data?: PartialMessage<CreateWarehouseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateWarehouseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWarehouseResponse {
+    return new CreateWarehouseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWarehouseResponse {
+    return new CreateWarehouseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWarehouseResponse {
+    return new CreateWarehouseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWarehouseResponse | PlainMessage<CreateWarehouseResponse> | undefined, b: CreateWarehouseResponse | PlainMessage<CreateWarehouseResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWarehouseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest
+ */
+export class UpdateWarehouseRequest extends Message<UpdateWarehouseRequest> {
+  /**
+   * @generated from oneof akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest.warehouse
+   */
+  warehouse: {
+    /**
+     * @generated from field: akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec typed = 1;
+     */
+    value: TypedWarehouseSpec;
+    case: "typed";
+  } | {
+    /**
+     * @generated from field: string yaml = 2;
+     */
+    value: string;
+    case: "yaml";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<UpdateWarehouseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "typed", kind: "message", T: TypedWarehouseSpec, oneof: "warehouse" },
+    { no: 2, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "warehouse" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWarehouseRequest {
+    return new UpdateWarehouseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWarehouseRequest {
+    return new UpdateWarehouseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWarehouseRequest {
+    return new UpdateWarehouseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateWarehouseRequest | PlainMessage<UpdateWarehouseRequest> | undefined, b: UpdateWarehouseRequest | PlainMessage<UpdateWarehouseRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateWarehouseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UpdateWarehouseResponse
+ */
+export class UpdateWarehouseResponse extends Message<UpdateWarehouseResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   */
+  warehouse?: Warehouse;
+
+  constructor(data?: PartialMessage<UpdateWarehouseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateWarehouseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWarehouseResponse {
+    return new UpdateWarehouseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWarehouseResponse {
+    return new UpdateWarehouseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWarehouseResponse {
+    return new UpdateWarehouseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateWarehouseResponse | PlainMessage<UpdateWarehouseResponse> | undefined, b: UpdateWarehouseResponse | PlainMessage<UpdateWarehouseResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateWarehouseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.DeleteWarehouseRequest
+ */
+export class DeleteWarehouseRequest extends Message<DeleteWarehouseRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<DeleteWarehouseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteWarehouseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWarehouseRequest {
+    return new DeleteWarehouseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWarehouseRequest {
+    return new DeleteWarehouseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWarehouseRequest {
+    return new DeleteWarehouseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWarehouseRequest | PlainMessage<DeleteWarehouseRequest> | undefined, b: DeleteWarehouseRequest | PlainMessage<DeleteWarehouseRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteWarehouseRequest, a, b);
+  }
+}
+
+/**
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.DeleteWarehouseResponse
+ */
+export class DeleteWarehouseResponse extends Message<DeleteWarehouseResponse> {
+  constructor(data?: PartialMessage<DeleteWarehouseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteWarehouseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWarehouseResponse {
+    return new DeleteWarehouseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWarehouseResponse {
+    return new DeleteWarehouseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWarehouseResponse {
+    return new DeleteWarehouseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWarehouseResponse | PlainMessage<DeleteWarehouseResponse> | undefined, b: DeleteWarehouseResponse | PlainMessage<DeleteWarehouseResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteWarehouseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RefreshWarehouseRequest
+ */
+export class RefreshWarehouseRequest extends Message<RefreshWarehouseRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<RefreshWarehouseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshWarehouseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshWarehouseRequest {
+    return new RefreshWarehouseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshWarehouseRequest {
+    return new RefreshWarehouseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshWarehouseRequest {
+    return new RefreshWarehouseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshWarehouseRequest | PlainMessage<RefreshWarehouseRequest> | undefined, b: RefreshWarehouseRequest | PlainMessage<RefreshWarehouseRequest> | undefined): boolean {
+    return proto3.util.equals(RefreshWarehouseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RefreshWarehouseResponse
+ */
+export class RefreshWarehouseResponse extends Message<RefreshWarehouseResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   */
+  warehouse?: Warehouse;
+
+  constructor(data?: PartialMessage<RefreshWarehouseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshWarehouseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshWarehouseResponse {
+    return new RefreshWarehouseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshWarehouseResponse {
+    return new RefreshWarehouseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshWarehouseResponse {
+    return new RefreshWarehouseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshWarehouseResponse | PlainMessage<RefreshWarehouseResponse> | undefined, b: RefreshWarehouseResponse | PlainMessage<RefreshWarehouseResponse> | undefined): boolean {
+    return proto3.util.equals(RefreshWarehouseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GenerateWarehouseFromArgoCDAppRequest
+ */
+export class GenerateWarehouseFromArgoCDAppRequest extends Message<GenerateWarehouseFromArgoCDAppRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string app_name = 2;
+   */
+  appName = "";
+
+  /**
+   * app_namespace is the namespace of the Argo CD Application. It defaults to
+   * the namespace Argo CD is installed in.
+   *
+   * @generated from field: optional string app_namespace = 3;
+   */
+  appNamespace?: string;
+
+  /**
+   * name is the name of the generated Warehouse and Stage. It defaults to the
+   * name of the Argo CD Application.
+   *
+   * @generated from field: optional string name = 4;
+   */
+  name?: string;
+
+  constructor(data?: PartialMessage<GenerateWarehouseFromArgoCDAppRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GenerateWarehouseFromArgoCDAppRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "app_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "app_namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateWarehouseFromArgoCDAppRequest {
+    return new GenerateWarehouseFromArgoCDAppRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateWarehouseFromArgoCDAppRequest {
+    return new GenerateWarehouseFromArgoCDAppRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateWarehouseFromArgoCDAppRequest {
+    return new GenerateWarehouseFromArgoCDAppRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateWarehouseFromArgoCDAppRequest | PlainMessage<GenerateWarehouseFromArgoCDAppRequest> | undefined, b: GenerateWarehouseFromArgoCDAppRequest | PlainMessage<GenerateWarehouseFromArgoCDAppRequest> | undefined): boolean {
+    return proto3.util.equals(GenerateWarehouseFromArgoCDAppRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GenerateWarehouseFromArgoCDAppResponse
+ */
+export class GenerateWarehouseFromArgoCDAppResponse extends Message<GenerateWarehouseFromArgoCDAppResponse> {
+  /**
+   * manifest is a multi-document YAML manifest describing a Warehouse that
+   * subscribes to the Argo CD Application's sources and the images it runs,
+   * and a Stage that promotes Freight from that Warehouse by updating the
+   * Application. Neither resource is created.
+   *
+   * @generated from field: bytes manifest = 1;
+   */
+  manifest = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<GenerateWarehouseFromArgoCDAppResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GenerateWarehouseFromArgoCDAppResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "manifest", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateWarehouseFromArgoCDAppResponse {
+    return new GenerateWarehouseFromArgoCDAppResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateWarehouseFromArgoCDAppResponse {
+    return new GenerateWarehouseFromArgoCDAppResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateWarehouseFromArgoCDAppResponse {
+    return new GenerateWarehouseFromArgoCDAppResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateWarehouseFromArgoCDAppResponse | PlainMessage<GenerateWarehouseFromArgoCDAppResponse> | undefined, b: GenerateWarehouseFromArgoCDAppResponse | PlainMessage<GenerateWarehouseFromArgoCDAppResponse> | undefined): boolean {
+    return proto3.util.equals(GenerateWarehouseFromArgoCDAppResponse, a, b);
+  }
+}
+
+/**
+ * Operation is heavy work performed by the API server in the background on
+ * behalf of the user who started it. Clients poll for its state until it
+ * reaches a terminal phase.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.Operation
+ */
+export class Operation extends Message<Operation> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * type is the type of work performed by the operation. e.g. "ExportProject"
+   *
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  /**
+   * @generated from field: string project = 3;
+   */
+  project = "";
+
+  /**
+   * phase is one of "Running", "Succeeded", "Failed", or "Canceled".
+   *
+   * @generated from field: string phase = 4;
+   */
+  phase = "";
+
+  /**
+   * message is the most recently reported progress of a running operation or
+   * the cause of a failed operation's failure.
+   *
+   * @generated from field: string message = 5;
+   */
+  message = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 6;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp end_time = 7;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * result is only set for operations that have succeeded.
+   *
+   * @generated from field: optional akuity.io.kargo.service.v1alpha1.OperationResult result = 8;
+   */
+  result?: OperationResult;
+
+  constructor(data?: PartialMessage<Operation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.Operation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "start_time", kind: "message", T: Timestamp },
+    { no: 7, name: "end_time", kind: "message", T: Timestamp, opt: true },
+    { no: 8, name: "result", kind: "message", T: OperationResult, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Operation {
+    return new Operation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Operation {
+    return new Operation().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FreightList {
-    return new FreightList().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Operation {
+    return new Operation().fromJsonString(jsonString, options);
   }
 
-  static equals(a: FreightList | PlainMessage<FreightList> | undefined, b: FreightList | PlainMessage<FreightList> | undefined): boolean {
-    return proto3.util.equals(FreightList, a, b);
+  static equals(a: Operation | PlainMessage<Operation> | undefined, b: Operation | PlainMessage<Operation> | undefined): boolean {
+    return proto3.util.equals(Operation, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.ListWarehousesRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.OperationResult
  */
-export class ListWarehousesRequest extends Message<ListWarehousesRequest> {
+export class OperationResult extends Message<OperationResult> {
+  /**
+   * @generated from oneof akuity.io.kargo.service.v1alpha1.OperationResult.result
+   */
+  result: {
+    /**
+     * @generated from field: akuity.io.kargo.service.v1alpha1.DeleteResourceResponse delete_resource = 1;
+     */
+    value: DeleteResourceResponse;
+    case: "deleteResource";
+  } | {
+    /**
+     * @generated from field: akuity.io.kargo.service.v1alpha1.ExportProjectResult export_project = 2;
+     */
+    value: ExportProjectResult;
+    case: "exportProject";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<OperationResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.OperationResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "delete_resource", kind: "message", T: DeleteResourceResponse, oneof: "result" },
+    { no: 2, name: "export_project", kind: "message", T: ExportProjectResult, oneof: "result" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationResult {
+    return new OperationResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OperationResult {
+    return new OperationResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OperationResult {
+    return new OperationResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OperationResult | PlainMessage<OperationResult> | undefined, b: OperationResult | PlainMessage<OperationResult> | undefined): boolean {
+    return proto3.util.equals(OperationResult, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.StartOperationRequest
+ */
+export class StartOperationRequest extends Message<StartOperationRequest> {
+  /**
+   * @generated from oneof akuity.io.kargo.service.v1alpha1.StartOperationRequest.operation
+   */
+  operation: {
+    /**
+     * delete_resource deletes all resources described by a manifest.
+     *
+     * @generated from field: akuity.io.kargo.service.v1alpha1.DeleteResourceRequest delete_resource = 1;
+     */
+    value: DeleteResourceRequest;
+    case: "deleteResource";
+  } | {
+    /**
+     * export_project exports all of a project's Stages, Warehouses, and
+     * PromotionPolicies as a manifest.
+     *
+     * @generated from field: akuity.io.kargo.service.v1alpha1.ExportProjectRequest export_project = 2;
+     */
+    value: ExportProjectRequest;
+    case: "exportProject";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<StartOperationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.StartOperationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "delete_resource", kind: "message", T: DeleteResourceRequest, oneof: "operation" },
+    { no: 2, name: "export_project", kind: "message", T: ExportProjectRequest, oneof: "operation" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartOperationRequest {
+    return new StartOperationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartOperationRequest {
+    return new StartOperationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartOperationRequest {
+    return new StartOperationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StartOperationRequest | PlainMessage<StartOperationRequest> | undefined, b: StartOperationRequest | PlainMessage<StartOperationRequest> | undefined): boolean {
+    return proto3.util.equals(StartOperationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.StartOperationResponse
+ */
+export class StartOperationResponse extends Message<StartOperationResponse> {
+  /**
+   * @generated from field: akuity.io.kargo.service.v1alpha1.Operation operation = 1;
+   */
+  operation?: Operation;
+
+  constructor(data?: PartialMessage<StartOperationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.StartOperationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation", kind: "message", T: Operation },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartOperationResponse {
+    return new StartOperationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartOperationResponse {
+    return new StartOperationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartOperationResponse {
+    return new StartOperationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StartOperationResponse | PlainMessage<StartOperationResponse> | undefined, b: StartOperationResponse | PlainMessage<StartOperationResponse> | undefined): boolean {
+    return proto3.util.equals(StartOperationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ExportProjectRequest
+ */
+export class ExportProjectRequest extends Message<ExportProjectRequest> {
   /**
    * @generated from field: string project = 1;
    */
   project = "";
 
-  constructor(data?: PartialMessage<ListWarehousesRequest>) {
+  constructor(data?: PartialMessage<ExportProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListWarehousesRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ExportProjectRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWarehousesRequest {
-    return new ListWarehousesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportProjectRequest {
+    return new ExportProjectRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWarehousesRequest {
-    return new ListWarehousesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportProjectRequest {
+    return new ExportProjectRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWarehousesRequest {
-    return new ListWarehousesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportProjectRequest {
+    return new ExportProjectRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWarehousesRequest | PlainMessage<ListWarehousesRequest> | undefined, b: ListWarehousesRequest | PlainMessage<ListWarehousesRequest> | undefined): boolean {
-    return proto3.util.equals(ListWarehousesRequest, a, b);
+  static equals(a: ExportProjectRequest | PlainMessage<ExportProjectRequest> | undefined, b: ExportProjectRequest | PlainMessage<ExportProjectRequest> | undefined): boolean {
+    return proto3.util.equals(ExportProjectRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.ListWarehousesResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.ExportProjectResult
  */
-export class ListWarehousesResponse extends Message<ListWarehousesResponse> {
+export class ExportProjectResult extends Message<ExportProjectResult> {
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouses = 1;
+   * @generated from field: bytes manifest = 1;
    */
-  warehouses: Warehouse[] = [];
+  manifest = new Uint8Array(0);
 
-  constructor(data?: PartialMessage<ListWarehousesResponse>) {
+  constructor(data?: PartialMessage<ExportProjectResult>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListWarehousesResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ExportProjectResult";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouses", kind: "message", T: Warehouse, repeated: true },
+    { no: 1, name: "manifest", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWarehousesResponse {
-    return new ListWarehousesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportProjectResult {
+    return new ExportProjectResult().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWarehousesResponse {
-    return new ListWarehousesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportProjectResult {
+    return new ExportProjectResult().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWarehousesResponse {
-    return new ListWarehousesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportProjectResult {
+    return new ExportProjectResult().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWarehousesResponse | PlainMessage<ListWarehousesResponse> | undefined, b: ListWarehousesResponse | PlainMessage<ListWarehousesResponse> | undefined): boolean {
-    return proto3.util.equals(ListWarehousesResponse, a, b);
+  static equals(a: ExportProjectResult | PlainMessage<ExportProjectResult> | undefined, b: ExportProjectResult | PlainMessage<ExportProjectResult> | undefined): boolean {
+    return proto3.util.equals(ExportProjectResult, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.GetWarehouseRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetOperationRequest
  */
-export class GetWarehouseRequest extends Message<GetWarehouseRequest> {
+export class GetOperationRequest extends Message<GetOperationRequest> {
   /**
-   * @generated from field: string project = 1;
+   * @generated from field: string id = 1;
    */
-  project = "";
+  id = "";
+
+  constructor(data?: PartialMessage<GetOperationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetOperationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOperationRequest {
+    return new GetOperationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOperationRequest {
+    return new GetOperationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOperationRequest {
+    return new GetOperationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetOperationRequest | PlainMessage<GetOperationRequest> | undefined, b: GetOperationRequest | PlainMessage<GetOperationRequest> | undefined): boolean {
+    return proto3.util.equals(GetOperationRequest, a, b);
+  }
+}
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetOperationResponse
+ */
+export class GetOperationResponse extends Message<GetOperationResponse> {
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: akuity.io.kargo.service.v1alpha1.Operation operation = 1;
    */
-  name = "";
+  operation?: Operation;
 
-  constructor(data?: PartialMessage<GetWarehouseRequest>) {
+  constructor(data?: PartialMessage<GetOperationResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetWarehouseRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetOperationResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "operation", kind: "message", T: Operation },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWarehouseRequest {
-    return new GetWarehouseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOperationResponse {
+    return new GetOperationResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWarehouseRequest {
-    return new GetWarehouseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOperationResponse {
+    return new GetOperationResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWarehouseRequest {
-    return new GetWarehouseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOperationResponse {
+    return new GetOperationResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetWarehouseRequest | PlainMessage<GetWarehouseRequest> | undefined, b: GetWarehouseRequest | PlainMessage<GetWarehouseRequest> | undefined): boolean {
-    return proto3.util.equals(GetWarehouseRequest, a, b);
+  static equals(a: GetOperationResponse | PlainMessage<GetOperationResponse> | undefined, b: GetOperationResponse | PlainMessage<GetOperationResponse> | undefined): boolean {
+    return proto3.util.equals(GetOperationResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.GetWarehouseResponse
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListOperationsRequest
  */
-export class GetWarehouseResponse extends Message<GetWarehouseResponse> {
+export class ListOperationsRequest extends Message<ListOperationsRequest> {
+  constructor(data?: PartialMessage<ListOperationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListOperationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOperationsRequest {
+    return new ListOperationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOperationsRequest {
+    return new ListOperationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOperationsRequest {
+    return new ListOperationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOperationsRequest | PlainMessage<ListOperationsRequest> | undefined, b: ListOperationsRequest | PlainMessage<ListOperationsRequest> | undefined): boolean {
+    return proto3.util.equals(ListOperationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListOperationsResponse
+ */
+export class ListOperationsResponse extends Message<ListOperationsResponse> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.Operation operations = 1;
    */
-  warehouse?: Warehouse;
+  operations: Operation[] = [];
 
-  constructor(data?: PartialMessage<GetWarehouseResponse>) {
+  constructor(data?: PartialMessage<ListOperationsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetWarehouseResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListOperationsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+    { no: 1, name: "operations", kind: "message", T: Operation, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWarehouseResponse {
-    return new GetWarehouseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOperationsResponse {
+    return new ListOperationsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWarehouseResponse {
-    return new GetWarehouseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOperationsResponse {
+    return new ListOperationsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWarehouseResponse {
-    return new GetWarehouseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOperationsResponse {
+    return new ListOperationsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetWarehouseResponse | PlainMessage<GetWarehouseResponse> | undefined, b: GetWarehouseResponse | PlainMessage<GetWarehouseResponse> | undefined): boolean {
-    return proto3.util.equals(GetWarehouseResponse, a, b);
+  static equals(a: ListOperationsResponse | PlainMessage<ListOperationsResponse> | undefined, b: ListOperationsResponse | PlainMessage<ListOperationsResponse> | undefined): boolean {
+    return proto3.util.equals(ListOperationsResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.WatchWarehousesRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.CancelOperationRequest
  */
-export class WatchWarehousesRequest extends Message<WatchWarehousesRequest> {
+export class CancelOperationRequest extends Message<CancelOperationRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<CancelOperationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CancelOperationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelOperationRequest {
+    return new CancelOperationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelOperationRequest {
+    return new CancelOperationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelOperationRequest {
+    return new CancelOperationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelOperationRequest | PlainMessage<CancelOperationRequest> | undefined, b: CancelOperationRequest | PlainMessage<CancelOperationRequest> | undefined): boolean {
+    return proto3.util.equals(CancelOperationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CancelOperationResponse
+ */
+export class CancelOperationResponse extends Message<CancelOperationResponse> {
+  /**
+   * @generated from field: akuity.io.kargo.service.v1alpha1.Operation operation = 1;
+   */
+  operation?: Operation;
+
+  constructor(data?: PartialMessage<CancelOperationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CancelOperationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation", kind: "message", T: Operation },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelOperationResponse {
+    return new CancelOperationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelOperationResponse {
+    return new CancelOperationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelOperationResponse {
+    return new CancelOperationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelOperationResponse | PlainMessage<CancelOperationResponse> | undefined, b: CancelOperationResponse | PlainMessage<CancelOperationResponse> | undefined): boolean {
+    return proto3.util.equals(CancelOperationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.TestCredentialsRequest
+ */
+export class TestCredentialsRequest extends Message<TestCredentialsRequest> {
   /**
    * @generated from field: string project = 1;
    */
   project = "";
 
   /**
+   * name is the name of a Secret, in the project's namespace, holding
+   * repository credentials.
+   *
    * @generated from field: string name = 2;
    */
   name = "";
 
-  constructor(data?: PartialMessage<WatchWarehousesRequest>) {
+  /**
+   * repo_url is the URL of a repository to test credentials against. It is
+   * required if, and only if, the Secret holds a credentials template, which
+   * applies to all repositories whose URLs begin with the template's URL.
+   *
+   * @generated from field: string repo_url = 3;
+   */
+  repoUrl = "";
+
+  constructor(data?: PartialMessage<TestCredentialsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.WatchWarehousesRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.TestCredentialsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchWarehousesRequest {
-    return new WatchWarehousesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestCredentialsRequest {
+    return new TestCredentialsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchWarehousesRequest {
-    return new WatchWarehousesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestCredentialsRequest {
+    return new TestCredentialsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchWarehousesRequest {
-    return new WatchWarehousesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestCredentialsRequest {
+    return new TestCredentialsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WatchWarehousesRequest | PlainMessage<WatchWarehousesRequest> | undefined, b: WatchWarehousesRequest | PlainMessage<WatchWarehousesRequest> | undefined): boolean {
-    return proto3.util.equals(WatchWarehousesRequest, a, b);
+  static equals(a: TestCredentialsRequest | PlainMessage<TestCredentialsRequest> | undefined, b: TestCredentialsRequest | PlainMessage<TestCredentialsRequest> | undefined): boolean {
+    return proto3.util.equals(TestCredentialsRequest, a, b);
   }
 }
 
-/**
- * @generated from message akuity.io.kargo.service.v1alpha1.WatchWarehousesResponse
- */
-export class WatchWarehousesResponse extends Message<WatchWarehousesResponse> {
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.TestCredentialsResponse
+ */
+export class TestCredentialsResponse extends Message<TestCredentialsResponse> {
+  /**
+   * type is one of "git", "helm", or "image".
+   *
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * @generated from field: string repo_url = 2;
+   */
+  repoUrl = "";
+
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   * @generated from field: bool success = 3;
    */
-  warehouse?: Warehouse;
+  success = false;
 
   /**
-   * @generated from field: string type = 2;
+   * message describes the outcome of the test. If the test failed, it
+   * includes the error that was encountered.
+   *
+   * @generated from field: string message = 4;
    */
-  type = "";
+  message = "";
 
-  constructor(data?: PartialMessage<WatchWarehousesResponse>) {
+  constructor(data?: PartialMessage<TestCredentialsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.WatchWarehousesResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.TestCredentialsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
-    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchWarehousesResponse {
-    return new WatchWarehousesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestCredentialsResponse {
+    return new TestCredentialsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchWarehousesResponse {
-    return new WatchWarehousesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestCredentialsResponse {
+    return new TestCredentialsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchWarehousesResponse {
-    return new WatchWarehousesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestCredentialsResponse {
+    return new TestCredentialsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WatchWarehousesResponse | PlainMessage<WatchWarehousesResponse> | undefined, b: WatchWarehousesResponse | PlainMessage<WatchWarehousesResponse> | undefined): boolean {
-    return proto3.util.equals(WatchWarehousesResponse, a, b);
+  static equals(a: TestCredentialsResponse | PlainMessage<TestCredentialsResponse> | undefined, b: TestCredentialsResponse | PlainMessage<TestCredentialsResponse> | undefined): boolean {
+    return proto3.util.equals(TestCredentialsResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec
+ * @generated from message akuity.io.kargo.service.v1alpha1.TestClusterConnectionRequest
  */
-export class TypedWarehouseSpec extends Message<TypedWarehouseSpec> {
+export class TestClusterConnectionRequest extends Message<TestClusterConnectionRequest> {
   /**
    * @generated from field: string project = 1;
    */
@@ -3328,368 +6382,467 @@ export class TypedWarehouseSpec extends Message<TypedWarehouseSpec> {
    */
   name = "";
 
-  /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec spec = 3;
-   */
-  spec?: WarehouseSpec;
-
-  constructor(data?: PartialMessage<TypedWarehouseSpec>) {
+  constructor(data?: PartialMessage<TestClusterConnectionRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.TestClusterConnectionRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "spec", kind: "message", T: WarehouseSpec },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TypedWarehouseSpec {
-    return new TypedWarehouseSpec().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestClusterConnectionRequest {
+    return new TestClusterConnectionRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TypedWarehouseSpec {
-    return new TypedWarehouseSpec().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestClusterConnectionRequest {
+    return new TestClusterConnectionRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TypedWarehouseSpec {
-    return new TypedWarehouseSpec().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestClusterConnectionRequest {
+    return new TestClusterConnectionRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TypedWarehouseSpec | PlainMessage<TypedWarehouseSpec> | undefined, b: TypedWarehouseSpec | PlainMessage<TypedWarehouseSpec> | undefined): boolean {
-    return proto3.util.equals(TypedWarehouseSpec, a, b);
+  static equals(a: TestClusterConnectionRequest | PlainMessage<TestClusterConnectionRequest> | undefined, b: TestClusterConnectionRequest | PlainMessage<TestClusterConnectionRequest> | undefined): boolean {
+    return proto3.util.equals(TestClusterConnectionRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.TestClusterConnectionResponse
  */
-export class CreateWarehouseRequest extends Message<CreateWarehouseRequest> {
+export class TestClusterConnectionResponse extends Message<TestClusterConnectionResponse> {
   /**
-   * @generated from oneof akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest.warehouse
+   * @generated from field: string server = 1;
    */
-  warehouse: {
-    /**
-     * @generated from field: akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec typed = 1;
-     */
-    value: TypedWarehouseSpec;
-    case: "typed";
-  } | {
-    /**
-     * @generated from field: string yaml = 2;
-     */
-    value: string;
-    case: "yaml";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  server = "";
 
-  constructor(data?: PartialMessage<CreateWarehouseRequest>) {
+  /**
+   * @generated from field: bool success = 2;
+   */
+  success = false;
+
+  /**
+   * message describes the outcome of the test. If the test failed, it
+   * includes the error that was encountered.
+   *
+   * @generated from field: string message = 3;
+   */
+  message = "";
+
+  /**
+   * server_version is the version of Kubernetes the cluster reported, if the
+   * test succeeded.
+   *
+   * @generated from field: optional string server_version = 4;
+   */
+  serverVersion?: string;
+
+  constructor(data?: PartialMessage<TestClusterConnectionResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.TestClusterConnectionResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "typed", kind: "message", T: TypedWarehouseSpec, oneof: "warehouse" },
-    { no: 2, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "warehouse" },
+    { no: 1, name: "server", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "server_version", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWarehouseRequest {
-    return new CreateWarehouseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestClusterConnectionResponse {
+    return new TestClusterConnectionResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWarehouseRequest {
-    return new CreateWarehouseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestClusterConnectionResponse {
+    return new TestClusterConnectionResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWarehouseRequest {
-    return new CreateWarehouseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestClusterConnectionResponse {
+    return new TestClusterConnectionResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWarehouseRequest | PlainMessage<CreateWarehouseRequest> | undefined, b: CreateWarehouseRequest | PlainMessage<CreateWarehouseRequest> | undefined): boolean {
-    return proto3.util.equals(CreateWarehouseRequest, a, b);
+  static equals(a: TestClusterConnectionResponse | PlainMessage<TestClusterConnectionResponse> | undefined, b: TestClusterConnectionResponse | PlainMessage<TestClusterConnectionResponse> | undefined): boolean {
+    return proto3.util.equals(TestClusterConnectionResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.CreateWarehouseResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListEventsRequest
  */
-export class CreateWarehouseResponse extends Message<CreateWarehouseResponse> {
+export class ListEventsRequest extends Message<ListEventsRequest> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   * @generated from field: string project = 1;
    */
-  warehouse?: Warehouse;
+  project = "";
 
-  constructor(data?: PartialMessage<CreateWarehouseResponse>) {
+  /**
+   * stage, if set, limits events to those concerning the Stage or its
+   * Promotions.
+   *
+   * @generated from field: optional string stage = 2;
+   */
+  stage?: string;
+
+  /**
+   * promotion, if set, limits events to those concerning the Promotion.
+   *
+   * @generated from field: optional string promotion = 3;
+   */
+  promotion?: string;
+
+  constructor(data?: PartialMessage<ListEventsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateWarehouseResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListEventsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "promotion", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWarehouseResponse {
-    return new CreateWarehouseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEventsRequest {
+    return new ListEventsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWarehouseResponse {
-    return new CreateWarehouseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEventsRequest {
+    return new ListEventsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWarehouseResponse {
-    return new CreateWarehouseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEventsRequest {
+    return new ListEventsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWarehouseResponse | PlainMessage<CreateWarehouseResponse> | undefined, b: CreateWarehouseResponse | PlainMessage<CreateWarehouseResponse> | undefined): boolean {
-    return proto3.util.equals(CreateWarehouseResponse, a, b);
+  static equals(a: ListEventsRequest | PlainMessage<ListEventsRequest> | undefined, b: ListEventsRequest | PlainMessage<ListEventsRequest> | undefined): boolean {
+    return proto3.util.equals(ListEventsRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListEventsResponse
  */
-export class UpdateWarehouseRequest extends Message<UpdateWarehouseRequest> {
+export class ListEventsResponse extends Message<ListEventsResponse> {
   /**
-   * @generated from oneof akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest.warehouse
+   * events are ordered from oldest to newest.
+   *
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.Event events = 1;
    */
-  warehouse: {
-    /**
-     * @generated from field: akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec typed = 1;
-     */
-    value: TypedWarehouseSpec;
-    case: "typed";
-  } | {
-    /**
-     * @generated from field: string yaml = 2;
-     */
-    value: string;
-    case: "yaml";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  events: Event[] = [];
 
-  constructor(data?: PartialMessage<UpdateWarehouseRequest>) {
+  constructor(data?: PartialMessage<ListEventsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListEventsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "typed", kind: "message", T: TypedWarehouseSpec, oneof: "warehouse" },
-    { no: 2, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "warehouse" },
+    { no: 1, name: "events", kind: "message", T: Event, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWarehouseRequest {
-    return new UpdateWarehouseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEventsResponse {
+    return new ListEventsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWarehouseRequest {
-    return new UpdateWarehouseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEventsResponse {
+    return new ListEventsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWarehouseRequest {
-    return new UpdateWarehouseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEventsResponse {
+    return new ListEventsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateWarehouseRequest | PlainMessage<UpdateWarehouseRequest> | undefined, b: UpdateWarehouseRequest | PlainMessage<UpdateWarehouseRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateWarehouseRequest, a, b);
+  static equals(a: ListEventsResponse | PlainMessage<ListEventsResponse> | undefined, b: ListEventsResponse | PlainMessage<ListEventsResponse> | undefined): boolean {
+    return proto3.util.equals(ListEventsResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.UpdateWarehouseResponse
+ * Event is a single entry in the timeline of a project. It is either a
+ * Kubernetes Event emitted for a Kargo resource or an audit entry derived from
+ * a user action recorded by a Kargo resource.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.Event
  */
-export class UpdateWarehouseResponse extends Message<UpdateWarehouseResponse> {
+export class Event extends Message<Event> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   * @generated from field: google.protobuf.Timestamp time = 1;
    */
-  warehouse?: Warehouse;
+  time?: Timestamp;
 
-  constructor(data?: PartialMessage<UpdateWarehouseResponse>) {
+  /**
+   * source is one of "kubernetes" or "audit".
+   *
+   * @generated from field: string source = 2;
+   */
+  source = "";
+
+  /**
+   * type is one of "Normal" or "Warning".
+   *
+   * @generated from field: string type = 3;
+   */
+  type = "";
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: string kind = 5;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string name = 6;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string message = 7;
+   */
+  message = "";
+
+  /**
+   * actor identifies the user responsible for an audit entry, if known.
+   *
+   * @generated from field: string actor = 8;
+   */
+  actor = "";
+
+  /**
+   * count is the number of times a Kubernetes Event has occurred.
+   *
+   * @generated from field: int32 count = 9;
+   */
+  count = 0;
+
+  constructor(data?: PartialMessage<Event>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateWarehouseResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.Event";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+    { no: 1, name: "time", kind: "message", T: Timestamp },
+    { no: 2, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "actor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWarehouseResponse {
-    return new UpdateWarehouseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Event {
+    return new Event().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWarehouseResponse {
-    return new UpdateWarehouseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Event {
+    return new Event().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWarehouseResponse {
-    return new UpdateWarehouseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Event {
+    return new Event().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateWarehouseResponse | PlainMessage<UpdateWarehouseResponse> | undefined, b: UpdateWarehouseResponse | PlainMessage<UpdateWarehouseResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateWarehouseResponse, a, b);
+  static equals(a: Event | PlainMessage<Event> | undefined, b: Event | PlainMessage<Event> | undefined): boolean {
+    return proto3.util.equals(Event, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.DeleteWarehouseRequest
+ * Table is a display-ready representation of a list of resources, computed by
+ * the server so that all clients render the same columns in the same way.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.Table
  */
-export class DeleteWarehouseRequest extends Message<DeleteWarehouseRequest> {
+export class Table extends Message<Table> {
   /**
-   * @generated from field: string project = 1;
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.TableColumn columns = 1;
    */
-  project = "";
+  columns: TableColumn[] = [];
 
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.TableRow rows = 2;
    */
-  name = "";
+  rows: TableRow[] = [];
 
-  constructor(data?: PartialMessage<DeleteWarehouseRequest>) {
+  constructor(data?: PartialMessage<Table>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteWarehouseRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.Table";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "columns", kind: "message", T: TableColumn, repeated: true },
+    { no: 2, name: "rows", kind: "message", T: TableRow, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWarehouseRequest {
-    return new DeleteWarehouseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Table {
+    return new Table().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWarehouseRequest {
-    return new DeleteWarehouseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Table {
+    return new Table().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWarehouseRequest {
-    return new DeleteWarehouseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Table {
+    return new Table().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteWarehouseRequest | PlainMessage<DeleteWarehouseRequest> | undefined, b: DeleteWarehouseRequest | PlainMessage<DeleteWarehouseRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteWarehouseRequest, a, b);
+  static equals(a: Table | PlainMessage<Table> | undefined, b: Table | PlainMessage<Table> | undefined): boolean {
+    return proto3.util.equals(Table, a, b);
   }
 }
 
 /**
- * explicitly empty 
- *
- * @generated from message akuity.io.kargo.service.v1alpha1.DeleteWarehouseResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.TableColumn
  */
-export class DeleteWarehouseResponse extends Message<DeleteWarehouseResponse> {
-  constructor(data?: PartialMessage<DeleteWarehouseResponse>) {
+export class TableColumn extends Message<TableColumn> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * type hints at how the column's cells should be rendered. It is one of
+   * "string", "age", or "health".
+   *
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  constructor(data?: PartialMessage<TableColumn>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteWarehouseResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.TableColumn";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWarehouseResponse {
-    return new DeleteWarehouseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableColumn {
+    return new TableColumn().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWarehouseResponse {
-    return new DeleteWarehouseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableColumn {
+    return new TableColumn().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWarehouseResponse {
-    return new DeleteWarehouseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableColumn {
+    return new TableColumn().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteWarehouseResponse | PlainMessage<DeleteWarehouseResponse> | undefined, b: DeleteWarehouseResponse | PlainMessage<DeleteWarehouseResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteWarehouseResponse, a, b);
+  static equals(a: TableColumn | PlainMessage<TableColumn> | undefined, b: TableColumn | PlainMessage<TableColumn> | undefined): boolean {
+    return proto3.util.equals(TableColumn, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.RefreshWarehouseRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.TableRow
  */
-export class RefreshWarehouseRequest extends Message<RefreshWarehouseRequest> {
+export class TableRow extends Message<TableRow> {
   /**
-   * @generated from field: string project = 1;
+   * name is the name of the resource the row represents.
+   *
+   * @generated from field: string name = 1;
    */
-  project = "";
+  name = "";
 
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.TableCell cells = 2;
    */
-  name = "";
+  cells: TableCell[] = [];
 
-  constructor(data?: PartialMessage<RefreshWarehouseRequest>) {
+  constructor(data?: PartialMessage<TableRow>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshWarehouseRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.TableRow";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cells", kind: "message", T: TableCell, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshWarehouseRequest {
-    return new RefreshWarehouseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableRow {
+    return new TableRow().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshWarehouseRequest {
-    return new RefreshWarehouseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableRow {
+    return new TableRow().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshWarehouseRequest {
-    return new RefreshWarehouseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableRow {
+    return new TableRow().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RefreshWarehouseRequest | PlainMessage<RefreshWarehouseRequest> | undefined, b: RefreshWarehouseRequest | PlainMessage<RefreshWarehouseRequest> | undefined): boolean {
-    return proto3.util.equals(RefreshWarehouseRequest, a, b);
+  static equals(a: TableRow | PlainMessage<TableRow> | undefined, b: TableRow | PlainMessage<TableRow> | undefined): boolean {
+    return proto3.util.equals(TableRow, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.RefreshWarehouseResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.TableCell
  */
-export class RefreshWarehouseResponse extends Message<RefreshWarehouseResponse> {
+export class TableCell extends Message<TableCell> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   * @generated from field: string text = 1;
    */
-  warehouse?: Warehouse;
+  text = "";
 
-  constructor(data?: PartialMessage<RefreshWarehouseResponse>) {
+  /**
+   * glyph, if set, is the state to be reflected by a graphical indicator
+   * accompanying the cell. It is one of "healthy", "progressing", "unhealthy",
+   * or "unknown".
+   *
+   * @generated from field: string glyph = 2;
+   */
+  glyph = "";
+
+  constructor(data?: PartialMessage<TableCell>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshWarehouseResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.TableCell";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+    { no: 1, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "glyph", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshWarehouseResponse {
-    return new RefreshWarehouseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableCell {
+    return new TableCell().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshWarehouseResponse {
-    return new RefreshWarehouseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableCell {
+    return new TableCell().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshWarehouseResponse {
-    return new RefreshWarehouseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableCell {
+    return new TableCell().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RefreshWarehouseResponse | PlainMessage<RefreshWarehouseResponse> | undefined, b: RefreshWarehouseResponse | PlainMessage<RefreshWarehouseResponse> | undefined): boolean {
-    return proto3.util.equals(RefreshWarehouseResponse, a, b);
+  static equals(a: TableCell | PlainMessage<TableCell> | undefined, b: TableCell | PlainMessage<TableCell> | undefined): boolean {
+    return proto3.util.equals(TableCell, a, b);
   }
 }
 
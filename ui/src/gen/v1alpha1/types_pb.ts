@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
-import { ListMeta, ObjectMeta } from "../metav1/types_pb.js";
+import { Condition, ListMeta, ObjectMeta } from "../metav1/types_pb.js";
 
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppUpdate
@@ -26,6 +26,11 @@ export class ArgoCDAppUpdate extends Message<ArgoCDAppUpdate> {
    */
   sourceUpdates: ArgoCDSourceUpdate[] = [];
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDResourceHealthOverride health_overrides = 4;
+   */
+  healthOverrides: ArgoCDResourceHealthOverride[] = [];
+
   constructor(data?: PartialMessage<ArgoCDAppUpdate>) {
     super();
     proto3.util.initPartial(data, this);
@@ -37,6 +42,7 @@ export class ArgoCDAppUpdate extends Message<ArgoCDAppUpdate> {
     { no: 1, name: "app_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "app_namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 3, name: "source_updates", kind: "message", T: ArgoCDSourceUpdate, repeated: true },
+    { no: 4, name: "health_overrides", kind: "message", T: ArgoCDResourceHealthOverride, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDAppUpdate {
@@ -56,6 +62,67 @@ export class ArgoCDAppUpdate extends Message<ArgoCDAppUpdate> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDResourceHealthOverride
+ */
+export class ArgoCDResourceHealthOverride extends Message<ArgoCDResourceHealthOverride> {
+  /**
+   * @generated from field: optional string group = 1;
+   */
+  group?: string;
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: optional string name = 3;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: repeated string when = 4;
+   */
+  when: string[] = [];
+
+  /**
+   * @generated from field: string health = 5;
+   */
+  health = "";
+
+  constructor(data?: PartialMessage<ArgoCDResourceHealthOverride>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDResourceHealthOverride";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "group", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "when", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "health", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDResourceHealthOverride {
+    return new ArgoCDResourceHealthOverride().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArgoCDResourceHealthOverride {
+    return new ArgoCDResourceHealthOverride().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArgoCDResourceHealthOverride {
+    return new ArgoCDResourceHealthOverride().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArgoCDResourceHealthOverride | PlainMessage<ArgoCDResourceHealthOverride> | undefined, b: ArgoCDResourceHealthOverride | PlainMessage<ArgoCDResourceHealthOverride> | undefined): boolean {
+    return proto3.util.equals(ArgoCDResourceHealthOverride, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDHelm
  */
@@ -470,6 +537,11 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
    */
   render?: KargoRenderPromotionMechanism;
 
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.GitConflictResolution conflict_resolution = 8;
+   */
+  conflictResolution?: GitConflictResolution;
+
   constructor(data?: PartialMessage<GitRepoUpdate>) {
     super();
     proto3.util.initPartial(data, this);
@@ -484,6 +556,7 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
     { no: 5, name: "kustomize", kind: "message", T: KustomizePromotionMechanism, opt: true },
     { no: 6, name: "helm", kind: "message", T: HelmPromotionMechanism, opt: true },
     { no: 7, name: "render", kind: "message", T: KargoRenderPromotionMechanism, opt: true },
+    { no: 8, name: "conflict_resolution", kind: "message", T: GitConflictResolution, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitRepoUpdate {
@@ -503,6 +576,55 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.GitConflictResolution
+ */
+export class GitConflictResolution extends Message<GitConflictResolution> {
+  /**
+   * @generated from field: optional string strategy = 1;
+   */
+  strategy?: string;
+
+  /**
+   * @generated from field: repeated string paths = 2;
+   */
+  paths: string[] = [];
+
+  /**
+   * @generated from field: optional int32 max_attempts = 3;
+   */
+  maxAttempts?: number;
+
+  constructor(data?: PartialMessage<GitConflictResolution>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.GitConflictResolution";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "strategy", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "max_attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitConflictResolution {
+    return new GitConflictResolution().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GitConflictResolution {
+    return new GitConflictResolution().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GitConflictResolution {
+    return new GitConflictResolution().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GitConflictResolution | PlainMessage<GitConflictResolution> | undefined, b: GitConflictResolution | PlainMessage<GitConflictResolution> | undefined): boolean {
+    return proto3.util.equals(GitConflictResolution, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription
  */
@@ -565,6 +687,21 @@ export class Health extends Message<Health> {
    */
   argocdApps: ArgoCDAppState[] = [];
 
+  /**
+   * @generated from field: optional google.protobuf.Timestamp healthy_since = 4;
+   */
+  healthySince?: Timestamp;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.HealthProviderStatus providers = 5;
+   */
+  providers: HealthProviderStatus[] = [];
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.HealthRecovery recovery = 6;
+   */
+  recovery?: HealthRecovery;
+
   constructor(data?: PartialMessage<Health>) {
     super();
     proto3.util.initPartial(data, this);
@@ -576,6 +713,9 @@ export class Health extends Message<Health> {
     { no: 1, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "issues", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "argocd_apps", jsonName: "argoCDApps", kind: "message", T: ArgoCDAppState, repeated: true },
+    { no: 4, name: "healthy_since", kind: "message", T: Timestamp, opt: true },
+    { no: 5, name: "providers", kind: "message", T: HealthProviderStatus, repeated: true },
+    { no: 6, name: "recovery", kind: "message", T: HealthRecovery, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Health {
@@ -595,6 +735,98 @@ export class Health extends Message<Health> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HealthRecovery
+ */
+export class HealthRecovery extends Message<HealthRecovery> {
+  /**
+   * @generated from field: google.protobuf.Timestamp since = 1;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: int32 checks = 2;
+   */
+  checks = 0;
+
+  constructor(data?: PartialMessage<HealthRecovery>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HealthRecovery";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "since", kind: "message", T: Timestamp },
+    { no: 2, name: "checks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HealthRecovery {
+    return new HealthRecovery().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HealthRecovery {
+    return new HealthRecovery().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HealthRecovery {
+    return new HealthRecovery().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HealthRecovery | PlainMessage<HealthRecovery> | undefined, b: HealthRecovery | PlainMessage<HealthRecovery> | undefined): boolean {
+    return proto3.util.equals(HealthRecovery, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HealthProviderStatus
+ */
+export class HealthProviderStatus extends Message<HealthProviderStatus> {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider = "";
+
+  /**
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * @generated from field: repeated string issues = 3;
+   */
+  issues: string[] = [];
+
+  constructor(data?: PartialMessage<HealthProviderStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HealthProviderStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "issues", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HealthProviderStatus {
+    return new HealthProviderStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HealthProviderStatus {
+    return new HealthProviderStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HealthProviderStatus {
+    return new HealthProviderStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HealthProviderStatus | PlainMessage<HealthProviderStatus> | undefined, b: HealthProviderStatus | PlainMessage<HealthProviderStatus> | undefined): boolean {
+    return proto3.util.equals(HealthProviderStatus, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppState
  */
@@ -619,6 +851,11 @@ export class ArgoCDAppState extends Message<ArgoCDAppState> {
    */
   syncStatus?: ArgoCDAppSyncStatus;
 
+  /**
+   * @generated from field: optional string cluster = 5;
+   */
+  cluster?: string;
+
   constructor(data?: PartialMessage<ArgoCDAppState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -631,6 +868,7 @@ export class ArgoCDAppState extends Message<ArgoCDAppState> {
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "health_status", kind: "message", T: ArgoCDAppHealthStatus },
     { no: 4, name: "sync_status", kind: "message", T: ArgoCDAppSyncStatus },
+    { no: 5, name: "cluster", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDAppState {
@@ -1108,6 +1346,15 @@ export class Promotion extends Message<Promotion> {
    */
   status?: PromotionStatus;
 
+  /**
+   * external_links are links to external systems, configured for the Kargo
+   * installation, concerning the Promotion's Stage. They are populated only
+   * by the API server.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ExternalLink external_links = 6;
+   */
+  externalLinks: ExternalLink[] = [];
+
   constructor(data?: PartialMessage<Promotion>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1121,6 +1368,7 @@ export class Promotion extends Message<Promotion> {
     { no: 3, name: "metadata", kind: "message", T: ObjectMeta, opt: true },
     { no: 4, name: "spec", kind: "message", T: PromotionSpec },
     { no: 5, name: "status", kind: "message", T: PromotionStatus },
+    { no: 6, name: "external_links", kind: "message", T: ExternalLink, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Promotion {
@@ -1140,6 +1388,49 @@ export class Promotion extends Message<Promotion> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ExternalLink
+ */
+export class ExternalLink extends Message<ExternalLink> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url = "";
+
+  constructor(data?: PartialMessage<ExternalLink>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ExternalLink";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExternalLink {
+    return new ExternalLink().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExternalLink {
+    return new ExternalLink().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExternalLink {
+    return new ExternalLink().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExternalLink | PlainMessage<ExternalLink> | undefined, b: ExternalLink | PlainMessage<ExternalLink> | undefined): boolean {
+    return proto3.util.equals(ExternalLink, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionInfo
  */
@@ -1298,6 +1589,26 @@ export class PromotionPolicy extends Message<PromotionPolicy> {
    */
   enableAutoPromotion = false;
 
+  /**
+   * @generated from field: bool enable_self_heal = 6;
+   */
+  enableSelfHeal = false;
+
+  /**
+   * @generated from field: optional string auto_promotion_holdback = 7;
+   */
+  autoPromotionHoldback?: string;
+
+  /**
+   * @generated from field: bool coalesce_auto_promotions = 8;
+   */
+  coalesceAutoPromotions = false;
+
+  /**
+   * @generated from field: optional string max_freight_age = 9;
+   */
+  maxFreightAge?: string;
+
   constructor(data?: PartialMessage<PromotionPolicy>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1311,6 +1622,10 @@ export class PromotionPolicy extends Message<PromotionPolicy> {
     { no: 3, name: "metadata", kind: "message", T: ObjectMeta },
     { no: 4, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "enable_auto_promotion", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "enable_self_heal", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "auto_promotion_holdback", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "coalesce_auto_promotions", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "max_freight_age", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionPolicy {
@@ -1387,6 +1702,11 @@ export class PromotionSpec extends Message<PromotionSpec> {
    */
   freight = "";
 
+  /**
+   * @generated from field: string freight_project = 3;
+   */
+  freightProject = "";
+
   constructor(data?: PartialMessage<PromotionSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1397,6 +1717,7 @@ export class PromotionSpec extends Message<PromotionSpec> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "freight_project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionSpec {
@@ -1430,6 +1751,31 @@ export class PromotionStatus extends Message<PromotionStatus> {
    */
   error = "";
 
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.PromotionAttestation attestation = 3;
+   */
+  attestation?: PromotionAttestation;
+
+  /**
+   * @generated from field: string failure_reason = 4;
+   */
+  failureReason = "";
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.GitPushAttempt push_attempts = 5;
+   */
+  pushAttempts: GitPushAttempt[] = [];
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.PromotionImpact impact = 6;
+   */
+  impact?: PromotionImpact;
+
+  /**
+   * @generated from field: optional string log_url = 7 [json_name = "logURL"];
+   */
+  logUrl?: string;
+
   constructor(data?: PartialMessage<PromotionStatus>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1440,6 +1786,11 @@ export class PromotionStatus extends Message<PromotionStatus> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "attestation", kind: "message", T: PromotionAttestation, opt: true },
+    { no: 4, name: "failure_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "push_attempts", kind: "message", T: GitPushAttempt, repeated: true },
+    { no: 6, name: "impact", kind: "message", T: PromotionImpact, opt: true },
+    { no: 7, name: "log_url", jsonName: "logURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStatus {
@@ -1460,104 +1811,652 @@ export class PromotionStatus extends Message<PromotionStatus> {
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionImpact
  */
-export class RepoSubscription extends Message<RepoSubscription> {
+export class PromotionImpact extends Message<PromotionImpact> {
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription git = 1;
+   * @generated from field: repeated string repos = 1;
    */
-  git?: GitSubscription;
+  repos: string[] = [];
 
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription image = 2;
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppReference argocd_apps = 2 [json_name = "argoCDApps"];
    */
-  image?: ImageSubscription;
+  argocdApps: ArgoCDAppReference[] = [];
 
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ChartSubscription chart = 3;
+   * @generated from field: repeated string clusters = 3;
    */
-  chart?: ChartSubscription;
+  clusters: string[] = [];
 
-  constructor(data?: PartialMessage<RepoSubscription>) {
+  constructor(data?: PartialMessage<PromotionImpact>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionImpact";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "git", kind: "message", T: GitSubscription, opt: true },
-    { no: 2, name: "image", kind: "message", T: ImageSubscription, opt: true },
-    { no: 3, name: "chart", kind: "message", T: ChartSubscription, opt: true },
+    { no: 1, name: "repos", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "argocd_apps", jsonName: "argoCDApps", kind: "message", T: ArgoCDAppReference, repeated: true },
+    { no: 3, name: "clusters", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoSubscription {
-    return new RepoSubscription().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionImpact {
+    return new PromotionImpact().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepoSubscription {
-    return new RepoSubscription().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionImpact {
+    return new PromotionImpact().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepoSubscription {
-    return new RepoSubscription().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionImpact {
+    return new PromotionImpact().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RepoSubscription | PlainMessage<RepoSubscription> | undefined, b: RepoSubscription | PlainMessage<RepoSubscription> | undefined): boolean {
-    return proto3.util.equals(RepoSubscription, a, b);
+  static equals(a: PromotionImpact | PlainMessage<PromotionImpact> | undefined, b: PromotionImpact | PlainMessage<PromotionImpact> | undefined): boolean {
+    return proto3.util.equals(PromotionImpact, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Stage
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppReference
  */
-export class Stage extends Message<Stage> {
-  /**
-   * @generated from field: string api_version = 1;
-   */
-  apiVersion = "";
-
-  /**
-   * @generated from field: string kind = 2;
-   */
-  kind = "";
-
+export class ArgoCDAppReference extends Message<ArgoCDAppReference> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ObjectMeta metadata = 3;
+   * @generated from field: string namespace = 1;
    */
-  metadata?: ObjectMeta;
+  namespace = "";
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec spec = 4;
+   * @generated from field: string name = 2;
    */
-  spec?: StageSpec;
+  name = "";
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus status = 5;
+   * @generated from field: optional string cluster = 3;
    */
-  status?: StageStatus;
+  cluster?: string;
 
-  constructor(data?: PartialMessage<Stage>) {
+  constructor(data?: PartialMessage<ArgoCDAppReference>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Stage";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppReference";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "metadata", kind: "message", T: ObjectMeta },
-    { no: 4, name: "spec", kind: "message", T: StageSpec },
-    { no: 5, name: "status", kind: "message", T: StageStatus },
+    { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "cluster", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Stage {
-    return new Stage().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDAppReference {
+    return new ArgoCDAppReference().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Stage {
-    return new Stage().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArgoCDAppReference {
+    return new ArgoCDAppReference().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArgoCDAppReference {
+    return new ArgoCDAppReference().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArgoCDAppReference | PlainMessage<ArgoCDAppReference> | undefined, b: ArgoCDAppReference | PlainMessage<ArgoCDAppReference> | undefined): boolean {
+    return proto3.util.equals(ArgoCDAppReference, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.GitPushAttempt
+ */
+export class GitPushAttempt extends Message<GitPushAttempt> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string branch = 2;
+   */
+  branch = "";
+
+  /**
+   * @generated from field: int32 attempt = 3;
+   */
+  attempt = 0;
+
+  /**
+   * @generated from field: string result = 4;
+   */
+  result = "";
+
+  /**
+   * @generated from field: repeated string resolved_paths = 5;
+   */
+  resolvedPaths: string[] = [];
+
+  /**
+   * @generated from field: optional string message = 6;
+   */
+  message?: string;
+
+  constructor(data?: PartialMessage<GitPushAttempt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.GitPushAttempt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "attempt", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "result", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "resolved_paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitPushAttempt {
+    return new GitPushAttempt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GitPushAttempt {
+    return new GitPushAttempt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GitPushAttempt {
+    return new GitPushAttempt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GitPushAttempt | PlainMessage<GitPushAttempt> | undefined, b: GitPushAttempt | PlainMessage<GitPushAttempt> | undefined): boolean {
+    return proto3.util.equals(GitPushAttempt, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionAttestation
+ */
+export class PromotionAttestation extends Message<PromotionAttestation> {
+  /**
+   * @generated from field: string payload_type = 1;
+   */
+  payloadType = "";
+
+  /**
+   * @generated from field: bytes payload = 2;
+   */
+  payload = new Uint8Array(0);
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.AttestationSignature signatures = 3;
+   */
+  signatures: AttestationSignature[] = [];
+
+  /**
+   * @generated from field: string reference = 4;
+   */
+  reference = "";
+
+  constructor(data?: PartialMessage<PromotionAttestation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionAttestation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "payload_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "payload", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "signatures", kind: "message", T: AttestationSignature, repeated: true },
+    { no: 4, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionAttestation {
+    return new PromotionAttestation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionAttestation {
+    return new PromotionAttestation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionAttestation {
+    return new PromotionAttestation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionAttestation | PlainMessage<PromotionAttestation> | undefined, b: PromotionAttestation | PlainMessage<PromotionAttestation> | undefined): boolean {
+    return proto3.util.equals(PromotionAttestation, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.AttestationSignature
+ */
+export class AttestationSignature extends Message<AttestationSignature> {
+  /**
+   * @generated from field: string keyid = 1;
+   */
+  keyid = "";
+
+  /**
+   * @generated from field: bytes sig = 2;
+   */
+  sig = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<AttestationSignature>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.AttestationSignature";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "keyid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sig", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttestationSignature {
+    return new AttestationSignature().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttestationSignature {
+    return new AttestationSignature().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttestationSignature {
+    return new AttestationSignature().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AttestationSignature | PlainMessage<AttestationSignature> | undefined, b: AttestationSignature | PlainMessage<AttestationSignature> | undefined): boolean {
+    return proto3.util.equals(AttestationSignature, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Release
+ */
+export class Release extends Message<Release> {
+  /**
+   * @generated from field: string api_version = 1;
+   */
+  apiVersion = "";
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ObjectMeta metadata = 3;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseSpec spec = 4;
+   */
+  spec?: ReleaseSpec;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseStatus status = 5;
+   */
+  status?: ReleaseStatus;
+
+  constructor(data?: PartialMessage<Release>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Release";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metadata", kind: "message", T: ObjectMeta },
+    { no: 4, name: "spec", kind: "message", T: ReleaseSpec },
+    { no: 5, name: "status", kind: "message", T: ReleaseStatus },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Release {
+    return new Release().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Release {
+    return new Release().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Release {
+    return new Release().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Release | PlainMessage<Release> | undefined, b: Release | PlainMessage<Release> | undefined): boolean {
+    return proto3.util.equals(Release, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseSpec
+ */
+export class ReleaseSpec extends Message<ReleaseSpec> {
+  /**
+   * @generated from field: string freight = 1;
+   */
+  freight = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp start_time = 2;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseStep steps = 3;
+   */
+  steps: ReleaseStep[] = [];
+
+  constructor(data?: PartialMessage<ReleaseSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "start_time", kind: "message", T: Timestamp, opt: true },
+    { no: 3, name: "steps", kind: "message", T: ReleaseStep, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReleaseSpec {
+    return new ReleaseSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReleaseSpec {
+    return new ReleaseSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReleaseSpec {
+    return new ReleaseSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReleaseSpec | PlainMessage<ReleaseSpec> | undefined, b: ReleaseSpec | PlainMessage<ReleaseSpec> | undefined): boolean {
+    return proto3.util.equals(ReleaseSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseStep
+ */
+export class ReleaseStep extends Message<ReleaseStep> {
+  /**
+   * @generated from field: string stage = 1;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: optional string delay = 2;
+   */
+  delay?: string;
+
+  /**
+   * @generated from field: optional string soak = 3;
+   */
+  soak?: string;
+
+  constructor(data?: PartialMessage<ReleaseStep>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseStep";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "delay", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "soak", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReleaseStep {
+    return new ReleaseStep().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReleaseStep {
+    return new ReleaseStep().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReleaseStep {
+    return new ReleaseStep().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReleaseStep | PlainMessage<ReleaseStep> | undefined, b: ReleaseStep | PlainMessage<ReleaseStep> | undefined): boolean {
+    return proto3.util.equals(ReleaseStep, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseStatus
+ */
+export class ReleaseStatus extends Message<ReleaseStatus> {
+  /**
+   * @generated from field: string phase = 1;
+   */
+  phase = "";
+
+  /**
+   * @generated from field: string error = 2;
+   */
+  error = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp started_at = 3;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseStepStatus steps = 4;
+   */
+  steps: ReleaseStepStatus[] = [];
+
+  constructor(data?: PartialMessage<ReleaseStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "started_at", kind: "message", T: Timestamp, opt: true },
+    { no: 4, name: "steps", kind: "message", T: ReleaseStepStatus, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReleaseStatus {
+    return new ReleaseStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReleaseStatus {
+    return new ReleaseStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReleaseStatus {
+    return new ReleaseStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReleaseStatus | PlainMessage<ReleaseStatus> | undefined, b: ReleaseStatus | PlainMessage<ReleaseStatus> | undefined): boolean {
+    return proto3.util.equals(ReleaseStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseStepStatus
+ */
+export class ReleaseStepStatus extends Message<ReleaseStepStatus> {
+  /**
+   * @generated from field: string stage = 1;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: string promotion = 2;
+   */
+  promotion = "";
+
+  /**
+   * @generated from field: string phase = 3;
+   */
+  phase = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp completed_at = 4;
+   */
+  completedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp verified_at = 5;
+   */
+  verifiedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<ReleaseStepStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ReleaseStepStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "promotion", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "completed_at", kind: "message", T: Timestamp, opt: true },
+    { no: 5, name: "verified_at", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReleaseStepStatus {
+    return new ReleaseStepStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReleaseStepStatus {
+    return new ReleaseStepStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReleaseStepStatus {
+    return new ReleaseStepStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReleaseStepStatus | PlainMessage<ReleaseStepStatus> | undefined, b: ReleaseStepStatus | PlainMessage<ReleaseStepStatus> | undefined): boolean {
+    return proto3.util.equals(ReleaseStepStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription
+ */
+export class RepoSubscription extends Message<RepoSubscription> {
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription git = 1;
+   */
+  git?: GitSubscription;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription image = 2;
+   */
+  image?: ImageSubscription;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ChartSubscription chart = 3;
+   */
+  chart?: ChartSubscription;
+
+  constructor(data?: PartialMessage<RepoSubscription>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "git", kind: "message", T: GitSubscription, opt: true },
+    { no: 2, name: "image", kind: "message", T: ImageSubscription, opt: true },
+    { no: 3, name: "chart", kind: "message", T: ChartSubscription, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoSubscription {
+    return new RepoSubscription().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepoSubscription {
+    return new RepoSubscription().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepoSubscription {
+    return new RepoSubscription().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RepoSubscription | PlainMessage<RepoSubscription> | undefined, b: RepoSubscription | PlainMessage<RepoSubscription> | undefined): boolean {
+    return proto3.util.equals(RepoSubscription, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Stage
+ */
+export class Stage extends Message<Stage> {
+  /**
+   * @generated from field: string api_version = 1;
+   */
+  apiVersion = "";
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ObjectMeta metadata = 3;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec spec = 4;
+   */
+  spec?: StageSpec;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus status = 5;
+   */
+  status?: StageStatus;
+
+  /**
+   * external_links are links to external systems, configured for the Kargo
+   * installation, concerning the Stage. They are populated only by the API
+   * server.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ExternalLink external_links = 6;
+   */
+  externalLinks: ExternalLink[] = [];
+
+  constructor(data?: PartialMessage<Stage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Stage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metadata", kind: "message", T: ObjectMeta },
+    { no: 4, name: "spec", kind: "message", T: StageSpec },
+    { no: 5, name: "status", kind: "message", T: StageStatus },
+    { no: 6, name: "external_links", kind: "message", T: ExternalLink, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Stage {
+    return new Stage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Stage {
+    return new Stage().fromJson(jsonValue, options);
   }
 
   static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Stage {
@@ -1603,55 +2502,392 @@ export class StageList extends Message<StageList> {
     return new StageList().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageList {
-    return new StageList().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageList {
+    return new StageList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StageList | PlainMessage<StageList> | undefined, b: StageList | PlainMessage<StageList> | undefined): boolean {
+    return proto3.util.equals(StageList, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec
+ */
+export class StageSpec extends Message<StageSpec> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Subscriptions subscriptions = 1;
+   */
+  subscriptions?: Subscriptions;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms promotion_mechanisms = 2;
+   */
+  promotionMechanisms?: PromotionMechanisms;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.QualificationPolicy qualification = 3;
+   */
+  qualification?: QualificationPolicy;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.HealthCheck health_checks = 4;
+   */
+  healthChecks: HealthCheck[] = [];
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.HealthDampingPolicy health_damping = 5;
+   */
+  healthDamping?: HealthDampingPolicy;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.StageOwner owners = 6;
+   */
+  owners: StageOwner[] = [];
+
+  /**
+   * @generated from field: optional string reconcile_interval = 7;
+   */
+  reconcileInterval?: string;
+
+  constructor(data?: PartialMessage<StageSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscriptions", kind: "message", T: Subscriptions },
+    { no: 2, name: "promotion_mechanisms", kind: "message", T: PromotionMechanisms },
+    { no: 3, name: "qualification", kind: "message", T: QualificationPolicy, opt: true },
+    { no: 4, name: "health_checks", kind: "message", T: HealthCheck, repeated: true },
+    { no: 5, name: "health_damping", kind: "message", T: HealthDampingPolicy, opt: true },
+    { no: 6, name: "owners", kind: "message", T: StageOwner, repeated: true },
+    { no: 7, name: "reconcile_interval", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageSpec {
+    return new StageSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageSpec {
+    return new StageSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageSpec {
+    return new StageSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StageSpec | PlainMessage<StageSpec> | undefined, b: StageSpec | PlainMessage<StageSpec> | undefined): boolean {
+    return proto3.util.equals(StageSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageOwner
+ */
+export class StageOwner extends Message<StageOwner> {
+  /**
+   * @generated from field: string kind = 1;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<StageOwner>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageOwner";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageOwner {
+    return new StageOwner().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageOwner {
+    return new StageOwner().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageOwner {
+    return new StageOwner().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StageOwner | PlainMessage<StageOwner> | undefined, b: StageOwner | PlainMessage<StageOwner> | undefined): boolean {
+    return proto3.util.equals(StageOwner, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HealthDampingPolicy
+ */
+export class HealthDampingPolicy extends Message<HealthDampingPolicy> {
+  /**
+   * @generated from field: int32 consecutive_checks = 1;
+   */
+  consecutiveChecks = 0;
+
+  /**
+   * @generated from field: optional string for = 2;
+   */
+  for?: string;
+
+  constructor(data?: PartialMessage<HealthDampingPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HealthDampingPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "consecutive_checks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "for", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HealthDampingPolicy {
+    return new HealthDampingPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HealthDampingPolicy {
+    return new HealthDampingPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HealthDampingPolicy {
+    return new HealthDampingPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HealthDampingPolicy | PlainMessage<HealthDampingPolicy> | undefined, b: HealthDampingPolicy | PlainMessage<HealthDampingPolicy> | undefined): boolean {
+    return proto3.util.equals(HealthDampingPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HealthCheck
+ */
+export class HealthCheck extends Message<HealthCheck> {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider = "";
+
+  /**
+   * @generated from field: map<string, string> parameters = 2;
+   */
+  parameters: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<HealthCheck>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HealthCheck";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "parameters", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HealthCheck {
+    return new HealthCheck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HealthCheck {
+    return new HealthCheck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HealthCheck {
+    return new HealthCheck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HealthCheck | PlainMessage<HealthCheck> | undefined, b: HealthCheck | PlainMessage<HealthCheck> | undefined): boolean {
+    return proto3.util.equals(HealthCheck, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.QualificationPolicy
+ */
+export class QualificationPolicy extends Message<QualificationPolicy> {
+  /**
+   * @generated from field: string operator = 1;
+   */
+  operator = "";
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.QualificationCriterion criteria = 2;
+   */
+  criteria: QualificationCriterion[] = [];
+
+  constructor(data?: PartialMessage<QualificationPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.QualificationPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operator", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "criteria", kind: "message", T: QualificationCriterion, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QualificationPolicy {
+    return new QualificationPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QualificationPolicy {
+    return new QualificationPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QualificationPolicy {
+    return new QualificationPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QualificationPolicy | PlainMessage<QualificationPolicy> | undefined, b: QualificationPolicy | PlainMessage<QualificationPolicy> | undefined): boolean {
+    return proto3.util.equals(QualificationPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.QualificationCriterion
+ */
+export class QualificationCriterion extends Message<QualificationCriterion> {
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.HealthyCriterion healthy = 1;
+   */
+  healthy?: HealthyCriterion;
+
+  /**
+   * @generated from field: bool manual_approval = 2;
+   */
+  manualApproval = false;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.VerificationCriterion verification = 3;
+   */
+  verification?: VerificationCriterion;
+
+  constructor(data?: PartialMessage<QualificationCriterion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.QualificationCriterion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "healthy", kind: "message", T: HealthyCriterion, opt: true },
+    { no: 2, name: "manual_approval", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "verification", kind: "message", T: VerificationCriterion, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QualificationCriterion {
+    return new QualificationCriterion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QualificationCriterion {
+    return new QualificationCriterion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QualificationCriterion {
+    return new QualificationCriterion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QualificationCriterion | PlainMessage<QualificationCriterion> | undefined, b: QualificationCriterion | PlainMessage<QualificationCriterion> | undefined): boolean {
+    return proto3.util.equals(QualificationCriterion, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.VerificationCriterion
+ */
+export class VerificationCriterion extends Message<VerificationCriterion> {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider = "";
+
+  /**
+   * @generated from field: map<string, string> parameters = 2;
+   */
+  parameters: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<VerificationCriterion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.VerificationCriterion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "parameters", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerificationCriterion {
+    return new VerificationCriterion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerificationCriterion {
+    return new VerificationCriterion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerificationCriterion {
+    return new VerificationCriterion().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StageList | PlainMessage<StageList> | undefined, b: StageList | PlainMessage<StageList> | undefined): boolean {
-    return proto3.util.equals(StageList, a, b);
+  static equals(a: VerificationCriterion | PlainMessage<VerificationCriterion> | undefined, b: VerificationCriterion | PlainMessage<VerificationCriterion> | undefined): boolean {
+    return proto3.util.equals(VerificationCriterion, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HealthyCriterion
  */
-export class StageSpec extends Message<StageSpec> {
-  /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Subscriptions subscriptions = 1;
-   */
-  subscriptions?: Subscriptions;
-
+export class HealthyCriterion extends Message<HealthyCriterion> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms promotion_mechanisms = 2;
+   * @generated from field: optional string for = 1;
    */
-  promotionMechanisms?: PromotionMechanisms;
+  for?: string;
 
-  constructor(data?: PartialMessage<StageSpec>) {
+  constructor(data?: PartialMessage<HealthyCriterion>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HealthyCriterion";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "subscriptions", kind: "message", T: Subscriptions },
-    { no: 2, name: "promotion_mechanisms", kind: "message", T: PromotionMechanisms },
+    { no: 1, name: "for", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageSpec {
-    return new StageSpec().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HealthyCriterion {
+    return new HealthyCriterion().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageSpec {
-    return new StageSpec().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HealthyCriterion {
+    return new HealthyCriterion().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageSpec {
-    return new StageSpec().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HealthyCriterion {
+    return new HealthyCriterion().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StageSpec | PlainMessage<StageSpec> | undefined, b: StageSpec | PlainMessage<StageSpec> | undefined): boolean {
-    return proto3.util.equals(StageSpec, a, b);
+  static equals(a: HealthyCriterion | PlainMessage<HealthyCriterion> | undefined, b: HealthyCriterion | PlainMessage<HealthyCriterion> | undefined): boolean {
+    return proto3.util.equals(HealthyCriterion, a, b);
   }
 }
 
@@ -1699,6 +2935,11 @@ export class Freight extends Message<Freight> {
    */
   status?: FreightStatus;
 
+  /**
+   * @generated from field: string alias = 9;
+   */
+  alias = "";
+
   constructor(data?: PartialMessage<Freight>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1715,6 +2956,7 @@ export class Freight extends Message<Freight> {
     { no: 6, name: "images", kind: "message", T: Image, repeated: true },
     { no: 7, name: "charts", kind: "message", T: Chart, repeated: true },
     { no: 8, name: "status", kind: "message", T: FreightStatus },
+    { no: 9, name: "alias", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Freight {
@@ -1743,6 +2985,16 @@ export class FreightStatus extends Message<FreightStatus> {
    */
   qualifications: { [key: string]: Qualification } = {};
 
+  /**
+   * @generated from field: map<string, github.com.akuity.kargo.pkg.api.v1alpha1.Approval> approvals = 2;
+   */
+  approvals: { [key: string]: Approval } = {};
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.BuildInfo build_info = 3;
+   */
+  buildInfo: BuildInfo[] = [];
+
   constructor(data?: PartialMessage<FreightStatus>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1752,6 +3004,8 @@ export class FreightStatus extends Message<FreightStatus> {
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "qualifications", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: Qualification} },
+    { no: 2, name: "approvals", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: Approval} },
+    { no: 3, name: "build_info", kind: "message", T: BuildInfo, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightStatus {
@@ -1771,6 +3025,67 @@ export class FreightStatus extends Message<FreightStatus> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.BuildInfo
+ */
+export class BuildInfo extends Message<BuildInfo> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string revision = 2;
+   */
+  revision = "";
+
+  /**
+   * @generated from field: optional string pipeline_url = 3 [json_name = "pipelineURL"];
+   */
+  pipelineUrl?: string;
+
+  /**
+   * @generated from field: optional string test_results_url = 4 [json_name = "testResultsURL"];
+   */
+  testResultsUrl?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp reported_at = 5;
+   */
+  reportedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<BuildInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.BuildInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "revision", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "pipeline_url", jsonName: "pipelineURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "test_results_url", jsonName: "testResultsURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "reported_at", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BuildInfo {
+    return new BuildInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BuildInfo {
+    return new BuildInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BuildInfo {
+    return new BuildInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BuildInfo | PlainMessage<BuildInfo> | undefined, b: BuildInfo | PlainMessage<BuildInfo> | undefined): boolean {
+    return proto3.util.equals(BuildInfo, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Qualification
  */
@@ -1802,6 +3117,49 @@ export class Qualification extends Message<Qualification> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Approval
+ */
+export class Approval extends Message<Approval> {
+  /**
+   * @generated from field: string approver = 1;
+   */
+  approver = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp approved_at = 2;
+   */
+  approvedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Approval>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Approval";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "approver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "approved_at", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Approval {
+    return new Approval().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Approval {
+    return new Approval().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Approval {
+    return new Approval().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Approval | PlainMessage<Approval> | undefined, b: Approval | PlainMessage<Approval> | undefined): boolean {
+    return proto3.util.equals(Approval, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.SimpleFreight
  */
@@ -1831,6 +3189,16 @@ export class SimpleFreight extends Message<SimpleFreight> {
    */
   charts: Chart[] = [];
 
+  /**
+   * @generated from field: bool superseded = 7;
+   */
+  superseded = false;
+
+  /**
+   * @generated from field: string project = 8;
+   */
+  project = "";
+
   constructor(data?: PartialMessage<SimpleFreight>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1844,6 +3212,8 @@ export class SimpleFreight extends Message<SimpleFreight> {
     { no: 4, name: "commits", kind: "message", T: GitCommit, repeated: true },
     { no: 5, name: "images", kind: "message", T: Image, repeated: true },
     { no: 6, name: "charts", kind: "message", T: Chart, repeated: true },
+    { no: 7, name: "superseded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SimpleFreight {
@@ -1892,6 +3262,21 @@ export class StageStatus extends Message<StageStatus> {
    */
   currentPromotion?: PromotionInfo;
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.metav1.Condition conditions = 7;
+   */
+  conditions: Condition[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.VerificationResult verifications = 8;
+   */
+  verifications: VerificationResult[] = [];
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.AutoPromotionHold auto_promotion_hold = 9;
+   */
+  autoPromotionHold?: AutoPromotionHold;
+
   constructor(data?: PartialMessage<StageStatus>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1905,6 +3290,9 @@ export class StageStatus extends Message<StageStatus> {
     { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "health", kind: "message", T: Health, opt: true },
     { no: 6, name: "current_promotion", kind: "message", T: PromotionInfo, opt: true },
+    { no: 7, name: "conditions", kind: "message", T: Condition, repeated: true },
+    { no: 8, name: "verifications", kind: "message", T: VerificationResult, repeated: true },
+    { no: 9, name: "auto_promotion_hold", kind: "message", T: AutoPromotionHold, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageStatus {
@@ -1924,6 +3312,122 @@ export class StageStatus extends Message<StageStatus> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.AutoPromotionHold
+ */
+export class AutoPromotionHold extends Message<AutoPromotionHold> {
+  /**
+   * @generated from field: google.protobuf.Timestamp since = 1;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp until = 2;
+   */
+  until?: Timestamp;
+
+  constructor(data?: PartialMessage<AutoPromotionHold>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.AutoPromotionHold";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "since", kind: "message", T: Timestamp },
+    { no: 2, name: "until", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AutoPromotionHold {
+    return new AutoPromotionHold().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AutoPromotionHold {
+    return new AutoPromotionHold().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AutoPromotionHold {
+    return new AutoPromotionHold().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AutoPromotionHold | PlainMessage<AutoPromotionHold> | undefined, b: AutoPromotionHold | PlainMessage<AutoPromotionHold> | undefined): boolean {
+    return proto3.util.equals(AutoPromotionHold, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.VerificationResult
+ */
+export class VerificationResult extends Message<VerificationResult> {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider = "";
+
+  /**
+   * @generated from field: string freight = 2;
+   */
+  freight = "";
+
+  /**
+   * @generated from field: bool passed = 3;
+   */
+  passed = false;
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message = "";
+
+  /**
+   * @generated from field: map<string, string> details = 5;
+   */
+  details: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp verified_at = 6;
+   */
+  verifiedAt?: Timestamp;
+
+  /**
+   * @generated from field: map<string, string> artifact_urls = 7 [json_name = "artifactURLs"];
+   */
+  artifactUrls: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<VerificationResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.VerificationResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "passed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "details", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 6, name: "verified_at", kind: "message", T: Timestamp, opt: true },
+    { no: 7, name: "artifact_urls", jsonName: "artifactURLs", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerificationResult {
+    return new VerificationResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerificationResult {
+    return new VerificationResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerificationResult {
+    return new VerificationResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VerificationResult | PlainMessage<VerificationResult> | undefined, b: VerificationResult | PlainMessage<VerificationResult> | undefined): boolean {
+    return proto3.util.equals(VerificationResult, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageSubscription
  */
@@ -2074,6 +3578,11 @@ export class WarehouseSpec extends Message<WarehouseSpec> {
    */
   subscriptions: RepoSubscription[] = [];
 
+  /**
+   * @generated from field: optional string reconcile_interval = 2;
+   */
+  reconcileInterval?: string;
+
   constructor(data?: PartialMessage<WarehouseSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2083,6 +3592,7 @@ export class WarehouseSpec extends Message<WarehouseSpec> {
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "subscriptions", kind: "message", T: RepoSubscription, repeated: true },
+    { no: 2, name: "reconcile_interval", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WarehouseSpec {
@@ -2116,6 +3626,16 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
    */
   observedGeneration = protoInt64.zero;
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.BuildInfo build_info = 3;
+   */
+  buildInfo: BuildInfo[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionRejections rejections = 4;
+   */
+  rejections: SubscriptionRejections[] = [];
+
   constructor(data?: PartialMessage<WarehouseStatus>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2126,6 +3646,8 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "observed_generation", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "build_info", kind: "message", T: BuildInfo, repeated: true },
+    { no: 4, name: "rejections", kind: "message", T: SubscriptionRejections, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WarehouseStatus {
@@ -2145,3 +3667,101 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionRejections
+ */
+export class SubscriptionRejections extends Message<SubscriptionRejections> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: optional string chart = 2;
+   */
+  chart?: string;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.RejectedVersion versions = 3;
+   */
+  versions: RejectedVersion[] = [];
+
+  /**
+   * @generated from field: optional int32 omitted = 4;
+   */
+  omitted?: number;
+
+  constructor(data?: PartialMessage<SubscriptionRejections>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionRejections";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "chart", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "versions", kind: "message", T: RejectedVersion, repeated: true },
+    { no: 4, name: "omitted", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubscriptionRejections {
+    return new SubscriptionRejections().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubscriptionRejections {
+    return new SubscriptionRejections().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubscriptionRejections {
+    return new SubscriptionRejections().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SubscriptionRejections | PlainMessage<SubscriptionRejections> | undefined, b: SubscriptionRejections | PlainMessage<SubscriptionRejections> | undefined): boolean {
+    return proto3.util.equals(SubscriptionRejections, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.RejectedVersion
+ */
+export class RejectedVersion extends Message<RejectedVersion> {
+  /**
+   * @generated from field: string version = 1;
+   */
+  version = "";
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<RejectedVersion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.RejectedVersion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectedVersion {
+    return new RejectedVersion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectedVersion {
+    return new RejectedVersion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectedVersion {
+    return new RejectedVersion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RejectedVersion | PlainMessage<RejectedVersion> | undefined, b: RejectedVersion | PlainMessage<RejectedVersion> | undefined): boolean {
+    return proto3.util.equals(RejectedVersion, a, b);
+  }
+}
+
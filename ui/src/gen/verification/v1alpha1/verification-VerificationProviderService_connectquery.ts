@@ -0,0 +1,27 @@
+// @generated by protoc-gen-connect-query v0.4.1 with parameter "target=ts"
+// @generated from file verification/v1alpha1/verification.proto (package akuity.io.kargo.verification.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { createQueryService } from "@bufbuild/connect-query";
+import { MethodKind } from "@bufbuild/protobuf";
+import { VerifyRequest, VerifyResponse } from "./verification_pb.js";
+
+export const typeName = "akuity.io.kargo.verification.v1alpha1.VerificationProviderService";
+
+/**
+ * @generated from rpc akuity.io.kargo.verification.v1alpha1.VerificationProviderService.Verify
+ */
+export const verify = createQueryService({
+  service: {
+    methods: {
+      verify: {
+        name: "Verify",
+        kind: MethodKind.Unary,
+        I: VerifyRequest,
+        O: VerifyResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.verification.v1alpha1.VerificationProviderService",
+  },
+}).verify;
@@ -0,0 +1,30 @@
+// @generated by protoc-gen-connect-es v0.12.0 with parameter "target=ts"
+// @generated from file verification/v1alpha1/verification.proto (package akuity.io.kargo.verification.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { VerifyRequest, VerifyResponse } from "./verification_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * VerificationProviderService is implemented by external verification
+ * providers. Kargo's Stage controller invokes it to determine whether a Stage's
+ * current Freight satisfies a Verification qualification criterion.
+ *
+ * @generated from service akuity.io.kargo.verification.v1alpha1.VerificationProviderService
+ */
+export const VerificationProviderService = {
+  typeName: "akuity.io.kargo.verification.v1alpha1.VerificationProviderService",
+  methods: {
+    /**
+     * @generated from rpc akuity.io.kargo.verification.v1alpha1.VerificationProviderService.Verify
+     */
+    verify: {
+      name: "Verify",
+      I: VerifyRequest,
+      O: VerifyResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
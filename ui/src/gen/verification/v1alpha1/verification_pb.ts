@@ -0,0 +1,140 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=ts"
+// @generated from file verification/v1alpha1/verification.proto (package akuity.io.kargo.verification.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import { SimpleFreight } from "../../v1alpha1/types_pb.js";
+
+/**
+ * @generated from message akuity.io.kargo.verification.v1alpha1.VerifyRequest
+ */
+export class VerifyRequest extends Message<VerifyRequest> {
+  /**
+   * namespace is the namespace (i.e. project) of the Stage.
+   *
+   * @generated from field: string namespace = 1;
+   */
+  namespace = "";
+
+  /**
+   * stage is the name of the Stage.
+   *
+   * @generated from field: string stage = 2;
+   */
+  stage = "";
+
+  /**
+   * freight is the Stage's current Freight.
+   *
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.SimpleFreight freight = 3;
+   */
+  freight?: SimpleFreight;
+
+  /**
+   * parameters are the provider-specific parameters specified by the
+   * Verification qualification criterion.
+   *
+   * @generated from field: map<string, string> parameters = 4;
+   */
+  parameters: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<VerifyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.verification.v1alpha1.VerifyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "freight", kind: "message", T: SimpleFreight },
+    { no: 4, name: "parameters", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyRequest {
+    return new VerifyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyRequest {
+    return new VerifyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyRequest {
+    return new VerifyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VerifyRequest | PlainMessage<VerifyRequest> | undefined, b: VerifyRequest | PlainMessage<VerifyRequest> | undefined): boolean {
+    return proto3.util.equals(VerifyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.verification.v1alpha1.VerifyResponse
+ */
+export class VerifyResponse extends Message<VerifyResponse> {
+  /**
+   * passed indicates whether the Freight passed the provider's checks.
+   *
+   * @generated from field: bool passed = 1;
+   */
+  passed = false;
+
+  /**
+   * message is a human-readable summary of the outcome of the verification.
+   *
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  /**
+   * details are provider-specific, structured details of the outcome of the
+   * verification.
+   *
+   * @generated from field: map<string, string> details = 3;
+   */
+  details: { [key: string]: string } = {};
+
+  /**
+   * artifacts are any provider-specific artifacts of the verification, such as
+   * test reports, keyed by name. Names may contain slashes. Artifacts are
+   * stored outside of the Stage, which records only their URLs, and are
+   * discarded if Kargo has not been configured with an artifact store.
+   *
+   * @generated from field: map<string, bytes> artifacts = 4;
+   */
+  artifacts: { [key: string]: Uint8Array } = {};
+
+  constructor(data?: PartialMessage<VerifyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.verification.v1alpha1.VerifyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "passed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "details", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "artifacts", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 12 /* ScalarType.BYTES */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyResponse {
+    return new VerifyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyResponse {
+    return new VerifyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyResponse {
+    return new VerifyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VerifyResponse | PlainMessage<VerifyResponse> | undefined, b: VerifyResponse | PlainMessage<VerifyResponse> | undefined): boolean {
+    return proto3.util.equals(VerifyResponse, a, b);
+  }
+}
+